@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/config"
+)
+
+// dbCheckTimeout bounds how long --check-config waits for the database to answer.
+const dbCheckTimeout = 5 * time.Second
+
+// runConfigCheck validates the configuration, verifies database reachability and
+// prints actionable results. It returns the process exit code (0 ok, 1 failure).
+func runConfigCheck() int {
+	cfg := config.Read()
+	result := cfg.Check()
+
+	fmt.Println("Configuration summary:")
+	summary := cfg.Summary()
+	for i := 0; i+1 < len(summary); i += 2 {
+		fmt.Printf("  %-24s %v\n", summary[i], summary[i+1])
+	}
+	fmt.Println()
+
+	for _, w := range result.Warnings {
+		fmt.Printf("WARN  %s %s\n", w.Field, w.Message)
+	}
+	for _, e := range result.Errors {
+		fmt.Printf("ERROR %s %s\n", e.Field, e.Message)
+	}
+
+	// Only load the signing keys and probe the database when their settings passed validation
+	if result.OK() {
+		if _, err := newJWTService(cfg.JWT); err != nil {
+			fmt.Printf("ERROR JWT %v\n", err)
+			result.Errors = append(result.Errors, &config.ConfigError{Field: "JWT", Message: err.Error()})
+		} else {
+			fmt.Println("OK    JWT signing key loaded")
+		}
+	}
+	if result.OK() && cfg.TLS.CertFile != "" {
+		if _, err := tls.LoadX509KeyPair(cfg.TLS.CertFile, cfg.TLS.KeyFile); err != nil {
+			fmt.Printf("ERROR TLS %v\n", err)
+			result.Errors = append(result.Errors, &config.ConfigError{Field: "TLS", Message: err.Error()})
+		} else {
+			fmt.Println("OK    TLS certificate loaded")
+		}
+	}
+	if result.OK() {
+		if err := checkDatabase(cfg); err != nil {
+			fmt.Printf("ERROR DB %v\n", err)
+			result.Errors = append(result.Errors, &config.ConfigError{Field: "DB", Message: err.Error()})
+		} else {
+			fmt.Println("OK    database reachable")
+		}
+	}
+
+	if !result.OK() {
+		fmt.Fprintf(os.Stderr, "\nconfiguration check failed: %d error(s), %d warning(s)\n",
+			len(result.Errors), len(result.Warnings))
+		return 1
+	}
+
+	fmt.Printf("\nconfiguration check passed with %d warning(s)\n", len(result.Warnings))
+	return 0
+}
+
+// checkDatabase opens a connection with the configured DSN and pings it.
+func checkDatabase(cfg *config.Config) error {
+	db, err := connectDB(cfg)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	defer sqlDB.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dbCheckTimeout)
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("database unreachable at %s:%s: %w", cfg.DB.Host, cfg.DB.Port, err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/config"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/migrate"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/cache"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/footballapi"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+)
+
+// importArgs holds the --import command line options.
+type importArgs struct {
+	enabled     bool
+	competition string // Overrides IMPORT_COMPETITION when set
+	season      int    // Overrides IMPORT_SEASON when > 0
+}
+
+// runImport imports a season of a competition from the external football data provider in
+// IMPORT_PROVIDER: the competition, the season, its teams and squads and its fixtures with
+// results.
+// Running it again updates what it imported before. It returns the process exit code.
+func runImport(args importArgs) int {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+	if args.competition != "" {
+		cfg.Import.Competition = args.competition
+	}
+	if args.season > 0 {
+		cfg.Import.Season = args.season
+	}
+	if !cfg.Import.Enabled() {
+		fmt.Fprintln(os.Stderr, "IMPORT_PROVIDER is not set; nothing to import from")
+		return 1
+	}
+	if err := cfg.Check().Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		return 1
+	}
+
+	provider, err := footballapi.New(cfg.Import.Provider, cfg.Import.APIKey, cfg.Import.BaseURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid import provider: %v\n", err)
+		return 1
+	}
+	db, err := connectDB(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to database: %v\n", err)
+		return 1
+	}
+	if err := migrate.Run(db, cfg.Match.Location()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to run auto migration: %v\n", err)
+		return 1
+	}
+
+	// Only a shared cache outlives this process, so an in-memory one is left out
+	var responseCache *service.ResponseCache
+	if cfg.Cache.TTL > 0 && cfg.Cache.RedisURL != "" {
+		client, err := redis.NewClient(cfg.Cache.RedisURL)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to configure redis: %v\n", err)
+			return 1
+		}
+		defer client.Close()
+		responseCache = service.NewResponseCache(cache.NewRedis(client, cfg.App.Name+":cache:"), cfg.Cache.Backend(), cfg.Cache.TTL)
+	}
+
+	teamRepo := repository.NewTeamRepository(db)
+	matchRepo := repository.NewMatchRepository(db)
+	syncService := service.NewSyncService(provider,
+		repository.NewExternalRefRepository(db),
+		repository.NewCompetitionRepository(db),
+		repository.NewSeasonRepository(db),
+		teamRepo,
+		repository.NewPlayerRepository(db),
+		matchRepo,
+		repository.NewMatchEventRepository(db),
+		service.NewRatingService(matchRepo, repository.NewTeamRatingRepository(db), teamRepo, cfg.Match.RatingKFactor),
+		service.NewStandingsHistoryService(service.NewStandingsService(matchRepo, nil), matchRepo, repository.NewStandingSnapshotRepository(db), teamRepo),
+		responseCache,
+	)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	report, err := syncService.Sync(ctx, cfg.Import.Competition, cfg.Import.Season)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to import %s %d: %v\n", cfg.Import.Competition, cfg.Import.Season, err)
+		return 1
+	}
+
+	fmt.Printf("Imported %s %d from %s:\n", cfg.Import.Competition, cfg.Import.Season, report.Provider)
+	fmt.Printf("  %-12s %s, season %s (%s to %s)\n", "competition", report.Competition.Name, report.Season.Name, report.Season.StartDate, report.Season.EndDate)
+	fmt.Printf("  %-12s %d created, %d updated, %d unchanged\n", "teams", report.Teams.Created, report.Teams.Updated, report.Teams.Unchanged)
+	fmt.Printf("  %-12s %d created, %d updated, %d unchanged, %d skipped\n", "players", report.Players.Created, report.Players.Updated, report.Players.Unchanged, report.Players.Skipped)
+	fmt.Printf("  %-12s %d created, %d updated, %d unchanged, %d skipped\n", "matches", report.Matches.Created, report.Matches.Updated, report.Matches.Unchanged, report.Matches.Skipped)
+	return 0
+}
@@ -1,120 +1,466 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
-	"log"
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"time"
+	// Embedded zone database so MATCH_TIMEZONE resolves on minimal images without tzdata
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/config"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/graph"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/handler"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/migrate"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/router"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/cache"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/chat"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errtrack"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
 	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/logging"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/mailer"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ratelimit"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/scheduler"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
 	"github.com/spf13/viper"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 //	@title						XYZ Football API
 //	@version					1.0
-//	@description				REST API for managing football teams, players, match schedules, results, and reports for Perusahaan XYZ.
+//	@description				REST API for managing football teams, players, match schedules, results, and reports for Perusahaan XYZ. Messages are in English or Bahasa Indonesia according to the Accept-Language header (en, id).
 //	@termsOfService				http://swagger.io/terms/
 //	@contact.name				API Support
 //	@contact.email				admin@xyz-football.com
 //	@license.name				MIT
 //	@license.url				https://opensource.org/licenses/MIT
-//	@host						localhost:8080
 //	@BasePath					/api/v1
 //	@securityDefinitions.apikey	BearerAuth
 //	@in							header
 //	@name						Authorization
 //	@description				Enter your bearer token in the format: Bearer {token}
+//	@securityDefinitions.apikey	ApiKeyAuth
+//	@in							header
+//	@name						X-API-Key
+//	@description				API key for machine clients; accepted on read endpoints within the key's scopes
 
 func main() {
+	startedAt := time.Now()
+
+	// Log as text until the configuration says otherwise; every record carries the request ID from the context
+	slog.SetDefault(slog.New(logging.NewHandler(os.Stderr, logging.FormatText, slog.LevelInfo)))
+
+	checkConfig := flag.Bool("check-config", false, "validate configuration and database connectivity, then exit")
+	var seeding seedArgs
+	flag.StringVar(&seeding.dataset, "seed", "", "populate an empty database with a demo data set (small, demo or full), then exit")
+	flag.IntVar(&seeding.teams, "seed-teams", 0, "number of teams to seed (overrides the data set)")
+	flag.IntVar(&seeding.players, "seed-players", 0, "players per seeded team (overrides the data set)")
+	flag.StringVar(&seeding.start, "seed-start", "", "first matchday of the seeded season, YYYY-MM-DD (default: half the season already played)")
+	flag.Uint64Var(&seeding.random, "seed-random", 0, "random seed to reproduce a data set (default: from the clock)")
+	var importing importArgs
+	flag.BoolVar(&importing.enabled, "import", false, "import a season from the football data provider in IMPORT_PROVIDER, then exit")
+	flag.StringVar(&importing.competition, "import-competition", "", "competition to import, e.g. PL (overrides IMPORT_COMPETITION)")
+	flag.IntVar(&importing.season, "import-season", 0, "year the imported season starts in (overrides IMPORT_SEASON)")
+	flag.Parse()
+
+	if *checkConfig {
+		os.Exit(runConfigCheck())
+	}
+	if seeding.dataset != "" {
+		os.Exit(runSeed(seeding))
+	}
+	if importing.enabled {
+		os.Exit(runImport(importing))
+	}
+
 	// 1. Load configuration
 	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("failed to load config: %v", err)
+		fatal("failed to load config", err)
 	}
 
-	slog.Info("configuration loaded",
-		"app", cfg.App.Name,
-		"env", cfg.App.Env,
-		"port", cfg.Server.Port,
-	)
+	slog.SetDefault(slog.New(logging.NewHandler(os.Stderr, cfg.Log.Format, cfg.Log.SlogLevel())))
+	slog.Info("starting xyz-football-api", buildinfo.Get().LogAttrs()...)
+	slog.Info("configuration loaded", cfg.Summary()...)
 
-	// 2. Set GIN mode based on environment
+	// 2. Set GIN mode based on environment; its debug output goes through slog
 	if cfg.App.Env == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
+	gin.DebugPrintRouteFunc = func(method, path, handler string, _ int) {
+		slog.Debug("route registered", "method", method, "path", path, "handler", handler)
+	}
+	gin.DebugPrintFunc = func(format string, values ...any) {
+		slog.Debug(strings.TrimSpace(fmt.Sprintf(format, values...)))
+	}
+	if err := handler.RegisterValidators(); err != nil {
+		fatal("failed to register request validators", err)
+	}
+	timefmt.SetLocation(cfg.Server.Location())
 
 	// 3. Connect to PostgreSQL
 	db, err := connectDB(cfg)
 	if err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+		fatal("failed to connect to database", err)
 	}
 	slog.Info("database connected successfully")
 
 	// 4. Run AutoMigrate
-	if err := autoMigrate(db); err != nil {
-		log.Fatalf("failed to run auto migration: %v", err)
+	if err := migrate.Run(db, cfg.Match.Location()); err != nil {
+		fatal("failed to run auto migration", err)
 	}
 	slog.Info("database migration completed")
 
-	// 5. Seed default admin
-	if err := seedAdmin(db, cfg.App.Env); err != nil {
-		log.Fatalf("failed to seed admin: %v", err)
+	// 5. Seed default admin (and optional read-only viewer)
+	passwordPolicy := newPasswordPolicy(cfg.Password)
+	if err := seedAdmin(db, cfg.App.Env, passwordPolicy); err != nil {
+		fatal("failed to seed admin", err)
+	}
+	if err := seedViewer(db, passwordPolicy); err != nil {
+		fatal("failed to seed viewer", err)
 	}
 
 	// 6. Initialize JWT service
-	jwtService := jwtpkg.NewService(
-		cfg.JWT.Secret,
-		cfg.JWT.AccessExpiration,
-		cfg.JWT.RefreshExpiration,
-	)
+	jwtService, err := newJWTService(cfg.JWT)
+	if err != nil {
+		fatal("failed to load JWT signing key", err)
+	}
 
 	// 7. Initialize repositories (all take *gorm.DB)
 	adminRepo := repository.NewAdminRepository(db)
 	teamRepo := repository.NewTeamRepository(db)
+	teamManagerRepo := repository.NewTeamManagerRepository(db)
 	playerRepo := repository.NewPlayerRepository(db)
+	coachRepo := repository.NewCoachRepository(db)
+	absenceRepo := repository.NewPlayerAbsenceRepository(db)
 	matchRepo := repository.NewMatchRepository(db)
-	goalRepo := repository.NewGoalRepository(db)
+	eventRepo := repository.NewMatchEventRepository(db)
+	lineupRepo := repository.NewMatchLineupRepository(db)
+	statusChangeRepo := repository.NewMatchStatusChangeRepository(db)
+	snapshotRepo := repository.NewStandingSnapshotRepository(db)
+	ratingRepo := repository.NewTeamRatingRepository(db)
+	attachmentRepo := repository.NewMatchAttachmentRepository(db)
+	competitionRepo := repository.NewCompetitionRepository(db)
+	seasonRepo := repository.NewSeasonRepository(db)
+	groupRepo := repository.NewGroupRepository(db)
+	stadiumRepo := repository.NewStadiumRepository(db)
+	refereeRepo := repository.NewRefereeRepository(db)
+	officialRepo := repository.NewMatchOfficialRepository(db)
 	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(db)
+	passwordHistoryRepo := repository.NewPasswordHistoryRepository(db)
+	notificationPrefRepo := repository.NewNotificationPreferenceRepository(db)
+	confirmationRepo := repository.NewConfirmationTokenRepository(db)
+	healthRepo := repository.NewHealthRepository(db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
+	txManager := repository.NewTxManager(db)
+
+	// 8. Redis connections (shared when URLs match) and response cache
+	redisClients := make(map[string]*redis.Client)
+	defer func() {
+		for _, client := range redisClients {
+			client.Close()
+		}
+	}()
+
+	var responseCache *service.ResponseCache
+	if cfg.Cache.TTL > 0 {
+		var store cache.Cache = cache.NewMemory()
+		if cfg.Cache.RedisURL != "" {
+			store = cache.NewRedis(redisClient(redisClients, cfg.Cache.RedisURL), cfg.App.Name+":cache:")
+		}
+		responseCache = service.NewResponseCache(store, cfg.Cache.Backend(), cfg.Cache.TTL)
+	}
+
+	// Event bus for live updates, shared by the match stream and webhooks
+	eventBus := events.NewBus()
+
+	// Message broker for downstream consumers; events are discarded unless BROKER_URL is set
+	var brokerPublisher events.Publisher = events.Noop{}
+	if cfg.Broker.URL != "" {
+		natsPublisher, err := events.NewNATSPublisher(cfg.Broker.URL, cfg.App.Name)
+		if err != nil {
+			fatal("invalid BROKER_URL", err)
+		}
+		brokerPublisher = natsPublisher
+	}
+	defer brokerPublisher.Close()
+
+	// Mail server for email notifications; nothing is sent unless SMTP_URL is set
+	var mail mailer.Mailer = mailer.Noop{}
+	if cfg.Mail.Enabled() {
+		smtpMailer, err := mailer.NewSMTP(cfg.Mail.SMTPURL, cfg.Mail.From)
+		if err != nil {
+			fatal("invalid SMTP_URL or MAIL_FROM", err)
+		}
+		mail = smtpMailer
+	}
+
+	// Chat channels that result summaries are posted to
+	var chatPosters []chat.Poster
+	if cfg.Chat.SlackEnabled() {
+		slack, err := chat.NewSlack(cfg.Chat.SlackWebhookURL)
+		if err != nil {
+			fatal("invalid SLACK_WEBHOOK_URL", err)
+		}
+		chatPosters = append(chatPosters, slack)
+	}
+	if cfg.Chat.TelegramEnabled() {
+		telegram, err := chat.NewTelegram(cfg.Chat.TelegramBotToken, cfg.Chat.TelegramChatID)
+		if err != nil {
+			fatal("invalid TELEGRAM_BOT_TOKEN or TELEGRAM_CHAT_ID", err)
+		}
+		chatPosters = append(chatPosters, telegram)
+	}
 
-	// 8. Initialize services
-	authService := service.NewAuthService(adminRepo, refreshTokenRepo, jwtService)
-	teamService := service.NewTeamService(teamRepo)
-	playerService := service.NewPlayerService(playerRepo, teamRepo)
-	matchService := service.NewMatchService(matchRepo, teamRepo, playerRepo, goalRepo)
-	reportService := service.NewReportService(matchRepo, goalRepo)
+	// Uploaded files: public ones such as player photos, and match attachments only handed out by the API
+	uploads := storage.NewLocal(cfg.Storage.Dir, cfg.Storage.PublicURL)
+	attachmentFiles := storage.NewLocal(cfg.Storage.AttachmentDir, "")
+
+	// 9. Initialize services
+	authService := service.NewAuthService(adminRepo, refreshTokenRepo, loginAttemptRepo, jwtService, cfg.Security.MaxLoginAttempts, cfg.Security.LockoutDuration, cfg.Password.MaxAge)
+	teamService := service.NewTeamService(teamRepo, playerRepo, matchRepo, stadiumRepo, txManager, responseCache, eventBus)
+	coachService := service.NewCoachService(coachRepo, teamRepo, responseCache)
+	absenceService := service.NewAbsenceService(absenceRepo, playerRepo)
+	playerService := service.NewPlayerService(playerRepo, teamRepo, teamManagerRepo, txManager, newRosterRules(cfg.Roster), responseCache, eventBus, uploads)
+	disciplinaryService := service.NewDisciplinaryService(matchRepo, eventRepo, playerRepo, cfg.Match.YellowCardLimit)
+	matchService := service.NewMatchService(matchRepo, teamRepo, playerRepo, seasonRepo, stadiumRepo, teamManagerRepo, statusChangeRepo, lineupRepo, disciplinaryService, txManager, cfg.Match.ConflictWindow, cfg.Match.Location(), cfg.Match.ResultUndoWindow, responseCache, eventBus)
+	lineupService := service.NewLineupService(matchRepo, playerRepo, lineupRepo, absenceRepo, disciplinaryService, txManager, cfg.Match.Location())
+	timelineService := service.NewTimelineService(matchRepo, statusChangeRepo)
+	attachmentService := service.NewAttachmentService(matchRepo, attachmentRepo, attachmentFiles)
+	officialService := service.NewOfficialService(matchRepo, refereeRepo, officialRepo)
+	reportService := service.NewReportService(matchRepo, eventRepo, lineupRepo, officialRepo, cfg.Match.Location())
+	standingsService := service.NewStandingsService(matchRepo, responseCache)
+	standingsHistoryService := service.NewStandingsHistoryService(standingsService, matchRepo, snapshotRepo, teamRepo)
+	ratingService := service.NewRatingService(matchRepo, ratingRepo, teamRepo, cfg.Match.RatingKFactor)
+	statsService := service.NewStatsService(playerRepo, statsRepo)
+	formService := service.NewFormService(teamRepo, matchRepo)
+	predictionService := service.NewPredictionService(matchRepo, service.NewFormAndHeadToHeadStrategy())
+	competitionService := service.NewCompetitionService(competitionRepo, seasonRepo, responseCache)
+	seasonService := service.NewSeasonService(seasonRepo, competitionRepo)
+	groupService := service.NewGroupService(groupRepo, seasonRepo, teamRepo, matchRepo)
+	stadiumService := service.NewStadiumService(stadiumRepo, responseCache)
+	refereeService := service.NewRefereeService(refereeRepo, officialRepo, cfg.Match.Location())
+	confirmationService := service.NewConfirmationService(confirmationRepo, cfg.Security.ConfirmationTTL)
+	adminService := service.NewAdminService(adminRepo, refreshTokenRepo, loginAttemptRepo, teamManagerRepo, teamRepo, passwordHistoryRepo, passwordPolicy)
+	auditService := service.NewAuditService(auditLogRepo)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	webhookService := service.NewWebhookService(webhookRepo, webhookDeliveryRepo, cfg.Webhook.Timeout, cfg.Webhook.MaxAttempts)
+	notificationService := service.NewNotificationService(notificationPrefRepo, standingsService, mail, cfg.Mail.Recipients, cfg.Match.Location())
+	chatService := service.NewChatService(standingsService, chatPosters)
+	healthService := service.NewHealthService(healthRepo, migrate.Tables(), startedAt)
+	graphService := service.NewGraphService(teamRepo, playerRepo, matchRepo, eventRepo, cfg.Match.Location())
+	if responseCache != nil {
+		healthService.Register(service.ComponentCache, responseCache.HealthCheck())
+	}
 
-	// 9. Initialize handlers
+	// 10. Initialize handlers
+	queryBinder := handler.NewQueryBinder(cfg.Server.StrictQuery)
 	authHandler := handler.NewAuthHandler(authService)
-	teamHandler := handler.NewTeamHandler(teamService)
-	playerHandler := handler.NewPlayerHandler(playerService)
-	matchHandler := handler.NewMatchHandler(matchService)
-	reportHandler := handler.NewReportHandler(reportService)
+	teamHandler := handler.NewTeamHandler(teamService, formService, queryBinder)
+	playerHandler := handler.NewPlayerHandler(playerService, statsService, queryBinder)
+	coachHandler := handler.NewCoachHandler(coachService, queryBinder)
+	absenceHandler := handler.NewAbsenceHandler(absenceService, queryBinder)
+	matchHandler := handler.NewMatchHandler(matchService, lineupService, officialService, disciplinaryService, timelineService, attachmentService, predictionService, eventBus, queryBinder)
+	reportHandler := handler.NewReportHandler(reportService, standingsService, standingsHistoryService, ratingService, cfg.Report.Organization, cfg.Report.Signatures, queryBinder)
+	competitionHandler := handler.NewCompetitionHandler(competitionService, queryBinder)
+	seasonHandler := handler.NewSeasonHandler(seasonService, groupService, queryBinder)
+	stadiumHandler := handler.NewStadiumHandler(stadiumService, queryBinder)
+	refereeHandler := handler.NewRefereeHandler(refereeService, queryBinder)
+	confirmationHandler := handler.NewConfirmationHandler(confirmationService)
+	maintenanceService := service.NewMaintenanceService(cfg.Maintenance.Enabled, cfg.Maintenance.Message)
+	healthHandler := handler.NewHealthHandler(healthService, maintenanceService)
+	maintenanceHandler := handler.NewMaintenanceHandler(maintenanceService)
+	adminHandler := handler.NewAdminHandler(adminService, queryBinder)
+	auditLogHandler := handler.NewAuditLogHandler(auditService, queryBinder)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService, queryBinder)
+	webhookHandler := handler.NewWebhookHandler(webhookService, queryBinder)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	graphSchema, err := graph.NewSchema(graph.Services{
+		Teams:     teamService,
+		Players:   playerService,
+		Matches:   matchService,
+		Reports:   reportService,
+		Standings: standingsService,
+		Graph:     graphService,
+	})
+	if err != nil {
+		fatal("failed to build GraphQL schema", err)
+	}
+	graphqlHandler := handler.NewGraphQLHandler(graphSchema)
 
-	// 10. Setup router
+	// 11. Rate limiting — Redis shares limits across instances; otherwise each instance counts in memory
+	var rateLimiter ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		if cfg.RateLimit.RedisURL != "" {
+			rateLimiter = ratelimit.NewRedisLimiter(redisClient(redisClients, cfg.RateLimit.RedisURL), cfg.App.Name+":ratelimit:")
+		} else {
+			rateLimiter = ratelimit.NewMemoryLimiter()
+		}
+	}
+	loginRule := ratelimit.Rule{Requests: cfg.RateLimit.LoginRequests, Period: cfg.RateLimit.LoginWindow}
+	apiRule := ratelimit.Rule{Requests: cfg.RateLimit.APIRequests, Period: cfg.RateLimit.APIWindow}
+
+	// 12. Error tracking — panics are always logged, and also sent to Sentry when configured
+	var errorTracker errtrack.Tracker = errtrack.Nop{}
+	if cfg.Sentry.Enabled() {
+		sentry, err := errtrack.NewSentry(cfg.Sentry.DSN, cfg.App.Env, buildinfo.Get().Version)
+		if err != nil {
+			fatal("invalid SENTRY_DSN", err)
+		}
+		errorTracker = sentry
+	}
+
+	// 13. Setup router
+	var docsHandler *handler.DocsHandler
+	if cfg.Docs.Enabled {
+		docsHandler = handler.NewDocsHandler(buildinfo.Get().Version, cfg.Docs.Username, cfg.Docs.Password)
+	}
 	r := router.Setup(
-		cfg.App.Env,
+		docsHandler,
+		errorTracker,
+		middleware.CORSPolicy{
+			AllowOrigins: cfg.CORS.AllowedOrigins,
+			AllowMethods: cfg.CORS.AllowedMethods,
+			AllowHeaders: cfg.CORS.AllowedHeaders,
+			MaxAge:       cfg.CORS.MaxAge,
+		},
+		middleware.BodyPolicy{
+			MaxBytes:       cfg.Server.MaxBodyBytes,
+			MaxUploadBytes: cfg.Server.MaxUploadBytes,
+		},
+		middleware.CompressionPolicy{
+			Level:        cfg.Compression.Level,
+			MinBytes:     cfg.Compression.MinBytes,
+			ContentTypes: cfg.Compression.ContentTypes,
+		},
 		jwtService,
+		confirmationService,
+		auditService,
+		apiKeyService,
+		maintenanceService,
+		rateLimiter,
+		loginRule,
+		apiRule,
 		authHandler,
 		teamHandler,
 		playerHandler,
+		coachHandler,
+		absenceHandler,
 		matchHandler,
 		reportHandler,
+		competitionHandler,
+		seasonHandler,
+		stadiumHandler,
+		refereeHandler,
+		confirmationHandler,
+		healthHandler,
+		adminHandler,
+		auditLogHandler,
+		apiKeyHandler,
+		webhookHandler,
+		notificationHandler,
+		graphqlHandler,
+		maintenanceHandler,
 	)
 
-	// 11. Start HTTP server with graceful configuration
+	// Uploads are served from STORAGE_DIR unless a web server or CDN serves them
+	if cfg.Storage.ServedByAPI() {
+		r.Static(cfg.Storage.PublicURL, cfg.Storage.Dir)
+	}
+
+	// Client IPs (used for login rate limits) come from X-Forwarded-For only behind trusted proxies
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		fatal("invalid SERVER_TRUSTED_PROXIES", err)
+	}
+
+	// 14. Background jobs
+	jobs := scheduler.New(slog.Default())
+	jobs.Add(scheduler.Job{
+		Name:     "refresh-token-cleanup",
+		Interval: cfg.Security.TokenCleanupInterval,
+		Timeout:  cfg.Jobs.Timeout,
+		Run:      purgeExpiredTokens(authService),
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "webhook-retry",
+		Interval: cfg.Webhook.PollInterval,
+		Timeout:  cfg.Jobs.Timeout,
+		Run:      dispatchWebhooks(webhookService),
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "match-status",
+		Interval: cfg.Jobs.MatchStatusInterval,
+		Timeout:  cfg.Jobs.Timeout,
+		Run:      advanceMatchStatuses(matchService, cfg.Match.ResultGrace),
+	})
+	if responseCache != nil {
+		jobs.Add(scheduler.Job{
+			Name:     "standings-warm",
+			Interval: cfg.Jobs.StandingsWarmInterval,
+			Timeout:  cfg.Jobs.Timeout,
+			Run:      warmStandings(standingsService),
+		})
+	}
+	if cfg.Jobs.AuditLogRetention > 0 {
+		jobs.Add(scheduler.Job{
+			Name:     "audit-log-prune",
+			Interval: cfg.Jobs.AuditLogPruneInterval,
+			Timeout:  cfg.Jobs.Timeout,
+			Run:      pruneAuditLogs(auditService, cfg.Jobs.AuditLogRetention),
+		})
+	}
+	if cfg.Jobs.SeasonArchiveAfter > 0 {
+		jobs.Add(scheduler.Job{
+			Name:     "season-archive",
+			Interval: cfg.Jobs.SeasonArchiveInterval,
+			Timeout:  cfg.Jobs.Timeout,
+			Run:      archiveSeasons(matchService, cfg.Jobs.SeasonArchiveAfter),
+		})
+	}
+	if cfg.Mail.Enabled() && cfg.Mail.DigestDay != "" {
+		jobs.Add(scheduler.Job{
+			Name:     "standings-digest",
+			Interval: time.Hour,
+			Timeout:  cfg.Jobs.Timeout,
+			Run:      sendStandingsDigest(notificationService, cfg.Mail.DigestDay, cfg.Mail.DigestHour, cfg.Match.Location()),
+		})
+	}
+	jobs.Start(context.Background())
+
+	// Events are always queued so deliveries survive until a dispatcher picks them up
+	go runWebhookQueue(webhookService, eventBus)
+	go runBrokerPublisher(brokerPublisher, eventBus, cfg.Broker.SubjectPrefix)
+	go runEventHandler("standings-history", standingsHistoryService, eventBus)
+	go runEventHandler("ratings", ratingService, eventBus)
+	// Ratings are also replayed on start, to cover results recorded while no instance was listening
+	go func() { _ = ratingService.Recalculate(context.Background()) }()
+	if cfg.Mail.Enabled() {
+		go runEventHandler("email", notificationService, eventBus)
+	}
+	if len(chatPosters) > 0 {
+		go runEventHandler("chat", chatService, eventBus)
+	}
+
+	// 15. Start HTTP server with graceful configuration
 	srv := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
 		Handler:      r,
@@ -122,37 +468,164 @@ func main() {
 		WriteTimeout: cfg.Server.WriteTimeout,
 	}
 
-	slog.Info("starting server", "port", cfg.Server.Port)
-	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatalf("failed to start server: %v", err)
+	if err := serve(srv, cfg.TLS); err != nil && err != http.ErrServerClosed {
+		fatal("failed to start server", err)
+	}
+}
+
+// fatal logs a startup failure through slog, so it is formatted like every other line, and exits.
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// redisClient returns the client for url from clients, creating it on first use.
+func redisClient(clients map[string]*redis.Client, url string) *redis.Client {
+	if client, ok := clients[url]; ok {
+		return client
+	}
+	client, err := redis.NewClient(url)
+	if err != nil {
+		fatal("failed to configure redis", err)
+	}
+	clients[url] = client
+	return client
+}
+
+// purgeExpiredTokens returns the job that deletes expired refresh tokens.
+func purgeExpiredTokens(authService service.AuthService) func(context.Context) error {
+	return func(ctx context.Context) error {
+		deleted, err := authService.PurgeExpiredTokens(ctx)
+		if err == nil && deleted > 0 {
+			slog.InfoContext(ctx, "purged expired refresh tokens", "count", deleted)
+		}
+		return err
+	}
+}
+
+// dispatchWebhooks returns the job that sends due webhook deliveries, including retries
+// whose backoff has passed. It keeps dispatching while full batches are found.
+func dispatchWebhooks(webhookService service.WebhookService) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			n, err := webhookService.DispatchDue(ctx)
+			if err != nil || n == 0 || ctx.Err() != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendStandingsDigest returns the job that emails the standings digest. The job runs hourly
+// and only sends during the given hour of the given weekday, in location.
+func sendStandingsDigest(notificationService service.NotificationService, day string, hour int, location *time.Location) func(context.Context) error {
+	return func(ctx context.Context) error {
+		now := time.Now().In(location)
+		if !strings.EqualFold(now.Weekday().String(), day) || now.Hour() != hour {
+			return nil
+		}
+		return notificationService.SendStandingsDigest(ctx)
+	}
+}
+
+// advanceMatchStatuses returns the job that moves kicked-off matches to live, and matches
+// still without a result after resultGrace to awaiting_result.
+func advanceMatchStatuses(matchService service.MatchService, resultGrace time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		changed, err := matchService.AdvanceStatuses(ctx, resultGrace)
+		if err == nil && changed > 0 {
+			slog.InfoContext(ctx, "advanced match statuses", "count", changed)
+		}
+		return err
+	}
+}
+
+// warmStandings returns the job that recomputes the cached standings tables.
+func warmStandings(standingsService service.StandingsService) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := standingsService.WarmCache(ctx)
+		return err
+	}
+}
+
+// pruneAuditLogs returns the job that deletes audit logs older than retention.
+func pruneAuditLogs(auditService service.AuditService, retention time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		deleted, err := auditService.PurgeOlderThan(ctx, retention)
+		if err == nil && deleted > 0 {
+			slog.InfoContext(ctx, "purged old audit logs", "count", deleted, "retention", retention.String())
+		}
+		return err
+	}
+}
+
+// archiveSeasons returns the job that archives the matches of seasons that ended more than years ago.
+func archiveSeasons(matchService service.MatchService, years int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		archived, err := matchService.ArchiveSeasons(ctx, years)
+		if err == nil && archived > 0 {
+			slog.InfoContext(ctx, "archived matches of old seasons", "count", archived, "after_years", years)
+		}
+		return err
+	}
+}
+
+// runWebhookQueue turns published events into queued webhook deliveries.
+// If the bus drops the subscription because the queue fell behind, it subscribes again;
+// events published in between are not delivered to webhooks.
+func runWebhookQueue(webhookService service.WebhookService, bus *events.Bus) {
+	for {
+		sub := bus.Subscribe(256)
+		for event := range sub.C() {
+			if err := webhookService.Enqueue(context.Background(), event); err != nil {
+				slog.Error("failed to queue webhook deliveries", "error", err, "event_type", event.Type)
+			}
+		}
+		slog.Warn("webhook queue fell behind the event bus, resubscribing")
+	}
+}
+
+// eventHandler is a service that reacts to events on the bus, such as the email and chat notifiers.
+type eventHandler interface {
+	HandleEvent(ctx context.Context, event events.Event) error
+}
+
+// runEventHandler passes every event on the bus to handler. Like the webhook queue, it
+// subscribes again if it falls behind; events published in between are not handled.
+func runEventHandler(name string, handler eventHandler, bus *events.Bus) {
+	for {
+		sub := bus.Subscribe(256)
+		for event := range sub.C() {
+			// Failures are logged by the handler
+			_ = handler.HandleEvent(context.Background(), event)
+		}
+		slog.Warn("event handler fell behind the event bus, resubscribing", "handler", name)
+	}
+}
+
+// runBrokerPublisher publishes every event on the bus to the message broker, on the subject
+// "<prefix>.<event type>". Delivery is at most once: events that fail to publish are logged and dropped.
+func runBrokerPublisher(publisher events.Publisher, bus *events.Bus, prefix string) {
+	for {
+		sub := bus.Subscribe(256)
+		for event := range sub.C() {
+			_, payload, err := service.EncodeEvent(event)
+			if err == nil {
+				err = publisher.Publish(context.Background(), prefix+"."+event.Type, payload)
+			}
+			if err != nil {
+				slog.Error("failed to publish event to broker", "error", err, "event_type", event.Type)
+			}
+		}
+		slog.Warn("broker publisher fell behind the event bus, resubscribing")
 	}
 }
 
 // connectDB establishes a connection to the PostgreSQL database using GORM.
+// SQL is logged through slog: every query at debug, slow queries and failures above that.
 func connectDB(cfg *config.Config) (*gorm.DB, error) {
-	// Configure GORM logger based on environment
-	var gormLogLevel logger.LogLevel
-	switch cfg.App.Env {
-	case "production":
-		gormLogLevel = logger.Silent
-	case "development":
-		gormLogLevel = logger.Info
-	default:
-		gormLogLevel = logger.Warn
-	}
-
-	gormLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             200 * time.Millisecond,
-			LogLevel:                  gormLogLevel,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-
 	db, err := gorm.Open(postgres.Open(cfg.DB.DSN()), &gorm.Config{
-		Logger: gormLogger,
+		Logger: logging.NewGormLogger(slog.Default(), 200*time.Millisecond),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
@@ -170,23 +643,83 @@ func connectDB(cfg *config.Config) (*gorm.DB, error) {
 	return db, nil
 }
 
-// autoMigrate runs GORM AutoMigrate for all models.
-func autoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&model.Admin{},
-		&model.RefreshToken{},
-		&model.Team{},
-		&model.Player{},
-		&model.Match{},
-		&model.Goal{},
-	)
+// newJWTService builds the token service from its configuration: the shared secret or the
+// private key file, and the key used before a rotation, accepted for the grace window.
+func newJWTService(cfg config.JWTConfig) (*jwtpkg.Service, error) {
+	key := jwtpkg.HMACKey(cfg.KeyID, []byte(cfg.Secret))
+	if cfg.Asymmetric() {
+		var err error
+		key, err = loadJWTKey("JWT_PRIVATE_KEY_FILE", cfg.PrivateKeyFile, cfg.KeyID)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != cfg.Algorithm {
+			return nil, fmt.Errorf("JWT_PRIVATE_KEY_FILE holds an %s key but JWT_ALGORITHM is %s", key.Algorithm, cfg.Algorithm)
+		}
+	}
+	service, err := jwtpkg.NewServiceWithKey(key, cfg.AccessExpiration, cfg.RefreshExpiration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT_PRIVATE_KEY_FILE: %w", err)
+	}
+
+	until := time.Now().Add(cfg.PreviousKeyGrace)
+	switch {
+	case cfg.PreviousSecret != "":
+		service.AcceptPreviousKey(jwtpkg.HMACKey(cfg.PreviousKeyID, []byte(cfg.PreviousSecret)), until)
+	case cfg.PreviousKeyFile != "":
+		previous, err := loadJWTKey("JWT_PREVIOUS_KEY_FILE", cfg.PreviousKeyFile, cfg.PreviousKeyID)
+		if err != nil {
+			return nil, err
+		}
+		service.AcceptPreviousKey(previous, until)
+	}
+	return service, nil
+}
+
+// loadJWTKey reads a PEM key file; setting names the variable it is configured by.
+func loadJWTKey(setting, path, keyID string) (jwtpkg.Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return jwtpkg.Key{}, fmt.Errorf("failed to read %s: %w", setting, err)
+	}
+	key, err := jwtpkg.ParsePEMKey(keyID, data)
+	if err != nil {
+		return jwtpkg.Key{}, fmt.Errorf("invalid %s: %w", setting, err)
+	}
+	return key, nil
+}
+
+// newPasswordPolicy builds the admin password policy from its configuration.
+func newPasswordPolicy(cfg config.PasswordConfig) service.PasswordPolicy {
+	return service.PasswordPolicy{
+		MinLength:     cfg.MinLength,
+		RequireUpper:  cfg.RequireUpper,
+		RequireLower:  cfg.RequireLower,
+		RequireDigit:  cfg.RequireDigit,
+		RequireSymbol: cfg.RequireSymbol,
+		History:       cfg.History,
+		BcryptCost:    cfg.BcryptCost,
+	}
+}
+
+// newRosterRules builds the squad regulations from their configuration.
+func newRosterRules(cfg config.RosterConfig) service.RosterRules {
+	return service.RosterRules{
+		MaxSquadSize:      cfg.MaxSquadSize,
+		MaxForeignPlayers: cfg.MaxForeignPlayers,
+		MinGoalkeepers:    cfg.MinGoalkeepers,
+		MinYouthPlayers:   cfg.MinYouthPlayers,
+		YouthAge:          cfg.YouthAge,
+		HomeNationality:   cfg.HomeNationality,
+	}
 }
 
-// seedAdmin creates a default admin user if none exists.
+// seedAdmin creates a default super admin user if none exists.
 // Credentials are read from ADMIN_USERNAME and ADMIN_PASSWORD environment
 // variables. In development, defaults are used when those vars are unset.
 // In production the application refuses to start with default credentials.
-func seedAdmin(db *gorm.DB, appEnv string) error {
+// The admin has to change the password on first login.
+func seedAdmin(db *gorm.DB, appEnv string, policy service.PasswordPolicy) error {
 	var count int64
 	if err := db.Model(&model.Admin{}).Count(&count).Error; err != nil {
 		return fmt.Errorf("failed to count admins: %w", err)
@@ -214,14 +747,16 @@ func seedAdmin(db *gorm.DB, appEnv string) error {
 		password = "password123"
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := policy.Hash(password)
 	if err != nil {
 		return fmt.Errorf("failed to hash admin password: %w", err)
 	}
 
 	admin := model.Admin{
-		Username: username,
-		Password: string(hashedPassword),
+		Username:           username,
+		Password:           hashedPassword,
+		Role:               model.RoleSuperAdmin,
+		MustChangePassword: true,
 	}
 
 	if err := db.Create(&admin).Error; err != nil {
@@ -232,3 +767,42 @@ func seedAdmin(db *gorm.DB, appEnv string) error {
 
 	return nil
 }
+
+// seedViewer creates a read-only viewer account when VIEWER_USERNAME and
+// VIEWER_PASSWORD are set (e.g. for coaching staff dashboards).
+// Skipped silently when either variable is unset or the username already exists.
+func seedViewer(db *gorm.DB, policy service.PasswordPolicy) error {
+	username := viper.GetString("VIEWER_USERNAME")
+	password := viper.GetString("VIEWER_PASSWORD")
+	if username == "" || password == "" {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(&model.Admin{}).Where("username = ?", username).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check viewer existence: %w", err)
+	}
+	if count > 0 {
+		slog.Info("viewer already exists, skipping seeder", "username", username)
+		return nil
+	}
+
+	hashedPassword, err := policy.Hash(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash viewer password: %w", err)
+	}
+
+	viewer := model.Admin{
+		Username: username,
+		Password: hashedPassword,
+		Role:     model.RoleViewer,
+	}
+
+	if err := db.Create(&viewer).Error; err != nil {
+		return fmt.Errorf("failed to create viewer: %w", err)
+	}
+
+	slog.Info("viewer seeded", "username", username)
+
+	return nil
+}
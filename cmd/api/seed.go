@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/config"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/migrate"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/seed"
+	"gorm.io/gorm"
+)
+
+// seedBatchSize is the number of rows per INSERT when writing a data set.
+const seedBatchSize = 200
+
+// seedArgs holds the --seed command line options.
+type seedArgs struct {
+	dataset string // Preset name, see seed.Presets
+	teams   int    // Overrides the preset when > 0
+	players int    // Overrides the preset when > 0
+	start   string // First matchday (YYYY-MM-DD); by default half the season has been played
+	random  uint64 // Random seed, so a data set can be reproduced; 0 picks one from the clock
+}
+
+// runSeed populates an empty database with a demo data set: a competition, one season,
+// teams with full rosters and every fixture of the season, with results for those already
+// played. The default admin is seeded as on startup. It returns the process exit code.
+func runSeed(args seedArgs) int {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+	if cfg.App.Env == "production" {
+		fmt.Fprintln(os.Stderr, "refusing to seed demo data in production")
+		return 1
+	}
+
+	if args.random == 0 {
+		args.random = uint64(time.Now().UnixNano())
+	}
+	opts, err := seedOptions(args, cfg.Match.Location())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid seed options: %v\n", err)
+		return 1
+	}
+	dataset, err := seed.Generate(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid seed options: %v\n", err)
+		return 1
+	}
+
+	db, err := connectDB(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to database: %v\n", err)
+		return 1
+	}
+	if err := migrate.Run(db, cfg.Match.Location()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to run auto migration: %v\n", err)
+		return 1
+	}
+	if err := seedAdmin(db, cfg.App.Env, newPasswordPolicy(cfg.Password)); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to seed admin: %v\n", err)
+		return 1
+	}
+
+	// Demo teams would mix with real ones and clash on names, so only empty databases are seeded
+	var teams int64
+	if err := db.Model(&model.Team{}).Count(&teams).Error; err != nil {
+		fmt.Fprintf(os.Stderr, "failed to count teams: %v\n", err)
+		return 1
+	}
+	if teams > 0 {
+		fmt.Fprintf(os.Stderr, "database already has %d team(s); seeding only populates an empty database\n", teams)
+		return 1
+	}
+
+	if err := insertDataset(db, dataset); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to insert data set: %v\n", err)
+		return 1
+	}
+
+	completed := 0
+	for _, m := range dataset.Matches {
+		if m.Status == model.MatchStatusCompleted {
+			completed++
+		}
+	}
+	fmt.Printf("Seeded %q data set (random seed %d):\n", args.dataset, args.random)
+	fmt.Printf("  %-12s %s, season %s (%s to %s)\n", "competition", dataset.Competition.Name, dataset.Season.Name, dataset.Season.StartDate, dataset.Season.EndDate)
+	fmt.Printf("  %-12s %d\n", "teams", len(dataset.Teams))
+	fmt.Printf("  %-12s %d\n", "players", len(dataset.Players))
+	fmt.Printf("  %-12s %d (%d completed)\n", "matches", len(dataset.Matches), completed)
+	fmt.Printf("  %-12s %d\n", "events", len(dataset.Events))
+	return 0
+}
+
+// seedOptions resolves the preset and overrides in args into generator options.
+// Kick-offs are at 15:00 in loc.
+func seedOptions(args seedArgs, loc *time.Location) (seed.Options, error) {
+	opts, ok := seed.Presets[args.dataset]
+	if !ok {
+		return opts, fmt.Errorf("unknown data set %q (use small, demo or full)", args.dataset)
+	}
+	if args.teams > 0 {
+		opts.Teams = args.teams
+	}
+	if args.players > 0 {
+		opts.PlayersPerTeam = args.players
+	}
+
+	now := time.Now()
+	opts.Now = now
+	if args.start != "" {
+		day, err := time.ParseInLocation("2006-01-02", args.start, loc)
+		if err != nil {
+			return opts, fmt.Errorf("seed-start must be a date (YYYY-MM-DD)")
+		}
+		opts.SeasonStart = day.Add(15 * time.Hour)
+	} else {
+		// Start far enough back that the season is half played
+		today := time.Date(now.Year(), now.Month(), now.Day(), 15, 0, 0, 0, loc)
+		opts.SeasonStart = today.AddDate(0, 0, -7*(seed.RoundsFor(opts.Teams)/2))
+	}
+
+	opts.Rand = rand.New(rand.NewPCG(args.random, args.random))
+	return opts, nil
+}
+
+// insertDataset writes every record of dataset in one transaction.
+func insertDataset(db *gorm.DB, dataset *seed.Dataset) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&dataset.Competition).Error; err != nil {
+			return fmt.Errorf("competition: %w", err)
+		}
+		if err := tx.Create(&dataset.Season).Error; err != nil {
+			return fmt.Errorf("season: %w", err)
+		}
+		if err := tx.CreateInBatches(&dataset.Teams, seedBatchSize).Error; err != nil {
+			return fmt.Errorf("teams: %w", err)
+		}
+		if err := tx.CreateInBatches(&dataset.Players, seedBatchSize).Error; err != nil {
+			return fmt.Errorf("players: %w", err)
+		}
+		if err := tx.CreateInBatches(&dataset.Matches, seedBatchSize).Error; err != nil {
+			return fmt.Errorf("matches: %w", err)
+		}
+		if len(dataset.Events) > 0 {
+			if err := tx.CreateInBatches(&dataset.Events, seedBatchSize).Error; err != nil {
+				return fmt.Errorf("match events: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/config"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// redirectTimeout bounds requests to the HTTP redirect listener, which never reads a body.
+const redirectTimeout = 5 * time.Second
+
+// serve runs srv until it fails, over HTTPS when cfg enables it. With a redirect port, a second
+// listener sends plain HTTP clients to HTTPS and answers Let's Encrypt HTTP-01 challenges.
+func serve(srv *http.Server, cfg config.TLSConfig) error {
+	if !cfg.Enabled() {
+		slog.Info("starting server", "addr", srv.Addr)
+		return srv.ListenAndServe()
+	}
+
+	var redirect http.Handler = httpsRedirect(srv.Addr)
+	certFile, keyFile := cfg.CertFile, cfg.KeyFile
+	if cfg.Autocert() {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.AutocertDomains...),
+			Cache:      autocert.DirCache(cfg.AutocertCacheDir),
+			Email:      cfg.AutocertEmail,
+		}
+		srv.TLSConfig = manager.TLSConfig()
+		redirect = manager.HTTPHandler(redirect)
+		certFile, keyFile = "", ""
+	} else {
+		srv.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	if cfg.RedirectPort != "" {
+		redirectSrv := &http.Server{
+			Addr:         ":" + cfg.RedirectPort,
+			Handler:      redirect,
+			ReadTimeout:  redirectTimeout,
+			WriteTimeout: redirectTimeout,
+		}
+		go func() {
+			slog.Info("starting HTTPS redirect", "addr", redirectSrv.Addr)
+			if err := redirectSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fatal("failed to start HTTPS redirect", err)
+			}
+		}()
+	}
+
+	slog.Info("starting server", "addr", srv.Addr, "tls", true, "autocert", cfg.Autocert())
+	return srv.ListenAndServeTLS(certFile, keyFile)
+}
+
+// httpsRedirect returns a handler permanently redirecting requests to the same URL over HTTPS
+// on the port of httpsAddr, which is left out of the URL when it is the default 443.
+func httpsRedirect(httpsAddr string) http.Handler {
+	_, port, _ := net.SplitHostPort(httpsAddr)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if port != "" && port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+		target := "https://" + host + r.URL.RequestURI()
+		// 308 rather than 301, so clients repeat POSTs as POSTs instead of turning them into GETs
+		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+	})
+}
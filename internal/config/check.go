@@ -0,0 +1,709 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/mail"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/footballapi"
+	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minJWTSecretLength is the minimum accepted length of JWT_SECRET in bytes.
+const minJWTSecretLength = 32
+
+// minJWTSecretEntropy is the minimum Shannon entropy (bits per character) accepted for JWT_SECRET.
+const minJWTSecretEntropy = 3.0
+
+// minUploadBytes is the upload limit below which files accepted by the player import are rejected.
+const minUploadBytes = 2<<20 + 64<<10 // 2 MB file plus multipart framing
+
+// maxSaneTimeout is the upper bound above which a server timeout is reported as suspicious.
+const maxSaneTimeout = 5 * time.Minute
+
+// placeholderSecrets lists well-known example values that must never be used as a JWT secret.
+var placeholderSecrets = []string{
+	"secret",
+	"changeme",
+	"change-me",
+	"your-secret-key",
+	"your-super-secret-key-change-in-production",
+}
+
+// CheckResult holds the outcome of a configuration check.
+// Errors prevent the application from starting; warnings are informational.
+type CheckResult struct {
+	Errors   []*ConfigError
+	Warnings []*ConfigError
+}
+
+// OK reports whether the check found no errors.
+func (r CheckResult) OK() bool {
+	return len(r.Errors) == 0
+}
+
+// Err joins all errors into a single error, or returns nil if there are none.
+func (r CheckResult) Err() error {
+	if r.OK() {
+		return nil
+	}
+	joined := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		joined[i] = e
+	}
+	return errors.Join(joined...)
+}
+
+func (r *CheckResult) addError(field, message string) {
+	r.Errors = append(r.Errors, &ConfigError{Field: field, Message: message})
+}
+
+func (r *CheckResult) addWarning(field, message string) {
+	r.Warnings = append(r.Warnings, &ConfigError{Field: field, Message: message})
+}
+
+// Check validates the configuration and reports every problem found rather than
+// stopping at the first one. Secret-strength issues are errors in production and
+// warnings elsewhere so local development keeps working with simple values.
+func (c *Config) Check() CheckResult {
+	var r CheckResult
+
+	// Tokens are signed with the shared secret or, for RS256 and EdDSA, a private key
+	jwtKey, jwtValue := "JWT_SECRET", c.JWT.Secret
+	if c.JWT.Asymmetric() {
+		jwtKey, jwtValue = "JWT_PRIVATE_KEY_FILE", c.JWT.PrivateKeyFile
+	}
+
+	// Ordered so the output is deterministic
+	required := []struct {
+		key string
+		val string
+	}{
+		{"DB_USER", c.DB.User},
+		{"DB_PASSWORD", c.DB.Password},
+		{"DB_NAME", c.DB.Name},
+		{jwtKey, jwtValue},
+	}
+	for _, req := range required {
+		if req.val == "" {
+			r.addError(req.key, "is required but not set")
+		}
+	}
+
+	c.checkJWTSecret(&r)
+	c.checkDurations(&r)
+	c.checkRoster(&r)
+	c.checkRateLimit(&r)
+	c.checkCache(&r)
+	c.checkWebhook(&r)
+	c.checkJobs(&r)
+	c.checkBroker(&r)
+	c.checkMail(&r)
+	c.checkChat(&r)
+	c.checkStorage(&r)
+	c.checkLog(&r)
+	c.checkSentry(&r)
+	c.checkCORS(&r)
+	c.checkTLS(&r)
+	c.checkBodyLimits(&r)
+	c.checkCompression(&r)
+	c.checkDocs(&r)
+	c.checkImport(&r)
+
+	return r
+}
+
+// checkJWTSecret checks the signing algorithm and key rotation settings, and rejects
+// placeholder, short or low-entropy secrets.
+func (c *Config) checkJWTSecret(r *CheckResult) {
+	if !slices.Contains(jwtpkg.Algorithms, c.JWT.Algorithm) {
+		r.addError("JWT_ALGORITHM", "must be one of "+strings.Join(jwtpkg.Algorithms, ", "))
+	}
+	if c.JWT.PreviousSecret != "" && c.JWT.PreviousKeyFile != "" {
+		r.addError("JWT_PREVIOUS_SECRET", "cannot be combined with JWT_PREVIOUS_KEY_FILE; set the one the previous key used")
+	}
+	if c.JWT.HasPreviousKey() && c.JWT.PreviousKeyGrace <= 0 {
+		r.addError("JWT_PREVIOUS_KEY_GRACE_MINUTES", "must be greater than zero")
+	}
+
+	secret := c.JWT.Secret
+	if secret == "" || c.JWT.Asymmetric() {
+		return
+	}
+
+	report := r.addWarning
+	if c.App.Env == "production" {
+		report = r.addError
+	}
+
+	for _, p := range placeholderSecrets {
+		if strings.EqualFold(secret, p) {
+			report("JWT_SECRET", "is a well-known placeholder value; generate one with `openssl rand -hex 32`")
+			return
+		}
+	}
+	if len(secret) < minJWTSecretLength {
+		report("JWT_SECRET", "must be at least 32 characters long")
+		return
+	}
+	if shannonEntropy(secret) < minJWTSecretEntropy {
+		report("JWT_SECRET", "has too little entropy; use a randomly generated value")
+	}
+}
+
+// checkDurations verifies that timeouts and token lifetimes are positive and consistent.
+func (c *Config) checkDurations(r *CheckResult) {
+	timeouts := []struct {
+		key string
+		val time.Duration
+	}{
+		{"SERVER_READ_TIMEOUT_SECONDS", c.Server.ReadTimeout},
+		{"SERVER_WRITE_TIMEOUT_SECONDS", c.Server.WriteTimeout},
+		{"CONFIRMATION_TTL_SECONDS", c.Security.ConfirmationTTL},
+	}
+	for _, t := range timeouts {
+		switch {
+		case t.val <= 0:
+			r.addError(t.key, "must be greater than zero")
+		case t.val > maxSaneTimeout && t.key != "CONFIRMATION_TTL_SECONDS":
+			r.addWarning(t.key, "is unusually large (over 5 minutes)")
+		}
+	}
+
+	if c.JWT.AccessExpiration <= 0 {
+		r.addError("JWT_ACCESS_EXPIRATION_MINUTES", "must be greater than zero")
+	}
+	if c.JWT.RefreshExpiration <= 0 {
+		r.addError("JWT_REFRESH_EXPIRATION_DAYS", "must be greater than zero")
+	} else if c.JWT.RefreshExpiration <= c.JWT.AccessExpiration {
+		r.addError("JWT_REFRESH_EXPIRATION_DAYS", "must be longer than the access token lifetime")
+	}
+
+	switch {
+	case c.Security.MaxLoginAttempts < 0:
+		r.addError("LOGIN_MAX_ATTEMPTS", "must not be negative")
+	case c.Security.MaxLoginAttempts == 0 && c.App.Env == "production":
+		r.addWarning("LOGIN_MAX_ATTEMPTS", "is 0; accounts are never locked after failed logins")
+	case c.Security.MaxLoginAttempts > 0 && c.Security.LockoutDuration <= 0:
+		r.addError("LOGIN_LOCKOUT_MINUTES", "must be greater than zero")
+	}
+
+	if c.Security.TokenCleanupInterval < 0 {
+		r.addError("TOKEN_CLEANUP_INTERVAL_MINUTES", "must not be negative")
+	}
+
+	// bcrypt only hashes the first 72 bytes, the longest password accepted
+	if c.Password.MinLength < 1 || c.Password.MinLength > 72 {
+		r.addError("PASSWORD_MIN_LENGTH", "must be between 1 and 72")
+	} else if c.Password.MinLength < 8 && c.App.Env == "production" {
+		r.addWarning("PASSWORD_MIN_LENGTH", "is below 8; short passwords are easy to guess")
+	}
+	if c.Password.History < 0 {
+		r.addError("PASSWORD_HISTORY", "must not be negative")
+	}
+	if c.Password.MaxAge < 0 {
+		r.addError("PASSWORD_MAX_AGE_DAYS", "must not be negative")
+	}
+	if c.Password.BcryptCost < bcrypt.MinCost || c.Password.BcryptCost > bcrypt.MaxCost {
+		r.addError("BCRYPT_COST", fmt.Sprintf("must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost))
+	}
+
+	if _, err := time.LoadLocation(c.Server.Timezone); err != nil || c.Server.Timezone == "" {
+		r.addError("SERVER_TIMEZONE", "must be an IANA timezone name such as UTC or Asia/Jakarta")
+	}
+
+	if c.Match.ConflictWindow < 0 {
+		r.addError("MATCH_CONFLICT_WINDOW_HOURS", "must not be negative")
+	}
+	if _, err := time.LoadLocation(c.Match.Timezone); err != nil || c.Match.Timezone == "" {
+		r.addError("MATCH_TIMEZONE", "must be an IANA timezone name such as Asia/Jakarta")
+	}
+	if c.Match.YellowCardLimit < 0 {
+		r.addError("MATCH_YELLOW_CARD_LIMIT", "must not be negative")
+	}
+	if c.Match.ResultGrace <= 0 {
+		r.addError("MATCH_RESULT_GRACE_MINUTES", "must be greater than zero")
+	}
+	if c.Match.ResultUndoWindow < 0 {
+		r.addError("MATCH_RESULT_UNDO_MINUTES", "must not be negative")
+	}
+	if c.Match.RatingKFactor <= 0 {
+		r.addError("MATCH_RATING_K_FACTOR", "must be greater than zero")
+	}
+}
+
+// checkRoster verifies the squad regulations are consistent.
+func (c *Config) checkRoster(r *CheckResult) {
+	if c.Roster.MaxSquadSize < 0 {
+		r.addError("ROSTER_MAX_SQUAD_SIZE", "must not be negative")
+	}
+	if c.Roster.MaxForeignPlayers < 0 {
+		r.addError("ROSTER_MAX_FOREIGN_PLAYERS", "must not be negative")
+	}
+	switch {
+	case c.Roster.MinGoalkeepers < 0:
+		r.addError("ROSTER_MIN_GOALKEEPERS", "must not be negative")
+	case c.Roster.MinGoalkeepers > 0 && c.Roster.MaxSquadSize == 0:
+		r.addWarning("ROSTER_MIN_GOALKEEPERS", "is ignored while ROSTER_MAX_SQUAD_SIZE is 0")
+	case c.Roster.MaxSquadSize > 0 && c.Roster.MinGoalkeepers > c.Roster.MaxSquadSize:
+		r.addError("ROSTER_MIN_GOALKEEPERS", "must not exceed ROSTER_MAX_SQUAD_SIZE")
+	}
+	switch {
+	case c.Roster.MinYouthPlayers < 0:
+		r.addError("ROSTER_MIN_YOUTH_PLAYERS", "must not be negative")
+	case c.Roster.MinYouthPlayers > 0 && c.Roster.MaxSquadSize == 0:
+		r.addWarning("ROSTER_MIN_YOUTH_PLAYERS", "is ignored while ROSTER_MAX_SQUAD_SIZE is 0")
+	case c.Roster.MaxSquadSize > 0 && c.Roster.MinGoalkeepers+c.Roster.MinYouthPlayers > c.Roster.MaxSquadSize:
+		r.addError("ROSTER_MIN_YOUTH_PLAYERS", "together with ROSTER_MIN_GOALKEEPERS must not exceed ROSTER_MAX_SQUAD_SIZE")
+	}
+	if c.Roster.MinYouthPlayers > 0 && c.Roster.YouthAge <= 0 {
+		r.addError("ROSTER_YOUTH_AGE", "must be positive while ROSTER_MIN_YOUTH_PLAYERS is set")
+	}
+	if len(c.Roster.HomeNationality) != 2 {
+		r.addError("ROSTER_HOME_NATIONALITY", "must be an ISO 3166-1 alpha-2 country code such as ID")
+	}
+}
+
+// checkRateLimit verifies that enabled rate limits allow at least one request per positive window.
+func (c *Config) checkRateLimit(r *CheckResult) {
+	if !c.RateLimit.Enabled {
+		if c.App.Env == "production" {
+			r.addWarning("RATE_LIMIT_ENABLED", "is false; login attempts are not throttled")
+		}
+		return
+	}
+
+	if c.RateLimit.LoginRequests <= 0 {
+		r.addError("RATE_LIMIT_LOGIN_REQUESTS", "must be greater than zero")
+	}
+	if c.RateLimit.LoginWindow <= 0 {
+		r.addError("RATE_LIMIT_LOGIN_WINDOW_SECONDS", "must be greater than zero")
+	}
+	if c.RateLimit.APIRequests <= 0 {
+		r.addError("RATE_LIMIT_API_REQUESTS", "must be greater than zero")
+	}
+	if c.RateLimit.APIWindow <= 0 {
+		r.addError("RATE_LIMIT_API_WINDOW_SECONDS", "must be greater than zero")
+	}
+	if c.RateLimit.RedisURL != "" {
+		if u, err := url.Parse(c.RateLimit.RedisURL); err != nil || u.Scheme != "redis" || u.Host == "" {
+			r.addError("RATE_LIMIT_REDIS_URL", "must look like redis://[[user]:password@]host:port[/db]")
+		}
+	}
+}
+
+// checkCache verifies the response cache TTL and Redis URL.
+func (c *Config) checkCache(r *CheckResult) {
+	if c.Cache.TTL < 0 {
+		r.addError("CACHE_TTL_SECONDS", "must not be negative")
+	}
+	if c.Cache.RedisURL != "" {
+		if u, err := url.Parse(c.Cache.RedisURL); err != nil || u.Scheme != "redis" || u.Host == "" {
+			r.addError("CACHE_REDIS_URL", "must look like redis://[[user]:password@]host:port[/db]")
+		}
+	}
+}
+
+// checkWebhook verifies the webhook delivery settings.
+func (c *Config) checkWebhook(r *CheckResult) {
+	if c.Webhook.Timeout <= 0 {
+		r.addError("WEBHOOK_TIMEOUT_SECONDS", "must be greater than zero")
+	} else if c.Webhook.Timeout > maxSaneTimeout {
+		r.addWarning("WEBHOOK_TIMEOUT_SECONDS", "is unusually large (over 5 minutes)")
+	}
+	if c.Webhook.MaxAttempts <= 0 {
+		r.addError("WEBHOOK_MAX_ATTEMPTS", "must be greater than zero")
+	}
+	if c.Webhook.PollInterval < 0 {
+		r.addError("WEBHOOK_POLL_INTERVAL_SECONDS", "must not be negative")
+	}
+}
+
+// checkJobs verifies the background job schedules.
+func (c *Config) checkJobs(r *CheckResult) {
+	if c.Jobs.MatchStatusInterval < 0 {
+		r.addError("MATCH_STATUS_INTERVAL_MINUTES", "must not be negative")
+	}
+	if c.Jobs.StandingsWarmInterval < 0 {
+		r.addError("STANDINGS_WARM_INTERVAL_MINUTES", "must not be negative")
+	}
+	if c.Jobs.AuditLogRetention < 0 {
+		r.addError("AUDIT_LOG_RETENTION_DAYS", "must not be negative")
+	}
+	if c.Jobs.AuditLogPruneInterval < 0 {
+		r.addError("AUDIT_LOG_PRUNE_INTERVAL_HOURS", "must not be negative")
+	} else if c.Jobs.AuditLogRetention > 0 && c.Jobs.AuditLogPruneInterval == 0 {
+		r.addWarning("AUDIT_LOG_PRUNE_INTERVAL_HOURS", "is 0; AUDIT_LOG_RETENTION_DAYS is not enforced on this instance")
+	}
+	if c.Jobs.SeasonArchiveAfter < 0 {
+		r.addError("SEASON_ARCHIVE_AFTER_YEARS", "must not be negative")
+	}
+	if c.Jobs.SeasonArchiveInterval < 0 {
+		r.addError("SEASON_ARCHIVE_INTERVAL_HOURS", "must not be negative")
+	} else if c.Jobs.SeasonArchiveAfter > 0 && c.Jobs.SeasonArchiveInterval == 0 {
+		r.addWarning("SEASON_ARCHIVE_INTERVAL_HOURS", "is 0; seasons are not archived on this instance")
+	}
+	if c.Jobs.Timeout <= 0 {
+		r.addError("JOB_TIMEOUT_SECONDS", "must be greater than zero")
+	}
+}
+
+// checkBroker verifies the message broker URL and subject prefix.
+func (c *Config) checkBroker(r *CheckResult) {
+	if c.Broker.URL != "" {
+		if u, err := url.Parse(c.Broker.URL); err != nil || u.Scheme != "nats" || u.Host == "" {
+			r.addError("BROKER_URL", "must look like nats://[user:password@]host:port")
+		}
+	}
+	if c.Broker.SubjectPrefix == "" || strings.ContainsAny(c.Broker.SubjectPrefix, " \t*>") ||
+		strings.HasPrefix(c.Broker.SubjectPrefix, ".") || strings.HasSuffix(c.Broker.SubjectPrefix, ".") {
+		r.addError("BROKER_SUBJECT_PREFIX", "must be a NATS subject such as xyz-football (no spaces, wildcards or leading/trailing dots)")
+	}
+}
+
+// weekdays are the accepted values of NOTIFY_DIGEST_DAY.
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// checkMail verifies the SMTP server, sender and notification recipients.
+func (c *Config) checkMail(r *CheckResult) {
+	if !c.Mail.Enabled() {
+		if len(c.Mail.Recipients) > 0 {
+			r.addWarning("NOTIFY_RECIPIENTS", "is set but SMTP_URL is not; no email is sent")
+		}
+		return
+	}
+
+	if u, err := url.Parse(c.Mail.SMTPURL); err != nil || (u.Scheme != "smtp" && u.Scheme != "smtps") || u.Hostname() == "" {
+		r.addError("SMTP_URL", "must look like smtp[s]://[user:password@]host:port")
+	}
+	if c.Mail.From == "" {
+		r.addError("MAIL_FROM", "is required when SMTP_URL is set")
+	} else if _, err := mail.ParseAddress(c.Mail.From); err != nil {
+		r.addError("MAIL_FROM", "must be an email address such as XYZ Football <noreply@example.com>")
+	}
+	for _, addr := range c.Mail.Recipients {
+		if _, err := mail.ParseAddress(addr); err != nil {
+			r.addError("NOTIFY_RECIPIENTS", "must be a comma-separated list of email addresses")
+			break
+		}
+	}
+	if c.Mail.DigestDay != "" && !slices.Contains(weekdays, c.Mail.DigestDay) {
+		r.addError("NOTIFY_DIGEST_DAY", "must be a weekday name such as monday, or empty to disable the digest")
+	}
+	if c.Mail.DigestHour < 0 || c.Mail.DigestHour > 23 {
+		r.addError("NOTIFY_DIGEST_HOUR", "must be between 0 and 23")
+	}
+}
+
+// checkChat verifies the Slack webhook and the Telegram bot settings when they are set.
+func (c *Config) checkChat(r *CheckResult) {
+	if c.Chat.SlackEnabled() {
+		if u, err := url.Parse(c.Chat.SlackWebhookURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			r.addError("SLACK_WEBHOOK_URL", "must be an https:// incoming webhook URL")
+		}
+	}
+	if c.Chat.TelegramEnabled() && !strings.Contains(c.Chat.TelegramBotToken, ":") {
+		r.addError("TELEGRAM_BOT_TOKEN", "must look like <bot id>:<secret> as issued by @BotFather")
+	}
+	if c.Chat.TelegramEnabled() && c.Chat.TelegramChatID == "" {
+		r.addError("TELEGRAM_CHAT_ID", "is required when TELEGRAM_BOT_TOKEN is set")
+	}
+	if !c.Chat.TelegramEnabled() && c.Chat.TelegramChatID != "" {
+		r.addWarning("TELEGRAM_CHAT_ID", "is set but TELEGRAM_BOT_TOKEN is not; nothing is posted to Telegram")
+	}
+}
+
+// checkStorage verifies the upload directories and the URL public uploads are served from.
+// Attachments must be kept apart from public uploads, which anyone can download.
+func (c *Config) checkStorage(r *CheckResult) {
+	if c.Storage.Dir == "" {
+		r.addError("STORAGE_DIR", "must not be empty")
+	}
+	if c.Storage.AttachmentDir == "" {
+		r.addError("STORAGE_ATTACHMENT_DIR", "must not be empty")
+	} else if c.Storage.Dir != "" {
+		dir, attachments := filepath.Clean(c.Storage.Dir), filepath.Clean(c.Storage.AttachmentDir)
+		if rel, err := filepath.Rel(dir, attachments); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			r.addError("STORAGE_ATTACHMENT_DIR", "must not be inside STORAGE_DIR, whose files are public")
+		}
+	}
+	if c.Storage.ServedByAPI() {
+		if strings.HasPrefix(c.Storage.PublicURL, "/api/") || c.Storage.PublicURL == "/api" {
+			r.addError("STORAGE_PUBLIC_URL", "must not be under /api")
+		}
+		return
+	}
+	if u, err := url.Parse(c.Storage.PublicURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		r.addError("STORAGE_PUBLIC_URL", "must be a path such as /uploads or an http(s):// URL")
+	}
+}
+
+// checkLog verifies the log level and format.
+func (c *Config) checkLog(r *CheckResult) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		r.addError("LOG_LEVEL", "must be one of debug, info, warn or error")
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		r.addError("LOG_FORMAT", "must be json or text")
+	}
+}
+
+// checkSentry verifies the Sentry DSN when one is set.
+func (c *Config) checkSentry(r *CheckResult) {
+	if !c.Sentry.Enabled() {
+		return
+	}
+	u, err := url.Parse(c.Sentry.DSN)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User == nil ||
+		u.User.Username() == "" || strings.Trim(u.Path, "/") == "" {
+		r.addError("SENTRY_DSN", "must look like https://<key>@<host>/<project id>")
+	}
+}
+
+// checkCORS verifies the allowed origins and methods. Wildcard origins are rejected
+// in production, where the API should only be reachable from known front ends.
+func (c *Config) checkCORS(r *CheckResult) {
+	for _, origin := range c.CORS.AllowedOrigins {
+		if strings.Contains(origin, "*") {
+			if c.App.Env == "production" {
+				r.addError("CORS_ALLOWED_ORIGINS", "must not contain wildcards in production; list each front-end origin")
+				break
+			}
+			if origin == "*" || (strings.Count(origin, "*") == 1 && strings.Contains(origin, "://*.")) {
+				continue
+			}
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			r.addError("CORS_ALLOWED_ORIGINS", fmt.Sprintf("has invalid origin %q; use scheme://host[:port], * or https://*.example.com", origin))
+		}
+	}
+
+	for _, method := range c.CORS.AllowedMethods {
+		switch method {
+		case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+		default:
+			r.addError("CORS_ALLOWED_METHODS", fmt.Sprintf("has unknown method %q", method))
+		}
+	}
+	if c.CORS.MaxAge < 0 {
+		r.addError("CORS_MAX_AGE_SECONDS", "must not be negative")
+	}
+}
+
+// checkBodyLimits verifies the request body size limits.
+func (c *Config) checkBodyLimits(r *CheckResult) {
+	if c.Server.MaxBodyBytes <= 0 {
+		r.addError("SERVER_MAX_BODY_BYTES", "must be greater than zero")
+	}
+	if c.Server.MaxUploadBytes <= 0 {
+		r.addError("SERVER_MAX_UPLOAD_BYTES", "must be greater than zero")
+	} else if c.Server.MaxUploadBytes < minUploadBytes {
+		r.addWarning("SERVER_MAX_UPLOAD_BYTES", "is below 2 MB; player import files up to that size will be rejected")
+	}
+}
+
+// checkTLS verifies that HTTPS is configured one way only, with a certificate and its key
+// or with autocert domains, and that the redirect listener does not take the server's port.
+// The key pair itself is loaded by --check-config and at startup.
+func (c *Config) checkTLS(r *CheckResult) {
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		r.addError("TLS_KEY_FILE", "must be set together with TLS_CERT_FILE")
+	}
+	if c.TLS.Autocert() {
+		if c.TLS.CertFile != "" {
+			r.addError("TLS_AUTOCERT_DOMAINS", "cannot be combined with TLS_CERT_FILE; use one or the other")
+		}
+		if c.TLS.AutocertCacheDir == "" {
+			r.addError("TLS_AUTOCERT_CACHE_DIR", "must not be empty, or every restart requests new certificates")
+		}
+		for _, domain := range c.TLS.AutocertDomains {
+			if strings.ContainsAny(domain, "*/:") || !strings.Contains(domain, ".") {
+				r.addError("TLS_AUTOCERT_DOMAINS", fmt.Sprintf("has invalid domain %q; list host names such as api.example.com", domain))
+			}
+		}
+		// Let's Encrypt validates over port 443 (TLS-ALPN-01) or port 80 (HTTP-01)
+		if c.Server.Port != "443" && c.TLS.RedirectPort != "80" {
+			r.addWarning("TLS_AUTOCERT_DOMAINS", "needs Let's Encrypt to reach the server on port 443 or 80; set SERVER_PORT=443 or TLS_REDIRECT_PORT=80 unless a port mapping forwards them")
+		}
+	}
+	if c.TLS.RedirectPort != "" {
+		if !c.TLS.Enabled() {
+			r.addError("TLS_REDIRECT_PORT", "requires TLS_CERT_FILE or TLS_AUTOCERT_DOMAINS")
+		} else if c.TLS.RedirectPort == c.Server.Port {
+			r.addError("TLS_REDIRECT_PORT", "must differ from SERVER_PORT")
+		}
+	}
+}
+
+// checkCompression verifies the gzip level and size threshold.
+func (c *Config) checkCompression(r *CheckResult) {
+	if c.Compression.Level < 0 || c.Compression.Level > 9 {
+		r.addError("COMPRESSION_LEVEL", "must be between 1 and 9, or 0 to disable compression")
+	}
+	if c.Compression.MinBytes < 0 {
+		r.addError("COMPRESSION_MIN_BYTES", "must not be negative")
+	}
+}
+
+// checkDocs verifies the Swagger credentials. Docs switched on in production must be
+// behind them, so the full API spec is not published to anyone who finds the URL.
+func (c *Config) checkDocs(r *CheckResult) {
+	if c.Docs.Protected() && (c.Docs.Username == "" || c.Docs.Password == "") {
+		r.addError("SWAGGER_PASSWORD", "must be set together with SWAGGER_USERNAME")
+	}
+	if c.Docs.Enabled && !c.Docs.Protected() && c.App.Env == "production" {
+		r.addError("SWAGGER_ENABLED", "requires SWAGGER_USERNAME and SWAGGER_PASSWORD in production")
+	}
+}
+
+// checkImport verifies the import settings when a provider to import from is set.
+func (c *Config) checkImport(r *CheckResult) {
+	if !c.Import.Enabled() {
+		return
+	}
+	if !slices.Contains(footballapi.Providers, c.Import.Provider) {
+		r.addError("IMPORT_PROVIDER", "must be one of "+strings.Join(footballapi.Providers, ", ")+", or empty to disable importing")
+	}
+	if c.Import.APIKey == "" {
+		r.addError("IMPORT_API_KEY", "is required when IMPORT_PROVIDER is set")
+	}
+	if c.Import.BaseURL != "" {
+		if u, err := url.Parse(c.Import.BaseURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			r.addError("IMPORT_BASE_URL", "must be an http(s):// URL")
+		}
+	}
+	if c.Import.Competition == "" {
+		r.addError("IMPORT_COMPETITION", "is required when IMPORT_PROVIDER is set")
+	}
+	if c.Import.Season <= 1900 {
+		r.addError("IMPORT_SEASON", "must be the year the season starts in, e.g. 2024")
+	}
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	freq := make(map[rune]int)
+	for _, ch := range s {
+		freq[ch]++
+	}
+	n := float64(len([]rune(s)))
+	var h float64
+	for _, count := range freq {
+		p := float64(count) / n
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// Summary returns a flat, log-friendly view of the configuration with secrets masked.
+func (c *Config) Summary() []any {
+	return []any{
+		"app_name", c.App.Name,
+		"env", c.App.Env,
+		"db_host", c.DB.Host,
+		"db_port", c.DB.Port,
+		"db_user", c.DB.User,
+		"db_password", mask(c.DB.Password),
+		"db_name", c.DB.Name,
+		"db_sslmode", c.DB.SSLMode,
+		"jwt_algorithm", c.JWT.Algorithm,
+		"jwt_secret", mask(c.JWT.Secret),
+		"jwt_private_key_file", c.JWT.PrivateKeyFile,
+		"jwt_key_id", c.JWT.KeyID,
+		"jwt_previous_key", c.JWT.HasPreviousKey(),
+		"jwt_previous_key_grace", c.JWT.PreviousKeyGrace.String(),
+		"jwt_access_expiration", c.JWT.AccessExpiration.String(),
+		"jwt_refresh_expiration", c.JWT.RefreshExpiration.String(),
+		"server_port", c.Server.Port,
+		"server_read_timeout", c.Server.ReadTimeout.String(),
+		"server_write_timeout", c.Server.WriteTimeout.String(),
+		"server_timezone", c.Server.Timezone,
+		"server_strict_query", c.Server.StrictQuery,
+		"confirmation_ttl", c.Security.ConfirmationTTL.String(),
+		"login_max_attempts", c.Security.MaxLoginAttempts,
+		"login_lockout", c.Security.LockoutDuration.String(),
+		"token_cleanup_interval", c.Security.TokenCleanupInterval.String(),
+		"password_min_length", c.Password.MinLength,
+		"password_history", c.Password.History,
+		"password_max_age", c.Password.MaxAge.String(),
+		"bcrypt_cost", c.Password.BcryptCost,
+		"match_conflict_window", c.Match.ConflictWindow.String(),
+		"match_timezone", c.Match.Timezone,
+		"match_yellow_card_limit", c.Match.YellowCardLimit,
+		"match_result_grace", c.Match.ResultGrace.String(),
+		"match_result_undo_window", c.Match.ResultUndoWindow.String(),
+		"match_rating_k_factor", c.Match.RatingKFactor,
+		"roster_max_squad_size", c.Roster.MaxSquadSize,
+		"roster_max_foreign_players", c.Roster.MaxForeignPlayers,
+		"roster_min_goalkeepers", c.Roster.MinGoalkeepers,
+		"roster_min_youth_players", c.Roster.MinYouthPlayers,
+		"roster_youth_age", c.Roster.YouthAge,
+		"roster_home_nationality", c.Roster.HomeNationality,
+		"server_trusted_proxies", c.Server.TrustedProxies,
+		"server_max_body_bytes", c.Server.MaxBodyBytes,
+		"server_max_upload_bytes", c.Server.MaxUploadBytes,
+		"tls_enabled", c.TLS.Enabled(),
+		"tls_autocert_domains", c.TLS.AutocertDomains,
+		"tls_redirect_port", c.TLS.RedirectPort,
+		"rate_limit_enabled", c.RateLimit.Enabled,
+		"rate_limit_login", fmt.Sprintf("%d/%s", c.RateLimit.LoginRequests, c.RateLimit.LoginWindow),
+		"rate_limit_api", fmt.Sprintf("%d/%s", c.RateLimit.APIRequests, c.RateLimit.APIWindow),
+		"rate_limit_backend", c.RateLimit.Backend(),
+		"cache_ttl", c.Cache.TTL.String(),
+		"cache_backend", c.Cache.Backend(),
+		"webhook_timeout", c.Webhook.Timeout.String(),
+		"webhook_max_attempts", c.Webhook.MaxAttempts,
+		"webhook_poll_interval", c.Webhook.PollInterval.String(),
+		"match_status_interval", c.Jobs.MatchStatusInterval.String(),
+		"standings_warm_interval", c.Jobs.StandingsWarmInterval.String(),
+		"audit_log_retention", c.Jobs.AuditLogRetention.String(),
+		"audit_log_prune_interval", c.Jobs.AuditLogPruneInterval.String(),
+		"season_archive_after_years", c.Jobs.SeasonArchiveAfter,
+		"season_archive_interval", c.Jobs.SeasonArchiveInterval.String(),
+		"job_timeout", c.Jobs.Timeout.String(),
+		"broker_backend", c.Broker.Backend(),
+		"broker_subject_prefix", c.Broker.SubjectPrefix,
+		"mail_enabled", c.Mail.Enabled(),
+		"mail_from", c.Mail.From,
+		"notify_recipients", len(c.Mail.Recipients),
+		"notify_digest_day", c.Mail.DigestDay,
+		"notify_digest_hour", c.Mail.DigestHour,
+		"chat_slack_enabled", c.Chat.SlackEnabled(),
+		"chat_telegram_enabled", c.Chat.TelegramEnabled(),
+		"storage_dir", c.Storage.Dir,
+		"storage_public_url", c.Storage.PublicURL,
+		"storage_attachment_dir", c.Storage.AttachmentDir,
+		"log_level", c.Log.Level,
+		"log_format", c.Log.Format,
+		"sentry_enabled", c.Sentry.Enabled(),
+		"cors_allowed_origins", c.CORS.AllowedOrigins,
+		"cors_allowed_methods", c.CORS.AllowedMethods,
+		"cors_max_age", c.CORS.MaxAge.String(),
+		"compression_level", c.Compression.Level,
+		"compression_min_bytes", c.Compression.MinBytes,
+		"maintenance_mode", c.Maintenance.Enabled,
+		"report_organization", c.Report.Organization,
+		"report_signatures", c.Report.Signatures,
+		"swagger_enabled", c.Docs.Enabled,
+		"swagger_protected", c.Docs.Protected(),
+		"import_provider", c.Import.Provider,
+	}
+}
+
+// mask hides a secret value while still revealing whether it is set.
+func mask(s string) string {
+	if s == "" {
+		return "(not set)"
+	}
+	return "********"
+}
@@ -2,17 +2,42 @@ package config
 
 import (
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 )
 
+// defaultCompressionContentTypes are the text formats the API and Swagger UI send.
+const defaultCompressionContentTypes = "application/json,text/plain,text/csv,text/calendar,text/html,text/css,application/javascript"
+
 // Config holds all application configuration values.
 type Config struct {
-	App    AppConfig
-	DB     DBConfig
-	JWT    JWTConfig
-	Server ServerConfig
+	App         AppConfig
+	DB          DBConfig
+	JWT         JWTConfig
+	Server      ServerConfig
+	TLS         TLSConfig
+	Security    SecurityConfig
+	Password    PasswordConfig
+	Match       MatchConfig
+	Roster      RosterConfig
+	RateLimit   RateLimitConfig
+	Cache       CacheConfig
+	Webhook     WebhookConfig
+	Jobs        JobsConfig
+	Broker      BrokerConfig
+	Mail        MailConfig
+	Chat        ChatConfig
+	Storage     StorageConfig
+	Log         LogConfig
+	Sentry      SentryConfig
+	CORS        CORSConfig
+	Compression CompressionConfig
+	Docs        DocsConfig
+	Maintenance MaintenanceConfig
+	Report      ReportConfig
+	Import      ImportConfig
 }
 
 // AppConfig holds general application settings.
@@ -34,21 +59,342 @@ type DBConfig struct {
 
 // JWTConfig holds JWT token settings.
 type JWTConfig struct {
-	Secret            string
+	Algorithm      string // HS256 (Secret), RS256 or EdDSA (PrivateKeyFile)
+	Secret         string
+	PrivateKeyFile string // PEM-encoded RSA or Ed25519 private key
+	KeyID          string // "kid" header of issued tokens; derived from the public key when empty
+	// The key used before a rotation, accepted for PreviousKeyGrace after startup:
+	// a secret for HS256 or a PEM key file (the public key is enough)
+	PreviousSecret    string
+	PreviousKeyFile   string
+	PreviousKeyID     string
+	PreviousKeyGrace  time.Duration
 	AccessExpiration  time.Duration
 	RefreshExpiration time.Duration
 }
 
+// Asymmetric reports whether tokens are signed with a private key rather than the shared secret.
+func (c JWTConfig) Asymmetric() bool {
+	return c.Algorithm != "HS256"
+}
+
+// HasPreviousKey reports whether a key from before a rotation is configured.
+func (c JWTConfig) HasPreviousKey() bool {
+	return c.PreviousSecret != "" || c.PreviousKeyFile != ""
+}
+
 // ServerConfig holds HTTP server settings.
 type ServerConfig struct {
-	Port         string
-	ReadTimeout  time.Duration
-	WriteTimeout time.Duration
+	Port           string
+	ReadTimeout    time.Duration
+	WriteTimeout   time.Duration
+	TrustedProxies []string // Proxies whose X-Forwarded-For header is trusted for the client IP
+	MaxBodyBytes   int64    // Largest accepted JSON request body
+	MaxUploadBytes int64    // Largest accepted multipart body on file upload routes
+	Timezone       string   // IANA zone (e.g. "UTC") response timestamps are written in
+	StrictQuery    bool     // Invalid pagination parameters (per_page=abc, unknown sort_by) return 400 instead of the defaults
+}
+
+// Location returns the response timezone, falling back to UTC if it cannot be loaded.
+func (c *ServerConfig) Location() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// TLSConfig holds how the server terminates HTTPS itself, for deployments without a
+// reverse proxy in front of it. With neither a certificate nor autocert domains it serves plain HTTP.
+type TLSConfig struct {
+	CertFile string // PEM certificate chain
+	KeyFile  string // PEM private key of CertFile
+	// Domains to obtain certificates for from Let's Encrypt, instead of CertFile and KeyFile
+	AutocertDomains  []string
+	AutocertEmail    string // Contact address for expiry and account notices; optional
+	AutocertCacheDir string // Where issued certificates and the account key are kept across restarts
+	// RedirectPort is the port of a plain HTTP listener redirecting every request to HTTPS,
+	// which also answers Let's Encrypt's HTTP-01 challenges; empty runs no such listener.
+	RedirectPort string
+}
+
+// Enabled reports whether the server serves HTTPS.
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.Autocert()
+}
+
+// Autocert reports whether certificates are obtained from Let's Encrypt.
+func (c *TLSConfig) Autocert() bool {
+	return len(c.AutocertDomains) > 0
+}
+
+// SecurityConfig holds settings for safeguards around sensitive operations.
+type SecurityConfig struct {
+	ConfirmationTTL  time.Duration // Lifetime of confirmation tokens for destructive operations
+	MaxLoginAttempts int           // Consecutive failed logins before an account is locked; 0 disables lockout
+	LockoutDuration  time.Duration // How long a locked account stays locked
+	// TokenCleanupInterval is how often expired refresh tokens are purged; zero disables the job.
+	TokenCleanupInterval time.Duration
+}
+
+// PasswordConfig holds the password policy for admin accounts.
+type PasswordConfig struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	History       int           // Previous passwords that cannot be reused; 0 only rejects the current one
+	MaxAge        time.Duration // Age after which a password must be changed; zero disables expiry
+	BcryptCost    int
+}
+
+// MatchConfig holds match scheduling and discipline rules.
+type MatchConfig struct {
+	// ConflictWindow is the minimum time between kick-offs of two matches of the same team on one date.
+	// Zero means a team may play at most one match per date.
+	ConflictWindow time.Duration
+	// Timezone is the IANA zone (e.g. "Asia/Jakarta") that kick-off times without a UTC offset are read in
+	// and that responses report local kick-off times in.
+	Timezone string
+	// YellowCardLimit is the number of accumulated yellow cards that earns a one-match suspension.
+	// Zero disables accumulation; red cards still suspend.
+	YellowCardLimit int
+	// ResultGrace is how long after kick-off a match may go without a result before it is flagged awaiting_result.
+	ResultGrace time.Duration
+	// ResultUndoWindow is how long after submission a super admin can undo a result. Zero disables undoing.
+	ResultUndoWindow time.Duration
+	// RatingKFactor is the most Elo rating points a team can win or lose in one match.
+	RatingKFactor int
+}
+
+// RosterConfig holds the league's squad regulations. Zero disables a limit.
+type RosterConfig struct {
+	MaxSquadSize      int    // Players a team may register
+	MaxForeignPlayers int    // Registered players whose nationality is not HomeNationality
+	MinGoalkeepers    int    // Goalkeepers a full squad must include; needs MaxSquadSize
+	MinYouthPlayers   int    // Players younger than YouthAge a full squad must include; needs MaxSquadSize
+	YouthAge          int    // Age youth players must be under, e.g. 23
+	HomeNationality   string // ISO 3166-1 alpha-2 code of the league's country, e.g. "ID"
+}
+
+// Location returns the match timezone, falling back to UTC if it cannot be loaded.
+func (c *MatchConfig) Location() *time.Location {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// RateLimitConfig holds request rate limits. Login attempts are limited per client IP,
+// every other request per admin (or per client IP when unauthenticated).
+type RateLimitConfig struct {
+	Enabled       bool
+	LoginRequests int
+	LoginWindow   time.Duration
+	APIRequests   int
+	APIWindow     time.Duration
+	RedisURL      string // Shares limits across instances when set; in-memory otherwise
+}
+
+// Backend returns the name of the store holding rate limit state.
+func (c *RateLimitConfig) Backend() string {
+	if c.RedisURL != "" {
+		return "redis"
+	}
+	return "memory"
+}
+
+// CacheConfig holds response caching settings for read-heavy endpoints.
+type CacheConfig struct {
+	TTL      time.Duration // How long cached responses are served; zero disables caching
+	RedisURL string        // Shares the cache across instances when set; in-memory otherwise
+}
+
+// Backend returns the name of the store holding cached responses.
+func (c *CacheConfig) Backend() string {
+	if c.RedisURL != "" {
+		return "redis"
+	}
+	return "memory"
+}
+
+// WebhookConfig holds webhook delivery settings.
+type WebhookConfig struct {
+	Timeout      time.Duration // Time a receiver has to answer one delivery
+	MaxAttempts  int           // Attempts per delivery before it is marked failed
+	PollInterval time.Duration // How often due deliveries are sent; zero disables the dispatcher
+}
+
+// JobsConfig holds the schedules of periodic background jobs not configured elsewhere.
+// A zero interval disables a job.
+type JobsConfig struct {
+	MatchStatusInterval   time.Duration // How often kicked-off matches are moved to live or awaiting_result
+	StandingsWarmInterval time.Duration // How often standings are recomputed into the response cache
+	AuditLogRetention     time.Duration // Age after which audit logs are deleted; zero keeps them forever
+	AuditLogPruneInterval time.Duration // How often audit logs past the retention are deleted
+	SeasonArchiveAfter    int           // Years after a season's end its matches are archived; zero never archives
+	SeasonArchiveInterval time.Duration // How often seasons past SeasonArchiveAfter are archived
+	Timeout               time.Duration // Deadline of a single job run
+}
+
+// BrokerConfig holds the optional message broker that domain events are published to.
+type BrokerConfig struct {
+	URL           string // nats:// URL; events are not published when empty
+	SubjectPrefix string // Prepended to the event type to form the subject, e.g. "xyz-football.match.completed"
+}
+
+// Backend returns the name of the broker events are published to.
+func (c *BrokerConfig) Backend() string {
+	if c.URL != "" {
+		return "nats"
+	}
+	return "none"
+}
+
+// MailConfig holds the optional SMTP server email notifications are sent through.
+type MailConfig struct {
+	SMTPURL    string   // smtp[s]://[user:password@]host:port; no email is sent when empty
+	From       string   // Sender address, e.g. "XYZ Football <noreply@example.com>"
+	Recipients []string // Addresses that receive every notification regardless of admin preferences
+	DigestDay  string   // Weekday the standings digest is sent on (in MATCH_TIMEZONE); empty disables it
+	DigestHour int      // Hour of DigestDay the digest is sent at
+}
+
+// Enabled reports whether email notifications are sent.
+func (c *MailConfig) Enabled() bool {
+	return c.SMTPURL != ""
+}
+
+// ChatConfig holds the optional chat channels result summaries are posted to.
+type ChatConfig struct {
+	SlackWebhookURL  string // Slack incoming webhook; nothing is posted to Slack when empty
+	TelegramBotToken string // Token of the Telegram bot that posts to TelegramChatID
+	TelegramChatID   string // Numeric chat ID or @channel username
 }
 
-// Load reads configuration from .env file and environment variables.
+// SlackEnabled reports whether result summaries are posted to Slack.
+func (c *ChatConfig) SlackEnabled() bool {
+	return c.SlackWebhookURL != ""
+}
+
+// TelegramEnabled reports whether result summaries are posted to Telegram.
+func (c *ChatConfig) TelegramEnabled() bool {
+	return c.TelegramBotToken != ""
+}
+
+// StorageConfig holds where uploaded files such as player photos are kept.
+type StorageConfig struct {
+	Dir           string // Directory public uploads such as player photos are written to
+	PublicURL     string // URL Dir is served from; a path such as /uploads is served by the API itself
+	AttachmentDir string // Directory of match attachments, only downloaded through the API; must not be served
+}
+
+// ServedByAPI reports whether the API serves uploaded files itself rather than a web server or CDN.
+func (c *StorageConfig) ServedByAPI() bool {
+	return strings.HasPrefix(c.PublicURL, "/")
+}
+
+// LogConfig holds log output settings.
+type LogConfig struct {
+	Level  string // debug, info, warn or error
+	Format string // json or text; defaults to text in development and json elsewhere
+}
+
+// SlogLevel returns the parsed log level, falling back to info if it is invalid.
+func (c *LogConfig) SlogLevel() slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// SentryConfig holds the optional Sentry project that unexpected errors are reported to.
+type SentryConfig struct {
+	DSN string // https://<key>@<host>/<project id>; errors are only logged when empty
+}
+
+// Enabled reports whether errors are sent to Sentry.
+func (c *SentryConfig) Enabled() bool {
+	return c.DSN != ""
+}
+
+// CORSConfig holds the cross-origin policy for browser clients.
+type CORSConfig struct {
+	AllowedOrigins []string // Origins such as https://app.example.com; "*" allows any (not in production)
+	AllowedMethods []string
+	AllowedHeaders []string
+	MaxAge         time.Duration // How long browsers may cache a preflight response
+}
+
+// MaintenanceConfig holds the maintenance mode the server starts in. Super admins switch it
+// at runtime through /api/v1/maintenance.
+type MaintenanceConfig struct {
+	Enabled bool
+	Message string // Told to clients while maintenance mode is on
+}
+
+// ReportConfig holds the branding of printable match report documents.
+type ReportConfig struct {
+	Organization string   // Issuer printed in the header of every page
+	Signatures   []string // Who signs a match report, one signature line each
+}
+
+// ImportConfig holds the external football data provider the --import command reads from.
+type ImportConfig struct {
+	Provider    string // One of footballapi.Providers; empty disables importing
+	APIKey      string
+	BaseURL     string // Overrides the provider's API address, e.g. for a proxy
+	Competition string // The provider's code or ID of the competition, e.g. PL or 39
+	Season      int    // Year the imported season starts in
+}
+
+// Enabled reports whether a provider to import from is configured.
+func (c *ImportConfig) Enabled() bool {
+	return c.Provider != ""
+}
+
+// DocsConfig holds who can read the Swagger UI and OpenAPI spec.
+type DocsConfig struct {
+	Enabled  bool   // Defaults to on everywhere except production
+	Username string // With Password, asks for HTTP basic credentials before serving the docs
+	Password string
+}
+
+// Protected reports whether the docs are served behind basic authentication.
+func (c *DocsConfig) Protected() bool {
+	return c.Username != "" || c.Password != ""
+}
+
+// CompressionConfig holds gzip response compression settings.
+type CompressionConfig struct {
+	Level        int      // gzip level from 1 (fastest) to 9 (smallest); 0 disables compression
+	MinBytes     int      // Responses smaller than this are sent uncompressed
+	ContentTypes []string // Media types that are compressed
+}
+
+// Load reads configuration from .env file and environment variables and validates it.
 // Environment variables take precedence over .env file values.
+// Warnings are logged; any error aborts startup.
 func Load() (*Config, error) {
+	cfg := Read()
+
+	result := cfg.Check()
+	for _, w := range result.Warnings {
+		slog.Warn("configuration warning", "field", w.Field, "message", w.Message)
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Read reads configuration from .env file and environment variables without validating it.
+// Use Check to validate the returned configuration.
+func Read() *Config {
 	viper.SetConfigFile(".env")
 	viper.AutomaticEnv()
 
@@ -64,11 +410,73 @@ func Load() (*Config, error) {
 	viper.SetDefault("DB_PORT", "5432")
 	viper.SetDefault("DB_SSLMODE", "disable")
 	viper.SetDefault("DB_TIMEZONE", "UTC")
+	viper.SetDefault("JWT_ALGORITHM", "HS256")
+	viper.SetDefault("JWT_PREVIOUS_KEY_GRACE_MINUTES", 60)
 	viper.SetDefault("JWT_ACCESS_EXPIRATION_MINUTES", 15)
 	viper.SetDefault("JWT_REFRESH_EXPIRATION_DAYS", 7)
 	viper.SetDefault("SERVER_PORT", "8080")
 	viper.SetDefault("SERVER_READ_TIMEOUT_SECONDS", 10)
 	viper.SetDefault("SERVER_WRITE_TIMEOUT_SECONDS", 10)
+	viper.SetDefault("SERVER_MAX_BODY_BYTES", 1<<20)
+	viper.SetDefault("SERVER_MAX_UPLOAD_BYTES", 5<<20)
+	viper.SetDefault("SERVER_TIMEZONE", "UTC")
+	viper.SetDefault("SERVER_STRICT_QUERY", true)
+	viper.SetDefault("TLS_AUTOCERT_CACHE_DIR", "certs")
+	viper.SetDefault("CONFIRMATION_TTL_SECONDS", 120)
+	viper.SetDefault("LOGIN_MAX_ATTEMPTS", 5)
+	viper.SetDefault("LOGIN_LOCKOUT_MINUTES", 15)
+	viper.SetDefault("TOKEN_CLEANUP_INTERVAL_MINUTES", 60)
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 8)
+	viper.SetDefault("PASSWORD_REQUIRE_UPPERCASE", false)
+	viper.SetDefault("PASSWORD_REQUIRE_LOWERCASE", true)
+	viper.SetDefault("PASSWORD_REQUIRE_DIGIT", true)
+	viper.SetDefault("PASSWORD_REQUIRE_SYMBOL", false)
+	viper.SetDefault("PASSWORD_HISTORY", 5)
+	viper.SetDefault("PASSWORD_MAX_AGE_DAYS", 0)
+	viper.SetDefault("BCRYPT_COST", 10)
+	viper.SetDefault("MATCH_CONFLICT_WINDOW_HOURS", 0)
+	viper.SetDefault("MATCH_TIMEZONE", "Asia/Jakarta")
+	viper.SetDefault("MATCH_YELLOW_CARD_LIMIT", 5)
+	viper.SetDefault("MATCH_RESULT_GRACE_MINUTES", 180)
+	viper.SetDefault("MATCH_RESULT_UNDO_MINUTES", 60)
+	viper.SetDefault("MATCH_RATING_K_FACTOR", 20)
+	viper.SetDefault("ROSTER_MAX_SQUAD_SIZE", 0)
+	viper.SetDefault("ROSTER_MAX_FOREIGN_PLAYERS", 0)
+	viper.SetDefault("ROSTER_MIN_GOALKEEPERS", 0)
+	viper.SetDefault("ROSTER_MIN_YOUTH_PLAYERS", 0)
+	viper.SetDefault("ROSTER_YOUTH_AGE", 23)
+	viper.SetDefault("ROSTER_HOME_NATIONALITY", "ID")
+	viper.SetDefault("RATE_LIMIT_ENABLED", true)
+	viper.SetDefault("RATE_LIMIT_LOGIN_REQUESTS", 5)
+	viper.SetDefault("RATE_LIMIT_LOGIN_WINDOW_SECONDS", 60)
+	viper.SetDefault("RATE_LIMIT_API_REQUESTS", 120)
+	viper.SetDefault("RATE_LIMIT_API_WINDOW_SECONDS", 60)
+	viper.SetDefault("CACHE_TTL_SECONDS", 30)
+	viper.SetDefault("WEBHOOK_TIMEOUT_SECONDS", 10)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL_SECONDS", 5)
+	viper.SetDefault("MATCH_STATUS_INTERVAL_MINUTES", 1)
+	viper.SetDefault("STANDINGS_WARM_INTERVAL_MINUTES", 5)
+	viper.SetDefault("AUDIT_LOG_RETENTION_DAYS", 0)
+	viper.SetDefault("AUDIT_LOG_PRUNE_INTERVAL_HOURS", 24)
+	viper.SetDefault("SEASON_ARCHIVE_AFTER_YEARS", 0)
+	viper.SetDefault("SEASON_ARCHIVE_INTERVAL_HOURS", 24)
+	viper.SetDefault("JOB_TIMEOUT_SECONDS", 300)
+	viper.SetDefault("BROKER_SUBJECT_PREFIX", "xyz-football")
+	viper.SetDefault("NOTIFY_DIGEST_DAY", "monday")
+	viper.SetDefault("NOTIFY_DIGEST_HOUR", 8)
+	viper.SetDefault("STORAGE_DIR", "uploads")
+	viper.SetDefault("STORAGE_PUBLIC_URL", "/uploads")
+	viper.SetDefault("STORAGE_ATTACHMENT_DIR", "attachments")
+	viper.SetDefault("LOG_LEVEL", "info")
+	viper.SetDefault("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
+	viper.SetDefault("CORS_ALLOWED_HEADERS", "Origin,Content-Type,Accept,Authorization,X-Requested-With,X-Request-ID,X-API-Key,If-Match,If-None-Match")
+	viper.SetDefault("CORS_MAX_AGE_SECONDS", 43200)
+	viper.SetDefault("COMPRESSION_LEVEL", 6)
+	viper.SetDefault("COMPRESSION_MIN_BYTES", 1024)
+	viper.SetDefault("COMPRESSION_CONTENT_TYPES", defaultCompressionContentTypes)
+	viper.SetDefault("REPORT_ORGANIZATION", "XYZ Football")
+	viper.SetDefault("REPORT_SIGNATURES", "Referee,Match Commissioner,Home Team Manager,Away Team Manager")
 
 	cfg := &Config{
 		App: AppConfig{
@@ -85,22 +493,184 @@ func Load() (*Config, error) {
 			TimeZone: viper.GetString("DB_TIMEZONE"),
 		},
 		JWT: JWTConfig{
+			Algorithm:         viper.GetString("JWT_ALGORITHM"),
 			Secret:            viper.GetString("JWT_SECRET"),
+			PrivateKeyFile:    viper.GetString("JWT_PRIVATE_KEY_FILE"),
+			KeyID:             viper.GetString("JWT_KEY_ID"),
+			PreviousSecret:    viper.GetString("JWT_PREVIOUS_SECRET"),
+			PreviousKeyFile:   viper.GetString("JWT_PREVIOUS_KEY_FILE"),
+			PreviousKeyID:     viper.GetString("JWT_PREVIOUS_KEY_ID"),
+			PreviousKeyGrace:  time.Duration(viper.GetInt("JWT_PREVIOUS_KEY_GRACE_MINUTES")) * time.Minute,
 			AccessExpiration:  time.Duration(viper.GetInt("JWT_ACCESS_EXPIRATION_MINUTES")) * time.Minute,
 			RefreshExpiration: time.Duration(viper.GetInt("JWT_REFRESH_EXPIRATION_DAYS")) * 24 * time.Hour,
 		},
 		Server: ServerConfig{
-			Port:         viper.GetString("SERVER_PORT"),
-			ReadTimeout:  time.Duration(viper.GetInt("SERVER_READ_TIMEOUT_SECONDS")) * time.Second,
-			WriteTimeout: time.Duration(viper.GetInt("SERVER_WRITE_TIMEOUT_SECONDS")) * time.Second,
+			Port:           viper.GetString("SERVER_PORT"),
+			ReadTimeout:    time.Duration(viper.GetInt("SERVER_READ_TIMEOUT_SECONDS")) * time.Second,
+			WriteTimeout:   time.Duration(viper.GetInt("SERVER_WRITE_TIMEOUT_SECONDS")) * time.Second,
+			TrustedProxies: splitList(viper.GetString("SERVER_TRUSTED_PROXIES")),
+			MaxBodyBytes:   viper.GetInt64("SERVER_MAX_BODY_BYTES"),
+			MaxUploadBytes: viper.GetInt64("SERVER_MAX_UPLOAD_BYTES"),
+			Timezone:       viper.GetString("SERVER_TIMEZONE"),
+			StrictQuery:    viper.GetBool("SERVER_STRICT_QUERY"),
+		},
+		TLS: TLSConfig{
+			CertFile:         viper.GetString("TLS_CERT_FILE"),
+			KeyFile:          viper.GetString("TLS_KEY_FILE"),
+			AutocertDomains:  splitList(strings.ToLower(viper.GetString("TLS_AUTOCERT_DOMAINS"))),
+			AutocertEmail:    viper.GetString("TLS_AUTOCERT_EMAIL"),
+			AutocertCacheDir: viper.GetString("TLS_AUTOCERT_CACHE_DIR"),
+			RedirectPort:     viper.GetString("TLS_REDIRECT_PORT"),
+		},
+		Security: SecurityConfig{
+			ConfirmationTTL:      time.Duration(viper.GetInt("CONFIRMATION_TTL_SECONDS")) * time.Second,
+			MaxLoginAttempts:     viper.GetInt("LOGIN_MAX_ATTEMPTS"),
+			LockoutDuration:      time.Duration(viper.GetInt("LOGIN_LOCKOUT_MINUTES")) * time.Minute,
+			TokenCleanupInterval: time.Duration(viper.GetInt("TOKEN_CLEANUP_INTERVAL_MINUTES")) * time.Minute,
+		},
+		Password: PasswordConfig{
+			MinLength:     viper.GetInt("PASSWORD_MIN_LENGTH"),
+			RequireUpper:  viper.GetBool("PASSWORD_REQUIRE_UPPERCASE"),
+			RequireLower:  viper.GetBool("PASSWORD_REQUIRE_LOWERCASE"),
+			RequireDigit:  viper.GetBool("PASSWORD_REQUIRE_DIGIT"),
+			RequireSymbol: viper.GetBool("PASSWORD_REQUIRE_SYMBOL"),
+			History:       viper.GetInt("PASSWORD_HISTORY"),
+			MaxAge:        time.Duration(viper.GetInt("PASSWORD_MAX_AGE_DAYS")) * 24 * time.Hour,
+			BcryptCost:    viper.GetInt("BCRYPT_COST"),
+		},
+		Match: MatchConfig{
+			ConflictWindow:   time.Duration(viper.GetInt("MATCH_CONFLICT_WINDOW_HOURS")) * time.Hour,
+			Timezone:         viper.GetString("MATCH_TIMEZONE"),
+			YellowCardLimit:  viper.GetInt("MATCH_YELLOW_CARD_LIMIT"),
+			ResultGrace:      time.Duration(viper.GetInt("MATCH_RESULT_GRACE_MINUTES")) * time.Minute,
+			ResultUndoWindow: time.Duration(viper.GetInt("MATCH_RESULT_UNDO_MINUTES")) * time.Minute,
+			RatingKFactor:    viper.GetInt("MATCH_RATING_K_FACTOR"),
+		},
+		Roster: RosterConfig{
+			MaxSquadSize:      viper.GetInt("ROSTER_MAX_SQUAD_SIZE"),
+			MaxForeignPlayers: viper.GetInt("ROSTER_MAX_FOREIGN_PLAYERS"),
+			MinGoalkeepers:    viper.GetInt("ROSTER_MIN_GOALKEEPERS"),
+			MinYouthPlayers:   viper.GetInt("ROSTER_MIN_YOUTH_PLAYERS"),
+			YouthAge:          viper.GetInt("ROSTER_YOUTH_AGE"),
+			HomeNationality:   strings.ToUpper(viper.GetString("ROSTER_HOME_NATIONALITY")),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:       viper.GetBool("RATE_LIMIT_ENABLED"),
+			LoginRequests: viper.GetInt("RATE_LIMIT_LOGIN_REQUESTS"),
+			LoginWindow:   time.Duration(viper.GetInt("RATE_LIMIT_LOGIN_WINDOW_SECONDS")) * time.Second,
+			APIRequests:   viper.GetInt("RATE_LIMIT_API_REQUESTS"),
+			APIWindow:     time.Duration(viper.GetInt("RATE_LIMIT_API_WINDOW_SECONDS")) * time.Second,
+			RedisURL:      viper.GetString("RATE_LIMIT_REDIS_URL"),
+		},
+		Cache: CacheConfig{
+			TTL:      time.Duration(viper.GetInt("CACHE_TTL_SECONDS")) * time.Second,
+			RedisURL: viper.GetString("CACHE_REDIS_URL"),
+		},
+		Webhook: WebhookConfig{
+			Timeout:      time.Duration(viper.GetInt("WEBHOOK_TIMEOUT_SECONDS")) * time.Second,
+			MaxAttempts:  viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+			PollInterval: time.Duration(viper.GetInt("WEBHOOK_POLL_INTERVAL_SECONDS")) * time.Second,
+		},
+		Jobs: JobsConfig{
+			MatchStatusInterval:   time.Duration(viper.GetInt("MATCH_STATUS_INTERVAL_MINUTES")) * time.Minute,
+			StandingsWarmInterval: time.Duration(viper.GetInt("STANDINGS_WARM_INTERVAL_MINUTES")) * time.Minute,
+			AuditLogRetention:     time.Duration(viper.GetInt("AUDIT_LOG_RETENTION_DAYS")) * 24 * time.Hour,
+			AuditLogPruneInterval: time.Duration(viper.GetInt("AUDIT_LOG_PRUNE_INTERVAL_HOURS")) * time.Hour,
+			SeasonArchiveAfter:    viper.GetInt("SEASON_ARCHIVE_AFTER_YEARS"),
+			SeasonArchiveInterval: time.Duration(viper.GetInt("SEASON_ARCHIVE_INTERVAL_HOURS")) * time.Hour,
+			Timeout:               time.Duration(viper.GetInt("JOB_TIMEOUT_SECONDS")) * time.Second,
+		},
+		Broker: BrokerConfig{
+			URL:           viper.GetString("BROKER_URL"),
+			SubjectPrefix: viper.GetString("BROKER_SUBJECT_PREFIX"),
+		},
+		Mail: MailConfig{
+			SMTPURL:    viper.GetString("SMTP_URL"),
+			From:       viper.GetString("MAIL_FROM"),
+			Recipients: splitList(viper.GetString("NOTIFY_RECIPIENTS")),
+			DigestDay:  strings.ToLower(viper.GetString("NOTIFY_DIGEST_DAY")),
+			DigestHour: viper.GetInt("NOTIFY_DIGEST_HOUR"),
+		},
+		Chat: ChatConfig{
+			SlackWebhookURL:  viper.GetString("SLACK_WEBHOOK_URL"),
+			TelegramBotToken: viper.GetString("TELEGRAM_BOT_TOKEN"),
+			TelegramChatID:   viper.GetString("TELEGRAM_CHAT_ID"),
+		},
+		Storage: StorageConfig{
+			Dir:           viper.GetString("STORAGE_DIR"),
+			PublicURL:     strings.TrimSuffix(viper.GetString("STORAGE_PUBLIC_URL"), "/"),
+			AttachmentDir: viper.GetString("STORAGE_ATTACHMENT_DIR"),
+		},
+		Log: LogConfig{
+			Level:  strings.ToLower(viper.GetString("LOG_LEVEL")),
+			Format: strings.ToLower(viper.GetString("LOG_FORMAT")),
+		},
+		Sentry: SentryConfig{
+			DSN: viper.GetString("SENTRY_DSN"),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: splitList(viper.GetString("CORS_ALLOWED_ORIGINS")),
+			AllowedMethods: splitList(strings.ToUpper(viper.GetString("CORS_ALLOWED_METHODS"))),
+			AllowedHeaders: splitList(viper.GetString("CORS_ALLOWED_HEADERS")),
+			MaxAge:         time.Duration(viper.GetInt("CORS_MAX_AGE_SECONDS")) * time.Second,
+		},
+		Compression: CompressionConfig{
+			Level:        viper.GetInt("COMPRESSION_LEVEL"),
+			MinBytes:     viper.GetInt("COMPRESSION_MIN_BYTES"),
+			ContentTypes: splitList(strings.ToLower(viper.GetString("COMPRESSION_CONTENT_TYPES"))),
+		},
+		Maintenance: MaintenanceConfig{
+			Enabled: viper.GetBool("MAINTENANCE_MODE"),
+			Message: viper.GetString("MAINTENANCE_MESSAGE"),
+		},
+		Report: ReportConfig{
+			Organization: viper.GetString("REPORT_ORGANIZATION"),
+			Signatures:   splitList(viper.GetString("REPORT_SIGNATURES")),
+		},
+		Import: ImportConfig{
+			Provider:    strings.ToLower(viper.GetString("IMPORT_PROVIDER")),
+			APIKey:      viper.GetString("IMPORT_API_KEY"),
+			BaseURL:     viper.GetString("IMPORT_BASE_URL"),
+			Competition: viper.GetString("IMPORT_COMPETITION"),
+			Season:      viper.GetInt("IMPORT_SEASON"),
+		},
+		Docs: DocsConfig{
+			Enabled:  viper.GetBool("SWAGGER_ENABLED"),
+			Username: viper.GetString("SWAGGER_USERNAME"),
+			Password: viper.GetString("SWAGGER_PASSWORD"),
 		},
 	}
-
-	if err := cfg.validate(); err != nil {
-		return nil, err
+	if viper.GetString("SWAGGER_ENABLED") == "" {
+		// The API spec is kept private in production unless it is switched on explicitly
+		cfg.Docs.Enabled = cfg.App.Env != "production"
+	}
+	if len(cfg.CORS.AllowedOrigins) == 0 && cfg.App.Env == "development" {
+		// Any origin may call a local instance; elsewhere browsers are only let in from listed origins
+		cfg.CORS.AllowedOrigins = []string{"*"}
+	}
+	if len(cfg.Compression.ContentTypes) == 0 {
+		cfg.Compression.ContentTypes = splitList(defaultCompressionContentTypes)
+	}
+	if cfg.Log.Format == "" {
+		// Readable locally, one JSON object per line for log shippers everywhere else
+		cfg.Log.Format = "json"
+		if cfg.App.Env == "development" {
+			cfg.Log.Format = "text"
+		}
 	}
 
-	return cfg, nil
+	return cfg
+}
+
+// splitList splits a comma-separated value, dropping empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // DSN returns the PostgreSQL connection string.
@@ -114,24 +684,6 @@ func (c *DBConfig) DSN() string {
 		" TimeZone=" + c.TimeZone
 }
 
-// validate checks that all required configuration values are present.
-func (c *Config) validate() error {
-	required := map[string]string{
-		"DB_USER":     c.DB.User,
-		"DB_PASSWORD": c.DB.Password,
-		"DB_NAME":     c.DB.Name,
-		"JWT_SECRET":  c.JWT.Secret,
-	}
-
-	for key, val := range required {
-		if val == "" {
-			return &ConfigError{Field: key, Message: "is required but not set"}
-		}
-	}
-
-	return nil
-}
-
 // ConfigError represents a configuration validation error.
 type ConfigError struct {
 	Field   string
@@ -0,0 +1,29 @@
+package dto
+
+// CreateAbsenceRequest represents the request payload for recording a player's injury or suspension.
+type CreateAbsenceRequest struct {
+	Type      string `json:"type" binding:"required,oneof=injury suspension" example:"injury"`
+	Reason    string `json:"reason" binding:"omitempty,max=500" example:"Hamstring strain"`
+	StartDate string `json:"start_date" binding:"required,date" example:"2025-06-10"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"omitempty,date" example:"2025-06-30"`  // YYYY-MM-DD, inclusive; empty while the return date is unknown
+}
+
+// UpdateAbsenceRequest represents the request payload for updating an absence.
+type UpdateAbsenceRequest struct {
+	Type      string `json:"type" binding:"required,oneof=injury suspension" example:"injury"`
+	Reason    string `json:"reason" binding:"omitempty,max=500" example:"Hamstring strain"`
+	StartDate string `json:"start_date" binding:"required,date" example:"2025-06-10"`
+	EndDate   string `json:"end_date" binding:"omitempty,date" example:"2025-06-30"`
+}
+
+// AbsenceResponse represents the absence data returned in API responses.
+type AbsenceResponse struct {
+	ID        string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000007"`
+	PlayerID  string `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Type      string `json:"type" example:"injury"`
+	Reason    string `json:"reason,omitempty" example:"Hamstring strain"`
+	StartDate string `json:"start_date" example:"2025-06-10"`
+	EndDate   string `json:"end_date,omitempty" example:"2025-06-30"`
+	CreatedAt string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt string `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+// CreateAdminRequest represents the request payload for creating an admin account.
+// TeamIDs are the teams a team_manager may manage; other roles cannot have teams.
+// The password must also follow the configured password policy.
+type CreateAdminRequest struct {
+	Username string   `json:"username" binding:"required,min=3,max=50" example:"editor1"`
+	Password string   `json:"password" binding:"required,max=72" example:"s3cure-passw0rd"`
+	Role     string   `json:"role" binding:"required,oneof=super_admin editor team_manager viewer" example:"editor"`
+	TeamIDs  []string `json:"team_ids" binding:"omitempty,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+}
+
+// UpdateAdminRequest represents the request payload for updating an admin account.
+// Password is optional; when set it replaces the current password.
+// TeamIDs replace the teams a team_manager may manage.
+type UpdateAdminRequest struct {
+	Username string   `json:"username" binding:"required,min=3,max=50" example:"editor1"`
+	Password string   `json:"password" binding:"omitempty,max=72" example:"n3w-s3cure-passw0rd"`
+	Role     string   `json:"role" binding:"required,oneof=super_admin editor team_manager viewer" example:"editor"`
+	TeamIDs  []string `json:"team_ids" binding:"omitempty,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+}
+
+// ChangePasswordRequest represents the request payload for the self-service password change.
+// The new password must follow the password policy and differ from the recent ones.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" example:"password123"`
+	NewPassword     string `json:"new_password" binding:"required,max=72" example:"n3w-s3cure-passw0rd"`
+}
+
+// AdminDetailResponse represents an admin account returned by the admin management endpoints.
+// TeamIDs lists the teams a team_manager manages and is empty for other roles.
+type AdminDetailResponse struct {
+	ID       string   `json:"id" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	Username string   `json:"username" example:"editor1"`
+	Role     string   `json:"role" example:"editor"`
+	TeamIDs  []string `json:"team_ids" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	// MustChangePassword is set until the admin changes a seeded or expired password
+	MustChangePassword bool   `json:"must_change_password" example:"false"`
+	CreatedAt          string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt          string `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// CreateAPIKeyRequest represents the request payload for issuing an API key.
+// ExpiresInDays is optional; keys without it stay valid until revoked.
+type CreateAPIKeyRequest struct {
+	Name          string   `json:"name" binding:"required,max=100" example:"Stadium scoreboard"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,oneof=teams:read matches:read competitions:read reports:read" example:"matches:read,reports:read"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,gt=0,lte=3650" example:"365"`
+}
+
+// APIKeyResponse represents an issued API key. The key itself is never returned after creation.
+type APIKeyResponse struct {
+	ID         string   `json:"id" example:"019292f0-6b00-7a50-8d00-000000006000"`
+	Name       string   `json:"name" example:"Stadium scoreboard"`
+	Prefix     string   `json:"prefix" example:"xyz_3f9a1c2b"`
+	Scopes     []string `json:"scopes" example:"matches:read,reports:read"`
+	CreatedBy  string   `json:"created_by" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	Active     bool     `json:"active" example:"true"`
+	ExpiresAt  string   `json:"expires_at,omitempty" example:"2026-01-15T10:30:00.000Z"`
+	LastUsedAt string   `json:"last_used_at,omitempty" example:"2025-01-20T08:00:00.000Z"`
+	RevokedAt  string   `json:"revoked_at,omitempty" example:"2025-02-01T09:00:00.000Z"`
+	CreatedAt  string   `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// APIKeyCreatedResponse is returned once when a key is issued; Key must be stored by the client.
+type APIKeyCreatedResponse struct {
+	Key    string         `json:"key" example:"xyz_3f9a1c2b4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8"`
+	APIKey APIKeyResponse `json:"api_key"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// MatchAttachmentResponse represents a document filed with a match.
+type MatchAttachmentResponse struct {
+	ID          string `json:"id" example:"019292f0-6b00-7a50-8d00-000000002000"`
+	MatchID     string `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	FileName    string `json:"file_name" example:"referee-report.pdf"`
+	ContentType string `json:"content_type" example:"application/pdf"`
+	Size        int64  `json:"size" example:"184320"` // In bytes
+	UploadedBy  string `json:"uploaded_by,omitempty" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	DownloadURL string `json:"download_url" example:"/api/v1/matches/019292f0-6b00-7a50-8d00-000000001000/attachments/019292f0-6b00-7a50-8d00-000000002000"`
+	CreatedAt   string `json:"created_at" example:"2025-06-15T14:30:00.000Z"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "encoding/json"
+
+// AuditLogFilterQuery holds the optional filters accepted by the audit log listing.
+// From and To are dates (YYYY-MM-DD); both are inclusive.
+type AuditLogFilterQuery struct {
+	AdminID  string `form:"admin_id" binding:"omitempty,uuid"`
+	Entity   string `form:"entity" binding:"omitempty,oneof=team player match competition season group stadium referee coach absence"`
+	EntityID string `form:"entity_id" binding:"omitempty,uuid"`
+	Action   string `form:"action" binding:"omitempty,oneof=create update delete result_submit result_update result_undo status_change lineup_set officials_set attachment_add attachment_delete transfer"`
+	From     string `form:"from" binding:"omitempty,date"`
+	To       string `form:"to" binding:"omitempty,date"`
+}
+
+// AuditLogResponse represents a single audit log entry.
+// Before and After are snapshots of the entity's columns; Changes maps each changed column to {"before", "after"}.
+type AuditLogResponse struct {
+	ID        string          `json:"id" example:"019292f0-6b00-7a50-8d00-000000005000"`
+	AdminID   string          `json:"admin_id" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	Entity    string          `json:"entity" example:"team"`
+	EntityID  string          `json:"entity_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Action    string          `json:"action" example:"update"`
+	Before    json.RawMessage `json:"before" swaggertype:"object"`
+	After     json.RawMessage `json:"after" swaggertype:"object"`
+	Changes   json.RawMessage `json:"changes" swaggertype:"object"`
+	CreatedAt string          `json:"created_at" example:"2025-01-01T00:00:00.000Z"`
+}
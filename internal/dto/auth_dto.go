@@ -28,4 +28,8 @@ type RefreshResponse struct {
 type AdminResponse struct {
 	ID       string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000001"`
 	Username string `json:"username" example:"admin"`
+	Role     string `json:"role" example:"super_admin"`
+	// MustChangePassword means every request other than changing the password or logging
+	// out is refused until the password is changed
+	MustChangePassword bool `json:"must_change_password" example:"false"`
 }
@@ -0,0 +1,29 @@
+package dto
+
+// CreateCoachRequest represents the request payload for adding a coach to a team's staff.
+type CreateCoachRequest struct {
+	Name          string `json:"name" binding:"required" example:"Carlos Pena"`
+	Role          string `json:"role" binding:"required,oneof=head_coach assistant_coach goalkeeper_coach fitness_coach analyst" example:"head_coach"`
+	ContractStart string `json:"contract_start" binding:"omitempty,date" example:"2025-06-01"` // YYYY-MM-DD
+	ContractEnd   string `json:"contract_end" binding:"omitempty,date" example:"2027-05-31"`   // YYYY-MM-DD
+}
+
+// UpdateCoachRequest represents the request payload for updating a coach.
+type UpdateCoachRequest struct {
+	Name          string `json:"name" binding:"required" example:"Carlos Pena"`
+	Role          string `json:"role" binding:"required,oneof=head_coach assistant_coach goalkeeper_coach fitness_coach analyst" example:"head_coach"`
+	ContractStart string `json:"contract_start" binding:"omitempty,date" example:"2025-06-01"`
+	ContractEnd   string `json:"contract_end" binding:"omitempty,date" example:"2027-05-31"`
+}
+
+// CoachResponse represents the coach data returned in API responses.
+type CoachResponse struct {
+	ID            string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000006"`
+	TeamID        string `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Name          string `json:"name" example:"Carlos Pena"`
+	Role          string `json:"role" example:"head_coach"`
+	ContractStart string `json:"contract_start,omitempty" example:"2025-06-01"`
+	ContractEnd   string `json:"contract_end,omitempty" example:"2027-05-31"`
+	CreatedAt     string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt     string `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+// CreateConfirmationRequest represents the request payload for requesting a confirmation token.
+type CreateConfirmationRequest struct {
+	Action     string `json:"action" binding:"required,oneof=team.delete player.delete match.delete result.delete competition.delete season.delete group.delete stadium.delete referee.delete coach.delete absence.delete admin.delete attachment.delete" example:"team.delete"`
+	ResourceID string `json:"resource_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+}
+
+// ConfirmationResponse represents an issued confirmation token.
+// The token must be sent in the X-Confirmation-Token header of the destructive request.
+type ConfirmationResponse struct {
+	Token      string `json:"token" example:"6f1c2a9e4b7d8c0f3e5a1b2c4d6e8f0a6f1c2a9e4b7d8c0f3e5a1b2c4d6e8f0a"`
+	Action     string `json:"action" example:"team.delete"`
+	ResourceID string `json:"resource_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	ExpiresAt  string `json:"expires_at" example:"2025-01-15T10:32:00.000Z"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// SuspensionResponse represents a player who may not take part in a match because of cards
+// received in the team's earlier matches.
+type SuspensionResponse struct {
+	PlayerID         string `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Name             string `json:"name" example:"Marko Simic"`
+	TeamID           string `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Reason           string `json:"reason" example:"red card"`
+	MatchesRemaining int    `json:"matches_remaining" example:"1"` // Including this match
+}
+
+// MatchSuspensionsResponse lists the suspended players of both teams for a match.
+type MatchSuspensionsResponse struct {
+	MatchID     string               `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000000200"`
+	Suspensions []SuspensionResponse `json:"suspensions"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+// EventPayload is the JSON envelope of a domain event, as POSTed to webhooks and published
+// to the message broker. ID identifies the event: a webhook delivery keeps it across retries,
+// so receivers can use it to ignore duplicates.
+type EventPayload struct {
+	ID        string `json:"id" example:"019292f0-6b00-7a50-8d00-000000009000"`
+	Type      string `json:"type" example:"match.completed"`
+	CreatedAt string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	Data      any    `json:"data"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+
+// Component health states.
+const (
+	HealthStatusUp       = "up"
+	HealthStatusDown     = "down"
+	HealthStatusDisabled = "disabled"
+)
+
+// ComponentHealth represents the status of a single dependency or subsystem.
+type ComponentHealth struct {
+	Status    string         `json:"status" example:"up"` // up, down, disabled
+	LatencyMS float64        `json:"latency_ms,omitempty" example:"1.42"`
+	Message   string         `json:"message,omitempty" example:"connection refused"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// HealthDetailsResponse represents the detailed health report for operators.
+type HealthDetailsResponse struct {
+	Status        string                     `json:"status" example:"ok"` // ok, degraded
+	Build         buildinfo.Info             `json:"build"`
+	StartedAt     string                     `json:"started_at" example:"2025-01-15T10:30:00.000Z"`
+	UptimeSeconds int64                      `json:"uptime_seconds" example:"86400"`
+	Components    map[string]ComponentHealth `json:"components"`
+}
+
+// Readiness states.
+const (
+	ReadinessReady    = "ready"
+	ReadinessNotReady = "not_ready"
+	// ReadinessMaintenance is reported in maintenance mode, when the database is not checked
+	ReadinessMaintenance = "maintenance"
+)
+
+// ReadinessResponse tells load balancers whether the instance can serve traffic.
+type ReadinessResponse struct {
+	Status     string                     `json:"status" example:"ready"` // ready, not_ready, maintenance
+	Components map[string]ComponentHealth `json:"components"`
+	Build      buildinfo.Info             `json:"build"` // Tells instances behind the load balancer apart during a rollout
+}
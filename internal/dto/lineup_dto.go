@@ -0,0 +1,32 @@
+package dto
+
+// LineupRequest represents the request payload for recording one team's lineup for a match.
+// Submitting a lineup for a team replaces any lineup previously recorded for that team.
+type LineupRequest struct {
+	TeamID      string   `json:"team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Starters    []string `json:"starters" binding:"required,min=1,max=11,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Substitutes []string `json:"substitutes" binding:"omitempty,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000101"`
+}
+
+// LineupPlayerResponse represents a player listed in a lineup.
+type LineupPlayerResponse struct {
+	PlayerID     string `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Name         string `json:"name" example:"Marko Simic"`
+	Position     string `json:"position" example:"penyerang"`
+	JerseyNumber int    `json:"jersey_number" example:"9"`
+}
+
+// TeamLineupResponse represents one team's starting XI and substitutes.
+type TeamLineupResponse struct {
+	TeamID      string                 `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Starters    []LineupPlayerResponse `json:"starters"`
+	Substitutes []LineupPlayerResponse `json:"substitutes"`
+}
+
+// MatchLineupResponse represents the lineups of both teams for a match.
+// A team's lineup is null until it has been recorded.
+type MatchLineupResponse struct {
+	MatchID  string              `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeTeam *TeamLineupResponse `json:"home_team"`
+	AwayTeam *TeamLineupResponse `json:"away_team"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// MaintenanceRequest represents the request payload for switching maintenance mode on.
+type MaintenanceRequest struct {
+	Message         string `json:"message" binding:"omitempty,max=500" example:"Database upgrade in progress"`
+	DurationMinutes int    `json:"duration_minutes" binding:"omitempty,min=1,max=1440" example:"30"` // Expected length, for Retry-After; unknown if omitted
+}
+
+// MaintenanceResponse represents the maintenance mode state. It is also the data of the
+// 503 responses sent while maintenance mode is on.
+type MaintenanceResponse struct {
+	Enabled           bool   `json:"enabled" example:"true"`
+	Message           string `json:"message,omitempty" example:"Database upgrade in progress"`
+	StartedAt         string `json:"started_at,omitempty" example:"2025-01-15T22:00:00.000Z"`
+	EndsAt            string `json:"ends_at,omitempty" example:"2025-01-15T22:30:00.000Z"` // Expected end, if known
+	RetryAfterSeconds int    `json:"retry_after_seconds,omitempty" example:"1800"`         // Until EndsAt, while it is in the future
+}
@@ -1,58 +1,291 @@
 package dto
 
+import "encoding/json"
+
 // CreateMatchRequest represents the request payload for creating a match schedule.
+// MatchDatetime is an ISO 8601 kick-off time; without a UTC offset it is read in the configured match timezone.
+// Without a venue_id the match is played at the home team's stadium, if it has one.
+// A round (matchweek) needs a season_id, and each team plays at most one match per round.
 type CreateMatchRequest struct {
-	HomeTeamID string `json:"home_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	AwayTeamID string `json:"away_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000020"`
-	MatchDate  string `json:"match_date" binding:"required" example:"2025-06-15"` // YYYY-MM-DD
-	MatchTime  string `json:"match_time" binding:"required" example:"19:30"`      // HH:MM
+	HomeTeamID    string `json:"home_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	AwayTeamID    string `json:"away_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	MatchDatetime string `json:"match_datetime" binding:"required,matchdatetime" example:"2025-06-15T19:30:00+07:00"`
+	SeasonID      string `json:"season_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	VenueID       string `json:"venue_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	Round         int    `json:"round" binding:"omitempty,min=1,max=100" example:"12"`
+}
+
+// MatchFilterQuery holds the optional filters accepted by the match listing.
+// DateFrom and DateTo are dates (YYYY-MM-DD) in the match timezone; both are inclusive.
+type MatchFilterQuery struct {
+	IDs      string `form:"ids" binding:"omitempty,max=4000"`   // Comma-separated; returns these matches in this order
+	Fields   string `form:"fields" binding:"omitempty,max=500"` // Comma-separated response fields; all when empty
+	SeasonID string `form:"season_id" binding:"omitempty,uuid"`
+	Round    int    `form:"round" binding:"omitempty,min=1,max=100"`
+	Status   string `form:"status" binding:"omitempty,matchstatus" enums:"scheduled,live,awaiting_result,completed,postponed,cancelled"`
+	TeamID   string `form:"team_id" binding:"omitempty,uuid"`
+	DateFrom string `form:"date_from" binding:"omitempty,date"`
+	DateTo   string `form:"date_to" binding:"omitempty,date"`
+}
+
+// MatchCalendarQuery holds the optional filters of the match calendar feed.
+type MatchCalendarQuery struct {
+	TeamID   string `form:"team_id" binding:"omitempty,uuid"`
+	SeasonID string `form:"season_id" binding:"omitempty,uuid"`
+}
+
+// MatchStatusRequest represents the request payload for moving a match to another status.
+// A match is completed by submitting its result, not through this request.
+type MatchStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=scheduled live postponed cancelled" example:"live"`
 }
 
 // UpdateMatchRequest represents the request payload for updating a match schedule.
+// Without a venue_id the match is played at the home team's stadium, if it has one; without a
+// round it is taken out of its round.
 type UpdateMatchRequest struct {
-	HomeTeamID string `json:"home_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	AwayTeamID string `json:"away_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000020"`
-	MatchDate  string `json:"match_date" binding:"required" example:"2025-06-15"`
-	MatchTime  string `json:"match_time" binding:"required" example:"19:30"`
+	HomeTeamID    string `json:"home_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	AwayTeamID    string `json:"away_team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	MatchDatetime string `json:"match_datetime" binding:"required,matchdatetime" example:"2025-06-15T19:30:00+07:00"`
+	SeasonID      string `json:"season_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	VenueID       string `json:"venue_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	Round         int    `json:"round" binding:"omitempty,min=1,max=100" example:"12"`
+	Version       int    `json:"version" binding:"omitempty,min=1" example:"3"` // Version last read; the update fails with 409 if it changed since
+}
+
+// PatchMatchRequest represents the request payload for partially updating a match schedule.
+// Only fields present in the body are changed; an empty season_id removes the match from its season
+// (and its round), a round of 0 removes it from its round.
+// Without venue_id the venue is kept unless the home team changes; an empty venue_id (or a new home team)
+// moves the match to the home team's stadium.
+type PatchMatchRequest struct {
+	HomeTeamID    *string `json:"home_team_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	AwayTeamID    *string `json:"away_team_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	MatchDatetime *string `json:"match_datetime" binding:"omitempty,matchdatetime" example:"2025-06-15T19:30:00+07:00"`
+	SeasonID      *string `json:"season_id" binding:"omitempty,eq=|uuid" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	VenueID       *string `json:"venue_id" binding:"omitempty,eq=|uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	Round         *int    `json:"round" binding:"omitempty,min=0,max=100" example:"12"`
+	Version       int     `json:"version" binding:"omitempty,min=1" example:"3"` // Version last read; the update fails with 409 if it changed since
 }
 
 // MatchResultRequest represents the request payload for submitting match results.
+// Events is a mixed, chronological list of goals, cards and substitutions.
+// Goals is the legacy goal-only format and is still accepted; each entry is treated as a "goal" event.
+// Shootout records the penalty shootout that settled a drawn match, if there was one.
+// ManOfTheMatchID, Attendance and Ticketing are optional; a correction replaces them like the rest
+// of the result.
 type MatchResultRequest struct {
-	Goals []GoalInput `json:"goals" binding:"required,dive"`
+	Events          []MatchEventInput `json:"events" binding:"omitempty,dive"`
+	Goals           []GoalInput       `json:"goals" binding:"omitempty,dive"`
+	Shootout        *ShootoutInput    `json:"shootout"`
+	ManOfTheMatchID string            `json:"man_of_the_match_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000100"` // A player of either team, in its lineup if one was recorded
+	Attendance      *int              `json:"attendance" binding:"omitempty,min=0,max=1000000" example:"48500"`                            // Spectators in the stadium
+	Ticketing       *TicketingInput   `json:"ticketing"`
+}
+
+// TicketingInput represents the ticket sales of a match.
+type TicketingInput struct {
+	TicketsSold int   `json:"tickets_sold" binding:"min=0,max=1000000" example:"51200"`
+	Revenue     int64 `json:"revenue" binding:"min=0" example:"1280000"` // Gross, in euros
 }
 
-// GoalInput represents a single goal entry in the match result request.
+// ShootoutInput represents the penalties scored by each team in a shootout.
+type ShootoutInput struct {
+	HomeScore int `json:"home_score" binding:"min=0" example:"4"`
+	AwayScore int `json:"away_score" binding:"min=0" example:"3"`
+}
+
+// MatchEventInput represents a single event entry in the match result request.
+// For own goals, team_id is the team of the player who scored (the goal counts for the opponent).
+// For substitutions, player_id leaves the pitch and related_player_id comes on.
+type MatchEventInput struct {
+	Type            string      `json:"type" binding:"required,oneof=goal own_goal penalty yellow_card red_card substitution" example:"goal"`
+	PlayerID        string      `json:"player_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	RelatedPlayerID string      `json:"related_player_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000101"`
+	TeamID          string      `json:"team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Minute          EventMinute `json:"minute" binding:"required,eventminute" swaggertype:"string" example:"90+3"`
+}
+
+// GoalInput represents a single goal entry in the legacy match result format.
+// Type defaults to open_play; own goals are credited to the opponent of team_id, the scorer's team.
 type GoalInput struct {
-	PlayerID string `json:"player_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000100"`
-	TeamID   string `json:"team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	Minute   int    `json:"minute" binding:"required,gte=1" example:"45"`
+	Type     string      `json:"type" binding:"omitempty,oneof=open_play penalty own_goal" example:"open_play"`
+	PlayerID string      `json:"player_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	TeamID   string      `json:"team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Minute   EventMinute `json:"minute" binding:"required,eventminute" swaggertype:"string" example:"45"`
+}
+
+// MatchResultQuery represents the query parameters of a result submission.
+type MatchResultQuery struct {
+	DryRun bool `form:"dry_run"` // Validate and preview the score without saving
+}
+
+// MatchResultPreviewResponse represents the outcome of a dry-run result submission: the score
+// the result would give the match, which is left unchanged.
+type MatchResultPreviewResponse struct {
+	MatchID           string             `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeTeam          TeamResponse       `json:"home_team"`
+	AwayTeam          TeamResponse       `json:"away_team"`
+	HomeScore         int                `json:"home_score" example:"2"`
+	AwayScore         int                `json:"away_score" example:"1"`
+	HomeShootoutScore *int               `json:"home_shootout_score,omitempty" example:"4"`
+	AwayShootoutScore *int               `json:"away_shootout_score,omitempty" example:"3"`
+	ScoreLine         string             `json:"score_line" example:"2-1"`
+	Events            []MatchReportEvent `json:"events"`
+}
+
+// MatchResultImportRequest represents a batch of match results entered together, such as a
+// matchweek's fixtures.
+type MatchResultImportRequest struct {
+	Results []MatchResultImportItem `json:"results" binding:"required,min=1,max=50,dive"`
+}
+
+// MatchResultImportItem represents the result of one match in a bulk import, in the format of
+// a single result submission.
+type MatchResultImportItem struct {
+	MatchID string `json:"match_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	MatchResultRequest
+}
+
+// MatchResultImportError describes a match whose result could not be imported.
+type MatchResultImportError struct {
+	MatchID string `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Row     int    `json:"row,omitempty" example:"4"` // First file row of the match, for file imports
+	Code    string `json:"code" example:"MATCH_ALREADY_COMPLETED"`
+	Message string `json:"message" example:"Match result already submitted. Use PUT to update."`
+}
+
+// MatchResultImportResponse reports the outcome of a bulk result import.
+// Each match is saved in its own transaction; matches listed in errors are left unchanged.
+type MatchResultImportResponse struct {
+	Total     int                      `json:"total" example:"9"`
+	Imported  int                      `json:"imported" example:"8"`
+	Failed    int                      `json:"failed" example:"1"`
+	Submitted []MatchResponse          `json:"submitted"`
+	Errors    []MatchResultImportError `json:"errors"`
+}
+
+// EventMinute is the minute of a match event, sent as a number such as 67 or as a string
+// such as "67" or, in added time, "90+3" (see model.ParseEventMinute).
+type EventMinute string
+
+// UnmarshalJSON accepts both numbers and strings.
+func (m *EventMinute) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*m = EventMinute(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*m = EventMinute(n)
+	return nil
 }
 
 // MatchResponse represents the match data returned in API responses.
 type MatchResponse struct {
-	ID         string         `json:"id" example:"019292f0-6b00-7a50-8d00-000000001000"`
-	HomeTeamID string         `json:"home_team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	AwayTeamID string         `json:"away_team_id" example:"019292f0-6b00-7a50-8d00-000000000020"`
-	MatchDate  string         `json:"match_date" example:"2025-06-15"`
-	MatchTime  string         `json:"match_time" example:"19:30"`
-	HomeScore  int            `json:"home_score" example:"2"`
-	AwayScore  int            `json:"away_score" example:"1"`
-	Status     string         `json:"status" example:"completed"`
-	HomeTeam   *TeamResponse  `json:"home_team,omitempty"`
-	AwayTeam   *TeamResponse  `json:"away_team,omitempty"`
-	Goals      []GoalResponse `json:"goals,omitempty"`
-	CreatedAt  string         `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt  string         `json:"updated_at" example:"2025-01-15T10:30:00Z"`
-}
-
-// GoalResponse represents a goal entry in API responses.
-type GoalResponse struct {
-	ID        string          `json:"id" example:"019292f0-6b00-7a50-8d00-000000010000"`
-	MatchID   string          `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
-	PlayerID  string          `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
-	TeamID    string          `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	Minute    int             `json:"minute" example:"45"`
-	Player    *PlayerResponse `json:"player,omitempty"`
-	Team      *TeamResponse   `json:"team,omitempty"`
-	CreatedAt string          `json:"created_at" example:"2025-01-15T10:30:00Z"`
+	ID                string               `json:"id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeTeamID        string               `json:"home_team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	AwayTeamID        string               `json:"away_team_id" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	SeasonID          string               `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Round             *int                 `json:"round,omitempty" example:"12"`
+	VenueID           string               `json:"venue_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	MatchDatetime     string               `json:"match_datetime" example:"2025-06-15T12:30:00.000Z"`      // In SERVER_TIMEZONE
+	LocalDatetime     string               `json:"local_datetime" example:"2025-06-15T19:30:00.000+07:00"` // In Timezone
+	Timezone          string               `json:"timezone" example:"Asia/Jakarta"`
+	HomeScore         int                  `json:"home_score" example:"2"`
+	AwayScore         int                  `json:"away_score" example:"1"`
+	HomeShootoutScore *int                 `json:"home_shootout_score,omitempty" example:"4"` // Only for matches settled by a shootout
+	AwayShootoutScore *int                 `json:"away_shootout_score,omitempty" example:"3"`
+	ManOfTheMatchID   string               `json:"man_of_the_match_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Attendance        *int                 `json:"attendance,omitempty" example:"48500"` // Only when recorded with the result
+	Ticketing         *TicketingResponse   `json:"ticketing,omitempty"`
+	Status            string               `json:"status" example:"completed"`
+	Version           int                  `json:"version" example:"3"`
+	ArchivedAt        string               `json:"archived_at,omitempty" example:"2031-07-01T03:00:00.000Z"` // Set for matches of archived seasons
+	HomeTeam          *TeamResponse        `json:"home_team,omitempty"`
+	AwayTeam          *TeamResponse        `json:"away_team,omitempty"`
+	Venue             *StadiumResponse     `json:"venue,omitempty"`
+	Events            []MatchEventResponse `json:"events,omitempty"`
+	CreatedAt         string               `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt         string               `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// MatchPredictionResponse is a naive estimate of the outcome of a match from its teams' results
+// before kick-off. A completed match gets the prediction it had before it was played, next to its
+// actual outcome. Outcomes are decided by the score; a penalty shootout does not change them.
+type MatchPredictionResponse struct {
+	MatchID    string           `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeTeam   TeamResponse     `json:"home_team"`
+	AwayTeam   TeamResponse     `json:"away_team"`
+	Strategy   string           `json:"strategy" example:"form_and_head_to_head"` // Model that made the prediction
+	HomeWin    float64          `json:"home_win" example:"0.512"`                 // Probability from 0 to 1
+	Draw       float64          `json:"draw" example:"0.263"`
+	AwayWin    float64          `json:"away_win" example:"0.225"`
+	Predicted  string           `json:"predicted" example:"home_win"` // Most likely outcome: home_win, draw or away_win
+	HeadToHead HeadToHeadRecord `json:"head_to_head"`
+	HomeForm   string           `json:"home_form" example:"WWDLW"` // Latest results before kick-off, oldest first
+	AwayForm   string           `json:"away_form" example:"LDWLL"`
+	Actual     string           `json:"actual,omitempty" example:"home_win"` // Only for completed matches
+	Correct    *bool            `json:"correct,omitempty" example:"true"`    // Whether the predicted outcome happened
+}
+
+// HeadToHeadRecord is the record of the latest meetings of two teams before a match, at either ground.
+type HeadToHeadRecord struct {
+	Played       int `json:"played" example:"6"`
+	HomeTeamWins int `json:"home_team_wins" example:"3"`
+	Draws        int `json:"draws" example:"2"`
+	AwayTeamWins int `json:"away_team_wins" example:"1"`
+}
+
+// TicketingResponse represents the ticket sales recorded with a match result.
+type TicketingResponse struct {
+	TicketsSold int   `json:"tickets_sold" example:"51200"`
+	Revenue     int64 `json:"revenue" example:"1280000"` // Gross, in euros
+}
+
+// MatchEventResponse represents a match event entry in API responses.
+type MatchEventResponse struct {
+	ID              string          `json:"id" example:"019292f0-6b00-7a50-8d00-000000010000"`
+	MatchID         string          `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Type            string          `json:"type" example:"goal"`
+	PlayerID        string          `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	RelatedPlayerID string          `json:"related_player_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000101"`
+	TeamID          string          `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Minute          int             `json:"minute" example:"90"`
+	AddedTime       int             `json:"added_time,omitempty" example:"3"` // Minutes of added time after minute
+	DisplayMinute   string          `json:"display_minute" example:"90+3'"`   // Minute as reports show it
+	Player          *PlayerResponse `json:"player,omitempty"`
+	RelatedPlayer   *PlayerResponse `json:"related_player,omitempty"`
+	Team            *TeamResponse   `json:"team,omitempty"`
+	CreatedAt       string          `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// MatchStatusChangedEvent is the payload of the match.status_changed feed event.
+type MatchStatusChangedEvent struct {
+	MatchID        string        `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	PreviousStatus string        `json:"previous_status" example:"scheduled"`
+	Status         string        `json:"status" example:"live"`
+	Match          MatchResponse `json:"match"`
+}
+
+// MatchDeletedEvent is the payload of the match.deleted feed event. Status is the status
+// of the match when it was deleted; a completed match takes its result with it.
+type MatchDeletedEvent struct {
+	MatchID string `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Status  string `json:"status" example:"completed"`
+}
+
+// MatchGoalEvent is the payload of the match.goal feed event.
+// HomeScore and AwayScore are the running score once this goal is counted.
+type MatchGoalEvent struct {
+	MatchID   string             `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeScore int                `json:"home_score" example:"1"`
+	AwayScore int                `json:"away_score" example:"0"`
+	Event     MatchEventResponse `json:"event"`
 }
@@ -0,0 +1,18 @@
+package dto
+
+// UpdateNotificationPreferencesRequest represents the request payload for the authenticated
+// admin's email notification settings. Email is required while any notification is enabled.
+type UpdateNotificationPreferencesRequest struct {
+	Email           string `json:"email" binding:"omitempty,email,max=254" example:"editor1@example.com"`
+	ResultSubmitted bool   `json:"result_submitted" example:"true"`
+	MatchPostponed  bool   `json:"match_postponed" example:"true"`
+	StandingsDigest bool   `json:"standings_digest" example:"false"`
+}
+
+// NotificationPreferencesResponse represents an admin's email notification settings.
+type NotificationPreferencesResponse struct {
+	Email           string `json:"email" example:"editor1@example.com"`
+	ResultSubmitted bool   `json:"result_submitted" example:"true"`
+	MatchPostponed  bool   `json:"match_postponed" example:"true"`
+	StandingsDigest bool   `json:"standings_digest" example:"false"`
+}
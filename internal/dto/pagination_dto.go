@@ -13,7 +13,7 @@ func (p *PaginationQuery) GetOffset() int {
 	return (p.Page - 1) * p.PerPage
 }
 
-// Sanitize applies defaults to empty or zero-value fields.
+// Sanitize applies defaults to empty, zero-value and out-of-range fields.
 func (p *PaginationQuery) Sanitize() {
 	if p.Page <= 0 {
 		p.Page = 1
@@ -27,7 +27,7 @@ func (p *PaginationQuery) Sanitize() {
 	if p.SortBy == "" {
 		p.SortBy = "created_at"
 	}
-	if p.SortOrder == "" {
+	if p.SortOrder != "asc" && p.SortOrder != "desc" {
 		p.SortOrder = "desc"
 	}
 }
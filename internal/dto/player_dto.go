@@ -2,32 +2,120 @@ package dto
 
 // CreatePlayerRequest represents the request payload for creating a player.
 type CreatePlayerRequest struct {
-	Name         string `json:"name" binding:"required" example:"Marko Simic"`
-	Height       int    `json:"height" binding:"required,gt=0" example:"185"`
-	Weight       int    `json:"weight" binding:"required,gt=0" example:"80"`
-	Position     string `json:"position" binding:"required,oneof=penyerang gelandang bertahan penjaga_gawang" example:"penyerang"`
-	JerseyNumber int    `json:"jersey_number" binding:"required,gt=0" example:"9"`
+	Name          string `json:"name" binding:"required" example:"Marko Simic"`
+	Height        int    `json:"height" binding:"required,gt=0" example:"185"`
+	Weight        int    `json:"weight" binding:"required,gt=0" example:"80"`
+	Position      string `json:"position" binding:"required,position" enums:"penyerang,gelandang,bertahan,penjaga_gawang" example:"penyerang"`
+	JerseyNumber  int    `json:"jersey_number" binding:"required,gt=0" example:"9"`
+	Nationality   string `json:"nationality" binding:"omitempty,iso3166_1_alpha2" example:"HR"` // ISO 3166-1 alpha-2 code
+	BirthDate     string `json:"birth_date" binding:"omitempty,date" example:"1987-01-13"`      // YYYY-MM-DD, not in the future
+	PreferredFoot string `json:"preferred_foot" binding:"omitempty,foot" enums:"left,right,both" example:"left"`
+	MarketValue   int64  `json:"market_value" binding:"omitempty,min=0" example:"750000"` // In euros
 }
 
 // UpdatePlayerRequest represents the request payload for updating a player.
 type UpdatePlayerRequest struct {
-	Name         string `json:"name" binding:"required" example:"Marko Simic"`
-	Height       int    `json:"height" binding:"required,gt=0" example:"185"`
-	Weight       int    `json:"weight" binding:"required,gt=0" example:"80"`
-	Position     string `json:"position" binding:"required,oneof=penyerang gelandang bertahan penjaga_gawang" example:"penyerang"`
-	JerseyNumber int    `json:"jersey_number" binding:"required,gt=0" example:"9"`
+	Name          string `json:"name" binding:"required" example:"Marko Simic"`
+	Height        int    `json:"height" binding:"required,gt=0" example:"185"`
+	Weight        int    `json:"weight" binding:"required,gt=0" example:"80"`
+	Position      string `json:"position" binding:"required,position" enums:"penyerang,gelandang,bertahan,penjaga_gawang" example:"penyerang"`
+	JerseyNumber  int    `json:"jersey_number" binding:"required,gt=0" example:"9"`
+	Nationality   string `json:"nationality" binding:"omitempty,iso3166_1_alpha2" example:"HR"` // ISO 3166-1 alpha-2 code
+	BirthDate     string `json:"birth_date" binding:"omitempty,date" example:"1987-01-13"`      // YYYY-MM-DD, not in the future
+	PreferredFoot string `json:"preferred_foot" binding:"omitempty,foot" enums:"left,right,both" example:"left"`
+	MarketValue   int64  `json:"market_value" binding:"omitempty,min=0" example:"750000"` // In euros
+	Version       int    `json:"version" binding:"omitempty,min=1" example:"3"`           // Version last read; the update fails with 409 if it changed since
+}
+
+// PatchPlayerRequest represents the request payload for partially updating a player.
+// Only fields present in the body are changed.
+type PatchPlayerRequest struct {
+	Name          *string `json:"name" binding:"omitempty,min=1" example:"Marko Simic"`
+	Height        *int    `json:"height" binding:"omitempty,gt=0" example:"185"`
+	Weight        *int    `json:"weight" binding:"omitempty,gt=0" example:"80"`
+	Position      *string `json:"position" binding:"omitempty,position" enums:"penyerang,gelandang,bertahan,penjaga_gawang" example:"penyerang"`
+	JerseyNumber  *int    `json:"jersey_number" binding:"omitempty,gt=0" example:"9"`
+	Nationality   *string `json:"nationality" binding:"omitempty,eq=|iso3166_1_alpha2" example:"HR"` // "" clears it
+	BirthDate     *string `json:"birth_date" binding:"omitempty,eq=|date" example:"1987-01-13"`      // "" clears it
+	PreferredFoot *string `json:"preferred_foot" binding:"omitempty,eq=|foot" enums:"left,right,both" example:"left"`
+	MarketValue   *int64  `json:"market_value" binding:"omitempty,min=0" example:"750000"`
+	Version       int     `json:"version" binding:"omitempty,min=1" example:"3"` // Version last read; the update fails with 409 if it changed since
+}
+
+// TransferPlayerRequest represents the request payload for moving a player to another team.
+// The jersey number is kept when omitted; it must be free in the new team.
+type TransferPlayerRequest struct {
+	TeamID       string `json:"team_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000011"`
+	JerseyNumber int    `json:"jersey_number" binding:"omitempty,gt=0" example:"10"`
 }
 
 // PlayerResponse represents the player data returned in API responses.
 type PlayerResponse struct {
-	ID           string        `json:"id" example:"019292f0-6b00-7a50-8d00-000000000100"`
-	TeamID       string        `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	Name         string        `json:"name" example:"Marko Simic"`
-	Height       int           `json:"height" example:"185"`
-	Weight       int           `json:"weight" example:"80"`
-	Position     string        `json:"position" example:"penyerang"`
-	JerseyNumber int           `json:"jersey_number" example:"9"`
-	Team         *TeamResponse `json:"team,omitempty"`
-	CreatedAt    string        `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt    string        `json:"updated_at" example:"2025-01-15T10:30:00Z"`
+	ID            string        `json:"id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	TeamID        string        `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Name          string        `json:"name" example:"Marko Simic"`
+	Slug          string        `json:"slug" example:"marko-simic"`
+	Height        int           `json:"height" example:"185"`
+	Weight        int           `json:"weight" example:"80"`
+	Position      string        `json:"position" example:"penyerang"`
+	JerseyNumber  int           `json:"jersey_number" example:"9"`
+	Nationality   string        `json:"nationality,omitempty" example:"HR"`
+	BirthDate     string        `json:"birth_date,omitempty" example:"1987-01-13"`
+	Age           *int          `json:"age,omitempty" example:"38"` // In whole years today; only with a birth date
+	PreferredFoot string        `json:"preferred_foot,omitempty" example:"left"`
+	MarketValue   int64         `json:"market_value,omitempty" example:"750000"` // In euros
+	PhotoURL      string        `json:"photo_url,omitempty" example:"/uploads/players/019292f0-6b00-7a50-8d00-000000000100/photo.jpg?v=1736937000"`
+	ThumbnailURL  string        `json:"thumbnail_url,omitempty" example:"/uploads/players/019292f0-6b00-7a50-8d00-000000000100/thumbnail.jpg?v=1736937000"`
+	Version       int           `json:"version" example:"3"`
+	Team          *TeamResponse `json:"team,omitempty"`
+	CreatedAt     string        `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt     string        `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// PlayerFilterQuery holds the optional search filters accepted by the cross-team player listing.
+type PlayerFilterQuery struct {
+	IDs           string `form:"ids" binding:"omitempty,max=4000"`   // Comma-separated; returns these players in this order
+	Fields        string `form:"fields" binding:"omitempty,max=500"` // Comma-separated response fields; all when empty
+	TeamID        string `form:"team_id" binding:"omitempty,uuid"`
+	Name          string `form:"name" binding:"omitempty,max=100"`
+	Position      string `form:"position" binding:"omitempty,position" enums:"penyerang,gelandang,bertahan,penjaga_gawang"`
+	JerseyNumber  int    `form:"jersey_number" binding:"omitempty,gt=0"`
+	HeightMin     int    `form:"height_min" binding:"omitempty,gt=0"`
+	HeightMax     int    `form:"height_max" binding:"omitempty,gt=0"`
+	WeightMin     int    `form:"weight_min" binding:"omitempty,gt=0"`
+	WeightMax     int    `form:"weight_max" binding:"omitempty,gt=0"`
+	Nationality   string `form:"nationality" binding:"omitempty,iso3166_1_alpha2"`
+	PreferredFoot string `form:"preferred_foot" binding:"omitempty,foot" enums:"left,right,both"`
+	AgeMin        int    `form:"age_min" binding:"omitempty,gt=0"` // Players without a birth date are excluded by either age bound
+	AgeMax        int    `form:"age_max" binding:"omitempty,gt=0"` // e.g. 22 for players eligible as under-23s
+	AvailabilityQuery
+}
+
+// AvailabilityQuery holds the optional availability filter accepted by player listings.
+type AvailabilityQuery struct {
+	AvailableOn string `form:"available_on" binding:"omitempty,date"` // Excludes players absent on this date
+}
+
+// PlayerImportError describes a problem with one row of an imported player file.
+type PlayerImportError struct {
+	Row     int    `json:"row" example:"3"`
+	Field   string `json:"field,omitempty" example:"jersey_number"`
+	Message string `json:"message" example:"jersey number 9 is already used in this team"`
+}
+
+// PlayerImportResponse reports the outcome of a bulk player import.
+// Valid rows are inserted together; rows listed in errors are skipped.
+type PlayerImportResponse struct {
+	TotalRows int                 `json:"total_rows" example:"26"`
+	Imported  int                 `json:"imported" example:"25"`
+	Failed    int                 `json:"failed" example:"1"`
+	Created   []PlayerResponse    `json:"created"`
+	Errors    []PlayerImportError `json:"errors"`
+}
+
+// PlayerTransferredEvent is the payload of the player.transferred feed event.
+type PlayerTransferredEvent struct {
+	Player     PlayerResponse `json:"player"`
+	FromTeamID string         `json:"from_team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	ToTeamID   string         `json:"to_team_id" example:"019292f0-6b00-7a50-8d00-000000000011"`
 }
@@ -0,0 +1,43 @@
+package dto
+
+// CreateRefereeRequest represents the request payload for creating a referee.
+type CreateRefereeRequest struct {
+	Name    string `json:"name" binding:"required" example:"Thoriq Alkatiri"`
+	Country string `json:"country" binding:"omitempty" example:"Indonesia"`
+}
+
+// UpdateRefereeRequest represents the request payload for updating a referee.
+type UpdateRefereeRequest struct {
+	Name    string `json:"name" binding:"required" example:"Thoriq Alkatiri"`
+	Country string `json:"country" binding:"omitempty" example:"Indonesia"`
+}
+
+// RefereeResponse represents the referee data returned in API responses.
+type RefereeResponse struct {
+	ID        string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000007"`
+	Name      string `json:"name" example:"Thoriq Alkatiri"`
+	Country   string `json:"country" example:"Indonesia"`
+	CreatedAt string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt string `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// MatchOfficialsRequest represents the request payload for assigning the officials of a match.
+// Assigning officials replaces any officials previously assigned to the match.
+type MatchOfficialsRequest struct {
+	RefereeID    string   `json:"referee_id" binding:"required,uuid" example:"019292f0-6b00-7a50-8d00-000000000007"`
+	AssistantIDs []string `json:"assistant_ids" binding:"omitempty,max=2,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000008"`
+}
+
+// MatchOfficialsResponse represents the officials assigned to a match.
+// Referee is null until officials have been assigned.
+type MatchOfficialsResponse struct {
+	MatchID    string            `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Referee    *RefereeResponse  `json:"referee"`
+	Assistants []RefereeResponse `json:"assistants"`
+}
+
+// RefereeMatchResponse represents a match a referee officiated, or is assigned to, in a given role.
+type RefereeMatchResponse struct {
+	Role  string        `json:"role" example:"referee"` // "referee" or "assistant"
+	Match MatchResponse `json:"match"`
+}
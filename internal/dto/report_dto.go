@@ -2,25 +2,61 @@ package dto
 
 // MatchReportResponse represents the detailed match report for a completed match.
 type MatchReportResponse struct {
-	MatchID           string             `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
-	MatchDate         string             `json:"match_date" example:"2025-06-15"`
-	MatchTime         string             `json:"match_time" example:"19:30"`
-	HomeTeam          TeamResponse       `json:"home_team"`
-	AwayTeam          TeamResponse       `json:"away_team"`
-	HomeScore         int                `json:"home_score" example:"2"`
-	AwayScore         int                `json:"away_score" example:"1"`
-	MatchResult       string             `json:"match_result" example:"Home Win"` // "Home Win", "Away Win", "Draw"
-	Goals             []MatchReportGoal  `json:"goals"`
-	TopScorer         *TopScorerResponse `json:"top_scorer"`
-	HomeTeamTotalWins int                `json:"home_team_total_wins" example:"5"`
-	AwayTeamTotalWins int                `json:"away_team_total_wins" example:"3"`
+	MatchID           string                 `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	MatchDatetime     string                 `json:"match_datetime" example:"2025-06-15T12:30:00.000Z"`      // In SERVER_TIMEZONE
+	LocalDatetime     string                 `json:"local_datetime" example:"2025-06-15T19:30:00.000+07:00"` // In Timezone
+	Timezone          string                 `json:"timezone" example:"Asia/Jakarta"`
+	HomeTeam          TeamResponse           `json:"home_team"`
+	AwayTeam          TeamResponse           `json:"away_team"`
+	Venue             *StadiumResponse       `json:"venue,omitempty"`
+	HomeScore         int                    `json:"home_score" example:"2"`
+	AwayScore         int                    `json:"away_score" example:"1"`
+	HomeShootoutScore *int                   `json:"home_shootout_score,omitempty" example:"4"` // Only for matches settled by a shootout
+	AwayShootoutScore *int                   `json:"away_shootout_score,omitempty" example:"3"`
+	ScoreLine         string                 `json:"score_line" example:"2-2, 4-3 on pens"`
+	MatchResult       string                 `json:"match_result" example:"Home Win"` // "Home Win", "Away Win", "Draw"; see the competition's shootout rule
+	Goals             []MatchReportGoal      `json:"goals"`
+	Events            []MatchReportEvent     `json:"events"`
+	HomeLineup        *TeamLineupResponse    `json:"home_lineup"`
+	AwayLineup        *TeamLineupResponse    `json:"away_lineup"`
+	Referee           *RefereeResponse       `json:"referee"`
+	Assistants        []RefereeResponse      `json:"assistants"`
+	TopScorer         *TopScorerResponse     `json:"top_scorer"`
+	ManOfTheMatch     *ManOfTheMatchResponse `json:"man_of_the_match"` // Null unless chosen with the result
+	HomeTeamTotalWins int                    `json:"home_team_total_wins" example:"5"`
+	AwayTeamTotalWins int                    `json:"away_team_total_wins" example:"3"`
 }
 
 // MatchReportGoal represents a goal entry in the match report.
+// TeamName is the team credited with the goal (the opponent of the scorer for own goals).
 type MatchReportGoal struct {
+	Type          string `json:"type" example:"goal"`           // "goal", "penalty" or "own_goal"
+	Marker        string `json:"marker,omitempty" example:"OG"` // "OG" for own goals, "PEN" for penalties
+	PlayerName    string `json:"player_name" example:"Marko Simic"`
+	TeamName      string `json:"team_name" example:"Persija Jakarta"`
+	Minute        int    `json:"minute" example:"90"`
+	AddedTime     int    `json:"added_time,omitempty" example:"3"`
+	DisplayMinute string `json:"display_minute" example:"90+3'"`
+}
+
+// MatchReportEvent represents any event (goal, card or substitution) in the match report timeline.
+type MatchReportEvent struct {
+	Type              string `json:"type" example:"substitution"`
+	Marker            string `json:"marker,omitempty"` // "OG" for own goals, "PEN" for penalties
+	PlayerName        string `json:"player_name" example:"Marko Simic"`
+	RelatedPlayerName string `json:"related_player_name,omitempty" example:"Riko Simanjuntak"`
+	TeamName          string `json:"team_name" example:"Persija Jakarta"`
+	Minute            int    `json:"minute" example:"70"`
+	AddedTime         int    `json:"added_time,omitempty" example:"0"`
+	DisplayMinute     string `json:"display_minute" example:"70'"`
+}
+
+// ManOfTheMatchResponse represents the player named man of the match.
+// TeamName is the player's current team.
+type ManOfTheMatchResponse struct {
+	PlayerID   string `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
 	PlayerName string `json:"player_name" example:"Marko Simic"`
 	TeamName   string `json:"team_name" example:"Persija Jakarta"`
-	Minute     int    `json:"minute" example:"45"`
 }
 
 // TopScorerResponse represents the top scorer of a match.
@@ -32,12 +68,191 @@ type TopScorerResponse struct {
 
 // MatchReportListItem represents a summary item in the match report list.
 type MatchReportListItem struct {
-	MatchID     string       `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
-	MatchDate   string       `json:"match_date" example:"2025-06-15"`
-	MatchTime   string       `json:"match_time" example:"19:30"`
-	HomeTeam    TeamResponse `json:"home_team"`
-	AwayTeam    TeamResponse `json:"away_team"`
-	HomeScore   int          `json:"home_score" example:"2"`
-	AwayScore   int          `json:"away_score" example:"1"`
-	MatchResult string       `json:"match_result" example:"Home Win"`
+	MatchID           string           `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	SeasonID          string           `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Round             *int             `json:"round,omitempty" example:"12"`
+	MatchDatetime     string           `json:"match_datetime" example:"2025-06-15T12:30:00.000Z"`      // In SERVER_TIMEZONE
+	LocalDatetime     string           `json:"local_datetime" example:"2025-06-15T19:30:00.000+07:00"` // In Timezone
+	Timezone          string           `json:"timezone" example:"Asia/Jakarta"`
+	HomeTeam          TeamResponse     `json:"home_team"`
+	AwayTeam          TeamResponse     `json:"away_team"`
+	Venue             *StadiumResponse `json:"venue,omitempty"`
+	HomeScore         int              `json:"home_score" example:"2"`
+	AwayScore         int              `json:"away_score" example:"1"`
+	HomeShootoutScore *int             `json:"home_shootout_score,omitempty" example:"4"`
+	AwayShootoutScore *int             `json:"away_shootout_score,omitempty" example:"3"`
+	ScoreLine         string           `json:"score_line" example:"2-2, 4-3 on pens"`
+	MatchResult       string           `json:"match_result" example:"Home Win"`
+	HomeTeamTotalWins int              `json:"home_team_total_wins" example:"5"`
+	AwayTeamTotalWins int              `json:"away_team_total_wins" example:"3"`
+}
+
+// StandingResponse represents a single row of the league table.
+// Teams are ranked by points, then the competition's tiebreakers (by default goal difference,
+// then goals scored). Points include any bonus points.
+type StandingResponse struct {
+	Position       int          `json:"position" example:"1"`
+	Team           TeamResponse `json:"team"`
+	Played         int          `json:"played" example:"10"`
+	Won            int          `json:"won" example:"7"`
+	Drawn          int          `json:"drawn" example:"2"`
+	Lost           int          `json:"lost" example:"1"`
+	GoalsFor       int          `json:"goals_for" example:"21"`
+	GoalsAgainst   int          `json:"goals_against" example:"8"`
+	GoalDifference int          `json:"goal_difference" example:"13"`
+	Points         int          `json:"points" example:"23"`
+	BonusPoints    int          `json:"bonus_points,omitempty" example:"2"` // Only in competitions awarding bonus points
+	Qualifies      bool         `json:"qualifies,omitempty" example:"true"` // Only in group tables: the position advances to the knockout stage
+	TeamForm
+}
+
+// RoundReportResponse summarizes the results of a round (matchweek). Completed matches are
+// listed in kick-off order; the others are only counted.
+type RoundReportResponse struct {
+	Round     int                   `json:"round" example:"12"`
+	SeasonID  string                `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Matches   int                   `json:"matches" example:"9"`   // Matches scheduled in the round, whatever their status
+	Completed int                   `json:"completed" example:"8"` // Matches with a result
+	Goals     int                   `json:"goals" example:"23"`
+	HomeWins  int                   `json:"home_wins" example:"4"`
+	AwayWins  int                   `json:"away_wins" example:"2"`
+	Draws     int                   `json:"draws" example:"2"`
+	Results   []MatchReportListItem `json:"results"`
+}
+
+// StandingHistoryQuery selects the team, and optionally the season, of a standings history.
+type StandingHistoryQuery struct {
+	TeamID   string `form:"team_id" binding:"required,uuid"`
+	SeasonID string `form:"season_id" binding:"omitempty,uuid"`
+}
+
+// StandingHistoryResponse is a team's position in the league table over time, for charts.
+// Without a season the positions are in the all-time table.
+type StandingHistoryResponse struct {
+	Team     TeamResponse           `json:"team"`
+	SeasonID string                 `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Rounds   []StandingHistoryEntry `json:"rounds"`
+}
+
+// StandingHistoryEntry is a team's row of the table after a round, recorded when the
+// match ending it was completed. A round is the most matches any team had played.
+type StandingHistoryEntry struct {
+	Round          int    `json:"round" example:"12"`
+	MatchID        string `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Position       int    `json:"position" example:"3"`
+	Played         int    `json:"played" example:"12"`
+	Points         int    `json:"points" example:"24"`
+	GoalDifference int    `json:"goal_difference" example:"9"`
+	RecordedAt     string `json:"recorded_at" example:"2025-06-15T14:30:00.000Z"`
+}
+
+// TeamRatingResponse is a team's current Elo rating. Teams without a completed match have
+// the starting rating.
+type TeamRatingResponse struct {
+	Position   int          `json:"position" example:"1"`
+	Team       TeamResponse `json:"team"`
+	Rating     float64      `json:"rating" example:"1562.4"`
+	Played     int          `json:"played" example:"18"`       // Rated matches
+	LastChange float64      `json:"last_change" example:"8.7"` // Of the team's latest match
+}
+
+// RatingHistoryQuery selects the team of a rating history.
+type RatingHistoryQuery struct {
+	TeamID string `form:"team_id" binding:"required,uuid"`
+}
+
+// RatingHistoryResponse is a team's Elo rating over time, for charts.
+type RatingHistoryResponse struct {
+	Team    TeamResponse         `json:"team"`
+	Rating  float64              `json:"rating" example:"1562.4"` // Current rating
+	Matches []RatingHistoryEntry `json:"matches"`
+}
+
+// RatingHistoryEntry is a team's rating right after one of its completed matches.
+type RatingHistoryEntry struct {
+	MatchID    string  `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	OpponentID string  `json:"opponent_id" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	PlayedAt   string  `json:"played_at" example:"2025-06-15T19:00:00.000Z"`
+	Rating     float64 `json:"rating" example:"1562.4"`
+	Change     float64 `json:"change" example:"-6.2"`
+}
+
+// SeasonReportQuery selects the season of a report that always covers one season.
+type SeasonReportQuery struct {
+	SeasonID string `form:"season_id" binding:"required,uuid"`
+}
+
+// GoalsByTeamResponse is the goals every team scored and conceded per round of a season,
+// laid out as chart series: each team has one value per entry of Rounds, null for a round
+// in which it has no completed match.
+type GoalsByTeamResponse struct {
+	SeasonID string           `json:"season_id" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Rounds   []int            `json:"rounds" example:"1,2,3"`
+	Teams    []TeamGoalSeries `json:"teams"`
+}
+
+// TeamGoalSeries is a team's goals per round, aligned with the rounds of the report.
+type TeamGoalSeries struct {
+	TeamID        string `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	TeamName      string `json:"team_name" example:"Persija Jakarta"`
+	Scored        []*int `json:"scored" example:"2,0,1"`
+	Conceded      []*int `json:"conceded" example:"1,1,0"`
+	TotalScored   int    `json:"total_scored" example:"3"`
+	TotalConceded int    `json:"total_conceded" example:"2"`
+}
+
+// ManOfTheMatchLeaderboardResponse ranks the players of a season by their man of the match awards.
+type ManOfTheMatchLeaderboardResponse struct {
+	SeasonID string                     `json:"season_id" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Players  []ManOfTheMatchLeaderEntry `json:"players"`
+}
+
+// ManOfTheMatchLeaderEntry is a player's row of the man of the match leaderboard. Players with
+// as many awards share a position. TeamName is the player's current team.
+type ManOfTheMatchLeaderEntry struct {
+	Position   int    `json:"position" example:"1"`
+	PlayerID   string `json:"player_id" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	PlayerName string `json:"player_name" example:"Marko Simic"`
+	TeamID     string `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	TeamName   string `json:"team_name" example:"Persija Jakarta"`
+	Awards     int    `json:"awards" example:"5"`
+}
+
+// AttendanceReportResponse summarizes the attendance recorded at completed matches, optionally
+// of one season, per home team and per stadium. Matches without an attendance are left out.
+type AttendanceReportResponse struct {
+	SeasonID          string            `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Matches           int               `json:"matches" example:"153"` // Matches with a recorded attendance
+	TotalAttendance   int64             `json:"total_attendance" example:"3264800"`
+	AverageAttendance int               `json:"average_attendance" example:"21338"`
+	Teams             []TeamAttendance  `json:"teams"`
+	Venues            []VenueAttendance `json:"venues"`
+}
+
+// TeamAttendance is the attendance at a team's home matches.
+type TeamAttendance struct {
+	TeamID            string `json:"team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	TeamName          string `json:"team_name" example:"Persija Jakarta"`
+	Matches           int    `json:"matches" example:"17"`
+	TotalAttendance   int64  `json:"total_attendance" example:"705500"`
+	AverageAttendance int    `json:"average_attendance" example:"41500"`
+	HighestAttendance int    `json:"highest_attendance" example:"76000"`
+}
+
+// VenueAttendance is the attendance at the matches played in a stadium.
+type VenueAttendance struct {
+	VenueID           string   `json:"venue_id" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	VenueName         string   `json:"venue_name" example:"Jakarta International Stadium"`
+	Capacity          int      `json:"capacity,omitempty" example:"82000"`
+	Matches           int      `json:"matches" example:"17"`
+	TotalAttendance   int64    `json:"total_attendance" example:"705500"`
+	AverageAttendance int      `json:"average_attendance" example:"41500"`
+	HighestAttendance int      `json:"highest_attendance" example:"76000"`
+	Occupancy         *float64 `json:"occupancy,omitempty" example:"50.6"` // Average attendance as a percentage of capacity, when it is known
+}
+
+// ReportFormatQuery selects the representation of a report listing.
+// "json" (the default) returns the usual envelope; "csv" and "pdf" download a file.
+type ReportFormatQuery struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv pdf"`
 }
@@ -0,0 +1,111 @@
+package dto
+
+// CreateCompetitionRequest represents the request payload for creating a competition.
+// ShootoutRule decides how matches settled by a penalty shootout count: "draw" (the default)
+// or "win" for the shootout winner.
+//
+// The points rules default to 3 points for a win and 1 for a draw, ties broken by goal
+// difference then goals scored, and no bonus points. Tiebreakers are applied in the order
+// given; a bonus point is earned for scoring at least bonus_goals goals in a match and for
+// winning by at least bonus_margin goals (0 for none).
+type CreateCompetitionRequest struct {
+	Name          string   `json:"name" binding:"required" example:"Liga 1"`
+	Country       string   `json:"country" binding:"omitempty" example:"Indonesia"`
+	ShootoutRule  string   `json:"shootout_rule" binding:"omitempty,oneof=draw win" example:"draw"`
+	PointsForWin  *int     `json:"points_for_win" binding:"omitempty,min=1,max=10" example:"3"`
+	PointsForDraw *int     `json:"points_for_draw" binding:"omitempty,min=0,max=10" example:"1"`
+	Tiebreakers   []string `json:"tiebreakers" binding:"omitempty,max=4,unique,dive,oneof=goal_difference goals_for wins head_to_head" example:"head_to_head,goal_difference"`
+	BonusGoals    *int     `json:"bonus_goals" binding:"omitempty,min=0,max=20" example:"0"`
+	BonusMargin   *int     `json:"bonus_margin" binding:"omitempty,min=0,max=20" example:"0"`
+}
+
+// UpdateCompetitionRequest represents the request payload for updating a competition.
+// The shootout rule and points rules left out are kept; an empty tiebreakers list restores
+// the default order.
+type UpdateCompetitionRequest struct {
+	Name          string   `json:"name" binding:"required" example:"Liga 1"`
+	Country       string   `json:"country" binding:"omitempty" example:"Indonesia"`
+	ShootoutRule  string   `json:"shootout_rule" binding:"omitempty,oneof=draw win" example:"draw"`
+	PointsForWin  *int     `json:"points_for_win" binding:"omitempty,min=1,max=10" example:"3"`
+	PointsForDraw *int     `json:"points_for_draw" binding:"omitempty,min=0,max=10" example:"1"`
+	Tiebreakers   []string `json:"tiebreakers" binding:"omitempty,max=4,unique,dive,oneof=goal_difference goals_for wins head_to_head" example:"head_to_head,goal_difference"`
+	BonusGoals    *int     `json:"bonus_goals" binding:"omitempty,min=0,max=20" example:"0"`
+	BonusMargin   *int     `json:"bonus_margin" binding:"omitempty,min=0,max=20" example:"0"`
+}
+
+// CompetitionResponse represents the competition data returned in API responses.
+type CompetitionResponse struct {
+	ID            string   `json:"id" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	Name          string   `json:"name" example:"Liga 1"`
+	Country       string   `json:"country" example:"Indonesia"`
+	ShootoutRule  string   `json:"shootout_rule" example:"draw"` // "draw" or "win"
+	PointsForWin  int      `json:"points_for_win" example:"3"`
+	PointsForDraw int      `json:"points_for_draw" example:"1"`
+	Tiebreakers   []string `json:"tiebreakers" example:"goal_difference,goals_for"` // After points, in order; then team name
+	BonusGoals    int      `json:"bonus_goals" example:"0"`
+	BonusMargin   int      `json:"bonus_margin" example:"0"`
+	CreatedAt     string   `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt     string   `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// CreateSeasonRequest represents the request payload for creating a season within a competition.
+type CreateSeasonRequest struct {
+	Name      string `json:"name" binding:"required" example:"2025/26"`
+	StartDate string `json:"start_date" binding:"required,date" example:"2025-08-01"` // YYYY-MM-DD
+	EndDate   string `json:"end_date" binding:"required,date" example:"2026-05-31"`   // YYYY-MM-DD
+}
+
+// UpdateSeasonRequest represents the request payload for updating a season.
+type UpdateSeasonRequest struct {
+	Name      string `json:"name" binding:"required" example:"2025/26"`
+	StartDate string `json:"start_date" binding:"required,date" example:"2025-08-01"`
+	EndDate   string `json:"end_date" binding:"required,date" example:"2026-05-31"`
+}
+
+// SeasonResponse represents the season data returned in API responses.
+type SeasonResponse struct {
+	ID            string               `json:"id" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	CompetitionID string               `json:"competition_id" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	Name          string               `json:"name" example:"2025/26"`
+	StartDate     string               `json:"start_date" example:"2025-08-01"`
+	EndDate       string               `json:"end_date" example:"2026-05-31"`
+	Competition   *CompetitionResponse `json:"competition,omitempty"`
+	CreatedAt     string               `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt     string               `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// SeasonFilterQuery holds the optional season filter accepted by match, report and standings listings.
+type SeasonFilterQuery struct {
+	SeasonID string `form:"season_id" binding:"omitempty,uuid"`
+}
+
+// GroupRequest represents the request payload for creating or updating a group of a season's
+// group stage. Qualifiers is how many teams advance from the group (2 by default) and cannot
+// exceed the number of teams; a team can play in only one group of a season.
+type GroupRequest struct {
+	Name       string   `json:"name" binding:"required,max=50" example:"Group A"`
+	Qualifiers int      `json:"qualifiers" binding:"omitempty,min=1" example:"2"`
+	TeamIDs    []string `json:"team_ids" binding:"required,min=2,max=32,dive,uuid" example:"019292f0-6b00-7a50-8d00-000000000010"`
+}
+
+// GroupResponse represents a group of a season's group stage with its teams.
+type GroupResponse struct {
+	ID         string         `json:"id" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	SeasonID   string         `json:"season_id" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Name       string         `json:"name" example:"Group A"`
+	Qualifiers int            `json:"qualifiers" example:"2"`
+	Teams      []TeamResponse `json:"teams"`
+	CreatedAt  string         `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt  string         `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// GroupStandingsResponse is the table of a group, counting only the season's completed matches
+// between its teams. Every team of the group is listed, played or not. Qualified lists the
+// teams currently in a qualifying place, in table order, for the knockout draw.
+type GroupStandingsResponse struct {
+	GroupID    string             `json:"group_id" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	Name       string             `json:"name" example:"Group A"`
+	Qualifiers int                `json:"qualifiers" example:"2"`
+	Standings  []StandingResponse `json:"standings"`
+	Qualified  []TeamResponse     `json:"qualified"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+// CreateStadiumRequest represents the request payload for creating a stadium.
+type CreateStadiumRequest struct {
+	Name     string `json:"name" binding:"required" example:"Jakarta International Stadium"`
+	City     string `json:"city" binding:"omitempty" example:"Jakarta"`
+	Capacity int    `json:"capacity" binding:"omitempty,min=0" example:"82000"`
+}
+
+// UpdateStadiumRequest represents the request payload for updating a stadium.
+type UpdateStadiumRequest struct {
+	Name     string `json:"name" binding:"required" example:"Jakarta International Stadium"`
+	City     string `json:"city" binding:"omitempty" example:"Jakarta"`
+	Capacity int    `json:"capacity" binding:"omitempty,min=0" example:"82000"`
+}
+
+// StadiumResponse represents the stadium data returned in API responses.
+type StadiumResponse struct {
+	ID        string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	Name      string `json:"name" example:"Jakarta International Stadium"`
+	City      string `json:"city" example:"Jakarta"`
+	Capacity  int    `json:"capacity" example:"82000"`
+	CreatedAt string `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt string `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
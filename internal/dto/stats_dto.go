@@ -0,0 +1,49 @@
+package dto
+
+// PlayerStatLine holds a player's appearances and goals over a set of completed matches.
+// A player appears in a match when named in the starting XI or involved in one of its events
+// (including coming on as a substitute). Own goals are not counted as goals.
+type PlayerStatLine struct {
+	MatchesPlayed   int     `json:"matches_played" example:"24"`
+	Goals           int     `json:"goals" example:"11"`
+	GoalsPerMatch   float64 `json:"goals_per_match" example:"0.46"` // Rounded to two decimals; 0 without appearances
+	FirstGoalMinute *int    `json:"first_goal_minute" example:"4"`  // Earliest minute scored in; null without goals
+	LastGoalMinute  *int    `json:"last_goal_minute" example:"90"`  // Latest minute scored in; null without goals
+}
+
+// PlayerSeasonStats holds a player's statistics in one season.
+// Matches not assigned to a season are grouped in an entry without season_id.
+type PlayerSeasonStats struct {
+	SeasonID   string `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	SeasonName string `json:"season_name,omitempty" example:"2025/26"`
+	PlayerStatLine
+}
+
+// PlayerStatsResponse represents a player's career statistics with a per-season breakdown,
+// ordered by each season's first match.
+type PlayerStatsResponse struct {
+	Player PlayerResponse `json:"player"`
+	PlayerStatLine
+	Seasons []PlayerSeasonStats `json:"seasons"`
+}
+
+// TeamForm summarises a team's latest completed matches.
+// Streaks count back from the most recent match and are 0 when it broke them.
+type TeamForm struct {
+	Form           string `json:"form" example:"WWDLW"` // Up to the last 5 results, oldest first: W(in), D(raw) or L(oss)
+	WinningStreak  int    `json:"winning_streak" example:"1"`
+	UnbeatenStreak int    `json:"unbeaten_streak" example:"1"` // Consecutive wins or draws
+	LosingStreak   int    `json:"losing_streak" example:"0"`
+}
+
+// TeamStatsResponse represents a team's record and form over completed matches.
+type TeamStatsResponse struct {
+	Team         TeamResponse `json:"team"`
+	Played       int          `json:"played" example:"10"`
+	Won          int          `json:"won" example:"7"`
+	Drawn        int          `json:"drawn" example:"2"`
+	Lost         int          `json:"lost" example:"1"`
+	GoalsFor     int          `json:"goals_for" example:"21"`
+	GoalsAgainst int          `json:"goals_against" example:"8"`
+	TeamForm
+}
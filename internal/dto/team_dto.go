@@ -2,30 +2,102 @@ package dto
 
 // CreateTeamRequest represents the request payload for creating a team.
 type CreateTeamRequest struct {
-	Name        string `json:"name" binding:"required" example:"Persija Jakarta"`
-	LogoURL     string `json:"logo_url" binding:"omitempty,url" example:"https://example.com/persija-logo.png"`
-	FoundedYear int    `json:"founded_year" binding:"omitempty,min=1800,max=2100" example:"1928"`
-	Address     string `json:"address" binding:"omitempty" example:"Jakarta International Stadium"`
-	City        string `json:"city" binding:"omitempty" example:"Jakarta"`
+	Name           string `json:"name" binding:"required" example:"Persija Jakarta"`
+	LogoURL        string `json:"logo_url" binding:"omitempty,url" example:"https://example.com/persija-logo.png"`
+	FoundedYear    int    `json:"founded_year" binding:"omitempty,min=1800,max=2100" example:"1928"`
+	Address        string `json:"address" binding:"omitempty" example:"Jakarta International Stadium"`
+	City           string `json:"city" binding:"omitempty" example:"Jakarta"`
+	StadiumID      string `json:"stadium_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	ShortName      string `json:"short_name" binding:"omitempty,max=30" example:"Persija"`
+	Abbreviation   string `json:"abbreviation" binding:"omitempty,teamcode" example:"PSJ"` // Three letters, unique among teams
+	PrimaryColor   string `json:"primary_color" binding:"omitempty,color" example:"#E30613"`
+	SecondaryColor string `json:"secondary_color" binding:"omitempty,color" example:"#FFFFFF"`
+	WebsiteURL     string `json:"website_url" binding:"omitempty,url" example:"https://www.persija.id"`
+	FacebookURL    string `json:"facebook_url" binding:"omitempty,url" example:"https://www.facebook.com/persija"`
+	InstagramURL   string `json:"instagram_url" binding:"omitempty,url" example:"https://www.instagram.com/persija"`
+	XURL           string `json:"x_url" binding:"omitempty,url" example:"https://x.com/persija"`
 }
 
 // UpdateTeamRequest represents the request payload for updating a team.
 type UpdateTeamRequest struct {
-	Name        string `json:"name" binding:"required" example:"Persija Jakarta"`
-	LogoURL     string `json:"logo_url" binding:"omitempty,url" example:"https://example.com/persija-logo.png"`
-	FoundedYear int    `json:"founded_year" binding:"omitempty,min=1800,max=2100" example:"1928"`
-	Address     string `json:"address" binding:"omitempty" example:"Jakarta International Stadium"`
-	City        string `json:"city" binding:"omitempty" example:"Jakarta"`
+	Name           string `json:"name" binding:"required" example:"Persija Jakarta"`
+	LogoURL        string `json:"logo_url" binding:"omitempty,url" example:"https://example.com/persija-logo.png"`
+	FoundedYear    int    `json:"founded_year" binding:"omitempty,min=1800,max=2100" example:"1928"`
+	Address        string `json:"address" binding:"omitempty" example:"Jakarta International Stadium"`
+	City           string `json:"city" binding:"omitempty" example:"Jakarta"`
+	StadiumID      string `json:"stadium_id" binding:"omitempty,uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	ShortName      string `json:"short_name" binding:"omitempty,max=30" example:"Persija"`
+	Abbreviation   string `json:"abbreviation" binding:"omitempty,teamcode" example:"PSJ"` // Three letters, unique among teams
+	PrimaryColor   string `json:"primary_color" binding:"omitempty,color" example:"#E30613"`
+	SecondaryColor string `json:"secondary_color" binding:"omitempty,color" example:"#FFFFFF"`
+	WebsiteURL     string `json:"website_url" binding:"omitempty,url" example:"https://www.persija.id"`
+	FacebookURL    string `json:"facebook_url" binding:"omitempty,url" example:"https://www.facebook.com/persija"`
+	InstagramURL   string `json:"instagram_url" binding:"omitempty,url" example:"https://www.instagram.com/persija"`
+	XURL           string `json:"x_url" binding:"omitempty,url" example:"https://x.com/persija"`
+	Version        int    `json:"version" binding:"omitempty,min=1" example:"3"` // Version last read; the update fails with 409 if it changed since
+}
+
+// PatchTeamRequest represents the request payload for partially updating a team.
+// Only fields present in the body are changed; an empty string or 0 clears an optional field.
+type PatchTeamRequest struct {
+	Name           *string `json:"name" binding:"omitempty,min=1" example:"Persija Jakarta"`
+	LogoURL        *string `json:"logo_url" binding:"omitempty,eq=|url" example:"https://example.com/persija-logo.png"`
+	FoundedYear    *int    `json:"founded_year" binding:"omitempty,eq=0|min=1800,max=2100" example:"1928"`
+	Address        *string `json:"address" example:"Jakarta International Stadium"`
+	City           *string `json:"city" example:"Jakarta"`
+	StadiumID      *string `json:"stadium_id" binding:"omitempty,eq=|uuid" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	ShortName      *string `json:"short_name" binding:"omitempty,max=30" example:"Persija"`
+	Abbreviation   *string `json:"abbreviation" binding:"omitempty,eq=|teamcode" example:"PSJ"`
+	PrimaryColor   *string `json:"primary_color" binding:"omitempty,eq=|color" example:"#E30613"`
+	SecondaryColor *string `json:"secondary_color" binding:"omitempty,eq=|color" example:"#FFFFFF"`
+	WebsiteURL     *string `json:"website_url" binding:"omitempty,eq=|url" example:"https://www.persija.id"`
+	FacebookURL    *string `json:"facebook_url" binding:"omitempty,eq=|url" example:"https://www.facebook.com/persija"`
+	InstagramURL   *string `json:"instagram_url" binding:"omitempty,eq=|url" example:"https://www.instagram.com/persija"`
+	XURL           *string `json:"x_url" binding:"omitempty,eq=|url" example:"https://x.com/persija"`
+	Version        int     `json:"version" binding:"omitempty,min=1" example:"3"` // Version last read; the update fails with 409 if it changed since
 }
 
 // TeamResponse represents the team data returned in API responses.
 type TeamResponse struct {
-	ID          string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000010"`
-	Name        string `json:"name" example:"Persija Jakarta"`
-	LogoURL     string `json:"logo_url" example:"https://example.com/persija-logo.png"`
-	FoundedYear int    `json:"founded_year" example:"1928"`
-	Address     string `json:"address" example:"Jakarta International Stadium"`
-	City        string `json:"city" example:"Jakarta"`
-	CreatedAt   string `json:"created_at" example:"2025-01-15T10:30:00Z"`
-	UpdatedAt   string `json:"updated_at" example:"2025-01-15T10:30:00Z"`
+	ID             string         `json:"id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	Name           string         `json:"name" example:"Persija Jakarta"`
+	Slug           string         `json:"slug" example:"persija-jakarta"`
+	LogoURL        string         `json:"logo_url" example:"https://example.com/persija-logo.png"`
+	FoundedYear    int            `json:"founded_year" example:"1928"`
+	Address        string         `json:"address" example:"Jakarta International Stadium"`
+	City           string         `json:"city" example:"Jakarta"`
+	StadiumID      string         `json:"stadium_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	ShortName      string         `json:"short_name,omitempty" example:"Persija"`
+	Abbreviation   string         `json:"abbreviation,omitempty" example:"PSJ"`
+	PrimaryColor   string         `json:"primary_color,omitempty" example:"#E30613"`
+	SecondaryColor string         `json:"secondary_color,omitempty" example:"#FFFFFF"`
+	WebsiteURL     string         `json:"website_url,omitempty" example:"https://www.persija.id"`
+	FacebookURL    string         `json:"facebook_url,omitempty" example:"https://www.facebook.com/persija"`
+	InstagramURL   string         `json:"instagram_url,omitempty" example:"https://www.instagram.com/persija"`
+	XURL           string         `json:"x_url,omitempty" example:"https://x.com/persija"`
+	HeadCoach      *CoachResponse `json:"head_coach,omitempty"` // Omitted where the team is nested, e.g. in matches
+	Version        int            `json:"version" example:"3"`
+	CreatedAt      string         `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt      string         `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// TeamFilterQuery holds the optional search filters accepted by the team listing.
+type TeamFilterQuery struct {
+	IDs             string `form:"ids" binding:"omitempty,max=4000"`   // Comma-separated; returns these teams in this order
+	Fields          string `form:"fields" binding:"omitempty,max=500"` // Comma-separated response fields; all when empty
+	Search          string `form:"search" binding:"omitempty,max=100"`
+	City            string `form:"city" binding:"omitempty,max=100"`
+	FoundedYearFrom int    `form:"founded_year_from" binding:"omitempty,min=1800,max=2100"`
+	FoundedYearTo   int    `form:"founded_year_to" binding:"omitempty,min=1800,max=2100"`
+}
+
+// TeamDeletedEvent is the payload of the team.deleted feed event.
+type TeamDeletedEvent struct {
+	ID             string `json:"id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	PlayersDeleted int64  `json:"players_deleted" example:"0"`
+}
+
+// DeleteTeamQuery holds the options of a team deletion.
+type DeleteTeamQuery struct {
+	Cascade bool `form:"cascade"`
 }
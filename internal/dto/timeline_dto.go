@@ -0,0 +1,33 @@
+package dto
+
+// MatchTimelineResponse is the chronological feed of a match for live ticker UIs: its events
+// and status changes in the order they happened, with the current score.
+type MatchTimelineResponse struct {
+	MatchID   string               `json:"match_id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	Status    string               `json:"status" example:"completed"`
+	HomeTeam  *TeamResponse        `json:"home_team,omitempty"`
+	AwayTeam  *TeamResponse        `json:"away_team,omitempty"`
+	HomeScore int                  `json:"home_score" example:"2"`
+	AwayScore int                  `json:"away_score" example:"1"`
+	Entries   []MatchTimelineEntry `json:"entries"`
+}
+
+// MatchTimelineEntry is one line of a match timeline: a match event (Type is the event type)
+// or a status change (Type is "status_change").
+type MatchTimelineEntry struct {
+	Type              string `json:"type" example:"goal"`
+	Minute            int    `json:"minute" example:"67"`              // Match minute; for status changes, minutes since kick-off (negative before it)
+	AddedTime         int    `json:"added_time,omitempty" example:"0"` // Minutes of added time after minute
+	DisplayMinute     string `json:"display_minute" example:"67'"`     // Empty for status changes before kick-off
+	TeamID            string `json:"team_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	TeamName          string `json:"team_name,omitempty" example:"Persija Jakarta"`
+	PlayerID          string `json:"player_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	PlayerName        string `json:"player_name,omitempty" example:"Marko Simic"`
+	RelatedPlayerID   string `json:"related_player_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000101"`
+	RelatedPlayerName string `json:"related_player_name,omitempty" example:"Riko Simanjuntak"`
+	FromStatus        string `json:"from_status,omitempty" example:"scheduled"`
+	ToStatus          string `json:"to_status,omitempty" example:"live"`
+	OccurredAt        string `json:"occurred_at,omitempty" example:"2025-06-15T12:30:00.000Z"` // Status changes only
+	Score             string `json:"score,omitempty" example:"1-0"`                            // Running score after goals, final score at full time
+	Text              string `json:"text" example:"Goal! Marko Simic (Persija Jakarta) 1-0"`
+}
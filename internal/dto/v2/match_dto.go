@@ -0,0 +1,74 @@
+// Package v2 holds the request and response bodies of /api/v2 that differ from /api/v1.
+// Every other v2 endpoint uses the v1 bodies in package dto.
+package v2
+
+import "github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+
+// MatchResponse represents a match in v2 API responses. The kick-off is a single
+// RFC 3339 timestamp in the match timezone, replacing v1's match_datetime
+// and local_datetime pair.
+type MatchResponse struct {
+	ID                string                   `json:"id" example:"019292f0-6b00-7a50-8d00-000000001000"`
+	HomeTeamID        string                   `json:"home_team_id" example:"019292f0-6b00-7a50-8d00-000000000010"`
+	AwayTeamID        string                   `json:"away_team_id" example:"019292f0-6b00-7a50-8d00-000000000020"`
+	SeasonID          string                   `json:"season_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000002"`
+	Round             *int                     `json:"round,omitempty" example:"12"`
+	VenueID           string                   `json:"venue_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000005"`
+	KickoffAt         string                   `json:"kickoff_at" example:"2025-06-15T19:30:00.000+07:00"` // In Timezone
+	Timezone          string                   `json:"timezone" example:"Asia/Jakarta"`
+	HomeScore         int                      `json:"home_score" example:"2"`
+	AwayScore         int                      `json:"away_score" example:"1"`
+	HomeShootoutScore *int                     `json:"home_shootout_score,omitempty" example:"4"`
+	AwayShootoutScore *int                     `json:"away_shootout_score,omitempty" example:"3"`
+	ManOfTheMatchID   string                   `json:"man_of_the_match_id,omitempty" example:"019292f0-6b00-7a50-8d00-000000000100"`
+	Attendance        *int                     `json:"attendance,omitempty" example:"48500"`
+	Ticketing         *dto.TicketingResponse   `json:"ticketing,omitempty"`
+	Status            string                   `json:"status" example:"completed"`
+	Version           int                      `json:"version" example:"3"`
+	ArchivedAt        string                   `json:"archived_at,omitempty" example:"2031-07-01T03:00:00.000Z"`
+	HomeTeam          *dto.TeamResponse        `json:"home_team,omitempty"`
+	AwayTeam          *dto.TeamResponse        `json:"away_team,omitempty"`
+	Venue             *dto.StadiumResponse     `json:"venue,omitempty"`
+	Events            []dto.MatchEventResponse `json:"events,omitempty"`
+	CreatedAt         string                   `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt         string                   `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// FromMatch converts a v1 match response to v2.
+func FromMatch(m dto.MatchResponse) MatchResponse {
+	return MatchResponse{
+		ID:                m.ID,
+		HomeTeamID:        m.HomeTeamID,
+		AwayTeamID:        m.AwayTeamID,
+		SeasonID:          m.SeasonID,
+		Round:             m.Round,
+		VenueID:           m.VenueID,
+		KickoffAt:         m.LocalDatetime,
+		Timezone:          m.Timezone,
+		HomeScore:         m.HomeScore,
+		AwayScore:         m.AwayScore,
+		HomeShootoutScore: m.HomeShootoutScore,
+		AwayShootoutScore: m.AwayShootoutScore,
+		ManOfTheMatchID:   m.ManOfTheMatchID,
+		Attendance:        m.Attendance,
+		Ticketing:         m.Ticketing,
+		Status:            m.Status,
+		Version:           m.Version,
+		ArchivedAt:        m.ArchivedAt,
+		HomeTeam:          m.HomeTeam,
+		AwayTeam:          m.AwayTeam,
+		Venue:             m.Venue,
+		Events:            m.Events,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+// FromMatches converts a list of v1 match responses to v2.
+func FromMatches(matches []dto.MatchResponse) []MatchResponse {
+	resp := make([]MatchResponse, len(matches))
+	for i, m := range matches {
+		resp[i] = FromMatch(m)
+	}
+	return resp
+}
@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+)
+
+// Custom validation tags of the request DTOs. Bad values are rejected while binding, with a
+// field error, before they reach the services.
+const (
+	TagPosition      = "position"      // A player position (model.ValidPositions)
+	TagFoot          = "foot"          // A player's preferred foot (model.ValidPreferredFeet)
+	TagColor         = "color"         // A hex RGB color, #RRGGBB
+	TagTeamCode      = "teamcode"      // A three-letter team abbreviation such as PSJ
+	TagMatchStatus   = "matchstatus"   // A match status (model.ValidMatchStatuses)
+	TagDate          = "date"          // A calendar date, YYYY-MM-DD
+	TagMatchDatetime = "matchdatetime" // An ISO 8601 kick-off time (timefmt.Parse)
+	TagEventMinute   = "eventminute"   // A match event minute such as 67 or 90+3 (model.ParseEventMinute)
+)
+
+// RegisterValidators adds the custom tags to v, the validator behind gin's binding
+// (binding.Validator.Engine()). It must run before the first request is bound.
+func RegisterValidators(v *validator.Validate) error {
+	validators := map[string]validator.Func{
+		TagPosition:      oneOf(model.ValidPositions),
+		TagFoot:          oneOf(model.ValidPreferredFeet),
+		TagColor:         isColor,
+		TagTeamCode:      isTeamCode,
+		TagMatchStatus:   oneOf(model.ValidMatchStatuses),
+		TagDate:          isDate,
+		TagMatchDatetime: isMatchDatetime,
+		TagEventMinute:   isEventMinute,
+	}
+	for tag, fn := range validators {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oneOf accepts the values listed, like the oneof tag but kept in sync with the model.
+func oneOf(values []string) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return slices.Contains(values, fl.Field().String())
+	}
+}
+
+func isDate(fl validator.FieldLevel) bool {
+	_, err := time.Parse("2006-01-02", fl.Field().String())
+	return err == nil
+}
+
+// isMatchDatetime only checks the format; values without a UTC offset are read in the
+// match timezone by the services.
+func isMatchDatetime(fl validator.FieldLevel) bool {
+	_, err := timefmt.Parse(fl.Field().String(), time.UTC)
+	return err == nil
+}
+
+func isColor(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if len(value) != 7 || value[0] != '#' {
+		return false
+	}
+	_, err := hex.DecodeString(value[1:])
+	return err == nil
+}
+
+// isTeamCode accepts three ASCII letters in either case; the services store them in upper case.
+func isTeamCode(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if len(value) != 3 {
+		return false
+	}
+	for _, r := range value {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isEventMinute(fl validator.FieldLevel) bool {
+	_, _, err := model.ParseEventMinute(fl.Field().String())
+	return err == nil
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "encoding/json"
+
+// CreateWebhookRequest represents the request payload for registering a webhook.
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=2048" example:"https://erp.example.com/hooks/football"`
+	Description string   `json:"description" binding:"max=255" example:"ERP result sync"`
+	Events      []string `json:"events" binding:"required,min=1,dive,oneof=match.created match.status_changed match.goal match.completed match.result_updated match.deleted team.created team.updated team.deleted player.transferred" example:"match.completed,team.created"`
+}
+
+// UpdateWebhookRequest represents the request payload for updating a webhook.
+// Deactivated webhooks keep their configuration but receive no deliveries.
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url,max=2048" example:"https://erp.example.com/hooks/football"`
+	Description string   `json:"description" binding:"max=255" example:"ERP result sync"`
+	Events      []string `json:"events" binding:"required,min=1,dive,oneof=match.created match.status_changed match.goal match.completed match.result_updated match.deleted team.created team.updated team.deleted player.transferred" example:"match.completed"`
+	Active      *bool    `json:"active" binding:"required" example:"true"`
+}
+
+// WebhookResponse represents a registered webhook. The signing secret is never returned after creation.
+type WebhookResponse struct {
+	ID          string   `json:"id" example:"019292f0-6b00-7a50-8d00-000000007000"`
+	URL         string   `json:"url" example:"https://erp.example.com/hooks/football"`
+	Description string   `json:"description" example:"ERP result sync"`
+	Events      []string `json:"events" example:"match.completed,team.created"`
+	Active      bool     `json:"active" example:"true"`
+	CreatedBy   string   `json:"created_by" example:"019292f0-6b00-7a50-8d00-000000000001"`
+	CreatedAt   string   `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+	UpdatedAt   string   `json:"updated_at" example:"2025-01-15T10:30:00.000Z"`
+}
+
+// WebhookCreatedResponse is returned once when a webhook is registered; Secret verifies delivery signatures.
+type WebhookCreatedResponse struct {
+	Secret  string          `json:"secret" example:"whsec_8c1f0e6a2b4d4f7e9a3c5b1d7e9f0a2c4b6d8e0f1a3c5e7f9b1d3f5a7c9e1b3d"`
+	Webhook WebhookResponse `json:"webhook"`
+}
+
+// WebhookDeliveryResponse represents one entry of a webhook's delivery log.
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id" example:"019292f0-6b00-7a50-8d00-000000008000"`
+	EventID        string          `json:"event_id" example:"019292f0-6b00-7a50-8d00-000000009000"`
+	EventType      string          `json:"event_type" example:"match.completed"`
+	Status         string          `json:"status" example:"succeeded"`
+	Attempts       int             `json:"attempts" example:"1"`
+	LastStatusCode int             `json:"last_status_code,omitempty" example:"200"`
+	LastError      string          `json:"last_error,omitempty" example:""`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty" example:""`
+	DeliveredAt    string          `json:"delivered_at,omitempty" example:"2025-01-15T10:30:01.000Z"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt      string          `json:"created_at" example:"2025-01-15T10:30:00.000Z"`
+}
@@ -0,0 +1,306 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/graphql"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// maxFilterLength matches the max=100 binding on the REST list filters.
+const maxFilterLength = 100
+
+// queryTypes are the object types the root query returns.
+type queryTypes struct {
+	team, player, match, report, standing *graphql.Object
+	teamPage, playerPage, matchPage       *graphql.Object
+}
+
+// queryFields returns the root query fields. Lists are paginated like the REST listings
+// (perPage is capped at 100); single records that do not exist resolve to null.
+func queryFields(svc Services, types queryTypes) []*graphql.Field {
+	pageArgs := func(args ...*graphql.Arg) []*graphql.Arg {
+		return append([]*graphql.Arg{
+			{Name: "page", Type: graphql.Int, Default: 1},
+			{Name: "perPage", Type: graphql.Int, Default: 10},
+		}, args...)
+	}
+	idArg := []*graphql.Arg{{Name: "id", Type: graphql.NonNullOf(graphql.ID)}}
+
+	return []*graphql.Field{
+		{
+			Name: "team",
+			Type: types.team,
+			Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				id, err := parseID(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				return orNotFound(svc.Teams.GetByID(ctx, id))
+			},
+		},
+		{
+			Name: "teams",
+			Type: graphql.NonNullOf(types.teamPage),
+			Args: pageArgs(
+				&graphql.Arg{Name: "search", Type: graphql.String, Description: "Matches name or city"},
+				&graphql.Arg{Name: "city", Type: graphql.String},
+			),
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				if err := checkLength(args, "search", "city"); err != nil {
+					return nil, err
+				}
+				filter := dto.TeamFilterQuery{Search: args.String("search"), City: args.String("city")}
+				teams, meta, err := svc.Teams.GetAll(ctx, pagination(args), filter)
+				return page[dto.TeamResponse]{items: teams, meta: meta}, err
+			},
+		},
+		{
+			Name: "player",
+			Type: types.player,
+			Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				id, err := parseID(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				return orNotFound(svc.Players.GetByID(ctx, id))
+			},
+		},
+		{
+			Name: "players",
+			Type: graphql.NonNullOf(types.playerPage),
+			Args: pageArgs(
+				&graphql.Arg{Name: "teamId", Type: graphql.ID},
+				&graphql.Arg{Name: "name", Type: graphql.String},
+				&graphql.Arg{Name: "position", Type: graphql.String},
+			),
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				if err := checkLength(args, "name"); err != nil {
+					return nil, err
+				}
+				if err := checkOneOf(args, "position", model.ValidPositions); err != nil {
+					return nil, err
+				}
+				filter := dto.PlayerFilterQuery{TeamID: args.String("teamId"), Name: args.String("name"), Position: args.String("position")}
+				players, meta, err := svc.Players.GetAll(ctx, pagination(args), filter)
+				return page[dto.PlayerResponse]{items: players, meta: meta}, err
+			},
+		},
+		{
+			Name: "match",
+			Type: types.match,
+			Args: idArg,
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				id, err := parseID(args, "id")
+				if err != nil {
+					return nil, err
+				}
+				return orNotFound(svc.Matches.GetByID(ctx, id))
+			},
+		},
+		{
+			Name: "matches",
+			Type: graphql.NonNullOf(types.matchPage),
+			Args: pageArgs(
+				&graphql.Arg{Name: "status", Type: graphql.String},
+				&graphql.Arg{Name: "teamId", Type: graphql.ID},
+				&graphql.Arg{Name: "seasonId", Type: graphql.ID},
+			),
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				if err := checkOneOf(args, "status", model.ValidMatchStatuses); err != nil {
+					return nil, err
+				}
+				filter := dto.MatchFilterQuery{Status: args.String("status"), TeamID: args.String("teamId"), SeasonID: args.String("seasonId")}
+				matches, meta, err := svc.Matches.GetAll(ctx, pagination(args), filter)
+				return page[dto.MatchResponse]{items: matches, meta: meta}, err
+			},
+		},
+		{
+			Name:        "matchReport",
+			Description: "Report of a completed match; null if the match does not exist.",
+			Type:        types.report,
+			Args:        []*graphql.Arg{{Name: "matchId", Type: graphql.NonNullOf(graphql.ID)}},
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				id, err := parseID(args, "matchId")
+				if err != nil {
+					return nil, err
+				}
+				return orNotFound(svc.Reports.GetMatchReportByID(ctx, id))
+			},
+		},
+		{
+			Name:        "standings",
+			Description: "League table, optionally for one season.",
+			Type:        graphql.NonNullOf(graphql.ListOf(graphql.NonNullOf(types.standing))),
+			Args:        []*graphql.Arg{{Name: "seasonId", Type: graphql.ID}},
+			Resolve: func(ctx context.Context, _ any, args graphql.Args) (any, error) {
+				return svc.Standings.GetStandings(ctx, dto.SeasonFilterQuery{SeasonID: args.String("seasonId")})
+			},
+		},
+	}
+}
+
+// page is the source of the TeamPage, PlayerPage and MatchPage types.
+type page[T any] struct {
+	items []T
+	meta  *response.PaginationMeta
+}
+
+func pageFields[T any](items, pageInfo graphql.Type) []*graphql.Field {
+	return []*graphql.Field{
+		prop("items", items, "", func(p page[T]) any { return p.items }),
+		prop("pageInfo", graphql.NonNullOf(pageInfo), "", func(p page[T]) any { return p.meta }),
+	}
+}
+
+// prop returns a field read from a source of type T.
+func prop[T any](name string, typ graphql.Type, description string, get func(T) any) *graphql.Field {
+	return &graphql.Field{
+		Name:        name,
+		Type:        typ,
+		Description: description,
+		Resolve: func(_ context.Context, source any, _ graphql.Args) (any, error) {
+			return get(source.(T)), nil
+		},
+	}
+}
+
+// one returns a field resolved in one load for every source at a level: key gives the ID of the
+// related record ("" if there is none) and load returns the records by ID. Missing records are null.
+func one[T, V any](name string, typ graphql.Type, description string, key func(T) string, load func(context.Context, []string, graphql.Args) (map[string]V, error)) *graphql.Field {
+	return &graphql.Field{
+		Name:        name,
+		Type:        typ,
+		Description: description,
+		Batch: func(ctx context.Context, sources []any, args graphql.Args) ([]any, error) {
+			keys := sourceKeys(sources, key)
+			values, err := load(ctx, uniqueKeys(keys), args)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]any, len(sources))
+			for i, k := range keys {
+				if v, ok := values[k]; ok {
+					out[i] = v
+				}
+			}
+			return out, nil
+		},
+	}
+}
+
+// many is like one for lists: load returns the related records grouped by source ID,
+// and sources without any get an empty list.
+func many[T, V any](name string, typ graphql.Type, description string, key func(T) string, load func(context.Context, []string, graphql.Args) (map[string][]V, error)) *graphql.Field {
+	return &graphql.Field{
+		Name:        name,
+		Type:        typ,
+		Description: description,
+		Batch: func(ctx context.Context, sources []any, args graphql.Args) ([]any, error) {
+			keys := sourceKeys(sources, key)
+			values, err := load(ctx, uniqueKeys(keys), args)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]any, len(sources))
+			for i, k := range keys {
+				out[i] = values[k]
+			}
+			return out, nil
+		},
+	}
+}
+
+// withArgs adds arguments to a field.
+func withArgs(f *graphql.Field, args ...*graphql.Arg) *graphql.Field {
+	f.Args = append(f.Args, args...)
+	return f
+}
+
+func sourceKeys[T any](sources []any, key func(T) string) []string {
+	keys := make([]string, len(sources))
+	for i, source := range sources {
+		keys[i] = key(source.(T))
+	}
+	return keys
+}
+
+func uniqueKeys(keys []string) []string {
+	var unique []string
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if k != "" && !seen[k] {
+			seen[k] = true
+			unique = append(unique, k)
+		}
+	}
+	return unique
+}
+
+// nullable maps an empty optional string to null.
+func nullable(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// nullableInt maps a missing optional number to null.
+func nullableInt(n *int) any {
+	if n == nil {
+		return nil
+	}
+	return *n
+}
+
+// orNotFound turns a service's 404 into a null result, as GraphQL clients expect for a missing record.
+func orNotFound[T any](v *T, err error) (any, error) {
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) && appErr.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return *v, nil
+}
+
+func parseID(args graphql.Args, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(args.String(name))
+	if err != nil {
+		return uuid.Nil, errs.ErrBadRequest("Invalid UUID format for '" + name + "' argument")
+	}
+	return id, nil
+}
+
+func pagination(args graphql.Args) dto.PaginationQuery {
+	p := dto.PaginationQuery{Page: args.Int("page"), PerPage: args.Int("perPage")}
+	p.Sanitize()
+	return p
+}
+
+func checkLength(args graphql.Args, names ...string) error {
+	for _, name := range names {
+		if len(args.String(name)) > maxFilterLength {
+			return errs.ErrBadRequest(name + " must be at most 100 characters")
+		}
+	}
+	return nil
+}
+
+func checkOneOf(args graphql.Args, name string, allowed []string) error {
+	if v := args.String(name); v != "" && !slices.Contains(allowed, v) {
+		return errs.ErrBadRequest(name + " must be one of " + strings.Join(allowed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Package graph defines the read-only GraphQL schema served at /api/v1/graphql.
+// Root queries go through the same services as the REST endpoints; nested fields
+// (a team's players, a match's teams and events, ...) are loaded per query level
+// through service.GraphService, so a list of N teams with their players costs two
+// queries rather than N+1.
+package graph
+
+import (
+	"context"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/graphql"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// MaxDepth is how deeply selections may nest, e.g. teams > players > goals > match > homeTeam.
+const MaxDepth = 8
+
+// Services are the services the schema resolves through.
+type Services struct {
+	Teams     service.TeamService
+	Players   service.PlayerService
+	Matches   service.MatchService
+	Reports   service.ReportService
+	Standings service.StandingsService
+	Graph     service.GraphService
+}
+
+// NewSchema builds the schema.
+func NewSchema(svc Services) (*graphql.Schema, error) {
+	var (
+		team        = &graphql.Object{Name: "Team", Description: "A football club."}
+		player      = &graphql.Object{Name: "Player", Description: "A player registered with a team."}
+		match       = &graphql.Object{Name: "Match", Description: "A scheduled or played match."}
+		event       = &graphql.Object{Name: "MatchEvent", Description: "A goal, card or substitution in a match."}
+		report      = &graphql.Object{Name: "MatchReport", Description: "The report of a completed match."}
+		reportGoal  = &graphql.Object{Name: "ReportGoal", Description: "A goal in a match report, credited to the team it counts for."}
+		topScorer   = &graphql.Object{Name: "TopScorer", Description: "The player who scored most goals in a match."}
+		standing    = &graphql.Object{Name: "Standing", Description: "A row of the league table."}
+		pageInfo    = &graphql.Object{Name: "PageInfo", Description: "Pagination of a list."}
+		teamPage    = &graphql.Object{Name: "TeamPage", Description: "A page of teams."}
+		playerPage  = &graphql.Object{Name: "PlayerPage", Description: "A page of players."}
+		matchPage   = &graphql.Object{Name: "MatchPage", Description: "A page of matches."}
+		nonNullTeam = graphql.NonNullOf(team)
+	)
+	listOf := func(t graphql.Type) graphql.Type {
+		return graphql.NonNullOf(graphql.ListOf(graphql.NonNullOf(t)))
+	}
+	teamsByID := func(ctx context.Context, ids []string, _ graphql.Args) (map[string]dto.TeamResponse, error) {
+		return svc.Graph.TeamsByIDs(ctx, ids)
+	}
+
+	team.Fields = []*graphql.Field{
+		prop("id", graphql.NonNullOf(graphql.ID), "", func(t dto.TeamResponse) any { return t.ID }),
+		prop("name", graphql.NonNullOf(graphql.String), "", func(t dto.TeamResponse) any { return t.Name }),
+		prop("slug", graphql.String, "URL-friendly name, e.g. persija-jakarta.", func(t dto.TeamResponse) any { return nullable(t.Slug) }),
+		prop("logoUrl", graphql.String, "", func(t dto.TeamResponse) any { return t.LogoURL }),
+		prop("foundedYear", graphql.Int, "", func(t dto.TeamResponse) any { return t.FoundedYear }),
+		prop("address", graphql.String, "Home stadium address.", func(t dto.TeamResponse) any { return t.Address }),
+		prop("city", graphql.String, "", func(t dto.TeamResponse) any { return t.City }),
+		prop("shortName", graphql.String, "", func(t dto.TeamResponse) any { return nullable(t.ShortName) }),
+		prop("abbreviation", graphql.String, "Three-letter code for scoreboards.", func(t dto.TeamResponse) any { return nullable(t.Abbreviation) }),
+		prop("primaryColor", graphql.String, "Kit color, #RRGGBB.", func(t dto.TeamResponse) any { return nullable(t.PrimaryColor) }),
+		prop("secondaryColor", graphql.String, "Kit color, #RRGGBB.", func(t dto.TeamResponse) any { return nullable(t.SecondaryColor) }),
+		prop("websiteUrl", graphql.String, "", func(t dto.TeamResponse) any { return nullable(t.WebsiteURL) }),
+		prop("facebookUrl", graphql.String, "", func(t dto.TeamResponse) any { return nullable(t.FacebookURL) }),
+		prop("instagramUrl", graphql.String, "", func(t dto.TeamResponse) any { return nullable(t.InstagramURL) }),
+		prop("xUrl", graphql.String, "", func(t dto.TeamResponse) any { return nullable(t.XURL) }),
+		prop("stadiumId", graphql.ID, "Home stadium, the default venue of home matches.", func(t dto.TeamResponse) any { return nullable(t.StadiumID) }),
+		prop("version", graphql.NonNullOf(graphql.Int), "", func(t dto.TeamResponse) any { return t.Version }),
+		prop("createdAt", graphql.NonNullOf(graphql.String), "", func(t dto.TeamResponse) any { return t.CreatedAt }),
+		prop("updatedAt", graphql.NonNullOf(graphql.String), "", func(t dto.TeamResponse) any { return t.UpdatedAt }),
+		many("players", listOf(player), "Current squad, by jersey number.",
+			func(t dto.TeamResponse) string { return t.ID },
+			func(ctx context.Context, ids []string, _ graphql.Args) (map[string][]dto.PlayerResponse, error) {
+				return svc.Graph.PlayersByTeamIDs(ctx, ids)
+			}),
+		withArgs(many("matches", listOf(match), "Home and away matches in kick-off order.",
+			func(t dto.TeamResponse) string { return t.ID },
+			func(ctx context.Context, ids []string, args graphql.Args) (map[string][]dto.MatchResponse, error) {
+				return svc.Graph.MatchesByTeamIDs(ctx, ids, args.String("status"))
+			}),
+			&graphql.Arg{Name: "status", Type: graphql.String, Description: "scheduled, live, awaiting_result, completed, postponed or cancelled"}),
+	}
+
+	player.Fields = []*graphql.Field{
+		prop("id", graphql.NonNullOf(graphql.ID), "", func(p dto.PlayerResponse) any { return p.ID }),
+		prop("name", graphql.NonNullOf(graphql.String), "", func(p dto.PlayerResponse) any { return p.Name }),
+		prop("slug", graphql.String, "URL-friendly name, e.g. marko-simic.", func(p dto.PlayerResponse) any { return nullable(p.Slug) }),
+		prop("position", graphql.NonNullOf(graphql.String), "penyerang, gelandang, bertahan or penjaga_gawang.", func(p dto.PlayerResponse) any { return p.Position }),
+		prop("jerseyNumber", graphql.NonNullOf(graphql.Int), "", func(p dto.PlayerResponse) any { return p.JerseyNumber }),
+		prop("nationality", graphql.String, "ISO 3166-1 alpha-2 country code.", func(p dto.PlayerResponse) any { return p.Nationality }),
+		prop("birthDate", graphql.String, "YYYY-MM-DD.", func(p dto.PlayerResponse) any { return nullable(p.BirthDate) }),
+		prop("age", graphql.Int, "In whole years today; null without a birth date.", func(p dto.PlayerResponse) any { return nullableInt(p.Age) }),
+		prop("preferredFoot", graphql.String, "left, right or both.", func(p dto.PlayerResponse) any { return nullable(p.PreferredFoot) }),
+		prop("marketValue", graphql.Int, "Estimated transfer value in euros.", func(p dto.PlayerResponse) any {
+			if p.MarketValue == 0 {
+				return nil
+			}
+			return p.MarketValue
+		}),
+		prop("height", graphql.Int, "In centimetres.", func(p dto.PlayerResponse) any { return p.Height }),
+		prop("weight", graphql.Int, "In kilograms.", func(p dto.PlayerResponse) any { return p.Weight }),
+		prop("photoUrl", graphql.String, "Headshot, at most 800 pixels on its longest side.", func(p dto.PlayerResponse) any { return nullable(p.PhotoURL) }),
+		prop("thumbnailUrl", graphql.String, "Square 160 × 160 thumbnail of the headshot.", func(p dto.PlayerResponse) any { return nullable(p.ThumbnailURL) }),
+		prop("version", graphql.NonNullOf(graphql.Int), "", func(p dto.PlayerResponse) any { return p.Version }),
+		prop("createdAt", graphql.NonNullOf(graphql.String), "", func(p dto.PlayerResponse) any { return p.CreatedAt }),
+		prop("updatedAt", graphql.NonNullOf(graphql.String), "", func(p dto.PlayerResponse) any { return p.UpdatedAt }),
+		one("team", team, "",
+			func(p dto.PlayerResponse) string { return p.TeamID }, teamsByID),
+		many("goals", listOf(event), "Goals and converted penalties, oldest first; own goals are not included.",
+			func(p dto.PlayerResponse) string { return p.ID },
+			func(ctx context.Context, ids []string, _ graphql.Args) (map[string][]dto.MatchEventResponse, error) {
+				return svc.Graph.GoalsByPlayerIDs(ctx, ids)
+			}),
+	}
+
+	matchEvents := func(filter func(dto.MatchEventResponse) bool) func(context.Context, []string, graphql.Args) (map[string][]dto.MatchEventResponse, error) {
+		return func(ctx context.Context, ids []string, _ graphql.Args) (map[string][]dto.MatchEventResponse, error) {
+			events, err := svc.Graph.EventsByMatchIDs(ctx, ids)
+			if err != nil || filter == nil {
+				return events, err
+			}
+			for id, list := range events {
+				kept := list[:0]
+				for _, e := range list {
+					if filter(e) {
+						kept = append(kept, e)
+					}
+				}
+				events[id] = kept
+			}
+			return events, nil
+		}
+	}
+	match.Fields = []*graphql.Field{
+		prop("id", graphql.NonNullOf(graphql.ID), "", func(m dto.MatchResponse) any { return m.ID }),
+		prop("status", graphql.NonNullOf(graphql.String), "scheduled, live, awaiting_result, completed, postponed or cancelled.", func(m dto.MatchResponse) any { return m.Status }),
+		prop("matchDatetime", graphql.NonNullOf(graphql.String), "Kick-off in UTC.", func(m dto.MatchResponse) any { return m.MatchDatetime }),
+		prop("localDatetime", graphql.NonNullOf(graphql.String), "Kick-off in the configured timezone.", func(m dto.MatchResponse) any { return m.LocalDatetime }),
+		prop("timezone", graphql.NonNullOf(graphql.String), "", func(m dto.MatchResponse) any { return m.Timezone }),
+		prop("homeScore", graphql.NonNullOf(graphql.Int), "", func(m dto.MatchResponse) any { return m.HomeScore }),
+		prop("awayScore", graphql.NonNullOf(graphql.Int), "", func(m dto.MatchResponse) any { return m.AwayScore }),
+		prop("homeShootoutScore", graphql.Int, "Penalties scored in a shootout; null unless one settled the match.", func(m dto.MatchResponse) any { return nullableInt(m.HomeShootoutScore) }),
+		prop("awayShootoutScore", graphql.Int, "", func(m dto.MatchResponse) any { return nullableInt(m.AwayShootoutScore) }),
+		prop("manOfTheMatchId", graphql.ID, "Player named man of the match; null unless chosen with the result.", func(m dto.MatchResponse) any { return nullable(m.ManOfTheMatchID) }),
+		prop("attendance", graphql.Int, "Spectators; null unless recorded with the result.", func(m dto.MatchResponse) any { return nullableInt(m.Attendance) }),
+		prop("seasonId", graphql.ID, "", func(m dto.MatchResponse) any { return nullable(m.SeasonID) }),
+		prop("venueId", graphql.ID, "Stadium the match is played at.", func(m dto.MatchResponse) any { return nullable(m.VenueID) }),
+		prop("version", graphql.NonNullOf(graphql.Int), "", func(m dto.MatchResponse) any { return m.Version }),
+		prop("createdAt", graphql.NonNullOf(graphql.String), "", func(m dto.MatchResponse) any { return m.CreatedAt }),
+		prop("updatedAt", graphql.NonNullOf(graphql.String), "", func(m dto.MatchResponse) any { return m.UpdatedAt }),
+		one("homeTeam", nonNullTeam, "", func(m dto.MatchResponse) string { return m.HomeTeamID }, teamsByID),
+		one("awayTeam", nonNullTeam, "", func(m dto.MatchResponse) string { return m.AwayTeamID }, teamsByID),
+		many("events", listOf(event), "Every event in chronological order.",
+			func(m dto.MatchResponse) string { return m.ID }, matchEvents(nil)),
+		many("goals", listOf(event), "Goals, penalties and own goals in chronological order.",
+			func(m dto.MatchResponse) string { return m.ID },
+			matchEvents(func(e dto.MatchEventResponse) bool { return model.MatchEvent{Type: e.Type}.IsScoring() })),
+	}
+
+	playerByID := func(ctx context.Context, ids []string, _ graphql.Args) (map[string]dto.PlayerResponse, error) {
+		return svc.Graph.PlayersByIDs(ctx, ids)
+	}
+	event.Fields = []*graphql.Field{
+		prop("id", graphql.NonNullOf(graphql.ID), "", func(e dto.MatchEventResponse) any { return e.ID }),
+		prop("type", graphql.NonNullOf(graphql.String), "goal, own_goal, penalty, yellow_card, red_card or substitution.", func(e dto.MatchEventResponse) any { return e.Type }),
+		prop("minute", graphql.NonNullOf(graphql.Int), "", func(e dto.MatchEventResponse) any { return e.Minute }),
+		prop("addedTime", graphql.NonNullOf(graphql.Int), "Minutes into added time, 0 outside it.", func(e dto.MatchEventResponse) any { return e.AddedTime }),
+		prop("displayMinute", graphql.NonNullOf(graphql.String), "Minute as shown on scoreboards, e.g. 67' or 90+3'.", func(e dto.MatchEventResponse) any { return e.DisplayMinute }),
+		prop("createdAt", graphql.NonNullOf(graphql.String), "", func(e dto.MatchEventResponse) any { return e.CreatedAt }),
+		one("match", graphql.NonNullOf(match), "",
+			func(e dto.MatchEventResponse) string { return e.MatchID },
+			func(ctx context.Context, ids []string, _ graphql.Args) (map[string]dto.MatchResponse, error) {
+				return svc.Graph.MatchesByIDs(ctx, ids)
+			}),
+		one("player", graphql.NonNullOf(player), "The scorer, the booked player or the player substituted off.",
+			func(e dto.MatchEventResponse) string { return e.PlayerID }, playerByID),
+		one("relatedPlayer", player, "The player substituted on.",
+			func(e dto.MatchEventResponse) string { return e.RelatedPlayerID }, playerByID),
+		one("team", nonNullTeam, "The player's team; own goals count for the opponent.",
+			func(e dto.MatchEventResponse) string { return e.TeamID }, teamsByID),
+	}
+
+	report.Fields = []*graphql.Field{
+		prop("matchResult", graphql.NonNullOf(graphql.String), "Home Win, Away Win or Draw.", func(r dto.MatchReportResponse) any { return r.MatchResult }),
+		prop("scoreLine", graphql.NonNullOf(graphql.String), "Final score, e.g. 2-1 or 2-2, 4-3 on pens.", func(r dto.MatchReportResponse) any { return r.ScoreLine }),
+		prop("homeScore", graphql.NonNullOf(graphql.Int), "", func(r dto.MatchReportResponse) any { return r.HomeScore }),
+		prop("awayScore", graphql.NonNullOf(graphql.Int), "", func(r dto.MatchReportResponse) any { return r.AwayScore }),
+		prop("homeTeamTotalWins", graphql.NonNullOf(graphql.Int), "Wins of the home team up to this match.", func(r dto.MatchReportResponse) any { return r.HomeTeamTotalWins }),
+		prop("awayTeamTotalWins", graphql.NonNullOf(graphql.Int), "Wins of the away team up to this match.", func(r dto.MatchReportResponse) any { return r.AwayTeamTotalWins }),
+		prop("goals", listOf(reportGoal), "", func(r dto.MatchReportResponse) any { return r.Goals }),
+		prop("topScorer", topScorer, "Null when nobody scored or several players share the most goals.", func(r dto.MatchReportResponse) any { return r.TopScorer }),
+		one("match", graphql.NonNullOf(match), "",
+			func(r dto.MatchReportResponse) string { return r.MatchID },
+			func(ctx context.Context, ids []string, _ graphql.Args) (map[string]dto.MatchResponse, error) {
+				return svc.Graph.MatchesByIDs(ctx, ids)
+			}),
+	}
+	reportGoal.Fields = []*graphql.Field{
+		prop("type", graphql.NonNullOf(graphql.String), "goal, penalty or own_goal.", func(g dto.MatchReportGoal) any { return g.Type }),
+		prop("marker", graphql.String, "OG for own goals, PEN for penalties.", func(g dto.MatchReportGoal) any { return nullable(g.Marker) }),
+		prop("playerName", graphql.NonNullOf(graphql.String), "", func(g dto.MatchReportGoal) any { return g.PlayerName }),
+		prop("teamName", graphql.NonNullOf(graphql.String), "", func(g dto.MatchReportGoal) any { return g.TeamName }),
+		prop("minute", graphql.NonNullOf(graphql.Int), "", func(g dto.MatchReportGoal) any { return g.Minute }),
+		prop("addedTime", graphql.NonNullOf(graphql.Int), "", func(g dto.MatchReportGoal) any { return g.AddedTime }),
+		prop("displayMinute", graphql.NonNullOf(graphql.String), "", func(g dto.MatchReportGoal) any { return g.DisplayMinute }),
+	}
+	topScorer.Fields = []*graphql.Field{
+		prop("playerName", graphql.NonNullOf(graphql.String), "", func(s *dto.TopScorerResponse) any { return s.PlayerName }),
+		prop("teamName", graphql.NonNullOf(graphql.String), "", func(s *dto.TopScorerResponse) any { return s.TeamName }),
+		prop("goalsInMatch", graphql.NonNullOf(graphql.Int), "", func(s *dto.TopScorerResponse) any { return s.GoalsInMatch }),
+	}
+
+	standing.Fields = []*graphql.Field{
+		prop("position", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.Position }),
+		prop("team", nonNullTeam, "", func(s dto.StandingResponse) any { return s.Team }),
+		prop("played", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.Played }),
+		prop("won", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.Won }),
+		prop("drawn", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.Drawn }),
+		prop("lost", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.Lost }),
+		prop("goalsFor", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.GoalsFor }),
+		prop("goalsAgainst", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.GoalsAgainst }),
+		prop("goalDifference", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.GoalDifference }),
+		prop("points", graphql.NonNullOf(graphql.Int), "Including bonus points.", func(s dto.StandingResponse) any { return s.Points }),
+		prop("bonusPoints", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.BonusPoints }),
+		prop("form", graphql.NonNullOf(graphql.String), "Last five results, oldest first: W, D or L.", func(s dto.StandingResponse) any { return s.Form }),
+		prop("winningStreak", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.WinningStreak }),
+		prop("unbeatenStreak", graphql.NonNullOf(graphql.Int), "Consecutive wins or draws up to the latest match.", func(s dto.StandingResponse) any { return s.UnbeatenStreak }),
+		prop("losingStreak", graphql.NonNullOf(graphql.Int), "", func(s dto.StandingResponse) any { return s.LosingStreak }),
+	}
+
+	pageInfo.Fields = []*graphql.Field{
+		prop("page", graphql.NonNullOf(graphql.Int), "", func(m *response.PaginationMeta) any { return m.Page }),
+		prop("perPage", graphql.NonNullOf(graphql.Int), "", func(m *response.PaginationMeta) any { return m.PerPage }),
+		prop("total", graphql.NonNullOf(graphql.Int), "", func(m *response.PaginationMeta) any { return m.Total }),
+		prop("totalPages", graphql.NonNullOf(graphql.Int), "", func(m *response.PaginationMeta) any { return m.TotalPages }),
+	}
+	teamPage.Fields = pageFields[dto.TeamResponse](listOf(team), pageInfo)
+	playerPage.Fields = pageFields[dto.PlayerResponse](listOf(player), pageInfo)
+	matchPage.Fields = pageFields[dto.MatchResponse](listOf(match), pageInfo)
+
+	query := &graphql.Object{Name: "Query", Fields: queryFields(svc, queryTypes{
+		team: team, player: player, match: match, report: report, standing: standing,
+		teamPage: teamPage, playerPage: playerPage, matchPage: matchPage,
+	})}
+	return graphql.NewSchema(query, MaxDepth)
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// AbsenceHandler handles absence-related HTTP requests.
+type AbsenceHandler struct {
+	absenceService service.AbsenceService
+	query          QueryBinder
+}
+
+// NewAbsenceHandler creates a new AbsenceHandler instance.
+func NewAbsenceHandler(absenceService service.AbsenceService, query QueryBinder) *AbsenceHandler {
+	return &AbsenceHandler{absenceService: absenceService, query: query}
+}
+
+// GetAllByPlayerID handles GET /api/v1/players/:id/absences
+// Returns a paginated list of the specified player's injuries and suspensions.
+//
+//	@Summary		List player absences
+//	@Description	Returns a paginated list of the injuries and suspensions recorded for the specified player, latest first
+//	@Tags			Absences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string	true	"Player UUID"
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Success		200			{object}	response.Envelope{data=[]dto.AbsenceResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/players/{id}/absences [get]
+func (h *AbsenceHandler) GetAllByPlayerID(c *gin.Context) {
+	playerID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	pagination, ok := h.query.bindPagination(c)
+	if !ok {
+		return
+	}
+
+	absences, meta, err := h.absenceService.GetAllByPlayerID(c.Request.Context(), playerID, pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Absences retrieved successfully", absences, meta)
+}
+
+// GetByID handles GET /api/v1/absences/:id
+// Returns details of a single absence.
+//
+//	@Summary		Get absence by ID
+//	@Description	Returns details of a single injury or suspension
+//	@Tags			Absences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Absence UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.AbsenceResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/absences/{id} [get]
+func (h *AbsenceHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	absence, err := h.absenceService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Absence retrieved successfully", absence)
+}
+
+// Create handles POST /api/v1/players/:id/absences
+// Records an injury or suspension for the specified player.
+//
+//	@Summary		Record a player absence
+//	@Description	Records an injury or suspension for the specified player. Both dates are inclusive; leave end_date empty while the return date is unknown. The player cannot be named in lineups for matches on those days
+//	@Tags			Absences
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Player UUID"
+//	@Param			request	body		dto.CreateAbsenceRequest	true	"Absence data"
+//	@Success		201		{object}	response.Envelope{data=dto.AbsenceResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/players/{id}/absences [post]
+func (h *AbsenceHandler) Create(c *gin.Context) {
+	playerID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.CreateAbsenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	absence, err := h.absenceService.Create(c.Request.Context(), playerID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Absence created successfully", absence)
+}
+
+// Update handles PUT /api/v1/absences/:id
+// Updates an existing absence.
+//
+//	@Summary		Update an absence
+//	@Description	Updates an existing absence by its UUID
+//	@Tags			Absences
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Absence UUID"
+//	@Param			request	body		dto.UpdateAbsenceRequest	true	"Updated absence data"
+//	@Success		200		{object}	response.Envelope{data=dto.AbsenceResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/absences/{id} [put]
+func (h *AbsenceHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAbsenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	absence, err := h.absenceService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Absence updated successfully", absence)
+}
+
+// Delete handles DELETE /api/v1/absences/:id
+// Soft-deletes an absence.
+//
+//	@Summary		Delete an absence
+//	@Description	Soft-deletes an absence, e.g. one recorded by mistake. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Absences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Absence UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/absences/{id} [delete]
+func (h *AbsenceHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.absenceService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Absence deleted successfully", nil)
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// AdminHandler handles admin account management HTTP requests.
+type AdminHandler struct {
+	adminService service.AdminService
+	query        QueryBinder
+}
+
+// NewAdminHandler creates a new AdminHandler instance.
+func NewAdminHandler(adminService service.AdminService, query QueryBinder) *AdminHandler {
+	return &AdminHandler{adminService: adminService, query: query}
+}
+
+// GetAll handles GET /api/v1/admins
+// Returns a paginated list of all admin accounts.
+//
+//	@Summary		List all admins
+//	@Description	Returns a paginated list of admin accounts. Super admin only
+//	@Tags			Admins
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, username, role)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.AdminDetailResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/admins [get]
+func (h *AdminHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.AdminSorts...)
+	if !ok {
+		return
+	}
+
+	admins, meta, err := h.adminService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Admins retrieved successfully", admins, meta)
+}
+
+// GetByID handles GET /api/v1/admins/:id
+// Returns details of a single admin account.
+//
+//	@Summary		Get admin by ID
+//	@Description	Returns details of a single admin account by its UUID. Super admin only
+//	@Tags			Admins
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Admin UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.AdminDetailResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/admins/{id} [get]
+func (h *AdminHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	admin, err := h.adminService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Admin retrieved successfully", admin)
+}
+
+// Create handles POST /api/v1/admins
+// Creates a new admin account.
+//
+//	@Summary		Create a new admin
+//	@Description	Creates a new admin account with the given role. A team_manager is assigned the teams in team_ids; other roles cannot have teams. Super admin only
+//	@Tags			Admins
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateAdminRequest	true	"Admin data"
+//	@Success		201		{object}	response.Envelope{data=dto.AdminDetailResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/admins [post]
+func (h *AdminHandler) Create(c *gin.Context) {
+	var req dto.CreateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	admin, err := h.adminService.Create(c.Request.Context(), req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Admin created successfully", admin)
+}
+
+// Update handles PUT /api/v1/admins/:id
+// Updates an existing admin account.
+//
+//	@Summary		Update an admin
+//	@Description	Updates username, role, managed teams (team_ids, team_manager only) and optionally password of an admin account. The last super admin cannot be demoted. Super admin only
+//	@Tags			Admins
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Admin UUID"
+//	@Param			request	body		dto.UpdateAdminRequest	true	"Updated admin data"
+//	@Success		200		{object}	response.Envelope{data=dto.AdminDetailResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/admins/{id} [put]
+func (h *AdminHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	admin, err := h.adminService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Admin updated successfully", admin)
+}
+
+// Delete handles DELETE /api/v1/admins/:id
+// Permanently deletes an admin account and revokes its sessions.
+//
+//	@Summary		Delete an admin
+//	@Description	Permanently deletes an admin account and revokes its refresh tokens. The last super admin cannot be deleted. Requires a confirmation token (see POST /confirmations). Super admin only
+//	@Tags			Admins
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Admin UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/admins/{id} [delete]
+func (h *AdminHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.adminService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Admin deleted successfully", nil)
+}
+
+// Unlock handles POST /api/v1/admins/:id/unlock
+// Lifts a login lockout.
+//
+//	@Summary		Unlock an admin
+//	@Description	Lifts a lockout caused by too many failed logins and resets the failed login counter. Super admin only
+//	@Tags			Admins
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Admin UUID"
+//	@Success		200	{object}	response.Envelope
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/admins/{id}/unlock [post]
+func (h *AdminHandler) Unlock(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.adminService.Unlock(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Admin unlocked successfully", nil)
+}
+
+// ChangePassword handles POST /api/v1/auth/change-password (and PUT /api/v1/auth/password)
+// Changes the authenticated admin's own password.
+//
+//	@Summary		Change own password
+//	@Description	Changes the password of the authenticated admin. The current password is required, the new one must follow the password policy and cannot be a recently used password. Clears a required password change; all existing refresh tokens are revoked
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.ChangePasswordRequest	true	"Current and new password"
+//	@Success		200		{object}	response.Envelope
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/auth/change-password [post]
+//	@Router			/auth/password [put]
+func (h *AdminHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.adminService.ChangePassword(c.Request.Context(), adminID, req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Password changed successfully", nil)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// APIKeyHandler handles API key management HTTP requests.
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyService
+	query         QueryBinder
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler instance.
+func NewAPIKeyHandler(apiKeyService service.APIKeyService, query QueryBinder) *APIKeyHandler {
+	return &APIKeyHandler{apiKeyService: apiKeyService, query: query}
+}
+
+// GetAll handles GET /api/v1/api-keys
+// Returns a paginated list of issued API keys, including revoked ones.
+//
+//	@Summary		List API keys
+//	@Description	Returns a paginated list of issued API keys, including revoked and expired ones. Key values are never returned. Super admin only
+//	@Tags			API Keys
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, last_used_at)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.APIKeyResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/api-keys [get]
+func (h *APIKeyHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.APIKeySorts...)
+	if !ok {
+		return
+	}
+
+	keys, meta, err := h.apiKeyService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "API keys retrieved successfully", keys, meta)
+}
+
+// Create handles POST /api/v1/api-keys
+// Issues a new API key for a machine client.
+//
+//	@Summary		Create an API key
+//	@Description	Issues an API key with the given read scopes (teams:read, matches:read, competitions:read, reports:read). The key is only shown in this response; store it securely. Super admin only
+//	@Tags			API Keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateAPIKeyRequest	true	"API key data"
+//	@Success		201		{object}	response.Envelope{data=dto.APIKeyCreatedResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/api-keys [post]
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	key, err := h.apiKeyService.Create(c.Request.Context(), adminID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "API key created successfully", key)
+}
+
+// Revoke handles DELETE /api/v1/api-keys/:id
+// Revokes an API key; it stays listed but can no longer authenticate.
+//
+//	@Summary		Revoke an API key
+//	@Description	Revokes an API key immediately. Revoked keys stay in the list for reference. Super admin only
+//	@Tags			API Keys
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"API key UUID"
+//	@Success		200	{object}	response.Envelope
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/api-keys/{id} [delete]
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.apiKeyService.Revoke(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "API key revoked successfully", nil)
+}
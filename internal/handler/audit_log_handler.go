@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// AuditLogHandler handles audit trail HTTP requests.
+type AuditLogHandler struct {
+	auditService service.AuditService
+	query        QueryBinder
+}
+
+// NewAuditLogHandler creates a new AuditLogHandler instance.
+func NewAuditLogHandler(auditService service.AuditService, query QueryBinder) *AuditLogHandler {
+	return &AuditLogHandler{auditService: auditService, query: query}
+}
+
+// GetAll handles GET /api/v1/audit-logs
+// Returns a paginated, filterable list of audit log entries.
+//
+//	@Summary		List audit logs
+//	@Description	Returns who changed what and when for teams, players, matches (including results, status and lineups), competitions and seasons, newest first. Super admin only
+//	@Tags			Audit
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			admin_id	query		string	false	"Filter by admin UUID"
+//	@Param			entity		query		string	false	"Filter by entity"	Enums(team, player, match, competition, season, group, stadium, referee, coach, absence)
+//	@Param			entity_id	query		string	false	"Filter by entity UUID"
+//	@Param			action		query		string	false	"Filter by action"	Enums(create, update, delete, result_submit, result_update, result_undo, status_change, lineup_set, officials_set, attachment_add, attachment_delete, transfer)
+//	@Param			from		query		string	false	"Earliest date (YYYY-MM-DD, inclusive)"
+//	@Param			to			query		string	false	"Latest date (YYYY-MM-DD, inclusive)"
+//	@Success		200			{object}	response.Envelope{data=[]dto.AuditLogResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/audit-logs [get]
+func (h *AuditLogHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c)
+	if !ok {
+		return
+	}
+	filter, ok := bindAuditLogFilter(c)
+	if !ok {
+		return
+	}
+
+	logs, meta, err := h.auditService.GetAll(c.Request.Context(), pagination, filter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Audit logs retrieved successfully", logs, meta)
+}
@@ -23,7 +23,7 @@ func NewAuthHandler(authService service.AuthService) *AuthHandler {
 // Validates credentials and returns an access + refresh token pair.
 //
 //	@Summary		Admin login
-//	@Description	Authenticate with username and password to receive access and refresh tokens
+//	@Description	Authenticate with username and password to receive access and refresh tokens. Repeated failures lock the account. When admin.must_change_password is true, the tokens only allow changing the password and logging out
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -31,6 +31,8 @@ func NewAuthHandler(authService service.AuthService) *AuthHandler {
 //	@Success		200		{object}	response.Envelope{data=dto.LoginResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		423		{object}	response.Envelope
+//	@Failure		429		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -40,7 +42,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	tokenPair, admin, err := h.authService.Login(req.Username, req.Password)
+	tokenPair, admin, err := h.authService.Login(c.Request.Context(), req.Username, req.Password)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -52,6 +54,9 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Admin: dto.AdminResponse{
 			ID:       admin.ID.String(),
 			Username: admin.Username,
+			Role:     admin.Role,
+			// Also set when the password has expired
+			MustChangePassword: admin.MustChangePassword,
 		},
 	}
 
@@ -79,7 +84,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	tokenPair, err := h.authService.RefreshToken(req.RefreshToken)
+	tokenPair, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -115,10 +120,51 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
-	if err := h.authService.Logout(req.RefreshToken); err != nil {
+	if err := h.authService.Logout(c.Request.Context(), req.RefreshToken); err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
 	response.Success(c, http.StatusOK, "Logout successful", nil)
 }
+
+// LogoutAll handles POST /api/v1/auth/logout-all
+// Invalidates every refresh token of the authenticated admin.
+//
+//	@Summary		Logout from all devices
+//	@Description	Invalidate every refresh token of the authenticated admin. Access tokens already issued remain valid until they expire
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.LogoutAll(c.Request.Context(), adminID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Logged out from all sessions", nil)
+}
+
+// JWKS handles GET /.well-known/jwks.json
+// Publishes the public keys access tokens are signed with, so other services can verify them.
+//
+//	@Summary		JSON Web Key Set
+//	@Description	Public keys for verifying access tokens, in JWKS format (RFC 7517) and not wrapped in the response envelope. Tokens name their key in the kid header. Empty when tokens are signed with a shared HS256 secret
+//	@Tags			Auth
+//	@Produce		json
+//	@Success		200	{object}	jwt.JWKSet
+//	@Router			/.well-known/jwks.json [get]
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	// Verifiers cache the keys; short enough to pick up a rotation quickly
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.authService.JWKS())
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// CoachHandler handles coach-related HTTP requests.
+type CoachHandler struct {
+	coachService service.CoachService
+	query        QueryBinder
+}
+
+// NewCoachHandler creates a new CoachHandler instance.
+func NewCoachHandler(coachService service.CoachService, query QueryBinder) *CoachHandler {
+	return &CoachHandler{coachService: coachService, query: query}
+}
+
+// GetAllByTeamID handles GET /api/v1/teams/:id/coaches
+// Returns a paginated list of the specified team's coaching staff.
+//
+//	@Summary		List coaches by team
+//	@Description	Returns a paginated list of the coaching staff of the specified team
+//	@Tags			Coaches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string	true	"Team UUID"
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, role, contract_end)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.CoachResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/teams/{id}/coaches [get]
+func (h *CoachHandler) GetAllByTeamID(c *gin.Context) {
+	teamID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	pagination, ok := h.query.bindPagination(c, model.CoachSorts...)
+	if !ok {
+		return
+	}
+
+	coaches, meta, err := h.coachService.GetAllByTeamID(c.Request.Context(), teamID, pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Coaches retrieved successfully", coaches, meta)
+}
+
+// GetByID handles GET /api/v1/coaches/:id
+// Returns details of a single coach.
+//
+//	@Summary		Get coach by ID
+//	@Description	Returns details of a single member of a team's coaching staff
+//	@Tags			Coaches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Coach UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.CoachResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/coaches/{id} [get]
+func (h *CoachHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	coach, err := h.coachService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Coach retrieved successfully", coach)
+}
+
+// Create handles POST /api/v1/teams/:id/coaches
+// Adds a coach to the specified team's staff.
+//
+//	@Summary		Add a coach to a team
+//	@Description	Adds a coach to the specified team's staff. A team can have only one head_coach; contract_end must not be before contract_start.
+//	@Tags			Coaches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Team UUID"
+//	@Param			request	body		dto.CreateCoachRequest	true	"Coach data"
+//	@Success		201		{object}	response.Envelope{data=dto.CoachResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/teams/{id}/coaches [post]
+func (h *CoachHandler) Create(c *gin.Context) {
+	teamID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCoachRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	coach, err := h.coachService.Create(c.Request.Context(), teamID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Coach created successfully", coach)
+}
+
+// Update handles PUT /api/v1/coaches/:id
+// Updates an existing coach.
+//
+//	@Summary		Update a coach
+//	@Description	Updates an existing coach by its UUID. A team can have only one head_coach
+//	@Tags			Coaches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Coach UUID"
+//	@Param			request	body		dto.UpdateCoachRequest	true	"Updated coach data"
+//	@Success		200		{object}	response.Envelope{data=dto.CoachResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/coaches/{id} [put]
+func (h *CoachHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCoachRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	coach, err := h.coachService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Coach updated successfully", coach)
+}
+
+// Delete handles DELETE /api/v1/coaches/:id
+// Soft-deletes a coach.
+//
+//	@Summary		Delete a coach
+//	@Description	Soft-deletes a coach. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Coaches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Coach UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/coaches/{id} [delete]
+func (h *CoachHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.coachService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Coach deleted successfully", nil)
+}
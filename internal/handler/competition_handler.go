@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// CompetitionHandler handles competition-related HTTP requests.
+type CompetitionHandler struct {
+	competitionService service.CompetitionService
+	query              QueryBinder
+}
+
+// NewCompetitionHandler creates a new CompetitionHandler instance.
+func NewCompetitionHandler(competitionService service.CompetitionService, query QueryBinder) *CompetitionHandler {
+	return &CompetitionHandler{competitionService: competitionService, query: query}
+}
+
+// GetAll handles GET /api/v1/competitions
+// Returns a paginated list of all competitions.
+//
+//	@Summary		List all competitions
+//	@Description	Returns a paginated list of all competitions (leagues and cups)
+//	@Tags			Competitions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, country)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.CompetitionResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/competitions [get]
+func (h *CompetitionHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.CompetitionSorts...)
+	if !ok {
+		return
+	}
+
+	competitions, meta, err := h.competitionService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Competitions retrieved successfully", competitions, meta)
+}
+
+// GetByID handles GET /api/v1/competitions/:id
+// Returns details of a single competition.
+//
+//	@Summary		Get competition by ID
+//	@Description	Returns details of a single competition by its UUID
+//	@Tags			Competitions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Competition UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.CompetitionResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/competitions/{id} [get]
+func (h *CompetitionHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	competition, err := h.competitionService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition retrieved successfully", competition)
+}
+
+// Create handles POST /api/v1/competitions
+// Creates a new competition.
+//
+//	@Summary		Create a new competition
+//	@Description	Creates a new competition (e.g. "Liga 1"). Points rules left out default to 3 points for a win, 1 for a draw, goal difference then goals scored as tiebreakers and no bonus points.
+//	@Tags			Competitions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateCompetitionRequest	true	"Competition data"
+//	@Success		201		{object}	response.Envelope{data=dto.CompetitionResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/competitions [post]
+func (h *CompetitionHandler) Create(c *gin.Context) {
+	var req dto.CreateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	competition, err := h.competitionService.Create(c.Request.Context(), req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Competition created successfully", competition)
+}
+
+// Update handles PUT /api/v1/competitions/:id
+// Updates an existing competition.
+//
+//	@Summary		Update a competition
+//	@Description	Updates an existing competition by its UUID. The shootout rule and points rules left out are kept; changed rules apply to the standings straight away.
+//	@Tags			Competitions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Competition UUID"
+//	@Param			request	body		dto.UpdateCompetitionRequest	true	"Updated competition data"
+//	@Success		200		{object}	response.Envelope{data=dto.CompetitionResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/competitions/{id} [put]
+func (h *CompetitionHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCompetitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	competition, err := h.competitionService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition updated successfully", competition)
+}
+
+// Delete handles DELETE /api/v1/competitions/:id
+// Soft-deletes a competition.
+//
+//	@Summary		Delete a competition
+//	@Description	Soft-deletes a competition that has no seasons. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Competitions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Competition UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/competitions/{id} [delete]
+func (h *CompetitionHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.competitionService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Competition deleted successfully", nil)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// ConfirmationHandler handles confirmation token requests for destructive operations.
+type ConfirmationHandler struct {
+	confirmationService service.ConfirmationService
+}
+
+// NewConfirmationHandler creates a new ConfirmationHandler instance.
+func NewConfirmationHandler(confirmationService service.ConfirmationService) *ConfirmationHandler {
+	return &ConfirmationHandler{confirmationService: confirmationService}
+}
+
+// Create handles POST /api/v1/confirmations
+// Issues a single-use confirmation token for a destructive action on a resource.
+//
+//	@Summary		Request a confirmation token
+//	@Description	Issues a short-lived, single-use token required (via the X-Confirmation-Token header) by destructive operations such as deleting teams, players or matches
+//	@Tags			Confirmations
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateConfirmationRequest	true	"Action and target resource"
+//	@Success		201		{object}	response.Envelope{data=dto.ConfirmationResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/confirmations [post]
+func (h *ConfirmationHandler) Create(c *gin.Context) {
+	var req dto.CreateConfirmationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	confirmation, err := h.confirmationService.Issue(c.Request.Context(), adminID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Confirmation token issued", confirmation)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"github.com/mhakimsaputra17/xyz-football-api/docs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// DocsHandler serves the Swagger UI and the OpenAPI spec compiled into the binary.
+type DocsHandler struct {
+	version            string
+	username, password string
+	ui                 gin.HandlerFunc
+}
+
+// NewDocsHandler creates a new DocsHandler instance. version replaces the annotated API
+// version in the spec unless it is empty or "dev". With a username and password, the docs
+// are only served to clients sending them as HTTP basic credentials.
+func NewDocsHandler(version, username, password string) *DocsHandler {
+	return &DocsHandler{
+		version:  version,
+		username: username,
+		password: password,
+		ui:       ginSwagger.WrapHandler(swaggerFiles.Handler),
+	}
+}
+
+// Serve handles GET /swagger/*any
+// Serves the spec at /swagger/doc.json, pointed at the host and scheme the request reached
+// the API on, and the Swagger UI files for every other path.
+func (h *DocsHandler) Serve(c *gin.Context) {
+	if h.username != "" || h.password != "" {
+		username, password, ok := c.Request.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(h.username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(h.password)) != 1 {
+			c.Header("WWW-Authenticate", `Basic realm="API docs", charset="UTF-8"`)
+			response.Abort(c, errs.ErrUnauthorized("Invalid documentation credentials"))
+			return
+		}
+	}
+
+	if c.Param("any") != "/doc.json" {
+		h.ui(c)
+		return
+	}
+
+	// A copy, as the template is rendered per request and the registered spec is shared
+	spec := *docs.SwaggerInfo
+	spec.Host = requestHost(c)
+	spec.Schemes = []string{requestScheme(c)}
+	if h.version != "" && h.version != "dev" {
+		spec.Version = h.version
+	}
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Vary", "X-Forwarded-Host, X-Forwarded-Proto")
+	c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(spec.ReadDoc()))
+}
+
+// requestHost returns the host the client addressed, as forwarded by a reverse proxy if there is one.
+func requestHost(c *gin.Context) string {
+	if host := forwardedValue(c.GetHeader("X-Forwarded-Host")); host != "" {
+		return host
+	}
+	return c.Request.Host
+}
+
+// requestScheme returns "https" if the client connected over TLS, directly or to a reverse proxy.
+func requestScheme(c *gin.Context) string {
+	if proto := strings.ToLower(forwardedValue(c.GetHeader("X-Forwarded-Proto"))); proto == "https" || proto == "http" {
+		return proto
+	}
+	if c.Request.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// forwardedValue returns the first, client-facing entry of a comma-separated forwarding header.
+func forwardedValue(header string) string {
+	first, _, _ := strings.Cut(header, ",")
+	return strings.TrimSpace(first)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/graphql"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/i18n"
+)
+
+// GraphQLHandler handles the read-only GraphQL endpoint.
+type GraphQLHandler struct {
+	schema *graphql.Schema
+}
+
+// NewGraphQLHandler creates a new GraphQLHandler instance.
+func NewGraphQLHandler(schema *graphql.Schema) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema}
+}
+
+// Query handles POST /api/v1/graphql
+// Runs a GraphQL query. Responses use the GraphQL format instead of the usual envelope.
+//
+//	@Summary		Run a GraphQL query
+//	@Description	Queries teams, players, matches, goals, match reports and standings as a graph. Nested fields are loaded once per level of the query, not once per parent. Only queries are supported (no mutations or subscriptions) and selections may nest at most 8 levels. Responses follow the GraphQL format: `data` plus an `errors` list. A query that cannot run (syntax, unknown field, bad variable) returns 400 without `data`; errors in individual fields return 200 with those fields set to null. The schema is at GET /graphql/schema
+//	@Tags			GraphQL
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		graphql.Request		true	"GraphQL query, operation name and variables"
+//	@Success		200		{object}	graphql.Response
+//	@Failure		400		{object}	graphql.Response
+//	@Failure		401		{object}	response.Envelope
+//	@Router			/graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req graphql.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	resp := h.schema.Execute(c.Request.Context(), req)
+
+	// Translate service errors and expose their codes, and report the first server error;
+	// the rest usually share its cause (e.g. the database is down)
+	lang := i18n.Language(c.Request.Context())
+	reported := false
+	for _, gqlErr := range resp.Errors {
+		var appErr *errs.AppError
+		if !errors.As(gqlErr, &appErr) {
+			continue
+		}
+		gqlErr.Message = i18n.Translate(lang, appErr.Message)
+		gqlErr.Extensions = map[string]any{"code": appErr.ErrorCode}
+		if appErr.Code >= http.StatusInternalServerError && !reported {
+			middleware.ReportError(c, appErr, appErr.Code, nil)
+			reported = true
+		}
+	}
+
+	status := http.StatusOK
+	if resp.Data == nil {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, resp)
+}
+
+// Schema handles GET /api/v1/graphql/schema
+// Returns the schema definition, for client code generators and as documentation.
+//
+//	@Summary		GraphQL schema
+//	@Description	Returns the GraphQL schema in the schema definition language (SDL). Introspection queries are not supported; use this instead
+//	@Tags			GraphQL
+//	@Produce		plain
+//	@Security		BearerAuth
+//	@Success		200	{string}	string	"Schema definition"
+//	@Failure		401	{object}	response.Envelope
+//	@Router			/graphql/schema [get]
+func (h *GraphQLHandler) Schema(c *gin.Context) {
+	c.String(http.StatusOK, h.schema.SDL())
+}
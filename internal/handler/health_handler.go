@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// HealthHandler handles operational health requests.
+type HealthHandler struct {
+	healthService service.HealthService
+	maintenance   service.MaintenanceService
+}
+
+// NewHealthHandler creates a new HealthHandler instance.
+func NewHealthHandler(healthService service.HealthService, maintenance service.MaintenanceService) *HealthHandler {
+	return &HealthHandler{healthService: healthService, maintenance: maintenance}
+}
+
+// Details handles GET /api/v1/health/details
+// Returns build info, uptime and per-component status for operators.
+//
+//	@Summary		Detailed health report
+//	@Description	Returns version/build info, uptime, and status of the database (latency, pool stats), migrations, cache and background workers
+//	@Tags			Health
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.Envelope{data=dto.HealthDetailsResponse}
+//	@Failure		401	{object}	response.Envelope
+//	@Router			/health/details [get]
+func (h *HealthHandler) Details(c *gin.Context) {
+	details := h.healthService.Details(c.Request.Context())
+	response.Success(c, http.StatusOK, "Health details retrieved successfully", details)
+}
+
+// Live handles GET /health/live (and GET /health)
+// Reports that the process is up. It checks no dependencies, so a database outage
+// does not get the instance restarted. Served outside /api/v1 and the response envelope.
+func (h *HealthHandler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Ready handles GET /health/ready
+// Reports whether the instance can serve traffic: 200 if the database answers a ping
+// (with its latency and connection pool stats), 503 if not. Served outside /api/v1 and the response envelope.
+// In maintenance mode the database is not checked and the instance stays ready, so load balancers
+// keep sending clients to it for the maintenance response rather than an error page of their own.
+func (h *HealthHandler) Ready(c *gin.Context) {
+	if h.maintenance.Status().Enabled {
+		c.JSON(http.StatusOK, dto.ReadinessResponse{Status: dto.ReadinessMaintenance, Components: map[string]dto.ComponentHealth{}, Build: buildinfo.Get()})
+		return
+	}
+
+	readiness := h.healthService.Ready(c.Request.Context())
+	code := http.StatusOK
+	if readiness.Status != dto.ReadinessReady {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, readiness)
+}
+
+// Version handles GET /version
+// Reports the version, commit and build time of the running binary, so operators can tell
+// which build answers behind a load balancer. Served outside /api/v1 and the response envelope.
+func (h *HealthHandler) Version(c *gin.Context) {
+	c.JSON(http.StatusOK, buildinfo.Get())
+}
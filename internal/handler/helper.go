@@ -1,35 +1,79 @@
 package handler
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/export"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/fields"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
 )
 
+// RegisterValidators adds the custom validation tags of the DTOs (see dto.RegisterValidators)
+// to gin's binding. Call it once at startup, before the router serves requests.
+func RegisterValidators() error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return fmt.Errorf("unexpected binding validator %T", binding.Validator.Engine())
+	}
+	return dto.RegisterValidators(v)
+}
+
+// successPage sends a page of items with only the fields listed in the fields query parameter,
+// which the service has checked, or every field without it.
+func successPage[T any](c *gin.Context, message string, items []T, meta *response.PaginationMeta, rawFields string) {
+	data, err := fields.Select(items, fields.Parse(rawFields))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+	response.SuccessWithPagination(c, http.StatusOK, message, data, meta)
+}
+
 // handleServiceError converts service-layer errors (*AppError) into HTTP responses.
+// Server errors are also reported to the error tracker; the cause is in the service's log
+// line with the same request ID.
 func handleServiceError(c *gin.Context, err error) {
 	var appErr *errs.AppError
-	if errors.As(err, &appErr) {
-		response.Error(c, appErr)
-		return
+	if !errors.As(err, &appErr) {
+		// Fallback for unexpected errors — generic 500
+		appErr = errs.ErrInternal("Internal server error")
+	}
+	if appErr.Code >= http.StatusInternalServerError {
+		middleware.ReportError(c, err, appErr.Code, nil)
 	}
-	// Fallback for unexpected errors — generic 500
-	response.Error(c, errs.ErrInternal("Internal server error"))
+	response.Error(c, appErr)
 }
 
 // handleBindingError converts GIN binding/validation errors into structured
 // field-level error responses using errs.ErrValidation.
 // For validator.ValidationErrors it maps each field to a human-readable message.
+// A body cut off by RequestBodyMiddleware's size limit returns 413.
 // For other errors (e.g., malformed JSON) it returns a generic 400.
 func handleBindingError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		response.Error(c, middleware.TooLarge(tooLarge.Limit))
+		return
+	}
+
 	var ve validator.ValidationErrors
 	if !errors.As(err, &ve) {
 		// Not a validation error — likely malformed JSON
@@ -61,13 +105,238 @@ func parseUUID(c *gin.Context, raw string, paramName string) (uuid.UUID, bool) {
 	return id, true
 }
 
-// bindPagination parses pagination query parameters from the request.
-func bindPagination(c *gin.Context) dto.PaginationQuery {
+// setETag sends a record's version as its ETag. Clients send it back in If-Match
+// to update the record only if nobody changed it in the meantime.
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// bindIfMatch copies the version from the If-Match header into *version, which holds the
+// version sent in the request body (0 if none). Sends a 400 error and returns false if the
+// header is not an ETag returned by this API or disagrees with the body.
+func bindIfMatch(c *gin.Context, version *int) bool {
+	header := c.GetHeader("If-Match")
+	if header == "" {
+		return true
+	}
+	// ETagMiddleware appends a body hash to the version ("3-9f86d081..."); only the version matters here
+	tag, _, _ := strings.Cut(strings.Trim(strings.TrimPrefix(header, "W/"), `"`), "-")
+	v, err := strconv.Atoi(tag)
+	if err != nil || v < 1 {
+		response.Error(c, errs.ErrBadRequest("If-Match must be an ETag returned by this API"))
+		return false
+	}
+	if *version != 0 && *version != v {
+		response.Error(c, errs.ErrBadRequest("If-Match and version in the request body do not match"))
+		return false
+	}
+	*version = v
+	return true
+}
+
+// currentAdminID returns the authenticated admin's ID set by AuthMiddleware.
+// Sends a 401 error and returns false if it is missing from the context.
+func currentAdminID(c *gin.Context) (uuid.UUID, bool) {
+	adminID, ok := c.Get(middleware.ContextKeyAdminID)
+	if !ok {
+		response.Error(c, errs.ErrUnauthorized("Authentication required"))
+		return uuid.Nil, false
+	}
+	id, ok := adminID.(uuid.UUID)
+	if !ok {
+		response.Error(c, errs.ErrUnauthorized("Authentication required"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// QueryBinder parses the pagination parameters (page, per_page, sort_by, sort_order) of the
+// listings. Handlers share the one built from the server configuration.
+type QueryBinder struct {
+	strict bool
+}
+
+// NewQueryBinder creates a QueryBinder. With strict set, invalid pagination parameters are
+// rejected instead of falling back to the defaults.
+func NewQueryBinder(strict bool) QueryBinder {
+	return QueryBinder{strict: strict}
+}
+
+// bindQuery binds the query parameters of the request into obj, a pointer to a query DTO.
+// Sends field errors and returns false if any of them is invalid, including values of the
+// wrong type such as per_page=abc.
+func bindQuery(c *gin.Context, obj any) bool {
+	err := c.ShouldBindQuery(obj)
+	if err == nil {
+		return true
+	}
+	if errors.As(err, new(validator.ValidationErrors)) {
+		handleBindingError(c, err)
+		return false
+	}
+	// Values that do not parse into their field fail before validation, without naming the field
+	if fields := queryTypeErrors(c, reflect.TypeOf(obj).Elem()); len(fields) > 0 {
+		response.Error(c, errs.ErrValidation(fields))
+		return false
+	}
+	response.Error(c, errs.ErrBadRequest("Invalid query parameters"))
+	return false
+}
+
+// queryTypeErrors returns an error for every query parameter of struct type t (including its
+// embedded structs) whose value does not parse into the type of its field.
+func queryTypeErrors(c *gin.Context, t reflect.Type) []errs.FieldError {
+	var fields []errs.FieldError
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			fields = append(fields, queryTypeErrors(c, field.Type)...)
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		for _, value := range c.QueryArray(name) {
+			if message := queryTypeMessage(name, field.Type, value); message != "" {
+				fields = append(fields, errs.FieldError{Field: name, Message: message})
+				break
+			}
+		}
+	}
+	return fields
+}
+
+// queryTypeMessage returns why value is not a valid query parameter of type t, or "" if it is.
+func queryTypeMessage(name string, t reflect.Type, value string) string {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if value == "" {
+		return ""
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if _, err := strconv.ParseInt(value, 10, t.Bits()); err != nil {
+			return name + " must be a whole number"
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if _, err := strconv.ParseUint(value, 10, t.Bits()); err != nil {
+			return name + " must be a whole number"
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(value, t.Bits()); err != nil {
+			return name + " must be a number"
+		}
+	case reflect.Bool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return name + " must be true or false"
+		}
+	}
+	return ""
+}
+
+// bindPagination parses the page, per_page, sort_by and sort_order query parameters. sorts are
+// the sort_by values the listing supports (a model.*Sorts list); listings with a fixed order pass
+// none and ignore sort_by. Sends field errors and returns false if any parameter is invalid,
+// unless strict checking is off, in which case invalid values fall back to the defaults.
+func (q QueryBinder) bindPagination(c *gin.Context, sorts ...string) (dto.PaginationQuery, bool) {
 	var pagination dto.PaginationQuery
-	// ShouldBindQuery does not abort on error; defaults are set via Sanitize().
-	_ = c.ShouldBindQuery(&pagination)
+	if !q.strict {
+		_ = c.ShouldBindQuery(&pagination)
+		pagination.Sanitize()
+		return pagination, true
+	}
+
+	if !bindQuery(c, &pagination) {
+		return pagination, false
+	}
+	if len(sorts) > 0 && !slices.Contains(sorts, pagination.SortBy) {
+		response.Error(c, errs.ErrValidation([]errs.FieldError{{
+			Field:   "sort_by",
+			Message: "sort_by must be one of: " + strings.Join(sorts, ", "),
+		}}))
+		return pagination, false
+	}
 	pagination.Sanitize()
-	return pagination
+	return pagination, true
+}
+
+// bindSeasonFilter parses the optional season_id query parameter.
+// Sends a validation error and returns false if it is not a valid UUID.
+func bindSeasonFilter(c *gin.Context) (dto.SeasonFilterQuery, bool) {
+	var filter dto.SeasonFilterQuery
+	if !bindQuery(c, &filter) {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindTeamFilter parses the optional team search query parameters.
+// Sends a validation error and returns false if any of them is invalid.
+func bindTeamFilter(c *gin.Context) (dto.TeamFilterQuery, bool) {
+	var filter dto.TeamFilterQuery
+	if !bindQuery(c, &filter) {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindMatchFilter parses the optional match listing query parameters.
+// Sends a validation error and returns false if any of them is invalid.
+func bindMatchFilter(c *gin.Context) (dto.MatchFilterQuery, bool) {
+	var filter dto.MatchFilterQuery
+	if !bindQuery(c, &filter) {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindPlayerFilter parses the optional player search query parameters.
+// Sends a validation error and returns false if any of them is invalid.
+func bindPlayerFilter(c *gin.Context) (dto.PlayerFilterQuery, bool) {
+	var filter dto.PlayerFilterQuery
+	if !bindQuery(c, &filter) {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindAuditLogFilter parses the optional audit log query parameters.
+// Sends a validation error and returns false if any of them is invalid.
+func bindAuditLogFilter(c *gin.Context) (dto.AuditLogFilterQuery, bool) {
+	var filter dto.AuditLogFilterQuery
+	if !bindQuery(c, &filter) {
+		return filter, false
+	}
+	return filter, true
+}
+
+// bindReportFormat parses the optional format query parameter of report listings.
+// Returns "" for JSON; sends a validation error and returns false for unknown formats.
+func bindReportFormat(c *gin.Context) (export.Format, bool) {
+	var query dto.ReportFormatQuery
+	if !bindQuery(c, &query) {
+		return "", false
+	}
+	if query.Format == "json" {
+		return "", true
+	}
+	return export.Format(query.Format), true
+}
+
+// writeExport sends table as a file download named "<name>-<date>.<format>".
+func writeExport(c *gin.Context, format export.Format, name string, table export.Table) {
+	var buf bytes.Buffer
+	if err := format.Write(&buf, table); err != nil {
+		slog.ErrorContext(c.Request.Context(), "failed to render export", "error", err, "format", format)
+		response.Error(c, errs.ErrInternal("Internal server error"))
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, format.ContentType(), buf.Bytes())
 }
 
 // fieldName extracts a JSON-style field path from a validator.FieldError.
@@ -118,8 +387,42 @@ func validationMessage(fe validator.FieldError) string {
 		return field + " must be a valid URL"
 	case "uuid":
 		return field + " must be a valid UUID"
+	case "eq=|url":
+		return field + " must be a valid URL or empty"
+	case "eq=|uuid":
+		return field + " must be a valid UUID or empty"
+	case "eq=0|min":
+		return field + " must be 0 or at least " + fe.Param()
+	case "iso3166_1_alpha2":
+		return field + " must be an ISO 3166-1 alpha-2 country code such as ID"
+	case "eq=|iso3166_1_alpha2":
+		return field + " must be an ISO 3166-1 alpha-2 country code such as ID, or empty"
 	case "oneof":
 		return field + " must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case dto.TagPosition:
+		return field + " must be one of: " + strings.Join(model.ValidPositions, ", ")
+	case dto.TagMatchStatus:
+		return field + " must be one of: " + strings.Join(model.ValidMatchStatuses, ", ")
+	case dto.TagDate:
+		return field + " must be a date in YYYY-MM-DD format"
+	case "eq=|" + dto.TagDate:
+		return field + " must be a date in YYYY-MM-DD format, or empty"
+	case dto.TagColor:
+		return field + " must be a hex color such as #E30613"
+	case "eq=|" + dto.TagColor:
+		return field + " must be a hex color such as #E30613, or empty"
+	case dto.TagTeamCode:
+		return field + " must be three letters such as PSJ"
+	case "eq=|" + dto.TagTeamCode:
+		return field + " must be three letters such as PSJ, or empty"
+	case dto.TagFoot:
+		return field + " must be one of: " + strings.Join(model.ValidPreferredFeet, ", ")
+	case "eq=|" + dto.TagFoot:
+		return field + " must be one of: " + strings.Join(model.ValidPreferredFeet, ", ") + ", or empty"
+	case dto.TagMatchDatetime:
+		return field + " must be an ISO 8601 date and time such as 2025-06-15T19:30:00+07:00"
+	case dto.TagEventMinute:
+		return field + " must be a match minute such as 67, or 90+3 in added time"
 	default:
 		return field + " is invalid"
 	}
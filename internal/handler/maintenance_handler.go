@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// MaintenanceHandler handles maintenance mode HTTP requests.
+type MaintenanceHandler struct {
+	maintenance service.MaintenanceService
+}
+
+// NewMaintenanceHandler creates a new MaintenanceHandler instance.
+func NewMaintenanceHandler(maintenance service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{maintenance: maintenance}
+}
+
+// Get handles GET /api/v1/maintenance
+// Returns whether maintenance mode is on.
+//
+//	@Summary		Get maintenance mode
+//	@Description	Returns whether maintenance mode is on, with its message and expected end. Super admin only
+//	@Tags			Maintenance
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.Envelope{data=dto.MaintenanceResponse}
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Router			/maintenance [get]
+func (h *MaintenanceHandler) Get(c *gin.Context) {
+	response.Success(c, http.StatusOK, "Maintenance mode retrieved successfully", h.maintenance.Status())
+}
+
+// Enable handles PUT /api/v1/maintenance
+// Switches maintenance mode on.
+//
+//	@Summary		Enable maintenance mode
+//	@Description	Switches maintenance mode on for this instance: every route except health probes, authentication and maintenance mode itself answers 503 with code MAINTENANCE, the message and the expected end (also as Retry-After). Calling it again updates the message and duration. Super admin only
+//	@Tags			Maintenance
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.MaintenanceRequest	false	"Message for clients and expected duration"
+//	@Success		200		{object}	response.Envelope{data=dto.MaintenanceResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Router			/maintenance [put]
+func (h *MaintenanceHandler) Enable(c *gin.Context) {
+	var req dto.MaintenanceRequest
+	// The body is optional
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		handleBindingError(c, err)
+		return
+	}
+
+	status := h.maintenance.Enable(c.Request.Context(), req)
+	response.Success(c, http.StatusOK, "Maintenance mode enabled", status)
+}
+
+// Disable handles DELETE /api/v1/maintenance
+// Switches maintenance mode off.
+//
+//	@Summary		Disable maintenance mode
+//	@Description	Switches maintenance mode off for this instance. Super admin only
+//	@Tags			Maintenance
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.Envelope{data=dto.MaintenanceResponse}
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Router			/maintenance [delete]
+func (h *MaintenanceHandler) Disable(c *gin.Context) {
+	status := h.maintenance.Disable(c.Request.Context())
+	response.Success(c, http.StatusOK, "Maintenance mode disabled", status)
+}
@@ -1,66 +1,277 @@
 package handler
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	dtov2 "github.com/mhakimsaputra17/xyz-football-api/internal/dto/v2"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ical"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/sheet"
+)
+
+// Match stream (Server-Sent Events) settings.
+const (
+	// streamBuffer is how many events a client may lag behind before it is disconnected.
+	streamBuffer = 64
+	// streamHeartbeat is how often a comment line is sent so proxies keep idle streams open.
+	streamHeartbeat = 25 * time.Second
+	// streamRetry is the reconnect delay suggested to EventSource clients.
+	streamRetry = 3 * time.Second
 )
 
 // MatchHandler handles match-related HTTP requests.
 type MatchHandler struct {
-	matchService service.MatchService
+	matchService    service.MatchService
+	lineupService   service.LineupService
+	officialService service.OfficialService
+	discipline      service.DisciplinaryService
+	timelineService service.TimelineService
+	attachments     service.AttachmentService
+	predictions     service.PredictionService
+	feed            *events.Bus
+	query           QueryBinder
 }
 
 // NewMatchHandler creates a new MatchHandler instance.
-func NewMatchHandler(matchService service.MatchService) *MatchHandler {
-	return &MatchHandler{matchService: matchService}
+// feed is the event bus the match stream subscribes to; only match events are streamed.
+func NewMatchHandler(matchService service.MatchService, lineupService service.LineupService, officialService service.OfficialService, discipline service.DisciplinaryService, timelineService service.TimelineService, attachments service.AttachmentService, predictions service.PredictionService, feed *events.Bus, query QueryBinder) *MatchHandler {
+	return &MatchHandler{
+		matchService:    matchService,
+		lineupService:   lineupService,
+		officialService: officialService,
+		discipline:      discipline,
+		timelineService: timelineService,
+		attachments:     attachments,
+		predictions:     predictions,
+		feed:            feed,
+		query:           query,
+	}
 }
 
 // GetAll handles GET /api/v1/matches
 // Returns a paginated list of all matches.
 //
 //	@Summary		List all matches
-//	@Description	Returns a paginated list of all matches with home/away team details
+//	@Description	Returns a paginated list of all matches with home/away team details, optionally filtered by season, round (matchweek), status, team and kick-off date range (dates in the match timezone, inclusive)
 //	@Tags			Matches
 //	@Produce		json
 //	@Security		BearerAuth
+//	@Security		ApiKeyAuth
 //	@Param			page		query		int		false	"Page number"		default(1)
 //	@Param			per_page	query		int		false	"Items per page"	default(10)
-//	@Param			sort_by		query		string	false	"Sort field"		default(created_at)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, match_datetime, round, status, score_difference, home_team_name, away_team_name)	default(created_at)
 //	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			ids		query		string	false	"Comma-separated match UUIDs (at most 100); returns these matches in this order, on one page"
+//	@Param			fields		query		string	false	"Comma-separated match response fields to return, e.g. id,status,home_team; all when empty"
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Param			round		query		int		false	"Round (matchweek) filter"
+//	@Param			status		query		string	false	"Status filter"	Enums(scheduled, live, awaiting_result, completed, postponed, cancelled)
+//	@Param			team_id		query		string	false	"Team UUID filter (home or away)"
+//	@Param			date_from	query		string	false	"Earliest kick-off date (YYYY-MM-DD)"
+//	@Param			date_to		query		string	false	"Latest kick-off date (YYYY-MM-DD)"
 //	@Success		200			{object}	response.Envelope{data=[]dto.MatchResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
 //	@Failure		401			{object}	response.Envelope
 //	@Failure		500			{object}	response.Envelope
 //	@Router			/matches [get]
 func (h *MatchHandler) GetAll(c *gin.Context) {
-	pagination := bindPagination(c)
+	pagination, ok := h.query.bindPagination(c, model.MatchSorts...)
+	if !ok {
+		return
+	}
+	filter, ok := bindMatchFilter(c)
+	if !ok {
+		return
+	}
+
+	matches, meta, err := h.matchService.GetAll(c.Request.Context(), pagination, filter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	successPage(c, "Matches retrieved successfully", matches, meta, filter.Fields)
+}
+
+// GetArchived handles GET /api/v1/archive/matches
+// Returns a paginated list of the matches of archived seasons.
+//
+//	@Summary		List archived matches
+//	@Description	Returns a paginated list of the completed matches of archived seasons, which are left out of GET /matches, standings, reports and statistics. Takes the same filters as GET /matches. Super admin only
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, match_datetime, round, status, score_difference, home_team_name, away_team_name)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			fields		query		string	false	"Comma-separated match response fields to return, e.g. id,status,home_team; all when empty"
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Param			round		query		int		false	"Round (matchweek) filter"
+//	@Param			team_id		query		string	false	"Team UUID filter (home or away)"
+//	@Param			date_from	query		string	false	"Earliest kick-off date (YYYY-MM-DD)"
+//	@Param			date_to		query		string	false	"Latest kick-off date (YYYY-MM-DD)"
+//	@Success		200			{object}	response.Envelope{data=[]dto.MatchResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/archive/matches [get]
+func (h *MatchHandler) GetArchived(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.MatchSorts...)
+	if !ok {
+		return
+	}
+	filter, ok := bindMatchFilter(c)
+	if !ok {
+		return
+	}
+
+	matches, meta, err := h.matchService.GetArchived(c.Request.Context(), pagination, filter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	successPage(c, "Archived matches retrieved successfully", matches, meta, filter.Fields)
+}
+
+// Calendar handles GET /api/v1/matches/calendar.ics
+// Returns the match schedule as an iCalendar feed that calendar apps can subscribe to.
+//
+//	@Summary		Match calendar feed
+//	@Description	Returns all matches as an iCalendar (RFC 5545) feed, optionally filtered by team and season. Public, no authentication required so calendar apps can subscribe to the URL
+//	@Tags			Matches
+//	@Produce		text/calendar
+//	@Param			team_id		query		string	false	"Team UUID filter (home or away)"
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Success		200			{string}	string	"iCalendar feed"
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		429			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/matches/calendar.ics [get]
+func (h *MatchHandler) Calendar(c *gin.Context) {
+	var query dto.MatchCalendarQuery
+	if !bindQuery(c, &query) {
+		return
+	}
 
-	matches, meta, err := h.matchService.GetAll(pagination)
+	cal, err := h.matchService.GetCalendar(c.Request.Context(), query)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
-	response.SuccessWithPagination(c, http.StatusOK, "Matches retrieved successfully", matches, meta)
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, *cal); err != nil {
+		slog.ErrorContext(c.Request.Context(), "failed to render calendar", "error", err)
+		response.Error(c, errs.ErrInternal("Internal server error"))
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="matches.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", buf.Bytes())
+}
+
+// Stream handles GET /api/v1/matches/stream
+// Streams live match updates as Server-Sent Events.
+//
+//	@Summary		Live match feed (SSE)
+//	@Description	Streams match updates as Server-Sent Events for clients that cannot use WebSockets. Event types: match.created (MatchResponse), match.status_changed (MatchStatusChangedEvent), match.goal (MatchGoalEvent, one per goal of a submitted result), match.completed (MatchResponse), match.result_updated (MatchResponse) and match.deleted (MatchDeletedEvent). Only updates made after connecting are sent; on reconnect, reload current state. Public, no authentication required so browsers' EventSource can connect
+//	@Tags			Matches
+//	@Produce		text/event-stream
+//	@Success		200	{string}	string	"Event stream"
+//	@Failure		429	{object}	response.Envelope
+//	@Router			/matches/stream [get]
+func (h *MatchHandler) Stream(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// The stream stays open far longer than the server write timeout allows
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		slog.WarnContext(ctx, "failed to clear write deadline for match stream", "error", err)
+	}
+
+	sub := h.feed.Subscribe(streamBuffer)
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Stop nginx from buffering the stream
+	c.Status(http.StatusOK)
+
+	if _, err := fmt.Fprintf(c.Writer, "retry: %d\n\n", streamRetry.Milliseconds()); err != nil {
+		return
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		case event, ok := <-sub.C():
+			if !ok {
+				// Dropped for falling behind; the client reconnects after the retry delay
+				return
+			}
+			if !strings.HasPrefix(event.Type, "match.") {
+				continue
+			}
+			data, marshalErr := json.Marshal(event.Data)
+			if marshalErr != nil {
+				slog.ErrorContext(ctx, "failed to encode match stream event", "error", marshalErr, "type", event.Type)
+				continue
+			}
+			_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+		}
+		if err != nil {
+			return
+		}
+		c.Writer.Flush()
+	}
 }
 
 // GetByID handles GET /api/v1/matches/:id
-// Returns details of a single match including goals.
+// Returns details of a single match including its events.
 //
 //	@Summary		Get match by ID
-//	@Description	Returns details of a single match including goals, home team, and away team
+//	@Description	Returns details of a single match including events (goals, cards, substitutions), home team, and away team
 //	@Tags			Matches
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id	path		string	true	"Match UUID"
-//	@Success		200	{object}	response.Envelope{data=dto.MatchResponse}
-//	@Failure		400	{object}	response.Envelope
-//	@Failure		401	{object}	response.Envelope
-//	@Failure		404	{object}	response.Envelope
-//	@Failure		500	{object}	response.Envelope
+//	@Security		ApiKeyAuth
+//	@Param			id				path		string	true	"Match UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; returns 304 if the match has not changed"
+//	@Header			200				{string}	ETag	"Match version and body hash, for If-None-Match and for If-Match on update"
+//	@Success		200				{object}	response.Envelope{data=dto.MatchResponse}
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
 //	@Router			/matches/{id} [get]
 func (h *MatchHandler) GetByID(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
@@ -68,20 +279,62 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	match, err := h.matchService.GetByID(id)
+	match, err := h.matchService.GetByID(c.Request.Context(), id)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	setETag(c, match.Version)
 	response.Success(c, http.StatusOK, "Match retrieved successfully", match)
 }
 
+// GetAllV2 handles GET /api/v2/matches
+// Same as GetAll, with each match's kick-off as a single timestamp in the match timezone.
+func (h *MatchHandler) GetAllV2(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.MatchSorts...)
+	if !ok {
+		return
+	}
+	filter, ok := bindMatchFilter(c)
+	if !ok {
+		return
+	}
+	// Field names are those of the v1 response, so v2 always sends every field
+	filter.Fields = ""
+
+	matches, meta, err := h.matchService.GetAll(c.Request.Context(), pagination, filter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Matches retrieved successfully", dtov2.FromMatches(matches), meta)
+}
+
+// GetByIDV2 handles GET /api/v2/matches/:id
+// Same as GetByID, with the kick-off as a single timestamp in the match timezone.
+func (h *MatchHandler) GetByIDV2(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	match, err := h.matchService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, match.Version)
+	response.Success(c, http.StatusOK, "Match retrieved successfully", dtov2.FromMatch(*match))
+}
+
 // Create handles POST /api/v1/matches
 // Creates a new match schedule.
 //
 //	@Summary		Create a new match
-//	@Description	Creates a new match schedule between two different teams
+//	@Description	Creates a new match schedule between two different teams. Without a venue_id the match is played at the home team's stadium. A round (matchweek) can only be set for a match in a season, and fails with 409 if either team already plays in that round
 //	@Tags			Matches
 //	@Accept			json
 //	@Produce		json
@@ -90,7 +343,9 @@ func (h *MatchHandler) GetByID(c *gin.Context) {
 //	@Success		201		{object}	response.Envelope{data=dto.MatchResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/matches [post]
 func (h *MatchHandler) Create(c *gin.Context) {
@@ -100,7 +355,7 @@ func (h *MatchHandler) Create(c *gin.Context) {
 		return
 	}
 
-	match, err := h.matchService.Create(req)
+	match, err := h.matchService.Create(c.Request.Context(), req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -120,10 +375,14 @@ func (h *MatchHandler) Create(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"Match UUID"
 //	@Param			request	body		dto.UpdateMatchRequest	true	"Updated match data"
+//	@Param			If-Match	header		string	false	"ETag from a previous read; fails with 409 if the match changed since"
+//	@Header			200		{string}	ETag	"Match version"
 //	@Success		200		{object}	response.Envelope{data=dto.MatchResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/matches/{id} [put]
 func (h *MatchHandler) Update(c *gin.Context) {
@@ -137,13 +396,63 @@ func (h *MatchHandler) Update(c *gin.Context) {
 		handleBindingError(c, err)
 		return
 	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
 
-	match, err := h.matchService.Update(id, req)
+	match, err := h.matchService.Update(c.Request.Context(), id, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	setETag(c, match.Version)
+	response.Success(c, http.StatusOK, "Match updated successfully", match)
+}
+
+// Patch handles PATCH /api/v1/matches/:id
+// Partially updates an existing match.
+//
+//	@Summary		Partially update a match
+//	@Description	Changes only the schedule fields present in the body, e.g. just match_datetime; omitted fields keep their value. Cannot update a completed match.
+//	@Tags			Matches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string					true	"Match UUID"
+//	@Param			request		body		dto.PatchMatchRequest	true	"Fields to change"
+//	@Param			If-Match	header		string					false	"ETag from a previous read; fails with 409 if the match changed since"
+//	@Header			200			{string}	ETag					"Match version"
+//	@Success		200			{object}	response.Envelope{data=dto.MatchResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		409			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/matches/{id} [patch]
+func (h *MatchHandler) Patch(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.PatchMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
+
+	match, err := h.matchService.Patch(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, match.Version)
 	response.Success(c, http.StatusOK, "Match updated successfully", match)
 }
 
@@ -151,16 +460,19 @@ func (h *MatchHandler) Update(c *gin.Context) {
 // Soft-deletes a match.
 //
 //	@Summary		Delete a match
-//	@Description	Soft-deletes a match by its UUID
+//	@Description	Soft-deletes a match by its UUID. Requires a confirmation token (see POST /confirmations)
 //	@Tags			Matches
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id	path		string	true	"Match UUID"
-//	@Success		200	{object}	response.Envelope
-//	@Failure		400	{object}	response.Envelope
-//	@Failure		401	{object}	response.Envelope
-//	@Failure		404	{object}	response.Envelope
-//	@Failure		500	{object}	response.Envelope
+//	@Param			id						path		string	true	"Match UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
 //	@Router			/matches/{id} [delete]
 func (h *MatchHandler) Delete(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
@@ -168,7 +480,7 @@ func (h *MatchHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.matchService.Delete(id); err != nil {
+	if err := h.matchService.Delete(c.Request.Context(), id); err != nil {
 		handleServiceError(c, err)
 		return
 	}
@@ -177,19 +489,22 @@ func (h *MatchHandler) Delete(c *gin.Context) {
 }
 
 // SubmitResult handles POST /api/v1/matches/:id/result
-// Submits match results (goals), auto-computes scores, transitions status to completed.
+// Submits match results (events), auto-computes scores, transitions status to completed.
+// With ?dry_run=true the result is only validated and its score returned.
 //
 //	@Summary		Submit match result
-//	@Description	Submits goals for a scheduled match, auto-computes scores, and marks the match as completed
+//	@Description	Submits match events (goal, own_goal, penalty, yellow_card, red_card, substitution) for a scheduled or live match, auto-computes scores (own goals count for the opponent), and marks the match as completed. The man of the match (a player of either team, in its lineup if one was recorded), attendance and ticket sales may be recorded with the result. The legacy `goals` list is still accepted. With `dry_run=true` the result goes through every check and the score it would give is returned as a dto.MatchResultPreviewResponse, but nothing is saved
 //	@Tags			Matches
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"Match UUID"
-//	@Param			request	body		dto.MatchResultRequest	true	"Match result with goals"
+//	@Param			dry_run	query		bool					false	"Only validate and preview the score"	default(false)
+//	@Param			request	body		dto.MatchResultRequest	true	"Match result with events"
 //	@Success		200		{object}	response.Envelope{data=dto.MatchResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/matches/{id}/result [post]
@@ -199,13 +514,27 @@ func (h *MatchHandler) SubmitResult(c *gin.Context) {
 		return
 	}
 
+	var query dto.MatchResultQuery
+	if !bindQuery(c, &query) {
+		return
+	}
 	var req dto.MatchResultRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		handleBindingError(c, err)
 		return
 	}
 
-	match, err := h.matchService.SubmitResult(id, req)
+	if query.DryRun {
+		preview, err := h.matchService.PreviewResult(c.Request.Context(), id, req)
+		if err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		response.Success(c, http.StatusOK, "Match result is valid", preview)
+		return
+	}
+
+	match, err := h.matchService.SubmitResult(c.Request.Context(), id, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -218,16 +547,17 @@ func (h *MatchHandler) SubmitResult(c *gin.Context) {
 // Replaces existing match results with new data.
 //
 //	@Summary		Update match result
-//	@Description	Replaces existing goals for a completed match with new result data and recomputes scores
+//	@Description	Replaces existing events for a completed match with new result data and recomputes scores
 //	@Tags			Matches
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"Match UUID"
-//	@Param			request	body		dto.MatchResultRequest	true	"Updated match result with goals"
+//	@Param			request	body		dto.MatchResultRequest	true	"Updated match result with events"
 //	@Success		200		{object}	response.Envelope{data=dto.MatchResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/matches/{id}/result [put]
@@ -243,7 +573,7 @@ func (h *MatchHandler) UpdateResult(c *gin.Context) {
 		return
 	}
 
-	match, err := h.matchService.UpdateResult(id, req)
+	match, err := h.matchService.UpdateResult(c.Request.Context(), id, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -251,3 +581,534 @@ func (h *MatchHandler) UpdateResult(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, "Match result updated successfully", match)
 }
+
+// UndoResult handles DELETE /api/v1/matches/:id/result
+// Reverts a recently submitted result, returning the match to the schedule.
+//
+//	@Summary		Undo match result
+//	@Description	Reverts a completed match to scheduled with a 0-0 score and soft-deletes its events. Only results submitted less than MATCH_RESULT_UNDO_MINUTES ago can be undone; correct older ones with PUT. Super admins only. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Match UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope{data=dto.MatchResponse}
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/matches/{id}/result [delete]
+func (h *MatchHandler) UndoResult(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	match, err := h.matchService.UndoResult(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match result undone successfully", match)
+}
+
+// MaxResultImportFileSize is the largest accepted result import, in JSON or CSV.
+const MaxResultImportFileSize = 1 << 20
+
+// ImportResults handles POST /api/v1/matches/results/import
+// Submits the results of several matches at once, from JSON or CSV.
+//
+//	@Summary		Import match results
+//	@Description	Submits the results of up to 50 matches, such as a matchweek's fixtures, each exactly like POST /matches/{id}/result and in its own transaction. Matches that fail, for example because their result was already submitted, are listed in `errors` and left unchanged; the others are completed. With `Content-Type: text/csv` (max 1 MB) the body is a file with one event per row in the columns match_id, type, player_id, team_id and minute, and optionally related_player_id, home_shootout_score, away_shootout_score and attendance; a match without events has a single row with only its match_id
+//	@Tags			Matches
+//	@Accept			json
+//	@Accept			text/csv
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.MatchResultImportRequest	true	"Match results"
+//	@Success		200		{object}	response.Envelope{data=dto.MatchResultImportResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		413		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/matches/results/import [post]
+func (h *MatchHandler) ImportResults(c *gin.Context) {
+	var (
+		result *dto.MatchResultImportResponse
+		err    error
+	)
+	if c.ContentType() == "text/csv" {
+		data, readErr := io.ReadAll(io.LimitReader(c.Request.Body, MaxResultImportFileSize+1))
+		if readErr != nil {
+			handleBindingError(c, readErr)
+			return
+		}
+		if len(data) > MaxResultImportFileSize {
+			response.Error(c, errs.ErrBadRequest("File must not be larger than 1 MB"))
+			return
+		}
+		rows, parseErr := sheet.Parse("results.csv", data)
+		if parseErr != nil {
+			response.Error(c, errs.ErrBadRequest(parseErr.Error()))
+			return
+		}
+		result, err = h.matchService.ImportResultRows(c.Request.Context(), rows)
+	} else {
+		var req dto.MatchResultImportRequest
+		if bindErr := c.ShouldBindJSON(&req); bindErr != nil {
+			handleBindingError(c, bindErr)
+			return
+		}
+		result, err = h.matchService.ImportResults(c.Request.Context(), req)
+	}
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, fmt.Sprintf("Imported %d of %d match results", result.Imported, result.Total), result)
+}
+
+// UpdateStatus handles POST /api/v1/matches/:id/status
+// Moves a match to another lifecycle status.
+//
+//	@Summary		Change match status
+//	@Description	Moves a match along its lifecycle: scheduled → live / postponed / cancelled, live → postponed, awaiting_result → postponed / cancelled, postponed → scheduled / cancelled. Completed and cancelled matches are final; a match is completed by submitting its result. Matches go live at kick-off and are flagged awaiting_result automatically when no result arrives in time
+//	@Tags			Matches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Match UUID"
+//	@Param			request	body		dto.MatchStatusRequest	true	"New status"
+//	@Success		200		{object}	response.Envelope{data=dto.MatchResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/matches/{id}/status [post]
+func (h *MatchHandler) UpdateStatus(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.MatchStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	match, err := h.matchService.UpdateStatus(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match status updated successfully", match)
+}
+
+// GetTimeline handles GET /api/v1/matches/:id/timeline
+// Returns the chronological feed of a match for live tickers.
+//
+//	@Summary		Get match timeline
+//	@Description	Returns goals, cards, substitutions and status changes of a match in chronological order. Events are placed by match minute with the running score after each goal; status changes by the minutes since kick-off, which are negative before it. Full time always comes last
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.MatchTimelineResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/timeline [get]
+func (h *MatchHandler) GetTimeline(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	timeline, err := h.timelineService.GetTimeline(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match timeline retrieved successfully", timeline)
+}
+
+// GetAttachments handles GET /api/v1/matches/:id/attachments
+// Returns the documents filed with a match.
+//
+//	@Summary		List match attachments
+//	@Description	Returns the documents filed with a match, such as referee reports, oldest first. Editors and super admins only
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=[]dto.MatchAttachmentResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/attachments [get]
+func (h *MatchHandler) GetAttachments(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	attachments, err := h.attachments.List(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match attachments retrieved successfully", attachments)
+}
+
+// UploadAttachment handles POST /api/v1/matches/:id/attachments
+// Files a document with a match.
+//
+//	@Summary		Upload a match attachment
+//	@Description	Files a PDF, JPEG or PNG document (max 4 MB) with a match, such as the referee's report. The type is detected from the file contents. Editors and super admins only
+//	@Tags			Matches
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Match UUID"
+//	@Param			file	formData	file	true	"PDF, JPEG or PNG file"
+//	@Success		201		{object}	response.Envelope{data=dto.MatchAttachmentResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		413		{object}	response.Envelope
+//	@Failure		415		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/matches/{id}/attachments [post]
+func (h *MatchHandler) UploadAttachment(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, middleware.TooLarge(tooLarge.Limit))
+			return
+		}
+		response.Error(c, errs.ErrBadRequest("A file is required in the 'file' form field"))
+		return
+	}
+	if header.Size > service.MaxAttachmentSize {
+		response.Error(c, errs.ErrBadRequest("Attachment must not be larger than 4 MB"))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, service.MaxAttachmentSize))
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+
+	attachment, err := h.attachments.Upload(c.Request.Context(), id, header.Filename, data)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Match attachment uploaded successfully", attachment)
+}
+
+// DownloadAttachment handles GET /api/v1/matches/:id/attachments/:attachmentId
+// Sends the file of a match attachment.
+//
+//	@Summary		Download a match attachment
+//	@Description	Sends the attached file with its original name as a download. Editors and super admins only
+//	@Tags			Matches
+//	@Produce		application/pdf,image/jpeg,image/png,json
+//	@Security		BearerAuth
+//	@Param			id				path		string	true	"Match UUID"
+//	@Param			attachmentId	path		string	true	"Attachment UUID"
+//	@Success		200				{file}		file
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		403				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/matches/{id}/attachments/{attachmentId} [get]
+func (h *MatchHandler) DownloadAttachment(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+	attachmentID, ok := parseUUID(c, c.Param("attachmentId"), "attachmentId")
+	if !ok {
+		return
+	}
+
+	file, err := h.attachments.Download(c.Request.Context(), id, attachmentID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
+// DeleteAttachment handles DELETE /api/v1/matches/:id/attachments/:attachmentId
+// Removes a match attachment.
+//
+//	@Summary		Delete a match attachment
+//	@Description	Removes an attachment and its file from the match. Editors and super admins only
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id				path		string	true	"Match UUID"
+//	@Param			attachmentId	path		string	true	"Attachment UUID"
+//	@Success		200				{object}	response.Envelope
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		403				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/matches/{id}/attachments/{attachmentId} [delete]
+func (h *MatchHandler) DeleteAttachment(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+	attachmentID, ok := parseUUID(c, c.Param("attachmentId"), "attachmentId")
+	if !ok {
+		return
+	}
+
+	if err := h.attachments.Delete(c.Request.Context(), id, attachmentID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match attachment deleted successfully", nil)
+}
+
+// GetLineup handles GET /api/v1/matches/:id/lineup
+// Returns the starting XI and substitutes of both teams.
+//
+//	@Summary		Get match lineup
+//	@Description	Returns the starting XI and substitutes recorded for the home and away teams. A team without a recorded lineup is null
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.MatchLineupResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/lineup [get]
+func (h *MatchHandler) GetLineup(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	lineup, err := h.lineupService.GetLineup(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match lineup retrieved successfully", lineup)
+}
+
+// SetLineup handles POST /api/v1/matches/:id/lineup
+// Records or replaces one team's lineup for a match.
+//
+//	@Summary		Set match lineup
+//	@Description	Records the starting XI (at most 11 players) and substitutes for one team of the match, replacing any previous lineup of that team. All players must belong to the team
+//	@Tags			Matches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Match UUID"
+//	@Param			request	body		dto.LineupRequest	true	"Team lineup"
+//	@Success		200		{object}	response.Envelope{data=dto.MatchLineupResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/matches/{id}/lineup [post]
+func (h *MatchHandler) SetLineup(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.LineupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	lineup, err := h.lineupService.SetLineup(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match lineup saved successfully", lineup)
+}
+
+// GetOfficials handles GET /api/v1/matches/:id/officials
+// Returns the referee and assistant referees assigned to a match.
+//
+//	@Summary		Get match officials
+//	@Description	Returns the referee and assistant referees assigned to the match. The referee is null and assistants empty until officials are assigned
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.MatchOfficialsResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/officials [get]
+func (h *MatchHandler) GetOfficials(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	officials, err := h.officialService.GetOfficials(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials retrieved successfully", officials)
+}
+
+// SetOfficials handles POST /api/v1/matches/:id/officials
+// Assigns or replaces the officials of a match.
+//
+//	@Summary		Set match officials
+//	@Description	Assigns the referee and up to two assistant referees to the match, replacing any previous assignment. A referee can hold only one role per match
+//	@Tags			Matches
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Match UUID"
+//	@Param			request	body		dto.MatchOfficialsRequest	true	"Match officials"
+//	@Success		200		{object}	response.Envelope{data=dto.MatchOfficialsResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/matches/{id}/officials [post]
+func (h *MatchHandler) SetOfficials(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.MatchOfficialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	officials, err := h.officialService.SetOfficials(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match officials saved successfully", officials)
+}
+
+// GetSuspensions handles GET /api/v1/matches/:id/suspensions
+// Lists the players of both teams who are suspended for the match.
+//
+//	@Summary		Get match suspensions
+//	@Description	Returns the players of both teams banned from the match for cards in their team's earlier matches of the same season: a red card or two yellow cards in one match ban the player for the next match, and so does every MATCH_YELLOW_CARD_LIMIT-th yellow card. Suspended players are rejected from lineups and results
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.MatchSuspensionsResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/suspensions [get]
+func (h *MatchHandler) GetSuspensions(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	suspensions, err := h.discipline.GetMatchSuspensions(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match suspensions retrieved successfully", suspensions)
+}
+
+// GetPrediction handles GET /api/v1/matches/:id/prediction
+// Returns a naive estimate of the match outcome from its teams' history.
+//
+//	@Summary		Get match prediction
+//	@Description	Estimates the probabilities of a home win, a draw and an away win from the teams' results before kick-off: the latest five matches of each team and their latest ten meetings. For a completed match the prediction is the one it had before it was played, with the `actual` outcome and whether the prediction was `correct`. Outcomes are decided by the score, not by a penalty shootout. `strategy` names the model that made the prediction
+//	@Tags			Matches
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.MatchPredictionResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/matches/{id}/prediction [get]
+func (h *MatchHandler) GetPrediction(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	prediction, err := h.predictions.GetPrediction(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Match prediction retrieved successfully", prediction)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// NotificationHandler handles the authenticated admin's email notification settings.
+type NotificationHandler struct {
+	notificationService service.NotificationService
+}
+
+// NewNotificationHandler creates a new NotificationHandler instance.
+func NewNotificationHandler(notificationService service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationService: notificationService}
+}
+
+// GetPreferences handles GET /api/v1/auth/notifications
+// Returns the authenticated admin's email notification settings.
+//
+//	@Summary		Get own notification preferences
+//	@Description	Returns the address the authenticated admin receives email notifications at and which notifications are enabled. All notifications are off until set
+//	@Tags			Auth
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	response.Envelope{data=dto.NotificationPreferencesResponse}
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/auth/notifications [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	prefs, err := h.notificationService.GetPreferences(c.Request.Context(), adminID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Notification preferences retrieved successfully", prefs)
+}
+
+// UpdatePreferences handles PUT /api/v1/auth/notifications
+// Replaces the authenticated admin's email notification settings.
+//
+//	@Summary		Update own notification preferences
+//	@Description	Sets the address the authenticated admin receives email notifications at and enables or disables result submitted, match postponed and weekly standings digest emails. An email address is required while any notification is enabled
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.UpdateNotificationPreferencesRequest	true	"Notification preferences"
+//	@Success		200		{object}	response.Envelope{data=dto.NotificationPreferencesResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/auth/notifications [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	prefs, err := h.notificationService.UpdatePreferences(c.Request.Context(), adminID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Notification preferences updated successfully", prefs)
+}
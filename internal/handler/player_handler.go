@@ -1,42 +1,105 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/sheet"
 )
 
 // PlayerHandler handles player-related HTTP requests.
 type PlayerHandler struct {
 	playerService service.PlayerService
+	statsService  service.StatsService
+	query         QueryBinder
 }
 
 // NewPlayerHandler creates a new PlayerHandler instance.
-func NewPlayerHandler(playerService service.PlayerService) *PlayerHandler {
-	return &PlayerHandler{playerService: playerService}
+func NewPlayerHandler(playerService service.PlayerService, statsService service.StatsService, query QueryBinder) *PlayerHandler {
+	return &PlayerHandler{playerService: playerService, statsService: statsService, query: query}
+}
+
+// GetAll handles GET /api/v1/players
+// Returns a paginated list of players across all teams, narrowed by optional search filters.
+//
+//	@Summary		Search players
+//	@Description	Returns a paginated list of players across all teams with their team. Filters are optional and combinable; `name` is a case-insensitive substring match and height/weight accept min/max ranges; `age_min`/`age_max` are ages today, in whole years, and leave out players without a birth date (e.g. `age_max=22` for under-23s); `available_on` leaves out players injured or suspended on that date
+//	@Tags			Players
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			page			query		int		false	"Page number"		default(1)
+//	@Param			per_page		query		int		false	"Items per page"	default(10)
+//	@Param			sort_by			query		string	false	"Sort field"		Enums(created_at, name, jersey_number, position, height, weight, birth_date, market_value)	default(created_at)
+//	@Param			sort_order		query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			ids			query		string	false	"Comma-separated player UUIDs (at most 100); returns these players in this order, on one page"
+//	@Param			fields			query		string	false	"Comma-separated player response fields to return, e.g. id,name; all when empty"
+//	@Param			team_id			query		string	false	"Filter by team UUID"
+//	@Param			name			query		string	false	"Search by player name"
+//	@Param			position		query		string	false	"Filter by position"	Enums(penyerang, gelandang, bertahan, penjaga_gawang)
+//	@Param			jersey_number	query		int		false	"Filter by jersey number"
+//	@Param			height_min		query		int		false	"Minimum height (cm)"
+//	@Param			height_max		query		int		false	"Maximum height (cm)"
+//	@Param			weight_min		query		int		false	"Minimum weight (kg)"
+//	@Param			weight_max		query		int		false	"Maximum weight (kg)"
+//	@Param			nationality		query		string	false	"Filter by nationality (ISO 3166-1 alpha-2 code)"
+//	@Param			preferred_foot	query		string	false	"Filter by preferred foot"	Enums(left, right, both)
+//	@Param			age_min			query		int		false	"Minimum age (years)"
+//	@Param			age_max			query		int		false	"Maximum age (years)"
+//	@Param			available_on	query		string	false	"Only players available on this date (YYYY-MM-DD)"
+//	@Success		200				{object}	response.Envelope{data=[]dto.PlayerResponse,meta=response.PaginationMeta}
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/players [get]
+func (h *PlayerHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.PlayerSorts...)
+	if !ok {
+		return
+	}
+	filter, ok := bindPlayerFilter(c)
+	if !ok {
+		return
+	}
+
+	players, meta, err := h.playerService.GetAll(c.Request.Context(), pagination, filter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	successPage(c, "Players retrieved successfully", players, meta, filter.Fields)
 }
 
 // GetAllByTeamID handles GET /api/v1/teams/:id/players
 // Returns a paginated list of players belonging to the specified team.
 //
 //	@Summary		List players by team
-//	@Description	Returns a paginated list of players belonging to the specified team
+//	@Description	Returns a paginated list of players belonging to the specified team, optionally only those not injured or suspended on available_on
 //	@Tags			Players
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id			path		string	true	"Team UUID"
-//	@Param			page		query		int		false	"Page number"		default(1)
-//	@Param			per_page	query		int		false	"Items per page"	default(10)
-//	@Param			sort_by		query		string	false	"Sort field"		default(created_at)
-//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
-//	@Success		200			{object}	response.Envelope{data=[]dto.PlayerResponse,meta=response.PaginationMeta}
-//	@Failure		400			{object}	response.Envelope
-//	@Failure		401			{object}	response.Envelope
-//	@Failure		404			{object}	response.Envelope
-//	@Failure		500			{object}	response.Envelope
+//	@Security		ApiKeyAuth
+//	@Param			id				path		string	true	"Team UUID"
+//	@Param			page			query		int		false	"Page number"		default(1)
+//	@Param			per_page		query		int		false	"Items per page"	default(10)
+//	@Param			sort_by			query		string	false	"Sort field"		Enums(created_at, name, jersey_number, position, height, weight, birth_date, market_value)	default(created_at)
+//	@Param			sort_order		query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			available_on	query		string	false	"Only players available on this date (YYYY-MM-DD)"
+//	@Success		200				{object}	response.Envelope{data=[]dto.PlayerResponse,meta=response.PaginationMeta}
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
 //	@Router			/teams/{id}/players [get]
 func (h *PlayerHandler) GetAllByTeamID(c *gin.Context) {
 	teamID, ok := parseUUID(c, c.Param("id"), "id")
@@ -44,9 +107,17 @@ func (h *PlayerHandler) GetAllByTeamID(c *gin.Context) {
 		return
 	}
 
-	pagination := bindPagination(c)
+	pagination, ok := h.query.bindPagination(c, model.PlayerSorts...)
+	if !ok {
+		return
+	}
 
-	players, meta, err := h.playerService.GetAllByTeamID(teamID, pagination)
+	var availability dto.AvailabilityQuery
+	if !bindQuery(c, &availability) {
+		return
+	}
+
+	players, meta, err := h.playerService.GetAllByTeamID(c.Request.Context(), teamID, pagination, availability)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -63,26 +134,91 @@ func (h *PlayerHandler) GetAllByTeamID(c *gin.Context) {
 //	@Tags			Players
 //	@Produce		json
 //	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id				path		string	true	"Player UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; returns 304 if the player has not changed"
+//	@Header			200				{string}	ETag	"Player version and body hash, for If-None-Match and for If-Match on update"
+//	@Success		200				{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/players/{id} [get]
+func (h *PlayerHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	player, err := h.playerService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, player.Version)
+	response.Success(c, http.StatusOK, "Player retrieved successfully", player)
+}
+
+// GetBySlug handles GET /api/v1/players/by-slug/:slug
+// Returns details of a single player by its slug, for readable URLs.
+//
+//	@Summary		Get player by slug
+//	@Description	Returns details of a single player by its URL-friendly slug (e.g. "marko-simic"), derived from the name
+//	@Tags			Players
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			slug			path		string	true	"Player slug"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; returns 304 if the player has not changed"
+//	@Header			200				{string}	ETag	"Player version and body hash, for If-None-Match and for If-Match on update"
+//	@Success		200				{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Success		304				"Not modified"
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/players/by-slug/{slug} [get]
+func (h *PlayerHandler) GetBySlug(c *gin.Context) {
+	player, err := h.playerService.GetBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, player.Version)
+	response.Success(c, http.StatusOK, "Player retrieved successfully", player)
+}
+
+// GetStats handles GET /api/v1/players/:id/stats
+// Returns a player's aggregated statistics.
+//
+//	@Summary		Get player statistics
+//	@Description	Returns matches played, goals, goals per match and the minutes of the player's earliest and latest goals over completed matches, in total and per season. A player counts as having played in a match when named in the starting XI or involved in one of its events (including coming on as a substitute). Own goals are not counted
+//	@Tags			Players
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
 //	@Param			id	path		string	true	"Player UUID"
-//	@Success		200	{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Success		200	{object}	response.Envelope{data=dto.PlayerStatsResponse}
 //	@Failure		400	{object}	response.Envelope
 //	@Failure		401	{object}	response.Envelope
 //	@Failure		404	{object}	response.Envelope
 //	@Failure		500	{object}	response.Envelope
-//	@Router			/players/{id} [get]
-func (h *PlayerHandler) GetByID(c *gin.Context) {
+//	@Router			/players/{id}/stats [get]
+func (h *PlayerHandler) GetStats(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
 	if !ok {
 		return
 	}
 
-	player, err := h.playerService.GetByID(id)
+	stats, err := h.statsService.GetPlayerStats(c.Request.Context(), id)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
-	response.Success(c, http.StatusOK, "Player retrieved successfully", player)
+	response.Success(c, http.StatusOK, "Player statistics retrieved successfully", stats)
 }
 
 // Create handles POST /api/v1/teams/:id/players
@@ -99,6 +235,7 @@ func (h *PlayerHandler) GetByID(c *gin.Context) {
 //	@Success		201		{object}	response.Envelope{data=dto.PlayerResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
 //	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
@@ -115,7 +252,7 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 		return
 	}
 
-	player, err := h.playerService.Create(teamID, req)
+	player, err := h.playerService.Create(c.Request.Context(), teamID, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -124,6 +261,146 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 	response.Success(c, http.StatusCreated, "Player created successfully", player)
 }
 
+// maxPlayerImportFileSize is the largest accepted player import upload.
+const maxPlayerImportFileSize = 2 << 20
+
+// Import handles POST /api/v1/teams/:id/players/import
+// Creates players in bulk from an uploaded CSV or XLSX file.
+//
+//	@Summary		Import players from a file
+//	@Description	Creates players under the specified team from a CSV or XLSX file (max 2 MB, 200 rows). The first row must name the columns name, position, jersey_number, height and weight, and may add nationality, birth_date (YYYY-MM-DD), preferred_foot and market_value. Every row is validated; valid rows are inserted in a single transaction and invalid rows are listed in `errors` with their row number
+//	@Tags			Players
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Team UUID"
+//	@Param			file	formData	file	true	"CSV or XLSX file"
+//	@Success		200		{object}	response.Envelope{data=dto.PlayerImportResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		413		{object}	response.Envelope
+//	@Failure		415		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/teams/{id}/players/import [post]
+func (h *PlayerHandler) Import(c *gin.Context) {
+	teamID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, middleware.TooLarge(tooLarge.Limit))
+			return
+		}
+		response.Error(c, errs.ErrBadRequest("A CSV or XLSX file is required in the 'file' form field"))
+		return
+	}
+	if header.Size > maxPlayerImportFileSize {
+		response.Error(c, errs.ErrBadRequest("File must not be larger than 2 MB"))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxPlayerImportFileSize))
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+
+	rows, err := sheet.Parse(header.Filename, data)
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest(err.Error()))
+		return
+	}
+
+	result, err := h.playerService.Import(c.Request.Context(), teamID, rows)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, fmt.Sprintf("Imported %d of %d players", result.Imported, result.TotalRows), result)
+}
+
+// maxPlayerPhotoFileSize is the largest accepted player photo upload.
+const maxPlayerPhotoFileSize = 4 << 20
+
+// UploadPhoto handles POST /api/v1/players/:id/photo
+// Stores a player's headshot with a thumbnail.
+//
+//	@Summary		Upload a player photo
+//	@Description	Stores a JPEG, PNG or GIF headshot (max 4 MB) for the player, replacing any earlier photo. The photo is scaled down to at most 800 pixels on its longest side and a 160 × 160 thumbnail is cropped from its center; both are saved as JPEG and returned as `photo_url` and `thumbnail_url`
+//	@Tags			Players
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Player UUID"
+//	@Param			file	formData	file	true	"JPEG, PNG or GIF image"
+//	@Success		200		{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		413		{object}	response.Envelope
+//	@Failure		415		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/players/{id}/photo [post]
+func (h *PlayerHandler) UploadPhoto(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.Error(c, middleware.TooLarge(tooLarge.Limit))
+			return
+		}
+		response.Error(c, errs.ErrBadRequest("An image file is required in the 'file' form field"))
+		return
+	}
+	if header.Size > maxPlayerPhotoFileSize {
+		response.Error(c, errs.ErrBadRequest("Photo must not be larger than 4 MB"))
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxPlayerPhotoFileSize))
+	if err != nil {
+		response.Error(c, errs.ErrBadRequest("Failed to read uploaded file"))
+		return
+	}
+
+	player, err := h.playerService.UploadPhoto(c.Request.Context(), id, data)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player photo uploaded successfully", player)
+}
+
 // Update handles PUT /api/v1/players/:id
 // Updates an existing player.
 //
@@ -135,9 +412,12 @@ func (h *PlayerHandler) Create(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"Player UUID"
 //	@Param			request	body		dto.UpdatePlayerRequest	true	"Updated player data"
+//	@Param			If-Match	header		string	false	"ETag from a previous read; fails with 409 if the player changed since"
+//	@Header			200		{string}	ETag	"Player version"
 //	@Success		200		{object}	response.Envelope{data=dto.PlayerResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
 //	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
@@ -153,30 +433,123 @@ func (h *PlayerHandler) Update(c *gin.Context) {
 		handleBindingError(c, err)
 		return
 	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
 
-	player, err := h.playerService.Update(id, req)
+	player, err := h.playerService.Update(c.Request.Context(), id, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	setETag(c, player.Version)
 	response.Success(c, http.StatusOK, "Player updated successfully", player)
 }
 
+// Patch handles PATCH /api/v1/players/:id
+// Partially updates an existing player.
+//
+//	@Summary		Partially update a player
+//	@Description	Changes only the fields present in the body; omitted fields keep their value. Jersey number must remain unique within the team.
+//	@Tags			Players
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string					true	"Player UUID"
+//	@Param			request		body		dto.PatchPlayerRequest	true	"Fields to change"
+//	@Param			If-Match	header		string					false	"ETag from a previous read; fails with 409 if the player changed since"
+//	@Header			200			{string}	ETag					"Player version"
+//	@Success		200			{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		409			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/players/{id} [patch]
+func (h *PlayerHandler) Patch(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.PatchPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
+
+	player, err := h.playerService.Patch(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, player.Version)
+	response.Success(c, http.StatusOK, "Player updated successfully", player)
+}
+
+// Transfer handles POST /api/v1/players/:id/transfer
+// Moves a player to another team.
+//
+//	@Summary		Transfer a player
+//	@Description	Moves a player to another team, keeping the jersey number unless a new one is given. The number must be free in the new team. Past match events and lineups are unchanged.
+//	@Tags			Players
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Player UUID"
+//	@Param			request	body		dto.TransferPlayerRequest	true	"Destination team"
+//	@Success		200		{object}	response.Envelope{data=dto.PlayerResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/players/{id}/transfer [post]
+func (h *PlayerHandler) Transfer(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.TransferPlayerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	player, err := h.playerService.Transfer(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Player transferred successfully", player)
+}
+
 // Delete handles DELETE /api/v1/players/:id
 // Soft-deletes a player.
 //
 //	@Summary		Delete a player
-//	@Description	Soft-deletes a player by its UUID
+//	@Description	Soft-deletes a player by its UUID. Requires a confirmation token (see POST /confirmations)
 //	@Tags			Players
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id	path		string	true	"Player UUID"
-//	@Success		200	{object}	response.Envelope
-//	@Failure		400	{object}	response.Envelope
-//	@Failure		401	{object}	response.Envelope
-//	@Failure		404	{object}	response.Envelope
-//	@Failure		500	{object}	response.Envelope
+//	@Param			id						path		string	true	"Player UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
 //	@Router			/players/{id} [delete]
 func (h *PlayerHandler) Delete(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
@@ -184,7 +557,7 @@ func (h *PlayerHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.playerService.Delete(id); err != nil {
+	if err := h.playerService.Delete(c.Request.Context(), id); err != nil {
 		handleServiceError(c, err)
 		return
 	}
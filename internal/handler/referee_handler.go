@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// RefereeHandler handles referee-related HTTP requests.
+type RefereeHandler struct {
+	refereeService service.RefereeService
+	query          QueryBinder
+}
+
+// NewRefereeHandler creates a new RefereeHandler instance.
+func NewRefereeHandler(refereeService service.RefereeService, query QueryBinder) *RefereeHandler {
+	return &RefereeHandler{refereeService: refereeService, query: query}
+}
+
+// GetAll handles GET /api/v1/referees
+// Returns a paginated list of all referees.
+//
+//	@Summary		List all referees
+//	@Description	Returns a paginated list of all referees
+//	@Tags			Referees
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, country)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.RefereeResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/referees [get]
+func (h *RefereeHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.RefereeSorts...)
+	if !ok {
+		return
+	}
+
+	referees, meta, err := h.refereeService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Referees retrieved successfully", referees, meta)
+}
+
+// GetByID handles GET /api/v1/referees/:id
+// Returns details of a single referee.
+//
+//	@Summary		Get referee by ID
+//	@Description	Returns details of a single referee by its UUID
+//	@Tags			Referees
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Referee UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.RefereeResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/referees/{id} [get]
+func (h *RefereeHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	referee, err := h.refereeService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Referee retrieved successfully", referee)
+}
+
+// Create handles POST /api/v1/referees
+// Creates a new referee.
+//
+//	@Summary		Create a new referee
+//	@Description	Creates a new referee that can be assigned to matches
+//	@Tags			Referees
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateRefereeRequest	true	"Referee data"
+//	@Success		201		{object}	response.Envelope{data=dto.RefereeResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/referees [post]
+func (h *RefereeHandler) Create(c *gin.Context) {
+	var req dto.CreateRefereeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	referee, err := h.refereeService.Create(c.Request.Context(), req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Referee created successfully", referee)
+}
+
+// Update handles PUT /api/v1/referees/:id
+// Updates an existing referee.
+//
+//	@Summary		Update a referee
+//	@Description	Updates an existing referee by its UUID
+//	@Tags			Referees
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Referee UUID"
+//	@Param			request	body		dto.UpdateRefereeRequest	true	"Updated referee data"
+//	@Success		200		{object}	response.Envelope{data=dto.RefereeResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/referees/{id} [put]
+func (h *RefereeHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateRefereeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	referee, err := h.refereeService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Referee updated successfully", referee)
+}
+
+// Delete handles DELETE /api/v1/referees/:id
+// Soft-deletes a referee.
+//
+//	@Summary		Delete a referee
+//	@Description	Soft-deletes a referee that is not assigned to any match. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Referees
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Referee UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/referees/{id} [delete]
+func (h *RefereeHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.refereeService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Referee deleted successfully", nil)
+}
+
+// GetMatches handles GET /api/v1/referees/:id/matches
+// Returns the matches a referee is assigned to.
+//
+//	@Summary		List matches of a referee
+//	@Description	Returns a paginated list of the matches the referee is assigned to, with the role held in each (referee or assistant), latest kick-off first
+//	@Tags			Referees
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string	true	"Referee UUID"
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Success		200			{object}	response.Envelope{data=[]dto.RefereeMatchResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/referees/{id}/matches [get]
+func (h *RefereeHandler) GetMatches(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	pagination, ok := h.query.bindPagination(c)
+	if !ok {
+		return
+	}
+
+	matches, meta, err := h.refereeService.GetMatches(c.Request.Context(), id, pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Referee matches retrieved successfully", matches, meta)
+}
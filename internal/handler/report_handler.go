@@ -1,44 +1,94 @@
 package handler
 
 import (
+	"bytes"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	_ "github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/export"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
 )
 
 // ReportHandler handles report-related HTTP requests.
 type ReportHandler struct {
-	reportService service.ReportService
+	reportService    service.ReportService
+	standingsService service.StandingsService
+	historyService   service.StandingsHistoryService
+	ratingService    service.RatingService
+	organization     string   // Issuer printed on match report documents
+	signatures       []string // Who signs match report documents
+	query            QueryBinder
 }
 
-// NewReportHandler creates a new ReportHandler instance.
-func NewReportHandler(reportService service.ReportService) *ReportHandler {
-	return &ReportHandler{reportService: reportService}
+// NewReportHandler creates a new ReportHandler instance. organization and signatures brand
+// the printable match reports.
+func NewReportHandler(reportService service.ReportService, standingsService service.StandingsService, historyService service.StandingsHistoryService, ratingService service.RatingService, organization string, signatures []string, query QueryBinder) *ReportHandler {
+	return &ReportHandler{
+		reportService:    reportService,
+		standingsService: standingsService,
+		historyService:   historyService,
+		ratingService:    ratingService,
+		organization:     organization,
+		signatures:       signatures,
+		query:            query,
+	}
 }
 
 // GetMatchReports handles GET /api/v1/reports/matches
-// Returns a paginated list of all completed match reports.
+// Returns a paginated list of all completed match reports, or every report as a CSV/PDF download.
 //
 //	@Summary		List match reports
-//	@Description	Returns a paginated list of completed match reports with results summary
+//	@Description	Returns a paginated list of completed match reports with results summary, optionally filtered by season. With `format=csv` or `format=pdf` every matching report is downloaded as a file instead (pagination is ignored)
 //	@Tags			Reports
 //	@Produce		json
+//	@Produce		text/csv
+//	@Produce		application/pdf
 //	@Security		BearerAuth
+//	@Security		ApiKeyAuth
 //	@Param			page		query		int		false	"Page number"		default(1)
 //	@Param			per_page	query		int		false	"Items per page"	default(10)
 //	@Param			sort_by		query		string	false	"Sort field"		default(created_at)
 //	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Param			format		query		string	false	"Response format"	Enums(json, csv, pdf)	default(json)
 //	@Success		200			{object}	response.Envelope{data=[]dto.MatchReportListItem,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
 //	@Failure		401			{object}	response.Envelope
 //	@Failure		500			{object}	response.Envelope
 //	@Router			/reports/matches [get]
 func (h *ReportHandler) GetMatchReports(c *gin.Context) {
-	pagination := bindPagination(c)
+	pagination, ok := h.query.bindPagination(c)
+	if !ok {
+		return
+	}
+	seasonFilter, ok := bindSeasonFilter(c)
+	if !ok {
+		return
+	}
+	format, ok := bindReportFormat(c)
+	if !ok {
+		return
+	}
 
-	reports, meta, err := h.reportService.GetMatchReports(pagination)
+	if format != "" {
+		reports, err := h.reportService.GetAllMatchReports(c.Request.Context(), seasonFilter)
+		if err != nil {
+			handleServiceError(c, err)
+			return
+		}
+		writeExport(c, format, "match-reports", matchReportsTable(reports, seasonFilter))
+		return
+	}
+
+	reports, meta, err := h.reportService.GetMatchReports(c.Request.Context(), pagination, seasonFilter)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -51,10 +101,11 @@ func (h *ReportHandler) GetMatchReports(c *gin.Context) {
 // Returns a detailed report for a single completed match.
 //
 //	@Summary		Get match report by ID
-//	@Description	Returns a detailed report for a completed match including goals, top scorer, match result, and accumulated total wins
+//	@Description	Returns a detailed report for a completed match including goals (with type), the full event timeline (cards, substitutions), top scorer, man of the match, match result, and accumulated total wins
 //	@Tags			Reports
 //	@Produce		json
 //	@Security		BearerAuth
+//	@Security		ApiKeyAuth
 //	@Param			id	path		string	true	"Match UUID"
 //	@Success		200	{object}	response.Envelope{data=dto.MatchReportResponse}
 //	@Failure		400	{object}	response.Envelope
@@ -68,7 +119,7 @@ func (h *ReportHandler) GetMatchReportByID(c *gin.Context) {
 		return
 	}
 
-	report, err := h.reportService.GetMatchReportByID(id)
+	report, err := h.reportService.GetMatchReportByID(c.Request.Context(), id)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -76,3 +127,479 @@ func (h *ReportHandler) GetMatchReportByID(c *gin.Context) {
 
 	response.Success(c, http.StatusOK, "Match report retrieved successfully", report)
 }
+
+// GetMatchReportPDF handles GET /api/v1/reports/matches/:id/pdf
+// Downloads the official, printable report of a completed match.
+//
+//	@Summary		Download match report PDF
+//	@Description	Downloads the official report of a completed match as an A4 PDF ready to print and archive: match details, officials, lineups, goals, cards, substitutions and a signature block. The issuer and signatories are set with REPORT_ORGANIZATION and REPORT_SIGNATURES
+//	@Tags			Reports
+//	@Produce		application/pdf
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Match UUID"
+//	@Success		200	{file}		file	"Match report document"
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/reports/matches/{id}/pdf [get]
+func (h *ReportHandler) GetMatchReportPDF(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.GetMatchReportByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteDocument(&buf, h.matchReportDocument(*report)); err != nil {
+		slog.ErrorContext(c.Request.Context(), "failed to render match report document", "error", err, "match_id", id)
+		response.Error(c, errs.ErrInternal("Internal server error"))
+		return
+	}
+
+	filename := fmt.Sprintf("match-report-%s.pdf", report.MatchID)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, export.FormatPDF.ContentType(), buf.Bytes())
+}
+
+// GetRoundReport handles GET /api/v1/reports/rounds/:round
+// Returns a summary of the results of a round (matchweek).
+//
+//	@Summary		Get round report
+//	@Description	Summarizes a round (matchweek): how many of its matches have a result, the goals scored, home wins, away wins and draws, and each completed match with its result, in kick-off order. Filter by `season_id` to report one season's round; without it the round is combined across seasons
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			round		path		int		true	"Round number"	minimum(1)
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Success		200			{object}	response.Envelope{data=dto.RoundReportResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/rounds/{round} [get]
+func (h *ReportHandler) GetRoundReport(c *gin.Context) {
+	round, err := strconv.Atoi(c.Param("round"))
+	if err != nil || round < 1 {
+		response.Error(c, errs.ErrBadRequest("Invalid round, expected a number of 1 or more"))
+		return
+	}
+	seasonFilter, ok := bindSeasonFilter(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.GetRoundReport(c.Request.Context(), round, seasonFilter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Round report retrieved successfully", report)
+}
+
+// GetStandings handles GET /api/v1/reports/standings
+// Returns the league table computed from completed matches, as JSON or a CSV/PDF download.
+//
+//	@Summary		Get standings
+//	@Description	Returns the league table (played, won, drawn, lost, goals, points) computed from completed matches, optionally for a single season, with each team's last five results (`form`, oldest first) and current winning, unbeaten and losing streaks. With `format=csv` or `format=pdf` the table is downloaded as a file
+//	@Tags			Reports
+//	@Produce		json
+//	@Produce		text/csv
+//	@Produce		application/pdf
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Param			format		query		string	false	"Response format"	Enums(json, csv, pdf)	default(json)
+//	@Success		200			{object}	response.Envelope{data=[]dto.StandingResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/standings [get]
+func (h *ReportHandler) GetStandings(c *gin.Context) {
+	seasonFilter, ok := bindSeasonFilter(c)
+	if !ok {
+		return
+	}
+
+	format, ok := bindReportFormat(c)
+	if !ok {
+		return
+	}
+
+	standings, err := h.standingsService.GetStandings(c.Request.Context(), seasonFilter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	if format != "" {
+		writeExport(c, format, "standings", standingsTable(standings, seasonFilter))
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Standings retrieved successfully", standings)
+}
+
+// GetStandingsHistory handles GET /api/v1/reports/standings/history
+// Returns a team's league position after every round, for charting.
+//
+//	@Summary		Get standings history
+//	@Description	Returns a team's position, points and goal difference in the league table after every round, oldest first, as recorded when each match was completed. A round is the most matches played by any team; when several matches end a round the table after the last one is shown. Without `season_id` the positions are in the all-time table
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			team_id		query		string	true	"Team UUID"
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Success		200			{object}	response.Envelope{data=dto.StandingHistoryResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/standings/history [get]
+func (h *ReportHandler) GetStandingsHistory(c *gin.Context) {
+	var query dto.StandingHistoryQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	history, err := h.historyService.GetHistory(c.Request.Context(), query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Standings history retrieved successfully", history)
+}
+
+// GetRatings handles GET /api/v1/reports/ratings
+// Returns the current Elo rating of every team.
+//
+//	@Summary		Get team ratings
+//	@Description	Returns the current Elo rating of every team, highest first, with the number of rated matches and the change from the team's latest one. Ratings start at 1500 and are replayed from every completed result, archived seasons included, whenever a result is recorded, corrected or undone. Each match moves its teams' ratings by up to MATCH_RATING_K_FACTOR points; the score decides, not a penalty shootout
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	response.Envelope{data=[]dto.TeamRatingResponse}
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/reports/ratings [get]
+func (h *ReportHandler) GetRatings(c *gin.Context) {
+	ratings, err := h.ratingService.GetRatings(c.Request.Context())
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Team ratings retrieved successfully", ratings)
+}
+
+// GetRatingHistory handles GET /api/v1/reports/ratings/history
+// Returns a team's Elo rating after each of its matches, for charting.
+//
+//	@Summary		Get rating history
+//	@Description	Returns a team's Elo rating and the change in it after each of its completed matches, oldest first, with its current rating
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			team_id	query		string	true	"Team UUID"
+//	@Success		200		{object}	response.Envelope{data=dto.RatingHistoryResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/reports/ratings/history [get]
+func (h *ReportHandler) GetRatingHistory(c *gin.Context) {
+	var query dto.RatingHistoryQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	history, err := h.ratingService.GetHistory(c.Request.Context(), query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Rating history retrieved successfully", history)
+}
+
+// GetGoalsByTeam handles GET /api/v1/reports/goals-by-team
+// Returns the goals every team scored and conceded per round of a season, for charting.
+//
+//	@Summary		Get goals by team
+//	@Description	Returns the goals every team scored and conceded in each round of a season, from its completed matches. `rounds` lists the rounds with a result and every team has one `scored` and one `conceded` value per round, in the same order, ready to plot as chart series; a value is null when the team has no completed match in that round. Matches without a round are left out
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			season_id	query		string	true	"Season UUID"
+//	@Success		200			{object}	response.Envelope{data=dto.GoalsByTeamResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/goals-by-team [get]
+func (h *ReportHandler) GetGoalsByTeam(c *gin.Context) {
+	var query dto.SeasonReportQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	goals, err := h.reportService.GetGoalsByTeam(c.Request.Context(), query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Goals by team retrieved successfully", goals)
+}
+
+// GetAttendance handles GET /api/v1/reports/attendance
+// Returns the average attendance per home team and per stadium.
+//
+//	@Summary		Get attendance report
+//	@Description	Summarizes the attendance recorded with the results of completed matches: the overall total and average, and for every home team and stadium the matches, total, average and highest attendance, highest average first. Stadiums with a known capacity include their average occupancy in percent. Matches without a recorded attendance are left out. Filter by `season_id` for a season summary
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Success		200			{object}	response.Envelope{data=dto.AttendanceReportResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/attendance [get]
+func (h *ReportHandler) GetAttendance(c *gin.Context) {
+	seasonFilter, ok := bindSeasonFilter(c)
+	if !ok {
+		return
+	}
+
+	attendance, err := h.reportService.GetAttendance(c.Request.Context(), seasonFilter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Attendance report retrieved successfully", attendance)
+}
+
+// GetManOfTheMatchLeaderboard handles GET /api/v1/reports/man-of-the-match
+// Returns the players of a season ranked by man of the match awards.
+//
+//	@Summary		Get man of the match leaderboard
+//	@Description	Ranks the players of a season by the number of its completed matches they were named man of the match in, most first. Players with as many awards share a position. Teams are the players' current teams
+//	@Tags			Reports
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			season_id	query		string	true	"Season UUID"
+//	@Success		200			{object}	response.Envelope{data=dto.ManOfTheMatchLeaderboardResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/reports/man-of-the-match [get]
+func (h *ReportHandler) GetManOfTheMatchLeaderboard(c *gin.Context) {
+	var query dto.SeasonReportQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	leaderboard, err := h.reportService.GetManOfTheMatchLeaderboard(c.Request.Context(), query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Man of the match leaderboard retrieved successfully", leaderboard)
+}
+
+// matchReportsTable lays out match report summaries for export.
+func matchReportsTable(reports []dto.MatchReportListItem, seasonFilter dto.SeasonFilterQuery) export.Table {
+	rows := make([][]string, len(reports))
+	for i, r := range reports {
+		// Local kick-off is RFC 3339 with the offset of the match timezone
+		date, clock := r.LocalDatetime, ""
+		if kickoff, err := time.Parse(time.RFC3339, r.LocalDatetime); err == nil {
+			date, clock = kickoff.Format("2006-01-02"), kickoff.Format("15:04")
+		}
+		rows[i] = []string{
+			date,
+			clock,
+			r.HomeTeam.Name,
+			strconv.Itoa(r.HomeScore),
+			strconv.Itoa(r.AwayScore),
+			r.AwayTeam.Name,
+			penalties(r.HomeShootoutScore, r.AwayShootoutScore),
+			r.MatchResult,
+		}
+	}
+	subtitle := exportSubtitle(seasonFilter)
+	if len(reports) > 0 {
+		subtitle += " - kick-off times in " + reports[0].Timezone
+	}
+	return export.Table{
+		Title:    "Match Reports",
+		Subtitle: subtitle,
+		Columns:  []string{"Date", "Time", "Home Team", "Home Score", "Away Score", "Away Team", "Penalties", "Result"},
+		Rows:     rows,
+	}
+}
+
+// penalties formats a shootout score for export, empty for matches without one.
+func penalties(home, away *int) string {
+	if home == nil || away == nil {
+		return ""
+	}
+	return strconv.Itoa(*home) + "-" + strconv.Itoa(*away)
+}
+
+// matchReportDocument lays out the official report of a completed match.
+func (h *ReportHandler) matchReportDocument(r dto.MatchReportResponse) export.Document {
+	kickoff := r.LocalDatetime
+	if t, err := time.Parse(time.RFC3339, r.LocalDatetime); err == nil {
+		kickoff = t.Format("Monday 2 January 2006, 15:04") + " (" + r.Timezone + ")"
+	}
+	venue := "-"
+	if r.Venue != nil {
+		venue = r.Venue.Name
+		if r.Venue.City != "" {
+			venue += ", " + r.Venue.City
+		}
+	}
+	match := export.Section{
+		Heading: "Match",
+		Fields: []export.Field{
+			{Label: "Home team", Value: r.HomeTeam.Name},
+			{Label: "Away team", Value: r.AwayTeam.Name},
+			{Label: "Kick-off", Value: kickoff},
+			{Label: "Venue", Value: venue},
+			{Label: "Final score", Value: r.HomeTeam.Name + " " + r.ScoreLine + " " + r.AwayTeam.Name},
+			{Label: "Result", Value: r.MatchResult},
+			{Label: "Top scorer", Value: "-"},
+		},
+	}
+	if r.TopScorer != nil {
+		match.Fields[len(match.Fields)-1].Value = fmt.Sprintf("%s (%s), %d", r.TopScorer.PlayerName, r.TopScorer.TeamName, r.TopScorer.GoalsInMatch)
+	}
+	if r.ManOfTheMatch != nil {
+		match.Fields = append(match.Fields, export.Field{Label: "Man of the match", Value: fmt.Sprintf("%s (%s)", r.ManOfTheMatch.PlayerName, r.ManOfTheMatch.TeamName)})
+	}
+
+	officials := export.Section{Heading: "Officials", Fields: []export.Field{{Label: "Referee", Value: "Not assigned"}}}
+	if r.Referee != nil {
+		officials.Fields[0].Value = refereeName(*r.Referee)
+	}
+	for i, assistant := range r.Assistants {
+		officials.Fields = append(officials.Fields, export.Field{Label: fmt.Sprintf("Assistant %d", i+1), Value: refereeName(assistant)})
+	}
+
+	goals := make([][]string, len(r.Goals))
+	for i, g := range r.Goals {
+		goals[i] = []string{g.DisplayMinute, g.TeamName, g.PlayerName, g.Marker}
+	}
+	var cards, substitutions [][]string
+	for _, e := range r.Events {
+		switch e.Type {
+		case model.EventYellowCard:
+			cards = append(cards, []string{e.DisplayMinute, e.TeamName, e.PlayerName, "Yellow"})
+		case model.EventRedCard:
+			cards = append(cards, []string{e.DisplayMinute, e.TeamName, e.PlayerName, "Red"})
+		case model.EventSubstitution:
+			substitutions = append(substitutions, []string{e.DisplayMinute, e.TeamName, e.RelatedPlayerName, e.PlayerName})
+		}
+	}
+
+	return export.Document{
+		Brand:    h.organization,
+		Title:    "Official Match Report",
+		Subtitle: r.HomeTeam.Name + " vs " + r.AwayTeam.Name,
+		Footer:   "Match " + r.MatchID + " - generated " + time.Now().UTC().Format("2006-01-02 15:04 UTC"),
+		Sections: []export.Section{
+			match,
+			officials,
+			{Heading: "Starting Lineups", Table: lineupTable(r, func(l *dto.TeamLineupResponse) []dto.LineupPlayerResponse { return l.Starters }), Empty: "No lineups recorded"},
+			{Heading: "Substitutes", Table: lineupTable(r, func(l *dto.TeamLineupResponse) []dto.LineupPlayerResponse { return l.Substitutes }), Empty: "No substitutes recorded"},
+			{Heading: "Goals", Table: &export.Table{Columns: []string{"Minute", "Team", "Player", "Note"}, Rows: goals}, Empty: "No goals"},
+			{Heading: "Cards", Table: &export.Table{Columns: []string{"Minute", "Team", "Player", "Card"}, Rows: cards}, Empty: "No cards"},
+			{Heading: "Substitutions", Table: &export.Table{Columns: []string{"Minute", "Team", "Player On", "Player Off"}, Rows: substitutions}, Empty: "No substitutions"},
+		},
+		Signatures: h.signatures,
+	}
+}
+
+// lineupTable pairs one list of each team's lineup, home on the left, with jersey numbers.
+func lineupTable(r dto.MatchReportResponse, players func(*dto.TeamLineupResponse) []dto.LineupPlayerResponse) *export.Table {
+	var home, away []dto.LineupPlayerResponse
+	if r.HomeLineup != nil {
+		home = players(r.HomeLineup)
+	}
+	if r.AwayLineup != nil {
+		away = players(r.AwayLineup)
+	}
+	rows := make([][]string, max(len(home), len(away)))
+	for i := range rows {
+		rows[i] = make([]string, 4)
+		if i < len(home) {
+			rows[i][0], rows[i][1] = strconv.Itoa(home[i].JerseyNumber), home[i].Name
+		}
+		if i < len(away) {
+			rows[i][2], rows[i][3] = strconv.Itoa(away[i].JerseyNumber), away[i].Name
+		}
+	}
+	return &export.Table{Columns: []string{"No", r.HomeTeam.Name, "No", r.AwayTeam.Name}, Rows: rows}
+}
+
+// refereeName formats a match official with their country.
+func refereeName(r dto.RefereeResponse) string {
+	if r.Country == "" {
+		return r.Name
+	}
+	return r.Name + " (" + r.Country + ")"
+}
+
+// standingsTable lays out the league table for export.
+func standingsTable(standings []dto.StandingResponse, seasonFilter dto.SeasonFilterQuery) export.Table {
+	rows := make([][]string, len(standings))
+	for i, s := range standings {
+		rows[i] = []string{
+			strconv.Itoa(s.Position),
+			s.Team.Name,
+			strconv.Itoa(s.Played),
+			strconv.Itoa(s.Won),
+			strconv.Itoa(s.Drawn),
+			strconv.Itoa(s.Lost),
+			strconv.Itoa(s.GoalsFor),
+			strconv.Itoa(s.GoalsAgainst),
+			strconv.Itoa(s.GoalDifference),
+			strconv.Itoa(s.Points),
+			s.Form,
+		}
+	}
+	return export.Table{
+		Title:    "Standings",
+		Subtitle: exportSubtitle(seasonFilter),
+		Columns:  []string{"Position", "Team", "Played", "Won", "Drawn", "Lost", "Goals For", "Goals Against", "Goal Difference", "Points", "Form"},
+		Rows:     rows,
+	}
+}
+
+// exportSubtitle describes the season scope and generation time of an exported report.
+func exportSubtitle(seasonFilter dto.SeasonFilterQuery) string {
+	scope := "All seasons"
+	if seasonFilter.SeasonID != "" {
+		scope = "Season " + seasonFilter.SeasonID
+	}
+	return scope + " - generated " + time.Now().UTC().Format("2006-01-02 15:04 UTC")
+}
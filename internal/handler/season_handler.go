@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// SeasonHandler handles season-related HTTP requests, including the groups of a season's group stage.
+type SeasonHandler struct {
+	seasonService service.SeasonService
+	groupService  service.GroupService
+	query         QueryBinder
+}
+
+// NewSeasonHandler creates a new SeasonHandler instance.
+func NewSeasonHandler(seasonService service.SeasonService, groupService service.GroupService, query QueryBinder) *SeasonHandler {
+	return &SeasonHandler{seasonService: seasonService, groupService: groupService, query: query}
+}
+
+// GetAllByCompetitionID handles GET /api/v1/competitions/:id/seasons
+// Returns a paginated list of seasons belonging to the specified competition.
+//
+//	@Summary		List seasons by competition
+//	@Description	Returns a paginated list of seasons belonging to the specified competition
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string	true	"Competition UUID"
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, start_date)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.SeasonResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/competitions/{id}/seasons [get]
+func (h *SeasonHandler) GetAllByCompetitionID(c *gin.Context) {
+	competitionID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	pagination, ok := h.query.bindPagination(c, model.SeasonSorts...)
+	if !ok {
+		return
+	}
+
+	seasons, meta, err := h.seasonService.GetAllByCompetitionID(c.Request.Context(), competitionID, pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Seasons retrieved successfully", seasons, meta)
+}
+
+// GetByID handles GET /api/v1/seasons/:id
+// Returns details of a single season.
+//
+//	@Summary		Get season by ID
+//	@Description	Returns details of a single season including its competition
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Season UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.SeasonResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/seasons/{id} [get]
+func (h *SeasonHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	season, err := h.seasonService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season retrieved successfully", season)
+}
+
+// Create handles POST /api/v1/competitions/:id/seasons
+// Creates a new season under the specified competition.
+//
+//	@Summary		Create a new season
+//	@Description	Creates a new season under the specified competition. end_date must not be before start_date.
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Competition UUID"
+//	@Param			request	body		dto.CreateSeasonRequest	true	"Season data"
+//	@Success		201		{object}	response.Envelope{data=dto.SeasonResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/competitions/{id}/seasons [post]
+func (h *SeasonHandler) Create(c *gin.Context) {
+	competitionID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.CreateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	season, err := h.seasonService.Create(c.Request.Context(), competitionID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Season created successfully", season)
+}
+
+// Update handles PUT /api/v1/seasons/:id
+// Updates an existing season.
+//
+//	@Summary		Update a season
+//	@Description	Updates an existing season by its UUID
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string					true	"Season UUID"
+//	@Param			request	body		dto.UpdateSeasonRequest	true	"Updated season data"
+//	@Success		200		{object}	response.Envelope{data=dto.SeasonResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/seasons/{id} [put]
+func (h *SeasonHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	season, err := h.seasonService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season updated successfully", season)
+}
+
+// Delete handles DELETE /api/v1/seasons/:id
+// Soft-deletes a season.
+//
+//	@Summary		Delete a season
+//	@Description	Soft-deletes a season that has no matches assigned. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Season UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/seasons/{id} [delete]
+func (h *SeasonHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.seasonService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Season deleted successfully", nil)
+}
+
+// GetGroups handles GET /api/v1/seasons/:id/groups
+// Returns the groups of a season's group stage with their teams.
+//
+//	@Summary		List groups by season
+//	@Description	Returns the groups of a season's group stage with their teams, by name
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Season UUID"
+//	@Success		200	{object}	response.Envelope{data=[]dto.GroupResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/seasons/{id}/groups [get]
+func (h *SeasonHandler) GetGroups(c *gin.Context) {
+	seasonID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	groups, err := h.groupService.GetBySeasonID(c.Request.Context(), seasonID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Groups retrieved successfully", groups)
+}
+
+// GetGroupStandings handles GET /api/v1/seasons/:id/groups/standings
+// Returns the table of every group of a season and the teams in qualifying places.
+//
+//	@Summary		Get group standings
+//	@Description	Returns the table of every group of a season. A group table counts the season's completed matches between teams of the group and lists every team of the group; the top qualifiers places are marked as qualifying and their teams listed in qualified, in table order, to seed the knockout stage.
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Season UUID"
+//	@Success		200	{object}	response.Envelope{data=[]dto.GroupStandingsResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/seasons/{id}/groups/standings [get]
+func (h *SeasonHandler) GetGroupStandings(c *gin.Context) {
+	seasonID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	standings, err := h.groupService.GetStandings(c.Request.Context(), seasonID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group standings retrieved successfully", standings)
+}
+
+// CreateGroup handles POST /api/v1/seasons/:id/groups
+// Adds a group with its teams to a season's group stage.
+//
+//	@Summary		Create a group
+//	@Description	Adds a group with its teams to a season's group stage. A team can play in only one group of a season; qualifiers (2 by default) cannot exceed the number of teams.
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Season UUID"
+//	@Param			request	body		dto.GroupRequest	true	"Group data"
+//	@Success		201		{object}	response.Envelope{data=dto.GroupResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/seasons/{id}/groups [post]
+func (h *SeasonHandler) CreateGroup(c *gin.Context) {
+	seasonID, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.GroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	group, err := h.groupService.Create(c.Request.Context(), seasonID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Group created successfully", group)
+}
+
+// GetGroup handles GET /api/v1/groups/:id
+// Returns a group with its teams.
+//
+//	@Summary		Get group by ID
+//	@Description	Returns a group of a season's group stage with its teams
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Group UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.GroupResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/groups/{id} [get]
+func (h *SeasonHandler) GetGroup(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	group, err := h.groupService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group retrieved successfully", group)
+}
+
+// UpdateGroup handles PUT /api/v1/groups/:id
+// Renames a group and replaces its teams and number of qualifiers.
+//
+//	@Summary		Update a group
+//	@Description	Renames a group and replaces its teams and number of qualifiers. Matches already played are kept.
+//	@Tags			Seasons
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Group UUID"
+//	@Param			request	body		dto.GroupRequest	true	"Updated group data"
+//	@Success		200		{object}	response.Envelope{data=dto.GroupResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/groups/{id} [put]
+func (h *SeasonHandler) UpdateGroup(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.GroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	group, err := h.groupService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group updated successfully", group)
+}
+
+// DeleteGroup handles DELETE /api/v1/groups/:id
+// Removes a group from its season's group stage.
+//
+//	@Summary		Delete a group
+//	@Description	Removes a group from its season's group stage, freeing its teams to join another group. Matches are kept.
+//	@Tags			Seasons
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Group UUID"
+//	@Success		200	{object}	response.Envelope
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/groups/{id} [delete]
+func (h *SeasonHandler) DeleteGroup(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.groupService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Group deleted successfully", nil)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// StadiumHandler handles stadium-related HTTP requests.
+type StadiumHandler struct {
+	stadiumService service.StadiumService
+	query          QueryBinder
+}
+
+// NewStadiumHandler creates a new StadiumHandler instance.
+func NewStadiumHandler(stadiumService service.StadiumService, query QueryBinder) *StadiumHandler {
+	return &StadiumHandler{stadiumService: stadiumService, query: query}
+}
+
+// GetAll handles GET /api/v1/stadiums
+// Returns a paginated list of all stadiums.
+//
+//	@Summary		List all stadiums
+//	@Description	Returns a paginated list of all stadiums
+//	@Tags			Stadiums
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, name, city, capacity)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.StadiumResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/stadiums [get]
+func (h *StadiumHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.StadiumSorts...)
+	if !ok {
+		return
+	}
+
+	stadiums, meta, err := h.stadiumService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Stadiums retrieved successfully", stadiums, meta)
+}
+
+// GetByID handles GET /api/v1/stadiums/:id
+// Returns details of a single stadium.
+//
+//	@Summary		Get stadium by ID
+//	@Description	Returns details of a single stadium by its UUID
+//	@Tags			Stadiums
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		string	true	"Stadium UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.StadiumResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/stadiums/{id} [get]
+func (h *StadiumHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	stadium, err := h.stadiumService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Stadium retrieved successfully", stadium)
+}
+
+// Create handles POST /api/v1/stadiums
+// Creates a new stadium.
+//
+//	@Summary		Create a new stadium
+//	@Description	Creates a new stadium that teams can play their home matches at
+//	@Tags			Stadiums
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateStadiumRequest	true	"Stadium data"
+//	@Success		201		{object}	response.Envelope{data=dto.StadiumResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/stadiums [post]
+func (h *StadiumHandler) Create(c *gin.Context) {
+	var req dto.CreateStadiumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	stadium, err := h.stadiumService.Create(c.Request.Context(), req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Stadium created successfully", stadium)
+}
+
+// Update handles PUT /api/v1/stadiums/:id
+// Updates an existing stadium.
+//
+//	@Summary		Update a stadium
+//	@Description	Updates an existing stadium by its UUID
+//	@Tags			Stadiums
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Stadium UUID"
+//	@Param			request	body		dto.UpdateStadiumRequest	true	"Updated stadium data"
+//	@Success		200		{object}	response.Envelope{data=dto.StadiumResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/stadiums/{id} [put]
+func (h *StadiumHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateStadiumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	stadium, err := h.stadiumService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Stadium updated successfully", stadium)
+}
+
+// Delete handles DELETE /api/v1/stadiums/:id
+// Soft-deletes a stadium.
+//
+//	@Summary		Delete a stadium
+//	@Description	Soft-deletes a stadium that is no team's home stadium and no match's venue. Requires a confirmation token (see POST /confirmations)
+//	@Tags			Stadiums
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id						path		string	true	"Stadium UUID"
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
+//	@Router			/stadiums/{id} [delete]
+func (h *StadiumHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.stadiumService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Stadium deleted successfully", nil)
+}
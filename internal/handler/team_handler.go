@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
 )
@@ -12,39 +13,56 @@ import (
 // TeamHandler handles team-related HTTP requests.
 type TeamHandler struct {
 	teamService service.TeamService
+	formService service.FormService
+	query       QueryBinder
 }
 
 // NewTeamHandler creates a new TeamHandler instance.
-func NewTeamHandler(teamService service.TeamService) *TeamHandler {
-	return &TeamHandler{teamService: teamService}
+func NewTeamHandler(teamService service.TeamService, formService service.FormService, query QueryBinder) *TeamHandler {
+	return &TeamHandler{teamService: teamService, formService: formService, query: query}
 }
 
 // GetAll handles GET /api/v1/teams
-// Returns a paginated list of all teams.
+// Returns a paginated list of teams, optionally filtered by search term, city and founding year.
 //
 //	@Summary		List all teams
-//	@Description	Returns a paginated list of all teams with sorting support
+//	@Description	Returns a paginated list of teams with sorting support. `search` matches name or city (case-insensitive); `city` and the founded year range narrow the result further
 //	@Tags			Teams
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			page		query		int		false	"Page number"		default(1)
-//	@Param			per_page	query		int		false	"Items per page"	default(10)
-//	@Param			sort_by		query		string	false	"Sort field"		default(created_at)
-//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
-//	@Success		200			{object}	response.Envelope{data=[]dto.TeamResponse,meta=response.PaginationMeta}
-//	@Failure		401			{object}	response.Envelope
-//	@Failure		500			{object}	response.Envelope
+//	@Security		ApiKeyAuth
+//	@Param			page				query		int		false	"Page number"		default(1)
+//	@Param			per_page			query		int		false	"Items per page"	default(10)
+//	@Param			sort_by				query		string	false	"Sort field"		Enums(created_at, name, founded_year, city, player_count)	default(created_at)
+//	@Param			sort_order			query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Param			ids				query		string	false	"Comma-separated team UUIDs (at most 100); returns these teams in this order, on one page"
+//	@Param			fields				query		string	false	"Comma-separated team response fields to return, e.g. id,name; all when empty"
+//	@Param			search				query		string	false	"Search in name or city"
+//	@Param			city				query		string	false	"Filter by city"
+//	@Param			founded_year_from	query		int		false	"Founded in or after year"
+//	@Param			founded_year_to		query		int		false	"Founded in or before year"
+//	@Success		200					{object}	response.Envelope{data=[]dto.TeamResponse,meta=response.PaginationMeta}
+//	@Failure		400					{object}	response.Envelope
+//	@Failure		401					{object}	response.Envelope
+//	@Failure		500					{object}	response.Envelope
 //	@Router			/teams [get]
 func (h *TeamHandler) GetAll(c *gin.Context) {
-	pagination := bindPagination(c)
+	pagination, ok := h.query.bindPagination(c, model.TeamSorts...)
+	if !ok {
+		return
+	}
+	filter, ok := bindTeamFilter(c)
+	if !ok {
+		return
+	}
 
-	teams, meta, err := h.teamService.GetAll(pagination)
+	teams, meta, err := h.teamService.GetAll(c.Request.Context(), pagination, filter)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
-	response.SuccessWithPagination(c, http.StatusOK, "Teams retrieved successfully", teams, meta)
+	successPage(c, "Teams retrieved successfully", teams, meta, filter.Fields)
 }
 
 // GetByID handles GET /api/v1/teams/:id
@@ -55,12 +73,16 @@ func (h *TeamHandler) GetAll(c *gin.Context) {
 //	@Tags			Teams
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id	path		string	true	"Team UUID"
-//	@Success		200	{object}	response.Envelope{data=dto.TeamResponse}
-//	@Failure		400	{object}	response.Envelope
-//	@Failure		401	{object}	response.Envelope
-//	@Failure		404	{object}	response.Envelope
-//	@Failure		500	{object}	response.Envelope
+//	@Security		ApiKeyAuth
+//	@Param			id				path		string	true	"Team UUID"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; returns 304 if the team has not changed"
+//	@Header			200				{string}	ETag	"Team version and body hash, for If-None-Match and for If-Match on update"
+//	@Success		200				{object}	response.Envelope{data=dto.TeamResponse}
+//	@Success		304				"Not modified"
+//	@Failure		400				{object}	response.Envelope
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
 //	@Router			/teams/{id} [get]
 func (h *TeamHandler) GetByID(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
@@ -68,20 +90,87 @@ func (h *TeamHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	team, err := h.teamService.GetByID(id)
+	team, err := h.teamService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, team.Version)
+	response.Success(c, http.StatusOK, "Team retrieved successfully", team)
+}
+
+// GetBySlug handles GET /api/v1/teams/by-slug/:slug
+// Returns details of a single team by its slug, for readable URLs.
+//
+//	@Summary		Get team by slug
+//	@Description	Returns details of a single team by its URL-friendly slug (e.g. "persija-jakarta"), derived from the name
+//	@Tags			Teams
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			slug			path		string	true	"Team slug"
+//	@Param			If-None-Match	header		string	false	"ETag from a previous read; returns 304 if the team has not changed"
+//	@Header			200				{string}	ETag	"Team version and body hash, for If-None-Match and for If-Match on update"
+//	@Success		200				{object}	response.Envelope{data=dto.TeamResponse}
+//	@Success		304				"Not modified"
+//	@Failure		401				{object}	response.Envelope
+//	@Failure		404				{object}	response.Envelope
+//	@Failure		500				{object}	response.Envelope
+//	@Router			/teams/by-slug/{slug} [get]
+func (h *TeamHandler) GetBySlug(c *gin.Context) {
+	team, err := h.teamService.GetBySlug(c.Request.Context(), c.Param("slug"))
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	setETag(c, team.Version)
 	response.Success(c, http.StatusOK, "Team retrieved successfully", team)
 }
 
+// GetStats handles GET /api/v1/teams/:id/stats
+// Returns a team's record, form and streaks.
+//
+//	@Summary		Get team statistics
+//	@Description	Returns the team's record (played, won, drawn, lost, goals) over completed matches, optionally for a single season, with its last five results (`form`, oldest first) and current winning, unbeaten and losing streaks
+//	@Tags			Teams
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		string	true	"Team UUID"
+//	@Param			season_id	query		string	false	"Season UUID filter"
+//	@Success		200			{object}	response.Envelope{data=dto.TeamStatsResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/teams/{id}/stats [get]
+func (h *TeamHandler) GetStats(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	seasonFilter, ok := bindSeasonFilter(c)
+	if !ok {
+		return
+	}
+
+	stats, err := h.formService.GetTeamForm(c.Request.Context(), id, seasonFilter)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Team statistics retrieved successfully", stats)
+}
+
 // Create handles POST /api/v1/teams
 // Creates a new team.
 //
 //	@Summary		Create a new team
-//	@Description	Creates a new football team
+//	@Description	Creates a new football team, optionally with a home stadium and display fields (short name, three-letter abbreviation, kit colors, links). Fails with 409 when another team has the name or abbreviation
 //	@Tags			Teams
 //	@Accept			json
 //	@Produce		json
@@ -90,6 +179,9 @@ func (h *TeamHandler) GetByID(c *gin.Context) {
 //	@Success		201		{object}	response.Envelope{data=dto.TeamResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/teams [post]
 func (h *TeamHandler) Create(c *gin.Context) {
@@ -99,7 +191,7 @@ func (h *TeamHandler) Create(c *gin.Context) {
 		return
 	}
 
-	team, err := h.teamService.Create(req)
+	team, err := h.teamService.Create(c.Request.Context(), req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -119,10 +211,14 @@ func (h *TeamHandler) Create(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"Team UUID"
 //	@Param			request	body		dto.UpdateTeamRequest	true	"Updated team data"
+//	@Param			If-Match	header		string	false	"ETag from a previous read; fails with 409 if the team changed since"
+//	@Header			200		{string}	ETag	"Team version"
 //	@Success		200		{object}	response.Envelope{data=dto.TeamResponse}
 //	@Failure		400		{object}	response.Envelope
 //	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
 //	@Failure		404		{object}	response.Envelope
+//	@Failure		409		{object}	response.Envelope
 //	@Failure		500		{object}	response.Envelope
 //	@Router			/teams/{id} [put]
 func (h *TeamHandler) Update(c *gin.Context) {
@@ -136,30 +232,85 @@ func (h *TeamHandler) Update(c *gin.Context) {
 		handleBindingError(c, err)
 		return
 	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
 
-	team, err := h.teamService.Update(id, req)
+	team, err := h.teamService.Update(c.Request.Context(), id, req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	setETag(c, team.Version)
+	response.Success(c, http.StatusOK, "Team updated successfully", team)
+}
+
+// Patch handles PATCH /api/v1/teams/:id
+// Partially updates an existing team.
+//
+//	@Summary		Partially update a team
+//	@Description	Changes only the fields present in the body; omitted fields keep their value
+//	@Tags			Teams
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string					true	"Team UUID"
+//	@Param			request		body		dto.PatchTeamRequest	true	"Fields to change"
+//	@Param			If-Match	header		string					false	"ETag from a previous read; fails with 409 if the team changed since"
+//	@Header			200			{string}	ETag					"Team version"
+//	@Success		200			{object}	response.Envelope{data=dto.TeamResponse}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		409			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/teams/{id} [patch]
+func (h *TeamHandler) Patch(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.PatchTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+	if !bindIfMatch(c, &req.Version) {
+		return
+	}
+
+	team, err := h.teamService.Patch(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	setETag(c, team.Version)
 	response.Success(c, http.StatusOK, "Team updated successfully", team)
 }
 
 // Delete handles DELETE /api/v1/teams/:id
-// Soft-deletes a team.
+// Soft-deletes a team, optionally together with its players.
 //
 //	@Summary		Delete a team
-//	@Description	Soft-deletes a team by its UUID
+//	@Description	Soft-deletes a team by its UUID. Requires a confirmation token (see POST /confirmations). Fails with 409 while the team has scheduled, live, awaiting_result or postponed matches, or has players and cascade is not set. With cascade=true its players are soft-deleted in the same transaction
 //	@Tags			Teams
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			id	path		string	true	"Team UUID"
-//	@Success		200	{object}	response.Envelope
-//	@Failure		400	{object}	response.Envelope
-//	@Failure		401	{object}	response.Envelope
-//	@Failure		404	{object}	response.Envelope
-//	@Failure		500	{object}	response.Envelope
+//	@Param			id						path		string	true	"Team UUID"
+//	@Param			cascade					query		bool	false	"Also soft-delete the team's players"	default(false)
+//	@Param			X-Confirmation-Token	header		string	true	"Single-use token from POST /confirmations"
+//	@Success		200						{object}	response.Envelope
+//	@Failure		400						{object}	response.Envelope
+//	@Failure		401						{object}	response.Envelope
+//	@Failure		403						{object}	response.Envelope
+//	@Failure		404						{object}	response.Envelope
+//	@Failure		409						{object}	response.Envelope
+//	@Failure		428						{object}	response.Envelope
+//	@Failure		500						{object}	response.Envelope
 //	@Router			/teams/{id} [delete]
 func (h *TeamHandler) Delete(c *gin.Context) {
 	id, ok := parseUUID(c, c.Param("id"), "id")
@@ -167,7 +318,12 @@ func (h *TeamHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.teamService.Delete(id); err != nil {
+	var query dto.DeleteTeamQuery
+	if !bindQuery(c, &query) {
+		return
+	}
+
+	if err := h.teamService.Delete(c.Request.Context(), id, query.Cascade); err != nil {
 		handleServiceError(c, err)
 		return
 	}
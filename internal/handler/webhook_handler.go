@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// WebhookHandler handles webhook subscription HTTP requests.
+type WebhookHandler struct {
+	webhookService service.WebhookService
+	query          QueryBinder
+}
+
+// NewWebhookHandler creates a new WebhookHandler instance.
+func NewWebhookHandler(webhookService service.WebhookService, query QueryBinder) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService, query: query}
+}
+
+// GetAll handles GET /api/v1/webhooks
+// Returns a paginated list of registered webhooks.
+//
+//	@Summary		List webhooks
+//	@Description	Returns a paginated list of registered webhooks. Signing secrets are never returned. Super admin only
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Param			sort_by		query		string	false	"Sort field"		Enums(created_at, url)	default(created_at)
+//	@Param			sort_order	query		string	false	"Sort order"		Enums(asc, desc)	default(desc)
+//	@Success		200			{object}	response.Envelope{data=[]dto.WebhookResponse,meta=response.PaginationMeta}
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/webhooks [get]
+func (h *WebhookHandler) GetAll(c *gin.Context) {
+	pagination, ok := h.query.bindPagination(c, model.WebhookSorts...)
+	if !ok {
+		return
+	}
+
+	webhooks, meta, err := h.webhookService.GetAll(c.Request.Context(), pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Webhooks retrieved successfully", webhooks, meta)
+}
+
+// GetByID handles GET /api/v1/webhooks/:id
+// Returns a single webhook.
+//
+//	@Summary		Get a webhook
+//	@Description	Returns a registered webhook by its ID. Super admin only
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Webhook UUID"
+//	@Success		200	{object}	response.Envelope{data=dto.WebhookResponse}
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/webhooks/{id} [get]
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Webhook retrieved successfully", webhook)
+}
+
+// Create handles POST /api/v1/webhooks
+// Registers a callback URL for the given event types.
+//
+//	@Summary		Register a webhook
+//	@Description	Registers a callback URL that receives signed POST requests for the subscribed events. The signing secret is only shown in this response; store it securely. Super admin only
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dto.CreateWebhookRequest	true	"Webhook data"
+//	@Success		201		{object}	response.Envelope{data=dto.WebhookCreatedResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	adminID, ok := currentAdminID(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.Create(c.Request.Context(), adminID, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, "Webhook created successfully", webhook)
+}
+
+// Update handles PUT /api/v1/webhooks/:id
+// Updates a webhook's URL, events and active flag.
+//
+//	@Summary		Update a webhook
+//	@Description	Replaces a webhook's URL, description, events and active flag. The signing secret is unchanged. Super admin only
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Webhook UUID"
+//	@Param			request	body		dto.UpdateWebhookRequest	true	"Webhook data"
+//	@Success		200		{object}	response.Envelope{data=dto.WebhookResponse}
+//	@Failure		400		{object}	response.Envelope
+//	@Failure		401		{object}	response.Envelope
+//	@Failure		403		{object}	response.Envelope
+//	@Failure		404		{object}	response.Envelope
+//	@Failure		500		{object}	response.Envelope
+//	@Router			/webhooks/{id} [put]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleBindingError(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.Update(c.Request.Context(), id, req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Webhook updated successfully", webhook)
+}
+
+// Delete handles DELETE /api/v1/webhooks/:id
+// Removes a webhook; its pending deliveries are abandoned.
+//
+//	@Summary		Delete a webhook
+//	@Description	Removes a webhook. Deliveries still waiting to be sent are marked failed. Super admin only
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Webhook UUID"
+//	@Success		200	{object}	response.Envelope
+//	@Failure		400	{object}	response.Envelope
+//	@Failure		401	{object}	response.Envelope
+//	@Failure		403	{object}	response.Envelope
+//	@Failure		404	{object}	response.Envelope
+//	@Failure		500	{object}	response.Envelope
+//	@Router			/webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.Delete(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, "Webhook deleted successfully", nil)
+}
+
+// GetDeliveries handles GET /api/v1/webhooks/:id/deliveries
+// Returns the delivery log of a webhook, newest first.
+//
+//	@Summary		List webhook deliveries
+//	@Description	Returns the delivery log of a webhook, newest first, with status, attempt count and the last receiver response. Super admin only
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Webhook UUID"
+//	@Param			page		query		int		false	"Page number"		default(1)
+//	@Param			per_page	query		int		false	"Items per page"	default(10)
+//	@Success		200			{object}	response.Envelope{data=[]dto.WebhookDeliveryResponse,meta=response.PaginationMeta}
+//	@Failure		400			{object}	response.Envelope
+//	@Failure		401			{object}	response.Envelope
+//	@Failure		403			{object}	response.Envelope
+//	@Failure		404			{object}	response.Envelope
+//	@Failure		500			{object}	response.Envelope
+//	@Router			/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	id, ok := parseUUID(c, c.Param("id"), "id")
+	if !ok {
+		return
+	}
+
+	pagination, ok := h.query.bindPagination(c)
+	if !ok {
+		return
+	}
+
+	deliveries, meta, err := h.webhookService.GetDeliveries(c.Request.Context(), id, pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "Webhook deliveries retrieved successfully", deliveries, meta)
+}
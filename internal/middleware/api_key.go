@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// HeaderAPIKey is the request header carrying an API key.
+const HeaderAPIKey = "X-API-Key"
+
+// ContextKeyAPIKeyID stores the ID of the API key that authenticated the request.
+const ContextKeyAPIKeyID = "api_key_id"
+
+// APIKeyMiddleware returns a GIN middleware that authenticates machine clients by API key.
+// Requests without the X-API-Key header pass through untouched so AuthMiddleware can handle them.
+// scopes maps route paths (as reported by c.FullPath) to the scope a key needs to read them;
+// keys are rejected on every other route and on any method but GET.
+// Must run before AuthMiddleware, which skips requests already authenticated here.
+func APIKeyMiddleware(apiKeyService service.APIKeyService, scopes map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(HeaderAPIKey)
+		if rawKey == "" {
+			c.Next()
+			return
+		}
+
+		scope, ok := scopes[c.FullPath()]
+		if !ok || c.Request.Method != http.MethodGet {
+			response.Abort(c, errs.ErrForbidden("API keys cannot access this endpoint"))
+			return
+		}
+
+		key, err := apiKeyService.Authenticate(c.Request.Context(), rawKey)
+		if err != nil {
+			var appErr *errs.AppError
+			if errors.As(err, &appErr) {
+				response.Abort(c, appErr)
+				return
+			}
+			response.Abort(c, errs.ErrInternal("Internal server error"))
+			return
+		}
+
+		if !key.HasScope(scope) {
+			response.Abort(c, errs.ErrForbidden("API key is missing the "+scope+" scope").WithCode(service.CodeAPIKeyScopeMissing))
+			return
+		}
+
+		c.Set(ContextKeyAPIKeyID, key.ID)
+		c.Next()
+	}
+}
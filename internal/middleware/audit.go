@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+)
+
+// bodyCaptureWriter tees the response body into a buffer so it can be inspected after the handler ran.
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// AuditMiddleware returns a GIN middleware that records a successful mutation of entity in the audit log.
+// For creates the entity IDs are read from the response ("data.id", or "data.created[].id" for bulk imports);
+// for every other action it is the ":id" path param, and the entity is snapshotted before the handler runs.
+// Bulk routes without an ":id", such as the result import, record every "data.submitted[].id" of the response.
+// Requests with "dry_run=true" in the query change nothing and are not recorded.
+// Must run after AuthMiddleware so the admin ID is available in the context.
+func AuditMiddleware(auditService service.AuditService, entity, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminID, _ := c.Get(ContextKeyAdminID)
+		actor, ok := adminID.(uuid.UUID)
+		if dryRun, _ := strconv.ParseBool(c.Query("dry_run")); !ok || dryRun {
+			c.Next()
+			return
+		}
+
+		var entityID uuid.UUID
+		var before map[string]any
+		bulk := action == model.AuditActionCreate || c.Param("id") == ""
+		if !bulk {
+			id, err := uuid.Parse(c.Param("id"))
+			if err != nil {
+				// Invalid IDs are rejected by the handler; nothing can change
+				c.Next()
+				return
+			}
+			entityID = id
+			before = auditService.Snapshot(c.Request.Context(), entity, entityID)
+		}
+
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		if writer.Status() < http.StatusOK || writer.Status() >= http.StatusMultipleChoices {
+			return
+		}
+		entityIDs := []uuid.UUID{entityID}
+		if bulk {
+			entityIDs = responseEntityIDs(writer.body.Bytes())
+		}
+
+		for _, id := range entityIDs {
+			auditService.Record(c.Request.Context(), service.AuditEntry{
+				AdminID:  actor,
+				Entity:   entity,
+				EntityID: id,
+				Action:   action,
+				Before:   before,
+			})
+		}
+	}
+}
+
+// responseEntityIDs extracts the affected IDs from a success envelope: "data.id" for a single create,
+// or every "data.created[].id" or "data.submitted[].id" for bulk imports. Unparseable IDs are skipped.
+func responseEntityIDs(body []byte) []uuid.UUID {
+	type created struct {
+		ID string `json:"id"`
+	}
+	var envelope struct {
+		Data struct {
+			created
+			Created   []created `json:"created"`
+			Submitted []created `json:"submitted"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+
+	var ids []uuid.UUID
+	items := append([]created{envelope.Data.created}, envelope.Data.Created...)
+	for _, item := range append(items, envelope.Data.Submitted...) {
+		if id, err := uuid.Parse(item.ID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
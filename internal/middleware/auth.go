@@ -1,9 +1,12 @@
 package middleware
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
 	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
@@ -13,13 +16,23 @@ import (
 const (
 	ContextKeyAdminID  = "admin_id"
 	ContextKeyUsername = "username"
+	ContextKeyRole     = "role"
+	// ContextKeyMustChangePassword is true when the admin has to change their password first
+	ContextKeyMustChangePassword = "must_change_password"
 )
 
 // AuthMiddleware returns a GIN middleware that validates JWT access tokens.
 // Extracts token from Authorization header, verifies signature and expiration,
 // then attaches decoded claims to request context.
+// Requests authenticated by an API key are passed through.
 func AuthMiddleware(jwtService *jwtpkg.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// Already authenticated by APIKeyMiddleware
+		if _, ok := c.Get(ContextKeyAPIKeyID); ok {
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			response.Abort(c, errs.ErrUnauthorized("Authorization header is required"))
@@ -42,14 +55,37 @@ func AuthMiddleware(jwtService *jwtpkg.Service) gin.HandlerFunc {
 		// Validate and parse the JWT token
 		claims, err := jwtService.ValidateAccessToken(tokenString)
 		if err != nil {
-			response.Abort(c, errs.ErrUnauthorized("Invalid or expired access token"))
+			response.Abort(c, errs.ErrUnauthorized("Invalid or expired access token").WithCode(service.CodeInvalidAccessToken))
 			return
 		}
 
+		// Tokens issued before roles were introduced still carry the legacy role until they
+		// expire; their accounts have been migrated to super admins meanwhile
+		role := claims.Role
+		if role == model.RoleLegacyAdmin {
+			role = model.RoleSuperAdmin
+		}
+
 		// Store admin claims in context for downstream handlers
 		c.Set(ContextKeyAdminID, claims.AdminID)
 		c.Set(ContextKeyUsername, claims.Username)
+		c.Set(ContextKeyRole, role)
+		c.Set(ContextKeyMustChangePassword, claims.MustChangePassword)
+		// ...and in the request context for the service layer
+		c.Request = c.Request.WithContext(service.WithActor(c.Request.Context(), service.Actor{AdminID: claims.AdminID, Role: role}))
+
+		c.Next()
+	}
+}
 
+// PasswordChangeMiddleware refuses requests of admins who must change their password first
+// (403), except on the allowed routes, given as full paths such as "/api/v1/auth/logout".
+func PasswordChangeMiddleware(allowed ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetBool(ContextKeyMustChangePassword) && !slices.Contains(allowed, c.FullPath()) {
+			response.Abort(c, errs.ErrForbidden("Password change required before using the API").WithCode(service.CodePasswordChangeRequired))
+			return
+		}
 		c.Next()
 	}
 }
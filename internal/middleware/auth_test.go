@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtService := jwtpkg.NewService("secret", 15*time.Minute, time.Hour)
+	token := func(role string) string {
+		signed, err := jwtService.GenerateAccessToken(uuid.Must(uuid.NewV7()), "admin", role, false)
+		require.NoError(t, err)
+		return "Bearer " + signed
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantRole      string
+	}{
+		{name: "super admin", authorization: token(model.RoleSuperAdmin), wantStatus: http.StatusOK, wantRole: model.RoleSuperAdmin},
+		{name: "token from before roles", authorization: token(model.RoleLegacyAdmin), wantStatus: http.StatusOK, wantRole: model.RoleSuperAdmin},
+		{name: "other role", authorization: token(model.RoleEditor), wantStatus: http.StatusForbidden},
+		{name: "missing header", wantStatus: http.StatusUnauthorized},
+		{name: "not bearer", authorization: "Basic YWRtaW46c2VjcmV0", wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer not-a-token", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var role string
+			r := gin.New()
+			r.GET("/admins", AuthMiddleware(jwtService), RoleMiddleware(model.RoleSuperAdmin), func(c *gin.Context) {
+				role = c.GetString(ContextKeyRole)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/admins", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRole, role)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"cmp"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// BodyPolicy limits the size and type of request bodies.
+type BodyPolicy struct {
+	MaxBytes       int64         // Largest JSON body
+	MaxUploadBytes int64         // Largest body on UploadRoutes without a limit of their own
+	UploadRoutes   []UploadRoute // Routes that take other bodies than JSON, such as file uploads
+}
+
+// UploadRoute is a route whose body is not (only) JSON.
+type UploadRoute struct {
+	Path       string   // Route pattern, as registered
+	MediaTypes []string // Accepted Content-Types; application/json includes the +json types
+	MaxBytes   int64    // Largest body; MaxUploadBytes when 0
+}
+
+// RequestBodyMiddleware returns a GIN middleware enforcing policy on requests that carry a body:
+// upload routes only accept their media types, every other route only JSON, otherwise 415.
+// A body declared larger than the limit is rejected with 413 before it is read; an undeclared
+// (chunked) one fails while it is read, which handlers report as 413 too.
+func RequestBodyMiddleware(policy BodyPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody || c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+		accepted, limit := []string{"application/json"}, policy.MaxBytes
+		if i := slices.IndexFunc(policy.UploadRoutes, func(r UploadRoute) bool { return r.Path == c.FullPath() }); i >= 0 {
+			route := policy.UploadRoutes[i]
+			accepted, limit = route.MediaTypes, cmp.Or(route.MaxBytes, policy.MaxUploadBytes)
+		}
+		if !acceptsMediaType(accepted, mediaType) {
+			response.Abort(c, errs.ErrUnsupportedMediaType("Content-Type must be "+strings.Join(accepted, " or ")))
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			response.Abort(c, TooLarge(limit))
+			return
+		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
+
+// TooLarge returns the 413 error for a body over limit bytes.
+func TooLarge(limit int64) *errs.AppError {
+	return errs.ErrPayloadTooLarge(fmt.Sprintf("Request body must not be larger than %s", formatBytes(limit)))
+}
+
+// acceptsMediaType reports whether mediaType is one of accepted, where application/json
+// stands for every JSON-based type.
+func acceptsMediaType(accepted []string, mediaType string) bool {
+	return slices.ContainsFunc(accepted, func(t string) bool {
+		return t == mediaType || (t == "application/json" && isJSON(mediaType))
+	})
+}
+
+// isJSON reports whether mediaType is application/json or a JSON-based type such as application/merge-patch+json.
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || (strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json"))
+}
+
+// formatBytes renders n as a short human-readable size, e.g. 1 MB or 512 KB.
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20 && n%(1<<20) == 0:
+		return fmt.Sprintf("%d MB", n>>20)
+	case n >= 1<<10 && n%(1<<10) == 0:
+		return fmt.Sprintf("%d KB", n>>10)
+	default:
+		return fmt.Sprintf("%d bytes", n)
+	}
+}
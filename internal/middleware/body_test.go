@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func multipartBody(t *testing.T, size int) (string, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile("file", "players.csv")
+	require.NoError(t, err)
+	_, err = part.Write(bytes.Repeat([]byte("a"), size))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return w.FormDataContentType(), buf.Bytes()
+}
+
+func TestRequestBodyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	policy := BodyPolicy{
+		MaxBytes:       64,
+		MaxUploadBytes: 1024,
+		UploadRoutes: []UploadRoute{
+			{Path: "/players/:id/photo", MediaTypes: []string{"multipart/form-data"}},
+			{Path: "/results/import", MediaTypes: []string{"application/json", "text/csv"}, MaxBytes: 128},
+		},
+	}
+	smallUpload, smallUploadBody := multipartBody(t, 100)
+	largeUpload, largeUploadBody := multipartBody(t, 2000)
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		body        []byte
+		wantStatus  int
+		wantMessage string
+	}{
+		{name: "json", path: "/teams", contentType: "application/json", body: []byte(`{"name":"Persija"}`), wantStatus: http.StatusOK},
+		{name: "json with charset", path: "/teams", contentType: "application/json; charset=utf-8", body: []byte(`{}`), wantStatus: http.StatusOK},
+		{name: "merge patch", path: "/teams", contentType: "application/merge-patch+json", body: []byte(`{}`), wantStatus: http.StatusOK},
+		{name: "form on json route", path: "/teams", contentType: "application/x-www-form-urlencoded", body: []byte("name=Persija"),
+			wantStatus: http.StatusUnsupportedMediaType, wantMessage: "Content-Type must be application/json"},
+		{name: "no content type", path: "/teams", body: []byte(`{}`), wantStatus: http.StatusUnsupportedMediaType},
+		{name: "json too large", path: "/teams", contentType: "application/json", body: bytes.Repeat([]byte(" "), 65),
+			wantStatus: http.StatusRequestEntityTooLarge, wantMessage: "Request body must not be larger than 64 bytes"},
+		{name: "empty body", path: "/teams", contentType: "text/plain", wantStatus: http.StatusOK},
+		{name: "upload", path: "/players/1/photo", contentType: smallUpload, body: smallUploadBody, wantStatus: http.StatusOK},
+		{name: "json on upload route", path: "/players/1/photo", contentType: "application/json", body: []byte(`{}`),
+			wantStatus: http.StatusUnsupportedMediaType, wantMessage: "Content-Type must be multipart/form-data"},
+		{name: "upload too large", path: "/players/1/photo", contentType: largeUpload, body: largeUploadBody,
+			wantStatus: http.StatusRequestEntityTooLarge, wantMessage: "Request body must not be larger than 1 KB"},
+		{name: "csv import", path: "/results/import", contentType: "text/csv", body: []byte("match_id\n1\n"), wantStatus: http.StatusOK},
+		{name: "json import", path: "/results/import", contentType: "application/json", body: []byte(`{"results":[]}`), wantStatus: http.StatusOK},
+		{name: "multipart import", path: "/results/import", contentType: smallUpload, body: smallUploadBody,
+			wantStatus: http.StatusUnsupportedMediaType, wantMessage: "Content-Type must be application/json or text/csv"},
+		{name: "import over its own limit", path: "/results/import", contentType: "text/csv", body: bytes.Repeat([]byte("a"), 129),
+			wantStatus: http.StatusRequestEntityTooLarge, wantMessage: "Request body must not be larger than 128 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(RequestBodyMiddleware(policy))
+			read := func(c *gin.Context) {
+				if _, err := io.ReadAll(c.Request.Body); err != nil {
+					c.Status(http.StatusInternalServerError)
+					return
+				}
+				c.Status(http.StatusOK)
+			}
+			r.POST("/teams", read)
+			r.POST("/players/:id/photo", read)
+			r.POST("/results/import", read)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantMessage != "" {
+				var envelope response.Envelope
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+				assert.Equal(t, tt.wantMessage, envelope.Message)
+			}
+		})
+	}
+}
+
+func TestRequestBodyMiddleware_UndeclaredLength(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var readErr error
+	r := gin.New()
+	r.Use(RequestBodyMiddleware(BodyPolicy{MaxBytes: 64}))
+	r.POST("/teams", func(c *gin.Context) {
+		_, readErr = io.ReadAll(c.Request.Body)
+	})
+
+	// A chunked body passes the declared-length check but is cut off while it is read
+	req := httptest.NewRequest(http.MethodPost, "/teams", io.NopCloser(strings.NewReader(strings.Repeat(" ", 100))))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var tooLarge *http.MaxBytesError
+	require.ErrorAs(t, readErr, &tooLarge)
+	assert.Equal(t, int64(64), tooLarge.Limit)
+}
@@ -0,0 +1,197 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompressionPolicy decides which responses are gzip-compressed.
+type CompressionPolicy struct {
+	Level        int      // gzip level from 1 (fastest) to 9 (smallest); 0 disables compression
+	MinBytes     int      // Smaller bodies are sent as they are, as compressing them saves little
+	ContentTypes []string // Media types worth compressing, e.g. application/json
+}
+
+// CompressionMiddleware returns a GIN middleware that gzip-compresses responses for clients
+// accepting it, when their media type is listed in policy and the body reaches policy.MinBytes.
+// Bodies are held back only until the threshold is reached, so large and streamed responses
+// are still sent as they are written; a Flush before then sends the response uncompressed.
+// Must run before RecoveryMiddleware so the error envelope of a recovered panic is compressed
+// like any other response.
+func CompressionMiddleware(policy CompressionPolicy) gin.HandlerFunc {
+	if policy.Level == 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+	pool := &sync.Pool{New: func() any {
+		gz, _ := gzip.NewWriterLevel(io.Discard, policy.Level)
+		return gz
+	}}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{ResponseWriter: c.Writer, policy: &policy, pool: pool, accepted: acceptsGzip(c.GetHeader("Accept-Encoding"))}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+		w.finish()
+	}
+}
+
+// compressWriter buffers the start of a response until it knows whether to compress it.
+type compressWriter struct {
+	gin.ResponseWriter
+	policy   *CompressionPolicy
+	pool     *sync.Pool
+	accepted bool
+
+	buf      bytes.Buffer
+	checked  bool // Whether eligible has been worked out from the status and headers
+	eligible bool
+	decided  bool
+	gz       *gzip.Writer // Set once the response is being compressed
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		if w.gz != nil {
+			return w.gz.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+	if !w.checked {
+		w.checked = true
+		w.eligible = w.compressible()
+	}
+	if !w.eligible {
+		_ = w.decide(false)
+		return w.ResponseWriter.Write(b)
+	}
+	w.buf.Write(b)
+	if w.buf.Len() >= w.policy.MinBytes {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow is deferred until the body shows whether Content-Encoding must be set.
+func (w *compressWriter) WriteHeaderNow() {
+	if w.decided {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) Written() bool {
+	return w.buf.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// compressible reports whether the response may be compressed, judging by its status and headers.
+// Responses to clients that do not accept gzip still get Vary so caches keep both versions apart.
+func (w *compressWriter) compressible() bool {
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	if !slices.Contains(w.policy.ContentTypes, mediaType) {
+		return false
+	}
+	header.Add("Vary", "Accept-Encoding")
+	return w.accepted
+}
+
+// decide sends the headers and anything buffered, compressed or not.
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+	if compress {
+		header := w.Header()
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		// Strong validators name the exact bytes, which are now different
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		w.gz = w.pool.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(w.buf.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+	w.buf.Reset()
+	return err
+}
+
+// finish sends a response still held back below the threshold, or ends the gzip stream.
+func (w *compressWriter) finish() {
+	if !w.decided {
+		if w.buf.Len() > 0 {
+			w.Header().Set("Content-Length", strconv.Itoa(w.buf.Len()))
+		}
+		_ = w.decide(false)
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.gz.Reset(io.Discard)
+		w.pool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip, honouring q=0.
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		q := strings.TrimSpace(params)
+		if v, ok := strings.CutPrefix(q, "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil && f == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCompressionPolicy = CompressionPolicy{
+	Level:        6,
+	MinBytes:     64,
+	ContentTypes: []string{"application/json", "text/event-stream"},
+}
+
+func gunzip(t *testing.T, data []byte) string {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	out, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := `{"data":"` + strings.Repeat("persija jakarta ", 20) + `"}`
+	small := `{"data":"ok"}`
+
+	tests := []struct {
+		name           string
+		method         string
+		acceptEncoding string
+		status         int
+		contentType    string
+		header         map[string]string // Set by the handler
+		body           string
+		wantGzip       bool
+		wantVary       bool
+	}{
+		{name: "large json", acceptEncoding: "gzip", body: large, wantGzip: true, wantVary: true},
+		{name: "below threshold", acceptEncoding: "gzip", body: small, wantVary: true},
+		{name: "gzip not accepted", body: large, wantVary: true},
+		{name: "gzip among others", acceptEncoding: "br;q=1.0, gzip;q=0.8", body: large, wantGzip: true, wantVary: true},
+		{name: "any encoding", acceptEncoding: "*", body: large, wantGzip: true, wantVary: true},
+		{name: "gzip refused", acceptEncoding: "gzip;q=0, deflate", body: large, wantVary: true},
+		{name: "upper case", acceptEncoding: "GZIP", body: large, wantGzip: true, wantVary: true},
+		{name: "incompressible type", acceptEncoding: "gzip", contentType: "image/png", body: large},
+		{name: "no content type", acceptEncoding: "gzip", contentType: "-", body: large},
+		{name: "already encoded", acceptEncoding: "gzip", header: map[string]string{"Content-Encoding": "br"}, body: large},
+		{name: "head", method: http.MethodHead, acceptEncoding: "gzip", body: large},
+		{name: "no content", acceptEncoding: "gzip", status: http.StatusNoContent},
+		{name: "error body", acceptEncoding: "gzip", status: http.StatusInternalServerError, body: large, wantGzip: true, wantVary: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			status := tt.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			contentType := tt.contentType
+			if contentType == "" {
+				contentType = "application/json; charset=utf-8"
+			}
+
+			r := gin.New()
+			r.Use(CompressionMiddleware(testCompressionPolicy))
+			r.Handle(method, "/teams", func(c *gin.Context) {
+				for k, v := range tt.header {
+					c.Header(k, v)
+				}
+				if contentType != "-" {
+					c.Header("Content-Type", contentType)
+				}
+				c.Header("Content-Length", "999") // Wrong once compressed; must not leak through
+				c.Status(status)
+				_, _ = c.Writer.WriteString(tt.body)
+			})
+
+			req := httptest.NewRequest(method, "/teams", nil)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, status, w.Code)
+			if tt.wantVary {
+				assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+			} else {
+				assert.Empty(t, w.Header().Get("Vary"))
+			}
+			if tt.wantGzip {
+				assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+				assert.Empty(t, w.Header().Get("Content-Length"))
+				assert.Equal(t, tt.body, gunzip(t, w.Body.Bytes()))
+				return
+			}
+			assert.NotEqual(t, "gzip", w.Header().Get("Content-Encoding"))
+			if method != http.MethodHead {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCompressionMiddleware_SmallBodyLength(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(CompressionMiddleware(testCompressionPolicy))
+	// Written in pieces, so only the middleware knows the total length
+	r.GET("/ok", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		_, _ = c.Writer.WriteString(`{"data":`)
+		_, _ = c.Writer.WriteString(`"ok"}`)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ok", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, `{"data":"ok"}`, w.Body.String())
+	assert.Equal(t, "13", w.Header().Get("Content-Length"))
+}
+
+func TestCompressionMiddleware_WeakensStrongETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(CompressionMiddleware(testCompressionPolicy))
+	r.GET("/teams/1", func(c *gin.Context) {
+		c.Header("ETag", `"3"`)
+		c.Data(http.StatusOK, "application/json", bytes.Repeat([]byte("a"), 100))
+	})
+
+	for acceptEncoding, want := range map[string]string{"gzip": `W/"3"`, "": `"3"`} {
+		req := httptest.NewRequest(http.MethodGet, "/teams/1", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, want, w.Header().Get("ETag"), "Accept-Encoding %q", acceptEncoding)
+	}
+}
+
+func TestCompressionMiddleware_Disabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(CompressionMiddleware(CompressionPolicy{Level: 0, ContentTypes: []string{"application/json"}}))
+	r.GET("/teams", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", bytes.Repeat([]byte("a"), 1000))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/teams", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Empty(t, w.Header().Get("Vary"))
+	assert.Len(t, w.Body.String(), 1000)
+}
+
+func TestCompressionMiddleware_StreamsFlushedEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	release := make(chan struct{})
+	r := gin.New()
+	r.Use(CompressionMiddleware(testCompressionPolicy))
+	r.GET("/events", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		_, _ = c.Writer.WriteString("data: kickoff\n\n")
+		c.Writer.Flush()
+		// The client must receive the first event while the handler is still running
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+		}
+		_, _ = c.Writer.WriteString("data: " + strings.Repeat("goal ", 50) + "\n\n")
+	})
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept-Encoding", "gzip") // Set explicitly, so the transport does not decompress
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Empty(t, resp.Header.Get("Content-Encoding"))
+	lines := bufio.NewReader(resp.Body)
+	first, err := lines.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "data: kickoff\n", first)
+
+	close(release)
+	rest, err := io.ReadAll(lines)
+	require.NoError(t, err)
+	assert.Equal(t, "\ndata: "+strings.Repeat("goal ", 50)+"\n\n", string(rest))
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := map[string]bool{
+		"":                 false,
+		"gzip":             true,
+		"deflate, gzip":    true,
+		"gzip;q=0.5":       true,
+		"gzip; q=0":        false,
+		"gzip;q=0.0":       false,
+		"*":                true,
+		"*;q=0":            false,
+		"br, deflate":      false,
+		"identity":         false,
+		" GZip ; q=1 , br": true,
+		"x-gzip, br;q=0.9": false,
+	}
+	for header, want := range tests {
+		assert.Equal(t, want, acceptsGzip(header), "%q", header)
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// HeaderConfirmationToken is the request header carrying a confirmation token.
+const HeaderConfirmationToken = "X-Confirmation-Token"
+
+// ConfirmationMiddleware returns a GIN middleware that requires a valid, single-use
+// confirmation token for the given action on the resource identified by the ":id" path param.
+// Tokens are obtained beforehand via POST /api/v1/confirmations. The token is consumed before
+// the handler runs, so concurrent requests cannot share it, and restored when the handler
+// answers with an error, as the operation then did not happen and the client may retry it.
+// Must run after AuthMiddleware so the admin ID is available in the context.
+func ConfirmationMiddleware(confirmationService service.ConfirmationService, action string) gin.HandlerFunc {
+	return ConfirmationMiddlewareFor(confirmationService, action, "id")
+}
+
+// ConfirmationMiddlewareFor is ConfirmationMiddleware for a resource identified by another path
+// param, such as a nested resource in "/matches/:id/attachments/:attachmentId".
+func ConfirmationMiddlewareFor(confirmationService service.ConfirmationService, action, param string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(HeaderConfirmationToken)
+		if token == "" {
+			response.Abort(c, errs.New(http.StatusPreconditionRequired,
+				"This operation requires confirmation. Request a token via POST /api/v1/confirmations and send it in the "+HeaderConfirmationToken+" header").WithCode(service.CodeConfirmationRequired))
+			return
+		}
+
+		resourceID, err := uuid.Parse(c.Param(param))
+		if err != nil {
+			response.Abort(c, errs.ErrBadRequest("Invalid UUID format for '"+param+"' parameter"))
+			return
+		}
+
+		adminID, _ := c.Get(ContextKeyAdminID)
+		id, ok := adminID.(uuid.UUID)
+		if !ok {
+			response.Abort(c, errs.ErrUnauthorized("Authentication required"))
+			return
+		}
+
+		consumed, err := confirmationService.Verify(c.Request.Context(), id, action, resourceID, token)
+		if err != nil {
+			var appErr *errs.AppError
+			if errors.As(err, &appErr) {
+				response.Abort(c, appErr)
+				return
+			}
+			response.Abort(c, errs.ErrInternal("Internal server error"))
+			return
+		}
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusBadRequest {
+			confirmationService.Restore(c.Request.Context(), consumed)
+		}
+	}
+}
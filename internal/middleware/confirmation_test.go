@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adminID, teamID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	token := &model.ConfirmationToken{AdminID: adminID, Action: model.ConfirmActionTeamDelete, ResourceID: teamID, Token: "tok",
+		ExpiresAt: time.Now().Add(time.Minute)}
+
+	tests := []struct {
+		name       string
+		header     string
+		status     int // Answered by the handler
+		setup      func(*mocks.MockConfirmationTokenRepository)
+		wantStatus int
+	}{
+		{
+			name:   "successful delete consumes the token",
+			header: "tok",
+			status: http.StatusOK,
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, teamID).Return(token, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "refused delete restores the token",
+			header: "tok",
+			status: http.StatusConflict,
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, teamID).Return(token, nil)
+				cr.EXPECT().Create(token).Return(nil)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "used token",
+			header: "tok",
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, teamID).Return(nil, nil)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "missing token",
+			setup:      func(cr *mocks.MockConfirmationTokenRepository) {},
+			wantStatus: http.StatusPreconditionRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmationRepo := mocks.NewMockConfirmationTokenRepository(t)
+			tt.setup(confirmationRepo)
+			svc := service.NewConfirmationService(confirmationRepo, time.Minute)
+
+			r := gin.New()
+			r.DELETE("/teams/:id",
+				func(c *gin.Context) { c.Set(ContextKeyAdminID, adminID) },
+				ConfirmationMiddleware(svc, model.ConfirmActionTeamDelete),
+				func(c *gin.Context) {
+					if tt.status == http.StatusOK {
+						c.Status(http.StatusOK)
+						return
+					}
+					response.Abort(c, errs.New(tt.status, "Team still has players"))
+				})
+
+			req := httptest.NewRequest(http.MethodDelete, "/teams/"+teamID.String(), nil)
+			if tt.header != "" {
+				req.Header.Set(HeaderConfirmationToken, tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestConfirmationMiddlewareFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adminID, matchID, attachmentID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	token := &model.ConfirmationToken{AdminID: adminID, Action: model.ConfirmActionAttachmentDelete, ResourceID: attachmentID, Token: "tok",
+		ExpiresAt: time.Now().Add(time.Minute)}
+
+	tests := []struct {
+		name       string
+		path       string
+		setup      func(*mocks.MockConfirmationTokenRepository)
+		wantStatus int
+	}{
+		{
+			name: "token for the attachment",
+			path: "/matches/" + matchID.String() + "/attachments/" + attachmentID.String(),
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionAttachmentDelete, attachmentID).Return(token, nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "invalid attachment ID",
+			path:       "/matches/" + matchID.String() + "/attachments/report.pdf",
+			setup:      func(cr *mocks.MockConfirmationTokenRepository) {},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confirmationRepo := mocks.NewMockConfirmationTokenRepository(t)
+			tt.setup(confirmationRepo)
+			svc := service.NewConfirmationService(confirmationRepo, time.Minute)
+
+			r := gin.New()
+			r.DELETE("/matches/:id/attachments/:attachmentId",
+				func(c *gin.Context) { c.Set(ContextKeyAdminID, adminID) },
+				ConfirmationMiddlewareFor(svc, model.ConfirmActionAttachmentDelete, "attachmentId"),
+				func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+			req := httptest.NewRequest(http.MethodDelete, tt.path, nil)
+			req.Header.Set(HeaderConfirmationToken, "tok")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
@@ -7,15 +7,27 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// CORSMiddleware returns a GIN middleware that configures CORS headers.
-// In development, all origins are allowed; in production, restrict to specific domains.
-func CORSMiddleware() gin.HandlerFunc {
+// CORSPolicy lists what browsers on other origins may do.
+type CORSPolicy struct {
+	AllowOrigins []string // "*", exact origins or one-wildcard patterns such as https://*.example.com
+	AllowMethods []string
+	AllowHeaders []string
+	MaxAge       time.Duration
+}
+
+// CORSMiddleware returns a GIN middleware that applies policy. With no allowed origins
+// it sends no CORS headers at all, so browsers only allow same-origin calls.
+func CORSMiddleware(policy CORSPolicy) gin.HandlerFunc {
+	if len(policy.AllowOrigins) == 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
 	return cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length", "Content-Type"},
+		AllowOrigins:     policy.AllowOrigins,
+		AllowWildcard:    true,
+		AllowMethods:     policy.AllowMethods,
+		AllowHeaders:     policy.AllowHeaders,
+		ExposeHeaders:    []string{"Content-Length", "Content-Type", "Content-Disposition", "Content-Language", "X-Request-ID", "ETag", "Deprecation", "Sunset", "Link"},
 		AllowCredentials: false,
-		MaxAge:           12 * time.Hour,
+		MaxAge:           policy.MaxAge,
 	})
 }
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecation describes a route that a later API version replaces.
+type Deprecation struct {
+	Since  time.Time // When the route was, or will be, deprecated
+	Sunset time.Time // When the route stops being served; zero if not yet decided
+	// The base paths of the deprecated version and its successor, such as /api/v1 and /api/v2.
+	// The successor's base path replaces the old one in the request path for the Link header.
+	BasePath, SuccessorBasePath string
+}
+
+// DeprecationMiddleware returns a GIN middleware announcing that a route is deprecated with the
+// Deprecation (RFC 9745) and Sunset (RFC 8594) headers, and linking the same route in the
+// successor version.
+func DeprecationMiddleware(d Deprecation) gin.HandlerFunc {
+	since := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	var sunset string
+	if !d.Sunset.IsZero() {
+		sunset = d.Sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", since)
+		if sunset != "" {
+			c.Header("Sunset", sunset)
+		}
+		if path, ok := strings.CutPrefix(c.Request.URL.Path, d.BasePath); ok && d.SuccessorBasePath != "" {
+			c.Header("Link", "<"+d.SuccessorBasePath+path+`>; rel="successor-version"`)
+		}
+		c.Next()
+	}
+}
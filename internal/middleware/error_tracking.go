@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errtrack"
+)
+
+// ContextKeyErrorTracker is the GIN context key holding the errtrack.Tracker for the request.
+const ContextKeyErrorTracker = "error_tracker"
+
+// ErrorTrackingMiddleware returns a GIN middleware that makes tracker available to
+// ReportError for the rest of the chain.
+func ErrorTrackingMiddleware(tracker errtrack.Tracker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(ContextKeyErrorTracker, tracker)
+		c.Next()
+	}
+}
+
+// ReportError sends err to the request's error tracker, tagged with the request ID, route,
+// method and status and, once authenticated, the admin or API key making the request.
+// stack may be nil. Without ErrorTrackingMiddleware in the chain it does nothing.
+func ReportError(c *gin.Context, err error, status int, stack []byte) {
+	value, _ := c.Get(ContextKeyErrorTracker)
+	tracker, ok := value.(errtrack.Tracker)
+	if !ok {
+		return
+	}
+
+	route := c.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+	tags := map[string]string{
+		"request_id": c.GetString(ContextKeyRequestID),
+		"route":      route,
+		"method":     c.Request.Method,
+		"status":     fmt.Sprint(status),
+	}
+	if adminID, ok := c.Get(ContextKeyAdminID); ok {
+		tags["admin_id"] = fmt.Sprint(adminID)
+	}
+	if keyID, ok := c.Get(ContextKeyAPIKeyID); ok {
+		tags["api_key_id"] = fmt.Sprint(keyID)
+	}
+
+	tracker.Capture(c.Request.Context(), errtrack.Event{
+		Err:     err,
+		Stack:   stack,
+		Request: c.Request,
+		Tags:    tags,
+		// Service errors all read "Internal server error", so group them per route instead
+		Fingerprint: []string{c.Request.Method + " " + route, err.Error()},
+	})
+}
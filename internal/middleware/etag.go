@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// conditionalWriter holds back a response so its ETag can be computed from the whole body.
+type conditionalWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *conditionalWriter) WriteHeader(code int) { w.status = code }
+
+func (w *conditionalWriter) WriteHeaderNow() {}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+func (w *conditionalWriter) WriteString(s string) (int, error) { return w.body.WriteString(s) }
+
+func (w *conditionalWriter) Flush() {}
+
+func (w *conditionalWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *conditionalWriter) Size() int { return w.body.Len() }
+
+func (w *conditionalWriter) Written() bool { return w.status != 0 || w.body.Len() > 0 }
+
+// ETagMiddleware returns a GIN middleware that gives successful GET responses a weak
+// ETag derived from a hash of the body, and answers 304 Not Modified with no body when the
+// request's If-None-Match already holds it, so polling clients only download what changed.
+//
+// A version ETag set by the handler (see handler.setETag) is kept as a prefix, W/"<version>-<hash>",
+// so the same value still works in If-Match on update while also changing when related data in
+// the representation (a team's name inside a match, say) does.
+func ETagMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		w := &conditionalWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		// Restore the real writer before a panic reaches RecoveryMiddleware, dropping the partial body
+		defer func() { c.Writer = w.ResponseWriter }()
+
+		c.Next()
+
+		status := w.Status()
+		if status == http.StatusOK && w.body.Len() > 0 {
+			sum := sha256.Sum256(w.body.Bytes())
+			tag := hex.EncodeToString(sum[:8])
+			if version := strings.Trim(strings.TrimPrefix(w.Header().Get("ETag"), "W/"), `"`); version != "" {
+				tag = version + "-" + tag
+			}
+			etag := `W/"` + tag + `"`
+			w.Header().Set("ETag", etag)
+
+			if etagMatches(c.GetHeader("If-None-Match"), etag) {
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.Header().Del("Content-Disposition")
+				w.ResponseWriter.WriteHeader(http.StatusNotModified)
+				w.ResponseWriter.WriteHeaderNow()
+				return
+			}
+		}
+
+		w.ResponseWriter.WriteHeader(status)
+		if w.body.Len() == 0 {
+			w.ResponseWriter.WriteHeaderNow()
+			return
+		}
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
+
+// etagMatches reports whether an If-None-Match header lists etag, comparing weakly
+// (W/ prefixes are ignored, as RFC 9110 requires for If-None-Match).
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
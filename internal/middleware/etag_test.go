@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETagMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"data":{"name":"Persija"}}`
+
+	tests := []struct {
+		name        string
+		method      string
+		status      int
+		version     string // ETag set by the handler
+		ifNoneMatch string
+		wantStatus  int
+		wantETag    bool
+		wantBody    bool
+	}{
+		{name: "ok gets an etag", method: http.MethodGet, status: http.StatusOK, wantStatus: http.StatusOK, wantETag: true, wantBody: true},
+		{name: "matching etag", method: http.MethodGet, status: http.StatusOK, ifNoneMatch: "$etag", wantStatus: http.StatusNotModified, wantETag: true},
+		{name: "matching strong form", method: http.MethodGet, status: http.StatusOK, ifNoneMatch: "$strong", wantStatus: http.StatusNotModified, wantETag: true},
+		{name: "match in a list", method: http.MethodGet, status: http.StatusOK, ifNoneMatch: `"other", $etag`, wantStatus: http.StatusNotModified, wantETag: true},
+		{name: "wildcard", method: http.MethodGet, status: http.StatusOK, ifNoneMatch: "*", wantStatus: http.StatusNotModified, wantETag: true},
+		{name: "stale etag", method: http.MethodGet, status: http.StatusOK, ifNoneMatch: `W/"0000000000000000"`, wantStatus: http.StatusOK, wantETag: true, wantBody: true},
+		{name: "version kept as prefix", method: http.MethodGet, status: http.StatusOK, version: `"3"`, wantStatus: http.StatusOK, wantETag: true, wantBody: true},
+		{name: "matching versioned etag", method: http.MethodGet, status: http.StatusOK, version: `"3"`, ifNoneMatch: "$etag", wantStatus: http.StatusNotModified, wantETag: true},
+		{name: "error response", method: http.MethodGet, status: http.StatusNotFound, ifNoneMatch: "*", wantStatus: http.StatusNotFound, wantBody: true},
+		{name: "created response", method: http.MethodGet, status: http.StatusCreated, wantStatus: http.StatusCreated, wantBody: true},
+		{name: "post", method: http.MethodPost, status: http.StatusOK, ifNoneMatch: "*", wantStatus: http.StatusOK, wantBody: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ETagMiddleware())
+			r.Handle(tt.method, "/teams/1", func(c *gin.Context) {
+				if tt.version != "" {
+					c.Header("ETag", tt.version)
+				}
+				c.Data(tt.status, "application/json; charset=utf-8", []byte(body))
+			})
+
+			// The first request learns the ETag the conditional one refers to
+			first := httptest.NewRecorder()
+			r.ServeHTTP(first, httptest.NewRequest(tt.method, "/teams/1", nil))
+			etag := first.Header().Get("ETag")
+
+			req := httptest.NewRequest(tt.method, "/teams/1", nil)
+			if tt.ifNoneMatch != "" {
+				header := strings.ReplaceAll(tt.ifNoneMatch, "$etag", etag)
+				req.Header.Set("If-None-Match", strings.ReplaceAll(header, "$strong", strings.TrimPrefix(etag, "W/")))
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantETag {
+				assert.Regexp(t, `^W/"`+strings.Trim(tt.version, `"`)+`-?[0-9a-f]{16}"$`, w.Header().Get("ETag"))
+			} else {
+				assert.Equal(t, tt.version, w.Header().Get("ETag"))
+			}
+			if tt.wantBody {
+				assert.Equal(t, body, w.Body.String())
+			} else {
+				assert.Empty(t, w.Body.String())
+				assert.Empty(t, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestETagMiddleware_EmptyBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(ETagMiddleware())
+	r.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestETagMiddleware_WithCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := `{"data":"` + strings.Repeat("persija ", 100) + `"}`
+
+	// As in the router: compression wraps everything, the ETag is computed inside it
+	r := gin.New()
+	r.Use(CompressionMiddleware(CompressionPolicy{Level: 5, MinBytes: 100, ContentTypes: []string{"application/json"}}))
+	r.GET("/teams/1", ETagMiddleware(), func(c *gin.Context) {
+		c.Header("ETag", `"3"`)
+		c.Data(http.StatusOK, "application/json", []byte(body))
+	})
+	get := func(acceptEncoding, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/teams/1", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	gzipped, plain := get("gzip", ""), get("", "")
+	require.Equal(t, "gzip", gzipped.Header().Get("Content-Encoding"))
+	require.Empty(t, plain.Header().Get("Content-Encoding"))
+
+	// The ETag is weak and hashes the uncompressed body, so both encodings share it
+	etag := gzipped.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(etag, `W/"3-`), etag)
+	assert.Equal(t, etag, plain.Header().Get("ETag"))
+
+	for _, acceptEncoding := range []string{"gzip", ""} {
+		for _, ifNoneMatch := range []string{etag, strings.TrimPrefix(etag, "W/")} {
+			w := get(acceptEncoding, ifNoneMatch)
+			assert.Equal(t, http.StatusNotModified, w.Code, "%q / %q", acceptEncoding, ifNoneMatch)
+			assert.Empty(t, w.Body.String())
+			assert.Empty(t, w.Header().Get("Content-Encoding"))
+		}
+	}
+}
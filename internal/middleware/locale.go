@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/i18n"
+)
+
+// LocaleMiddleware returns a GIN middleware that picks the language of the response messages
+// from the Accept-Language header and stores it in the request context, where the response
+// package reads it. Clients sending no header, or only unsupported languages, get English.
+func LocaleMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := i18n.Negotiate(c.GetHeader("Accept-Language"))
+		c.Request = c.Request.WithContext(i18n.WithLanguage(c.Request.Context(), lang))
+		c.Header("Content-Language", lang)
+		// Caches must not serve a response in one language to a client asking for another
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestLoggerMiddleware returns a GIN middleware that writes one structured log line per request.
+// Must run after RequestIDMiddleware so the line carries the request ID.
+func RequestLoggerMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []any{
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", status,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"client_ip", c.ClientIP(),
+		}
+		if adminID, ok := c.Get(ContextKeyAdminID); ok {
+			attrs = append(attrs, "admin_id", adminID)
+		}
+		if keyID, ok := c.Get(ContextKeyAPIKeyID); ok {
+			attrs = append(attrs, "api_key_id", keyID)
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+		slog.Log(c.Request.Context(), level, "http request", attrs...)
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// MaintenanceMiddleware returns a GIN middleware answering 503 with the maintenance state as
+// data while maintenance mode is on, instead of letting requests fail on an unavailable
+// database. Paths starting with one of exempt, such as health probes and login, are served
+// as usual. A Retry-After header is sent while the expected end is in the future.
+func MaintenanceMiddleware(maintenance service.MaintenanceService, exempt ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status := maintenance.Status()
+		if !status.Enabled {
+			c.Next()
+			return
+		}
+		for _, prefix := range exempt {
+			if strings.HasPrefix(c.Request.URL.Path, prefix) {
+				c.Next()
+				return
+			}
+		}
+
+		if status.RetryAfterSeconds > 0 {
+			c.Header("Retry-After", strconv.Itoa(status.RetryAfterSeconds))
+		}
+		response.AbortWithData(c, errs.ErrServiceUnavailable("The API is down for maintenance, please try again later").WithCode(service.CodeMaintenance), status)
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ratelimit"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// RateLimitKeyFunc identifies the client a request is counted against.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// KeyByClientIP counts requests per client IP.
+func KeyByClientIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// KeyByAdmin counts requests per authenticated admin or API key, falling back to the client IP.
+// Must run after AuthMiddleware to see the admin ID.
+func KeyByAdmin(c *gin.Context) string {
+	if keyID, ok := c.Get(ContextKeyAPIKeyID); ok {
+		if id, ok := keyID.(uuid.UUID); ok {
+			return "apikey:" + id.String()
+		}
+	}
+	if adminID, ok := c.Get(ContextKeyAdminID); ok {
+		if id, ok := adminID.(uuid.UUID); ok {
+			return "admin:" + id.String()
+		}
+	}
+	return KeyByClientIP(c)
+}
+
+// RateLimitMiddleware returns a GIN middleware that allows rule.Requests requests per rule.Period
+// for each key within scope. Rejected requests receive 429 with a Retry-After header (seconds).
+// If the limiter backend fails, the request is let through so an outage does not take the API down.
+func RateLimitMiddleware(limiter ratelimit.Limiter, scope string, rule ratelimit.Rule, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		decision, err := limiter.Allow(c.Request.Context(), scope+":"+keyFunc(c), rule)
+		if err != nil {
+			slog.ErrorContext(c.Request.Context(), "rate limiter unavailable, allowing request", "error", err, "scope", scope)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(rule.Requests))
+		if !decision.Allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+			response.Abort(c, errs.ErrTooManyRequests("Too many requests, please try again later"))
+			return
+		}
+		c.Next()
+	}
+}
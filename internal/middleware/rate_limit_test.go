@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLimiter records the key it was asked about and answers with a fixed decision.
+type fakeLimiter struct {
+	key      string
+	decision ratelimit.Decision
+	err      error
+}
+
+func (l *fakeLimiter) Allow(_ context.Context, key string, _ ratelimit.Rule) (ratelimit.Decision, error) {
+	l.key = key
+	return l.decision, l.err
+}
+
+func TestRateLimitMiddleware_Key(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adminID, apiKeyID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		keyFunc        RateLimitKeyFunc
+		setup          func(c *gin.Context)
+		wantKey        string
+	}{
+		{
+			name:    "forwarded header ignored without trusted proxies",
+			keyFunc: KeyByClientIP,
+			wantKey: "test:ip:10.0.0.1",
+		},
+		{
+			name:           "forwarded header used from a trusted proxy",
+			trustedProxies: []string{"10.0.0.1"},
+			keyFunc:        KeyByClientIP,
+			wantKey:        "test:ip:203.0.113.7",
+		},
+		{
+			name:           "forwarded header ignored from another proxy",
+			trustedProxies: []string{"10.0.0.2"},
+			keyFunc:        KeyByClientIP,
+			wantKey:        "test:ip:10.0.0.1",
+		},
+		{
+			name:    "admin",
+			keyFunc: KeyByAdmin,
+			setup:   func(c *gin.Context) { c.Set(ContextKeyAdminID, adminID) },
+			wantKey: "test:admin:" + adminID.String(),
+		},
+		{
+			name:    "api key wins over admin",
+			keyFunc: KeyByAdmin,
+			setup: func(c *gin.Context) {
+				c.Set(ContextKeyAdminID, adminID)
+				c.Set(ContextKeyAPIKeyID, apiKeyID)
+			},
+			wantKey: "test:apikey:" + apiKeyID.String(),
+		},
+		{
+			name:    "anonymous falls back to the client IP",
+			keyFunc: KeyByAdmin,
+			wantKey: "test:ip:10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &fakeLimiter{decision: ratelimit.Decision{Allowed: true}}
+			r := gin.New()
+			require.NoError(t, r.SetTrustedProxies(tt.trustedProxies))
+			if tt.setup != nil {
+				r.Use(func(c *gin.Context) { tt.setup(c) })
+			}
+			r.Use(RateLimitMiddleware(limiter, "test", ratelimit.Rule{Requests: 10, Period: time.Minute}, tt.keyFunc))
+			r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "10.0.0.1:4321"
+			req.Header.Set("X-Forwarded-For", "203.0.113.7")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.wantKey, limiter.key)
+		})
+	}
+}
+
+func TestRateLimitMiddleware_Decision(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		limiter        *fakeLimiter
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{
+			name:       "allowed",
+			limiter:    &fakeLimiter{decision: ratelimit.Decision{Allowed: true}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:           "rejected rounds retry after up",
+			limiter:        &fakeLimiter{decision: ratelimit.Decision{RetryAfter: 1500 * time.Millisecond}},
+			wantStatus:     http.StatusTooManyRequests,
+			wantRetryAfter: "2",
+		},
+		{
+			name:       "limiter failure lets the request through",
+			limiter:    &fakeLimiter{err: errors.New("redis down")},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(RateLimitMiddleware(tt.limiter, "test", ratelimit.Rule{Requests: 5, Period: time.Minute}, KeyByClientIP))
+			r.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRetryAfter, w.Header().Get("Retry-After"))
+			if tt.limiter.err == nil {
+				assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// RecoveryMiddleware returns a GIN middleware that turns a panic in a later handler into
+// a 500 response in the standard error envelope. The panic is logged with its stack trace
+// and request ID and sent to the error tracker. Panics caused by the client hanging up are
+// only logged, as no response can be delivered.
+// Must run after RequestIDMiddleware and ErrorTrackingMiddleware so the log line and the
+// report carry the request ID.
+func RecoveryMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// http.ErrAbortHandler is the documented way to abort a response; let net/http handle it
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			err, ok := recovered.(error)
+			if !ok {
+				err = fmt.Errorf("%v", recovered)
+			}
+			ctx := c.Request.Context()
+
+			if isBrokenConnection(err) {
+				slog.WarnContext(ctx, "client connection closed while writing response", "error", err, "path", c.Request.URL.Path)
+				c.Abort()
+				return
+			}
+
+			stack := debug.Stack()
+			slog.ErrorContext(ctx, "panic recovered",
+				"error", err,
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+				"stack", string(stack),
+			)
+			ReportError(c, err, http.StatusInternalServerError, stack)
+
+			// Part of a response may already be on the wire; another status line would be ignored
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			response.Abort(c, errs.ErrInternal("Internal server error"))
+		}()
+
+		c.Next()
+	}
+}
+
+// isBrokenConnection reports whether err comes from writing to a client that has gone away.
+func isBrokenConnection(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var sysErr *os.SyscallError
+	if errors.As(opErr, &sysErr) {
+		return errors.Is(sysErr.Err, syscall.EPIPE) || errors.Is(sysErr.Err, syscall.ECONNRESET)
+	}
+	return false
+}
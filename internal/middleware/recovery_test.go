@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errtrack"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/logging"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTracker records the captured events.
+type fakeTracker struct {
+	events []errtrack.Event
+}
+
+func (t *fakeTracker) Capture(_ context.Context, event errtrack.Event) {
+	t.events = append(t.events, event)
+}
+
+// captureLogs sends the default logger's JSON output to the returned buffer for the rest of the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(logging.NewContextHandler(slog.NewJSONHandler(&buf, nil))))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func newRecoveryRouter(tracker errtrack.Tracker, handler gin.HandlerFunc) *gin.Engine {
+	r := gin.New()
+	r.Use(RequestIDMiddleware(), ErrorTrackingMiddleware(tracker), RecoveryMiddleware())
+	r.GET("/teams/:id", handler)
+	return r
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{name: "error", value: errors.New("nil map")},
+		{name: "string", value: "index out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+			tracker := &fakeTracker{}
+			r := newRecoveryRouter(tracker, func(c *gin.Context) { panic(tt.value) })
+
+			req := httptest.NewRequest(http.MethodGet, "/teams/1", nil)
+			req.Header.Set(HeaderRequestID, "req-42")
+			w := httptest.NewRecorder()
+			require.NotPanics(t, func() { r.ServeHTTP(w, req) })
+
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+			assert.Equal(t, "req-42", w.Header().Get(HeaderRequestID))
+			var envelope response.Envelope
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &envelope))
+			assert.Equal(t, response.Envelope{Status: "error", Code: errs.CodeInternal, Message: "Internal server error"}, envelope)
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+			assert.Equal(t, "panic recovered", entry["msg"])
+			assert.Equal(t, "req-42", entry["request_id"])
+			assert.Equal(t, fmt.Sprint(tt.value), entry["error"])
+			assert.NotEmpty(t, entry["stack"])
+
+			require.Len(t, tracker.events, 1)
+			event := tracker.events[0]
+			assert.Equal(t, "req-42", event.Tags["request_id"])
+			assert.Equal(t, "/teams/:id", event.Tags["route"])
+			assert.Equal(t, "500", event.Tags["status"])
+			assert.NotEmpty(t, event.Stack)
+		})
+	}
+}
+
+func TestRecoveryMiddleware_AfterWrite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	captureLogs(t)
+	tracker := &fakeTracker{}
+	r := newRecoveryRouter(tracker, func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic("late failure")
+	})
+
+	w := httptest.NewRecorder()
+	require.NotPanics(t, func() { r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/teams/1", nil)) })
+
+	// The status line is already sent, so nothing is appended to the body
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "partial", w.Body.String())
+	assert.Len(t, tracker.events, 1)
+}
+
+func TestRecoveryMiddleware_BrokenConnection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logs := captureLogs(t)
+	tracker := &fakeTracker{}
+	r := newRecoveryRouter(tracker, func(c *gin.Context) {
+		panic(&net.OpError{Op: "write", Net: "tcp", Err: os.NewSyscallError("write", syscall.EPIPE)})
+	})
+
+	require.NotPanics(t, func() { r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/teams/1", nil)) })
+	assert.Empty(t, tracker.events)
+	assert.Contains(t, logs.String(), "client connection closed while writing response")
+}
+
+func TestRecoveryMiddleware_AbortHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tracker := &fakeTracker{}
+	r := newRecoveryRouter(tracker, func(c *gin.Context) { panic(http.ErrAbortHandler) })
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/teams/1", nil))
+	})
+	assert.Empty(t, tracker.events)
+}
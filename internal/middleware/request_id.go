@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/logging"
+)
+
+const (
+	// HeaderRequestID is the header used to propagate the request ID.
+	HeaderRequestID = "X-Request-ID"
+	// ContextKeyRequestID is the GIN context key holding the request ID.
+	ContextKeyRequestID = "request_id"
+)
+
+// validRequestID limits client-supplied IDs to a safe charset and length so they can be logged verbatim.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestIDMiddleware returns a GIN middleware that reuses the client's X-Request-ID
+// (when well-formed) or generates a new one, echoes it in the response and stores it
+// in both the GIN context and the request context used for logging.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(HeaderRequestID)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.Must(uuid.NewV7()).String()
+		}
+
+		c.Set(ContextKeyRequestID, requestID)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
+		c.Header(HeaderRequestID, requestID)
+
+		c.Next()
+	}
+}
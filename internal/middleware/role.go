@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// RoleMiddleware returns a GIN middleware that only lets the given roles through.
+// Must run after AuthMiddleware so the role claim is available in the context.
+// Requests from any other role receive 403.
+func RoleMiddleware(allowed ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !slices.Contains(allowed, c.GetString(ContextKeyRole)) {
+			response.Abort(c, errs.ErrForbidden("Insufficient permissions for this operation").WithCode(service.CodeInsufficientRole))
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,276 @@
+// Package migrate creates and updates the database schema.
+package migrate
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/slug"
+	"gorm.io/gorm"
+)
+
+// tabler is implemented by every model to declare its table name.
+type tabler interface {
+	TableName() string
+}
+
+// models lists every model managed by AutoMigrate.
+func models() []tabler {
+	return []tabler{
+		&model.Admin{},
+		&model.RefreshToken{},
+		&model.LoginAttempt{},
+		&model.PasswordHistory{},
+		&model.NotificationPreference{},
+		&model.Team{},
+		&model.TeamManager{},
+		&model.Player{},
+		&model.Coach{},
+		&model.PlayerAbsence{},
+		&model.Competition{},
+		&model.Season{},
+		&model.Group{},
+		&model.GroupTeam{},
+		&model.Stadium{},
+		&model.Match{},
+		&model.MatchEvent{},
+		&model.MatchStatusChange{},
+		&model.StandingSnapshot{},
+		&model.TeamRating{},
+		&model.ExternalRef{},
+		&model.MatchLineup{},
+		&model.Referee{},
+		&model.MatchOfficial{},
+		&model.MatchAttachment{},
+		&model.ConfirmationToken{},
+		&model.AuditLog{},
+		&model.APIKey{},
+		&model.Webhook{},
+		&model.WebhookDelivery{},
+	}
+}
+
+// Tables returns the table names of all migrated models.
+// Used by the health check to verify the schema is complete.
+func Tables() []string {
+	all := models()
+	tables := make([]string, len(all))
+	for i, m := range all {
+		tables[i] = m.TableName()
+	}
+	return tables
+}
+
+// Run brings the schema up to date: it converts legacy columns and tables, then runs
+// GORM AutoMigrate for all models. matchLoc is the timezone legacy match dates and
+// times were entered in. Run is idempotent and is called on every start.
+func Run(db *gorm.DB, matchLoc *time.Location) error {
+	// Must run first: AutoMigrate cannot add the NOT NULL kick-off column to existing rows
+	if err := migrateMatchDatetime(db, matchLoc); err != nil {
+		return err
+	}
+	// Must run before AutoMigrate creates the unique index on team names
+	if err := dedupeTeamNames(db); err != nil {
+		return err
+	}
+
+	all := models()
+	dst := make([]any, len(all))
+	for i, m := range all {
+		dst[i] = m
+	}
+	if err := db.AutoMigrate(dst...); err != nil {
+		return err
+	}
+	if err := dropRedundantIndexes(db); err != nil {
+		return err
+	}
+	if err := backfillSlugs(db, "teams", "team"); err != nil {
+		return err
+	}
+	if err := backfillSlugs(db, "players", "player"); err != nil {
+		return err
+	}
+
+	// Promote accounts created before RBAC existed so they keep full access
+	result := db.Model(&model.Admin{}).Where("role = ?", model.RoleLegacyAdmin).Update("role", model.RoleSuperAdmin)
+	if result.Error != nil {
+		return fmt.Errorf("failed to migrate legacy admin roles: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		slog.Info("migrated legacy admin accounts to super_admin", "count", result.RowsAffected)
+	}
+
+	return migrateLegacyGoals(db)
+}
+
+// redundantIndexes lists indexes created by earlier versions that a composite index starting
+// with the same column now serves. AutoMigrate never drops indexes, so Run does.
+var redundantIndexes = []struct {
+	model tabler
+	name  string
+}{
+	{&model.MatchEvent{}, "idx_match_events_match_id"}, // idx_match_events_match_minute
+}
+
+// dropRedundantIndexes drops the indexes in redundantIndexes. It is a no-op once they are gone.
+func dropRedundantIndexes(db *gorm.DB) error {
+	migrator := db.Migrator()
+	for _, index := range redundantIndexes {
+		if !migrator.HasIndex(index.model, index.name) {
+			continue
+		}
+		if err := migrator.DropIndex(index.model, index.name); err != nil {
+			return fmt.Errorf("failed to drop index %s: %w", index.name, err)
+		}
+		slog.Info("dropped redundant index", "index", index.name)
+	}
+	return nil
+}
+
+// migrateLegacyGoals moves rows from the pre-events goals table into match_events
+// as "goal" events and drops the old table. It is a no-op once the table is gone.
+func migrateLegacyGoals(db *gorm.DB) error {
+	if !db.Migrator().HasTable("goals") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`
+			INSERT INTO match_events (id, match_id, type, player_id, team_id, minute, created_at, updated_at, deleted_at)
+			SELECT id, match_id, ?, player_id, team_id, minute, created_at, updated_at, deleted_at FROM goals
+			ON CONFLICT (id) DO NOTHING`, model.EventGoal)
+		if result.Error != nil {
+			return fmt.Errorf("failed to migrate legacy goals: %w", result.Error)
+		}
+		if err := tx.Migrator().DropTable("goals"); err != nil {
+			return fmt.Errorf("failed to drop legacy goals table: %w", err)
+		}
+		slog.Info("migrated legacy goals to match_events", "count", result.RowsAffected)
+		return nil
+	})
+}
+
+// backfillSlugs gives the non-deleted rows of table created before slugs existed a slug made
+// from their name, oldest first so they get the unsuffixed slug when names clash. fallback is
+// the slug base for names without letters or digits. It is a no-op once every row has a slug.
+func backfillSlugs(db *gorm.DB, table, fallback string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var missing []struct {
+			ID   uuid.UUID
+			Name string
+		}
+		err := tx.Table(table).Select("id, name").
+			Where("deleted_at IS NULL AND slug = ''").Order("created_at, id").Scan(&missing).Error
+		if err != nil {
+			return fmt.Errorf("failed to find %s without slugs: %w", table, err)
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+
+		var taken []string
+		if err := tx.Table(table).Where("deleted_at IS NULL AND slug <> ''").Pluck("slug", &taken).Error; err != nil {
+			return fmt.Errorf("failed to fetch %s slugs: %w", table, err)
+		}
+		for _, row := range missing {
+			base := slug.Make(row.Name)
+			if base == "" {
+				base = fallback
+			}
+			s := slug.Unique(base, taken)
+			if err := tx.Table(table).Where("id = ?", row.ID).Update("slug", s).Error; err != nil {
+				return fmt.Errorf("failed to set slug of %s %s: %w", fallback, row.ID, err)
+			}
+			taken = append(taken, s)
+		}
+		slog.Info("generated slugs", "table", table, "count", len(missing))
+		return nil
+	})
+}
+
+// dedupeTeamNames renames non-deleted teams whose names differ only in case from an older
+// team's, e.g. rows created by a double-submitted form, so the unique index on lower(name)
+// can be created. The oldest team keeps the name; the others get a " (2)", " (3)" suffix and
+// are logged so admins can merge or rename them. It is a no-op without duplicates.
+func dedupeTeamNames(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&model.Team{}) {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			ID   uuid.UUID
+			Name string
+			Rank int
+		}
+		err := tx.Raw(`
+			SELECT id, name, rank FROM (
+				SELECT id, name, ROW_NUMBER() OVER (PARTITION BY lower(name) ORDER BY created_at, id) AS rank
+				FROM teams WHERE deleted_at IS NULL
+			) ranked WHERE rank > 1 ORDER BY lower(name), rank`).Scan(&rows).Error
+		if err != nil {
+			return fmt.Errorf("failed to find duplicate team names: %w", err)
+		}
+
+		for _, row := range rows {
+			name := fmt.Sprintf("%s (%d)", row.Name, row.Rank)
+			if err := tx.Exec("UPDATE teams SET name = ?, version = version + 1 WHERE id = ?", name, row.ID).Error; err != nil {
+				return fmt.Errorf("failed to rename duplicate team %s: %w", row.ID, err)
+			}
+			slog.Warn("renamed team with a duplicate name", "team_id", row.ID, "name", row.Name, "new_name", name)
+		}
+		return nil
+	})
+}
+
+// migrateMatchDatetime converts the legacy text match_date (YYYY-MM-DD) and match_time (HH:MM)
+// columns into the match_datetime timestamp, reading them in loc, and drops the old columns.
+// Rows whose values cannot be parsed fall back to midnight of the date, or to their creation time.
+// It is a no-op once the legacy columns are gone.
+func migrateMatchDatetime(db *gorm.DB, loc *time.Location) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&model.Match{}) || !migrator.HasColumn(&model.Match{}, "match_date") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&model.Match{}, "match_datetime") {
+			if err := tx.Exec("ALTER TABLE matches ADD COLUMN match_datetime timestamptz").Error; err != nil {
+				return fmt.Errorf("failed to add match_datetime column: %w", err)
+			}
+		}
+
+		var rows []struct {
+			ID        uuid.UUID
+			MatchDate string
+			MatchTime string
+			CreatedAt time.Time
+		}
+		if err := tx.Raw("SELECT id, match_date, match_time, created_at FROM matches").Scan(&rows).Error; err != nil {
+			return fmt.Errorf("failed to read legacy match dates: %w", err)
+		}
+
+		for _, row := range rows {
+			kickoff, err := time.ParseInLocation("2006-01-02 15:04", row.MatchDate+" "+row.MatchTime, loc)
+			if err != nil {
+				if kickoff, err = time.ParseInLocation("2006-01-02", row.MatchDate, loc); err != nil {
+					kickoff = row.CreatedAt
+				}
+				slog.Warn("legacy match date could not be fully parsed", "match_id", row.ID, "match_date", row.MatchDate, "match_time", row.MatchTime, "match_datetime", kickoff.UTC())
+			}
+			if err := tx.Exec("UPDATE matches SET match_datetime = ? WHERE id = ?", kickoff.UTC(), row.ID).Error; err != nil {
+				return fmt.Errorf("failed to migrate match %s date: %w", row.ID, err)
+			}
+		}
+
+		if err := tx.Exec("ALTER TABLE matches ALTER COLUMN match_datetime SET NOT NULL, DROP COLUMN match_date, DROP COLUMN match_time").Error; err != nil {
+			return fmt.Errorf("failed to drop legacy match date columns: %w", err)
+		}
+		slog.Info("migrated match dates to match_datetime", "count", len(rows), "timezone", loc.String())
+		return nil
+	})
+}
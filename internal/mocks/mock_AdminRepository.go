@@ -22,6 +22,117 @@ func (_m *MockAdminRepository) EXPECT() *MockAdminRepository_Expecter {
 	return &MockAdminRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function with no fields
+func (_m *MockAdminRepository) Count() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockAdminRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+func (_e *MockAdminRepository_Expecter) Count() *MockAdminRepository_Count_Call {
+	return &MockAdminRepository_Count_Call{Call: _e.mock.On("Count")}
+}
+
+func (_c *MockAdminRepository_Count_Call) Run(run func()) *MockAdminRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAdminRepository_Count_Call) Return(_a0 int64, _a1 error) *MockAdminRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockAdminRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByRole provides a mock function with given fields: role
+func (_m *MockAdminRepository) CountByRole(role string) (int64, error) {
+	ret := _m.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByRole")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(role)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminRepository_CountByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByRole'
+type MockAdminRepository_CountByRole_Call struct {
+	*mock.Call
+}
+
+// CountByRole is a helper method to define mock.On call
+//   - role string
+func (_e *MockAdminRepository_Expecter) CountByRole(role interface{}) *MockAdminRepository_CountByRole_Call {
+	return &MockAdminRepository_CountByRole_Call{Call: _e.mock.On("CountByRole", role)}
+}
+
+func (_c *MockAdminRepository_CountByRole_Call) Run(run func(role string)) *MockAdminRepository_CountByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminRepository_CountByRole_Call) Return(_a0 int64, _a1 error) *MockAdminRepository_CountByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminRepository_CountByRole_Call) RunAndReturn(run func(string) (int64, error)) *MockAdminRepository_CountByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: admin
 func (_m *MockAdminRepository) Create(admin *model.Admin) error {
 	ret := _m.Called(admin)
@@ -68,6 +179,113 @@ func (_c *MockAdminRepository_Create_Call) RunAndReturn(run func(*model.Admin) e
 	return _c
 }
 
+// Delete provides a mock function with given fields: id
+func (_m *MockAdminRepository) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAdminRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockAdminRepository_Expecter) Delete(id interface{}) *MockAdminRepository_Delete_Call {
+	return &MockAdminRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockAdminRepository_Delete_Call) Run(run func(id uuid.UUID)) *MockAdminRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockAdminRepository_Delete_Call) Return(_a0 error) *MockAdminRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *MockAdminRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: offset, limit, sortBy, sortOrder
+func (_m *MockAdminRepository) FindAll(offset int, limit int, sortBy string, sortOrder string) ([]model.Admin, error) {
+	ret := _m.Called(offset, limit, sortBy, sortOrder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.Admin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]model.Admin, error)); ok {
+		return rf(offset, limit, sortBy, sortOrder)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string, string) []model.Admin); ok {
+		r0 = rf(offset, limit, sortBy, sortOrder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Admin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string, string) error); ok {
+		r1 = rf(offset, limit, sortBy, sortOrder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdminRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockAdminRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - offset int
+//   - limit int
+//   - sortBy string
+//   - sortOrder string
+func (_e *MockAdminRepository_Expecter) FindAll(offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockAdminRepository_FindAll_Call {
+	return &MockAdminRepository_FindAll_Call{Call: _e.mock.On("FindAll", offset, limit, sortBy, sortOrder)}
+}
+
+func (_c *MockAdminRepository_FindAll_Call) Run(run func(offset int, limit int, sortBy string, sortOrder string)) *MockAdminRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdminRepository_FindAll_Call) Return(_a0 []model.Admin, _a1 error) *MockAdminRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdminRepository_FindAll_Call) RunAndReturn(run func(int, int, string, string) ([]model.Admin, error)) *MockAdminRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function with given fields: id
 func (_m *MockAdminRepository) FindByID(id uuid.UUID) (*model.Admin, error) {
 	ret := _m.Called(id)
@@ -184,6 +402,52 @@ func (_c *MockAdminRepository_FindByUsername_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// Update provides a mock function with given fields: admin
+func (_m *MockAdminRepository) Update(admin *model.Admin) error {
+	ret := _m.Called(admin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Admin) error); ok {
+		r0 = rf(admin)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAdminRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockAdminRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - admin *model.Admin
+func (_e *MockAdminRepository_Expecter) Update(admin interface{}) *MockAdminRepository_Update_Call {
+	return &MockAdminRepository_Update_Call{Call: _e.mock.On("Update", admin)}
+}
+
+func (_c *MockAdminRepository_Update_Call) Run(run func(admin *model.Admin)) *MockAdminRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Admin))
+	})
+	return _c
+}
+
+func (_c *MockAdminRepository_Update_Call) Return(_a0 error) *MockAdminRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAdminRepository_Update_Call) RunAndReturn(run func(*model.Admin) error) *MockAdminRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAdminRepository creates a new instance of MockAdminRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAdminRepository(t interface {
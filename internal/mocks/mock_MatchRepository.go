@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	time "time"
+
 	model "github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	repository "github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -22,27 +25,27 @@ func (_m *MockMatchRepository) EXPECT() *MockMatchRepository_Expecter {
 	return &MockMatchRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *MockMatchRepository) Count() (int64, error) {
-	ret := _m.Called()
+// ArchiveSeasonsEndedBefore provides a mock function with given fields: date
+func (_m *MockMatchRepository) ArchiveSeasonsEndedBefore(date string) (int64, error) {
+	ret := _m.Called(date)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Count")
+		panic("no return value specified for ArchiveSeasonsEndedBefore")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(date)
 	}
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(date)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(date)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -50,54 +53,57 @@ func (_m *MockMatchRepository) Count() (int64, error) {
 	return r0, r1
 }
 
-// MockMatchRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
-type MockMatchRepository_Count_Call struct {
+// MockMatchRepository_ArchiveSeasonsEndedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveSeasonsEndedBefore'
+type MockMatchRepository_ArchiveSeasonsEndedBefore_Call struct {
 	*mock.Call
 }
 
-// Count is a helper method to define mock.On call
-func (_e *MockMatchRepository_Expecter) Count() *MockMatchRepository_Count_Call {
-	return &MockMatchRepository_Count_Call{Call: _e.mock.On("Count")}
+// ArchiveSeasonsEndedBefore is a helper method to define mock.On call
+//   - date string
+func (_e *MockMatchRepository_Expecter) ArchiveSeasonsEndedBefore(date interface{}) *MockMatchRepository_ArchiveSeasonsEndedBefore_Call {
+	return &MockMatchRepository_ArchiveSeasonsEndedBefore_Call{Call: _e.mock.On("ArchiveSeasonsEndedBefore", date)}
 }
 
-func (_c *MockMatchRepository_Count_Call) Run(run func()) *MockMatchRepository_Count_Call {
+func (_c *MockMatchRepository_ArchiveSeasonsEndedBefore_Call) Run(run func(date string)) *MockMatchRepository_ArchiveSeasonsEndedBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_Count_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_Count_Call {
+func (_c *MockMatchRepository_ArchiveSeasonsEndedBefore_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_ArchiveSeasonsEndedBefore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockMatchRepository_Count_Call {
+func (_c *MockMatchRepository_ArchiveSeasonsEndedBefore_Call) RunAndReturn(run func(string) (int64, error)) *MockMatchRepository_ArchiveSeasonsEndedBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountCompletedMatches provides a mock function with no fields
-func (_m *MockMatchRepository) CountCompletedMatches() (int64, error) {
-	ret := _m.Called()
+// AttendanceByHomeTeam provides a mock function with given fields: filter
+func (_m *MockMatchRepository) AttendanceByHomeTeam(filter repository.MatchFilter) ([]repository.AttendanceStats, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountCompletedMatches")
+		panic("no return value specified for AttendanceByHomeTeam")
 	}
 
-	var r0 int64
+	var r0 []repository.AttendanceStats
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]repository.AttendanceStats, error)); ok {
+		return rf(filter)
 	}
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []repository.AttendanceStats); ok {
+		r0 = rf(filter)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.AttendanceStats)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -105,54 +111,57 @@ func (_m *MockMatchRepository) CountCompletedMatches() (int64, error) {
 	return r0, r1
 }
 
-// MockMatchRepository_CountCompletedMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountCompletedMatches'
-type MockMatchRepository_CountCompletedMatches_Call struct {
+// MockMatchRepository_AttendanceByHomeTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttendanceByHomeTeam'
+type MockMatchRepository_AttendanceByHomeTeam_Call struct {
 	*mock.Call
 }
 
-// CountCompletedMatches is a helper method to define mock.On call
-func (_e *MockMatchRepository_Expecter) CountCompletedMatches() *MockMatchRepository_CountCompletedMatches_Call {
-	return &MockMatchRepository_CountCompletedMatches_Call{Call: _e.mock.On("CountCompletedMatches")}
+// AttendanceByHomeTeam is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) AttendanceByHomeTeam(filter interface{}) *MockMatchRepository_AttendanceByHomeTeam_Call {
+	return &MockMatchRepository_AttendanceByHomeTeam_Call{Call: _e.mock.On("AttendanceByHomeTeam", filter)}
 }
 
-func (_c *MockMatchRepository_CountCompletedMatches_Call) Run(run func()) *MockMatchRepository_CountCompletedMatches_Call {
+func (_c *MockMatchRepository_AttendanceByHomeTeam_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_AttendanceByHomeTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(repository.MatchFilter))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_CountCompletedMatches_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_CountCompletedMatches_Call {
+func (_c *MockMatchRepository_AttendanceByHomeTeam_Call) Return(_a0 []repository.AttendanceStats, _a1 error) *MockMatchRepository_AttendanceByHomeTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_CountCompletedMatches_Call) RunAndReturn(run func() (int64, error)) *MockMatchRepository_CountCompletedMatches_Call {
+func (_c *MockMatchRepository_AttendanceByHomeTeam_Call) RunAndReturn(run func(repository.MatchFilter) ([]repository.AttendanceStats, error)) *MockMatchRepository_AttendanceByHomeTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CountWins provides a mock function with given fields: teamID
-func (_m *MockMatchRepository) CountWins(teamID uuid.UUID) (int, error) {
-	ret := _m.Called(teamID)
+// AttendanceByVenue provides a mock function with given fields: filter
+func (_m *MockMatchRepository) AttendanceByVenue(filter repository.MatchFilter) ([]repository.AttendanceStats, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountWins")
+		panic("no return value specified for AttendanceByVenue")
 	}
 
-	var r0 int
+	var r0 []repository.AttendanceStats
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) (int, error)); ok {
-		return rf(teamID)
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]repository.AttendanceStats, error)); ok {
+		return rf(filter)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) int); ok {
-		r0 = rf(teamID)
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []repository.AttendanceStats); ok {
+		r0 = rf(filter)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.AttendanceStats)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(teamID)
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -160,149 +169,169 @@ func (_m *MockMatchRepository) CountWins(teamID uuid.UUID) (int, error) {
 	return r0, r1
 }
 
-// MockMatchRepository_CountWins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWins'
-type MockMatchRepository_CountWins_Call struct {
+// MockMatchRepository_AttendanceByVenue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttendanceByVenue'
+type MockMatchRepository_AttendanceByVenue_Call struct {
 	*mock.Call
 }
 
-// CountWins is a helper method to define mock.On call
-//   - teamID uuid.UUID
-func (_e *MockMatchRepository_Expecter) CountWins(teamID interface{}) *MockMatchRepository_CountWins_Call {
-	return &MockMatchRepository_CountWins_Call{Call: _e.mock.On("CountWins", teamID)}
+// AttendanceByVenue is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) AttendanceByVenue(filter interface{}) *MockMatchRepository_AttendanceByVenue_Call {
+	return &MockMatchRepository_AttendanceByVenue_Call{Call: _e.mock.On("AttendanceByVenue", filter)}
 }
 
-func (_c *MockMatchRepository_CountWins_Call) Run(run func(teamID uuid.UUID)) *MockMatchRepository_CountWins_Call {
+func (_c *MockMatchRepository_AttendanceByVenue_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_AttendanceByVenue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID))
+		run(args[0].(repository.MatchFilter))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_CountWins_Call) Return(_a0 int, _a1 error) *MockMatchRepository_CountWins_Call {
+func (_c *MockMatchRepository_AttendanceByVenue_Call) Return(_a0 []repository.AttendanceStats, _a1 error) *MockMatchRepository_AttendanceByVenue_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_CountWins_Call) RunAndReturn(run func(uuid.UUID) (int, error)) *MockMatchRepository_CountWins_Call {
+func (_c *MockMatchRepository_AttendanceByVenue_Call) RunAndReturn(run func(repository.MatchFilter) ([]repository.AttendanceStats, error)) *MockMatchRepository_AttendanceByVenue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: match
-func (_m *MockMatchRepository) Create(match *model.Match) error {
-	ret := _m.Called(match)
+// Count provides a mock function with given fields: filter
+func (_m *MockMatchRepository) Count(filter repository.MatchFilter) (int64, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Count")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*model.Match) error); ok {
-		r0 = rf(match)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) (int64, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) int64); ok {
+		r0 = rf(filter)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockMatchRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockMatchRepository_Create_Call struct {
+// MockMatchRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockMatchRepository_Count_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - match *model.Match
-func (_e *MockMatchRepository_Expecter) Create(match interface{}) *MockMatchRepository_Create_Call {
-	return &MockMatchRepository_Create_Call{Call: _e.mock.On("Create", match)}
+// Count is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) Count(filter interface{}) *MockMatchRepository_Count_Call {
+	return &MockMatchRepository_Count_Call{Call: _e.mock.On("Count", filter)}
 }
 
-func (_c *MockMatchRepository_Create_Call) Run(run func(match *model.Match)) *MockMatchRepository_Create_Call {
+func (_c *MockMatchRepository_Count_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*model.Match))
+		run(args[0].(repository.MatchFilter))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_Create_Call) Return(_a0 error) *MockMatchRepository_Create_Call {
-	_c.Call.Return(_a0)
+func (_c *MockMatchRepository_Count_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_Create_Call) RunAndReturn(run func(*model.Match) error) *MockMatchRepository_Create_Call {
+func (_c *MockMatchRepository_Count_Call) RunAndReturn(run func(repository.MatchFilter) (int64, error)) *MockMatchRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockMatchRepository) Delete(id uuid.UUID) error {
-	ret := _m.Called(id)
+// CountCompletedMatches provides a mock function with given fields: filter
+func (_m *MockMatchRepository) CountCompletedMatches(filter repository.MatchFilter) (int64, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for CountCompletedMatches")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = rf(id)
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) (int64, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) int64); ok {
+		r0 = rf(filter)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockMatchRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockMatchRepository_Delete_Call struct {
+// MockMatchRepository_CountCompletedMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountCompletedMatches'
+type MockMatchRepository_CountCompletedMatches_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockMatchRepository_Expecter) Delete(id interface{}) *MockMatchRepository_Delete_Call {
-	return &MockMatchRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+// CountCompletedMatches is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) CountCompletedMatches(filter interface{}) *MockMatchRepository_CountCompletedMatches_Call {
+	return &MockMatchRepository_CountCompletedMatches_Call{Call: _e.mock.On("CountCompletedMatches", filter)}
 }
 
-func (_c *MockMatchRepository_Delete_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_Delete_Call {
+func (_c *MockMatchRepository_CountCompletedMatches_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_CountCompletedMatches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID))
+		run(args[0].(repository.MatchFilter))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_Delete_Call) Return(_a0 error) *MockMatchRepository_Delete_Call {
-	_c.Call.Return(_a0)
+func (_c *MockMatchRepository_CountCompletedMatches_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_CountCompletedMatches_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *MockMatchRepository_Delete_Call {
+func (_c *MockMatchRepository_CountCompletedMatches_Call) RunAndReturn(run func(repository.MatchFilter) (int64, error)) *MockMatchRepository_CountCompletedMatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAll provides a mock function with given fields: offset, limit, sortBy, sortOrder
-func (_m *MockMatchRepository) FindAll(offset int, limit int, sortBy string, sortOrder string) ([]model.Match, error) {
-	ret := _m.Called(offset, limit, sortBy, sortOrder)
+// CountManOfTheMatchAwards provides a mock function with given fields: filter
+func (_m *MockMatchRepository) CountManOfTheMatchAwards(filter repository.MatchFilter) ([]repository.ManOfTheMatchAwards, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindAll")
+		panic("no return value specified for CountManOfTheMatchAwards")
 	}
 
-	var r0 []model.Match
+	var r0 []repository.ManOfTheMatchAwards
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]model.Match, error)); ok {
-		return rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]repository.ManOfTheMatchAwards, error)); ok {
+		return rf(filter)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string, string) []model.Match); ok {
-		r0 = rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []repository.ManOfTheMatchAwards); ok {
+		r0 = rf(filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Match)
+			r0 = ret.Get(0).([]repository.ManOfTheMatchAwards)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, string, string) error); ok {
-		r1 = rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -310,60 +339,55 @@ func (_m *MockMatchRepository) FindAll(offset int, limit int, sortBy string, sor
 	return r0, r1
 }
 
-// MockMatchRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
-type MockMatchRepository_FindAll_Call struct {
+// MockMatchRepository_CountManOfTheMatchAwards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountManOfTheMatchAwards'
+type MockMatchRepository_CountManOfTheMatchAwards_Call struct {
 	*mock.Call
 }
 
-// FindAll is a helper method to define mock.On call
-//   - offset int
-//   - limit int
-//   - sortBy string
-//   - sortOrder string
-func (_e *MockMatchRepository_Expecter) FindAll(offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockMatchRepository_FindAll_Call {
-	return &MockMatchRepository_FindAll_Call{Call: _e.mock.On("FindAll", offset, limit, sortBy, sortOrder)}
+// CountManOfTheMatchAwards is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) CountManOfTheMatchAwards(filter interface{}) *MockMatchRepository_CountManOfTheMatchAwards_Call {
+	return &MockMatchRepository_CountManOfTheMatchAwards_Call{Call: _e.mock.On("CountManOfTheMatchAwards", filter)}
 }
 
-func (_c *MockMatchRepository_FindAll_Call) Run(run func(offset int, limit int, sortBy string, sortOrder string)) *MockMatchRepository_FindAll_Call {
+func (_c *MockMatchRepository_CountManOfTheMatchAwards_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_CountManOfTheMatchAwards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(string), args[3].(string))
+		run(args[0].(repository.MatchFilter))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_FindAll_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindAll_Call {
+func (_c *MockMatchRepository_CountManOfTheMatchAwards_Call) Return(_a0 []repository.ManOfTheMatchAwards, _a1 error) *MockMatchRepository_CountManOfTheMatchAwards_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_FindAll_Call) RunAndReturn(run func(int, int, string, string) ([]model.Match, error)) *MockMatchRepository_FindAll_Call {
+func (_c *MockMatchRepository_CountManOfTheMatchAwards_Call) RunAndReturn(run func(repository.MatchFilter) ([]repository.ManOfTheMatchAwards, error)) *MockMatchRepository_CountManOfTheMatchAwards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByID provides a mock function with given fields: id
-func (_m *MockMatchRepository) FindByID(id uuid.UUID) (*model.Match, error) {
-	ret := _m.Called(id)
+// CountUpcomingByTeamID provides a mock function with given fields: teamID
+func (_m *MockMatchRepository) CountUpcomingByTeamID(teamID uuid.UUID) (int64, error) {
+	ret := _m.Called(teamID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByID")
+		panic("no return value specified for CountUpcomingByTeamID")
 	}
 
-	var r0 *model.Match
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) (*model.Match, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (int64, error)); ok {
+		return rf(teamID)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) *model.Match); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID) int64); ok {
+		r0 = rf(teamID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Match)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+		r1 = rf(teamID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -371,57 +395,55 @@ func (_m *MockMatchRepository) FindByID(id uuid.UUID) (*model.Match, error) {
 	return r0, r1
 }
 
-// MockMatchRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
-type MockMatchRepository_FindByID_Call struct {
+// MockMatchRepository_CountUpcomingByTeamID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUpcomingByTeamID'
+type MockMatchRepository_CountUpcomingByTeamID_Call struct {
 	*mock.Call
 }
 
-// FindByID is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockMatchRepository_Expecter) FindByID(id interface{}) *MockMatchRepository_FindByID_Call {
-	return &MockMatchRepository_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+// CountUpcomingByTeamID is a helper method to define mock.On call
+//   - teamID uuid.UUID
+func (_e *MockMatchRepository_Expecter) CountUpcomingByTeamID(teamID interface{}) *MockMatchRepository_CountUpcomingByTeamID_Call {
+	return &MockMatchRepository_CountUpcomingByTeamID_Call{Call: _e.mock.On("CountUpcomingByTeamID", teamID)}
 }
 
-func (_c *MockMatchRepository_FindByID_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_FindByID_Call {
+func (_c *MockMatchRepository_CountUpcomingByTeamID_Call) Run(run func(teamID uuid.UUID)) *MockMatchRepository_CountUpcomingByTeamID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_FindByID_Call) Return(_a0 *model.Match, _a1 error) *MockMatchRepository_FindByID_Call {
+func (_c *MockMatchRepository_CountUpcomingByTeamID_Call) Return(_a0 int64, _a1 error) *MockMatchRepository_CountUpcomingByTeamID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_FindByID_Call) RunAndReturn(run func(uuid.UUID) (*model.Match, error)) *MockMatchRepository_FindByID_Call {
+func (_c *MockMatchRepository_CountUpcomingByTeamID_Call) RunAndReturn(run func(uuid.UUID) (int64, error)) *MockMatchRepository_CountUpcomingByTeamID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByIDWithDetails provides a mock function with given fields: id
-func (_m *MockMatchRepository) FindByIDWithDetails(id uuid.UUID) (*model.Match, error) {
-	ret := _m.Called(id)
+// CountWins provides a mock function with given fields: teamID
+func (_m *MockMatchRepository) CountWins(teamID uuid.UUID) (int, error) {
+	ret := _m.Called(teamID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByIDWithDetails")
+		panic("no return value specified for CountWins")
 	}
 
-	var r0 *model.Match
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID) (*model.Match, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (int, error)); ok {
+		return rf(teamID)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID) *model.Match); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uuid.UUID) int); ok {
+		r0 = rf(teamID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Match)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = rf(id)
+		r1 = rf(teamID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -429,57 +451,57 @@ func (_m *MockMatchRepository) FindByIDWithDetails(id uuid.UUID) (*model.Match,
 	return r0, r1
 }
 
-// MockMatchRepository_FindByIDWithDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDWithDetails'
-type MockMatchRepository_FindByIDWithDetails_Call struct {
+// MockMatchRepository_CountWins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWins'
+type MockMatchRepository_CountWins_Call struct {
 	*mock.Call
 }
 
-// FindByIDWithDetails is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockMatchRepository_Expecter) FindByIDWithDetails(id interface{}) *MockMatchRepository_FindByIDWithDetails_Call {
-	return &MockMatchRepository_FindByIDWithDetails_Call{Call: _e.mock.On("FindByIDWithDetails", id)}
+// CountWins is a helper method to define mock.On call
+//   - teamID uuid.UUID
+func (_e *MockMatchRepository_Expecter) CountWins(teamID interface{}) *MockMatchRepository_CountWins_Call {
+	return &MockMatchRepository_CountWins_Call{Call: _e.mock.On("CountWins", teamID)}
 }
 
-func (_c *MockMatchRepository_FindByIDWithDetails_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_FindByIDWithDetails_Call {
+func (_c *MockMatchRepository_CountWins_Call) Run(run func(teamID uuid.UUID)) *MockMatchRepository_CountWins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_FindByIDWithDetails_Call) Return(_a0 *model.Match, _a1 error) *MockMatchRepository_FindByIDWithDetails_Call {
+func (_c *MockMatchRepository_CountWins_Call) Return(_a0 int, _a1 error) *MockMatchRepository_CountWins_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_FindByIDWithDetails_Call) RunAndReturn(run func(uuid.UUID) (*model.Match, error)) *MockMatchRepository_FindByIDWithDetails_Call {
+func (_c *MockMatchRepository_CountWins_Call) RunAndReturn(run func(uuid.UUID) (int, error)) *MockMatchRepository_CountWins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindCompletedMatches provides a mock function with given fields: offset, limit
-func (_m *MockMatchRepository) FindCompletedMatches(offset int, limit int) ([]model.Match, error) {
-	ret := _m.Called(offset, limit)
+// CountWinsByTeamIDs provides a mock function with given fields: teamIDs
+func (_m *MockMatchRepository) CountWinsByTeamIDs(teamIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	ret := _m.Called(teamIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindCompletedMatches")
+		panic("no return value specified for CountWinsByTeamIDs")
 	}
 
-	var r0 []model.Match
+	var r0 map[uuid.UUID]int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]model.Match, error)); ok {
-		return rf(offset, limit)
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) (map[uuid.UUID]int, error)); ok {
+		return rf(teamIDs)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []model.Match); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) map[uuid.UUID]int); ok {
+		r0 = rf(teamIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Match)
+			r0 = ret.Get(0).(map[uuid.UUID]int)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(teamIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -487,41 +509,40 @@ func (_m *MockMatchRepository) FindCompletedMatches(offset int, limit int) ([]mo
 	return r0, r1
 }
 
-// MockMatchRepository_FindCompletedMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCompletedMatches'
-type MockMatchRepository_FindCompletedMatches_Call struct {
+// MockMatchRepository_CountWinsByTeamIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountWinsByTeamIDs'
+type MockMatchRepository_CountWinsByTeamIDs_Call struct {
 	*mock.Call
 }
 
-// FindCompletedMatches is a helper method to define mock.On call
-//   - offset int
-//   - limit int
-func (_e *MockMatchRepository_Expecter) FindCompletedMatches(offset interface{}, limit interface{}) *MockMatchRepository_FindCompletedMatches_Call {
-	return &MockMatchRepository_FindCompletedMatches_Call{Call: _e.mock.On("FindCompletedMatches", offset, limit)}
+// CountWinsByTeamIDs is a helper method to define mock.On call
+//   - teamIDs []uuid.UUID
+func (_e *MockMatchRepository_Expecter) CountWinsByTeamIDs(teamIDs interface{}) *MockMatchRepository_CountWinsByTeamIDs_Call {
+	return &MockMatchRepository_CountWinsByTeamIDs_Call{Call: _e.mock.On("CountWinsByTeamIDs", teamIDs)}
 }
 
-func (_c *MockMatchRepository_FindCompletedMatches_Call) Run(run func(offset int, limit int)) *MockMatchRepository_FindCompletedMatches_Call {
+func (_c *MockMatchRepository_CountWinsByTeamIDs_Call) Run(run func(teamIDs []uuid.UUID)) *MockMatchRepository_CountWinsByTeamIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_FindCompletedMatches_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindCompletedMatches_Call {
+func (_c *MockMatchRepository_CountWinsByTeamIDs_Call) Return(_a0 map[uuid.UUID]int, _a1 error) *MockMatchRepository_CountWinsByTeamIDs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMatchRepository_FindCompletedMatches_Call) RunAndReturn(run func(int, int) ([]model.Match, error)) *MockMatchRepository_FindCompletedMatches_Call {
+func (_c *MockMatchRepository_CountWinsByTeamIDs_Call) RunAndReturn(run func([]uuid.UUID) (map[uuid.UUID]int, error)) *MockMatchRepository_CountWinsByTeamIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: match
-func (_m *MockMatchRepository) Update(match *model.Match) error {
+// Create provides a mock function with given fields: match
+func (_m *MockMatchRepository) Create(match *model.Match) error {
 	ret := _m.Called(match)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Create")
 	}
 
 	var r0 error
@@ -534,30 +555,878 @@ func (_m *MockMatchRepository) Update(match *model.Match) error {
 	return r0
 }
 
-// MockMatchRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockMatchRepository_Update_Call struct {
+// MockMatchRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMatchRepository_Create_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - match *model.Match
-func (_e *MockMatchRepository_Expecter) Update(match interface{}) *MockMatchRepository_Update_Call {
-	return &MockMatchRepository_Update_Call{Call: _e.mock.On("Update", match)}
+func (_e *MockMatchRepository_Expecter) Create(match interface{}) *MockMatchRepository_Create_Call {
+	return &MockMatchRepository_Create_Call{Call: _e.mock.On("Create", match)}
 }
 
-func (_c *MockMatchRepository_Update_Call) Run(run func(match *model.Match)) *MockMatchRepository_Update_Call {
+func (_c *MockMatchRepository_Create_Call) Run(run func(match *model.Match)) *MockMatchRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(*model.Match))
 	})
 	return _c
 }
 
-func (_c *MockMatchRepository_Update_Call) Return(_a0 error) *MockMatchRepository_Update_Call {
+func (_c *MockMatchRepository_Create_Call) Return(_a0 error) *MockMatchRepository_Create_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockMatchRepository_Update_Call) RunAndReturn(run func(*model.Match) error) *MockMatchRepository_Update_Call {
+func (_c *MockMatchRepository_Create_Call) RunAndReturn(run func(*model.Match) error) *MockMatchRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MockMatchRepository) Delete(id uuid.UUID) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMatchRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockMatchRepository_Expecter) Delete(id interface{}) *MockMatchRepository_Delete_Call {
+	return &MockMatchRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockMatchRepository_Delete_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_Delete_Call) Return(_a0 error) *MockMatchRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error) *MockMatchRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: filter, offset, limit, sortBy, sortOrder
+func (_m *MockMatchRepository) FindAll(filter repository.MatchFilter, offset int, limit int, sortBy string, sortOrder string) ([]model.Match, error) {
+	ret := _m.Called(filter, offset, limit, sortBy, sortOrder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int, string, string) ([]model.Match, error)); ok {
+		return rf(filter, offset, limit, sortBy, sortOrder)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int, string, string) []model.Match); ok {
+		r0 = rf(filter, offset, limit, sortBy, sortOrder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter, int, int, string, string) error); ok {
+		r1 = rf(filter, offset, limit, sortBy, sortOrder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockMatchRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+//   - offset int
+//   - limit int
+//   - sortBy string
+//   - sortOrder string
+func (_e *MockMatchRepository_Expecter) FindAll(filter interface{}, offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockMatchRepository_FindAll_Call {
+	return &MockMatchRepository_FindAll_Call{Call: _e.mock.On("FindAll", filter, offset, limit, sortBy, sortOrder)}
+}
+
+func (_c *MockMatchRepository_FindAll_Call) Run(run func(filter repository.MatchFilter, offset int, limit int, sortBy string, sortOrder string)) *MockMatchRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter), args[1].(int), args[2].(int), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindAll_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindAll_Call) RunAndReturn(run func(repository.MatchFilter, int, int, string, string) ([]model.Match, error)) *MockMatchRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllCompleted provides a mock function with given fields: filter
+func (_m *MockMatchRepository) FindAllCompleted(filter repository.MatchFilter) ([]model.Match, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllCompleted")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]model.Match, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []model.Match); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindAllCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllCompleted'
+type MockMatchRepository_FindAllCompleted_Call struct {
+	*mock.Call
+}
+
+// FindAllCompleted is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) FindAllCompleted(filter interface{}) *MockMatchRepository_FindAllCompleted_Call {
+	return &MockMatchRepository_FindAllCompleted_Call{Call: _e.mock.On("FindAllCompleted", filter)}
+}
+
+func (_c *MockMatchRepository_FindAllCompleted_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_FindAllCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindAllCompleted_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindAllCompleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindAllCompleted_Call) RunAndReturn(run func(repository.MatchFilter) ([]model.Match, error)) *MockMatchRepository_FindAllCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByExternalRef provides a mock function with given fields: provider, externalID
+func (_m *MockMatchRepository) FindByExternalRef(provider string, externalID string) (*model.Match, error) {
+	ret := _m.Called(provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByExternalRef")
+	}
+
+	var r0 *model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.Match, error)); ok {
+		return rf(provider, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.Match); ok {
+		r0 = rf(provider, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByExternalRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByExternalRef'
+type MockMatchRepository_FindByExternalRef_Call struct {
+	*mock.Call
+}
+
+// FindByExternalRef is a helper method to define mock.On call
+//   - provider string
+//   - externalID string
+func (_e *MockMatchRepository_Expecter) FindByExternalRef(provider interface{}, externalID interface{}) *MockMatchRepository_FindByExternalRef_Call {
+	return &MockMatchRepository_FindByExternalRef_Call{Call: _e.mock.On("FindByExternalRef", provider, externalID)}
+}
+
+func (_c *MockMatchRepository_FindByExternalRef_Call) Run(run func(provider string, externalID string)) *MockMatchRepository_FindByExternalRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByExternalRef_Call) Return(_a0 *model.Match, _a1 error) *MockMatchRepository_FindByExternalRef_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByExternalRef_Call) RunAndReturn(run func(string, string) (*model.Match, error)) *MockMatchRepository_FindByExternalRef_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MockMatchRepository) FindByID(id uuid.UUID) (*model.Match, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*model.Match, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *model.Match); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockMatchRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockMatchRepository_Expecter) FindByID(id interface{}) *MockMatchRepository_FindByID_Call {
+	return &MockMatchRepository_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+}
+
+func (_c *MockMatchRepository_FindByID_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByID_Call) Return(_a0 *model.Match, _a1 error) *MockMatchRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByID_Call) RunAndReturn(run func(uuid.UUID) (*model.Match, error)) *MockMatchRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDWithDetails provides a mock function with given fields: id
+func (_m *MockMatchRepository) FindByIDWithDetails(id uuid.UUID) (*model.Match, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDWithDetails")
+	}
+
+	var r0 *model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (*model.Match, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) *model.Match); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByIDWithDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDWithDetails'
+type MockMatchRepository_FindByIDWithDetails_Call struct {
+	*mock.Call
+}
+
+// FindByIDWithDetails is a helper method to define mock.On call
+//   - id uuid.UUID
+func (_e *MockMatchRepository_Expecter) FindByIDWithDetails(id interface{}) *MockMatchRepository_FindByIDWithDetails_Call {
+	return &MockMatchRepository_FindByIDWithDetails_Call{Call: _e.mock.On("FindByIDWithDetails", id)}
+}
+
+func (_c *MockMatchRepository_FindByIDWithDetails_Call) Run(run func(id uuid.UUID)) *MockMatchRepository_FindByIDWithDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByIDWithDetails_Call) Return(_a0 *model.Match, _a1 error) *MockMatchRepository_FindByIDWithDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByIDWithDetails_Call) RunAndReturn(run func(uuid.UUID) (*model.Match, error)) *MockMatchRepository_FindByIDWithDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function with given fields: ids
+func (_m *MockMatchRepository) FindByIDs(ids []uuid.UUID) ([]model.Match, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]model.Match, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []model.Match); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockMatchRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MockMatchRepository_Expecter) FindByIDs(ids interface{}) *MockMatchRepository_FindByIDs_Call {
+	return &MockMatchRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ids)}
+}
+
+func (_c *MockMatchRepository_FindByIDs_Call) Run(run func(ids []uuid.UUID)) *MockMatchRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByIDs_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByIDs_Call) RunAndReturn(run func([]uuid.UUID) ([]model.Match, error)) *MockMatchRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTeamBetween provides a mock function with given fields: teamID, from, to
+func (_m *MockMatchRepository) FindByTeamBetween(teamID uuid.UUID, from time.Time, to time.Time) ([]model.Match, error) {
+	ret := _m.Called(teamID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTeamBetween")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time, time.Time) ([]model.Match, error)); ok {
+		return rf(teamID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID, time.Time, time.Time) []model.Match); ok {
+		r0 = rf(teamID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(teamID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByTeamBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTeamBetween'
+type MockMatchRepository_FindByTeamBetween_Call struct {
+	*mock.Call
+}
+
+// FindByTeamBetween is a helper method to define mock.On call
+//   - teamID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *MockMatchRepository_Expecter) FindByTeamBetween(teamID interface{}, from interface{}, to interface{}) *MockMatchRepository_FindByTeamBetween_Call {
+	return &MockMatchRepository_FindByTeamBetween_Call{Call: _e.mock.On("FindByTeamBetween", teamID, from, to)}
+}
+
+func (_c *MockMatchRepository_FindByTeamBetween_Call) Run(run func(teamID uuid.UUID, from time.Time, to time.Time)) *MockMatchRepository_FindByTeamBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByTeamBetween_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindByTeamBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByTeamBetween_Call) RunAndReturn(run func(uuid.UUID, time.Time, time.Time) ([]model.Match, error)) *MockMatchRepository_FindByTeamBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTeamIDs provides a mock function with given fields: teamIDs, status
+func (_m *MockMatchRepository) FindByTeamIDs(teamIDs []uuid.UUID, status string) ([]model.Match, error) {
+	ret := _m.Called(teamIDs, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTeamIDs")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, string) ([]model.Match, error)); ok {
+		return rf(teamIDs, status)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID, string) []model.Match); ok {
+		r0 = rf(teamIDs, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID, string) error); ok {
+		r1 = rf(teamIDs, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindByTeamIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTeamIDs'
+type MockMatchRepository_FindByTeamIDs_Call struct {
+	*mock.Call
+}
+
+// FindByTeamIDs is a helper method to define mock.On call
+//   - teamIDs []uuid.UUID
+//   - status string
+func (_e *MockMatchRepository_Expecter) FindByTeamIDs(teamIDs interface{}, status interface{}) *MockMatchRepository_FindByTeamIDs_Call {
+	return &MockMatchRepository_FindByTeamIDs_Call{Call: _e.mock.On("FindByTeamIDs", teamIDs, status)}
+}
+
+func (_c *MockMatchRepository_FindByTeamIDs_Call) Run(run func(teamIDs []uuid.UUID, status string)) *MockMatchRepository_FindByTeamIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByTeamIDs_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindByTeamIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindByTeamIDs_Call) RunAndReturn(run func([]uuid.UUID, string) ([]model.Match, error)) *MockMatchRepository_FindByTeamIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCompletedMatches provides a mock function with given fields: filter, offset, limit
+func (_m *MockMatchRepository) FindCompletedMatches(filter repository.MatchFilter, offset int, limit int) ([]model.Match, error) {
+	ret := _m.Called(filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCompletedMatches")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int) ([]model.Match, error)); ok {
+		return rf(filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int) []model.Match); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter, int, int) error); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindCompletedMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCompletedMatches'
+type MockMatchRepository_FindCompletedMatches_Call struct {
+	*mock.Call
+}
+
+// FindCompletedMatches is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+//   - offset int
+//   - limit int
+func (_e *MockMatchRepository_Expecter) FindCompletedMatches(filter interface{}, offset interface{}, limit interface{}) *MockMatchRepository_FindCompletedMatches_Call {
+	return &MockMatchRepository_FindCompletedMatches_Call{Call: _e.mock.On("FindCompletedMatches", filter, offset, limit)}
+}
+
+func (_c *MockMatchRepository_FindCompletedMatches_Call) Run(run func(filter repository.MatchFilter, offset int, limit int)) *MockMatchRepository_FindCompletedMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindCompletedMatches_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindCompletedMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindCompletedMatches_Call) RunAndReturn(run func(repository.MatchFilter, int, int) ([]model.Match, error)) *MockMatchRepository_FindCompletedMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCompletedWithWins provides a mock function with given fields: filter, offset, limit
+func (_m *MockMatchRepository) FindCompletedWithWins(filter repository.MatchFilter, offset int, limit int) ([]repository.MatchWithWins, error) {
+	ret := _m.Called(filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCompletedWithWins")
+	}
+
+	var r0 []repository.MatchWithWins
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int) ([]repository.MatchWithWins, error)); ok {
+		return rf(filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter, int, int) []repository.MatchWithWins); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.MatchWithWins)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter, int, int) error); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindCompletedWithWins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCompletedWithWins'
+type MockMatchRepository_FindCompletedWithWins_Call struct {
+	*mock.Call
+}
+
+// FindCompletedWithWins is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+//   - offset int
+//   - limit int
+func (_e *MockMatchRepository_Expecter) FindCompletedWithWins(filter interface{}, offset interface{}, limit interface{}) *MockMatchRepository_FindCompletedWithWins_Call {
+	return &MockMatchRepository_FindCompletedWithWins_Call{Call: _e.mock.On("FindCompletedWithWins", filter, offset, limit)}
+}
+
+func (_c *MockMatchRepository_FindCompletedWithWins_Call) Run(run func(filter repository.MatchFilter, offset int, limit int)) *MockMatchRepository_FindCompletedWithWins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindCompletedWithWins_Call) Return(_a0 []repository.MatchWithWins, _a1 error) *MockMatchRepository_FindCompletedWithWins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindCompletedWithWins_Call) RunAndReturn(run func(repository.MatchFilter, int, int) ([]repository.MatchWithWins, error)) *MockMatchRepository_FindCompletedWithWins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSchedule provides a mock function with given fields: filter
+func (_m *MockMatchRepository) FindSchedule(filter repository.MatchFilter) ([]model.Match, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSchedule")
+	}
+
+	var r0 []model.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]model.Match, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []model.Match); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_FindSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSchedule'
+type MockMatchRepository_FindSchedule_Call struct {
+	*mock.Call
+}
+
+// FindSchedule is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) FindSchedule(filter interface{}) *MockMatchRepository_FindSchedule_Call {
+	return &MockMatchRepository_FindSchedule_Call{Call: _e.mock.On("FindSchedule", filter)}
+}
+
+func (_c *MockMatchRepository_FindSchedule_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_FindSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_FindSchedule_Call) Return(_a0 []model.Match, _a1 error) *MockMatchRepository_FindSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_FindSchedule_Call) RunAndReturn(run func(repository.MatchFilter) ([]model.Match, error)) *MockMatchRepository_FindSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GoalsByTeamAndRound provides a mock function with given fields: filter
+func (_m *MockMatchRepository) GoalsByTeamAndRound(filter repository.MatchFilter) ([]repository.TeamRoundGoals, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GoalsByTeamAndRound")
+	}
+
+	var r0 []repository.TeamRoundGoals
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) ([]repository.TeamRoundGoals, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.MatchFilter) []repository.TeamRoundGoals); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.TeamRoundGoals)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.MatchFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMatchRepository_GoalsByTeamAndRound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GoalsByTeamAndRound'
+type MockMatchRepository_GoalsByTeamAndRound_Call struct {
+	*mock.Call
+}
+
+// GoalsByTeamAndRound is a helper method to define mock.On call
+//   - filter repository.MatchFilter
+func (_e *MockMatchRepository_Expecter) GoalsByTeamAndRound(filter interface{}) *MockMatchRepository_GoalsByTeamAndRound_Call {
+	return &MockMatchRepository_GoalsByTeamAndRound_Call{Call: _e.mock.On("GoalsByTeamAndRound", filter)}
+}
+
+func (_c *MockMatchRepository_GoalsByTeamAndRound_Call) Run(run func(filter repository.MatchFilter)) *MockMatchRepository_GoalsByTeamAndRound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.MatchFilter))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_GoalsByTeamAndRound_Call) Return(_a0 []repository.TeamRoundGoals, _a1 error) *MockMatchRepository_GoalsByTeamAndRound_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMatchRepository_GoalsByTeamAndRound_Call) RunAndReturn(run func(repository.MatchFilter) ([]repository.TeamRoundGoals, error)) *MockMatchRepository_GoalsByTeamAndRound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: match
+func (_m *MockMatchRepository) Update(match *model.Match) error {
+	ret := _m.Called(match)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Match) error); ok {
+		r0 = rf(match)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockMatchRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - match *model.Match
+func (_e *MockMatchRepository_Expecter) Update(match interface{}) *MockMatchRepository_Update_Call {
+	return &MockMatchRepository_Update_Call{Call: _e.mock.On("Update", match)}
+}
+
+func (_c *MockMatchRepository_Update_Call) Run(run func(match *model.Match)) *MockMatchRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Match))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_Update_Call) Return(_a0 error) *MockMatchRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchRepository_Update_Call) RunAndReturn(run func(*model.Match) error) *MockMatchRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: match, provider, externalID
+func (_m *MockMatchRepository) Upsert(match *model.Match, provider string, externalID string) error {
+	ret := _m.Called(match, provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Match, string, string) error); ok {
+		r0 = rf(match, provider, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMatchRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockMatchRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - match *model.Match
+//   - provider string
+//   - externalID string
+func (_e *MockMatchRepository_Expecter) Upsert(match interface{}, provider interface{}, externalID interface{}) *MockMatchRepository_Upsert_Call {
+	return &MockMatchRepository_Upsert_Call{Call: _e.mock.On("Upsert", match, provider, externalID)}
+}
+
+func (_c *MockMatchRepository_Upsert_Call) Run(run func(match *model.Match, provider string, externalID string)) *MockMatchRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Match), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMatchRepository_Upsert_Call) Return(_a0 error) *MockMatchRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMatchRepository_Upsert_Call) RunAndReturn(run func(*model.Match, string, string) error) *MockMatchRepository_Upsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
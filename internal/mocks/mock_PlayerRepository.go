@@ -4,6 +4,7 @@ package mocks
 
 import (
 	model "github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	repository "github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -22,6 +23,62 @@ func (_m *MockPlayerRepository) EXPECT() *MockPlayerRepository_Expecter {
 	return &MockPlayerRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function with given fields: filter
+func (_m *MockPlayerRepository) Count(filter repository.PlayerFilter) (int64, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repository.PlayerFilter) (int64, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(repository.PlayerFilter) int64); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repository.PlayerFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockPlayerRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - filter repository.PlayerFilter
+func (_e *MockPlayerRepository_Expecter) Count(filter interface{}) *MockPlayerRepository_Count_Call {
+	return &MockPlayerRepository_Count_Call{Call: _e.mock.On("Count", filter)}
+}
+
+func (_c *MockPlayerRepository_Count_Call) Run(run func(filter repository.PlayerFilter)) *MockPlayerRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repository.PlayerFilter))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_Count_Call) Return(_a0 int64, _a1 error) *MockPlayerRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_Count_Call) RunAndReturn(run func(repository.PlayerFilter) (int64, error)) *MockPlayerRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountByTeamID provides a mock function with given fields: teamID
 func (_m *MockPlayerRepository) CountByTeamID(teamID uuid.UUID) (int64, error) {
 	ret := _m.Called(teamID)
@@ -124,6 +181,52 @@ func (_c *MockPlayerRepository_Create_Call) RunAndReturn(run func(*model.Player)
 	return _c
 }
 
+// CreateBatch provides a mock function with given fields: players
+func (_m *MockPlayerRepository) CreateBatch(players []model.Player) error {
+	ret := _m.Called(players)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.Player) error); ok {
+		r0 = rf(players)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlayerRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockPlayerRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - players []model.Player
+func (_e *MockPlayerRepository_Expecter) CreateBatch(players interface{}) *MockPlayerRepository_CreateBatch_Call {
+	return &MockPlayerRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", players)}
+}
+
+func (_c *MockPlayerRepository_CreateBatch_Call) Run(run func(players []model.Player)) *MockPlayerRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.Player))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_CreateBatch_Call) Return(_a0 error) *MockPlayerRepository_CreateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlayerRepository_CreateBatch_Call) RunAndReturn(run func([]model.Player) error) *MockPlayerRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: id
 func (_m *MockPlayerRepository) Delete(id uuid.UUID) error {
 	ret := _m.Called(id)
@@ -170,29 +273,85 @@ func (_c *MockPlayerRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) err
 	return _c
 }
 
-// FindAllByTeamID provides a mock function with given fields: teamID, offset, limit, sortBy, sortOrder
-func (_m *MockPlayerRepository) FindAllByTeamID(teamID uuid.UUID, offset int, limit int, sortBy string, sortOrder string) ([]model.Player, error) {
-	ret := _m.Called(teamID, offset, limit, sortBy, sortOrder)
+// DeleteByTeamID provides a mock function with given fields: teamID
+func (_m *MockPlayerRepository) DeleteByTeamID(teamID uuid.UUID) (int64, error) {
+	ret := _m.Called(teamID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindAllByTeamID")
+		panic("no return value specified for DeleteByTeamID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (int64, error)); ok {
+		return rf(teamID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) int64); ok {
+		r0 = rf(teamID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_DeleteByTeamID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByTeamID'
+type MockPlayerRepository_DeleteByTeamID_Call struct {
+	*mock.Call
+}
+
+// DeleteByTeamID is a helper method to define mock.On call
+//   - teamID uuid.UUID
+func (_e *MockPlayerRepository_Expecter) DeleteByTeamID(teamID interface{}) *MockPlayerRepository_DeleteByTeamID_Call {
+	return &MockPlayerRepository_DeleteByTeamID_Call{Call: _e.mock.On("DeleteByTeamID", teamID)}
+}
+
+func (_c *MockPlayerRepository_DeleteByTeamID_Call) Run(run func(teamID uuid.UUID)) *MockPlayerRepository_DeleteByTeamID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_DeleteByTeamID_Call) Return(_a0 int64, _a1 error) *MockPlayerRepository_DeleteByTeamID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_DeleteByTeamID_Call) RunAndReturn(run func(uuid.UUID) (int64, error)) *MockPlayerRepository_DeleteByTeamID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: filter, offset, limit, sortBy, sortOrder
+func (_m *MockPlayerRepository) FindAll(filter repository.PlayerFilter, offset int, limit int, sortBy string, sortOrder string) ([]model.Player, error) {
+	ret := _m.Called(filter, offset, limit, sortBy, sortOrder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
 	}
 
 	var r0 []model.Player
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, string, string) ([]model.Player, error)); ok {
-		return rf(teamID, offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.PlayerFilter, int, int, string, string) ([]model.Player, error)); ok {
+		return rf(filter, offset, limit, sortBy, sortOrder)
 	}
-	if rf, ok := ret.Get(0).(func(uuid.UUID, int, int, string, string) []model.Player); ok {
-		r0 = rf(teamID, offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.PlayerFilter, int, int, string, string) []model.Player); ok {
+		r0 = rf(filter, offset, limit, sortBy, sortOrder)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Player)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uuid.UUID, int, int, string, string) error); ok {
-		r1 = rf(teamID, offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(1).(func(repository.PlayerFilter, int, int, string, string) error); ok {
+		r1 = rf(filter, offset, limit, sortBy, sortOrder)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -200,34 +359,93 @@ func (_m *MockPlayerRepository) FindAllByTeamID(teamID uuid.UUID, offset int, li
 	return r0, r1
 }
 
-// MockPlayerRepository_FindAllByTeamID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByTeamID'
-type MockPlayerRepository_FindAllByTeamID_Call struct {
+// MockPlayerRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockPlayerRepository_FindAll_Call struct {
 	*mock.Call
 }
 
-// FindAllByTeamID is a helper method to define mock.On call
-//   - teamID uuid.UUID
+// FindAll is a helper method to define mock.On call
+//   - filter repository.PlayerFilter
 //   - offset int
 //   - limit int
 //   - sortBy string
 //   - sortOrder string
-func (_e *MockPlayerRepository_Expecter) FindAllByTeamID(teamID interface{}, offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockPlayerRepository_FindAllByTeamID_Call {
-	return &MockPlayerRepository_FindAllByTeamID_Call{Call: _e.mock.On("FindAllByTeamID", teamID, offset, limit, sortBy, sortOrder)}
+func (_e *MockPlayerRepository_Expecter) FindAll(filter interface{}, offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockPlayerRepository_FindAll_Call {
+	return &MockPlayerRepository_FindAll_Call{Call: _e.mock.On("FindAll", filter, offset, limit, sortBy, sortOrder)}
 }
 
-func (_c *MockPlayerRepository_FindAllByTeamID_Call) Run(run func(teamID uuid.UUID, offset int, limit int, sortBy string, sortOrder string)) *MockPlayerRepository_FindAllByTeamID_Call {
+func (_c *MockPlayerRepository_FindAll_Call) Run(run func(filter repository.PlayerFilter, offset int, limit int, sortBy string, sortOrder string)) *MockPlayerRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uuid.UUID), args[1].(int), args[2].(int), args[3].(string), args[4].(string))
+		run(args[0].(repository.PlayerFilter), args[1].(int), args[2].(int), args[3].(string), args[4].(string))
 	})
 	return _c
 }
 
-func (_c *MockPlayerRepository_FindAllByTeamID_Call) Return(_a0 []model.Player, _a1 error) *MockPlayerRepository_FindAllByTeamID_Call {
+func (_c *MockPlayerRepository_FindAll_Call) Return(_a0 []model.Player, _a1 error) *MockPlayerRepository_FindAll_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockPlayerRepository_FindAllByTeamID_Call) RunAndReturn(run func(uuid.UUID, int, int, string, string) ([]model.Player, error)) *MockPlayerRepository_FindAllByTeamID_Call {
+func (_c *MockPlayerRepository_FindAll_Call) RunAndReturn(run func(repository.PlayerFilter, int, int, string, string) ([]model.Player, error)) *MockPlayerRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByExternalRef provides a mock function with given fields: provider, externalID
+func (_m *MockPlayerRepository) FindByExternalRef(provider string, externalID string) (*model.Player, error) {
+	ret := _m.Called(provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByExternalRef")
+	}
+
+	var r0 *model.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.Player, error)); ok {
+		return rf(provider, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.Player); ok {
+		r0 = rf(provider, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindByExternalRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByExternalRef'
+type MockPlayerRepository_FindByExternalRef_Call struct {
+	*mock.Call
+}
+
+// FindByExternalRef is a helper method to define mock.On call
+//   - provider string
+//   - externalID string
+func (_e *MockPlayerRepository_Expecter) FindByExternalRef(provider interface{}, externalID interface{}) *MockPlayerRepository_FindByExternalRef_Call {
+	return &MockPlayerRepository_FindByExternalRef_Call{Call: _e.mock.On("FindByExternalRef", provider, externalID)}
+}
+
+func (_c *MockPlayerRepository_FindByExternalRef_Call) Run(run func(provider string, externalID string)) *MockPlayerRepository_FindByExternalRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByExternalRef_Call) Return(_a0 *model.Player, _a1 error) *MockPlayerRepository_FindByExternalRef_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByExternalRef_Call) RunAndReturn(run func(string, string) (*model.Player, error)) *MockPlayerRepository_FindByExternalRef_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -290,6 +508,122 @@ func (_c *MockPlayerRepository_FindByID_Call) RunAndReturn(run func(uuid.UUID) (
 	return _c
 }
 
+// FindByIDs provides a mock function with given fields: ids
+func (_m *MockPlayerRepository) FindByIDs(ids []uuid.UUID) ([]model.Player, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []model.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]model.Player, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []model.Player); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockPlayerRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MockPlayerRepository_Expecter) FindByIDs(ids interface{}) *MockPlayerRepository_FindByIDs_Call {
+	return &MockPlayerRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ids)}
+}
+
+func (_c *MockPlayerRepository_FindByIDs_Call) Run(run func(ids []uuid.UUID)) *MockPlayerRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByIDs_Call) Return(_a0 []model.Player, _a1 error) *MockPlayerRepository_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByIDs_Call) RunAndReturn(run func([]uuid.UUID) ([]model.Player, error)) *MockPlayerRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlug provides a mock function with given fields: slug
+func (_m *MockPlayerRepository) FindBySlug(slug string) (*model.Player, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlug")
+	}
+
+	var r0 *model.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Player, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Player); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlug'
+type MockPlayerRepository_FindBySlug_Call struct {
+	*mock.Call
+}
+
+// FindBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MockPlayerRepository_Expecter) FindBySlug(slug interface{}) *MockPlayerRepository_FindBySlug_Call {
+	return &MockPlayerRepository_FindBySlug_Call{Call: _e.mock.On("FindBySlug", slug)}
+}
+
+func (_c *MockPlayerRepository_FindBySlug_Call) Run(run func(slug string)) *MockPlayerRepository_FindBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindBySlug_Call) Return(_a0 *model.Player, _a1 error) *MockPlayerRepository_FindBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindBySlug_Call) RunAndReturn(run func(string) (*model.Player, error)) *MockPlayerRepository_FindBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByTeamIDAndJerseyNumber provides a mock function with given fields: teamID, jerseyNumber
 func (_m *MockPlayerRepository) FindByTeamIDAndJerseyNumber(teamID uuid.UUID, jerseyNumber int) (*model.Player, error) {
 	ret := _m.Called(teamID, jerseyNumber)
@@ -349,6 +683,180 @@ func (_c *MockPlayerRepository_FindByTeamIDAndJerseyNumber_Call) RunAndReturn(ru
 	return _c
 }
 
+// FindByTeamIDs provides a mock function with given fields: teamIDs
+func (_m *MockPlayerRepository) FindByTeamIDs(teamIDs []uuid.UUID) ([]model.Player, error) {
+	ret := _m.Called(teamIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTeamIDs")
+	}
+
+	var r0 []model.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]model.Player, error)); ok {
+		return rf(teamIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []model.Player); ok {
+		r0 = rf(teamIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(teamIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindByTeamIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTeamIDs'
+type MockPlayerRepository_FindByTeamIDs_Call struct {
+	*mock.Call
+}
+
+// FindByTeamIDs is a helper method to define mock.On call
+//   - teamIDs []uuid.UUID
+func (_e *MockPlayerRepository_Expecter) FindByTeamIDs(teamIDs interface{}) *MockPlayerRepository_FindByTeamIDs_Call {
+	return &MockPlayerRepository_FindByTeamIDs_Call{Call: _e.mock.On("FindByTeamIDs", teamIDs)}
+}
+
+func (_c *MockPlayerRepository_FindByTeamIDs_Call) Run(run func(teamIDs []uuid.UUID)) *MockPlayerRepository_FindByTeamIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByTeamIDs_Call) Return(_a0 []model.Player, _a1 error) *MockPlayerRepository_FindByTeamIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindByTeamIDs_Call) RunAndReturn(run func([]uuid.UUID) ([]model.Player, error)) *MockPlayerRepository_FindByTeamIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindJerseyNumbersByTeamID provides a mock function with given fields: teamID
+func (_m *MockPlayerRepository) FindJerseyNumbersByTeamID(teamID uuid.UUID) ([]int, error) {
+	ret := _m.Called(teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJerseyNumbersByTeamID")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID) ([]int, error)); ok {
+		return rf(teamID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) []int); ok {
+		r0 = rf(teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = rf(teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindJerseyNumbersByTeamID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJerseyNumbersByTeamID'
+type MockPlayerRepository_FindJerseyNumbersByTeamID_Call struct {
+	*mock.Call
+}
+
+// FindJerseyNumbersByTeamID is a helper method to define mock.On call
+//   - teamID uuid.UUID
+func (_e *MockPlayerRepository_Expecter) FindJerseyNumbersByTeamID(teamID interface{}) *MockPlayerRepository_FindJerseyNumbersByTeamID_Call {
+	return &MockPlayerRepository_FindJerseyNumbersByTeamID_Call{Call: _e.mock.On("FindJerseyNumbersByTeamID", teamID)}
+}
+
+func (_c *MockPlayerRepository_FindJerseyNumbersByTeamID_Call) Run(run func(teamID uuid.UUID)) *MockPlayerRepository_FindJerseyNumbersByTeamID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindJerseyNumbersByTeamID_Call) Return(_a0 []int, _a1 error) *MockPlayerRepository_FindJerseyNumbersByTeamID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindJerseyNumbersByTeamID_Call) RunAndReturn(run func(uuid.UUID) ([]int, error)) *MockPlayerRepository_FindJerseyNumbersByTeamID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSlugs provides a mock function with given fields: base
+func (_m *MockPlayerRepository) FindSlugs(base string) ([]string, error) {
+	ret := _m.Called(base)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSlugs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(base)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(base)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(base)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerRepository_FindSlugs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSlugs'
+type MockPlayerRepository_FindSlugs_Call struct {
+	*mock.Call
+}
+
+// FindSlugs is a helper method to define mock.On call
+//   - base string
+func (_e *MockPlayerRepository_Expecter) FindSlugs(base interface{}) *MockPlayerRepository_FindSlugs_Call {
+	return &MockPlayerRepository_FindSlugs_Call{Call: _e.mock.On("FindSlugs", base)}
+}
+
+func (_c *MockPlayerRepository_FindSlugs_Call) Run(run func(base string)) *MockPlayerRepository_FindSlugs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindSlugs_Call) Return(_a0 []string, _a1 error) *MockPlayerRepository_FindSlugs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerRepository_FindSlugs_Call) RunAndReturn(run func(string) ([]string, error)) *MockPlayerRepository_FindSlugs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: player
 func (_m *MockPlayerRepository) Update(player *model.Player) error {
 	ret := _m.Called(player)
@@ -395,6 +903,54 @@ func (_c *MockPlayerRepository_Update_Call) RunAndReturn(run func(*model.Player)
 	return _c
 }
 
+// Upsert provides a mock function with given fields: player, provider, externalID
+func (_m *MockPlayerRepository) Upsert(player *model.Player, provider string, externalID string) error {
+	ret := _m.Called(player, provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Player, string, string) error); ok {
+		r0 = rf(player, provider, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlayerRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockPlayerRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - player *model.Player
+//   - provider string
+//   - externalID string
+func (_e *MockPlayerRepository_Expecter) Upsert(player interface{}, provider interface{}, externalID interface{}) *MockPlayerRepository_Upsert_Call {
+	return &MockPlayerRepository_Upsert_Call{Call: _e.mock.On("Upsert", player, provider, externalID)}
+}
+
+func (_c *MockPlayerRepository_Upsert_Call) Run(run func(player *model.Player, provider string, externalID string)) *MockPlayerRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Player), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerRepository_Upsert_Call) Return(_a0 error) *MockPlayerRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlayerRepository_Upsert_Call) RunAndReturn(run func(*model.Player, string, string) error) *MockPlayerRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPlayerRepository creates a new instance of MockPlayerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPlayerRepository(t interface {
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	time "time"
+
 	model "github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
@@ -160,6 +162,62 @@ func (_c *MockRefreshTokenRepository_DeleteByToken_Call) RunAndReturn(run func(s
 	return _c
 }
 
+// DeleteExpired provides a mock function with given fields: now
+func (_m *MockRefreshTokenRepository) DeleteExpired(now time.Time) (int64, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRefreshTokenRepository_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockRefreshTokenRepository_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockRefreshTokenRepository_Expecter) DeleteExpired(now interface{}) *MockRefreshTokenRepository_DeleteExpired_Call {
+	return &MockRefreshTokenRepository_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", now)}
+}
+
+func (_c *MockRefreshTokenRepository_DeleteExpired_Call) Run(run func(now time.Time)) *MockRefreshTokenRepository_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_DeleteExpired_Call) Return(_a0 int64, _a1 error) *MockRefreshTokenRepository_DeleteExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_DeleteExpired_Call) RunAndReturn(run func(time.Time) (int64, error)) *MockRefreshTokenRepository_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByToken provides a mock function with given fields: token
 func (_m *MockRefreshTokenRepository) FindByToken(token string) (*model.RefreshToken, error) {
 	ret := _m.Called(token)
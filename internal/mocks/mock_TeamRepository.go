@@ -4,6 +4,7 @@ package mocks
 
 import (
 	model "github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	repository "github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -22,9 +23,9 @@ func (_m *MockTeamRepository) EXPECT() *MockTeamRepository_Expecter {
 	return &MockTeamRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *MockTeamRepository) Count() (int64, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: filter
+func (_m *MockTeamRepository) Count(filter repository.TeamFilter) (int64, error) {
+	ret := _m.Called(filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -32,17 +33,17 @@ func (_m *MockTeamRepository) Count() (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(repository.TeamFilter) (int64, error)); ok {
+		return rf(filter)
 	}
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(repository.TeamFilter) int64); ok {
+		r0 = rf(filter)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(repository.TeamFilter) error); ok {
+		r1 = rf(filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,13 +57,14 @@ type MockTeamRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *MockTeamRepository_Expecter) Count() *MockTeamRepository_Count_Call {
-	return &MockTeamRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - filter repository.TeamFilter
+func (_e *MockTeamRepository_Expecter) Count(filter interface{}) *MockTeamRepository_Count_Call {
+	return &MockTeamRepository_Count_Call{Call: _e.mock.On("Count", filter)}
 }
 
-func (_c *MockTeamRepository_Count_Call) Run(run func()) *MockTeamRepository_Count_Call {
+func (_c *MockTeamRepository_Count_Call) Run(run func(filter repository.TeamFilter)) *MockTeamRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(repository.TeamFilter))
 	})
 	return _c
 }
@@ -72,7 +74,7 @@ func (_c *MockTeamRepository_Count_Call) Return(_a0 int64, _a1 error) *MockTeamR
 	return _c
 }
 
-func (_c *MockTeamRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockTeamRepository_Count_Call {
+func (_c *MockTeamRepository_Count_Call) RunAndReturn(run func(repository.TeamFilter) (int64, error)) *MockTeamRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -169,9 +171,9 @@ func (_c *MockTeamRepository_Delete_Call) RunAndReturn(run func(uuid.UUID) error
 	return _c
 }
 
-// FindAll provides a mock function with given fields: offset, limit, sortBy, sortOrder
-func (_m *MockTeamRepository) FindAll(offset int, limit int, sortBy string, sortOrder string) ([]model.Team, error) {
-	ret := _m.Called(offset, limit, sortBy, sortOrder)
+// FindAll provides a mock function with given fields: filter, offset, limit, sortBy, sortOrder
+func (_m *MockTeamRepository) FindAll(filter repository.TeamFilter, offset int, limit int, sortBy string, sortOrder string) ([]model.Team, error) {
+	ret := _m.Called(filter, offset, limit, sortBy, sortOrder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -179,19 +181,19 @@ func (_m *MockTeamRepository) FindAll(offset int, limit int, sortBy string, sort
 
 	var r0 []model.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]model.Team, error)); ok {
-		return rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.TeamFilter, int, int, string, string) ([]model.Team, error)); ok {
+		return rf(filter, offset, limit, sortBy, sortOrder)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string, string) []model.Team); ok {
-		r0 = rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(0).(func(repository.TeamFilter, int, int, string, string) []model.Team); ok {
+		r0 = rf(filter, offset, limit, sortBy, sortOrder)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Team)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, string, string) error); ok {
-		r1 = rf(offset, limit, sortBy, sortOrder)
+	if rf, ok := ret.Get(1).(func(repository.TeamFilter, int, int, string, string) error); ok {
+		r1 = rf(filter, offset, limit, sortBy, sortOrder)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -205,17 +207,18 @@ type MockTeamRepository_FindAll_Call struct {
 }
 
 // FindAll is a helper method to define mock.On call
+//   - filter repository.TeamFilter
 //   - offset int
 //   - limit int
 //   - sortBy string
 //   - sortOrder string
-func (_e *MockTeamRepository_Expecter) FindAll(offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockTeamRepository_FindAll_Call {
-	return &MockTeamRepository_FindAll_Call{Call: _e.mock.On("FindAll", offset, limit, sortBy, sortOrder)}
+func (_e *MockTeamRepository_Expecter) FindAll(filter interface{}, offset interface{}, limit interface{}, sortBy interface{}, sortOrder interface{}) *MockTeamRepository_FindAll_Call {
+	return &MockTeamRepository_FindAll_Call{Call: _e.mock.On("FindAll", filter, offset, limit, sortBy, sortOrder)}
 }
 
-func (_c *MockTeamRepository_FindAll_Call) Run(run func(offset int, limit int, sortBy string, sortOrder string)) *MockTeamRepository_FindAll_Call {
+func (_c *MockTeamRepository_FindAll_Call) Run(run func(filter repository.TeamFilter, offset int, limit int, sortBy string, sortOrder string)) *MockTeamRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int), args[2].(string), args[3].(string))
+		run(args[0].(repository.TeamFilter), args[1].(int), args[2].(int), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -225,7 +228,124 @@ func (_c *MockTeamRepository_FindAll_Call) Return(_a0 []model.Team, _a1 error) *
 	return _c
 }
 
-func (_c *MockTeamRepository_FindAll_Call) RunAndReturn(run func(int, int, string, string) ([]model.Team, error)) *MockTeamRepository_FindAll_Call {
+func (_c *MockTeamRepository_FindAll_Call) RunAndReturn(run func(repository.TeamFilter, int, int, string, string) ([]model.Team, error)) *MockTeamRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByAbbreviation provides a mock function with given fields: abbreviation
+func (_m *MockTeamRepository) FindByAbbreviation(abbreviation string) (*model.Team, error) {
+	ret := _m.Called(abbreviation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByAbbreviation")
+	}
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Team, error)); ok {
+		return rf(abbreviation)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
+		r0 = rf(abbreviation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(abbreviation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindByAbbreviation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByAbbreviation'
+type MockTeamRepository_FindByAbbreviation_Call struct {
+	*mock.Call
+}
+
+// FindByAbbreviation is a helper method to define mock.On call
+//   - abbreviation string
+func (_e *MockTeamRepository_Expecter) FindByAbbreviation(abbreviation interface{}) *MockTeamRepository_FindByAbbreviation_Call {
+	return &MockTeamRepository_FindByAbbreviation_Call{Call: _e.mock.On("FindByAbbreviation", abbreviation)}
+}
+
+func (_c *MockTeamRepository_FindByAbbreviation_Call) Run(run func(abbreviation string)) *MockTeamRepository_FindByAbbreviation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByAbbreviation_Call) Return(_a0 *model.Team, _a1 error) *MockTeamRepository_FindByAbbreviation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByAbbreviation_Call) RunAndReturn(run func(string) (*model.Team, error)) *MockTeamRepository_FindByAbbreviation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByExternalRef provides a mock function with given fields: provider, externalID
+func (_m *MockTeamRepository) FindByExternalRef(provider string, externalID string) (*model.Team, error) {
+	ret := _m.Called(provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByExternalRef")
+	}
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.Team, error)); ok {
+		return rf(provider, externalID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.Team); ok {
+		r0 = rf(provider, externalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, externalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindByExternalRef_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByExternalRef'
+type MockTeamRepository_FindByExternalRef_Call struct {
+	*mock.Call
+}
+
+// FindByExternalRef is a helper method to define mock.On call
+//   - provider string
+//   - externalID string
+func (_e *MockTeamRepository_Expecter) FindByExternalRef(provider interface{}, externalID interface{}) *MockTeamRepository_FindByExternalRef_Call {
+	return &MockTeamRepository_FindByExternalRef_Call{Call: _e.mock.On("FindByExternalRef", provider, externalID)}
+}
+
+func (_c *MockTeamRepository_FindByExternalRef_Call) Run(run func(provider string, externalID string)) *MockTeamRepository_FindByExternalRef_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByExternalRef_Call) Return(_a0 *model.Team, _a1 error) *MockTeamRepository_FindByExternalRef_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByExternalRef_Call) RunAndReturn(run func(string, string) (*model.Team, error)) *MockTeamRepository_FindByExternalRef_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -288,6 +408,238 @@ func (_c *MockTeamRepository_FindByID_Call) RunAndReturn(run func(uuid.UUID) (*m
 	return _c
 }
 
+// FindByIDs provides a mock function with given fields: ids
+func (_m *MockTeamRepository) FindByIDs(ids []uuid.UUID) ([]model.Team, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]model.Team, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []model.Team); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockTeamRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ids []uuid.UUID
+func (_e *MockTeamRepository_Expecter) FindByIDs(ids interface{}) *MockTeamRepository_FindByIDs_Call {
+	return &MockTeamRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ids)}
+}
+
+func (_c *MockTeamRepository_FindByIDs_Call) Run(run func(ids []uuid.UUID)) *MockTeamRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByIDs_Call) Return(_a0 []model.Team, _a1 error) *MockTeamRepository_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByIDs_Call) RunAndReturn(run func([]uuid.UUID) ([]model.Team, error)) *MockTeamRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByName provides a mock function with given fields: name
+func (_m *MockTeamRepository) FindByName(name string) (*model.Team, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Team, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type MockTeamRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - name string
+func (_e *MockTeamRepository_Expecter) FindByName(name interface{}) *MockTeamRepository_FindByName_Call {
+	return &MockTeamRepository_FindByName_Call{Call: _e.mock.On("FindByName", name)}
+}
+
+func (_c *MockTeamRepository_FindByName_Call) Run(run func(name string)) *MockTeamRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByName_Call) Return(_a0 *model.Team, _a1 error) *MockTeamRepository_FindByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindByName_Call) RunAndReturn(run func(string) (*model.Team, error)) *MockTeamRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlug provides a mock function with given fields: slug
+func (_m *MockTeamRepository) FindBySlug(slug string) (*model.Team, error) {
+	ret := _m.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlug")
+	}
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Team, error)); ok {
+		return rf(slug)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Team); ok {
+		r0 = rf(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlug'
+type MockTeamRepository_FindBySlug_Call struct {
+	*mock.Call
+}
+
+// FindBySlug is a helper method to define mock.On call
+//   - slug string
+func (_e *MockTeamRepository_Expecter) FindBySlug(slug interface{}) *MockTeamRepository_FindBySlug_Call {
+	return &MockTeamRepository_FindBySlug_Call{Call: _e.mock.On("FindBySlug", slug)}
+}
+
+func (_c *MockTeamRepository_FindBySlug_Call) Run(run func(slug string)) *MockTeamRepository_FindBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindBySlug_Call) Return(_a0 *model.Team, _a1 error) *MockTeamRepository_FindBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindBySlug_Call) RunAndReturn(run func(string) (*model.Team, error)) *MockTeamRepository_FindBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSlugs provides a mock function with given fields: base
+func (_m *MockTeamRepository) FindSlugs(base string) ([]string, error) {
+	ret := _m.Called(base)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSlugs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(base)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(base)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(base)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamRepository_FindSlugs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSlugs'
+type MockTeamRepository_FindSlugs_Call struct {
+	*mock.Call
+}
+
+// FindSlugs is a helper method to define mock.On call
+//   - base string
+func (_e *MockTeamRepository_Expecter) FindSlugs(base interface{}) *MockTeamRepository_FindSlugs_Call {
+	return &MockTeamRepository_FindSlugs_Call{Call: _e.mock.On("FindSlugs", base)}
+}
+
+func (_c *MockTeamRepository_FindSlugs_Call) Run(run func(base string)) *MockTeamRepository_FindSlugs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_FindSlugs_Call) Return(_a0 []string, _a1 error) *MockTeamRepository_FindSlugs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamRepository_FindSlugs_Call) RunAndReturn(run func(string) ([]string, error)) *MockTeamRepository_FindSlugs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: team
 func (_m *MockTeamRepository) Update(team *model.Team) error {
 	ret := _m.Called(team)
@@ -334,6 +686,54 @@ func (_c *MockTeamRepository_Update_Call) RunAndReturn(run func(*model.Team) err
 	return _c
 }
 
+// Upsert provides a mock function with given fields: team, provider, externalID
+func (_m *MockTeamRepository) Upsert(team *model.Team, provider string, externalID string) error {
+	ret := _m.Called(team, provider, externalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Team, string, string) error); ok {
+		r0 = rf(team, provider, externalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTeamRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockTeamRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - team *model.Team
+//   - provider string
+//   - externalID string
+func (_e *MockTeamRepository_Expecter) Upsert(team interface{}, provider interface{}, externalID interface{}) *MockTeamRepository_Upsert_Call {
+	return &MockTeamRepository_Upsert_Call{Call: _e.mock.On("Upsert", team, provider, externalID)}
+}
+
+func (_c *MockTeamRepository_Upsert_Call) Run(run func(team *model.Team, provider string, externalID string)) *MockTeamRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Team), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTeamRepository_Upsert_Call) Return(_a0 error) *MockTeamRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTeamRepository_Upsert_Call) RunAndReturn(run func(*model.Team, string, string) error) *MockTeamRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTeamRepository creates a new instance of MockTeamRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTeamRepository(t interface {
@@ -1,11 +1,47 @@
 package model
 
-// Admin represents a system administrator who can manage all resources.
-// Only admins can access CRUD operations after authentication.
+import "time"
+
+// Admin roles, from most to least privileged.
+//   - super_admin: full access, including managing other admin accounts
+//   - editor: can manage teams, players, matches, competitions and seasons
+//   - team_manager: can manage the players of the teams assigned to it (see TeamManager)
+//     and submit results for those teams' matches
+//   - viewer: read-only access (GET endpoints only)
+const (
+	RoleSuperAdmin  = "super_admin"
+	RoleEditor      = "editor"
+	RoleTeamManager = "team_manager"
+	RoleViewer      = "viewer"
+)
+
+// RoleLegacyAdmin is the role assigned before RBAC was introduced.
+// Existing rows are migrated to RoleSuperAdmin at startup, and access tokens issued before
+// then are read as RoleSuperAdmin until they expire.
+const RoleLegacyAdmin = "admin"
+
+// ValidRoles defines the allowed admin roles.
+var ValidRoles = []string{RoleSuperAdmin, RoleEditor, RoleTeamManager, RoleViewer}
+
+// Admin represents a system administrator account.
+// What an admin may do is determined by its Role.
 type Admin struct {
 	Base
 	Username string `gorm:"type:text;not null;uniqueIndex" json:"username"`
 	Password string `gorm:"type:text;not null" json:"-"` // Never exposed in JSON responses
+	Role     string `gorm:"type:text;not null;default:'viewer'" json:"role"`
+	// MustChangePassword limits the admin to changing their password, e.g. after the
+	// default admin is seeded. Cleared by the next password change.
+	MustChangePassword bool       `gorm:"not null;default:false" json:"must_change_password"`
+	PasswordChangedAt  *time.Time `json:"password_changed_at"` // Nil until the first change; CreatedAt applies
+}
+
+// PasswordAge returns how long the admin's current password has been in use.
+func (a *Admin) PasswordAge(now time.Time) time.Duration {
+	if a.PasswordChangedAt != nil {
+		return now.Sub(*a.PasswordChangedAt)
+	}
+	return now.Sub(a.CreatedAt)
 }
 
 // TableName overrides the default table name.
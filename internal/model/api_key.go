@@ -0,0 +1,63 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// API key scopes. Each scope grants read access to one area of the API.
+const (
+	ScopeTeamsRead        = "teams:read"        // Teams, players, coaches and absences
+	ScopeMatchesRead      = "matches:read"      // Matches, lineups, stadiums and referees
+	ScopeCompetitionsRead = "competitions:read" // Competitions and seasons
+	ScopeReportsRead      = "reports:read"      // Match reports and standings
+)
+
+// ValidScopes defines the scopes an API key can be granted.
+var ValidScopes = []string{
+	ScopeTeamsRead,
+	ScopeMatchesRead,
+	ScopeCompetitionsRead,
+	ScopeReportsRead,
+}
+
+// APIKey authenticates a machine client (e.g. a scoreboard integration) on read endpoints.
+// Only a SHA-256 hash of the key is stored; the plain key is shown once, when it is created.
+// Revoked keys are kept so the list of issued keys stays complete.
+type APIKey struct {
+	Base
+	Name       string     `gorm:"type:text;not null" json:"name"`
+	Prefix     string     `gorm:"type:text;not null" json:"prefix"` // First characters of the key, to tell keys apart
+	KeyHash    string     `gorm:"type:text;not null;uniqueIndex" json:"-"`
+	Scopes     string     `gorm:"type:text;not null" json:"scopes"` // Comma-separated
+	CreatedBy  uuid.UUID  `gorm:"type:uuid;not null;index" json:"created_by"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// TableName overrides the default table name.
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// ScopeList returns the scopes granted to the key.
+func (k APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
+
+// HasScope reports whether the key was granted scope.
+func (k APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.ScopeList(), scope)
+}
+
+// IsActive reports whether the key is neither revoked nor expired at now.
+func (k APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
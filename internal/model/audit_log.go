@@ -0,0 +1,82 @@
+package model
+
+import "github.com/google/uuid"
+
+// Audited entities.
+const (
+	AuditEntityTeam        = "team"
+	AuditEntityPlayer      = "player"
+	AuditEntityMatch       = "match"
+	AuditEntityCompetition = "competition"
+	AuditEntitySeason      = "season"
+	AuditEntityGroup       = "group"
+	AuditEntityStadium     = "stadium"
+	AuditEntityReferee     = "referee"
+	AuditEntityCoach       = "coach"
+	AuditEntityAbsence     = "absence"
+)
+
+// ValidAuditEntities defines the entities recorded in the audit log.
+var ValidAuditEntities = []string{
+	AuditEntityTeam,
+	AuditEntityPlayer,
+	AuditEntityMatch,
+	AuditEntityCompetition,
+	AuditEntitySeason,
+	AuditEntityGroup,
+	AuditEntityStadium,
+	AuditEntityReferee,
+	AuditEntityCoach,
+	AuditEntityAbsence,
+}
+
+// Audited actions. Result, status, lineup, officials and attachment changes are recorded against the match.
+const (
+	AuditActionCreate           = "create"
+	AuditActionUpdate           = "update"
+	AuditActionDelete           = "delete"
+	AuditActionSubmitResult     = "result_submit"
+	AuditActionUpdateResult     = "result_update"
+	AuditActionUndoResult       = "result_undo"
+	AuditActionChangeStatus     = "status_change"
+	AuditActionSetLineup        = "lineup_set"
+	AuditActionSetOfficials     = "officials_set"
+	AuditActionAddAttachment    = "attachment_add"
+	AuditActionDeleteAttachment = "attachment_delete"
+	AuditActionTransfer         = "transfer"
+)
+
+// ValidAuditActions defines the actions recorded in the audit log.
+var ValidAuditActions = []string{
+	AuditActionCreate,
+	AuditActionUpdate,
+	AuditActionDelete,
+	AuditActionSubmitResult,
+	AuditActionUpdateResult,
+	AuditActionUndoResult,
+	AuditActionChangeStatus,
+	AuditActionSetLineup,
+	AuditActionSetOfficials,
+	AuditActionAddAttachment,
+	AuditActionDeleteAttachment,
+	AuditActionTransfer,
+}
+
+// AuditLog records who changed what and when. Before and After hold JSON snapshots of the
+// entity's row (nil for creates and deletes respectively); Changes holds only the fields that differ.
+// Audit logs are append-only and never updated or deleted by the API.
+type AuditLog struct {
+	Base
+	AdminID  uuid.UUID `gorm:"type:uuid;not null;index" json:"admin_id"`
+	Entity   string    `gorm:"type:text;not null;index:idx_audit_logs_entity" json:"entity"`
+	EntityID uuid.UUID `gorm:"type:uuid;not null;index:idx_audit_logs_entity" json:"entity_id"`
+	Action   string    `gorm:"type:text;not null" json:"action"`
+	Before   *string   `gorm:"type:jsonb" json:"before"`
+	After    *string   `gorm:"type:jsonb" json:"after"`
+	Changes  *string   `gorm:"type:jsonb" json:"changes"`
+}
+
+// TableName overrides the default table name.
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
@@ -0,0 +1,31 @@
+package model
+
+import "github.com/google/uuid"
+
+// Coaching staff roles. A team has at most one head coach.
+const (
+	CoachRoleHead       = "head_coach"
+	CoachRoleAssistant  = "assistant_coach"
+	CoachRoleGoalkeeper = "goalkeeper_coach"
+	CoachRoleFitness    = "fitness_coach"
+	CoachRoleAnalyst    = "analyst"
+)
+
+// ValidCoachRoles defines the allowed coaching staff roles.
+var ValidCoachRoles = []string{CoachRoleHead, CoachRoleAssistant, CoachRoleGoalkeeper, CoachRoleFitness, CoachRoleAnalyst}
+
+// Coach represents a member of a team's coaching staff.
+// Contract dates are optional; either may be left empty when unknown or open-ended.
+type Coach struct {
+	Base
+	TeamID        uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+	Name          string    `gorm:"type:text;not null" json:"name"`
+	Role          string    `gorm:"type:text;not null" json:"role"`
+	ContractStart string    `gorm:"type:text" json:"contract_start"` // YYYY-MM-DD
+	ContractEnd   string    `gorm:"type:text" json:"contract_end"`   // YYYY-MM-DD
+}
+
+// TableName overrides the default table name.
+func (Coach) TableName() string {
+	return "coaches"
+}
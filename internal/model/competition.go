@@ -0,0 +1,81 @@
+package model
+
+import "strings"
+
+// Shootout rules decide how a drawn match settled by a penalty shootout counts towards the
+// standings and win totals.
+const (
+	ShootoutRuleDraw = "draw" // the match stays a draw; the shootout only decides who goes through
+	ShootoutRuleWin  = "win"  // the shootout winner is credited with a win, the loser with a loss
+)
+
+// ValidShootoutRules defines the allowed shootout rules.
+var ValidShootoutRules = []string{ShootoutRuleDraw, ShootoutRuleWin}
+
+// Tiebreakers order teams level on points in a competition's standings. Head-to-head compares
+// the points, then the goal difference, of the matches between the teams still level.
+const (
+	TiebreakerGoalDifference = "goal_difference"
+	TiebreakerGoalsFor       = "goals_for"
+	TiebreakerWins           = "wins"
+	TiebreakerHeadToHead     = "head_to_head"
+)
+
+// ValidTiebreakers defines the allowed tiebreakers.
+var ValidTiebreakers = []string{TiebreakerGoalDifference, TiebreakerGoalsFor, TiebreakerWins, TiebreakerHeadToHead}
+
+// PointsRules decide how a competition's standings award points and rank teams level on them.
+// Teams still level after all tiebreakers are ranked by name.
+type PointsRules struct {
+	Win         int
+	Draw        int
+	Tiebreakers []string // In the order they are applied
+	BonusGoals  int      // A team scoring at least this many goals in a match earns a bonus point; 0 for none
+	BonusMargin int      // A win by at least this many goals earns a bonus point; 0 for none
+}
+
+// DefaultPointsRules are the usual football rules, used for competitions that keep them and
+// for matches outside any season.
+var DefaultPointsRules = PointsRules{
+	Win:         3,
+	Draw:        1,
+	Tiebreakers: []string{TiebreakerGoalDifference, TiebreakerGoalsFor},
+}
+
+// Competition represents a league or cup (e.g. "Liga 1") that runs over one or more seasons.
+// The points columns are null for competitions awarding the default points.
+type Competition struct {
+	Base
+	Name          string   `gorm:"type:text;not null" json:"name"`
+	Country       string   `gorm:"type:text" json:"country"`
+	ShootoutRule  string   `gorm:"type:text;not null;default:'draw'" json:"shootout_rule"` // One of ValidShootoutRules
+	PointsForWin  *int     `gorm:"type:int" json:"points_for_win,omitempty"`
+	PointsForDraw *int     `gorm:"type:int" json:"points_for_draw,omitempty"`
+	Tiebreakers   string   `gorm:"type:text;not null;default:''" json:"tiebreakers"` // Comma-separated ValidTiebreakers; empty for the default order
+	BonusGoals    int      `gorm:"not null;default:0" json:"bonus_goals"`
+	BonusMargin   int      `gorm:"not null;default:0" json:"bonus_margin"`
+	Seasons       []Season `gorm:"foreignKey:CompetitionID" json:"seasons,omitempty"`
+}
+
+// PointsRules returns the competition's rules, falling back to DefaultPointsRules for
+// anything it does not set.
+func (c Competition) PointsRules() PointsRules {
+	rules := DefaultPointsRules
+	if c.PointsForWin != nil {
+		rules.Win = *c.PointsForWin
+	}
+	if c.PointsForDraw != nil {
+		rules.Draw = *c.PointsForDraw
+	}
+	if c.Tiebreakers != "" {
+		rules.Tiebreakers = strings.Split(c.Tiebreakers, ",")
+	}
+	rules.BonusGoals = c.BonusGoals
+	rules.BonusMargin = c.BonusMargin
+	return rules
+}
+
+// TableName overrides the default table name.
+func (Competition) TableName() string {
+	return "competitions"
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Destructive actions that require a prior confirmation token (challenge/confirm pattern).
+const (
+	ConfirmActionTeamDelete        = "team.delete"
+	ConfirmActionPlayerDelete      = "player.delete"
+	ConfirmActionMatchDelete       = "match.delete"
+	ConfirmActionResultDelete      = "result.delete"
+	ConfirmActionCompetitionDelete = "competition.delete"
+	ConfirmActionSeasonDelete      = "season.delete"
+	ConfirmActionGroupDelete       = "group.delete"
+	ConfirmActionStadiumDelete     = "stadium.delete"
+	ConfirmActionRefereeDelete     = "referee.delete"
+	ConfirmActionCoachDelete       = "coach.delete"
+	ConfirmActionAbsenceDelete     = "absence.delete"
+	ConfirmActionAdminDelete       = "admin.delete"
+	ConfirmActionAttachmentDelete  = "attachment.delete"
+)
+
+// ValidConfirmationActions defines the actions a confirmation token can be issued for.
+var ValidConfirmationActions = []string{
+	ConfirmActionTeamDelete,
+	ConfirmActionPlayerDelete,
+	ConfirmActionMatchDelete,
+	ConfirmActionResultDelete,
+	ConfirmActionCompetitionDelete,
+	ConfirmActionSeasonDelete,
+	ConfirmActionGroupDelete,
+	ConfirmActionStadiumDelete,
+	ConfirmActionRefereeDelete,
+	ConfirmActionCoachDelete,
+	ConfirmActionAbsenceDelete,
+	ConfirmActionAdminDelete,
+	ConfirmActionAttachmentDelete,
+}
+
+// ConfirmationToken is a short-lived, single-use token that authorizes one destructive
+// action on one resource by one admin. Tokens are hard-deleted when consumed.
+type ConfirmationToken struct {
+	Base
+	AdminID    uuid.UUID `gorm:"type:uuid;not null;index" json:"admin_id"`
+	Action     string    `gorm:"type:text;not null" json:"action"`
+	ResourceID uuid.UUID `gorm:"type:uuid;not null" json:"resource_id"`
+	Token      string    `gorm:"type:text;not null;uniqueIndex" json:"-"`
+	ExpiresAt  time.Time `gorm:"not null" json:"expires_at"`
+}
+
+// TableName overrides the default table name.
+func (ConfirmationToken) TableName() string {
+	return "confirmation_tokens"
+}
@@ -0,0 +1,28 @@
+package model
+
+import "github.com/google/uuid"
+
+// Kinds of records an ExternalRef maps.
+const (
+	ExternalRefCompetition = "competition"
+	ExternalRefSeason      = "season"
+	ExternalRefTeam        = "team"
+	ExternalRefPlayer      = "player"
+	ExternalRefMatch       = "match"
+)
+
+// ExternalRef maps a record to its ID at an external football data provider, so importing
+// from the provider again updates the record instead of creating another. A provider's ID
+// maps to one record of each kind.
+type ExternalRef struct {
+	Base
+	Provider   string    `gorm:"type:text;not null;index:idx_external_refs_key,unique,priority:1" json:"provider"`    // e.g. "football-data"
+	EntityType string    `gorm:"type:text;not null;index:idx_external_refs_key,unique,priority:2" json:"entity_type"` // One of the ExternalRef kinds
+	ExternalID string    `gorm:"type:text;not null;index:idx_external_refs_key,unique,priority:3" json:"external_id"`
+	EntityID   uuid.UUID `gorm:"type:uuid;not null;index" json:"entity_id"`
+}
+
+// TableName overrides the default table name.
+func (ExternalRef) TableName() string {
+	return "external_refs"
+}
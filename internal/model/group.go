@@ -0,0 +1,37 @@
+package model
+
+import "github.com/google/uuid"
+
+// DefaultGroupQualifiers is how many teams of a group advance to the knockout stage
+// unless the group says otherwise.
+const DefaultGroupQualifiers = 2
+
+// Group is a group of a season's group stage. Its teams play each other and the top
+// Qualifiers of its table advance to the knockout stage.
+type Group struct {
+	Base
+	SeasonID   uuid.UUID   `gorm:"type:uuid;not null;index" json:"season_id"`
+	Name       string      `gorm:"type:text;not null" json:"name"`
+	Qualifiers int         `gorm:"not null;default:2" json:"qualifiers"`
+	Teams      []GroupTeam `gorm:"foreignKey:GroupID" json:"teams,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (Group) TableName() string {
+	return "season_groups"
+}
+
+// GroupTeam assigns a team to a group. A team plays in at most one group of a season.
+// Rows are hard-deleted when a group's teams are replaced.
+type GroupTeam struct {
+	Base
+	GroupID  uuid.UUID `gorm:"type:uuid;not null;index" json:"group_id"`
+	SeasonID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_season_group_teams_season_team,priority:1" json:"season_id"`
+	TeamID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_season_group_teams_season_team,priority:2" json:"team_id"`
+	Team     *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (GroupTeam) TableName() string {
+	return "season_group_teams"
+}
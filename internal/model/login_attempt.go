@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginAttempt tracks consecutive failed logins of one admin.
+// The row is removed on a successful login or when a super admin unlocks the account.
+type LoginAttempt struct {
+	Base
+	AdminID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"admin_id"`
+	FailedCount  int        `gorm:"not null;default:0" json:"failed_count"`
+	LastFailedAt time.Time  `gorm:"not null" json:"last_failed_at"`
+	LockedUntil  *time.Time `json:"locked_until"`
+}
+
+// TableName overrides the default table name.
+func (LoginAttempt) TableName() string {
+	return "login_attempts"
+}
+
+// IsLocked reports whether the account is locked at the given time.
+func (a *LoginAttempt) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
@@ -1,27 +1,126 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Match statuses. A match normally moves scheduled → live → completed;
+// it can be postponed (and later rescheduled) or cancelled before it finishes.
+// A match still without a result well after kick-off is flagged awaiting_result.
+const (
+	MatchStatusScheduled      = "scheduled"
+	MatchStatusLive           = "live"
+	MatchStatusAwaitingResult = "awaiting_result"
+	MatchStatusCompleted      = "completed"
+	MatchStatusPostponed      = "postponed"
+	MatchStatusCancelled      = "cancelled"
+)
 
 // ValidMatchStatuses defines the allowed match statuses.
-var ValidMatchStatuses = []string{"scheduled", "completed"}
+var ValidMatchStatuses = []string{
+	MatchStatusScheduled,
+	MatchStatusLive,
+	MatchStatusAwaitingResult,
+	MatchStatusCompleted,
+	MatchStatusPostponed,
+	MatchStatusCancelled,
+}
+
+// matchTransitions lists the statuses reachable from each status.
+// Completed and cancelled are final.
+var matchTransitions = map[string][]string{
+	MatchStatusScheduled:      {MatchStatusLive, MatchStatusAwaitingResult, MatchStatusCompleted, MatchStatusPostponed, MatchStatusCancelled},
+	MatchStatusLive:           {MatchStatusAwaitingResult, MatchStatusCompleted, MatchStatusPostponed},
+	MatchStatusAwaitingResult: {MatchStatusCompleted, MatchStatusPostponed, MatchStatusCancelled},
+	MatchStatusPostponed:      {MatchStatusScheduled, MatchStatusCancelled},
+}
 
 // Match represents a football match between two teams.
-// Scores are computed automatically from the goals table.
+// Scores are computed automatically from the scoring events in the match_events table.
+// A drawn match may be settled by a penalty shootout, whose score is kept apart.
+// Attendance, ticketing figures and the man of the match are optional and recorded with the result.
+// The (status, match_datetime) index serves listings of one status in kick-off order,
+// such as the completed matches behind reports and standings; (season_id, round) serves
+// matchweek listings.
 type Match struct {
 	Base
-	HomeTeamID uuid.UUID `gorm:"type:uuid;not null;index" json:"home_team_id"`
-	AwayTeamID uuid.UUID `gorm:"type:uuid;not null;index" json:"away_team_id"`
-	MatchDate  string    `gorm:"type:text;not null" json:"match_date"` // YYYY-MM-DD
-	MatchTime  string    `gorm:"type:text;not null" json:"match_time"` // HH:MM
-	HomeScore  int       `gorm:"type:int;not null;default:0" json:"home_score"`
-	AwayScore  int       `gorm:"type:int;not null;default:0" json:"away_score"`
-	Status     string    `gorm:"type:text;not null;default:'scheduled'" json:"status"`
-	HomeTeam   *Team     `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
-	AwayTeam   *Team     `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
-	Goals      []Goal    `gorm:"foreignKey:MatchID" json:"goals,omitempty"`
+	HomeTeamID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"home_team_id"`
+	AwayTeamID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"away_team_id"`
+	SeasonID          *uuid.UUID   `gorm:"type:uuid;index;index:idx_matches_season_round,priority:1" json:"season_id"`
+	Round             *int         `gorm:"type:int;index:idx_matches_season_round,priority:2" json:"round"`                                    // Matchweek within the season, from 1
+	VenueID           *uuid.UUID   `gorm:"type:uuid;index" json:"venue_id"`                                                                    // Stadium the match is played at
+	MatchDatetime     time.Time    `gorm:"type:timestamptz;not null;index;index:idx_matches_status_datetime,priority:2" json:"match_datetime"` // Kick-off instant
+	HomeScore         int          `gorm:"type:int;not null;default:0" json:"home_score"`
+	AwayScore         int          `gorm:"type:int;not null;default:0" json:"away_score"`
+	HomeShootoutScore *int         `gorm:"type:int" json:"home_shootout_score"` // Set only for matches settled by a shootout
+	AwayShootoutScore *int         `gorm:"type:int" json:"away_shootout_score"`
+	Attendance        *int         `gorm:"type:int" json:"attendance"` // Spectators in the stadium
+	TicketsSold       *int         `gorm:"type:int" json:"tickets_sold"`
+	TicketRevenue     *int64       `gorm:"type:bigint" json:"ticket_revenue"`          // Gross ticket sales in euros
+	ManOfTheMatchID   *uuid.UUID   `gorm:"type:uuid;index" json:"man_of_the_match_id"` // Player of either team, chosen with the result
+	Status            string       `gorm:"type:text;not null;default:'scheduled';index:idx_matches_status_datetime,priority:1" json:"status"`
+	Version           int          `gorm:"not null;default:1" json:"version"`         // Incremented on every update, for optimistic locking
+	ArchivedAt        *time.Time   `gorm:"type:timestamptz;index" json:"archived_at"` // Set once the match's season is archived
+	HomeTeam          *Team        `gorm:"foreignKey:HomeTeamID" json:"home_team,omitempty"`
+	AwayTeam          *Team        `gorm:"foreignKey:AwayTeamID" json:"away_team,omitempty"`
+	Season            *Season      `gorm:"foreignKey:SeasonID" json:"season,omitempty"`
+	Venue             *Stadium     `gorm:"foreignKey:VenueID" json:"venue,omitempty"`
+	ManOfTheMatch     *Player      `gorm:"foreignKey:ManOfTheMatchID" json:"man_of_the_match,omitempty"`
+	Events            []MatchEvent `gorm:"foreignKey:MatchID" json:"events,omitempty"`
 }
 
 // TableName overrides the default table name.
 func (Match) TableName() string {
 	return "matches"
 }
+
+// CanTransitionTo reports whether the match may move from its current status to status.
+func (m Match) CanTransitionTo(status string) bool {
+	return slices.Contains(matchTransitions[m.Status], status)
+}
+
+// HasShootout reports whether the match was settled by a penalty shootout.
+func (m Match) HasShootout() bool {
+	return m.HomeShootoutScore != nil && m.AwayShootoutScore != nil
+}
+
+// Winner returns the team that won the match, or uuid.Nil for a draw. A shootout only decides
+// the winner when the match's competition counts shootout wins (ShootoutRuleWin), which needs
+// Season.Competition to be loaded; without it the match stays a draw.
+func (m Match) Winner() uuid.UUID {
+	home, away := m.HomeScore, m.AwayScore
+	if home == away && m.HasShootout() && m.Season != nil && m.Season.Competition != nil &&
+		m.Season.Competition.ShootoutRule == ShootoutRuleWin {
+		home, away = *m.HomeShootoutScore, *m.AwayShootoutScore
+	}
+	switch {
+	case home > away:
+		return m.HomeTeamID
+	case away > home:
+		return m.AwayTeamID
+	default:
+		return uuid.Nil
+	}
+}
+
+// PointsRules returns the points rules of the match's competition, which needs
+// Season.Competition to be loaded; without it the default rules apply.
+func (m Match) PointsRules() PointsRules {
+	if m.Season != nil && m.Season.Competition != nil {
+		return m.Season.Competition.PointsRules()
+	}
+	return DefaultPointsRules
+}
+
+// ScoreLine returns the final score as reports show it, e.g. "2-1" or "2-2, 4-3 on pens".
+func (m Match) ScoreLine() string {
+	line := strconv.Itoa(m.HomeScore) + "-" + strconv.Itoa(m.AwayScore)
+	if m.HasShootout() {
+		line += ", " + strconv.Itoa(*m.HomeShootoutScore) + "-" + strconv.Itoa(*m.AwayShootoutScore) + " on pens"
+	}
+	return line
+}
@@ -0,0 +1,20 @@
+package model
+
+import "github.com/google/uuid"
+
+// MatchAttachment is a document filed with a match, such as the referee's report.
+// The file itself is kept in private storage under StorageKey and only downloaded through the API.
+type MatchAttachment struct {
+	Base
+	MatchID     uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	FileName    string    `gorm:"type:text;not null" json:"file_name"` // Name of the uploaded file, used for downloads
+	ContentType string    `gorm:"type:text;not null" json:"content_type"`
+	Size        int64     `gorm:"not null" json:"size"` // In bytes
+	StorageKey  string    `gorm:"type:text;not null" json:"-"`
+	UploadedBy  uuid.UUID `gorm:"type:uuid" json:"uploaded_by"` // Admin who uploaded the file
+}
+
+// TableName overrides the default table name.
+func (MatchAttachment) TableName() string {
+	return "match_attachments"
+}
@@ -0,0 +1,128 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Match event types recorded during a match.
+const (
+	EventGoal         = "goal"
+	EventOwnGoal      = "own_goal"
+	EventPenalty      = "penalty" // converted penalty kick
+	EventYellowCard   = "yellow_card"
+	EventRedCard      = "red_card"
+	EventSubstitution = "substitution"
+)
+
+// ValidEventTypes defines the allowed match event types.
+var ValidEventTypes = []string{
+	EventGoal,
+	EventOwnGoal,
+	EventPenalty,
+	EventYellowCard,
+	EventRedCard,
+	EventSubstitution,
+}
+
+// Goal types accepted by the legacy goals payload, each recorded as the matching event type.
+const (
+	GoalOpenPlay = "open_play"
+	GoalPenalty  = "penalty"
+	GoalOwnGoal  = "own_goal"
+)
+
+// GoalTypeEvents maps each goal type to the event type it is recorded as.
+var GoalTypeEvents = map[string]string{
+	GoalOpenPlay: EventGoal,
+	GoalPenalty:  EventPenalty,
+	GoalOwnGoal:  EventOwnGoal,
+}
+
+// Event minutes run from 1 to the end of regular time (90) or of extra time (120). Added time is
+// recorded apart from the minute the period ends in: "90+3" is minute 90 with 3 minutes added.
+const (
+	MaxEventMinute = 120
+	MaxAddedTime   = 30
+)
+
+// PeriodEndMinutes are the minutes the halves of regular and extra time end in, the only
+// minutes that can have added time.
+var PeriodEndMinutes = []int{45, 90, 105, 120}
+
+// ErrInvalidEventMinute is returned by ParseEventMinute for minutes outside the match.
+var ErrInvalidEventMinute = errors.New("invalid event minute")
+
+// ParseEventMinute reads a minute written as "67" or, in added time, as "90+3".
+func ParseEventMinute(s string) (minute, added int, err error) {
+	base, extra, hasExtra := strings.Cut(strings.TrimSpace(s), "+")
+	if minute, err = strconv.Atoi(base); err != nil || minute < 1 || minute > MaxEventMinute {
+		return 0, 0, ErrInvalidEventMinute
+	}
+	if !hasExtra {
+		return minute, 0, nil
+	}
+	if added, err = strconv.Atoi(extra); err != nil || added < 1 || added > MaxAddedTime || !slices.Contains(PeriodEndMinutes, minute) {
+		return 0, 0, ErrInvalidEventMinute
+	}
+	return minute, added, nil
+}
+
+// FormatEventMinute writes a minute the way reports show it, e.g. "67'" or "90+3'".
+func FormatEventMinute(minute, added int) string {
+	if added > 0 {
+		return strconv.Itoa(minute) + "+" + strconv.Itoa(added) + "'"
+	}
+	return strconv.Itoa(minute) + "'"
+}
+
+// MatchEvent represents something that happened in a match: a goal, card or substitution.
+// TeamID is always the team of PlayerID; for own goals the score is credited to the opponent.
+// For substitutions PlayerID leaves the pitch and RelatedPlayerID comes on.
+// Players must belong to one of the two teams in the match (validated in service layer).
+// The (match_id, minute, added_time) index returns a match's events in match order without a sort.
+type MatchEvent struct {
+	Base
+	MatchID         uuid.UUID  `gorm:"type:uuid;not null;index:idx_match_events_match_minute,priority:1" json:"match_id"`
+	Type            string     `gorm:"type:text;not null;index" json:"type"`
+	PlayerID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"player_id"`
+	RelatedPlayerID *uuid.UUID `gorm:"type:uuid" json:"related_player_id,omitempty"`
+	TeamID          uuid.UUID  `gorm:"type:uuid;not null" json:"team_id"`
+	Minute          int        `gorm:"type:int;not null;index:idx_match_events_match_minute,priority:2" json:"minute"`               // 1 to MaxEventMinute
+	AddedTime       int        `gorm:"type:int;not null;default:0;index:idx_match_events_match_minute,priority:3" json:"added_time"` // Minutes of added time after Minute, which then ends a period
+	Match           *Match     `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+	Player          *Player    `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+	RelatedPlayer   *Player    `gorm:"foreignKey:RelatedPlayerID" json:"related_player,omitempty"`
+	Team            *Team      `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (MatchEvent) TableName() string {
+	return "match_events"
+}
+
+// DisplayMinute returns the minute of the event the way reports show it, e.g. "90+3'".
+func (e MatchEvent) DisplayMinute() string {
+	return FormatEventMinute(e.Minute, e.AddedTime)
+}
+
+// GoalMarker returns how reports mark the goal apart from open-play goals: "OG" for own goals,
+// "PEN" for penalties and "" otherwise.
+func (e MatchEvent) GoalMarker() string {
+	switch e.Type {
+	case EventOwnGoal:
+		return "OG"
+	case EventPenalty:
+		return "PEN"
+	}
+	return ""
+}
+
+// IsScoring reports whether the event changes the score.
+func (e MatchEvent) IsScoring() bool {
+	return slices.Contains([]string{EventGoal, EventOwnGoal, EventPenalty}, e.Type)
+}
@@ -0,0 +1,20 @@
+package model
+
+import "github.com/google/uuid"
+
+// MatchLineup records a player named in a team's matchday squad, either in the
+// starting XI or on the bench. The player must belong to TeamID (validated in service layer).
+// Rows are hard-deleted when a team's lineup is replaced.
+type MatchLineup struct {
+	Base
+	MatchID  uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	TeamID   uuid.UUID `gorm:"type:uuid;not null" json:"team_id"`
+	PlayerID uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	Starter  bool      `gorm:"not null;default:false" json:"starter"`
+	Player   *Player   `gorm:"foreignKey:PlayerID" json:"player,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (MatchLineup) TableName() string {
+	return "match_lineups"
+}
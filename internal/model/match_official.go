@@ -0,0 +1,25 @@
+package model
+
+import "github.com/google/uuid"
+
+// Officiating roles. A match has one referee and up to two assistants.
+const (
+	OfficialRoleReferee   = "referee"
+	OfficialRoleAssistant = "assistant"
+)
+
+// MatchOfficial records a referee assigned to a match in one role.
+// Rows are hard-deleted when a match's officials are replaced.
+type MatchOfficial struct {
+	Base
+	MatchID   uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	RefereeID uuid.UUID `gorm:"type:uuid;not null;index" json:"referee_id"`
+	Role      string    `gorm:"type:text;not null" json:"role"`
+	Referee   *Referee  `gorm:"foreignKey:RefereeID" json:"referee,omitempty"`
+	Match     *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (MatchOfficial) TableName() string {
+	return "match_officials"
+}
@@ -0,0 +1,17 @@
+package model
+
+import "github.com/google/uuid"
+
+// MatchStatusChange records a match moving from one status to another, whether changed by an
+// admin, by the status scheduler or by submitting the result. CreatedAt is when it happened.
+type MatchStatusChange struct {
+	Base
+	MatchID    uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	FromStatus string    `gorm:"type:text;not null" json:"from_status"`
+	ToStatus   string    `gorm:"type:text;not null" json:"to_status"`
+}
+
+// TableName overrides the default table name.
+func (MatchStatusChange) TableName() string {
+	return "match_status_changes"
+}
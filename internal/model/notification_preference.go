@@ -0,0 +1,26 @@
+package model
+
+import "github.com/google/uuid"
+
+// Email notifications an admin can subscribe to.
+const (
+	NotificationResultSubmitted = "result_submitted" // A match result was submitted
+	NotificationMatchPostponed  = "match_postponed"  // A match was postponed
+	NotificationStandingsDigest = "standings_digest" // Weekly league table
+)
+
+// NotificationPreference holds the address an admin receives email notifications at
+// and which notifications they want. Admins without a row receive none.
+type NotificationPreference struct {
+	Base
+	AdminID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"admin_id"`
+	Email           string    `gorm:"type:text;not null" json:"email"`
+	ResultSubmitted bool      `gorm:"not null;default:false" json:"result_submitted"`
+	MatchPostponed  bool      `gorm:"not null;default:false" json:"match_postponed"`
+	StandingsDigest bool      `gorm:"not null;default:false" json:"standings_digest"`
+}
+
+// TableName overrides the default table name.
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
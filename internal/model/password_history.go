@@ -0,0 +1,16 @@
+package model
+
+import "github.com/google/uuid"
+
+// PasswordHistory keeps the hash of a password an admin used before, so it cannot be reused.
+// Only the most recent entries allowed by the password policy are kept.
+type PasswordHistory struct {
+	Base
+	AdminID uuid.UUID `gorm:"type:uuid;not null;index" json:"admin_id"`
+	Hash    string    `gorm:"type:text;not null" json:"-"`
+}
+
+// TableName overrides the default table name.
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
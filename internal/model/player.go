@@ -1,25 +1,58 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // ValidPositions defines the allowed player positions.
-var ValidPositions = []string{"penyerang", "gelandang", "bertahan", "penjaga_gawang"}
+var ValidPositions = []string{"penyerang", "gelandang", "bertahan", PositionGoalkeeper}
+
+// PositionGoalkeeper is the position counted by the goalkeeper roster rule.
+const PositionGoalkeeper = "penjaga_gawang"
+
+// ValidPreferredFeet defines the allowed values of a player's preferred foot.
+var ValidPreferredFeet = []string{"left", "right", "both"}
 
 // Player represents a football player belonging to a team.
 // Jersey number uniqueness per team is validated at the service layer
 // (not via DB constraint) because soft-deleted players should free up their numbers.
+// The partial (team_id, jersey_number) index over current players serves that check and squad lists.
 type Player struct {
 	Base
-	TeamID       uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
-	Name         string    `gorm:"type:text;not null" json:"name"`
-	Height       int       `gorm:"type:int" json:"height"` // in cm
-	Weight       int       `gorm:"type:int" json:"weight"` // in kg
-	Position     string    `gorm:"type:text;not null" json:"position"`
-	JerseyNumber int       `gorm:"type:int;not null" json:"jersey_number"`
-	Team         *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	TeamID        uuid.UUID `gorm:"type:uuid;not null;index;index:idx_players_team_jersey,priority:1,where:deleted_at IS NULL" json:"team_id"`
+	Name          string    `gorm:"type:text;not null" json:"name"`
+	Slug          string    `gorm:"type:text;not null;default:'';index:idx_players_slug,unique,where:deleted_at IS NULL AND slug <> ''" json:"slug"` // From the name, e.g. "marko-simic"; unique among non-deleted players
+	Height        int       `gorm:"type:int" json:"height"`                                                                                          // in cm
+	Weight        int       `gorm:"type:int" json:"weight"`                                                                                          // in kg
+	Position      string    `gorm:"type:text;not null" json:"position"`
+	JerseyNumber  int       `gorm:"type:int;not null;index:idx_players_team_jersey,priority:2" json:"jersey_number"`
+	Nationality   string    `gorm:"type:text" json:"nationality"`      // ISO 3166-1 alpha-2 code, e.g. "ID"; empty if unknown
+	BirthDate     string    `gorm:"type:text" json:"birth_date"`       // YYYY-MM-DD; empty if unknown
+	PreferredFoot string    `gorm:"type:text" json:"preferred_foot"`   // "left", "right" or "both"; empty if unknown
+	MarketValue   int64     `gorm:"type:bigint" json:"market_value"`   // Estimated transfer value in euros; 0 if unknown
+	PhotoURL      string    `gorm:"type:text" json:"photo_url"`        // Resized headshot; empty until one is uploaded
+	ThumbnailURL  string    `gorm:"type:text" json:"thumbnail_url"`    // Square thumbnail of the headshot
+	Version       int       `gorm:"not null;default:1" json:"version"` // Incremented on every update, for optimistic locking
+	Team          *Team     `gorm:"foreignKey:TeamID" json:"team,omitempty"`
 }
 
 // TableName overrides the default table name.
 func (Player) TableName() string {
 	return "players"
 }
+
+// AgeOn returns the player's age in whole years on a date, and false if the birth date is
+// unknown. Players born on 29 February turn a year older on 1 March in common years.
+func (p Player) AgeOn(date time.Time) (int, bool) {
+	birth, err := time.Parse(time.DateOnly, p.BirthDate)
+	if err != nil {
+		return 0, false
+	}
+	age := date.Year() - birth.Year()
+	if date.Month() < birth.Month() || (date.Month() == birth.Month() && date.Day() < birth.Day()) {
+		age--
+	}
+	return age, true
+}
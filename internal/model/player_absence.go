@@ -0,0 +1,28 @@
+package model
+
+import "github.com/google/uuid"
+
+// Reasons a player can be unavailable.
+const (
+	AbsenceTypeInjury     = "injury"
+	AbsenceTypeSuspension = "suspension"
+)
+
+// ValidAbsenceTypes defines the allowed absence types.
+var ValidAbsenceTypes = []string{AbsenceTypeInjury, AbsenceTypeSuspension}
+
+// PlayerAbsence records a period in which a player is unavailable for selection.
+// Both dates are inclusive; an empty EndDate means the player is out until further notice.
+type PlayerAbsence struct {
+	Base
+	PlayerID  uuid.UUID `gorm:"type:uuid;not null;index" json:"player_id"`
+	Type      string    `gorm:"type:text;not null" json:"type"`
+	Reason    string    `gorm:"type:text" json:"reason"`
+	StartDate string    `gorm:"type:text;not null" json:"start_date"` // YYYY-MM-DD
+	EndDate   string    `gorm:"type:text" json:"end_date"`            // YYYY-MM-DD
+}
+
+// TableName overrides the default table name.
+func (PlayerAbsence) TableName() string {
+	return "player_absences"
+}
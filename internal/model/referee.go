@@ -0,0 +1,13 @@
+package model
+
+// Referee represents a match official who can be assigned to matches as referee or assistant.
+type Referee struct {
+	Base
+	Name    string `gorm:"type:text;not null" json:"name"`
+	Country string `gorm:"type:text" json:"country"`
+}
+
+// TableName overrides the default table name.
+func (Referee) TableName() string {
+	return "referees"
+}
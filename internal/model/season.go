@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// Season represents a single edition of a competition (e.g. "2025/26").
+// Matches may optionally be assigned to a season.
+type Season struct {
+	Base
+	CompetitionID uuid.UUID    `gorm:"type:uuid;not null;index" json:"competition_id"`
+	Name          string       `gorm:"type:text;not null" json:"name"`
+	StartDate     string       `gorm:"type:text;not null" json:"start_date"` // YYYY-MM-DD
+	EndDate       string       `gorm:"type:text;not null" json:"end_date"`   // YYYY-MM-DD
+	Competition   *Competition `gorm:"foreignKey:CompetitionID" json:"competition,omitempty"`
+}
+
+// TableName overrides the default table name.
+func (Season) TableName() string {
+	return "seasons"
+}
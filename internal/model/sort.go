@@ -0,0 +1,17 @@
+package model
+
+// Sort keys each listing accepts in sort_by, default first. Most are columns of the listed
+// table; the repositories order by nothing else and the handlers reject any other value.
+var (
+	AdminSorts       = []string{"created_at", "username", "role"}
+	APIKeySorts      = []string{"created_at", "name", "last_used_at"}
+	CoachSorts       = []string{"created_at", "name", "role", "contract_end"}
+	CompetitionSorts = []string{"created_at", "name", "country"}
+	MatchSorts       = []string{"created_at", "match_datetime", "round", "status", "score_difference", "home_team_name", "away_team_name"}
+	PlayerSorts      = []string{"created_at", "name", "jersey_number", "position", "height", "weight", "birth_date", "market_value"}
+	RefereeSorts     = []string{"created_at", "name", "country"}
+	SeasonSorts      = []string{"created_at", "name", "start_date"}
+	StadiumSorts     = []string{"created_at", "name", "city", "capacity"}
+	TeamSorts        = []string{"created_at", "name", "founded_year", "city", "player_count"}
+	WebhookSorts     = []string{"created_at", "url"}
+)
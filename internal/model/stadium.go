@@ -0,0 +1,15 @@
+package model
+
+// Stadium represents a venue matches are played at. A team may have a home stadium,
+// which new matches default to when no venue is given.
+type Stadium struct {
+	Base
+	Name     string `gorm:"type:text;not null" json:"name"`
+	City     string `gorm:"type:text" json:"city"`
+	Capacity int    `gorm:"type:int" json:"capacity"`
+}
+
+// TableName overrides the default table name.
+func (Stadium) TableName() string {
+	return "stadiums"
+}
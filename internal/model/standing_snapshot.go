@@ -0,0 +1,23 @@
+package model
+
+import "github.com/google/uuid"
+
+// StandingSnapshot is one team's row of a league table as it stood right after a match was
+// completed. Every completed match records the all-time table and, for matches in a season,
+// the season table, so positions can be charted over time without replaying old results.
+type StandingSnapshot struct {
+	Base
+	MatchID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"match_id"`
+	SeasonID       *uuid.UUID `gorm:"type:uuid;index:idx_standing_snapshots_team_season,priority:2" json:"season_id,omitempty"` // nil for the all-time table
+	TeamID         uuid.UUID  `gorm:"type:uuid;not null;index:idx_standing_snapshots_team_season,priority:1" json:"team_id"`
+	Round          int        `gorm:"not null" json:"round"` // Most matches played by any team in the table
+	Position       int        `gorm:"not null" json:"position"`
+	Played         int        `gorm:"not null" json:"played"`
+	Points         int        `gorm:"not null" json:"points"`
+	GoalDifference int        `gorm:"not null" json:"goal_difference"`
+}
+
+// TableName overrides the default table name.
+func (StandingSnapshot) TableName() string {
+	return "standing_snapshots"
+}
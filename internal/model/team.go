@@ -1,14 +1,29 @@
 package model
 
+import "github.com/google/uuid"
+
 // Team represents a football team managed by Perusahaan XYZ.
+// The short name, abbreviation, colors and links are optional display fields for scoreboards.
 type Team struct {
 	Base
-	Name        string   `gorm:"type:text;not null" json:"name"`
-	LogoURL     string   `gorm:"type:text" json:"logo_url"`
-	FoundedYear int      `gorm:"type:int" json:"founded_year"`
-	Address     string   `gorm:"type:text" json:"address"`
-	City        string   `gorm:"type:text" json:"city"`
-	Players     []Player `gorm:"foreignKey:TeamID" json:"players,omitempty"`
+	Name           string     `gorm:"type:text;not null;index:idx_teams_name_lower,unique,expression:lower(name),where:deleted_at IS NULL" json:"name"` // Unique among non-deleted teams, ignoring case
+	LogoURL        string     `gorm:"type:text" json:"logo_url"`
+	FoundedYear    int        `gorm:"type:int" json:"founded_year"`
+	Address        string     `gorm:"type:text" json:"address"`
+	City           string     `gorm:"type:text" json:"city"`
+	Slug           string     `gorm:"type:text;not null;default:'';index:idx_teams_slug,unique,where:deleted_at IS NULL AND slug <> ''" json:"slug"`     // From the name, e.g. "persija-jakarta"; unique among non-deleted teams
+	StadiumID      *uuid.UUID `gorm:"type:uuid;index" json:"stadium_id"`                                                                                 // Home stadium; the default venue of the team's home matches
+	ShortName      string     `gorm:"type:text" json:"short_name"`                                                                                       // e.g. "Persija"
+	Abbreviation   string     `gorm:"type:text;index:idx_teams_abbreviation,unique,where:deleted_at IS NULL AND abbreviation <> ''" json:"abbreviation"` // Three upper-case letters, unique among non-deleted teams
+	PrimaryColor   string     `gorm:"type:text" json:"primary_color"`                                                                                    // #RRGGBB, upper case
+	SecondaryColor string     `gorm:"type:text" json:"secondary_color"`
+	WebsiteURL     string     `gorm:"type:text" json:"website_url"`
+	FacebookURL    string     `gorm:"type:text" json:"facebook_url"`
+	InstagramURL   string     `gorm:"type:text" json:"instagram_url"`
+	XURL           string     `gorm:"type:text" json:"x_url"`
+	Version        int        `gorm:"not null;default:1" json:"version"` // Incremented on every update, for optimistic locking
+	Players        []Player   `gorm:"foreignKey:TeamID" json:"players,omitempty"`
+	HeadCoach      *Coach     `gorm:"foreignKey:TeamID" json:"head_coach,omitempty"` // Coach with the head_coach role, preloaded by FindAll and FindByID
 }
 
 // TableName overrides the default table name.
@@ -0,0 +1,16 @@
+package model
+
+import "github.com/google/uuid"
+
+// TeamManager assigns a team to an admin with the team_manager role.
+// Rows are hard-deleted when an admin's teams are replaced.
+type TeamManager struct {
+	Base
+	AdminID uuid.UUID `gorm:"type:uuid;not null;index" json:"admin_id"`
+	TeamID  uuid.UUID `gorm:"type:uuid;not null;index" json:"team_id"`
+}
+
+// TableName overrides the default table name.
+func (TeamManager) TableName() string {
+	return "team_managers"
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TeamRating is a team's Elo rating right after a completed match. Ratings are replayed from
+// every completed result whenever one changes, so a corrected or undone result also corrects
+// the ratings after it.
+type TeamRating struct {
+	Base
+	MatchID    uuid.UUID `gorm:"type:uuid;not null;index" json:"match_id"`
+	TeamID     uuid.UUID `gorm:"type:uuid;not null;index:idx_team_ratings_team_played,priority:1" json:"team_id"`
+	OpponentID uuid.UUID `gorm:"type:uuid;not null" json:"opponent_id"`
+	PlayedAt   time.Time `gorm:"not null;index:idx_team_ratings_team_played,priority:2" json:"played_at"` // Kick-off of the match
+	Rating     float64   `gorm:"not null" json:"rating"`                                                  // After the match
+	Change     float64   `gorm:"not null" json:"change"`
+}
+
+// TableName overrides the default table name.
+func (TeamRating) TableName() string {
+	return "team_ratings"
+}
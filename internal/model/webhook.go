@@ -0,0 +1,67 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook is a callback URL that receives signed POST requests for the subscribed event types.
+// Secret is the HMAC key deliveries are signed with; it is shown once, when the webhook is created.
+type Webhook struct {
+	Base
+	URL         string    `gorm:"type:text;not null" json:"url"`
+	Description string    `gorm:"type:text" json:"description"`
+	Events      string    `gorm:"type:text;not null" json:"events"` // Comma-separated event types
+	Secret      string    `gorm:"type:text;not null" json:"-"`
+	Active      bool      `gorm:"not null;default:true" json:"active"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null;index" json:"created_by"`
+}
+
+// TableName overrides the default table name.
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// EventList returns the event types the webhook is subscribed to.
+func (w Webhook) EventList() []string {
+	if w.Events == "" {
+		return nil
+	}
+	return strings.Split(w.Events, ",")
+}
+
+// Subscribes reports whether the webhook wants events of the given type.
+func (w Webhook) Subscribes(eventType string) bool {
+	return slices.Contains(w.EventList(), eventType)
+}
+
+// Webhook delivery statuses.
+const (
+	DeliveryStatusPending   = "pending"   // Waiting for its first or next attempt
+	DeliveryStatusSucceeded = "succeeded" // The endpoint answered with a 2xx status
+	DeliveryStatusFailed    = "failed"    // Every attempt failed, or the webhook was removed
+)
+
+// WebhookDelivery is one event queued for one webhook, together with the outcome of its latest attempt.
+// EventID is shared by the deliveries of the same event to different webhooks.
+type WebhookDelivery struct {
+	Base
+	WebhookID      uuid.UUID  `gorm:"type:uuid;not null;index" json:"webhook_id"`
+	EventID        uuid.UUID  `gorm:"type:uuid;not null" json:"event_id"`
+	EventType      string     `gorm:"type:text;not null" json:"event_type"`
+	Payload        string     `gorm:"type:jsonb;not null" json:"payload"`
+	Status         string     `gorm:"type:text;not null;index" json:"status"`
+	Attempts       int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time `gorm:"index" json:"next_attempt_at"`
+	LastStatusCode int        `json:"last_status_code"`
+	LastError      string     `gorm:"type:text" json:"last_error"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// TableName overrides the default table name.
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"slices"
+
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"gorm.io/gorm"
@@ -10,7 +12,12 @@ import (
 type AdminRepository interface {
 	FindByUsername(username string) (*model.Admin, error)
 	FindByID(id uuid.UUID) (*model.Admin, error)
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Admin, error)
 	Create(admin *model.Admin) error
+	Update(admin *model.Admin) error
+	Delete(id uuid.UUID) error
+	Count() (int64, error)
+	CountByRole(role string) (int64, error)
 }
 
 // adminRepository implements AdminRepository using GORM.
@@ -42,3 +49,46 @@ func (r *adminRepository) FindByID(id uuid.UUID) (*model.Admin, error) {
 func (r *adminRepository) Create(admin *model.Admin) error {
 	return r.db.Create(admin).Error
 }
+
+func (r *adminRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Admin, error) {
+	var admins []model.Admin
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.AdminSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&admins).Error; err != nil {
+		return nil, err
+	}
+	return admins, nil
+}
+
+func (r *adminRepository) Update(admin *model.Admin) error {
+	return r.db.Save(admin).Error
+}
+
+// Delete hard-deletes an admin so the username can be reused
+// (the unique index on username would otherwise still match the soft-deleted row).
+func (r *adminRepository) Delete(id uuid.UUID) error {
+	return r.db.Unscoped().Where("id = ?", id).Delete(&model.Admin{}).Error
+}
+
+func (r *adminRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Admin{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *adminRepository) CountByRole(role string) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Admin{}).Where("role = ?", role).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// APIKeyRepository defines the contract for API key data access.
+type APIKeyRepository interface {
+	Create(key *model.APIKey) error
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.APIKey, error)
+	Count() (int64, error)
+	FindByID(id uuid.UUID) (*model.APIKey, error)
+	FindByHash(keyHash string) (*model.APIKey, error)
+	Revoke(id uuid.UUID, at time.Time) error
+	TouchLastUsed(id uuid.UUID, at time.Time) error
+}
+
+// apiKeyRepository implements APIKeyRepository using GORM.
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository instance.
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(key *model.APIKey) error {
+	return r.db.Create(key).Error
+}
+
+func (r *apiKeyRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.APIKey, error) {
+	var keys []model.APIKey
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.APIKeySorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.APIKey{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *apiKeyRepository) FindByID(id uuid.UUID) (*model.APIKey, error) {
+	var key model.APIKey
+	if err := r.db.Where("id = ?", id).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindByHash looks up a key by the SHA-256 hash of its plain value.
+func (r *apiKeyRepository) FindByHash(keyHash string) (*model.APIKey, error) {
+	var key model.APIKey
+	if err := r.db.Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// Revoke marks the key as revoked at the given time. Already revoked keys keep their original time.
+func (r *apiKeyRepository) Revoke(id uuid.UUID, at time.Time) error {
+	return r.db.Model(&model.APIKey{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at).Error
+}
+
+// TouchLastUsed records when the key was last used, without changing updated_at.
+func (r *apiKeyRepository) TouchLastUsed(id uuid.UUID, at time.Time) error {
+	return r.db.Model(&model.APIKey{}).Where("id = ?", id).UpdateColumn("last_used_at", at).Error
+}
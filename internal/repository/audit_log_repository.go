@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// AuditLogFilter narrows audit log queries. Zero-value fields are ignored.
+type AuditLogFilter struct {
+	AdminID  *uuid.UUID
+	Entity   string
+	EntityID *uuid.UUID
+	Action   string
+	From     *time.Time // inclusive
+	To       *time.Time // exclusive
+}
+
+// apply adds the filter conditions to a query.
+func (f AuditLogFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.AdminID != nil {
+		query = query.Where("admin_id = ?", *f.AdminID)
+	}
+	if f.Entity != "" {
+		query = query.Where("entity = ?", f.Entity)
+	}
+	if f.EntityID != nil {
+		query = query.Where("entity_id = ?", *f.EntityID)
+	}
+	if f.Action != "" {
+		query = query.Where("action = ?", f.Action)
+	}
+	if f.From != nil {
+		query = query.Where("created_at >= ?", *f.From)
+	}
+	if f.To != nil {
+		query = query.Where("created_at < ?", *f.To)
+	}
+	return query
+}
+
+// AuditLogRepository defines the contract for audit log data access.
+type AuditLogRepository interface {
+	Create(log *model.AuditLog) error
+	FindAll(filter AuditLogFilter, offset, limit int) ([]model.AuditLog, error)
+	Count(filter AuditLogFilter) (int64, error)
+	FindRow(table string, id uuid.UUID) (map[string]any, error)
+	DeleteBefore(cutoff time.Time) (int64, error)
+}
+
+// auditLogRepository implements AuditLogRepository using GORM.
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository creates a new AuditLogRepository instance.
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(log *model.AuditLog) error {
+	return r.db.Create(log).Error
+}
+
+// FindAll returns audit logs, newest first.
+func (r *auditLogRepository) FindAll(filter AuditLogFilter, offset, limit int) ([]model.AuditLog, error) {
+	var logs []model.AuditLog
+	err := filter.apply(r.db).
+		Order("created_at desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (r *auditLogRepository) Count(filter AuditLogFilter) (int64, error) {
+	var count int64
+	if err := filter.apply(r.db.Model(&model.AuditLog{})).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// FindRow loads a single non-deleted row of any table as a column → value map.
+// Used to snapshot an entity before and after a mutation.
+// Returns gorm.ErrRecordNotFound if the row does not exist (or is soft-deleted).
+func (r *auditLogRepository) FindRow(table string, id uuid.UUID) (map[string]any, error) {
+	row := make(map[string]any)
+	if err := r.db.Table(table).Where("id = ? AND deleted_at IS NULL", id).Take(&row).Error; err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// DeleteBefore hard-deletes every audit log written before cutoff and returns how many were removed.
+func (r *auditLogRepository) DeleteBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().Where("created_at < ?", cutoff).Delete(&model.AuditLog{})
+	return result.RowsAffected, result.Error
+}
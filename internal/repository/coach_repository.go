@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// CoachRepository defines the contract for coaching staff data access.
+type CoachRepository interface {
+	FindAllByTeamID(teamID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Coach, error)
+	FindByID(id uuid.UUID) (*model.Coach, error)
+	FindHeadCoach(teamID uuid.UUID) (*model.Coach, error)
+	Create(coach *model.Coach) error
+	Update(coach *model.Coach) error
+	Delete(id uuid.UUID) error
+	CountByTeamID(teamID uuid.UUID) (int64, error)
+}
+
+// coachRepository implements CoachRepository using GORM.
+type coachRepository struct {
+	db *gorm.DB
+}
+
+// NewCoachRepository creates a new CoachRepository instance.
+func NewCoachRepository(db *gorm.DB) CoachRepository {
+	return &coachRepository{db: db}
+}
+
+func (r *coachRepository) FindAllByTeamID(teamID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Coach, error) {
+	var coaches []model.Coach
+	query := r.db.Where("team_id = ?", teamID).Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.CoachSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&coaches).Error; err != nil {
+		return nil, err
+	}
+	return coaches, nil
+}
+
+func (r *coachRepository) FindByID(id uuid.UUID) (*model.Coach, error) {
+	var coach model.Coach
+	if err := r.db.Where("id = ?", id).First(&coach).Error; err != nil {
+		return nil, err
+	}
+	return &coach, nil
+}
+
+// FindHeadCoach returns the team's head coach, or gorm.ErrRecordNotFound when it has none.
+func (r *coachRepository) FindHeadCoach(teamID uuid.UUID) (*model.Coach, error) {
+	var coach model.Coach
+	if err := r.db.Where("team_id = ? AND role = ?", teamID, model.CoachRoleHead).First(&coach).Error; err != nil {
+		return nil, err
+	}
+	return &coach, nil
+}
+
+func (r *coachRepository) Create(coach *model.Coach) error {
+	return r.db.Create(coach).Error
+}
+
+func (r *coachRepository) Update(coach *model.Coach) error {
+	return r.db.Save(coach).Error
+}
+
+func (r *coachRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Coach{}).Error
+}
+
+func (r *coachRepository) CountByTeamID(teamID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Coach{}).Where("team_id = ?", teamID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
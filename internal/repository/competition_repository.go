@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// CompetitionRepository defines the contract for competition data access.
+type CompetitionRepository interface {
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Competition, error)
+	FindByID(id uuid.UUID) (*model.Competition, error)
+	Create(competition *model.Competition) error
+	Update(competition *model.Competition) error
+	Delete(id uuid.UUID) error
+	Count() (int64, error)
+}
+
+// competitionRepository implements CompetitionRepository using GORM.
+type competitionRepository struct {
+	db *gorm.DB
+}
+
+// NewCompetitionRepository creates a new CompetitionRepository instance.
+func NewCompetitionRepository(db *gorm.DB) CompetitionRepository {
+	return &competitionRepository{db: db}
+}
+
+func (r *competitionRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Competition, error) {
+	var competitions []model.Competition
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.CompetitionSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&competitions).Error; err != nil {
+		return nil, err
+	}
+	return competitions, nil
+}
+
+func (r *competitionRepository) FindByID(id uuid.UUID) (*model.Competition, error) {
+	var competition model.Competition
+	if err := r.db.Where("id = ?", id).First(&competition).Error; err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+func (r *competitionRepository) Create(competition *model.Competition) error {
+	return r.db.Create(competition).Error
+}
+
+func (r *competitionRepository) Update(competition *model.Competition) error {
+	return r.db.Save(competition).Error
+}
+
+func (r *competitionRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Competition{}).Error
+}
+
+func (r *competitionRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Competition{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ConfirmationTokenRepository defines the contract for confirmation token data access.
+type ConfirmationTokenRepository interface {
+	Create(token *model.ConfirmationToken) error
+	Consume(token string, adminID uuid.UUID, action string, resourceID uuid.UUID) (*model.ConfirmationToken, error)
+	DeleteExpired() error
+}
+
+// confirmationTokenRepository implements ConfirmationTokenRepository using GORM.
+type confirmationTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewConfirmationTokenRepository creates a new ConfirmationTokenRepository instance.
+func NewConfirmationTokenRepository(db *gorm.DB) ConfirmationTokenRepository {
+	return &confirmationTokenRepository{db: db}
+}
+
+func (r *confirmationTokenRepository) Create(token *model.ConfirmationToken) error {
+	return r.db.Create(token).Error
+}
+
+// Consume atomically hard-deletes a matching, unexpired token and returns it, or nil if
+// there is none. Only one of two requests racing with the same value gets the token, so a
+// token can never be replayed. Creating the returned token again makes it usable again.
+func (r *confirmationTokenRepository) Consume(token string, adminID uuid.UUID, action string, resourceID uuid.UUID) (*model.ConfirmationToken, error) {
+	var consumed []model.ConfirmationToken
+	result := r.db.Unscoped().Clauses(clause.Returning{}).
+		Where("token = ? AND admin_id = ? AND action = ? AND resource_id = ? AND expires_at > ?",
+			token, adminID, action, resourceID, time.Now()).
+		Delete(&consumed)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(consumed) != 1 {
+		return nil, nil
+	}
+	return &consumed[0], nil
+}
+
+// DeleteExpired performs a hard delete of all tokens past their expiration time.
+func (r *confirmationTokenRepository) DeleteExpired() error {
+	return r.db.Unscoped().Where("expires_at <= ?", time.Now()).Delete(&model.ConfirmationToken{}).Error
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ExternalRefRepository defines the contract for data access of the IDs records have at
+// external football data providers.
+type ExternalRefRepository interface {
+	FindAll(provider, entityType string) (map[string]uuid.UUID, error)
+	Save(ref *model.ExternalRef) error
+}
+
+// externalRefRepository implements ExternalRefRepository using GORM.
+type externalRefRepository struct {
+	db *gorm.DB
+}
+
+// NewExternalRefRepository creates a new ExternalRefRepository instance.
+func NewExternalRefRepository(db *gorm.DB) ExternalRefRepository {
+	return &externalRefRepository{db: db}
+}
+
+// FindAll returns the records of a kind imported from a provider, by their external ID.
+func (r *externalRefRepository) FindAll(provider, entityType string) (map[string]uuid.UUID, error) {
+	var refs []model.ExternalRef
+	if err := r.db.Where("provider = ? AND entity_type = ?", provider, entityType).Find(&refs).Error; err != nil {
+		return nil, err
+	}
+	ids := make(map[string]uuid.UUID, len(refs))
+	for _, ref := range refs {
+		ids[ref.ExternalID] = ref.EntityID
+	}
+	return ids, nil
+}
+
+// Save maps an external ID to ref.EntityID, replacing the record it mapped to before.
+func (r *externalRefRepository) Save(ref *model.ExternalRef) error {
+	return saveExternalRef(r.db, ref)
+}
+
+// saveExternalRef inserts ref, or remaps its external ID when the ID is mapped already.
+func saveExternalRef(db *gorm.DB, ref *model.ExternalRef) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "provider"}, {Name: "entity_type"}, {Name: "external_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"entity_id", "updated_at"}),
+	}).Create(ref).Error
+}
+
+// externalRefID is a subquery selecting the ID of the record of entityType that provider's
+// externalID maps to, for finding the record with Where("id IN (?)", ...).
+func externalRefID(db *gorm.DB, provider, entityType, externalID string) *gorm.DB {
+	return db.Model(&model.ExternalRef{}).Select("entity_id").
+		Where("provider = ? AND entity_type = ? AND external_id = ?", provider, entityType, externalID)
+}
+
+// upsertExternal creates a record through create when id is unset, or saves it through update
+// otherwise, and maps provider's externalID to it, all in one transaction, so an import failing
+// halfway never leaves a record behind that it cannot find again and would create twice.
+func upsertExternal(db *gorm.DB, provider, entityType, externalID string, id *uuid.UUID, create, update func(tx *gorm.DB) error) error {
+	created := *id == uuid.Nil
+	save := update
+	if created {
+		save = create
+	}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := save(tx); err != nil {
+			return err
+		}
+		return saveExternalRef(tx, &model.ExternalRef{Provider: provider, EntityType: entityType, ExternalID: externalID, EntityID: *id})
+	})
+	if err != nil && created {
+		// The ID given to the record it tried to create is of no row
+		*id = uuid.Nil
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// likeEscaper escapes LIKE wildcards so user input is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern builds an ILIKE pattern that matches s anywhere in a column.
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(strings.TrimSpace(s)) + "%"
+}
+
+// Projection limits what a listing loads to the columns and associations its response
+// fields are made from, for sparse fieldsets. The zero value loads everything.
+type Projection struct {
+	Columns      []string // Loaded columns, which must be trusted names; id is always loaded
+	Associations []string // Preloaded associations by field name, e.g. "HeadCoach"
+}
+
+// loads reports whether association is preloaded.
+func (p Projection) loads(association string) bool {
+	return p.Columns == nil || slices.Contains(p.Associations, association)
+}
+
+// apply limits query to the projected columns of table.
+func (p Projection) apply(query *gorm.DB, table string) *gorm.DB {
+	if p.Columns == nil {
+		return query
+	}
+	columns := []string{table + ".id"}
+	for _, column := range p.Columns {
+		if column != "id" && !slices.Contains(columns, table+"."+column) {
+			columns = append(columns, table+"."+column)
+		}
+	}
+	return query.Select(columns)
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// GroupRepository defines the contract for group stage data access.
+type GroupRepository interface {
+	FindBySeasonID(seasonID uuid.UUID) ([]model.Group, error)
+	FindByID(id uuid.UUID) (*model.Group, error)
+	Create(group *model.Group) error
+	Update(group *model.Group) error
+	Delete(id uuid.UUID) error
+}
+
+// groupRepository implements GroupRepository using GORM.
+type groupRepository struct {
+	db *gorm.DB
+}
+
+// NewGroupRepository creates a new GroupRepository instance.
+func NewGroupRepository(db *gorm.DB) GroupRepository {
+	return &groupRepository{db: db}
+}
+
+// withTeams preloads a group's teams in the order they were assigned.
+func (r *groupRepository) withTeams() *gorm.DB {
+	return r.db.
+		Preload("Teams", func(db *gorm.DB) *gorm.DB { return db.Order("created_at asc, id asc") }).
+		Preload("Teams.Team", withDeleted)
+}
+
+// FindBySeasonID returns the groups of a season with their teams preloaded, by name.
+func (r *groupRepository) FindBySeasonID(seasonID uuid.UUID) ([]model.Group, error) {
+	var groups []model.Group
+	if err := r.withTeams().Where("season_id = ?", seasonID).Order("name asc").Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (r *groupRepository) FindByID(id uuid.UUID) (*model.Group, error) {
+	var group model.Group
+	if err := r.withTeams().Where("id = ?", id).First(&group).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// Create inserts the group together with its teams in a single transaction.
+func (r *groupRepository) Create(group *model.Group) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Teams").Create(group).Error; err != nil {
+			return err
+		}
+		return createGroupTeams(tx, group)
+	})
+}
+
+// Update saves the group and replaces its teams in a single transaction.
+func (r *groupRepository) Update(group *model.Group) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("group_id = ?", group.ID).Delete(&model.GroupTeam{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit("Teams").Save(group).Error; err != nil {
+			return err
+		}
+		return createGroupTeams(tx, group)
+	})
+}
+
+// createGroupTeams inserts the group's team assignments, leaving the teams themselves untouched.
+func createGroupTeams(tx *gorm.DB, group *model.Group) error {
+	if len(group.Teams) == 0 {
+		return nil
+	}
+	for i := range group.Teams {
+		group.Teams[i].GroupID = group.ID
+	}
+	return tx.Omit("Team").Create(&group.Teams).Error
+}
+
+// Delete soft-deletes the group and permanently removes its team assignments, so the teams
+// can join another group of the season.
+func (r *groupRepository) Delete(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("group_id = ?", id).Delete(&model.GroupTeam{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&model.Group{}).Error
+	})
+}
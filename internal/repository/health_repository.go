@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// HealthRepository defines the contract for database health probes.
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	PoolStats() (sql.DBStats, error)
+	MissingTables(tables []string) []string
+}
+
+// healthRepository implements HealthRepository using GORM.
+type healthRepository struct {
+	db *gorm.DB
+}
+
+// NewHealthRepository creates a new HealthRepository instance.
+func NewHealthRepository(db *gorm.DB) HealthRepository {
+	return &healthRepository{db: db}
+}
+
+// Ping verifies the database connection is alive.
+func (r *healthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// PoolStats returns connection pool statistics of the underlying sql.DB.
+func (r *healthRepository) PoolStats() (sql.DBStats, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return sql.DBStats{}, err
+	}
+	return sqlDB.Stats(), nil
+}
+
+// MissingTables returns the subset of the given table names that do not exist.
+// Used to detect an incomplete or failed migration.
+func (r *healthRepository) MissingTables(tables []string) []string {
+	missing := make([]string, 0)
+	for _, table := range tables {
+		if !r.db.Migrator().HasTable(table) {
+			missing = append(missing, table)
+		}
+	}
+	return missing
+}
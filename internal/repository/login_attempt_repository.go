@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LoginAttemptRepository defines the contract for failed login tracking.
+type LoginAttemptRepository interface {
+	FindByAdminID(adminID uuid.UUID) (*model.LoginAttempt, error)
+	RecordFailure(adminID uuid.UUID, now time.Time) (*model.LoginAttempt, error)
+	Lock(adminID uuid.UUID, until time.Time) error
+	DeleteByAdminID(adminID uuid.UUID) error
+}
+
+// loginAttemptRepository implements LoginAttemptRepository using GORM.
+type loginAttemptRepository struct {
+	db *gorm.DB
+}
+
+// NewLoginAttemptRepository creates a new LoginAttemptRepository instance.
+func NewLoginAttemptRepository(db *gorm.DB) LoginAttemptRepository {
+	return &loginAttemptRepository{db: db}
+}
+
+func (r *loginAttemptRepository) FindByAdminID(adminID uuid.UUID) (*model.LoginAttempt, error) {
+	var attempt model.LoginAttempt
+	if err := r.db.Where("admin_id = ?", adminID).First(&attempt).Error; err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+// lockExpired is true in RecordFailure's update when the stored lock has run out.
+const lockExpired = "login_attempts.locked_until <= excluded.last_failed_at"
+
+// RecordFailure counts a failed login at now in a single statement and returns the row as
+// it is afterwards, so concurrent failures never overwrite each other's count. The first
+// failure creates the row; a failure after an expired lock starts a new count.
+func (r *loginAttemptRepository) RecordFailure(adminID uuid.UUID, now time.Time) (*model.LoginAttempt, error) {
+	attempt := &model.LoginAttempt{AdminID: adminID, FailedCount: 1, LastFailedAt: now}
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "admin_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"failed_count":   gorm.Expr("CASE WHEN " + lockExpired + " THEN 1 ELSE login_attempts.failed_count + 1 END"),
+			"locked_until":   gorm.Expr("CASE WHEN " + lockExpired + " THEN NULL ELSE login_attempts.locked_until END"),
+			"last_failed_at": gorm.Expr("excluded.last_failed_at"),
+			"updated_at":     gorm.Expr("excluded.updated_at"),
+		}),
+	}, clause.Returning{}).Create(attempt).Error
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// Lock locks the account until the given time.
+func (r *loginAttemptRepository) Lock(adminID uuid.UUID, until time.Time) error {
+	return r.db.Model(&model.LoginAttempt{}).Where("admin_id = ?", adminID).Update("locked_until", until).Error
+}
+
+// DeleteByAdminID hard-deletes the attempt row, resetting the counter and any lock.
+func (r *loginAttemptRepository) DeleteByAdminID(adminID uuid.UUID) error {
+	return r.db.Unscoped().Where("admin_id = ?", adminID).Delete(&model.LoginAttempt{}).Error
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// MatchAttachmentRepository defines the contract for match attachment data access.
+type MatchAttachmentRepository interface {
+	Create(attachment *model.MatchAttachment) error
+	FindByID(id uuid.UUID) (*model.MatchAttachment, error)
+	FindByMatchID(matchID uuid.UUID) ([]model.MatchAttachment, error)
+	Delete(id uuid.UUID) error
+}
+
+// matchAttachmentRepository implements MatchAttachmentRepository using GORM.
+type matchAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewMatchAttachmentRepository creates a new MatchAttachmentRepository instance.
+func NewMatchAttachmentRepository(db *gorm.DB) MatchAttachmentRepository {
+	return &matchAttachmentRepository{db: db}
+}
+
+func (r *matchAttachmentRepository) Create(attachment *model.MatchAttachment) error {
+	return r.db.Create(attachment).Error
+}
+
+func (r *matchAttachmentRepository) FindByID(id uuid.UUID) (*model.MatchAttachment, error) {
+	var attachment model.MatchAttachment
+	if err := r.db.Where("id = ?", id).First(&attachment).Error; err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// FindByMatchID returns the attachments of a match, oldest first.
+func (r *matchAttachmentRepository) FindByMatchID(matchID uuid.UUID) ([]model.MatchAttachment, error) {
+	var attachments []model.MatchAttachment
+	if err := r.db.Where("match_id = ?", matchID).Order("created_at asc, id asc").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+func (r *matchAttachmentRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.MatchAttachment{}).Error
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// MatchEventRepository defines the contract for match event data access.
+type MatchEventRepository interface {
+	CreateBatch(events []model.MatchEvent) error
+	FindByMatchID(matchID uuid.UUID) ([]model.MatchEvent, error)
+	FindByMatchIDs(matchIDs []uuid.UUID) ([]model.MatchEvent, error)
+	FindGoalsByPlayerIDs(playerIDs []uuid.UUID) ([]model.MatchEvent, error)
+	DeleteByMatchID(matchID uuid.UUID) error
+}
+
+// matchEventRepository implements MatchEventRepository using GORM.
+type matchEventRepository struct {
+	db *gorm.DB
+}
+
+// NewMatchEventRepository creates a new MatchEventRepository instance.
+func NewMatchEventRepository(db *gorm.DB) MatchEventRepository {
+	return &matchEventRepository{db: db}
+}
+
+// CreateBatch inserts multiple event records in a single operation.
+func (r *matchEventRepository) CreateBatch(events []model.MatchEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.Create(&events).Error
+}
+
+func (r *matchEventRepository) FindByMatchID(matchID uuid.UUID) ([]model.MatchEvent, error) {
+	var events []model.MatchEvent
+	err := r.db.
+		Preload("Player", withDeleted).
+		Preload("RelatedPlayer", withDeleted).
+		Preload("Team", withDeleted).
+		Where("match_id = ?", matchID).
+		Order("minute asc, added_time asc").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindByMatchIDs returns the events of the given matches in chronological order, without associations.
+func (r *matchEventRepository) FindByMatchIDs(matchIDs []uuid.UUID) ([]model.MatchEvent, error) {
+	var events []model.MatchEvent
+	if len(matchIDs) == 0 {
+		return events, nil
+	}
+	if err := r.db.Where("match_id IN ?", matchIDs).Order("minute asc, added_time asc, created_at asc").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindGoalsByPlayerIDs returns the goals and converted penalties scored by the given players,
+// oldest first and without associations. Own goals are not counted as the player's goals.
+func (r *matchEventRepository) FindGoalsByPlayerIDs(playerIDs []uuid.UUID) ([]model.MatchEvent, error) {
+	var events []model.MatchEvent
+	if len(playerIDs) == 0 {
+		return events, nil
+	}
+	err := r.db.
+		Where("player_id IN ?", playerIDs).
+		Where("type IN ?", []string{model.EventGoal, model.EventPenalty}).
+		Order("created_at asc, minute asc, added_time asc").
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// DeleteByMatchID performs a soft delete of all events for a match.
+// Used when updating match results (delete old events, insert new ones).
+func (r *matchEventRepository) DeleteByMatchID(matchID uuid.UUID) error {
+	return r.db.Where("match_id = ?", matchID).Delete(&model.MatchEvent{}).Error
+}
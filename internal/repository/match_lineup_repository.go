@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// MatchLineupRepository defines the contract for match lineup data access.
+type MatchLineupRepository interface {
+	FindByMatchID(matchID uuid.UUID) ([]model.MatchLineup, error)
+	CreateBatch(entries []model.MatchLineup) error
+	DeleteByMatchIDAndTeamID(matchID, teamID uuid.UUID) error
+}
+
+// matchLineupRepository implements MatchLineupRepository using GORM.
+type matchLineupRepository struct {
+	db *gorm.DB
+}
+
+// NewMatchLineupRepository creates a new MatchLineupRepository instance.
+func NewMatchLineupRepository(db *gorm.DB) MatchLineupRepository {
+	return &matchLineupRepository{db: db}
+}
+
+// FindByMatchID returns both teams' lineup entries with players preloaded.
+func (r *matchLineupRepository) FindByMatchID(matchID uuid.UUID) ([]model.MatchLineup, error) {
+	var entries []model.MatchLineup
+	err := r.db.
+		Preload("Player", withDeleted).
+		Where("match_id = ?", matchID).
+		Order("starter desc, created_at asc").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// CreateBatch inserts multiple lineup entries in a single operation.
+func (r *matchLineupRepository) CreateBatch(entries []model.MatchLineup) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return r.db.Create(&entries).Error
+}
+
+// DeleteByMatchIDAndTeamID permanently removes a team's lineup for a match.
+// Used when a lineup is replaced (delete old entries, insert new ones).
+func (r *matchLineupRepository) DeleteByMatchIDAndTeamID(matchID, teamID uuid.UUID) error {
+	return r.db.Unscoped().Where("match_id = ? AND team_id = ?", matchID, teamID).Delete(&model.MatchLineup{}).Error
+}
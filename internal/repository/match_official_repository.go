@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// MatchOfficialRepository defines the contract for match officiating assignment data access.
+type MatchOfficialRepository interface {
+	FindByMatchID(matchID uuid.UUID) ([]model.MatchOfficial, error)
+	ReplaceByMatchID(matchID uuid.UUID, officials []model.MatchOfficial) error
+	FindByRefereeID(refereeID uuid.UUID, offset, limit int) ([]model.MatchOfficial, error)
+	CountByRefereeID(refereeID uuid.UUID) (int64, error)
+}
+
+// matchOfficialRepository implements MatchOfficialRepository using GORM.
+type matchOfficialRepository struct {
+	db *gorm.DB
+}
+
+// NewMatchOfficialRepository creates a new MatchOfficialRepository instance.
+func NewMatchOfficialRepository(db *gorm.DB) MatchOfficialRepository {
+	return &matchOfficialRepository{db: db}
+}
+
+// FindByMatchID returns the officials of a match with referees preloaded, referee first.
+func (r *matchOfficialRepository) FindByMatchID(matchID uuid.UUID) ([]model.MatchOfficial, error) {
+	var officials []model.MatchOfficial
+	err := r.db.
+		Preload("Referee", withDeleted).
+		Where("match_id = ?", matchID).
+		Order("role desc, created_at asc").
+		Find(&officials).Error
+	if err != nil {
+		return nil, err
+	}
+	return officials, nil
+}
+
+// ReplaceByMatchID permanently removes the match's officials and inserts the given ones
+// in a single transaction.
+func (r *matchOfficialRepository) ReplaceByMatchID(matchID uuid.UUID, officials []model.MatchOfficial) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("match_id = ?", matchID).Delete(&model.MatchOfficial{}).Error; err != nil {
+			return err
+		}
+		if len(officials) == 0 {
+			return nil
+		}
+		return tx.Create(&officials).Error
+	})
+}
+
+// refereeAssignments selects the assignments of a referee to matches that are not deleted.
+func (r *matchOfficialRepository) refereeAssignments(refereeID uuid.UUID) *gorm.DB {
+	return r.db.Model(&model.MatchOfficial{}).
+		Joins("JOIN matches ON matches.id = match_officials.match_id AND matches.deleted_at IS NULL").
+		Where("match_officials.referee_id = ?", refereeID)
+}
+
+// FindByRefereeID returns the referee's assignments with their matches (and the matches' teams
+// and venue) preloaded, latest kick-off first.
+func (r *matchOfficialRepository) FindByRefereeID(refereeID uuid.UUID, offset, limit int) ([]model.MatchOfficial, error) {
+	var officials []model.MatchOfficial
+	err := r.refereeAssignments(refereeID).
+		Preload("Match").
+		Preload("Match.HomeTeam", withDeleted).
+		Preload("Match.AwayTeam", withDeleted).
+		Preload("Match.Venue", withDeleted).
+		Order("matches.match_datetime desc, matches.id desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&officials).Error
+	if err != nil {
+		return nil, err
+	}
+	return officials, nil
+}
+
+// CountByRefereeID counts the referee's assignments to matches that are not deleted.
+func (r *matchOfficialRepository) CountByRefereeID(refereeID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.refereeAssignments(refereeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
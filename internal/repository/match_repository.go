@@ -1,23 +1,133 @@
 package repository
 
 import (
+	"fmt"
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"gorm.io/gorm"
 )
 
+// MatchFilter narrows match queries. Zero-value fields are ignored, except Archived:
+// archived matches are only returned when it is set, and then only those. Its conditions name
+// the matches table, so they hold in queries joining other tables.
+type MatchFilter struct {
+	IDs        []uuid.UUID
+	SeasonID   *uuid.UUID
+	Round      *int
+	TeamID     *uuid.UUID // Matches where the team plays at home or away
+	OpponentID *uuid.UUID // With TeamID, only meetings of the two teams
+	Status     string
+	From       *time.Time // Kick-off, inclusive
+	To         *time.Time // Kick-off, exclusive
+	Archived   bool
+	Projection Projection // Columns FindAll loads; other queries ignore it
+}
+
+// apply adds the filter conditions to a query.
+func (f MatchFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.Archived {
+		query = query.Where("matches.archived_at IS NOT NULL")
+	} else {
+		query = query.Where("matches.archived_at IS NULL")
+	}
+	if len(f.IDs) > 0 {
+		query = query.Where("matches.id IN ?", f.IDs)
+	}
+	if f.SeasonID != nil {
+		query = query.Where("matches.season_id = ?", *f.SeasonID)
+	}
+	if f.Round != nil {
+		query = query.Where("matches.round = ?", *f.Round)
+	}
+	if f.TeamID != nil {
+		query = query.Where("(matches.home_team_id = ? OR matches.away_team_id = ?)", *f.TeamID, *f.TeamID)
+	}
+	if f.OpponentID != nil {
+		query = query.Where("(matches.home_team_id = ? OR matches.away_team_id = ?)", *f.OpponentID, *f.OpponentID)
+	}
+	if f.Status != "" {
+		query = query.Where("matches.status = ?", f.Status)
+	}
+	if f.From != nil {
+		query = query.Where("matches.match_datetime >= ?", *f.From)
+	}
+	if f.To != nil {
+		query = query.Where("matches.match_datetime < ?", *f.To)
+	}
+	return query
+}
+
+// withDeleted makes a preload include soft-deleted rows, so matches, events and lineups
+// keep showing the teams and players they were played with after those are deleted.
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// MatchWithWins is a match with the total wins of both its teams, as counted by CountWins.
+type MatchWithWins struct {
+	model.Match
+	HomeTeamWins int
+	AwayTeamWins int
+}
+
+// TeamRoundGoals is the goals a team scored and conceded in the completed matches of a round.
+type TeamRoundGoals struct {
+	TeamID   uuid.UUID
+	TeamName string
+	Round    int
+	Scored   int
+	Conceded int
+}
+
+// ManOfTheMatchAwards is the number of completed matches a player was named man of the match in.
+type ManOfTheMatchAwards struct {
+	PlayerID   uuid.UUID
+	PlayerName string
+	TeamID     uuid.UUID // The player's current team
+	TeamName   string
+	Awards     int
+}
+
+// AttendanceStats is the attendance recorded at the completed matches of a team or stadium.
+type AttendanceStats struct {
+	ID       uuid.UUID
+	Name     string
+	Capacity int // Stadiums only
+	Matches  int // Matches with a recorded attendance
+	Total    int64
+	Highest  int
+}
+
 // MatchRepository defines the contract for match data access.
 type MatchRepository interface {
-	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Match, error)
+	FindAll(filter MatchFilter, offset, limit int, sortBy, sortOrder string) ([]model.Match, error)
 	FindByID(id uuid.UUID) (*model.Match, error)
 	FindByIDWithDetails(id uuid.UUID) (*model.Match, error)
+	FindByIDs(ids []uuid.UUID) ([]model.Match, error)
+	FindByExternalRef(provider, externalID string) (*model.Match, error)
+	FindByTeamIDs(teamIDs []uuid.UUID, status string) ([]model.Match, error)
 	Create(match *model.Match) error
 	Update(match *model.Match) error
+	Upsert(match *model.Match, provider, externalID string) error
 	Delete(id uuid.UUID) error
-	Count() (int64, error)
-	FindCompletedMatches(offset, limit int) ([]model.Match, error)
-	CountCompletedMatches() (int64, error)
+	Count(filter MatchFilter) (int64, error)
+	FindCompletedMatches(filter MatchFilter, offset, limit int) ([]model.Match, error)
+	FindCompletedWithWins(filter MatchFilter, offset, limit int) ([]MatchWithWins, error)
+	GoalsByTeamAndRound(filter MatchFilter) ([]TeamRoundGoals, error)
+	AttendanceByHomeTeam(filter MatchFilter) ([]AttendanceStats, error)
+	AttendanceByVenue(filter MatchFilter) ([]AttendanceStats, error)
+	CountManOfTheMatchAwards(filter MatchFilter) ([]ManOfTheMatchAwards, error)
+	CountCompletedMatches(filter MatchFilter) (int64, error)
+	FindAllCompleted(filter MatchFilter) ([]model.Match, error)
+	FindSchedule(filter MatchFilter) ([]model.Match, error)
 	CountWins(teamID uuid.UUID) (int, error)
+	CountWinsByTeamIDs(teamIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	CountUpcomingByTeamID(teamID uuid.UUID) (int64, error)
+	FindByTeamBetween(teamID uuid.UUID, from, to time.Time) ([]model.Match, error)
+	ArchiveSeasonsEndedBefore(date string) (int64, error)
 }
 
 // matchRepository implements MatchRepository using GORM.
@@ -30,46 +140,76 @@ func NewMatchRepository(db *gorm.DB) MatchRepository {
 	return &matchRepository{db: db}
 }
 
-func (r *matchRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Match, error) {
+func (r *matchRepository) FindAll(filter MatchFilter, offset, limit int, sortBy, sortOrder string) ([]model.Match, error) {
 	var matches []model.Match
-	query := r.db.Preload("HomeTeam").Preload("AwayTeam").Offset(offset).Limit(limit)
-
-	allowedSorts := map[string]bool{
-		"created_at": true,
-		"match_date": true,
-		"status":     true,
-	}
-	if allowedSorts[sortBy] {
-		query = query.Order(sortBy + " " + sortOrder)
-	} else {
-		query = query.Order("created_at desc")
+	query := r.db
+	for _, association := range []string{"HomeTeam", "AwayTeam", "Venue"} {
+		if filter.Projection.loads(association) {
+			query = query.Preload(association, withDeleted)
+		}
 	}
+	query = filter.Projection.apply(filter.apply(query), "matches").Offset(offset).Limit(limit)
 
-	if err := query.Find(&matches).Error; err != nil {
+	if err := matchOrder(query, sortBy, sortOrder).Find(&matches).Error; err != nil {
 		return nil, err
 	}
 	return matches, nil
 }
 
+// matchOrder orders a match listing by sortBy, one of model.MatchSorts, or else by creation,
+// newest first. Sorting by a team name joins that team, deleted or not.
+func matchOrder(query *gorm.DB, sortBy, sortOrder string) *gorm.DB {
+	switch sortBy {
+	case "score_difference":
+		return query.Order("ABS(matches.home_score - matches.away_score) " + sortOrder)
+	case "home_team_name":
+		return query.Joins("LEFT JOIN teams AS home_teams ON home_teams.id = matches.home_team_id").
+			Order("home_teams.name " + sortOrder)
+	case "away_team_name":
+		return query.Joins("LEFT JOIN teams AS away_teams ON away_teams.id = matches.away_team_id").
+			Order("away_teams.name " + sortOrder)
+	}
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.MatchSorts, sortBy) {
+		return query.Order("matches." + sortBy + " " + sortOrder)
+	}
+	return query.Order("matches.created_at desc")
+}
+
 func (r *matchRepository) FindByID(id uuid.UUID) (*model.Match, error) {
 	var match model.Match
-	if err := r.db.Preload("HomeTeam").Preload("AwayTeam").Where("id = ?", id).First(&match).Error; err != nil {
+	if err := r.db.Preload("HomeTeam", withDeleted).Preload("AwayTeam", withDeleted).Preload("Venue", withDeleted).Where("id = ?", id).First(&match).Error; err != nil {
 		return nil, err
 	}
 	return &match, nil
 }
 
-// FindByIDWithDetails loads a match with all associations: HomeTeam, AwayTeam, Goals, Goals.Player, Goals.Team.
+// FindByExternalRef returns the non-deleted match that provider's externalID maps to.
+func (r *matchRepository) FindByExternalRef(provider, externalID string) (*model.Match, error) {
+	var match model.Match
+	if err := r.db.Where("id IN (?)", externalRefID(r.db, provider, model.ExternalRefMatch, externalID)).First(&match).Error; err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+// FindByIDWithDetails loads a match with all associations: HomeTeam, AwayTeam, Venue, Season.Competition,
+// ManOfTheMatch.Team and Events (with Events.Player, Events.RelatedPlayer, Events.Team) in chronological order.
 func (r *matchRepository) FindByIDWithDetails(id uuid.UUID) (*model.Match, error) {
 	var match model.Match
 	err := r.db.
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Preload("Goals", func(db *gorm.DB) *gorm.DB {
-			return db.Order("minute asc")
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		Preload("Season.Competition", withDeleted).
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("minute asc, added_time asc, created_at asc")
 		}).
-		Preload("Goals.Player").
-		Preload("Goals.Team").
+		Preload("Events.Player", withDeleted).
+		Preload("Events.RelatedPlayer", withDeleted).
+		Preload("Events.Team", withDeleted).
+		Preload("ManOfTheMatch", withDeleted).
+		Preload("ManOfTheMatch.Team", withDeleted).
 		Where("id = ?", id).
 		First(&match).Error
 	if err != nil {
@@ -78,33 +218,73 @@ func (r *matchRepository) FindByIDWithDetails(id uuid.UUID) (*model.Match, error
 	return &match, nil
 }
 
+// FindByIDs returns the matches with the given IDs in no particular order, without associations.
+func (r *matchRepository) FindByIDs(ids []uuid.UUID) ([]model.Match, error) {
+	var matches []model.Match
+	if len(ids) == 0 {
+		return matches, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&matches).Error; err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindByTeamIDs returns the unarchived matches any of the given teams plays at home or away,
+// optionally only those with status, in kick-off order and without associations.
+func (r *matchRepository) FindByTeamIDs(teamIDs []uuid.UUID, status string) ([]model.Match, error) {
+	var matches []model.Match
+	if len(teamIDs) == 0 {
+		return matches, nil
+	}
+	query := r.db.Where("(home_team_id IN ? OR away_team_id IN ?) AND archived_at IS NULL", teamIDs, teamIDs)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("match_datetime asc").Find(&matches).Error; err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
 func (r *matchRepository) Create(match *model.Match) error {
 	return r.db.Create(match).Error
 }
 
+// Update saves the match if it is unchanged since it was read (see saveVersioned).
 func (r *matchRepository) Update(match *model.Match) error {
-	return r.db.Save(match).Error
+	return saveVersioned(r.db, match, &match.Version)
+}
+
+// Upsert creates the match, or updates it when it has an ID, and maps provider's externalID
+// to it in the same transaction (see upsertExternal).
+func (r *matchRepository) Upsert(match *model.Match, provider, externalID string) error {
+	return upsertExternal(r.db, provider, model.ExternalRefMatch, externalID, &match.ID,
+		func(tx *gorm.DB) error { return (&matchRepository{db: tx}).Create(match) },
+		func(tx *gorm.DB) error { return (&matchRepository{db: tx}).Update(match) })
 }
 
 func (r *matchRepository) Delete(id uuid.UUID) error {
 	return r.db.Where("id = ?", id).Delete(&model.Match{}).Error
 }
 
-func (r *matchRepository) Count() (int64, error) {
+func (r *matchRepository) Count(filter MatchFilter) (int64, error) {
 	var count int64
-	if err := r.db.Model(&model.Match{}).Count(&count).Error; err != nil {
+	if err := filter.apply(r.db.Model(&model.Match{})).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
 }
 
-func (r *matchRepository) FindCompletedMatches(offset, limit int) ([]model.Match, error) {
+func (r *matchRepository) FindCompletedMatches(filter MatchFilter, offset, limit int) ([]model.Match, error) {
 	var matches []model.Match
-	err := r.db.
-		Preload("HomeTeam").
-		Preload("AwayTeam").
-		Where("status = ?", "completed").
-		Order("match_date desc").
+	err := filter.apply(r.db).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		Preload("Season.Competition", withDeleted).
+		Where("status = ?", model.MatchStatusCompleted).
+		Order("match_datetime desc").
 		Offset(offset).
 		Limit(limit).
 		Find(&matches).Error
@@ -114,24 +294,245 @@ func (r *matchRepository) FindCompletedMatches(offset, limit int) ([]model.Match
 	return matches, nil
 }
 
-func (r *matchRepository) CountCompletedMatches() (int64, error) {
+func (r *matchRepository) CountCompletedMatches(filter MatchFilter) (int64, error) {
 	var count int64
-	if err := r.db.Model(&model.Match{}).Where("status = ?", "completed").Count(&count).Error; err != nil {
+	if err := filter.apply(r.db.Model(&model.Match{})).Where("status = ?", model.MatchStatusCompleted).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
 }
 
-// CountWins calculates the total number of wins for a team across ALL completed matches.
-// A win is when the team is home and home_score > away_score, or away and away_score > home_score.
+// FindAllCompleted returns every completed match (unpaginated) with teams and the season's competition
+// (for its shootout rule) preloaded, in kick-off order so each team's matches can be walked in
+// sequence (ties by ID, which follows creation).
+// Used for aggregate computations such as the standings table and team form.
+func (r *matchRepository) FindAllCompleted(filter MatchFilter) ([]model.Match, error) {
+	var matches []model.Match
+	err := filter.apply(r.db).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		Preload("Season.Competition", withDeleted).
+		Where("status = ?", model.MatchStatusCompleted).
+		Order("match_datetime asc, id asc").
+		Find(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindSchedule returns every match of any status (unpaginated) with teams preloaded,
+// in kick-off order. Used for the calendar feed.
+func (r *matchRepository) FindSchedule(filter MatchFilter) ([]model.Match, error) {
+	var matches []model.Match
+	err := filter.apply(r.db).
+		Preload("HomeTeam", withDeleted).
+		Preload("AwayTeam", withDeleted).
+		Preload("Venue", withDeleted).
+		Order("match_datetime asc").
+		Find(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// FindCompletedWithWins returns a page of completed matches like FindCompletedMatches, each with
+// the total wins of both teams. The wins of every team on the page are counted in one query.
+func (r *matchRepository) FindCompletedWithWins(filter MatchFilter, offset, limit int) ([]MatchWithWins, error) {
+	matches, err := r.FindCompletedMatches(filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	teamIDs := make([]uuid.UUID, 0, 2*len(matches))
+	for _, match := range matches {
+		teamIDs = append(teamIDs, match.HomeTeamID, match.AwayTeamID)
+	}
+	wins, err := r.CountWinsByTeamIDs(teamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]MatchWithWins, len(matches))
+	for i, match := range matches {
+		result[i] = MatchWithWins{
+			Match:        match,
+			HomeTeamWins: wins[match.HomeTeamID],
+			AwayTeamWins: wins[match.AwayTeamID],
+		}
+	}
+	return result, nil
+}
+
+// GoalsByTeamAndRound totals the goals every team scored and conceded per round in the
+// completed matches matching filter, ordered by team name and round. Matches without a
+// round are left out; deleted teams keep their goals.
+func (r *matchRepository) GoalsByTeamAndRound(filter MatchFilter) ([]TeamRoundGoals, error) {
+	side := func(team, scored, conceded string) *gorm.DB {
+		return filter.apply(r.db.Model(&model.Match{})).
+			Select(fmt.Sprintf("matches.%s AS team_id, matches.round, matches.%s AS scored, matches.%s AS conceded", team, scored, conceded)).
+			Where("matches.status = ? AND matches.round IS NOT NULL", model.MatchStatusCompleted)
+	}
+
+	var goals []TeamRoundGoals
+	err := r.db.Table("(? UNION ALL ?) AS sides",
+		side("home_team_id", "home_score", "away_score"),
+		side("away_team_id", "away_score", "home_score"),
+	).
+		Select("sides.team_id, teams.name AS team_name, sides.round, SUM(sides.scored) AS scored, SUM(sides.conceded) AS conceded").
+		Joins("JOIN teams ON teams.id = sides.team_id").
+		Group("sides.team_id, teams.name, sides.round").
+		Order("teams.name asc, sides.team_id asc, sides.round asc").
+		Scan(&goals).Error
+	if err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+// AttendanceByHomeTeam totals the recorded attendance of the completed matches matching
+// filter per home team, highest average first. Matches without an attendance are left out.
+func (r *matchRepository) AttendanceByHomeTeam(filter MatchFilter) ([]AttendanceStats, error) {
+	var stats []AttendanceStats
+	err := r.attendance(filter).
+		Select("teams.id, teams.name, COUNT(*) AS matches, SUM(matches.attendance) AS total, MAX(matches.attendance) AS highest").
+		Joins("JOIN teams ON teams.id = matches.home_team_id").
+		Group("teams.id, teams.name").
+		Order("AVG(matches.attendance) desc, teams.name asc").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// AttendanceByVenue totals the recorded attendance of the completed matches matching filter
+// per stadium, highest average first. Matches without an attendance or venue are left out.
+func (r *matchRepository) AttendanceByVenue(filter MatchFilter) ([]AttendanceStats, error) {
+	var stats []AttendanceStats
+	err := r.attendance(filter).
+		Select("stadiums.id, stadiums.name, stadiums.capacity, COUNT(*) AS matches, SUM(matches.attendance) AS total, MAX(matches.attendance) AS highest").
+		Joins("JOIN stadiums ON stadiums.id = matches.venue_id").
+		Group("stadiums.id, stadiums.name, stadiums.capacity").
+		Order("AVG(matches.attendance) desc, stadiums.name asc").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// CountManOfTheMatchAwards counts the man of the match awards of every player in the
+// completed matches matching filter, most awards first. Deleted players keep their awards.
+func (r *matchRepository) CountManOfTheMatchAwards(filter MatchFilter) ([]ManOfTheMatchAwards, error) {
+	var awards []ManOfTheMatchAwards
+	err := filter.apply(r.db.Model(&model.Match{})).
+		Select("players.id AS player_id, players.name AS player_name, teams.id AS team_id, teams.name AS team_name, COUNT(*) AS awards").
+		Joins("JOIN players ON players.id = matches.man_of_the_match_id").
+		Joins("JOIN teams ON teams.id = players.team_id").
+		Where("matches.status = ?", model.MatchStatusCompleted).
+		Group("players.id, players.name, teams.id, teams.name").
+		Order("awards desc, players.name asc").
+		Scan(&awards).Error
+	if err != nil {
+		return nil, err
+	}
+	return awards, nil
+}
+
+// attendance selects the completed matches matching filter with a recorded attendance.
+// Teams and stadiums are joined whether or not they were deleted since.
+func (r *matchRepository) attendance(filter MatchFilter) *gorm.DB {
+	return filter.apply(r.db.Model(&model.Match{})).
+		Where("matches.status = ? AND matches.attendance IS NOT NULL", model.MatchStatusCompleted)
+}
+
+// CountWins calculates the total number of wins for a team across all unarchived completed matches.
+// See CountWinsByTeamIDs for what counts as a win.
 func (r *matchRepository) CountWins(teamID uuid.UUID) (int, error) {
+	wins, err := r.CountWinsByTeamIDs([]uuid.UUID{teamID})
+	if err != nil {
+		return 0, err
+	}
+	return wins[teamID], nil
+}
+
+// CountWinsByTeamIDs counts the wins of each given team across all unarchived completed matches
+// in a single grouped query. Teams without a win are left out of the map.
+// A win is when the team is home and home_score > away_score, or away and away_score > home_score.
+// A drawn match settled by a shootout is won on penalties if its competition counts shootout wins.
+func (r *matchRepository) CountWinsByTeamIDs(teamIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	wins := make(map[uuid.UUID]int, len(teamIDs))
+	if len(teamIDs) == 0 {
+		return wins, nil
+	}
+
+	// A match has at most one winner, so each row names the winning team or is NULL
+	winners := r.db.Model(&model.Match{}).
+		Select(`CASE
+			WHEN matches.home_score > matches.away_score OR (matches.home_score = matches.away_score AND
+				competitions.shootout_rule = ? AND matches.home_shootout_score > matches.away_shootout_score) THEN matches.home_team_id
+			WHEN matches.away_score > matches.home_score OR (matches.home_score = matches.away_score AND
+				competitions.shootout_rule = ? AND matches.away_shootout_score > matches.home_shootout_score) THEN matches.away_team_id
+			END AS team_id`, model.ShootoutRuleWin, model.ShootoutRuleWin).
+		Joins("LEFT JOIN seasons ON seasons.id = matches.season_id").
+		Joins("LEFT JOIN competitions ON competitions.id = seasons.competition_id").
+		Where("matches.status = ? AND matches.archived_at IS NULL", model.MatchStatusCompleted).
+		Where("(matches.home_team_id IN ? OR matches.away_team_id IN ?)", teamIDs, teamIDs)
+
+	var rows []struct {
+		TeamID uuid.UUID
+		Wins   int
+	}
+	err := r.db.Table("(?) AS winners", winners).
+		Select("team_id, COUNT(*) AS wins").
+		Where("team_id IN ?", teamIDs).
+		Group("team_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		wins[row.TeamID] = row.Wins
+	}
+	return wins, nil
+}
+
+// CountUpcomingByTeamID counts the team's matches that are still to be played:
+// scheduled, live, awaiting a result or postponed, home or away.
+func (r *matchRepository) CountUpcomingByTeamID(teamID uuid.UUID) (int64, error) {
 	var count int64
 	err := r.db.Model(&model.Match{}).
-		Where("status = ? AND ((home_team_id = ? AND home_score > away_score) OR (away_team_id = ? AND away_score > home_score))",
-			"completed", teamID, teamID).
+		Where("status IN ? AND (home_team_id = ? OR away_team_id = ?)",
+			[]string{model.MatchStatusScheduled, model.MatchStatusLive, model.MatchStatusAwaitingResult, model.MatchStatusPostponed}, teamID, teamID).
 		Count(&count).Error
 	if err != nil {
 		return 0, err
 	}
-	return int(count), nil
+	return count, nil
+}
+
+// FindByTeamBetween returns every match kicking off in [from, to) in which the team plays, home or away.
+func (r *matchRepository) FindByTeamBetween(teamID uuid.UUID, from, to time.Time) ([]model.Match, error) {
+	var matches []model.Match
+	err := r.db.
+		Where("match_datetime >= ? AND match_datetime < ? AND (home_team_id = ? OR away_team_id = ?)", from, to, teamID, teamID).
+		Order("match_datetime asc").
+		Find(&matches).Error
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// ArchiveSeasonsEndedBefore archives the completed matches of seasons that ended before date
+// (YYYY-MM-DD) and returns how many were archived. Their events stay attached to them.
+func (r *matchRepository) ArchiveSeasonsEndedBefore(date string) (int64, error) {
+	seasons := r.db.Model(&model.Season{}).Select("id").Where("end_date < ?", date)
+	result := r.db.Model(&model.Match{}).
+		Where("archived_at IS NULL AND status = ? AND season_id IN (?)", model.MatchStatusCompleted, seasons).
+		Update("archived_at", time.Now())
+	return result.RowsAffected, result.Error
 }
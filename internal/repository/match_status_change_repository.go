@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// MatchStatusChangeRepository defines the contract for match status history data access.
+type MatchStatusChangeRepository interface {
+	Create(change *model.MatchStatusChange) error
+	FindByMatchID(matchID uuid.UUID) ([]model.MatchStatusChange, error)
+}
+
+// matchStatusChangeRepository implements MatchStatusChangeRepository using GORM.
+type matchStatusChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewMatchStatusChangeRepository creates a new MatchStatusChangeRepository instance.
+func NewMatchStatusChangeRepository(db *gorm.DB) MatchStatusChangeRepository {
+	return &matchStatusChangeRepository{db: db}
+}
+
+func (r *matchStatusChangeRepository) Create(change *model.MatchStatusChange) error {
+	return r.db.Create(change).Error
+}
+
+// FindByMatchID returns the status changes of a match, oldest first.
+func (r *matchStatusChangeRepository) FindByMatchID(matchID uuid.UUID) ([]model.MatchStatusChange, error) {
+	var changes []model.MatchStatusChange
+	if err := r.db.Where("match_id = ?", matchID).Order("created_at asc, id asc").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
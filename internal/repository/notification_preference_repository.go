@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// notificationColumns maps each notification to the preference column subscribing to it.
+var notificationColumns = map[string]string{
+	model.NotificationResultSubmitted: "result_submitted",
+	model.NotificationMatchPostponed:  "match_postponed",
+	model.NotificationStandingsDigest: "standings_digest",
+}
+
+// NotificationPreferenceRepository defines the contract for admins' email notification settings.
+type NotificationPreferenceRepository interface {
+	FindByAdminID(adminID uuid.UUID) (*model.NotificationPreference, error)
+	Save(pref *model.NotificationPreference) error
+	FindSubscribers(notification string) ([]model.NotificationPreference, error)
+}
+
+// notificationPreferenceRepository implements NotificationPreferenceRepository using GORM.
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceRepository creates a new NotificationPreferenceRepository instance.
+func NewNotificationPreferenceRepository(db *gorm.DB) NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+func (r *notificationPreferenceRepository) FindByAdminID(adminID uuid.UUID) (*model.NotificationPreference, error) {
+	var pref model.NotificationPreference
+	if err := r.db.Where("admin_id = ?", adminID).First(&pref).Error; err != nil {
+		return nil, err
+	}
+	return &pref, nil
+}
+
+// Save creates the preference row, or updates it when it already has an ID.
+func (r *notificationPreferenceRepository) Save(pref *model.NotificationPreference) error {
+	return r.db.Save(pref).Error
+}
+
+// FindSubscribers returns the preferences of every active admin subscribed to a notification.
+func (r *notificationPreferenceRepository) FindSubscribers(notification string) ([]model.NotificationPreference, error) {
+	column, ok := notificationColumns[notification]
+	if !ok {
+		return nil, fmt.Errorf("unknown notification %q", notification)
+	}
+
+	var prefs []model.NotificationPreference
+	err := r.db.
+		Joins("JOIN admins ON admins.id = notification_preferences.admin_id AND admins.deleted_at IS NULL").
+		Where("notification_preferences."+column+" = ?", true).
+		Order("notification_preferences.created_at asc").
+		Find(&prefs).Error
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// PasswordHistoryRepository defines the contract for previously used admin passwords.
+type PasswordHistoryRepository interface {
+	FindRecentByAdminID(adminID uuid.UUID, limit int) ([]model.PasswordHistory, error)
+	Create(entry *model.PasswordHistory) error
+	Prune(adminID uuid.UUID, keep int) error
+}
+
+// passwordHistoryRepository implements PasswordHistoryRepository using GORM.
+type passwordHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordHistoryRepository creates a new PasswordHistoryRepository instance.
+func NewPasswordHistoryRepository(db *gorm.DB) PasswordHistoryRepository {
+	return &passwordHistoryRepository{db: db}
+}
+
+// FindRecentByAdminID returns up to limit of the admin's previous passwords, newest first.
+func (r *passwordHistoryRepository) FindRecentByAdminID(adminID uuid.UUID, limit int) ([]model.PasswordHistory, error) {
+	var entries []model.PasswordHistory
+	if limit <= 0 {
+		return entries, nil
+	}
+	if err := r.db.Where("admin_id = ?", adminID).Order("created_at desc, id desc").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *passwordHistoryRepository) Create(entry *model.PasswordHistory) error {
+	return r.db.Create(entry).Error
+}
+
+// Prune hard-deletes all but the admin's keep most recent entries.
+func (r *passwordHistoryRepository) Prune(adminID uuid.UUID, keep int) error {
+	recent := r.db.Model(&model.PasswordHistory{}).Select("id").
+		Where("admin_id = ?", adminID).Order("created_at desc, id desc").Limit(keep)
+	return r.db.Unscoped().Where("admin_id = ? AND id NOT IN (?)", adminID, recent).Delete(&model.PasswordHistory{}).Error
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// PlayerAbsenceRepository defines the contract for player absence data access.
+type PlayerAbsenceRepository interface {
+	FindAllByPlayerID(playerID uuid.UUID, offset, limit int) ([]model.PlayerAbsence, error)
+	FindByID(id uuid.UUID) (*model.PlayerAbsence, error)
+	FindActiveByPlayerIDs(playerIDs []uuid.UUID, date string) ([]model.PlayerAbsence, error)
+	Create(absence *model.PlayerAbsence) error
+	Update(absence *model.PlayerAbsence) error
+	Delete(id uuid.UUID) error
+	CountByPlayerID(playerID uuid.UUID) (int64, error)
+}
+
+// playerAbsenceRepository implements PlayerAbsenceRepository using GORM.
+type playerAbsenceRepository struct {
+	db *gorm.DB
+}
+
+// NewPlayerAbsenceRepository creates a new PlayerAbsenceRepository instance.
+func NewPlayerAbsenceRepository(db *gorm.DB) PlayerAbsenceRepository {
+	return &playerAbsenceRepository{db: db}
+}
+
+// FindAllByPlayerID returns the player's absences, latest first.
+func (r *playerAbsenceRepository) FindAllByPlayerID(playerID uuid.UUID, offset, limit int) ([]model.PlayerAbsence, error) {
+	var absences []model.PlayerAbsence
+	err := r.db.
+		Where("player_id = ?", playerID).
+		Order("start_date desc, created_at desc").
+		Offset(offset).
+		Limit(limit).
+		Find(&absences).Error
+	if err != nil {
+		return nil, err
+	}
+	return absences, nil
+}
+
+func (r *playerAbsenceRepository) FindByID(id uuid.UUID) (*model.PlayerAbsence, error) {
+	var absence model.PlayerAbsence
+	if err := r.db.Where("id = ?", id).First(&absence).Error; err != nil {
+		return nil, err
+	}
+	return &absence, nil
+}
+
+// FindActiveByPlayerIDs returns the absences of any of the given players that cover date (YYYY-MM-DD).
+// Dates are stored as YYYY-MM-DD text, so they compare in calendar order.
+func (r *playerAbsenceRepository) FindActiveByPlayerIDs(playerIDs []uuid.UUID, date string) ([]model.PlayerAbsence, error) {
+	var absences []model.PlayerAbsence
+	if len(playerIDs) == 0 {
+		return absences, nil
+	}
+	err := r.db.
+		Where("player_id IN ?", playerIDs).
+		Where("start_date <= ? AND (end_date = '' OR end_date >= ?)", date, date).
+		Order("start_date asc").
+		Find(&absences).Error
+	if err != nil {
+		return nil, err
+	}
+	return absences, nil
+}
+
+func (r *playerAbsenceRepository) Create(absence *model.PlayerAbsence) error {
+	return r.db.Create(absence).Error
+}
+
+func (r *playerAbsenceRepository) Update(absence *model.PlayerAbsence) error {
+	return r.db.Save(absence).Error
+}
+
+func (r *playerAbsenceRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.PlayerAbsence{}).Error
+}
+
+func (r *playerAbsenceRepository) CountByPlayerID(playerID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.PlayerAbsence{}).Where("player_id = ?", playerID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
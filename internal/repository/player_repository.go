@@ -1,20 +1,102 @@
 package repository
 
 import (
+	"slices"
+
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"gorm.io/gorm"
 )
 
+// PlayerFilter narrows cross-team player queries. Zero-value fields are ignored.
+type PlayerFilter struct {
+	IDs            []uuid.UUID
+	TeamID         *uuid.UUID
+	Name           string // case-insensitive substring match
+	Position       string
+	JerseyNumber   int
+	HeightMin      int
+	HeightMax      int
+	WeightMin      int
+	WeightMax      int
+	AvailableOn    string // YYYY-MM-DD; excludes players with an absence covering the date
+	Nationality    string
+	PreferredFoot  string
+	BornAfter      string     // YYYY-MM-DD, exclusive; excludes players without a birth date
+	BornOnOrBefore string     // YYYY-MM-DD; excludes players without a birth date
+	Projection     Projection // Columns FindAll loads; Count ignores it
+}
+
+// apply adds the filter conditions to a query.
+func (f PlayerFilter) apply(query *gorm.DB) *gorm.DB {
+	if len(f.IDs) > 0 {
+		query = query.Where("id IN ?", f.IDs)
+	}
+	if f.TeamID != nil {
+		query = query.Where("team_id = ?", *f.TeamID)
+	}
+	if f.Name != "" {
+		query = query.Where("name ILIKE ?", containsPattern(f.Name))
+	}
+	if f.Position != "" {
+		query = query.Where("position = ?", f.Position)
+	}
+	if f.JerseyNumber > 0 {
+		query = query.Where("jersey_number = ?", f.JerseyNumber)
+	}
+	if f.HeightMin > 0 {
+		query = query.Where("height >= ?", f.HeightMin)
+	}
+	if f.HeightMax > 0 {
+		query = query.Where("height <= ?", f.HeightMax)
+	}
+	if f.WeightMin > 0 {
+		query = query.Where("weight >= ?", f.WeightMin)
+	}
+	if f.WeightMax > 0 {
+		query = query.Where("weight <= ?", f.WeightMax)
+	}
+	if f.Nationality != "" {
+		query = query.Where("nationality = ?", f.Nationality)
+	}
+	if f.PreferredFoot != "" {
+		query = query.Where("preferred_foot = ?", f.PreferredFoot)
+	}
+	// Birth dates are YYYY-MM-DD text, which compares in date order; unknown ones are empty or NULL
+	if f.BornAfter != "" {
+		query = query.Where("birth_date > ?", f.BornAfter)
+	}
+	if f.BornOnOrBefore != "" {
+		query = query.Where("birth_date <> '' AND birth_date <= ?", f.BornOnOrBefore)
+	}
+	if f.AvailableOn != "" {
+		query = query.Where(`NOT EXISTS (
+			SELECT 1 FROM player_absences a
+			WHERE a.player_id = players.id AND a.deleted_at IS NULL
+				AND a.start_date <= ? AND (a.end_date = '' OR a.end_date >= ?))`, f.AvailableOn, f.AvailableOn)
+	}
+	return query
+}
+
 // PlayerRepository defines the contract for player data access.
 type PlayerRepository interface {
-	FindAllByTeamID(teamID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Player, error)
+	FindAll(filter PlayerFilter, offset, limit int, sortBy, sortOrder string) ([]model.Player, error)
+	Count(filter PlayerFilter) (int64, error)
 	FindByID(id uuid.UUID) (*model.Player, error)
+	FindByIDs(ids []uuid.UUID) ([]model.Player, error)
+	FindBySlug(slug string) (*model.Player, error)
+	FindSlugs(base string) ([]string, error)
+	FindByExternalRef(provider, externalID string) (*model.Player, error)
+	FindByTeamIDs(teamIDs []uuid.UUID) ([]model.Player, error)
 	Create(player *model.Player) error
+	CreateBatch(players []model.Player) error
 	Update(player *model.Player) error
+	Upsert(player *model.Player, provider, externalID string) error
 	Delete(id uuid.UUID) error
+	DeleteByTeamID(teamID uuid.UUID) (int64, error)
 	CountByTeamID(teamID uuid.UUID) (int64, error)
 	FindByTeamIDAndJerseyNumber(teamID uuid.UUID, jerseyNumber int) (*model.Player, error)
+	FindJerseyNumbersByTeamID(teamID uuid.UUID) ([]int, error)
 }
 
 // playerRepository implements PlayerRepository using GORM.
@@ -27,17 +109,17 @@ func NewPlayerRepository(db *gorm.DB) PlayerRepository {
 	return &playerRepository{db: db}
 }
 
-func (r *playerRepository) FindAllByTeamID(teamID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Player, error) {
+// FindAll returns players across all teams matching the filter, with their team preloaded.
+func (r *playerRepository) FindAll(filter PlayerFilter, offset, limit int, sortBy, sortOrder string) ([]model.Player, error) {
 	var players []model.Player
-	query := r.db.Where("team_id = ?", teamID).Offset(offset).Limit(limit)
-
-	allowedSorts := map[string]bool{
-		"created_at":    true,
-		"name":          true,
-		"jersey_number": true,
-		"position":      true,
+	query := r.db
+	if filter.Projection.loads("Team") {
+		query = query.Preload("Team")
 	}
-	if allowedSorts[sortBy] {
+	query = filter.Projection.apply(filter.apply(query), "players").Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.PlayerSorts, sortBy) {
 		query = query.Order(sortBy + " " + sortOrder)
 	} else {
 		query = query.Order("created_at desc")
@@ -49,6 +131,14 @@ func (r *playerRepository) FindAllByTeamID(teamID uuid.UUID, offset, limit int,
 	return players, nil
 }
 
+func (r *playerRepository) Count(filter PlayerFilter) (int64, error) {
+	var count int64
+	if err := filter.apply(r.db.Model(&model.Player{})).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *playerRepository) FindByID(id uuid.UUID) (*model.Player, error) {
 	var player model.Player
 	if err := r.db.Preload("Team").Where("id = ?", id).First(&player).Error; err != nil {
@@ -57,18 +147,94 @@ func (r *playerRepository) FindByID(id uuid.UUID) (*model.Player, error) {
 	return &player, nil
 }
 
+// FindBySlug returns the non-deleted player with the given slug, with their team.
+func (r *playerRepository) FindBySlug(slug string) (*model.Player, error) {
+	var player model.Player
+	if err := r.db.Preload("Team").Where("slug = ?", slug).First(&player).Error; err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// FindSlugs returns the slugs of non-deleted players that are base or start with base and a
+// dash, for picking a free one.
+func (r *playerRepository) FindSlugs(base string) ([]string, error) {
+	var slugs []string
+	if err := r.db.Model(&model.Player{}).Where("slug = ? OR slug LIKE ?", base, base+"-%").Pluck("slug", &slugs).Error; err != nil {
+		return nil, err
+	}
+	return slugs, nil
+}
+
+// FindByExternalRef returns the non-deleted player that provider's externalID maps to.
+func (r *playerRepository) FindByExternalRef(provider, externalID string) (*model.Player, error) {
+	var player model.Player
+	if err := r.db.Where("id IN (?)", externalRefID(r.db, provider, model.ExternalRefPlayer, externalID)).First(&player).Error; err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// FindByIDs returns the players with the given IDs in no particular order, including
+// soft-deleted ones so match events keep resolving their players.
+func (r *playerRepository) FindByIDs(ids []uuid.UUID) ([]model.Player, error) {
+	var players []model.Player
+	if len(ids) == 0 {
+		return players, nil
+	}
+	if err := r.db.Unscoped().Where("id IN ?", ids).Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
+// FindByTeamIDs returns the current players of the given teams, ordered by jersey number.
+func (r *playerRepository) FindByTeamIDs(teamIDs []uuid.UUID) ([]model.Player, error) {
+	var players []model.Player
+	if len(teamIDs) == 0 {
+		return players, nil
+	}
+	if err := r.db.Where("team_id IN ?", teamIDs).Order("jersey_number asc").Find(&players).Error; err != nil {
+		return nil, err
+	}
+	return players, nil
+}
+
 func (r *playerRepository) Create(player *model.Player) error {
 	return r.db.Create(player).Error
 }
 
+// CreateBatch inserts multiple player records in a single operation.
+func (r *playerRepository) CreateBatch(players []model.Player) error {
+	if len(players) == 0 {
+		return nil
+	}
+	return r.db.Create(&players).Error
+}
+
+// Update saves the player if it is unchanged since it was read (see saveVersioned).
 func (r *playerRepository) Update(player *model.Player) error {
-	return r.db.Save(player).Error
+	return saveVersioned(r.db, player, &player.Version)
+}
+
+// Upsert creates the player, or updates them when they have an ID, and maps provider's
+// externalID to the player in the same transaction (see upsertExternal).
+func (r *playerRepository) Upsert(player *model.Player, provider, externalID string) error {
+	return upsertExternal(r.db, provider, model.ExternalRefPlayer, externalID, &player.ID,
+		func(tx *gorm.DB) error { return (&playerRepository{db: tx}).Create(player) },
+		func(tx *gorm.DB) error { return (&playerRepository{db: tx}).Update(player) })
 }
 
 func (r *playerRepository) Delete(id uuid.UUID) error {
 	return r.db.Where("id = ?", id).Delete(&model.Player{}).Error
 }
 
+// DeleteByTeamID soft-deletes every player of a team and returns how many were deleted.
+func (r *playerRepository) DeleteByTeamID(teamID uuid.UUID) (int64, error) {
+	result := r.db.Where("team_id = ?", teamID).Delete(&model.Player{})
+	return result.RowsAffected, result.Error
+}
+
 func (r *playerRepository) CountByTeamID(teamID uuid.UUID) (int64, error) {
 	var count int64
 	if err := r.db.Model(&model.Player{}).Where("team_id = ?", teamID).Count(&count).Error; err != nil {
@@ -87,3 +253,12 @@ func (r *playerRepository) FindByTeamIDAndJerseyNumber(teamID uuid.UUID, jerseyN
 	}
 	return &player, nil
 }
+
+// FindJerseyNumbersByTeamID returns the jersey numbers in use by the team's non-soft-deleted players.
+func (r *playerRepository) FindJerseyNumbersByTeamID(teamID uuid.UUID) ([]int, error) {
+	var numbers []int
+	if err := r.db.Model(&model.Player{}).Where("team_id = ?", teamID).Pluck("jersey_number", &numbers).Error; err != nil {
+		return nil, err
+	}
+	return numbers, nil
+}
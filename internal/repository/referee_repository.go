@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// RefereeRepository defines the contract for referee data access.
+type RefereeRepository interface {
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Referee, error)
+	FindByID(id uuid.UUID) (*model.Referee, error)
+	Create(referee *model.Referee) error
+	Update(referee *model.Referee) error
+	Delete(id uuid.UUID) error
+	Count() (int64, error)
+}
+
+// refereeRepository implements RefereeRepository using GORM.
+type refereeRepository struct {
+	db *gorm.DB
+}
+
+// NewRefereeRepository creates a new RefereeRepository instance.
+func NewRefereeRepository(db *gorm.DB) RefereeRepository {
+	return &refereeRepository{db: db}
+}
+
+func (r *refereeRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Referee, error) {
+	var referees []model.Referee
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.RefereeSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&referees).Error; err != nil {
+		return nil, err
+	}
+	return referees, nil
+}
+
+func (r *refereeRepository) FindByID(id uuid.UUID) (*model.Referee, error) {
+	var referee model.Referee
+	if err := r.db.Where("id = ?", id).First(&referee).Error; err != nil {
+		return nil, err
+	}
+	return &referee, nil
+}
+
+func (r *refereeRepository) Create(referee *model.Referee) error {
+	return r.db.Create(referee).Error
+}
+
+func (r *refereeRepository) Update(referee *model.Referee) error {
+	return r.db.Save(referee).Error
+}
+
+func (r *refereeRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Referee{}).Error
+}
+
+func (r *refereeRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Referee{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
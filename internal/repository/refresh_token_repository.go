@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"gorm.io/gorm"
@@ -12,6 +14,7 @@ type RefreshTokenRepository interface {
 	FindByToken(token string) (*model.RefreshToken, error)
 	DeleteByToken(token string) error
 	DeleteByAdminID(adminID uuid.UUID) error
+	DeleteExpired(now time.Time) (int64, error)
 }
 
 // refreshTokenRepository implements RefreshTokenRepository using GORM.
@@ -48,3 +51,9 @@ func (r *refreshTokenRepository) DeleteByToken(token string) error {
 func (r *refreshTokenRepository) DeleteByAdminID(adminID uuid.UUID) error {
 	return r.db.Unscoped().Where("admin_id = ?", adminID).Delete(&model.RefreshToken{}).Error
 }
+
+// DeleteExpired hard-deletes every refresh token that expired before now and returns how many were removed.
+func (r *refreshTokenRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Unscoped().Where("expires_at < ?", now).Delete(&model.RefreshToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,848 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunWithPostgres(m))
+}
+
+func TestTeamRepository_FindAllAndCount(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewTeamRepository(db)
+
+	persija := fx.Team("Persija Jakarta")
+	fx.Team("Persib Bandung")
+	arema := fx.Team("Arema FC")
+	require.NoError(t, db.Model(arema).Update("city", "Malang").Error)
+
+	tests := []struct {
+		name   string
+		filter repository.TeamFilter
+		want   []string
+	}{
+		{name: "no filter", filter: repository.TeamFilter{}, want: []string{"Arema FC", "Persib Bandung", "Persija Jakarta"}},
+		{name: "search on name", filter: repository.TeamFilter{Search: "persi"}, want: []string{"Persib Bandung", "Persija Jakarta"}},
+		{name: "search on city", filter: repository.TeamFilter{Search: "malang"}, want: []string{"Arema FC"}},
+		{name: "city", filter: repository.TeamFilter{City: "jakarta"}, want: []string{"Persib Bandung", "Persija Jakarta"}},
+		{name: "founded year", filter: repository.TeamFilter{FoundedYearFrom: 1929}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teams, err := repo.FindAll(tt.filter, 0, 10, "name", "asc")
+			require.NoError(t, err)
+			count, err := repo.Count(tt.filter)
+			require.NoError(t, err)
+
+			var names []string
+			for _, team := range teams {
+				names = append(names, team.Name)
+			}
+			assert.Equal(t, tt.want, names)
+			assert.Equal(t, int64(len(tt.want)), count)
+		})
+	}
+
+	t.Run("deleted teams are excluded", func(t *testing.T) {
+		require.NoError(t, repo.Delete(persija.ID))
+		count, err := repo.Count(repository.TeamFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+}
+
+func TestTeamRepository_SortByPlayerCount(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewTeamRepository(db)
+
+	persija, persib := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	fx.Team("Arema FC")
+	fx.Player(persija, 9)
+	fx.Player(persib, 7)
+	fx.Player(persib, 10)
+	// Deleted players are not counted
+	require.NoError(t, repository.NewPlayerRepository(db).Delete(fx.Player(persija, 11).ID))
+
+	// The filter applies next to the join
+	teams, err := repo.FindAll(repository.TeamFilter{Search: "a"}, 0, 10, "player_count", "desc")
+	require.NoError(t, err)
+	var names []string
+	for _, team := range teams {
+		names = append(names, team.Name)
+	}
+	assert.Equal(t, []string{"Persib Bandung", "Persija Jakarta", "Arema FC"}, names)
+}
+
+func TestTeamRepository_UniqueName(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewTeamRepository(db)
+
+	persija := fx.Team("Persija Jakarta")
+
+	found, err := repo.FindByName("PERSIJA JAKARTA")
+	require.NoError(t, err)
+	assert.Equal(t, persija.ID, found.ID)
+
+	err = repo.Create(&model.Team{Name: "persija jakarta"})
+	assert.ErrorIs(t, err, repository.ErrDuplicateTeamName)
+
+	persib := fx.Team("Persib Bandung")
+	persib.Name = "Persija jakarta"
+	assert.ErrorIs(t, repo.Update(persib), repository.ErrDuplicateTeamName)
+
+	t.Run("name of a deleted team can be reused", func(t *testing.T) {
+		require.NoError(t, repo.Delete(persija.ID))
+		assert.NoError(t, repo.Create(&model.Team{Name: "Persija Jakarta"}))
+	})
+
+	t.Run("abbreviations are unique, except when empty", func(t *testing.T) {
+		arema := &model.Team{Name: "Arema FC", Abbreviation: "ARE"}
+		require.NoError(t, repo.Create(arema))
+		assert.NoError(t, repo.Create(&model.Team{Name: "Bali United"}))
+		assert.NoError(t, repo.Create(&model.Team{Name: "Borneo FC"}))
+
+		found, err := repo.FindByAbbreviation("ARE")
+		require.NoError(t, err)
+		assert.Equal(t, arema.ID, found.ID)
+
+		err = repo.Create(&model.Team{Name: "Arema Indonesia", Abbreviation: "ARE"})
+		assert.ErrorIs(t, err, repository.ErrDuplicateTeamAbbreviation)
+	})
+
+	t.Run("slugs", func(t *testing.T) {
+		persis := &model.Team{Name: "Persis Solo", Slug: "persis-solo"}
+		require.NoError(t, repo.Create(persis))
+		require.NoError(t, repo.Create(&model.Team{Name: "Persis Solo FC", Slug: "persis-solo-2"}))
+		require.NoError(t, repo.Create(&model.Team{Name: "Persis Solo Raya", Slug: "persis-solo-raya"}))
+		require.NoError(t, repo.Create(&model.Team{Name: "Persisam", Slug: "persisam"}))
+
+		slugs, err := repo.FindSlugs("persis-solo")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"persis-solo", "persis-solo-2", "persis-solo-raya"}, slugs)
+
+		found, err := repo.FindBySlug("persis-solo")
+		require.NoError(t, err)
+		assert.Equal(t, persis.ID, found.ID)
+
+		// Deleted teams free their slug
+		require.NoError(t, repo.Delete(persis.ID))
+		_, err = repo.FindBySlug("persis-solo")
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.NoError(t, repo.Create(&model.Team{Name: "Persis", Slug: "persis-solo"}))
+	})
+}
+
+func TestMatchRepository_ArchiveSeasonsEndedBefore(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	old := fx.Season("2022/23", "2022-07-01", "2023-05-31")
+	current := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	oldMatch := fx.CompletedMatch(home, away, 2, 1, &old.ID, kickoff)
+	fx.CompletedMatch(home, away, 0, 0, &current.ID, kickoff.AddDate(3, 0, 0))
+	fx.CompletedMatch(home, away, 1, 1, nil, kickoff)
+	// Scheduled matches are never archived, even in an old season
+	scheduled := fx.Match(home, away, kickoff)
+	require.NoError(t, db.Model(scheduled).Update("season_id", old.ID).Error)
+
+	archived, err := repo.ArchiveSeasonsEndedBefore("2024-01-01")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), archived)
+
+	again, err := repo.ArchiveSeasonsEndedBefore("2024-01-01")
+	require.NoError(t, err)
+	assert.Zero(t, again, "already archived matches are skipped")
+
+	live, err := repo.FindAllCompleted(repository.MatchFilter{})
+	require.NoError(t, err)
+	assert.Len(t, live, 2)
+	for _, match := range live {
+		assert.NotEqual(t, oldMatch.ID, match.ID)
+	}
+
+	inArchive, err := repo.FindAll(repository.MatchFilter{Archived: true}, 0, 10, "", "")
+	require.NoError(t, err)
+	require.Len(t, inArchive, 1)
+	assert.Equal(t, oldMatch.ID, inArchive[0].ID)
+	assert.NotNil(t, inArchive[0].ArchivedAt)
+
+	wins, err := repo.CountWins(home.ID)
+	require.NoError(t, err)
+	assert.Zero(t, wins, "archived wins are not counted")
+}
+
+func TestMatchRepository_CountWinsShootout(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	cup := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	league := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	require.NoError(t, db.Model(&model.Competition{}).Where("id = ?", cup.CompetitionID).Update("shootout_rule", model.ShootoutRuleWin).Error)
+	kickoff := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	pens := func(match *model.Match, homePens, awayPens int) {
+		require.NoError(t, db.Model(match).Updates(map[string]any{"home_shootout_score": homePens, "away_shootout_score": awayPens}).Error)
+	}
+	pens(fx.CompletedMatch(home, away, 1, 1, &cup.ID, kickoff), 4, 3)
+	pens(fx.CompletedMatch(away, home, 0, 0, &cup.ID, kickoff.AddDate(0, 0, 7)), 5, 4)
+	pens(fx.CompletedMatch(home, away, 2, 2, &league.ID, kickoff.AddDate(0, 0, 14)), 4, 2)
+	fx.CompletedMatch(home, away, 3, 0, nil, kickoff.AddDate(0, 0, 21))
+
+	homeWins, err := repo.CountWins(home.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, homeWins, "the cup shootout and the outright win; the league shootout stays a draw")
+
+	awayWins, err := repo.CountWins(away.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, awayWins)
+
+	matches, err := repo.FindAllCompleted(repository.MatchFilter{SeasonID: &cup.ID})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, home.ID, matches[0].Winner())
+	assert.Equal(t, away.ID, matches[1].Winner())
+}
+
+func TestMatchRepository_FindCompletedWithWins(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	kickoff := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	fx.CompletedMatch(persija, persib, 2, 0, nil, kickoff)
+	fx.CompletedMatch(persib, persija, 1, 3, nil, kickoff.AddDate(0, 0, 7))
+	fx.CompletedMatch(arema, persib, 1, 1, nil, kickoff.AddDate(0, 0, 14))
+	fx.CompletedMatch(persib, arema, 2, 1, nil, kickoff.AddDate(0, 0, 21))
+	fx.Match(arema, persija, kickoff.AddDate(0, 0, 28))
+
+	wins, err := repo.CountWinsByTeamIDs([]uuid.UUID{persija.ID, persib.ID, arema.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{persija.ID: 2, persib.ID: 1}, wins, "teams without a win are left out")
+
+	matches, err := repo.FindCompletedWithWins(repository.MatchFilter{}, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	// Latest first, with every win of the teams rather than only those on the page
+	assert.Equal(t, persib.ID, matches[0].HomeTeamID)
+	assert.Equal(t, 1, matches[0].HomeTeamWins)
+	assert.Equal(t, 0, matches[0].AwayTeamWins)
+	assert.Equal(t, arema.ID, matches[1].HomeTeamID)
+	assert.Equal(t, 0, matches[1].HomeTeamWins)
+	assert.Equal(t, 1, matches[1].AwayTeamWins)
+	assert.NotNil(t, matches[0].HomeTeam, "teams are preloaded")
+}
+
+func TestMatchRepository_RoundFilter(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	first := fx.CompletedMatch(home, away, 2, 1, &season.ID, kickoff)
+	second := fx.CompletedMatch(away, home, 0, 0, &season.ID, kickoff.AddDate(0, 0, 7))
+	require.NoError(t, db.Model(first).Update("round", 1).Error)
+	require.NoError(t, db.Model(second).Update("round", 2).Error)
+
+	round := 2
+	matches, err := repo.FindAllCompleted(repository.MatchFilter{SeasonID: &season.ID, Round: &round})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, second.ID, matches[0].ID)
+	require.NotNil(t, matches[0].Round)
+	assert.Equal(t, 2, *matches[0].Round)
+}
+
+func TestMatchRepository_OpponentFilter(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	first := fx.CompletedMatch(persija, persib, 2, 1, nil, kickoff)
+	second := fx.CompletedMatch(persib, persija, 0, 0, nil, kickoff.AddDate(0, 0, 7))
+	fx.CompletedMatch(persija, arema, 1, 0, nil, kickoff.AddDate(0, 0, 14))
+	fx.CompletedMatch(persib, arema, 3, 3, nil, kickoff.AddDate(0, 0, 21))
+
+	matches, err := repo.FindAll(repository.MatchFilter{TeamID: &persija.ID, OpponentID: &persib.ID}, 0, 10, "match_datetime", "asc")
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	assert.Equal(t, first.ID, matches[0].ID)
+	assert.Equal(t, second.ID, matches[1].ID)
+}
+
+func TestMatchRepository_GoalsByTeamAndRound(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	first := fx.CompletedMatch(persija, persib, 2, 1, &season.ID, kickoff)
+	second := fx.CompletedMatch(arema, persija, 3, 0, &season.ID, kickoff.AddDate(0, 0, 7))
+	// Matches without a round or in another season are left out
+	fx.CompletedMatch(persib, arema, 5, 0, &season.ID, kickoff.AddDate(0, 0, 14))
+	other := fx.CompletedMatch(persib, arema, 4, 4, nil, kickoff.AddDate(0, 0, 21))
+	require.NoError(t, db.Model(first).Update("round", 1).Error)
+	require.NoError(t, db.Model(second).Update("round", 2).Error)
+	require.NoError(t, db.Model(other).Update("round", 1).Error)
+
+	goals, err := repo.GoalsByTeamAndRound(repository.MatchFilter{SeasonID: &season.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []repository.TeamRoundGoals{
+		{TeamID: arema.ID, TeamName: "Arema FC", Round: 2, Scored: 3, Conceded: 0},
+		{TeamID: persib.ID, TeamName: "Persib Bandung", Round: 1, Scored: 1, Conceded: 2},
+		{TeamID: persija.ID, TeamName: "Persija Jakarta", Round: 1, Scored: 2, Conceded: 1},
+		{TeamID: persija.ID, TeamName: "Persija Jakarta", Round: 2, Scored: 0, Conceded: 3},
+	}, goals)
+}
+
+func TestMatchRepository_Attendance(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	persija, persib := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	stadium := &model.Stadium{Name: "Jakarta International Stadium", City: "Jakarta", Capacity: 82000}
+	require.NoError(t, db.Create(stadium).Error)
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	for i, attendance := range []int{40000, 60000, 30000} {
+		home, away := persija, persib
+		if i == 2 {
+			home, away = persib, persija
+		}
+		match := fx.CompletedMatch(home, away, 1, 0, nil, kickoff.AddDate(0, 0, 7*i))
+		require.NoError(t, db.Model(match).Updates(map[string]any{"attendance": attendance, "venue_id": stadium.ID}).Error)
+	}
+	// Matches without an attendance are left out
+	fx.CompletedMatch(persib, persija, 0, 0, nil, kickoff.AddDate(0, 0, 28))
+
+	teams, err := repo.AttendanceByHomeTeam(repository.MatchFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []repository.AttendanceStats{
+		{ID: persija.ID, Name: "Persija Jakarta", Matches: 2, Total: 100000, Highest: 60000},
+		{ID: persib.ID, Name: "Persib Bandung", Matches: 1, Total: 30000, Highest: 30000},
+	}, teams)
+
+	venues, err := repo.AttendanceByVenue(repository.MatchFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []repository.AttendanceStats{
+		{ID: stadium.ID, Name: "Jakarta International Stadium", Capacity: 82000, Matches: 3, Total: 130000, Highest: 60000},
+	}, venues)
+}
+
+func TestMatchRepository_CountManOfTheMatchAwards(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	striker, keeper := fx.Player(home, 9), fx.Player(away, 1)
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	for i, player := range []*model.Player{striker, keeper, striker} {
+		match := fx.CompletedMatch(home, away, 1, 0, &season.ID, kickoff.AddDate(0, 0, 7*i))
+		require.NoError(t, db.Model(match).Update("man_of_the_match_id", player.ID).Error)
+	}
+	// Awards of other seasons are left out
+	other := fx.CompletedMatch(home, away, 0, 1, nil, kickoff.AddDate(0, 0, 28))
+	require.NoError(t, db.Model(other).Update("man_of_the_match_id", keeper.ID).Error)
+
+	awards, err := repo.CountManOfTheMatchAwards(repository.MatchFilter{SeasonID: &season.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []repository.ManOfTheMatchAwards{
+		{PlayerID: striker.ID, PlayerName: striker.Name, TeamID: home.ID, TeamName: "Persija Jakarta", Awards: 2},
+		{PlayerID: keeper.ID, PlayerName: keeper.Name, TeamID: away.ID, TeamName: "Persib Bandung", Awards: 1},
+	}, awards)
+}
+
+func TestMatchRepository_Sorts(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewMatchRepository(db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	rout := fx.CompletedMatch(persib, arema, 4, 0, nil, kickoff)
+	draw := fx.CompletedMatch(arema, persija, 1, 1, nil, kickoff.AddDate(0, 0, 7))
+	narrow := fx.CompletedMatch(persija, persib, 1, 2, nil, kickoff.AddDate(0, 0, 14))
+
+	tests := []struct {
+		sortBy, sortOrder string
+		want              []uuid.UUID
+	}{
+		{"match_datetime", "desc", []uuid.UUID{narrow.ID, draw.ID, rout.ID}},
+		{"score_difference", "desc", []uuid.UUID{rout.ID, narrow.ID, draw.ID}},
+		{"home_team_name", "asc", []uuid.UUID{draw.ID, rout.ID, narrow.ID}},
+		{"away_team_name", "desc", []uuid.UUID{draw.ID, narrow.ID, rout.ID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			// Filtering on id must not be ambiguous with the id of the joined teams
+			filter := repository.MatchFilter{IDs: []uuid.UUID{rout.ID, draw.ID, narrow.ID}, Status: model.MatchStatusCompleted}
+			matches, err := repo.FindAll(filter, 0, 10, tt.sortBy, tt.sortOrder)
+			require.NoError(t, err)
+
+			var ids []uuid.UUID
+			for _, match := range matches {
+				ids = append(ids, match.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestStatsRepository_PlayerStats(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewStatsRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	striker := fx.Player(home, 9)
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	first := fx.CompletedMatch(home, away, 2, 0, &season.ID, kickoff)
+	second := fx.CompletedMatch(home, away, 1, 0, nil, kickoff.AddDate(0, 0, 7))
+	for _, event := range []model.MatchEvent{
+		{MatchID: first.ID, Type: model.EventGoal, PlayerID: striker.ID, TeamID: home.ID, Minute: 10},
+		{MatchID: first.ID, Type: model.EventPenalty, PlayerID: striker.ID, TeamID: home.ID, Minute: 75},
+		{MatchID: second.ID, Type: model.EventGoal, PlayerID: striker.ID, TeamID: home.ID, Minute: 30},
+	} {
+		require.NoError(t, db.Create(&event).Error)
+	}
+	// A goal in a scheduled match does not count
+	upcoming := fx.Match(home, away, kickoff.AddDate(0, 1, 0))
+	require.NoError(t, db.Create(&model.MatchEvent{MatchID: upcoming.ID, Type: model.EventGoal, PlayerID: striker.ID, TeamID: home.ID, Minute: 5}).Error)
+
+	rows, err := repo.PlayerStats(striker.ID)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	total := rows[0]
+	assert.True(t, total.Total)
+	assert.Equal(t, 2, total.MatchesPlayed)
+	assert.Equal(t, 3, total.Goals)
+	require.NotNil(t, total.FirstGoalMinute)
+	require.NotNil(t, total.LastGoalMinute)
+	assert.Equal(t, 10, *total.FirstGoalMinute)
+	assert.Equal(t, 75, *total.LastGoalMinute)
+
+	inSeason := rows[1]
+	require.NotNil(t, inSeason.SeasonID)
+	assert.Equal(t, season.ID, *inSeason.SeasonID)
+	assert.Equal(t, 1, inSeason.MatchesPlayed)
+	assert.Equal(t, 2, inSeason.Goals)
+
+	withoutSeason := rows[2]
+	assert.Nil(t, withoutSeason.SeasonID)
+	assert.Equal(t, 1, withoutSeason.Goals)
+}
+
+func TestStandingSnapshotRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewStandingSnapshotRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	first := fx.CompletedMatch(home, away, 2, 1, &season.ID, kickoff)
+	second := fx.CompletedMatch(away, home, 1, 1, &season.ID, kickoff.AddDate(0, 0, 7))
+
+	snapshots := func(match *model.Match, position int) []model.StandingSnapshot {
+		return []model.StandingSnapshot{
+			{MatchID: match.ID, TeamID: home.ID, Round: 1, Position: position},
+			{MatchID: match.ID, SeasonID: &season.ID, TeamID: home.ID, Round: 1, Position: position},
+		}
+	}
+	require.NoError(t, repo.ReplaceByMatchID(first.ID, snapshots(first, 1)))
+	require.NoError(t, repo.ReplaceByMatchID(second.ID, snapshots(second, 2)))
+	// A corrected result replaces the tables recorded for the match
+	require.NoError(t, repo.ReplaceByMatchID(second.ID, snapshots(second, 1)))
+
+	allTime, err := repo.FindByTeamID(home.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, allTime, 2)
+	assert.Equal(t, first.ID, allTime[0].MatchID)
+	assert.Equal(t, second.ID, allTime[1].MatchID)
+	assert.Equal(t, 1, allTime[1].Position)
+	assert.Nil(t, allTime[0].SeasonID)
+
+	inSeason, err := repo.FindByTeamID(home.ID, &season.ID)
+	require.NoError(t, err)
+	assert.Len(t, inSeason, 2)
+
+	t.Run("undone result removes its tables", func(t *testing.T) {
+		require.NoError(t, repo.DeleteByMatchID(second.ID))
+		allTime, err := repo.FindByTeamID(home.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, allTime, 1)
+		assert.Equal(t, first.ID, allTime[0].MatchID)
+	})
+
+	t.Run("rebuilt history replaces every table", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceAll(append(snapshots(second, 3), snapshots(first, 2)...)))
+		allTime, err := repo.FindByTeamID(home.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, allTime, 2)
+		assert.Equal(t, second.ID, allTime[0].MatchID)
+		assert.Equal(t, 3, allTime[0].Position)
+		assert.Equal(t, 2, allTime[1].Position)
+
+		require.NoError(t, repo.ReplaceAll(nil))
+		allTime, err = repo.FindByTeamID(home.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, allTime)
+	})
+}
+
+func TestTeamRatingRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewTeamRatingRepository(db)
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	kickoff := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	first := fx.CompletedMatch(home, away, 2, 1, nil, kickoff)
+	second := fx.CompletedMatch(away, home, 1, 1, nil, kickoff.AddDate(0, 0, 7))
+
+	ratings := func(change float64) []model.TeamRating {
+		return []model.TeamRating{
+			{MatchID: first.ID, TeamID: home.ID, OpponentID: away.ID, PlayedAt: first.MatchDatetime, Rating: 1500 + change, Change: change},
+			{MatchID: first.ID, TeamID: away.ID, OpponentID: home.ID, PlayedAt: first.MatchDatetime, Rating: 1500 - change, Change: -change},
+			{MatchID: second.ID, TeamID: away.ID, OpponentID: home.ID, PlayedAt: second.MatchDatetime, Rating: 1500 - change + 1, Change: 1},
+			{MatchID: second.ID, TeamID: home.ID, OpponentID: away.ID, PlayedAt: second.MatchDatetime, Rating: 1500 + change - 1, Change: -1},
+		}
+	}
+	require.NoError(t, repo.ReplaceAll(ratings(5)))
+	// A replay replaces every rating
+	require.NoError(t, repo.ReplaceAll(ratings(10)))
+
+	history, err := repo.FindByTeamID(home.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, first.ID, history[0].MatchID)
+	assert.Equal(t, 1510.0, history[0].Rating)
+	assert.Equal(t, second.ID, history[1].MatchID)
+
+	current, err := repo.FindCurrent()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []repository.CurrentRating{
+		{TeamID: home.ID, Rating: 1509, Change: -1, Played: 2},
+		{TeamID: away.ID, Rating: 1491, Change: 1, Played: 2},
+	}, current)
+}
+
+func TestExternalRefRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewExternalRefRepository(db)
+
+	arsenal, chelsea := fx.Team("Arsenal FC"), fx.Team("Chelsea FC")
+	require.NoError(t, repo.Save(&model.ExternalRef{Provider: "football-data", EntityType: model.ExternalRefTeam, ExternalID: "57", EntityID: arsenal.ID}))
+	require.NoError(t, repo.Save(&model.ExternalRef{Provider: "api-football", EntityType: model.ExternalRefTeam, ExternalID: "57", EntityID: chelsea.ID}))
+	// Saving an external ID again remaps it instead of failing on the unique key
+	require.NoError(t, repo.Save(&model.ExternalRef{Provider: "football-data", EntityType: model.ExternalRefTeam, ExternalID: "57", EntityID: chelsea.ID}))
+
+	refs, err := repo.FindAll("football-data", model.ExternalRefTeam)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uuid.UUID{"57": chelsea.ID}, refs)
+
+	refs, err = repo.FindAll("football-data", model.ExternalRefMatch)
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestUpsertByExternalRef(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	teams := repository.NewTeamRepository(db)
+	players := repository.NewPlayerRepository(db)
+	matches := repository.NewMatchRepository(db)
+
+	arsenal := &model.Team{Name: "Arsenal FC", Slug: "arsenal-fc"}
+	require.NoError(t, teams.Upsert(arsenal, "football-data", "57"))
+	// Importing the team again updates it
+	arsenal.ShortName = "Arsenal"
+	require.NoError(t, teams.Upsert(arsenal, "football-data", "57"))
+	found, err := teams.FindByExternalRef("football-data", "57")
+	require.NoError(t, err)
+	assert.Equal(t, arsenal.ID, found.ID)
+	assert.Equal(t, "Arsenal", found.ShortName)
+	assert.Equal(t, 2, found.Version)
+
+	// A failed create leaves neither the team nor its mapping
+	duplicate := &model.Team{Name: "arsenal fc", Slug: "arsenal-fc-2"}
+	assert.ErrorIs(t, teams.Upsert(duplicate, "football-data", "58"), repository.ErrDuplicateTeamName)
+	assert.Equal(t, uuid.Nil, duplicate.ID)
+	_, err = teams.FindByExternalRef("football-data", "58")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	saka := &model.Player{TeamID: arsenal.ID, Name: "Bukayo Saka", Slug: "bukayo-saka", Position: "penyerang", JerseyNumber: 7}
+	require.NoError(t, players.Upsert(saka, "football-data", "7784"))
+	foundPlayer, err := players.FindByExternalRef("football-data", "7784")
+	require.NoError(t, err)
+	assert.Equal(t, saka.ID, foundPlayer.ID)
+	// Other providers' IDs and other kinds of records do not match
+	_, err = players.FindByExternalRef("api-football", "7784")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = teams.FindByExternalRef("football-data", "7784")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	chelsea := fx.Team("Chelsea FC")
+	match := &model.Match{HomeTeamID: arsenal.ID, AwayTeamID: chelsea.ID, MatchDatetime: time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC), Status: model.MatchStatusScheduled}
+	require.NoError(t, matches.Upsert(match, "football-data", "1001"))
+	match.Status, match.HomeScore, match.AwayScore = model.MatchStatusCompleted, 2, 1
+	require.NoError(t, matches.Upsert(match, "football-data", "1001"))
+	foundMatch, err := matches.FindByExternalRef("football-data", "1001")
+	require.NoError(t, err)
+	assert.Equal(t, match.ID, foundMatch.ID)
+	assert.Equal(t, 2, foundMatch.HomeScore)
+
+	// A deleted record is not found through its mapping, so importing it again recreates it
+	require.NoError(t, matches.Delete(match.ID))
+	_, err = matches.FindByExternalRef("football-data", "1001")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestGroupRepository(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewGroupRepository(db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	members := func(teams ...*model.Team) []model.GroupTeam {
+		var assigned []model.GroupTeam
+		for _, team := range teams {
+			// The team is set as the service sets it; it must not be written back
+			assigned = append(assigned, model.GroupTeam{SeasonID: season.ID, TeamID: team.ID, Team: team})
+		}
+		return assigned
+	}
+
+	group := &model.Group{SeasonID: season.ID, Name: "Group B", Qualifiers: 2, Teams: members(persija, persib)}
+	require.NoError(t, repo.Create(group))
+	require.NoError(t, repo.Create(&model.Group{SeasonID: season.ID, Name: "Group A", Qualifiers: 1, Teams: members(arema)}))
+
+	// A team plays in only one group of a season
+	err := repo.Create(&model.Group{SeasonID: season.ID, Name: "Group C", Qualifiers: 1, Teams: members(arema)})
+	assert.Error(t, err)
+
+	groups, err := repo.FindBySeasonID(season.ID)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "Group A", groups[0].Name)
+	require.Len(t, groups[1].Teams, 2)
+	assert.Equal(t, "Persija Jakarta", groups[1].Teams[0].Team.Name)
+
+	t.Run("update replaces the teams", func(t *testing.T) {
+		group.Name = "Group B (renamed)"
+		group.Teams = members(persib)
+		require.NoError(t, repo.Update(group))
+
+		found, err := repo.FindByID(group.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Group B (renamed)", found.Name)
+		require.Len(t, found.Teams, 1)
+		assert.Equal(t, persib.ID, found.Teams[0].TeamID)
+	})
+
+	t.Run("delete frees the teams", func(t *testing.T) {
+		require.NoError(t, repo.Delete(group.ID))
+		_, err := repo.FindByID(group.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		require.NoError(t, repo.Create(&model.Group{SeasonID: season.ID, Name: "Group D", Qualifiers: 1, Teams: members(persija, persib)}))
+	})
+}
+
+// BenchmarkIndexes runs hot queries on a seeded season with the composite indexes, then again
+// after dropping them, to show what they save:
+//
+//	go test -tags integration -run '^$' -bench Indexes ./internal/repository
+func BenchmarkIndexes(b *testing.B) {
+	db := testutil.NewDB(b)
+	matchRepo := repository.NewMatchRepository(db)
+	eventRepo := repository.NewMatchEventRepository(db)
+	playerRepo := repository.NewPlayerRepository(db)
+
+	const teamCount, squadSize, matchCount, eventsPerMatch = 40, 30, 5000, 10
+	teams := make([]model.Team, teamCount)
+	players := make([]model.Player, 0, teamCount*squadSize)
+	for i := range teams {
+		teams[i] = model.Team{Name: fmt.Sprintf("Team %d", i+1), City: "Jakarta"}
+	}
+	require.NoError(b, db.CreateInBatches(teams, 500).Error)
+	for _, team := range teams {
+		for n := 1; n <= squadSize; n++ {
+			players = append(players, model.Player{TeamID: team.ID, Name: fmt.Sprintf("Player %d", n), Position: "gelandang", JerseyNumber: n})
+		}
+	}
+	require.NoError(b, db.CreateInBatches(players, 500).Error)
+
+	kickoff := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	matches := make([]model.Match, matchCount)
+	for i := range matches {
+		home, away := i%teamCount, (i+1+i/teamCount)%teamCount
+		if home == away {
+			away = (away + 1) % teamCount
+		}
+		matches[i] = model.Match{
+			HomeTeamID:    teams[home].ID,
+			AwayTeamID:    teams[away].ID,
+			MatchDatetime: kickoff.Add(time.Duration(i) * 6 * time.Hour),
+			HomeScore:     i % 3,
+			AwayScore:     i % 2,
+			Status:        model.MatchStatusCompleted,
+		}
+		// The most recent tenth of the fixtures is still to be played
+		if i >= matchCount*9/10 {
+			matches[i].HomeScore, matches[i].AwayScore, matches[i].Status = 0, 0, model.MatchStatusScheduled
+		}
+	}
+	require.NoError(b, db.CreateInBatches(matches, 500).Error)
+
+	events := make([]model.MatchEvent, 0, matchCount*eventsPerMatch)
+	for i, match := range matches {
+		player := players[(i%teamCount)*squadSize+i%squadSize]
+		for e := 0; e < eventsPerMatch; e++ {
+			events = append(events, model.MatchEvent{
+				MatchID:  match.ID,
+				Type:     model.EventYellowCard,
+				PlayerID: player.ID,
+				TeamID:   player.TeamID,
+				Minute:   90 - e*9,
+			})
+		}
+	}
+	require.NoError(b, db.CreateInBatches(events, 1000).Error)
+	require.NoError(b, db.Exec("ANALYZE").Error)
+
+	match, team := matches[matchCount/2], teams[teamCount/2]
+	queries := []struct {
+		name string
+		run  func() error
+	}{
+		{"completed matches", func() error {
+			_, err := matchRepo.FindCompletedMatches(repository.MatchFilter{}, 0, 10)
+			return err
+		}},
+		{"match events", func() error {
+			_, err := eventRepo.FindByMatchID(match.ID)
+			return err
+		}},
+		{"jersey number", func() error {
+			_, err := playerRepo.FindByTeamIDAndJerseyNumber(team.ID, squadSize)
+			return err
+		}},
+	}
+	run := func(indexes string) {
+		for _, q := range queries {
+			b.Run(indexes+"/"+q.name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := q.run(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+
+	run("indexed")
+	for _, index := range []string{"idx_matches_status_datetime", "idx_match_events_match_minute", "idx_players_team_jersey"} {
+		require.NoError(b, db.Exec("DROP INDEX "+index).Error)
+	}
+	// Without the composite indexes the planner falls back to the single-column ones
+	require.NoError(b, db.Exec("CREATE INDEX idx_match_events_match_id ON match_events (match_id)").Error)
+	require.NoError(b, db.Exec("ANALYZE").Error)
+	run("unindexed")
+}
+
+func TestLoginAttemptRepository_RecordFailure(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	repo := repository.NewLoginAttemptRepository(db)
+	admin := fx.Admin("admin", "password123", model.RoleEditor)
+	now := time.Now()
+
+	t.Run("concurrent failures are all counted", func(t *testing.T) {
+		const failures = 20
+		counts := make(chan int, failures)
+		var wg sync.WaitGroup
+		for range failures {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				attempt, err := repo.RecordFailure(admin.ID, now)
+				if assert.NoError(t, err) {
+					counts <- attempt.FailedCount
+				}
+			}()
+		}
+		wg.Wait()
+		close(counts)
+
+		// Every failure sees its own count, so exactly one of them reaches any given limit
+		var got []int
+		for count := range counts {
+			got = append(got, count)
+		}
+		sort.Ints(got)
+		want := make([]int, failures)
+		for i := range want {
+			want[i] = i + 1
+		}
+		assert.Equal(t, want, got)
+
+		attempt, err := repo.FindByAdminID(admin.ID)
+		require.NoError(t, err)
+		assert.Equal(t, failures, attempt.FailedCount)
+	})
+
+	t.Run("locked account keeps counting", func(t *testing.T) {
+		require.NoError(t, repo.Lock(admin.ID, now.Add(time.Hour)))
+		attempt, err := repo.RecordFailure(admin.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, 21, attempt.FailedCount)
+		assert.True(t, attempt.IsLocked(now))
+	})
+
+	t.Run("failure after an expired lock starts a new count", func(t *testing.T) {
+		require.NoError(t, repo.Lock(admin.ID, now.Add(-time.Minute)))
+		attempt, err := repo.RecordFailure(admin.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempt.FailedCount)
+		assert.Nil(t, attempt.LockedUntil)
+	})
+}
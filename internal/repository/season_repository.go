@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// SeasonRepository defines the contract for season data access.
+type SeasonRepository interface {
+	FindAllByCompetitionID(competitionID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Season, error)
+	FindByID(id uuid.UUID) (*model.Season, error)
+	Create(season *model.Season) error
+	Update(season *model.Season) error
+	Delete(id uuid.UUID) error
+	CountByCompetitionID(competitionID uuid.UUID) (int64, error)
+	CountMatches(seasonID uuid.UUID) (int64, error)
+}
+
+// seasonRepository implements SeasonRepository using GORM.
+type seasonRepository struct {
+	db *gorm.DB
+}
+
+// NewSeasonRepository creates a new SeasonRepository instance.
+func NewSeasonRepository(db *gorm.DB) SeasonRepository {
+	return &seasonRepository{db: db}
+}
+
+func (r *seasonRepository) FindAllByCompetitionID(competitionID uuid.UUID, offset, limit int, sortBy, sortOrder string) ([]model.Season, error) {
+	var seasons []model.Season
+	query := r.db.Where("competition_id = ?", competitionID).Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.SeasonSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&seasons).Error; err != nil {
+		return nil, err
+	}
+	return seasons, nil
+}
+
+func (r *seasonRepository) FindByID(id uuid.UUID) (*model.Season, error) {
+	var season model.Season
+	if err := r.db.Preload("Competition").Where("id = ?", id).First(&season).Error; err != nil {
+		return nil, err
+	}
+	return &season, nil
+}
+
+func (r *seasonRepository) Create(season *model.Season) error {
+	return r.db.Create(season).Error
+}
+
+func (r *seasonRepository) Update(season *model.Season) error {
+	return r.db.Omit("Competition").Save(season).Error
+}
+
+func (r *seasonRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Season{}).Error
+}
+
+func (r *seasonRepository) CountByCompetitionID(competitionID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Season{}).Where("competition_id = ?", competitionID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountMatches returns the number of (non-deleted) matches assigned to a season.
+func (r *seasonRepository) CountMatches(seasonID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Match{}).Where("season_id = ?", seasonID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// StadiumRepository defines the contract for stadium data access.
+type StadiumRepository interface {
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Stadium, error)
+	FindByID(id uuid.UUID) (*model.Stadium, error)
+	Create(stadium *model.Stadium) error
+	Update(stadium *model.Stadium) error
+	Delete(id uuid.UUID) error
+	Count() (int64, error)
+	CountReferences(id uuid.UUID) (teams int64, matches int64, err error)
+}
+
+// stadiumRepository implements StadiumRepository using GORM.
+type stadiumRepository struct {
+	db *gorm.DB
+}
+
+// NewStadiumRepository creates a new StadiumRepository instance.
+func NewStadiumRepository(db *gorm.DB) StadiumRepository {
+	return &stadiumRepository{db: db}
+}
+
+func (r *stadiumRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Stadium, error) {
+	var stadiums []model.Stadium
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.StadiumSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&stadiums).Error; err != nil {
+		return nil, err
+	}
+	return stadiums, nil
+}
+
+func (r *stadiumRepository) FindByID(id uuid.UUID) (*model.Stadium, error) {
+	var stadium model.Stadium
+	if err := r.db.Where("id = ?", id).First(&stadium).Error; err != nil {
+		return nil, err
+	}
+	return &stadium, nil
+}
+
+func (r *stadiumRepository) Create(stadium *model.Stadium) error {
+	return r.db.Create(stadium).Error
+}
+
+func (r *stadiumRepository) Update(stadium *model.Stadium) error {
+	return r.db.Save(stadium).Error
+}
+
+func (r *stadiumRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Stadium{}).Error
+}
+
+func (r *stadiumRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Stadium{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountReferences counts the teams with the stadium as their home stadium and the matches played at it.
+func (r *stadiumRepository) CountReferences(id uuid.UUID) (teams int64, matches int64, err error) {
+	if err = r.db.Model(&model.Team{}).Where("stadium_id = ?", id).Count(&teams).Error; err != nil {
+		return 0, 0, err
+	}
+	if err = r.db.Model(&model.Match{}).Where("venue_id = ?", id).Count(&matches).Error; err != nil {
+		return 0, 0, err
+	}
+	return teams, matches, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// StandingSnapshotRepository defines the contract for standings history data access.
+type StandingSnapshotRepository interface {
+	ReplaceByMatchID(matchID uuid.UUID, snapshots []model.StandingSnapshot) error
+	ReplaceAll(snapshots []model.StandingSnapshot) error
+	DeleteByMatchID(matchID uuid.UUID) error
+	FindByTeamID(teamID uuid.UUID, seasonID *uuid.UUID) ([]model.StandingSnapshot, error)
+}
+
+// standingSnapshotRepository implements StandingSnapshotRepository using GORM.
+type standingSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewStandingSnapshotRepository creates a new StandingSnapshotRepository instance.
+func NewStandingSnapshotRepository(db *gorm.DB) StandingSnapshotRepository {
+	return &standingSnapshotRepository{db: db}
+}
+
+// ReplaceByMatchID permanently removes the tables recorded after a match and inserts the given
+// rows in a single transaction, so a corrected result replaces the tables of the original one.
+func (r *standingSnapshotRepository) ReplaceByMatchID(matchID uuid.UUID, snapshots []model.StandingSnapshot) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("match_id = ?", matchID).Delete(&model.StandingSnapshot{}).Error; err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+		return tx.Create(&snapshots).Error
+	})
+}
+
+// ReplaceAll permanently removes every recorded table and inserts the given rows in a single
+// transaction, so readers see either the old history or the rebuilt one.
+func (r *standingSnapshotRepository) ReplaceAll(snapshots []model.StandingSnapshot) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("1 = 1").Delete(&model.StandingSnapshot{}).Error; err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&snapshots, 500).Error
+	})
+}
+
+// DeleteByMatchID permanently removes the tables recorded after a match whose result was undone.
+func (r *standingSnapshotRepository) DeleteByMatchID(matchID uuid.UUID) error {
+	return r.db.Unscoped().Where("match_id = ?", matchID).Delete(&model.StandingSnapshot{}).Error
+}
+
+// FindByTeamID returns a team's rows of the season table, or of the all-time table when
+// seasonID is nil, oldest first.
+func (r *standingSnapshotRepository) FindByTeamID(teamID uuid.UUID, seasonID *uuid.UUID) ([]model.StandingSnapshot, error) {
+	query := r.db.Where("team_id = ?", teamID)
+	if seasonID != nil {
+		query = query.Where("season_id = ?", *seasonID)
+	} else {
+		query = query.Where("season_id IS NULL")
+	}
+
+	var snapshots []model.StandingSnapshot
+	if err := query.Order("created_at asc, id asc").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
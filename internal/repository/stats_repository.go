@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// PlayerStatsRow holds a player's aggregated record over completed matches, either for one
+// season or, when Total is set, for the whole career. SeasonID is nil on the row for matches
+// not assigned to a season.
+type PlayerStatsRow struct {
+	Total           bool
+	SeasonID        *uuid.UUID
+	SeasonName      *string
+	MatchesPlayed   int
+	Goals           int
+	FirstGoalMinute *int // Earliest minute the player scored in; nil without goals
+	LastGoalMinute  *int // Latest minute the player scored in; nil without goals
+}
+
+// StatsRepository defines the contract for aggregated statistics computed in the database.
+type StatsRepository interface {
+	PlayerStats(playerID uuid.UUID) ([]PlayerStatsRow, error)
+}
+
+// statsRepository implements StatsRepository using GORM.
+type statsRepository struct {
+	db *gorm.DB
+}
+
+// NewStatsRepository creates a new StatsRepository instance.
+func NewStatsRepository(db *gorm.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// playerStatsQuery aggregates a player's appearances and goals per season plus a career total
+// (the empty grouping set). A player appears in a match when named in the starting XI or
+// involved in any event of it, which covers coming on as a substitute. Goals are goal and
+// penalty events; own goals are not counted.
+const playerStatsQuery = `
+WITH appearances AS (
+	SELECT match_id FROM match_lineups
+	WHERE player_id = @player AND starter AND deleted_at IS NULL
+	UNION
+	SELECT match_id FROM match_events
+	WHERE (player_id = @player OR related_player_id = @player) AND deleted_at IS NULL
+),
+goals AS (
+	SELECT match_id, COUNT(*) AS goals, MIN(minute) AS first_minute, MAX(minute) AS last_minute
+	FROM match_events
+	WHERE player_id = @player AND type IN (@goal, @penalty) AND deleted_at IS NULL
+	GROUP BY match_id
+)
+SELECT
+	GROUPING(m.season_id) = 1 AS total,
+	m.season_id,
+	s.name AS season_name,
+	COUNT(m.id) AS matches_played,
+	COALESCE(SUM(g.goals), 0) AS goals,
+	MIN(g.first_minute) AS first_goal_minute,
+	MAX(g.last_minute) AS last_goal_minute
+FROM appearances a
+JOIN matches m ON m.id = a.match_id AND m.status = @completed AND m.archived_at IS NULL AND m.deleted_at IS NULL
+LEFT JOIN seasons s ON s.id = m.season_id
+LEFT JOIN goals g ON g.match_id = m.id
+GROUP BY GROUPING SETS ((m.season_id, s.name), ())
+ORDER BY total DESC, MIN(m.match_datetime)`
+
+// PlayerStats returns the player's career total first, then one row per season in order of
+// the season's first match. The total row is returned, with zeros, even without any appearances.
+func (r *statsRepository) PlayerStats(playerID uuid.UUID) ([]PlayerStatsRow, error) {
+	var rows []PlayerStatsRow
+	err := r.db.Raw(playerStatsQuery,
+		sql.Named("player", playerID),
+		sql.Named("goal", model.EventGoal),
+		sql.Named("penalty", model.EventPenalty),
+		sql.Named("completed", model.MatchStatusCompleted),
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
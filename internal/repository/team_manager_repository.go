@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// TeamManagerRepository defines the contract for admin team assignment data access.
+type TeamManagerRepository interface {
+	FindTeamIDsByAdminID(adminID uuid.UUID) ([]uuid.UUID, error)
+	FindByAdminIDs(adminIDs []uuid.UUID) ([]model.TeamManager, error)
+	ReplaceByAdminID(adminID uuid.UUID, teamIDs []uuid.UUID) error
+}
+
+// teamManagerRepository implements TeamManagerRepository using GORM.
+type teamManagerRepository struct {
+	db *gorm.DB
+}
+
+// NewTeamManagerRepository creates a new TeamManagerRepository instance.
+func NewTeamManagerRepository(db *gorm.DB) TeamManagerRepository {
+	return &teamManagerRepository{db: db}
+}
+
+// FindTeamIDsByAdminID returns the IDs of the teams assigned to an admin.
+func (r *teamManagerRepository) FindTeamIDsByAdminID(adminID uuid.UUID) ([]uuid.UUID, error) {
+	var teamIDs []uuid.UUID
+	if err := r.db.Model(&model.TeamManager{}).Where("admin_id = ?", adminID).Pluck("team_id", &teamIDs).Error; err != nil {
+		return nil, err
+	}
+	return teamIDs, nil
+}
+
+// FindByAdminIDs returns the team assignments of the given admins, oldest first.
+func (r *teamManagerRepository) FindByAdminIDs(adminIDs []uuid.UUID) ([]model.TeamManager, error) {
+	var assignments []model.TeamManager
+	if len(adminIDs) == 0 {
+		return assignments, nil
+	}
+	if err := r.db.Where("admin_id IN ?", adminIDs).Order("created_at asc").Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// ReplaceByAdminID permanently removes the admin's team assignments and assigns the given
+// teams in a single transaction.
+func (r *teamManagerRepository) ReplaceByAdminID(adminID uuid.UUID, teamIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("admin_id = ?", adminID).Delete(&model.TeamManager{}).Error; err != nil {
+			return err
+		}
+		if len(teamIDs) == 0 {
+			return nil
+		}
+		assignments := make([]model.TeamManager, len(teamIDs))
+		for i, teamID := range teamIDs {
+			assignments[i] = model.TeamManager{AdminID: adminID, TeamID: teamID}
+		}
+		return tx.Create(&assignments).Error
+	})
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+)
+
+// CurrentRating is a team's latest rating, with the number of rated matches it played.
+type CurrentRating struct {
+	TeamID uuid.UUID
+	Rating float64
+	Change float64 // Of the team's latest match
+	Played int
+}
+
+// TeamRatingRepository defines the contract for team rating data access.
+type TeamRatingRepository interface {
+	ReplaceAll(ratings []model.TeamRating) error
+	FindCurrent() ([]CurrentRating, error)
+	FindByTeamID(teamID uuid.UUID) ([]model.TeamRating, error)
+}
+
+// teamRatingRepository implements TeamRatingRepository using GORM.
+type teamRatingRepository struct {
+	db *gorm.DB
+}
+
+// NewTeamRatingRepository creates a new TeamRatingRepository instance.
+func NewTeamRatingRepository(db *gorm.DB) TeamRatingRepository {
+	return &teamRatingRepository{db: db}
+}
+
+// ReplaceAll permanently removes every rating and inserts the given ones in a single
+// transaction, so readers see either the old ratings or the replayed ones.
+func (r *teamRatingRepository) ReplaceAll(ratings []model.TeamRating) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("1 = 1").Delete(&model.TeamRating{}).Error; err != nil {
+			return err
+		}
+		if len(ratings) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&ratings, 500).Error
+	})
+}
+
+// FindCurrent returns the latest rating of every team that played a rated match.
+func (r *teamRatingRepository) FindCurrent() ([]CurrentRating, error) {
+	var ratings []CurrentRating
+	err := r.db.Model(&model.TeamRating{}).
+		Select("DISTINCT ON (team_id) team_id, rating, change, COUNT(*) OVER (PARTITION BY team_id) AS played").
+		Order("team_id, played_at desc, id desc").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// FindByTeamID returns a team's ratings after each of its matches, oldest first.
+func (r *teamRatingRepository) FindByTeamID(teamID uuid.UUID) ([]model.TeamRating, error) {
+	var ratings []model.TeamRating
+	if err := r.db.Where("team_id = ?", teamID).Order("played_at asc, id asc").Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
@@ -1,19 +1,74 @@
 package repository
 
 import (
+	"errors"
+	"slices"
+
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"gorm.io/gorm"
 )
 
+// ErrDuplicateTeamName is returned by Create and Update when another non-deleted team
+// already has the name, ignoring case.
+var ErrDuplicateTeamName = errors.New("team name already exists")
+
+// ErrDuplicateTeamAbbreviation is returned by Create and Update when another non-deleted team
+// already has the abbreviation.
+var ErrDuplicateTeamAbbreviation = errors.New("team abbreviation already exists")
+
+// TeamFilter narrows team queries. Zero-value fields are ignored.
+type TeamFilter struct {
+	IDs             []uuid.UUID
+	Search          string // case-insensitive match on name or city
+	City            string // case-insensitive match on city
+	FoundedYearFrom int
+	FoundedYearTo   int
+	Projection      Projection // Columns FindAll loads; Count ignores it
+}
+
+// apply adds the filter conditions to a query.
+func (f TeamFilter) apply(query *gorm.DB) *gorm.DB {
+	if len(f.IDs) > 0 {
+		query = query.Where("id IN ?", f.IDs)
+	}
+	if f.Search != "" {
+		pattern := containsPattern(f.Search)
+		query = query.Where("(name ILIKE ? OR city ILIKE ?)", pattern, pattern)
+	}
+	if f.City != "" {
+		query = query.Where("city ILIKE ?", containsPattern(f.City))
+	}
+	if f.FoundedYearFrom > 0 {
+		query = query.Where("founded_year >= ?", f.FoundedYearFrom)
+	}
+	if f.FoundedYearTo > 0 {
+		query = query.Where("founded_year <= ?", f.FoundedYearTo)
+	}
+	return query
+}
+
+// headCoach limits a HeadCoach preload to the coach with the head_coach role.
+func headCoach(db *gorm.DB) *gorm.DB {
+	return db.Where("role = ?", model.CoachRoleHead)
+}
+
 // TeamRepository defines the contract for team data access.
 type TeamRepository interface {
-	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Team, error)
+	FindAll(filter TeamFilter, offset, limit int, sortBy, sortOrder string) ([]model.Team, error)
 	FindByID(id uuid.UUID) (*model.Team, error)
+	FindByIDs(ids []uuid.UUID) ([]model.Team, error)
+	FindByName(name string) (*model.Team, error)
+	FindByAbbreviation(abbreviation string) (*model.Team, error)
+	FindBySlug(slug string) (*model.Team, error)
+	FindSlugs(base string) ([]string, error)
+	FindByExternalRef(provider, externalID string) (*model.Team, error)
 	Create(team *model.Team) error
 	Update(team *model.Team) error
+	Upsert(team *model.Team, provider, externalID string) error
 	Delete(id uuid.UUID) error
-	Count() (int64, error)
+	Count(filter TeamFilter) (int64, error)
 }
 
 // teamRepository implements TeamRepository using GORM.
@@ -26,53 +81,145 @@ func NewTeamRepository(db *gorm.DB) TeamRepository {
 	return &teamRepository{db: db}
 }
 
-func (r *teamRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Team, error) {
+func (r *teamRepository) FindAll(filter TeamFilter, offset, limit int, sortBy, sortOrder string) ([]model.Team, error) {
 	var teams []model.Team
-	query := r.db.Offset(offset).Limit(limit)
+	query := r.db
+	if filter.Projection.loads("HeadCoach") {
+		query = query.Preload("HeadCoach", headCoach)
+	}
+	query = filter.Projection.apply(filter.apply(query), "teams").Offset(offset).Limit(limit)
 
+	if err := teamOrder(query, sortBy, sortOrder).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// teamOrder orders a team listing by sortBy, one of model.TeamSorts, or else by creation,
+// newest first. Sorting by player_count joins the number of registered players of each team.
+func teamOrder(query *gorm.DB, sortBy, sortOrder string) *gorm.DB {
+	if sortBy == "player_count" {
+		squads := query.Session(&gorm.Session{NewDB: true}).Model(&model.Player{}).
+			Select("team_id, COUNT(*) AS player_count").
+			Group("team_id")
+		return query.Joins("LEFT JOIN (?) AS squads ON squads.team_id = teams.id", squads).
+			Order("COALESCE(squads.player_count, 0) " + sortOrder)
+	}
 	// Whitelist allowed sort columns to prevent SQL injection
-	allowedSorts := map[string]bool{
-		"created_at":   true,
-		"name":         true,
-		"founded_year": true,
-		"city":         true,
+	if slices.Contains(model.TeamSorts, sortBy) {
+		return query.Order("teams." + sortBy + " " + sortOrder)
 	}
-	if allowedSorts[sortBy] {
-		query = query.Order(sortBy + " " + sortOrder)
-	} else {
-		query = query.Order("created_at desc")
+	return query.Order("teams.created_at desc")
+}
+
+func (r *teamRepository) FindByID(id uuid.UUID) (*model.Team, error) {
+	var team model.Team
+	if err := r.db.Preload("HeadCoach", headCoach).Where("id = ?", id).First(&team).Error; err != nil {
+		return nil, err
 	}
+	return &team, nil
+}
 
-	if err := query.Find(&teams).Error; err != nil {
+// FindByIDs returns the teams with the given IDs in no particular order, including
+// soft-deleted ones so matches keep resolving the teams they were played by.
+func (r *teamRepository) FindByIDs(ids []uuid.UUID) ([]model.Team, error) {
+	var teams []model.Team
+	if len(ids) == 0 {
+		return teams, nil
+	}
+	if err := r.db.Unscoped().Where("id IN ?", ids).Find(&teams).Error; err != nil {
 		return nil, err
 	}
 	return teams, nil
 }
 
-func (r *teamRepository) FindByID(id uuid.UUID) (*model.Team, error) {
+// FindByName returns the non-deleted team with the given name, ignoring case.
+func (r *teamRepository) FindByName(name string) (*model.Team, error) {
+	var team model.Team
+	if err := r.db.Where("lower(name) = lower(?)", name).First(&team).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// FindByAbbreviation returns the non-deleted team with the given abbreviation.
+func (r *teamRepository) FindByAbbreviation(abbreviation string) (*model.Team, error) {
 	var team model.Team
-	if err := r.db.Where("id = ?", id).First(&team).Error; err != nil {
+	if err := r.db.Where("abbreviation = ?", abbreviation).First(&team).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// FindBySlug returns the non-deleted team with the given slug, with its head coach.
+func (r *teamRepository) FindBySlug(slug string) (*model.Team, error) {
+	var team model.Team
+	if err := r.db.Preload("HeadCoach", headCoach).Where("slug = ?", slug).First(&team).Error; err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// FindSlugs returns the slugs of non-deleted teams that are base or start with base and a dash,
+// for picking a free one.
+func (r *teamRepository) FindSlugs(base string) ([]string, error) {
+	var slugs []string
+	if err := r.db.Model(&model.Team{}).Where("slug = ? OR slug LIKE ?", base, base+"-%").Pluck("slug", &slugs).Error; err != nil {
+		return nil, err
+	}
+	return slugs, nil
+}
+
+// FindByExternalRef returns the non-deleted team that provider's externalID maps to.
+func (r *teamRepository) FindByExternalRef(provider, externalID string) (*model.Team, error) {
+	var team model.Team
+	if err := r.db.Where("id IN (?)", externalRefID(r.db, provider, model.ExternalRefTeam, externalID)).First(&team).Error; err != nil {
 		return nil, err
 	}
 	return &team, nil
 }
 
 func (r *teamRepository) Create(team *model.Team) error {
-	return r.db.Create(team).Error
+	return duplicateTeam(r.db.Create(team).Error)
 }
 
+// Update saves the team if it is unchanged since it was read (see saveVersioned).
 func (r *teamRepository) Update(team *model.Team) error {
-	return r.db.Save(team).Error
+	return duplicateTeam(saveVersioned(r.db, team, &team.Version))
+}
+
+// Upsert creates the team, or updates it when it has an ID, and maps provider's externalID
+// to it in the same transaction (see upsertExternal).
+func (r *teamRepository) Upsert(team *model.Team, provider, externalID string) error {
+	return upsertExternal(r.db, provider, model.ExternalRefTeam, externalID, &team.ID,
+		func(tx *gorm.DB) error { return (&teamRepository{db: tx}).Create(team) },
+		func(tx *gorm.DB) error { return (&teamRepository{db: tx}).Update(team) })
 }
 
 func (r *teamRepository) Delete(id uuid.UUID) error {
 	return r.db.Where("id = ?", id).Delete(&model.Team{}).Error
 }
 
-func (r *teamRepository) Count() (int64, error) {
+func (r *teamRepository) Count(filter TeamFilter) (int64, error) {
 	var count int64
-	if err := r.db.Model(&model.Team{}).Count(&count).Error; err != nil {
+	if err := filter.apply(r.db.Model(&model.Team{})).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
 }
+
+// duplicateTeam turns a violation of the unique indexes on team names and abbreviations into
+// ErrDuplicateTeamName and ErrDuplicateTeamAbbreviation.
+func duplicateTeam(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return err
+	}
+	switch pgErr.ConstraintName {
+	case "idx_teams_name_lower":
+		return ErrDuplicateTeamName
+	case "idx_teams_abbreviation":
+		return ErrDuplicateTeamAbbreviation
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package repository
+
+import "gorm.io/gorm"
+
+// TxRepositories holds repositories bound to a single database transaction.
+type TxRepositories struct {
+	Teams         TeamRepository
+	Matches       MatchRepository
+	Events        MatchEventRepository
+	StatusChanges MatchStatusChangeRepository
+	Lineups       MatchLineupRepository
+	Players       PlayerRepository
+}
+
+// TxManager runs a unit of work atomically.
+// If fn returns an error (or panics) every write made through the given repositories is rolled back.
+type TxManager interface {
+	WithinTransaction(fn func(TxRepositories) error) error
+}
+
+// txManager implements TxManager using GORM transactions.
+type txManager struct {
+	db *gorm.DB
+}
+
+// NewTxManager creates a new TxManager instance.
+func NewTxManager(db *gorm.DB) TxManager {
+	return &txManager{db: db}
+}
+
+func (m *txManager) WithinTransaction(fn func(TxRepositories) error) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		return fn(TxRepositories{
+			Teams:         NewTeamRepository(tx),
+			Matches:       NewMatchRepository(tx),
+			Events:        NewMatchEventRepository(tx),
+			StatusChanges: NewMatchStatusChangeRepository(tx),
+			Lineups:       NewMatchLineupRepository(tx),
+			Players:       NewPlayerRepository(tx),
+		})
+	})
+}
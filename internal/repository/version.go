@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrVersionConflict is returned when a versioned record was changed by another write
+// after it was read.
+var ErrVersionConflict = errors.New("record was modified by another request")
+
+// saveVersioned writes every column of record, but only if its version column still holds
+// *version, and increments *version. Associations are not saved. Another write in between
+// leaves the row untouched and returns ErrVersionConflict.
+func saveVersioned(db *gorm.DB, record any, version *int) error {
+	expected := *version
+	*version = expected + 1
+
+	result := db.Model(record).
+		Select("*").
+		Omit(clause.Associations).
+		Where("version = ?", expected).
+		Updates(record)
+	if result.Error == nil && result.RowsAffected == 0 {
+		result.Error = ErrVersionConflict
+	}
+	if result.Error != nil {
+		*version = expected
+	}
+	return result.Error
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WebhookRepository defines the contract for webhook data access.
+type WebhookRepository interface {
+	Create(webhook *model.Webhook) error
+	FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Webhook, error)
+	Count() (int64, error)
+	FindByID(id uuid.UUID) (*model.Webhook, error)
+	FindActive() ([]model.Webhook, error)
+	Update(webhook *model.Webhook) error
+	Delete(id uuid.UUID) error
+}
+
+// webhookRepository implements WebhookRepository using GORM.
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new WebhookRepository instance.
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) Create(webhook *model.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+func (r *webhookRepository) FindAll(offset, limit int, sortBy, sortOrder string) ([]model.Webhook, error) {
+	var webhooks []model.Webhook
+	query := r.db.Offset(offset).Limit(limit)
+
+	// Whitelist allowed sort columns to prevent SQL injection
+	if slices.Contains(model.WebhookSorts, sortBy) {
+		query = query.Order(sortBy + " " + sortOrder)
+	} else {
+		query = query.Order("created_at desc")
+	}
+
+	if err := query.Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *webhookRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Webhook{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *webhookRepository) FindByID(id uuid.UUID) (*model.Webhook, error) {
+	var webhook model.Webhook
+	if err := r.db.Where("id = ?", id).First(&webhook).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// FindActive returns every webhook that currently receives deliveries.
+func (r *webhookRepository) FindActive() ([]model.Webhook, error) {
+	var webhooks []model.Webhook
+	if err := r.db.Where("active = ?", true).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *webhookRepository) Update(webhook *model.Webhook) error {
+	return r.db.Save(webhook).Error
+}
+
+func (r *webhookRepository) Delete(id uuid.UUID) error {
+	return r.db.Where("id = ?", id).Delete(&model.Webhook{}).Error
+}
+
+// WebhookDeliveryRepository defines the contract for webhook delivery data access.
+type WebhookDeliveryRepository interface {
+	CreateBatch(deliveries []model.WebhookDelivery) error
+	FindByWebhookID(webhookID uuid.UUID, offset, limit int) ([]model.WebhookDelivery, error)
+	CountByWebhookID(webhookID uuid.UUID) (int64, error)
+	ClaimDue(now time.Time, lease time.Duration, limit int) ([]model.WebhookDelivery, error)
+	Update(delivery *model.WebhookDelivery) error
+}
+
+// webhookDeliveryRepository implements WebhookDeliveryRepository using GORM.
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookDeliveryRepository creates a new WebhookDeliveryRepository instance.
+func NewWebhookDeliveryRepository(db *gorm.DB) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+func (r *webhookDeliveryRepository) CreateBatch(deliveries []model.WebhookDelivery) error {
+	return r.db.Create(&deliveries).Error
+}
+
+// FindByWebhookID returns the delivery log of a webhook, newest first.
+func (r *webhookDeliveryRepository) FindByWebhookID(webhookID uuid.UUID, offset, limit int) ([]model.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	if err := r.db.Where("webhook_id = ?", webhookID).
+		Order("created_at desc").
+		Offset(offset).Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookDeliveryRepository) CountByWebhookID(webhookID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.WebhookDelivery{}).Where("webhook_id = ?", webhookID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// ClaimDue returns up to limit pending deliveries whose next attempt is due and pushes their
+// next attempt back by lease, so other instances skip them while they are being sent.
+// If the claiming instance dies, the deliveries become due again once the lease runs out.
+func (r *webhookDeliveryRepository) ClaimDue(now time.Time, lease time.Duration, limit int) ([]model.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", model.DeliveryStatusPending, now).
+			Order("next_attempt_at asc").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+		return tx.Model(&model.WebhookDelivery{}).Where("id IN ?", ids).UpdateColumn("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *webhookDeliveryRepository) Update(delivery *model.WebhookDelivery) error {
+	return r.db.Save(delivery).Error
+}
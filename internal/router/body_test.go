@@ -0,0 +1,80 @@
+package router_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResultImport_CSV checks that a CSV result import passes the body policy and reaches the
+// handler's CSV parser. Rows that fail before the (here unavailable) database is needed are
+// reported per match like any other failure.
+func TestResultImport_CSV(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	token := app.Token(t, model.RoleEditor)
+
+	post := func(contentType string, body []byte) *testutil.Response {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/matches/results/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Authorization", "Bearer "+token)
+		return app.Send(t, req)
+	}
+	errorCode := func(resp *testutil.Response) string {
+		var envelope response.Envelope
+		require.NoError(t, json.Unmarshal(resp.Body, &envelope))
+		return envelope.Code
+	}
+
+	t.Run("parsed", func(t *testing.T) {
+		csv := "match_id,type,player_id,team_id,minute\nnot-a-match,goal,,,12\n,,,,\n"
+		resp := post("text/csv; charset=utf-8", []byte(csv))
+		require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+
+		var result dto.MatchResultImportResponse
+		resp.Decode(t, &result)
+		assert.Equal(t, 1, result.Total)
+		assert.Equal(t, 1, result.Failed)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, dto.MatchResultImportError{MatchID: "not-a-match", Row: 2, Code: errs.CodeBadRequest, Message: "Invalid match_id format"}, result.Errors[0])
+	})
+
+	t.Run("unknown columns", func(t *testing.T) {
+		resp := post("text/csv", []byte("fixture,home,away\n1,2,0\n"))
+		assert.Equal(t, http.StatusBadRequest, resp.Code, string(resp.Body))
+		assert.Equal(t, service.CodeInvalidImportFile, errorCode(resp))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		csv := "match_id\n" + strings.Repeat("0190a1b2-0000-7000-8000-000000000000\n", 30000)
+		resp := post("text/csv", []byte(csv))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code, string(resp.Body))
+	})
+
+	t.Run("other media type", func(t *testing.T) {
+		resp := post("text/plain", []byte("match_id\n"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.Code)
+		var envelope response.Envelope
+		require.NoError(t, json.Unmarshal(resp.Body, &envelope))
+		assert.Equal(t, "Content-Type must be application/json or text/csv", envelope.Message)
+	})
+
+	t.Run("csv elsewhere", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/teams", strings.NewReader("name,city\nPersija,Jakarta\n"))
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := app.Send(t, req)
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.Code)
+	})
+}
@@ -0,0 +1,155 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// Contract tests check the router against the OpenAPI spec generated from the swag annotations.
+// They run without a database; the integration tests validate the responses of successful requests.
+
+// undocumentedRoutes are served on purpose without an OpenAPI operation.
+var undocumentedRoutes = map[string]string{
+	"GET /health":       "liveness probe kept for existing setups",
+	"GET /health/live":  "liveness probe for orchestrators",
+	"GET /health/ready": "readiness probe for load balancers",
+	"GET /swagger/*any": "the documentation itself",
+	"GET /version":      "build info for operators",
+}
+
+// servedOutsideBasePath maps operations to the route serving them outside /api/v1; Swagger 2.0
+// has a single base path for all operations.
+var servedOutsideBasePath = map[string]string{
+	"GET /api/v1/.well-known/jwks.json": "GET /.well-known/jwks.json",
+}
+
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+func TestRoutesAreDocumented(t *testing.T) {
+	spec := testutil.LoadSpec(t)
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+
+	routes := map[string]bool{}
+	var v2Routes []string
+	for _, route := range app.Router.Routes() {
+		path := ginParam.ReplaceAllString(route.Path, "{$1}")
+		// The spec documents v1; v2 serves the same routes, some with other bodies
+		if rest, ok := strings.CutPrefix(path, "/api/v2/"); ok {
+			v2Routes = append(v2Routes, route.Method+" /api/v1/"+rest)
+			continue
+		}
+		routes[route.Method+" "+path] = true
+	}
+	for _, route := range v2Routes {
+		if !routes[route] {
+			t.Errorf("%s has no v1 counterpart", strings.Replace(route, "/api/v1/", "/api/v2/", 1))
+		}
+	}
+
+	for _, op := range spec.Operations() {
+		route := op.String()
+		if served, ok := servedOutsideBasePath[route]; ok {
+			route = served
+		}
+		if !routes[route] {
+			t.Errorf("%s is documented but not routed", op)
+		}
+		delete(routes, route)
+	}
+	for route := range routes {
+		if _, ok := undocumentedRoutes[route]; !ok {
+			t.Errorf("%s is routed but not documented", route)
+		}
+	}
+}
+
+func TestContract_Unauthenticated(t *testing.T) {
+	spec := testutil.LoadSpec(t)
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	app.Spec = spec
+
+	for _, op := range spec.Operations() {
+		if !op.Secure {
+			continue
+		}
+		t.Run(op.String(), func(t *testing.T) {
+			resp := app.Do(t, op.Method, examplePath(op.Path), "", nil)
+			assert.Equal(t, http.StatusUnauthorized, resp.Code, string(resp.Body))
+		})
+	}
+}
+
+// TestContract_Forbidden checks that every operation refusing viewers documents the 403.
+// Role checks run before the handlers, so the other operations fail on the missing
+// database and are not checked here.
+func TestContract_Forbidden(t *testing.T) {
+	spec := testutil.LoadSpec(t)
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	token := app.Token(t, model.RoleViewer)
+
+	for _, op := range spec.Operations() {
+		if !op.Secure {
+			continue
+		}
+		path := examplePath(op.Path)
+		resp := app.Do(t, op.Method, path, token, nil)
+		if resp.Code != http.StatusForbidden {
+			continue
+		}
+		if err := spec.ValidateResponse(op.Method, path, resp); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSwaggerSpec_PointsAtRequestHost(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+
+	req := httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil)
+	req.Host = "10.0.0.5:8080"
+	req.Header.Set("X-Forwarded-Host", "api.example.com")
+	req.Header.Set("X-Forwarded-Proto", "https")
+	resp := app.Send(t, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var doc struct {
+		Host     string   `json:"host"`
+		Schemes  []string `json:"schemes"`
+		BasePath string   `json:"basePath"`
+	}
+	if assert.NoError(t, json.Unmarshal(resp.Body, &doc)) {
+		assert.Equal(t, "api.example.com", doc.Host)
+		assert.Equal(t, []string{"https"}, doc.Schemes)
+		assert.Equal(t, "/api/v1", doc.BasePath)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/swagger/doc.json", nil)
+	req.Host = "localhost:9090"
+	resp = app.Send(t, req)
+	assert.Contains(t, string(resp.Body), `"host": "localhost:9090"`)
+	assert.Contains(t, string(resp.Body), `"schemes": ["http"]`)
+}
+
+// examplePath fills the parameters of a path template with a random ID.
+func examplePath(template string) string {
+	var b strings.Builder
+	for i, part := range strings.Split(template, "/") {
+		if i > 0 {
+			b.WriteString("/")
+		}
+		if strings.HasPrefix(part, "{") {
+			part = uuid.Must(uuid.NewV7()).String()
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocale(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	app.Spec = testutil.LoadSpec(t)
+
+	tests := []struct {
+		name           string
+		method, path   string
+		body           string
+		acceptLanguage string
+		wantLanguage   string
+		wantMessage    string
+		wantFields     []errs.FieldError
+	}{
+		{
+			name:         "English by default",
+			method:       http.MethodGet,
+			path:         "/api/v1/teams",
+			wantLanguage: "en",
+			wantMessage:  "Authorization header is required",
+		},
+		{
+			name:           "Indonesian",
+			method:         http.MethodGet,
+			path:           "/api/v1/teams",
+			acceptLanguage: "id-ID,id;q=0.9,en;q=0.8",
+			wantLanguage:   "id",
+			wantMessage:    "Header Authorization wajib diisi",
+		},
+		{
+			name:           "English preferred over Indonesian",
+			method:         http.MethodGet,
+			path:           "/api/v1/teams",
+			acceptLanguage: "en-GB, id;q=0.5",
+			wantLanguage:   "en",
+			wantMessage:    "Authorization header is required",
+		},
+		{
+			name:           "unsupported language",
+			method:         http.MethodGet,
+			path:           "/api/v1/teams",
+			acceptLanguage: "fr-FR",
+			wantLanguage:   "en",
+			wantMessage:    "Authorization header is required",
+		},
+		{
+			name:           "validation errors",
+			method:         http.MethodPost,
+			path:           "/api/v1/auth/login",
+			body:           `{}`,
+			acceptLanguage: "id",
+			wantLanguage:   "id",
+			wantMessage:    "Validasi gagal",
+			wantFields: []errs.FieldError{
+				{Field: "username", Message: "username wajib diisi"},
+				{Field: "password", Message: "password wajib diisi"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			resp := app.Send(t, req)
+			assert.Equal(t, tt.wantLanguage, resp.Header.Get("Content-Language"))
+			assert.Contains(t, resp.Header.Values("Vary"), "Accept-Language")
+
+			var envelope response.Envelope
+			require.NoError(t, json.Unmarshal(resp.Body, &envelope))
+			assert.Equal(t, tt.wantMessage, envelope.Message)
+			assert.Equal(t, tt.wantFields, envelope.Errors)
+		})
+	}
+}
@@ -1,105 +1,432 @@
 package router
 
 import (
-	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
-
-	_ "github.com/mhakimsaputra17/xyz-football-api/docs"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/handler"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errtrack"
 	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ratelimit"
+)
+
+// Base paths of the API versions.
+const (
+	apiV1 = "/api/v1"
+	apiV2 = "/api/v2"
 )
 
+// v1Deprecation announces the v1 routes that v2 changes.
+var v1Deprecation = middleware.Deprecation{
+	Since:             time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+	Sunset:            time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC),
+	BasePath:          apiV1,
+	SuccessorBasePath: apiV2,
+}
+
+// apiVersion holds what differs between the API versions mounted by Setup.
+type apiVersion struct {
+	basePath string
+	// changed runs before the routes a later version changes
+	changed gin.HandlerFunc
+	// Handlers of the routes that differ between versions
+	listMatches, getMatch gin.HandlerFunc
+}
+
+// apiVersions returns the API versions to serve, oldest first.
+func apiVersions(matchHandler *handler.MatchHandler) []apiVersion {
+	return []apiVersion{
+		{
+			basePath:    apiV1,
+			changed:     middleware.DeprecationMiddleware(v1Deprecation),
+			listMatches: matchHandler.GetAll,
+			getMatch:    matchHandler.GetByID,
+		},
+		{
+			basePath:    apiV2,
+			changed:     func(c *gin.Context) { c.Next() },
+			listMatches: matchHandler.GetAllV2,
+			getMatch:    matchHandler.GetByIDV2,
+		},
+	}
+}
+
 // Setup configures all API routes and returns the GIN engine.
-// Swagger UI is only available in non-production environments.
+// A nil docsHandler leaves out the Swagger UI and spec.
+// A nil rateLimiter disables rate limiting. Recovered panics and 5xx service errors are
+// reported to errorTracker.
 func Setup(
-	appEnv string,
+	docsHandler *handler.DocsHandler,
+	errorTracker errtrack.Tracker,
+	corsPolicy middleware.CORSPolicy,
+	bodyPolicy middleware.BodyPolicy,
+	compressionPolicy middleware.CompressionPolicy,
 	jwtService *jwtpkg.Service,
+	confirmationService service.ConfirmationService,
+	auditService service.AuditService,
+	apiKeyService service.APIKeyService,
+	maintenanceService service.MaintenanceService,
+	rateLimiter ratelimit.Limiter,
+	loginRule, apiRule ratelimit.Rule,
 	authHandler *handler.AuthHandler,
 	teamHandler *handler.TeamHandler,
 	playerHandler *handler.PlayerHandler,
+	coachHandler *handler.CoachHandler,
+	absenceHandler *handler.AbsenceHandler,
 	matchHandler *handler.MatchHandler,
 	reportHandler *handler.ReportHandler,
+	competitionHandler *handler.CompetitionHandler,
+	seasonHandler *handler.SeasonHandler,
+	stadiumHandler *handler.StadiumHandler,
+	refereeHandler *handler.RefereeHandler,
+	confirmationHandler *handler.ConfirmationHandler,
+	healthHandler *handler.HealthHandler,
+	adminHandler *handler.AdminHandler,
+	auditLogHandler *handler.AuditLogHandler,
+	apiKeyHandler *handler.APIKeyHandler,
+	webhookHandler *handler.WebhookHandler,
+	notificationHandler *handler.NotificationHandler,
+	graphqlHandler *handler.GraphQLHandler,
+	maintenanceHandler *handler.MaintenanceHandler,
 ) *gin.Engine {
-	r := gin.Default()
+	r := gin.New()
 
-	// Global middleware
-	r.Use(middleware.CORSMiddleware())
+	// Global middleware — request ID first so every later log line carries it
+	r.Use(middleware.RequestIDMiddleware())
+	r.Use(middleware.RequestLoggerMiddleware())
+	r.Use(middleware.LocaleMiddleware())
+	r.Use(middleware.ErrorTrackingMiddleware(errorTracker))
+	r.Use(middleware.CompressionMiddleware(compressionPolicy))
+	r.Use(middleware.RecoveryMiddleware())
+	r.Use(middleware.CORSMiddleware(corsPolicy))
+	// In maintenance mode only probes, docs, signing in and switching maintenance off are served,
+	// none of which needs the database
+	exempt := []string{"/health", "/version", "/.well-known/", "/swagger/"}
+	for _, basePath := range []string{apiV1, apiV2} {
+		exempt = append(exempt, basePath+"/health/", basePath+"/auth/", basePath+"/maintenance")
+	}
+	r.Use(middleware.MaintenanceMiddleware(maintenanceService, exempt...))
+	// Bodies are JSON everywhere except on file uploads and the CSV result import
+	multipart := []string{"multipart/form-data"}
+	for _, basePath := range []string{apiV1, apiV2} {
+		bodyPolicy.UploadRoutes = append(bodyPolicy.UploadRoutes,
+			middleware.UploadRoute{Path: basePath + "/teams/:id/players/import", MediaTypes: multipart},
+			middleware.UploadRoute{Path: basePath + "/players/:id/photo", MediaTypes: multipart},
+			middleware.UploadRoute{Path: basePath + "/matches/:id/attachments", MediaTypes: multipart},
+			middleware.UploadRoute{Path: basePath + "/matches/results/import", MediaTypes: []string{"application/json", "text/csv"}, MaxBytes: handler.MaxResultImportFileSize},
+		)
+	}
+	r.Use(middleware.RequestBodyMiddleware(bodyPolicy))
 
-	// Health check endpoint — public, no auth required.
-	// Used by Docker HEALTHCHECK and load balancers.
-	r.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{"status": "ok"})
-	})
+	// Health probes — public, no auth required.
+	// Liveness for Docker HEALTHCHECK and orchestrators (/health is kept for existing setups);
+	// readiness for load balancers, failing with 503 while the database is unreachable.
+	r.GET("/health", healthHandler.Live)
+	r.GET("/health/live", healthHandler.Live)
+	r.GET("/health/ready", healthHandler.Ready)
+	// Build info, for telling which build is live behind the load balancer
+	r.GET("/version", healthHandler.Version)
 
-	// Swagger UI endpoint — disabled in production to prevent API spec leakage.
-	if appEnv != "production" {
-		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-	}
+	// Token verification keys for other services — public, as they only hold public keys
+	r.GET("/.well-known/jwks.json", authHandler.JWKS)
 
-	// API v1 group
-	v1 := r.Group("/api/v1")
+	// Swagger UI and spec — off in production unless enabled, and then behind basic auth
+	// to prevent API spec leakage
+	if docsHandler != nil {
+		r.GET("/swagger/*any", docsHandler.Serve)
+	}
 
-	// --- Public routes (no auth required) ---
-	auth := v1.Group("/auth")
-	{
-		auth.POST("/login", authHandler.Login)
-		auth.POST("/refresh", authHandler.Refresh)
+	// Rate limits — login attempts per client IP, everything else per admin (client IP before auth)
+	limit := func(scope string, rule ratelimit.Rule, key middleware.RateLimitKeyFunc) gin.HandlerFunc {
+		if rateLimiter == nil {
+			return func(c *gin.Context) { c.Next() }
+		}
+		return middleware.RateLimitMiddleware(rateLimiter, scope, rule, key)
 	}
 
-	// --- Protected routes (JWT auth required) ---
-	protected := v1.Group("")
-	protected.Use(middleware.AuthMiddleware(jwtService))
-	{
-		// Auth — logout requires authentication
-		protected.POST("/auth/logout", authHandler.Logout)
+	// Read routes open to API keys, by full path, with the scope each requires.
+	// Filled in by read() below before the server starts handling requests.
+	apiKeyScopes := make(map[string]string)
+	read := func(group *gin.RouterGroup, path, scope string, handlers ...gin.HandlerFunc) {
+		group.GET(path, handlers...)
+		apiKeyScopes[group.BasePath()+path] = scope
+	}
 
-		// Teams CRUD
-		teams := protected.Group("/teams")
+	// Each API version serves every route; v2 replaces the handlers of the routes it changes,
+	// sharing the rest with v1, and v1 announces the routes v2 changes as deprecated
+	mount := func(api *gin.RouterGroup, v apiVersion) {
+		// --- Public routes (no auth required) ---
+		auth := api.Group("/auth")
 		{
-			teams.GET("", teamHandler.GetAll)
-			teams.GET("/:id", teamHandler.GetByID)
-			teams.POST("", teamHandler.Create)
-			teams.PUT("/:id", teamHandler.Update)
-			teams.DELETE("/:id", teamHandler.Delete)
-
-			// Players nested under teams (create + list)
-			teams.GET("/:id/players", playerHandler.GetAllByTeamID)
-			teams.POST("/:id/players", playerHandler.Create)
+			auth.POST("/login", limit("login", loginRule, middleware.KeyByClientIP), authHandler.Login)
+			auth.POST("/refresh", limit("api", apiRule, middleware.KeyByClientIP), authHandler.Refresh)
 		}
 
-		// Players (get, update, delete — not nested under teams)
-		players := protected.Group("/players")
-		{
-			players.GET("/:id", playerHandler.GetByID)
-			players.PUT("/:id", playerHandler.Update)
-			players.DELETE("/:id", playerHandler.Delete)
-		}
+		// Calendar feed — public so calendar apps can subscribe without a bearer token
+		api.GET("/matches/calendar.ics", limit("api", apiRule, middleware.KeyByClientIP), middleware.ETagMiddleware(), matchHandler.Calendar)
 
-		// Matches CRUD + Results
-		matches := protected.Group("/matches")
-		{
-			matches.GET("", matchHandler.GetAll)
-			matches.GET("/:id", matchHandler.GetByID)
-			matches.POST("", matchHandler.Create)
-			matches.PUT("/:id", matchHandler.Update)
-			matches.DELETE("/:id", matchHandler.Delete)
-
-			// Match results (submit + update)
-			matches.POST("/:id/result", matchHandler.SubmitResult)
-			matches.PUT("/:id/result", matchHandler.UpdateResult)
-		}
+		// Live match feed (Server-Sent Events) — public because browsers' EventSource cannot send auth headers
+		api.GET("/matches/stream", limit("api", apiRule, middleware.KeyByClientIP), matchHandler.Stream)
 
-		// Reports (read-only)
-		reports := protected.Group("/reports")
+		// --- Protected routes (JWT auth required; API keys accepted on read routes) ---
+		protected := api.Group("")
+		protected.Use(
+			middleware.APIKeyMiddleware(apiKeyService, apiKeyScopes),
+			middleware.AuthMiddleware(jwtService),
+			// A seeded or expired password must be changed before anything else
+			middleware.PasswordChangeMiddleware(
+				api.BasePath()+"/auth/change-password",
+				api.BasePath()+"/auth/password",
+				api.BasePath()+"/auth/logout",
+				api.BasePath()+"/auth/logout-all",
+			),
+			limit("api", apiRule, middleware.KeyByAdmin),
+			middleware.ETagMiddleware(),
+		)
 		{
-			reports.GET("/matches", reportHandler.GetMatchReports)
-			reports.GET("/matches/:id", reportHandler.GetMatchReportByID)
+			// Auth — logout, password and notification settings of the current admin (available to every role)
+			protected.POST("/auth/logout", authHandler.Logout)
+			protected.POST("/auth/logout-all", authHandler.LogoutAll)
+			protected.POST("/auth/change-password", adminHandler.ChangePassword)
+			protected.PUT("/auth/password", adminHandler.ChangePassword)
+			protected.GET("/auth/notifications", notificationHandler.GetPreferences)
+			protected.PUT("/auth/notifications", notificationHandler.UpdatePreferences)
+
+			// Detailed health report — gated behind auth to avoid leaking infrastructure details
+			protected.GET("/health/details", healthHandler.Details)
+
+			// Per-route permissions: every role may read; only editors and super admins may write.
+			// Team managers may also change players and submit results, limited to their own
+			// teams by the services.
+			canEdit := middleware.RoleMiddleware(model.RoleSuperAdmin, model.RoleEditor)
+			canManage := middleware.RoleMiddleware(model.RoleSuperAdmin, model.RoleEditor, model.RoleTeamManager)
+
+			// Successful writes are recorded in the audit log
+			audit := func(entity, action string) gin.HandlerFunc {
+				return middleware.AuditMiddleware(auditService, entity, action)
+			}
+
+			// Confirmation tokens — required by destructive operations (challenge/confirm)
+			protected.POST("/confirmations", canManage, confirmationHandler.Create)
+
+			// Teams CRUD
+			teams := protected.Group("/teams")
+			{
+				read(teams, "", model.ScopeTeamsRead, teamHandler.GetAll)
+				read(teams, "/:id", model.ScopeTeamsRead, teamHandler.GetByID)
+				read(teams, "/by-slug/:slug", model.ScopeTeamsRead, teamHandler.GetBySlug)
+				read(teams, "/:id/stats", model.ScopeTeamsRead, teamHandler.GetStats)
+				teams.POST("", canEdit, audit(model.AuditEntityTeam, model.AuditActionCreate), teamHandler.Create)
+				teams.PUT("/:id", canEdit, audit(model.AuditEntityTeam, model.AuditActionUpdate), teamHandler.Update)
+				teams.PATCH("/:id", canEdit, audit(model.AuditEntityTeam, model.AuditActionUpdate), teamHandler.Patch)
+				teams.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionTeamDelete), audit(model.AuditEntityTeam, model.AuditActionDelete), teamHandler.Delete)
+
+				// Players nested under teams (create + list)
+				read(teams, "/:id/players", model.ScopeTeamsRead, playerHandler.GetAllByTeamID)
+				teams.POST("/:id/players", canManage, audit(model.AuditEntityPlayer, model.AuditActionCreate), playerHandler.Create)
+				teams.POST("/:id/players/import", canManage, audit(model.AuditEntityPlayer, model.AuditActionCreate), playerHandler.Import)
+
+				// Coaching staff nested under teams (create + list)
+				read(teams, "/:id/coaches", model.ScopeTeamsRead, coachHandler.GetAllByTeamID)
+				teams.POST("/:id/coaches", canEdit, audit(model.AuditEntityCoach, model.AuditActionCreate), coachHandler.Create)
+			}
+
+			// Players (search, get, stats, update, patch, transfer, delete — not nested under teams)
+			players := protected.Group("/players")
+			{
+				read(players, "", model.ScopeTeamsRead, playerHandler.GetAll)
+				read(players, "/:id", model.ScopeTeamsRead, playerHandler.GetByID)
+				read(players, "/by-slug/:slug", model.ScopeTeamsRead, playerHandler.GetBySlug)
+				read(players, "/:id/stats", model.ScopeTeamsRead, playerHandler.GetStats)
+				players.PUT("/:id", canManage, audit(model.AuditEntityPlayer, model.AuditActionUpdate), playerHandler.Update)
+				players.PATCH("/:id", canManage, audit(model.AuditEntityPlayer, model.AuditActionUpdate), playerHandler.Patch)
+				players.POST("/:id/photo", canManage, audit(model.AuditEntityPlayer, model.AuditActionUpdate), playerHandler.UploadPhoto)
+				players.POST("/:id/transfer", canManage, audit(model.AuditEntityPlayer, model.AuditActionTransfer), playerHandler.Transfer)
+				players.DELETE("/:id", canManage, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionPlayerDelete), audit(model.AuditEntityPlayer, model.AuditActionDelete), playerHandler.Delete)
+
+				// Injuries and suspensions nested under players (create + list)
+				read(players, "/:id/absences", model.ScopeTeamsRead, absenceHandler.GetAllByPlayerID)
+				players.POST("/:id/absences", canEdit, audit(model.AuditEntityAbsence, model.AuditActionCreate), absenceHandler.Create)
+			}
+
+			// Absences (get, update, delete — not nested under players)
+			absences := protected.Group("/absences")
+			{
+				read(absences, "/:id", model.ScopeTeamsRead, absenceHandler.GetByID)
+				absences.PUT("/:id", canEdit, audit(model.AuditEntityAbsence, model.AuditActionUpdate), absenceHandler.Update)
+				absences.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionAbsenceDelete), audit(model.AuditEntityAbsence, model.AuditActionDelete), absenceHandler.Delete)
+			}
+
+			// Coaches (get, update, delete — not nested under teams)
+			coaches := protected.Group("/coaches")
+			{
+				read(coaches, "/:id", model.ScopeTeamsRead, coachHandler.GetByID)
+				coaches.PUT("/:id", canEdit, audit(model.AuditEntityCoach, model.AuditActionUpdate), coachHandler.Update)
+				coaches.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionCoachDelete), audit(model.AuditEntityCoach, model.AuditActionDelete), coachHandler.Delete)
+			}
+
+			// Matches CRUD + Results
+			matches := protected.Group("/matches")
+			{
+				read(matches, "", model.ScopeMatchesRead, v.changed, v.listMatches)
+				read(matches, "/:id", model.ScopeMatchesRead, v.changed, v.getMatch)
+				matches.POST("", canEdit, audit(model.AuditEntityMatch, model.AuditActionCreate), matchHandler.Create)
+				matches.PUT("/:id", canEdit, audit(model.AuditEntityMatch, model.AuditActionUpdate), matchHandler.Update)
+				matches.PATCH("/:id", canEdit, audit(model.AuditEntityMatch, model.AuditActionUpdate), matchHandler.Patch)
+				matches.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionMatchDelete), audit(model.AuditEntityMatch, model.AuditActionDelete), matchHandler.Delete)
+
+				// Match results (submit + update, or many matches at once; undo is for super admins only)
+				matches.POST("/results/import", canManage, audit(model.AuditEntityMatch, model.AuditActionSubmitResult), matchHandler.ImportResults)
+				matches.POST("/:id/result", canManage, audit(model.AuditEntityMatch, model.AuditActionSubmitResult), matchHandler.SubmitResult)
+				matches.PUT("/:id/result", canManage, audit(model.AuditEntityMatch, model.AuditActionUpdateResult), matchHandler.UpdateResult)
+				matches.DELETE("/:id/result", middleware.RoleMiddleware(model.RoleSuperAdmin), middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionResultDelete), audit(model.AuditEntityMatch, model.AuditActionUndoResult), matchHandler.UndoResult)
+				matches.POST("/:id/status", canEdit, audit(model.AuditEntityMatch, model.AuditActionChangeStatus), matchHandler.UpdateStatus)
+				read(matches, "/:id/timeline", model.ScopeMatchesRead, matchHandler.GetTimeline)
+				read(matches, "/:id/lineup", model.ScopeMatchesRead, matchHandler.GetLineup)
+				matches.POST("/:id/lineup", canEdit, audit(model.AuditEntityMatch, model.AuditActionSetLineup), matchHandler.SetLineup)
+				read(matches, "/:id/officials", model.ScopeMatchesRead, matchHandler.GetOfficials)
+				matches.POST("/:id/officials", canEdit, audit(model.AuditEntityMatch, model.AuditActionSetOfficials), matchHandler.SetOfficials)
+				read(matches, "/:id/suspensions", model.ScopeMatchesRead, matchHandler.GetSuspensions)
+				read(matches, "/:id/prediction", model.ScopeMatchesRead, matchHandler.GetPrediction)
+
+				// Match documents such as referee reports — editors only, not open to API keys
+				matches.GET("/:id/attachments", canEdit, matchHandler.GetAttachments)
+				matches.POST("/:id/attachments", canEdit, audit(model.AuditEntityMatch, model.AuditActionAddAttachment), matchHandler.UploadAttachment)
+				matches.GET("/:id/attachments/:attachmentId", canEdit, matchHandler.DownloadAttachment)
+				matches.DELETE("/:id/attachments/:attachmentId", canEdit, middleware.ConfirmationMiddlewareFor(confirmationService, model.ConfirmActionAttachmentDelete, "attachmentId"), audit(model.AuditEntityMatch, model.AuditActionDeleteAttachment), matchHandler.DeleteAttachment)
+			}
+
+			// Competitions CRUD
+			competitions := protected.Group("/competitions")
+			{
+				read(competitions, "", model.ScopeCompetitionsRead, competitionHandler.GetAll)
+				read(competitions, "/:id", model.ScopeCompetitionsRead, competitionHandler.GetByID)
+				competitions.POST("", canEdit, audit(model.AuditEntityCompetition, model.AuditActionCreate), competitionHandler.Create)
+				competitions.PUT("/:id", canEdit, audit(model.AuditEntityCompetition, model.AuditActionUpdate), competitionHandler.Update)
+				competitions.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionCompetitionDelete), audit(model.AuditEntityCompetition, model.AuditActionDelete), competitionHandler.Delete)
+
+				// Seasons nested under competitions (create + list)
+				read(competitions, "/:id/seasons", model.ScopeCompetitionsRead, seasonHandler.GetAllByCompetitionID)
+				competitions.POST("/:id/seasons", canEdit, audit(model.AuditEntitySeason, model.AuditActionCreate), seasonHandler.Create)
+			}
+
+			// Seasons (get, update, delete — not nested under competitions)
+			seasons := protected.Group("/seasons")
+			{
+				read(seasons, "/:id", model.ScopeCompetitionsRead, seasonHandler.GetByID)
+				seasons.PUT("/:id", canEdit, audit(model.AuditEntitySeason, model.AuditActionUpdate), seasonHandler.Update)
+				seasons.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionSeasonDelete), audit(model.AuditEntitySeason, model.AuditActionDelete), seasonHandler.Delete)
+
+				// Groups of the season's group stage (create + list) and their tables
+				read(seasons, "/:id/groups", model.ScopeCompetitionsRead, seasonHandler.GetGroups)
+				read(seasons, "/:id/groups/standings", model.ScopeCompetitionsRead, seasonHandler.GetGroupStandings)
+				seasons.POST("/:id/groups", canEdit, audit(model.AuditEntityGroup, model.AuditActionCreate), seasonHandler.CreateGroup)
+			}
+
+			// Groups (get, update, delete — not nested under seasons)
+			groups := protected.Group("/groups")
+			{
+				read(groups, "/:id", model.ScopeCompetitionsRead, seasonHandler.GetGroup)
+				groups.PUT("/:id", canEdit, audit(model.AuditEntityGroup, model.AuditActionUpdate), seasonHandler.UpdateGroup)
+				groups.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionGroupDelete), audit(model.AuditEntityGroup, model.AuditActionDelete), seasonHandler.DeleteGroup)
+			}
+
+			// Stadiums CRUD — venues of matches and home grounds of teams
+			stadiums := protected.Group("/stadiums")
+			{
+				read(stadiums, "", model.ScopeMatchesRead, stadiumHandler.GetAll)
+				read(stadiums, "/:id", model.ScopeMatchesRead, stadiumHandler.GetByID)
+				stadiums.POST("", canEdit, audit(model.AuditEntityStadium, model.AuditActionCreate), stadiumHandler.Create)
+				stadiums.PUT("/:id", canEdit, audit(model.AuditEntityStadium, model.AuditActionUpdate), stadiumHandler.Update)
+				stadiums.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionStadiumDelete), audit(model.AuditEntityStadium, model.AuditActionDelete), stadiumHandler.Delete)
+			}
+
+			// Referees CRUD — officials assigned to matches
+			referees := protected.Group("/referees")
+			{
+				read(referees, "", model.ScopeMatchesRead, refereeHandler.GetAll)
+				read(referees, "/:id", model.ScopeMatchesRead, refereeHandler.GetByID)
+				read(referees, "/:id/matches", model.ScopeMatchesRead, refereeHandler.GetMatches)
+				referees.POST("", canEdit, audit(model.AuditEntityReferee, model.AuditActionCreate), refereeHandler.Create)
+				referees.PUT("/:id", canEdit, audit(model.AuditEntityReferee, model.AuditActionUpdate), refereeHandler.Update)
+				referees.DELETE("/:id", canEdit, middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionRefereeDelete), audit(model.AuditEntityReferee, model.AuditActionDelete), refereeHandler.Delete)
+			}
+
+			// Admin account management — super admins only
+			admins := protected.Group("/admins")
+			admins.Use(middleware.RoleMiddleware(model.RoleSuperAdmin))
+			{
+				admins.GET("", adminHandler.GetAll)
+				admins.GET("/:id", adminHandler.GetByID)
+				admins.POST("", adminHandler.Create)
+				admins.PUT("/:id", adminHandler.Update)
+				admins.DELETE("/:id", middleware.ConfirmationMiddleware(confirmationService, model.ConfirmActionAdminDelete), adminHandler.Delete)
+				admins.POST("/:id/unlock", adminHandler.Unlock)
+			}
+
+			// API keys for machine clients — super admins only
+			apiKeys := protected.Group("/api-keys")
+			apiKeys.Use(middleware.RoleMiddleware(model.RoleSuperAdmin))
+			{
+				apiKeys.GET("", apiKeyHandler.GetAll)
+				apiKeys.POST("", apiKeyHandler.Create)
+				apiKeys.DELETE("/:id", apiKeyHandler.Revoke)
+			}
+
+			// Webhook subscriptions — super admins only
+			webhooks := protected.Group("/webhooks")
+			webhooks.Use(middleware.RoleMiddleware(model.RoleSuperAdmin))
+			{
+				webhooks.GET("", webhookHandler.GetAll)
+				webhooks.POST("", webhookHandler.Create)
+				webhooks.GET("/:id", webhookHandler.GetByID)
+				webhooks.PUT("/:id", webhookHandler.Update)
+				webhooks.DELETE("/:id", webhookHandler.Delete)
+				webhooks.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+			}
+
+			// Maintenance mode — super admins only
+			maintenance := protected.Group("/maintenance")
+			maintenance.Use(middleware.RoleMiddleware(model.RoleSuperAdmin))
+			{
+				maintenance.GET("", maintenanceHandler.Get)
+				maintenance.PUT("", maintenanceHandler.Enable)
+				maintenance.DELETE("", maintenanceHandler.Disable)
+			}
+
+			// Audit trail — super admins only
+			protected.GET("/audit-logs", middleware.RoleMiddleware(model.RoleSuperAdmin), auditLogHandler.GetAll)
+
+			// Matches of archived seasons — super admins only
+			protected.GET("/archive/matches", middleware.RoleMiddleware(model.RoleSuperAdmin), matchHandler.GetArchived)
+
+			// Reports (read-only)
+			reports := protected.Group("/reports")
+			{
+				read(reports, "/matches", model.ScopeReportsRead, reportHandler.GetMatchReports)
+				read(reports, "/matches/:id", model.ScopeReportsRead, reportHandler.GetMatchReportByID)
+				read(reports, "/matches/:id/pdf", model.ScopeReportsRead, reportHandler.GetMatchReportPDF)
+				read(reports, "/rounds/:round", model.ScopeReportsRead, reportHandler.GetRoundReport)
+				read(reports, "/standings", model.ScopeReportsRead, reportHandler.GetStandings)
+				read(reports, "/standings/history", model.ScopeReportsRead, reportHandler.GetStandingsHistory)
+				read(reports, "/ratings", model.ScopeReportsRead, reportHandler.GetRatings)
+				read(reports, "/ratings/history", model.ScopeReportsRead, reportHandler.GetRatingHistory)
+				read(reports, "/goals-by-team", model.ScopeReportsRead, reportHandler.GetGoalsByTeam)
+				read(reports, "/attendance", model.ScopeReportsRead, reportHandler.GetAttendance)
+				read(reports, "/man-of-the-match", model.ScopeReportsRead, reportHandler.GetManOfTheMatchLeaderboard)
+			}
+
+			// GraphQL (read-only) — bearer tokens only, since one query can span every API key scope
+			protected.POST("/graphql", graphqlHandler.Query)
+			protected.GET("/graphql/schema", graphqlHandler.Schema)
 		}
-	}
 
+	}
+	for _, v := range apiVersions(matchHandler) {
+		mount(r.Group(v.basePath), v)
+	}
 	return r
 }
@@ -0,0 +1,310 @@
+//go:build integration
+
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.RunWithPostgres(m))
+}
+
+// TestMatchFlow goes through a match's life as an admin would: log in, create the teams,
+// schedule the match, submit its result, then read the match report and the standings.
+func TestMatchFlow(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	app := testutil.NewApp(t, db)
+	app.Spec = testutil.LoadSpec(t)
+
+	fx.Admin("admin", "secret-password", model.RoleSuperAdmin)
+	token := app.Login(t, "admin", "secret-password")
+
+	createTeam := func(name, city string) dto.TeamResponse {
+		t.Helper()
+		resp := app.Do(t, http.MethodPost, "/api/v1/teams", token, dto.CreateTeamRequest{Name: name, City: city, FoundedYear: 1928})
+		require.Equal(t, http.StatusCreated, resp.Code, string(resp.Body))
+		var team dto.TeamResponse
+		resp.Decode(t, &team)
+		return team
+	}
+	persija := createTeam("Persija Jakarta", "Jakarta")
+	persib := createTeam("Persib Bandung", "Bandung")
+
+	addPlayer := func(team dto.TeamResponse, name string, jersey int) dto.PlayerResponse {
+		t.Helper()
+		resp := app.Do(t, http.MethodPost, "/api/v1/teams/"+team.ID+"/players", token,
+			dto.CreatePlayerRequest{Name: name, Height: 180, Weight: 75, Position: "penyerang", JerseyNumber: jersey})
+		require.Equal(t, http.StatusCreated, resp.Code, string(resp.Body))
+		var player dto.PlayerResponse
+		resp.Decode(t, &player)
+		return player
+	}
+	simic := addPlayer(persija, "Marko Simic", 9)
+	silva := addPlayer(persib, "David da Silva", 10)
+
+	// Results can only be submitted once the match has kicked off
+	kickoff := time.Now().Add(-2 * time.Hour).UTC().Truncate(time.Second)
+	resp := app.Do(t, http.MethodPost, "/api/v1/matches", token, dto.CreateMatchRequest{
+		HomeTeamID:    persija.ID,
+		AwayTeamID:    persib.ID,
+		MatchDatetime: kickoff.Format(time.RFC3339),
+	})
+	require.Equal(t, http.StatusCreated, resp.Code, string(resp.Body))
+	var match dto.MatchResponse
+	resp.Decode(t, &match)
+	assert.Equal(t, model.MatchStatusScheduled, match.Status)
+
+	resp = app.Do(t, http.MethodPost, "/api/v1/matches/"+match.ID+"/result", token, dto.MatchResultRequest{
+		Events: []dto.MatchEventInput{
+			{Type: model.EventGoal, PlayerID: simic.ID, TeamID: persija.ID, Minute: "12"},
+			{Type: model.EventGoal, PlayerID: silva.ID, TeamID: persib.ID, Minute: "40"},
+			{Type: model.EventPenalty, PlayerID: simic.ID, TeamID: persija.ID, Minute: "88"},
+		},
+	})
+	require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+	resp.Decode(t, &match)
+	assert.Equal(t, model.MatchStatusCompleted, match.Status)
+	assert.Equal(t, 2, match.HomeScore)
+	assert.Equal(t, 1, match.AwayScore)
+
+	resp = app.Do(t, http.MethodPost, "/api/v1/matches/"+match.ID+"/result", token, dto.MatchResultRequest{})
+	assert.Equal(t, http.StatusBadRequest, resp.Code, "a result cannot be submitted twice")
+
+	resp = app.Do(t, http.MethodGet, "/api/v1/reports/matches/"+match.ID, token, nil)
+	require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+	var report dto.MatchReportResponse
+	resp.Decode(t, &report)
+	assert.Equal(t, "Home Win", report.MatchResult)
+	assert.Equal(t, 2, report.HomeScore)
+	assert.Equal(t, 1, report.AwayScore)
+	require.Len(t, report.Goals, 3)
+	assert.Equal(t, "Marko Simic", report.Goals[0].PlayerName)
+	assert.Equal(t, model.EventPenalty, report.Goals[2].Type)
+	require.NotNil(t, report.TopScorer)
+	assert.Equal(t, 1, report.HomeTeamTotalWins)
+	assert.Equal(t, 0, report.AwayTeamTotalWins)
+
+	resp = app.Do(t, http.MethodGet, "/api/v1/reports/standings", token, nil)
+	require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+	var standings []dto.StandingResponse
+	resp.Decode(t, &standings)
+	require.Len(t, standings, 2)
+	assert.Equal(t, persija.ID, standings[0].Team.ID)
+	assert.Equal(t, 3, standings[0].Points)
+	assert.Equal(t, 1, standings[0].GoalDifference)
+	assert.Equal(t, persib.ID, standings[1].Team.ID)
+	assert.Equal(t, 0, standings[1].Points)
+}
+
+func TestAuthorization(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	app := testutil.NewApp(t, db)
+	app.Spec = testutil.LoadSpec(t)
+
+	fx.Admin("viewer", "secret-password", model.RoleViewer)
+	token := app.Login(t, "viewer", "secret-password")
+
+	resp := app.Do(t, http.MethodGet, "/api/v1/teams", "", nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = app.Do(t, http.MethodGet, "/api/v1/teams", token, nil)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = app.Do(t, http.MethodPost, "/api/v1/teams", token, dto.CreateTeamRequest{Name: "Arema FC"})
+	assert.Equal(t, http.StatusForbidden, resp.Code, "viewers cannot create teams")
+}
+
+// TestResultImport_CSVCompletesMatches imports a matchweek's results from a CSV file.
+func TestResultImport_CSVCompletesMatches(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	app := testutil.NewApp(t, db)
+
+	persija, persib, arema := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung"), fx.Team("Arema FC")
+	scorer := fx.Player(persija, 9)
+	kickoff := time.Now().Add(-3 * time.Hour)
+	won := fx.Match(persija, persib, kickoff)
+	goalless := fx.Match(arema, persija, kickoff)
+
+	csv := "match_id,type,player_id,team_id,minute\n" +
+		won.ID.String() + ",goal," + scorer.ID.String() + "," + persija.ID.String() + ",12\n" +
+		won.ID.String() + ",goal," + scorer.ID.String() + "," + persija.ID.String() + ",90+2\n" +
+		goalless.ID.String() + ",,,,\n"
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/matches/results/import", strings.NewReader(csv))
+	req.Header.Set("Content-Type", "text/csv")
+	req.Header.Set("Authorization", "Bearer "+app.Token(t, model.RoleEditor))
+	resp := app.Send(t, req)
+	require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+
+	var result dto.MatchResultImportResponse
+	resp.Decode(t, &result)
+	assert.Equal(t, 2, result.Imported)
+	assert.Empty(t, result.Errors)
+
+	var stored model.Match
+	require.NoError(t, db.First(&stored, "id = ?", won.ID).Error)
+	assert.Equal(t, model.MatchStatusCompleted, stored.Status)
+	assert.Equal(t, 2, stored.HomeScore)
+	assert.Equal(t, 0, stored.AwayScore)
+	require.NoError(t, db.First(&stored, "id = ?", goalless.ID).Error)
+	assert.Equal(t, model.MatchStatusCompleted, stored.Status)
+}
+
+// TestContract_ReadEndpoints reads every documented GET endpoint as a super admin and
+// validates the responses against the OpenAPI spec.
+func TestContract_ReadEndpoints(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	app := testutil.NewApp(t, db)
+	spec := testutil.LoadSpec(t)
+	app.Spec = spec
+
+	admin := fx.Admin("admin", "secret-password", model.RoleSuperAdmin)
+	token := app.Login(t, "admin", "secret-password")
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	striker := fx.Player(home, 9)
+	season := fx.Season("2025/26", "2025-07-01", "2026-05-31")
+	match := fx.CompletedMatch(home, away, 1, 0, &season.ID, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, db.Model(match).Update("round", 1).Error)
+	require.NoError(t, db.Model(home).Update("slug", "persija-jakarta").Error)
+	require.NoError(t, db.Model(striker).Update("slug", "striker").Error)
+	coach := &model.Coach{TeamID: home.ID, Name: "Thomas Doll", Role: model.CoachRoleHead}
+	absence := &model.PlayerAbsence{PlayerID: striker.ID, Type: "injury", StartDate: "2025-08-01"}
+	stadium := &model.Stadium{Name: "Jakarta International Stadium", City: "Jakarta", Capacity: 82000}
+	referee := &model.Referee{Name: "Thoriq Alkatiri", Country: "Indonesia"}
+	group := &model.Group{SeasonID: season.ID, Name: "Group A", Qualifiers: 2, Teams: []model.GroupTeam{
+		{SeasonID: season.ID, TeamID: home.ID},
+		{SeasonID: season.ID, TeamID: away.ID},
+	}}
+	webhook := &model.Webhook{URL: "https://example.com/hook", Events: "match.completed", Secret: "secret", Active: true, CreatedBy: admin.ID}
+	attachment := &model.MatchAttachment{MatchID: match.ID, FileName: "referee-report.pdf", ContentType: "application/pdf", Size: 8, StorageKey: "matches/referee-report.pdf", UploadedBy: admin.ID}
+	_, err := app.Attachments.Put(t.Context(), attachment.StorageKey, []byte("%PDF-1.4"), attachment.ContentType)
+	require.NoError(t, err)
+	for _, value := range []any{
+		&model.MatchEvent{MatchID: match.ID, Type: model.EventGoal, PlayerID: striker.ID, TeamID: home.ID, Minute: 30},
+		coach, absence, stadium, referee, group, webhook, attachment,
+	} {
+		require.NoError(t, db.Create(value).Error)
+	}
+
+	// IDs by the path segment before the parameter; slugs by the resource and "by-slug"
+	ids := map[string]string{
+		"absences":        absence.ID.String(),
+		"admins":          admin.ID.String(),
+		"attachments":     attachment.ID.String(),
+		"coaches":         coach.ID.String(),
+		"competitions":    season.CompetitionID.String(),
+		"groups":          group.ID.String(),
+		"matches":         match.ID.String(),
+		"players":         striker.ID.String(),
+		"players/by-slug": "striker",
+		"referees":        referee.ID.String(),
+		"rounds":          "1",
+		"seasons":         season.ID.String(),
+		"stadiums":        stadium.ID.String(),
+		"teams":           home.ID.String(),
+		"teams/by-slug":   "persija-jakarta",
+		"webhooks":        webhook.ID.String(),
+	}
+	// Query strings of endpoints with required query parameters
+	queries := map[string]string{
+		"/api/v1/reports/standings/history": "team_id=" + home.ID.String(),
+		"/api/v1/reports/ratings/history":   "team_id=" + home.ID.String(),
+		"/api/v1/reports/goals-by-team":     "season_id=" + season.ID.String(),
+		"/api/v1/reports/man-of-the-match":  "season_id=" + season.ID.String(),
+	}
+
+	for _, op := range spec.Operations() {
+		// The event stream never ends; it is not a JSON response either
+		if op.Method != http.MethodGet || strings.HasSuffix(op.Path, "/matches/stream") {
+			continue
+		}
+		t.Run(op.String(), func(t *testing.T) {
+			segments := strings.Split(op.Path, "/")
+			for i, segment := range segments {
+				if strings.HasPrefix(segment, "{") {
+					key := segments[i-1]
+					if key == "by-slug" {
+						key = segments[i-2] + "/" + key
+					}
+					id, ok := ids[key]
+					require.True(t, ok, "no fixture for %s", key)
+					segments[i] = id
+				}
+			}
+			path := strings.Join(segments, "/")
+			if path == "/api/v1/.well-known/jwks.json" {
+				path = "/.well-known/jwks.json"
+			}
+			if query, ok := queries[op.Path]; ok {
+				path += "?" + query
+			}
+
+			resp := app.Do(t, http.MethodGet, path, token, nil)
+			assert.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+		})
+	}
+}
+
+// TestSparseFieldsets lists teams, players and matches with fields=, which loads and returns
+// only the fields asked for.
+func TestSparseFieldsets(t *testing.T) {
+	db := testutil.NewDB(t)
+	fx := testutil.NewFixtures(t, db)
+	app := testutil.NewApp(t, db)
+
+	fx.Admin("viewer", "secret-password", model.RoleViewer)
+	token := app.Login(t, "viewer", "secret-password")
+
+	home, away := fx.Team("Persija Jakarta"), fx.Team("Persib Bandung")
+	fx.Player(home, 9)
+	fx.Match(home, away, time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC))
+
+	list := func(path string) []map[string]any {
+		t.Helper()
+		resp := app.Do(t, http.MethodGet, path, token, nil)
+		require.Equal(t, http.StatusOK, resp.Code, string(resp.Body))
+		var items []map[string]any
+		resp.Decode(t, &items)
+		require.NotEmpty(t, items)
+		return items
+	}
+	keys := func(item map[string]any) []string {
+		var names []string
+		for name := range item {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	for _, team := range list("/api/v1/teams?fields=name,city") {
+		assert.ElementsMatch(t, []string{"name", "city"}, keys(team))
+		assert.NotEmpty(t, team["name"])
+	}
+
+	players := list("/api/v1/players?fields=name,team")
+	assert.ElementsMatch(t, []string{"name", "team"}, keys(players[0]))
+	assert.Equal(t, "Persija Jakarta", players[0]["team"].(map[string]any)["name"])
+
+	matches := list("/api/v1/matches?fields=id,local_datetime,away_team")
+	assert.ElementsMatch(t, []string{"id", "local_datetime", "away_team"}, keys(matches[0]))
+	assert.Equal(t, "Persib Bandung", matches[0]["away_team"].(map[string]any)["name"])
+
+	resp := app.Do(t, http.MethodGet, "/api/v1/teams?fields=name,colour", token, nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
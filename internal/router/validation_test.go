@@ -0,0 +1,142 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidation checks that the custom validation tags reject bad values while binding,
+// before the handlers reach the (here unavailable) database.
+func TestValidation(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	app.Spec = testutil.LoadSpec(t)
+	token := app.Token(t, model.RoleEditor)
+	teamID := uuid.Must(uuid.NewV7()).String()
+
+	player := map[string]any{"name": "Marko Simic", "height": 185, "weight": 80, "jersey_number": 9}
+	withField := func(base map[string]any, key string, value any) map[string]any {
+		body := map[string]any{key: value}
+		for k, v := range base {
+			if k != key {
+				body[k] = v
+			}
+		}
+		return body
+	}
+	match := map[string]any{"home_team_id": teamID, "away_team_id": uuid.Must(uuid.NewV7()).String()}
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		body      any
+		wantField errs.FieldError
+	}{
+		{
+			name:      "unknown position",
+			method:    http.MethodPost,
+			path:      "/api/v1/teams/" + teamID + "/players",
+			body:      withField(player, "position", "striker"),
+			wantField: errs.FieldError{Field: "position", Message: "position must be one of: penyerang, gelandang, bertahan, penjaga_gawang"},
+		},
+		{
+			name:      "unknown position filter",
+			method:    http.MethodGet,
+			path:      "/api/v1/players?position=striker",
+			wantField: errs.FieldError{Field: "position", Message: "position must be one of: penyerang, gelandang, bertahan, penjaga_gawang"},
+		},
+		{
+			name:      "unknown match status",
+			method:    http.MethodGet,
+			path:      "/api/v1/matches?status=finished",
+			wantField: errs.FieldError{Field: "status", Message: "status must be one of: scheduled, live, awaiting_result, completed, postponed, cancelled"},
+		},
+		{
+			name:      "impossible date",
+			method:    http.MethodGet,
+			path:      "/api/v1/matches?date_from=2025-13-45",
+			wantField: errs.FieldError{Field: "date_from", Message: "date_from must be a date in YYYY-MM-DD format"},
+		},
+		{
+			name:      "impossible kick-off time",
+			method:    http.MethodPost,
+			path:      "/api/v1/matches",
+			body:      withField(match, "match_datetime", "2025-06-15T25:99"),
+			wantField: errs.FieldError{Field: "match_datetime", Message: "match_datetime must be an ISO 8601 date and time such as 2025-06-15T19:30:00+07:00"},
+		},
+		{
+			name:      "date instead of kick-off time",
+			method:    http.MethodPost,
+			path:      "/api/v1/matches",
+			body:      withField(match, "match_datetime", "2025-06-15"),
+			wantField: errs.FieldError{Field: "match_datetime", Message: "match_datetime must be an ISO 8601 date and time such as 2025-06-15T19:30:00+07:00"},
+		},
+		{
+			name:   "added time in the middle of a half",
+			method: http.MethodPost,
+			path:   "/api/v1/matches/" + uuid.Must(uuid.NewV7()).String() + "/result",
+			body: map[string]any{"events": []map[string]any{
+				{"type": "goal", "player_id": uuid.Must(uuid.NewV7()).String(), "team_id": teamID, "minute": "46+2"},
+			}},
+			wantField: errs.FieldError{Field: "events[0].minute", Message: "events[0].minute must be a match minute such as 67, or 90+3 in added time"},
+		},
+		{
+			name:      "negative attendance",
+			method:    http.MethodPost,
+			path:      "/api/v1/matches/" + uuid.Must(uuid.NewV7()).String() + "/result",
+			body:      map[string]any{"attendance": -1},
+			wantField: errs.FieldError{Field: "attendance", Message: "attendance must be at least 0"},
+		},
+		{
+			name:      "per_page not a number",
+			method:    http.MethodGet,
+			path:      "/api/v1/teams?per_page=abc",
+			wantField: errs.FieldError{Field: "per_page", Message: "per_page must be a whole number"},
+		},
+		{
+			name:      "per_page above the limit",
+			method:    http.MethodGet,
+			path:      "/api/v1/players?per_page=500",
+			wantField: errs.FieldError{Field: "per_page", Message: "per_page must be at most 100"},
+		},
+		{
+			name:      "unknown sort order",
+			method:    http.MethodGet,
+			path:      "/api/v1/matches?sort_order=sideways",
+			wantField: errs.FieldError{Field: "sort_order", Message: "sort_order must be one of: asc, desc"},
+		},
+		{
+			name:      "unknown sort column",
+			method:    http.MethodGet,
+			path:      "/api/v1/teams?sort_by=bogus",
+			wantField: errs.FieldError{Field: "sort_by", Message: "sort_by must be one of: created_at, name, founded_year, city, player_count"},
+		},
+		{
+			name:      "filter not a number",
+			method:    http.MethodGet,
+			path:      "/api/v1/teams?founded_year_from=nineteen",
+			wantField: errs.FieldError{Field: "founded_year_from", Message: "founded_year_from must be a whole number"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := app.Do(t, tt.method, tt.path, token, tt.body)
+			require.Equal(t, http.StatusBadRequest, resp.Code, string(resp.Body))
+
+			var envelope response.Envelope
+			require.NoError(t, json.Unmarshal(resp.Body, &envelope))
+			assert.Equal(t, errs.CodeValidationFailed, envelope.Code)
+			assert.Equal(t, []errs.FieldError{tt.wantField}, envelope.Errors)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/testutil"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIVersions_Deprecation(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+	token := app.Token(t, model.RoleViewer)
+
+	tests := []struct {
+		name          string
+		path          string
+		wantDeprecate bool
+		wantLink      string
+	}{
+		{
+			name:          "v1 route changed in v2",
+			path:          "/api/v1/matches?status=unknown",
+			wantDeprecate: true,
+			wantLink:      `</api/v2/matches>; rel="successor-version"`,
+		},
+		{
+			name: "v1 route shared with v2",
+			path: "/api/v1/players?position=unknown",
+		},
+		{
+			name: "v2 route",
+			path: "/api/v2/matches?status=unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := app.Do(t, http.MethodGet, tt.path, token, nil)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			if !tt.wantDeprecate {
+				assert.Empty(t, resp.Header.Get("Deprecation"))
+				assert.Empty(t, resp.Header.Get("Sunset"))
+				return
+			}
+			assert.Regexp(t, `^@\d+$`, resp.Header.Get("Deprecation"))
+			assert.NotEmpty(t, resp.Header.Get("Sunset"))
+			assert.Equal(t, tt.wantLink, resp.Header.Get("Link"))
+		})
+	}
+}
+
+func TestVersionEndpoint(t *testing.T) {
+	app := testutil.NewApp(t, testutil.OfflineDB(t))
+
+	resp := app.Do(t, http.MethodGet, "/version", "", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var info buildinfo.Info
+	require.NoError(t, json.Unmarshal(resp.Body, &info))
+	assert.Equal(t, buildinfo.Get(), info)
+	assert.NotEmpty(t, info.Version)
+	assert.NotEmpty(t, info.GoVersion)
+}
@@ -0,0 +1,45 @@
+package seed
+
+// club is the fixture data of one seeded team.
+type club struct {
+	name    string
+	slug    string
+	city    string
+	stadium string
+	founded int
+}
+
+// clubs are the teams a data set is drawn from.
+var clubs = []club{
+	{"Persija Jakarta", "persija-jakarta", "Jakarta", "Jakarta International Stadium", 1928},
+	{"Persib Bandung", "persib-bandung", "Bandung", "Stadion Gelora Bandung Lautan Api", 1933},
+	{"Persebaya Surabaya", "persebaya-surabaya", "Surabaya", "Stadion Gelora Bung Tomo", 1927},
+	{"Arema FC", "arema-fc", "Malang", "Stadion Kanjuruhan", 1987},
+	{"PSM Makassar", "psm-makassar", "Makassar", "Stadion Gelora B.J. Habibie", 1915},
+	{"Bali United", "bali-united", "Gianyar", "Stadion Kapten I Wayan Dipta", 1989},
+	{"Borneo FC", "borneo-fc", "Samarinda", "Stadion Segiri", 2014},
+	{"PSIS Semarang", "psis-semarang", "Semarang", "Stadion Jatidiri", 1932},
+	{"Persik Kediri", "persik-kediri", "Kediri", "Stadion Brawijaya", 1950},
+	{"Persita Tangerang", "persita-tangerang", "Tangerang", "Indomilk Arena", 1953},
+	{"Madura United", "madura-united", "Pamekasan", "Stadion Gelora Ratu Pamelingan", 2016},
+	{"Dewa United", "dewa-united", "Tangerang", "Indomilk Arena", 2021},
+	{"Barito Putera", "barito-putera", "Banjarmasin", "Stadion Demang Lehman", 1988},
+	{"PSS Sleman", "pss-sleman", "Sleman", "Stadion Maguwoharjo", 1976},
+	{"Persis Solo", "persis-solo", "Surakarta", "Stadion Manahan", 1923},
+	{"Bhayangkara FC", "bhayangkara-fc", "Bekasi", "Stadion Patriot Candrabhaga", 2010},
+	{"Semen Padang", "semen-padang", "Padang", "Stadion Haji Agus Salim", 1980},
+	{"Persiraja Banda Aceh", "persiraja-banda-aceh", "Banda Aceh", "Stadion Harapan Bangsa", 1957},
+	{"PSBS Biak", "psbs-biak", "Biak", "Stadion Cendrawasih", 1970},
+	{"Malut United", "malut-united", "Ternate", "Stadion Gelora Kie Raha", 2023},
+}
+
+// firstNames and lastNames are combined into player names.
+var firstNames = []string{
+	"Andik", "Bambang", "Boaz", "Egy", "Evan", "Fachruddin", "Hansamu", "Ilham", "Irfan", "Kurniawan",
+	"Marselino", "Pratama", "Rachmat", "Ricky", "Rizky", "Saddil", "Stefano", "Witan", "Yakob", "Zulfiandi",
+}
+
+var lastNames = []string{
+	"Arhan", "Bachdim", "Dimas", "Ferdinan", "Hakim", "Irianto", "Jaya", "Kambuaya", "Lestaluhu", "Maulana",
+	"Nainggolan", "Pamungkas", "Ramadhan", "Sayuri", "Simanjuntak", "Sulaeman", "Sugiarto", "Syahputra", "Wahyudi", "Yanto",
+}
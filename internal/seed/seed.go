@@ -0,0 +1,334 @@
+// Package seed generates demo data sets: teams with full rosters and a double
+// round-robin season whose past fixtures already have results.
+// It only builds the records; persisting them is up to the caller.
+package seed
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/slug"
+)
+
+// MaxPlayersPerTeam keeps jersey numbers within 1-99.
+const MaxPlayersPerTeam = 40
+
+// Options controls the size and timing of a generated data set.
+type Options struct {
+	Teams          int        // 2 to len(clubs)
+	PlayersPerTeam int        // 11 to MaxPlayersPerTeam
+	SeasonStart    time.Time  // Kick-off of the first round; later rounds follow weekly
+	Now            time.Time  // Fixtures kicking off before Now are completed with a result
+	Rand           *rand.Rand // Source of all randomness, so a fixed seed gives the same data set
+}
+
+// Presets are the named data sets, from a quick smoke test to a full league.
+var Presets = map[string]Options{
+	"small": {Teams: 4, PlayersPerTeam: 14},
+	"demo":  {Teams: 8, PlayersPerTeam: 20},
+	"full":  {Teams: 18, PlayersPerTeam: 25},
+}
+
+// Dataset is a generated competition with one season, its teams, players, matches and match events.
+type Dataset struct {
+	Competition model.Competition
+	Season      model.Season
+	Teams       []model.Team
+	Players     []model.Player
+	Matches     []model.Match
+	Events      []model.MatchEvent
+}
+
+// RoundsFor returns the number of rounds in a double round-robin season of n teams.
+func RoundsFor(teams int) int {
+	return 2 * (teams + teams%2 - 1)
+}
+
+// Validate reports the first option out of range.
+func (o Options) Validate() error {
+	if o.Teams < 2 || o.Teams > len(clubs) {
+		return fmt.Errorf("teams must be between 2 and %d", len(clubs))
+	}
+	if o.PlayersPerTeam < 11 || o.PlayersPerTeam > MaxPlayersPerTeam {
+		return fmt.Errorf("players per team must be between 11 and %d", MaxPlayersPerTeam)
+	}
+	if o.Rand == nil {
+		return fmt.Errorf("a random source is required")
+	}
+	return nil
+}
+
+// Generate builds a data set. Every record has its ID set, so references between them
+// are valid before anything is inserted.
+func Generate(opts Options) (*Dataset, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	g := generator{rnd: opts.Rand, players: make(map[uuid.UUID][]model.Player)}
+
+	ds := &Dataset{}
+	ds.Competition = model.Competition{Base: g.base(), Name: "Liga Demo", Country: "Indonesia"}
+
+	for _, club := range g.pick(opts.Teams) {
+		team := model.Team{
+			Base:        g.base(),
+			Name:        club.name,
+			Slug:        club.slug,
+			LogoURL:     fmt.Sprintf("https://example.com/logos/%s.png", club.slug),
+			FoundedYear: club.founded,
+			Address:     club.stadium,
+			City:        club.city,
+		}
+		ds.Teams = append(ds.Teams, team)
+
+		squad := g.squad(team.ID, opts.PlayersPerTeam)
+		g.players[team.ID] = squad
+		ds.Players = append(ds.Players, squad...)
+	}
+
+	fixtures := roundRobin(ds.Teams)
+	first := opts.SeasonStart
+	last := first.AddDate(0, 0, 7*(len(fixtures)-1))
+	ds.Season = model.Season{
+		Base:          g.base(),
+		CompetitionID: ds.Competition.ID,
+		Name:          fmt.Sprintf("%d/%02d", first.Year(), (first.Year()+1)%100),
+		StartDate:     first.Format("2006-01-02"),
+		EndDate:       last.Format("2006-01-02"),
+	}
+
+	for round, pairs := range fixtures {
+		day := first.AddDate(0, 0, 7*round)
+		matchweek := round + 1
+		for i, pair := range pairs {
+			// Stagger kick-offs through the matchday: 15:00, 17:30, 20:00
+			kickoff := day.Add(time.Duration(i%3) * 150 * time.Minute)
+			match := model.Match{
+				Base:          g.base(),
+				HomeTeamID:    pair[0].ID,
+				AwayTeamID:    pair[1].ID,
+				SeasonID:      &ds.Season.ID,
+				Round:         &matchweek,
+				MatchDatetime: kickoff.UTC(),
+				Status:        model.MatchStatusScheduled,
+			}
+			if kickoff.Before(opts.Now) {
+				events := g.result(&match)
+				ds.Events = append(ds.Events, events...)
+			}
+			ds.Matches = append(ds.Matches, match)
+		}
+	}
+
+	return ds, nil
+}
+
+// roundRobin pairs every team with every other twice, home and away, using the circle method.
+// Each round lists the [home, away] pairs; with an odd number of teams one team rests per round.
+func roundRobin(teams []model.Team) [][][2]model.Team {
+	slots := slices.Clone(teams)
+	if len(slots)%2 == 1 {
+		slots = append(slots, model.Team{}) // bye
+	}
+	n := len(slots)
+
+	var firstHalf [][][2]model.Team
+	for round := 0; round < n-1; round++ {
+		var pairs [][2]model.Team
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			if home.ID == uuid.Nil || away.ID == uuid.Nil {
+				continue
+			}
+			// Alternate venues so no team plays every first-half match at home
+			if (round+i)%2 == 1 {
+				home, away = away, home
+			}
+			pairs = append(pairs, [2]model.Team{home, away})
+		}
+		firstHalf = append(firstHalf, pairs)
+		// Keep the first slot fixed and rotate the rest clockwise
+		slots = append([]model.Team{slots[0], slots[n-1]}, slots[1:n-1]...)
+	}
+
+	rounds := slices.Clone(firstHalf)
+	for _, pairs := range firstHalf {
+		reversed := make([][2]model.Team, len(pairs))
+		for i, pair := range pairs {
+			reversed[i] = [2]model.Team{pair[1], pair[0]}
+		}
+		rounds = append(rounds, reversed)
+	}
+	return rounds
+}
+
+type generator struct {
+	rnd     *rand.Rand
+	players map[uuid.UUID][]model.Player // Squad of each team
+	slugs   []string                     // Player slugs given out, as random names repeat
+}
+
+// base returns a model.Base with a fresh UUID v7.
+func (g *generator) base() model.Base {
+	return model.Base{ID: uuid.Must(uuid.NewV7())}
+}
+
+// pick returns n distinct clubs in random order.
+func (g *generator) pick(n int) []club {
+	shuffled := slices.Clone(clubs)
+	g.rnd.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled[:n]
+}
+
+// squad builds a roster of n players: about one goalkeeper in nine, the rest split
+// between defenders, midfielders and forwards. Jersey numbers are unique; 1 is a goalkeeper.
+func (g *generator) squad(teamID uuid.UUID, n int) []model.Player {
+	keepers := max(2, n/9)
+	defenders := (n - keepers) * 35 / 100
+	midfielders := (n - keepers) * 35 / 100
+	forwards := n - keepers - defenders - midfielders
+
+	var positions []string
+	for _, group := range []struct {
+		position string
+		count    int
+	}{
+		{"penjaga_gawang", keepers},
+		{"bertahan", defenders},
+		{"gelandang", midfielders},
+		{"penyerang", forwards},
+	} {
+		for range group.count {
+			positions = append(positions, group.position)
+		}
+	}
+
+	numbers := g.rnd.Perm(98)
+	players := make([]model.Player, n)
+	for i, position := range positions {
+		height, weight := g.build(position)
+		name := firstNames[g.rnd.IntN(len(firstNames))] + " " + lastNames[g.rnd.IntN(len(lastNames))]
+		playerSlug := slug.Unique(slug.Make(name), g.slugs)
+		g.slugs = append(g.slugs, playerSlug)
+		players[i] = model.Player{
+			Base:         g.base(),
+			TeamID:       teamID,
+			Name:         name,
+			Slug:         playerSlug,
+			Height:       height,
+			Weight:       weight,
+			Position:     position,
+			JerseyNumber: numbers[i] + 2, // 2-99
+		}
+	}
+	players[0].JerseyNumber = 1
+	return players
+}
+
+// build returns a plausible height (cm) and weight (kg) for a position.
+func (g *generator) build(position string) (int, int) {
+	height := 165 + g.rnd.IntN(21) // 165-185
+	if position == "penjaga_gawang" || position == "bertahan" {
+		height += 5
+	}
+	weight := height - 105 + g.rnd.IntN(11)
+	return height, weight
+}
+
+// result completes match with a random score and returns its events: goals (some of them
+// penalties or own goals) and a few yellow cards. The score matches the scoring events.
+func (g *generator) result(match *model.Match) []model.MatchEvent {
+	var events []model.MatchEvent
+	sides := []struct {
+		team, opponent uuid.UUID
+		expected       float64
+		score          *int
+	}{
+		{match.HomeTeamID, match.AwayTeamID, 1.5, &match.HomeScore},
+		{match.AwayTeamID, match.HomeTeamID, 1.1, &match.AwayScore},
+	}
+
+	for _, side := range sides {
+		goals := g.poisson(side.expected)
+		*side.score = goals
+		for range goals {
+			event := model.MatchEvent{Base: g.base(), MatchID: match.ID, Type: model.EventGoal, Minute: g.minute()}
+			switch roll := g.rnd.IntN(100); {
+			case roll < 3:
+				// Own goal: scored by an opponent, credited to this side
+				event.Type = model.EventOwnGoal
+				event.TeamID = side.opponent
+				event.PlayerID = g.player(side.opponent, "bertahan").ID
+			case roll < 13:
+				event.Type = model.EventPenalty
+				event.TeamID = side.team
+				event.PlayerID = g.player(side.team, "penyerang").ID
+			default:
+				event.TeamID = side.team
+				event.PlayerID = g.scorer(side.team).ID
+			}
+			events = append(events, event)
+		}
+
+		for range g.rnd.IntN(4) {
+			events = append(events, model.MatchEvent{
+				Base:     g.base(),
+				MatchID:  match.ID,
+				Type:     model.EventYellowCard,
+				PlayerID: g.player(side.team, "").ID,
+				TeamID:   side.team,
+				Minute:   g.minute(),
+			})
+		}
+	}
+
+	match.Status = model.MatchStatusCompleted
+	slices.SortStableFunc(events, func(a, b model.MatchEvent) int { return a.Minute - b.Minute })
+	return events
+}
+
+// scorer picks a goal scorer, favouring forwards over midfielders over defenders.
+func (g *generator) scorer(teamID uuid.UUID) model.Player {
+	switch roll := g.rnd.IntN(10); {
+	case roll < 6:
+		return g.player(teamID, "penyerang")
+	case roll < 9:
+		return g.player(teamID, "gelandang")
+	default:
+		return g.player(teamID, "bertahan")
+	}
+}
+
+// player picks a random player of a team in position, or any outfield player if position is "".
+func (g *generator) player(teamID uuid.UUID, position string) model.Player {
+	var candidates []model.Player
+	for _, p := range g.players[teamID] {
+		if p.Position == position || (position == "" && p.Position != "penjaga_gawang") {
+			candidates = append(candidates, p)
+		}
+	}
+	return candidates[g.rnd.IntN(len(candidates))]
+}
+
+// minute returns a match minute between 1 and 90.
+func (g *generator) minute() int {
+	return 1 + g.rnd.IntN(90)
+}
+
+// poisson draws a goal count with the given mean (Knuth's algorithm).
+func (g *generator) poisson(mean float64) int {
+	limit := math.Exp(-mean)
+	k, p := 0, 1.0
+	for {
+		p *= g.rnd.Float64()
+		if p <= limit {
+			return k
+		}
+		k++
+	}
+}
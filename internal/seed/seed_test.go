@@ -0,0 +1,173 @@
+package seed
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func testOptions(teams, players int) Options {
+	start := time.Date(2026, 1, 10, 15, 0, 0, 0, time.UTC)
+	return Options{
+		Teams:          teams,
+		PlayersPerTeam: players,
+		SeasonStart:    start,
+		Now:            start.AddDate(0, 0, 7*3+1), // four rounds played
+		Rand:           rand.New(rand.NewPCG(1, 2)),
+	}
+}
+
+func TestGenerate_Fixtures(t *testing.T) {
+	for _, teams := range []int{4, 5} {
+		ds, err := Generate(testOptions(teams, 14))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Len(t, ds.Teams, teams)
+		assert.Len(t, ds.Matches, teams*(teams-1), "every team meets every other home and away")
+
+		pairs := make(map[[2]uuid.UUID]int)
+		perRound := make(map[int]map[uuid.UUID]bool)
+		roundDays := make(map[int]time.Time)
+		for _, m := range ds.Matches {
+			assert.NotEqual(t, m.HomeTeamID, m.AwayTeamID)
+			pairs[[2]uuid.UUID{m.HomeTeamID, m.AwayTeamID}]++
+
+			if !assert.NotNil(t, m.Round, "fixtures are numbered by round") {
+				continue
+			}
+			round := *m.Round
+			day := m.MatchDatetime.Truncate(24 * time.Hour)
+			if perRound[round] == nil {
+				perRound[round] = make(map[uuid.UUID]bool)
+				roundDays[round] = day
+			}
+			assert.Equal(t, roundDays[round], day, "a round is played on one matchday")
+			assert.False(t, perRound[round][m.HomeTeamID], "a team plays at most once per round")
+			assert.False(t, perRound[round][m.AwayTeamID], "a team plays at most once per round")
+			perRound[round][m.HomeTeamID] = true
+			perRound[round][m.AwayTeamID] = true
+		}
+		for pair, n := range pairs {
+			assert.Equal(t, 1, n, "fixture %v is played once", pair)
+		}
+		assert.Len(t, perRound, RoundsFor(teams))
+		for round := 1; round <= RoundsFor(teams); round++ {
+			assert.Contains(t, perRound, round)
+		}
+	}
+}
+
+func TestGenerate_Results(t *testing.T) {
+	opts := testOptions(6, 18)
+	ds, err := Generate(opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	teamOf := make(map[uuid.UUID]uuid.UUID)
+	for _, p := range ds.Players {
+		teamOf[p.ID] = p.TeamID
+	}
+
+	eventsOf := make(map[uuid.UUID][]model.MatchEvent)
+	for _, e := range ds.Events {
+		eventsOf[e.MatchID] = append(eventsOf[e.MatchID], e)
+		assert.Equal(t, e.TeamID, teamOf[e.PlayerID], "event team is the player's team")
+	}
+
+	completed := 0
+	for _, m := range ds.Matches {
+		if m.MatchDatetime.After(opts.Now) {
+			assert.Equal(t, model.MatchStatusScheduled, m.Status)
+			assert.Empty(t, eventsOf[m.ID])
+			continue
+		}
+		completed++
+		assert.Equal(t, model.MatchStatusCompleted, m.Status)
+
+		home, away := 0, 0
+		for _, e := range eventsOf[m.ID] {
+			if !e.IsScoring() {
+				continue
+			}
+			scoredForHome := e.TeamID == m.HomeTeamID
+			if e.Type == model.EventOwnGoal {
+				scoredForHome = !scoredForHome
+			}
+			if scoredForHome {
+				home++
+			} else {
+				away++
+			}
+		}
+		assert.Equal(t, m.HomeScore, home)
+		assert.Equal(t, m.AwayScore, away)
+	}
+	assert.Equal(t, 4*3, completed, "four rounds of three matches")
+}
+
+func TestGenerate_Squads(t *testing.T) {
+	ds, err := Generate(testOptions(3, 23))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, team := range ds.Teams {
+		numbers := make(map[int]bool)
+		keepers := 0
+		for _, p := range ds.Players {
+			if p.TeamID != team.ID {
+				continue
+			}
+			assert.False(t, numbers[p.JerseyNumber], "jersey numbers are unique per team")
+			assert.True(t, p.JerseyNumber >= 1 && p.JerseyNumber <= 99)
+			numbers[p.JerseyNumber] = true
+			if p.Position == "penjaga_gawang" {
+				keepers++
+			}
+		}
+		assert.Len(t, numbers, 23)
+		assert.GreaterOrEqual(t, keepers, 2)
+		assert.True(t, numbers[1], "number 1 is taken")
+	}
+
+	slugs := make(map[string]bool)
+	for _, p := range ds.Players {
+		assert.NotEmpty(t, p.Slug)
+		assert.False(t, slugs[p.Slug], "player slugs are unique")
+		slugs[p.Slug] = true
+	}
+}
+
+func TestGenerate_SameSeedSameData(t *testing.T) {
+	a, err := Generate(testOptions(4, 14))
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := Generate(testOptions(4, 14))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for i := range a.Matches {
+		assert.Equal(t, a.Matches[i].HomeScore, b.Matches[i].HomeScore)
+		assert.Equal(t, a.Matches[i].AwayScore, b.Matches[i].AwayScore)
+	}
+	for i := range a.Teams {
+		assert.Equal(t, a.Teams[i].Name, b.Teams[i].Name)
+	}
+}
+
+func TestOptions_Validate(t *testing.T) {
+	assert.Error(t, testOptions(1, 14).Validate())
+	assert.Error(t, testOptions(len(clubs)+1, 14).Validate())
+	assert.Error(t, testOptions(4, 10).Validate())
+	assert.Error(t, testOptions(4, MaxPlayersPerTeam+1).Validate())
+	assert.NoError(t, testOptions(4, 14).Validate())
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// AbsenceService defines the contract for player injuries and suspensions.
+type AbsenceService interface {
+	GetAllByPlayerID(ctx context.Context, playerID uuid.UUID, pagination dto.PaginationQuery) ([]dto.AbsenceResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.AbsenceResponse, error)
+	Create(ctx context.Context, playerID uuid.UUID, req dto.CreateAbsenceRequest) (*dto.AbsenceResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateAbsenceRequest) (*dto.AbsenceResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type absenceService struct {
+	absenceRepo repository.PlayerAbsenceRepository
+	playerRepo  repository.PlayerRepository
+}
+
+// NewAbsenceService creates a new AbsenceService instance.
+func NewAbsenceService(absenceRepo repository.PlayerAbsenceRepository, playerRepo repository.PlayerRepository) AbsenceService {
+	return &absenceService{
+		absenceRepo: absenceRepo,
+		playerRepo:  playerRepo,
+	}
+}
+
+// GetAllByPlayerID returns the player's absences, latest first.
+func (s *absenceService) GetAllByPlayerID(ctx context.Context, playerID uuid.UUID, pagination dto.PaginationQuery) ([]dto.AbsenceResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	// Verify player exists
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player", "error", err, "player_id", playerID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	absences, err := s.absenceRepo.FindAllByPlayerID(playerID, pagination.GetOffset(), pagination.PerPage)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch absences", "error", err, "player_id", playerID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.absenceRepo.CountByPlayerID(playerID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count absences", "error", err, "player_id", playerID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	absenceResponses := make([]dto.AbsenceResponse, len(absences))
+	for i, absence := range absences {
+		absenceResponses[i] = toAbsenceResponse(absence)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return absenceResponses, meta, nil
+}
+
+func (s *absenceService) GetByID(ctx context.Context, id uuid.UUID) (*dto.AbsenceResponse, error) {
+	absence, err := s.absenceRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Absence not found").WithCode(CodeAbsenceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch absence", "error", err, "absence_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toAbsenceResponse(*absence)
+	return &resp, nil
+}
+
+// Create records an injury or suspension for a player.
+func (s *absenceService) Create(ctx context.Context, playerID uuid.UUID, req dto.CreateAbsenceRequest) (*dto.AbsenceResponse, error) {
+	if err := validateDateRange("start_date", req.StartDate, "end_date", req.EndDate); err != nil {
+		return nil, err
+	}
+
+	// Verify player exists
+	if _, err := s.playerRepo.FindByID(playerID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player for absence creation", "error", err, "player_id", playerID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	absence := model.PlayerAbsence{
+		PlayerID:  playerID,
+		Type:      req.Type,
+		Reason:    req.Reason,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	}
+
+	if err := s.absenceRepo.Create(&absence); err != nil {
+		slog.ErrorContext(ctx, "failed to create absence", "error", err, "player_id", playerID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toAbsenceResponse(absence)
+	return &resp, nil
+}
+
+func (s *absenceService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateAbsenceRequest) (*dto.AbsenceResponse, error) {
+	if err := validateDateRange("start_date", req.StartDate, "end_date", req.EndDate); err != nil {
+		return nil, err
+	}
+
+	absence, err := s.absenceRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Absence not found").WithCode(CodeAbsenceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch absence for update", "error", err, "absence_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	absence.Type = req.Type
+	absence.Reason = req.Reason
+	absence.StartDate = req.StartDate
+	absence.EndDate = req.EndDate
+
+	if err := s.absenceRepo.Update(absence); err != nil {
+		slog.ErrorContext(ctx, "failed to update absence", "error", err, "absence_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toAbsenceResponse(*absence)
+	return &resp, nil
+}
+
+// Delete soft-deletes an absence, e.g. one recorded by mistake.
+func (s *absenceService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.absenceRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Absence not found").WithCode(CodeAbsenceNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch absence for delete", "error", err, "absence_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.absenceRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete absence", "error", err, "absence_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// toAbsenceResponse converts a model.PlayerAbsence to dto.AbsenceResponse.
+func toAbsenceResponse(absence model.PlayerAbsence) dto.AbsenceResponse {
+	return dto.AbsenceResponse{
+		ID:        absence.ID.String(),
+		PlayerID:  absence.PlayerID.String(),
+		Type:      absence.Type,
+		Reason:    absence.Reason,
+		StartDate: absence.StartDate,
+		EndDate:   absence.EndDate,
+		CreatedAt: timefmt.Format(absence.CreatedAt),
+		UpdatedAt: timefmt.Format(absence.UpdatedAt),
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAbsenceService_Create(t *testing.T) {
+	playerID := uuid.Must(uuid.NewV7())
+	player := &model.Player{Base: model.Base{ID: playerID}, Name: "Marko Simic"}
+
+	tests := []struct {
+		name        string
+		req         dto.CreateAbsenceRequest
+		setup       func(*mocks.MockPlayerAbsenceRepository, *mocks.MockPlayerRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "injury with return date",
+			req:  dto.CreateAbsenceRequest{Type: model.AbsenceTypeInjury, Reason: "Hamstring strain", StartDate: "2025-06-10", EndDate: "2025-06-30"},
+			setup: func(ar *mocks.MockPlayerAbsenceRepository, pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByID(playerID).Return(player, nil)
+				ar.EXPECT().Create(mock.AnythingOfType("*model.PlayerAbsence")).Return(nil)
+			},
+		},
+		{
+			name: "open-ended suspension",
+			req:  dto.CreateAbsenceRequest{Type: model.AbsenceTypeSuspension, StartDate: "2025-06-10"},
+			setup: func(ar *mocks.MockPlayerAbsenceRepository, pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByID(playerID).Return(player, nil)
+				ar.EXPECT().Create(mock.AnythingOfType("*model.PlayerAbsence")).Return(nil)
+			},
+		},
+		{
+			name:        "ends before it starts",
+			req:         dto.CreateAbsenceRequest{Type: model.AbsenceTypeInjury, StartDate: "2025-06-10", EndDate: "2025-06-09"},
+			setup:       func(ar *mocks.MockPlayerAbsenceRepository, pr *mocks.MockPlayerRepository) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "end_date must not be before start_date",
+		},
+		{
+			name:        "invalid start date",
+			req:         dto.CreateAbsenceRequest{Type: model.AbsenceTypeInjury, StartDate: "10/06/2025"},
+			setup:       func(ar *mocks.MockPlayerAbsenceRepository, pr *mocks.MockPlayerRepository) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Invalid start_date format",
+		},
+		{
+			name: "player not found",
+			req:  dto.CreateAbsenceRequest{Type: model.AbsenceTypeInjury, StartDate: "2025-06-10"},
+			setup: func(ar *mocks.MockPlayerAbsenceRepository, pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByID(playerID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Player not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			absenceRepo := mocks.NewMockPlayerAbsenceRepository(t)
+			playerRepo := mocks.NewMockPlayerRepository(t)
+			tt.setup(absenceRepo, playerRepo)
+			svc := NewAbsenceService(absenceRepo, playerRepo)
+
+			absence, err := svc.Create(context.Background(), playerID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, playerID.String(), absence.PlayerID)
+			assert.Equal(t, tt.req.EndDate, absence.EndDate)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// Actor is the authenticated admin a request is made by.
+type Actor struct {
+	AdminID uuid.UUID
+	Role    string
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the admin making the request.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFrom returns the admin stored in ctx, if any.
+func actorFrom(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// ensureManagesTeam returns a 403 error unless the admin in ctx may manage one of teamIDs.
+// Only team managers are restricted, to the teams assigned to them; other roles, and calls
+// made outside a request, are allowed through. message is returned to refused admins.
+func ensureManagesTeam(ctx context.Context, managerRepo repository.TeamManagerRepository, message string, teamIDs ...uuid.UUID) error {
+	actor, ok := actorFrom(ctx)
+	if !ok || actor.Role != model.RoleTeamManager {
+		return nil
+	}
+
+	managed, err := managerRepo.FindTeamIDsByAdminID(actor.AdminID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch managed teams", "error", err, "admin_id", actor.AdminID)
+		return errs.ErrInternal("Internal server error")
+	}
+	for _, teamID := range teamIDs {
+		if slices.Contains(managed, teamID) {
+			return nil
+		}
+	}
+	return errs.ErrForbidden(message).WithCode(CodeTeamNotManaged)
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// AdminService defines the contract for admin account management.
+type AdminService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.AdminDetailResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.AdminDetailResponse, error)
+	Create(ctx context.Context, req dto.CreateAdminRequest) (*dto.AdminDetailResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateAdminRequest) (*dto.AdminDetailResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ChangePassword(ctx context.Context, adminID uuid.UUID, req dto.ChangePasswordRequest) error
+	Unlock(ctx context.Context, id uuid.UUID) error
+}
+
+type adminService struct {
+	adminRepo        repository.AdminRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	loginAttemptRepo repository.LoginAttemptRepository
+	managerRepo      repository.TeamManagerRepository
+	teamRepo         repository.TeamRepository
+	historyRepo      repository.PasswordHistoryRepository
+	policy           PasswordPolicy
+}
+
+// NewAdminService creates a new AdminService instance.
+// New passwords must follow policy and cannot repeat the admin's recent passwords.
+func NewAdminService(
+	adminRepo repository.AdminRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	loginAttemptRepo repository.LoginAttemptRepository,
+	managerRepo repository.TeamManagerRepository,
+	teamRepo repository.TeamRepository,
+	historyRepo repository.PasswordHistoryRepository,
+	policy PasswordPolicy,
+) AdminService {
+	return &adminService{
+		adminRepo:        adminRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		managerRepo:      managerRepo,
+		teamRepo:         teamRepo,
+		historyRepo:      historyRepo,
+		policy:           policy,
+	}
+}
+
+func (s *adminService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.AdminDetailResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	admins, err := s.adminRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch admins", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.adminRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count admins", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	adminIDs := make([]uuid.UUID, len(admins))
+	for i, admin := range admins {
+		adminIDs[i] = admin.ID
+	}
+	assignments, err := s.managerRepo.FindByAdminIDs(adminIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch managed teams", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+	managedTeams := make(map[uuid.UUID][]uuid.UUID)
+	for _, assignment := range assignments {
+		managedTeams[assignment.AdminID] = append(managedTeams[assignment.AdminID], assignment.TeamID)
+	}
+
+	adminResponses := make([]dto.AdminDetailResponse, len(admins))
+	for i, admin := range admins {
+		adminResponses[i] = toAdminDetailResponse(admin, managedTeams[admin.ID])
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return adminResponses, meta, nil
+}
+
+func (s *adminService) GetByID(ctx context.Context, id uuid.UUID) (*dto.AdminDetailResponse, error) {
+	admin, err := s.adminRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Admin not found").WithCode(CodeAdminNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch admin", "error", err, "admin_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	teamIDs, err := s.managerRepo.FindTeamIDsByAdminID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch managed teams", "error", err, "admin_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toAdminDetailResponse(*admin, teamIDs)
+	return &resp, nil
+}
+
+// Create adds a new admin account with a bcrypt-hashed password that follows the password policy.
+// Team managers are assigned the teams in req.TeamIDs.
+func (s *adminService) Create(ctx context.Context, req dto.CreateAdminRequest) (*dto.AdminDetailResponse, error) {
+	if err := s.policy.Validate(req.Password); err != nil {
+		return nil, err
+	}
+	if err := s.ensureUsernameAvailable(ctx, req.Username, uuid.Nil); err != nil {
+		return nil, err
+	}
+	teamIDs, err := s.resolveManagedTeams(ctx, req.Role, req.TeamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := s.policy.Hash(req.Password)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to hash admin password", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	admin := model.Admin{
+		Username: req.Username,
+		Password: hashedPassword,
+		Role:     req.Role,
+	}
+
+	if err := s.adminRepo.Create(&admin); err != nil {
+		slog.ErrorContext(ctx, "failed to create admin", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if len(teamIDs) > 0 {
+		if err := s.managerRepo.ReplaceByAdminID(admin.ID, teamIDs); err != nil {
+			slog.ErrorContext(ctx, "failed to assign managed teams", "error", err, "admin_id", admin.ID)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+	}
+
+	resp := toAdminDetailResponse(admin, teamIDs)
+	return &resp, nil
+}
+
+// Update changes an admin's username, role, managed teams and (optionally) password.
+// The last remaining super admin cannot be demoted.
+func (s *adminService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateAdminRequest) (*dto.AdminDetailResponse, error) {
+	admin, err := s.adminRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Admin not found").WithCode(CodeAdminNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch admin for update", "error", err, "admin_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if req.Username != admin.Username {
+		if err := s.ensureUsernameAvailable(ctx, req.Username, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if admin.Role == model.RoleSuperAdmin && req.Role != model.RoleSuperAdmin {
+		if err := s.ensureNotLastSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	teamIDs, err := s.resolveManagedTeams(ctx, req.Role, req.TeamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	previousHash := admin.Password
+	passwordChanged := req.Password != ""
+	if passwordChanged {
+		if err := s.setPassword(ctx, admin, req.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	admin.Username = req.Username
+	admin.Role = req.Role
+
+	if err := s.adminRepo.Update(admin); err != nil {
+		slog.ErrorContext(ctx, "failed to update admin", "error", err, "admin_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	// Also clears the teams of an admin who is no longer a team manager
+	if err := s.managerRepo.ReplaceByAdminID(id, teamIDs); err != nil {
+		slog.ErrorContext(ctx, "failed to assign managed teams", "error", err, "admin_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	// Force re-login so new credentials and role take effect on every session
+	if passwordChanged {
+		s.recordPreviousPassword(ctx, id, previousHash)
+		s.revokeSessions(ctx, id)
+	}
+
+	resp := toAdminDetailResponse(*admin, teamIDs)
+	return &resp, nil
+}
+
+// Delete removes an admin account and its refresh tokens.
+// The last remaining super admin cannot be deleted.
+func (s *adminService) Delete(ctx context.Context, id uuid.UUID) error {
+	admin, err := s.adminRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Admin not found").WithCode(CodeAdminNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch admin for delete", "error", err, "admin_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if admin.Role == model.RoleSuperAdmin {
+		if err := s.ensureNotLastSuperAdmin(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.refreshTokenRepo.DeleteByAdminID(id); err != nil {
+		slog.ErrorContext(ctx, "failed to revoke refresh tokens for deleted admin", "error", err, "admin_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.adminRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete admin", "error", err, "admin_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// ChangePassword lets an authenticated admin change their own password.
+// The current password must be supplied and the new one must follow the password policy.
+// A pending forced change is cleared; all existing sessions are revoked afterwards.
+func (s *adminService) ChangePassword(ctx context.Context, adminID uuid.UUID, req dto.ChangePasswordRequest) error {
+	admin, err := s.adminRepo.FindByID(adminID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Admin not found").WithCode(CodeAdminNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch admin for password change", "error", err, "admin_id", adminID)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(req.CurrentPassword)); err != nil {
+		return errs.ErrBadRequest("Current password is incorrect").WithCode(CodePasswordIncorrect)
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return errs.ErrBadRequest("New password must be different from the current password").WithCode(CodePasswordReused)
+	}
+
+	previousHash := admin.Password
+	if err := s.setPassword(ctx, admin, req.NewPassword); err != nil {
+		return err
+	}
+	admin.MustChangePassword = false
+
+	if err := s.adminRepo.Update(admin); err != nil {
+		slog.ErrorContext(ctx, "failed to update admin password", "error", err, "admin_id", adminID)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	s.recordPreviousPassword(ctx, adminID, previousHash)
+	s.revokeSessions(ctx, adminID)
+	return nil
+}
+
+// Unlock lifts a login lockout and resets the failed login counter of an admin.
+func (s *adminService) Unlock(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.adminRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Admin not found").WithCode(CodeAdminNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch admin for unlock", "error", err, "admin_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.loginAttemptRepo.DeleteByAdminID(id); err != nil {
+		slog.ErrorContext(ctx, "failed to reset login attempts", "error", err, "admin_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// ensureUsernameAvailable returns a 409 error if the username belongs to another admin.
+// exceptID is the admin being updated (uuid.Nil when creating).
+func (s *adminService) ensureUsernameAvailable(ctx context.Context, username string, exceptID uuid.UUID) error {
+	existing, err := s.adminRepo.FindByUsername(username)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "failed to check username uniqueness", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	if existing != nil && existing.ID != exceptID {
+		return errs.ErrConflict("Username already taken").WithCode(CodeUsernameTaken)
+	}
+	return nil
+}
+
+// resolveManagedTeams parses the teams assigned to an admin with the given role.
+// Only team managers can have teams, and every team must exist.
+func (s *adminService) resolveManagedTeams(ctx context.Context, role string, rawIDs []string) ([]uuid.UUID, error) {
+	if role != model.RoleTeamManager {
+		if len(rawIDs) > 0 {
+			return nil, errs.ErrBadRequest("team_ids can only be set for team managers")
+		}
+		return nil, nil
+	}
+
+	teamIDs := make([]uuid.UUID, 0, len(rawIDs))
+	for _, raw := range rawIDs {
+		teamID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, errs.ErrBadRequest("Invalid team_ids format")
+		}
+		if !slices.Contains(teamIDs, teamID) {
+			teamIDs = append(teamIDs, teamID)
+		}
+	}
+
+	teams, err := s.teamRepo.FindByIDs(teamIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch teams for admin", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	for _, teamID := range teamIDs {
+		// FindByIDs includes soft-deleted teams, which cannot be assigned
+		if !slices.ContainsFunc(teams, func(team model.Team) bool { return team.ID == teamID && !team.DeletedAt.Valid }) {
+			return nil, errs.ErrNotFound(fmt.Sprintf("Team %s not found", teamID))
+		}
+	}
+	return teamIDs, nil
+}
+
+// setPassword replaces the admin's password hash in memory, after checking the new password
+// against the password policy, the current password and the policy's number of previous ones.
+func (s *adminService) setPassword(ctx context.Context, admin *model.Admin, password string) error {
+	if err := s.policy.Validate(password); err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)) == nil {
+		return errs.ErrBadRequest("New password must be different from the current password").WithCode(CodePasswordReused)
+	}
+
+	previous, err := s.historyRepo.FindRecentByAdminID(admin.ID, s.policy.History)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch password history", "error", err, "admin_id", admin.ID)
+		return errs.ErrInternal("Internal server error")
+	}
+	for _, entry := range previous {
+		if bcrypt.CompareHashAndPassword([]byte(entry.Hash), []byte(password)) == nil {
+			return errs.ErrBadRequest(fmt.Sprintf("New password must not be one of the last %d passwords", s.policy.History)).WithCode(CodePasswordReused)
+		}
+	}
+
+	hashedPassword, err := s.policy.Hash(password)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to hash admin password", "error", err, "admin_id", admin.ID)
+		return errs.ErrInternal("Internal server error")
+	}
+	now := time.Now()
+	admin.Password = hashedPassword
+	admin.PasswordChangedAt = &now
+	return nil
+}
+
+// recordPreviousPassword adds a replaced password hash to the admin's history and drops entries
+// beyond the policy's limit. Failures are logged but not returned because the new password has
+// already been saved.
+func (s *adminService) recordPreviousPassword(ctx context.Context, adminID uuid.UUID, hash string) {
+	if s.policy.History == 0 {
+		return
+	}
+	if err := s.historyRepo.Create(&model.PasswordHistory{AdminID: adminID, Hash: hash}); err != nil {
+		slog.WarnContext(ctx, "failed to record password history", "error", err, "admin_id", adminID)
+		return
+	}
+	if err := s.historyRepo.Prune(adminID, s.policy.History); err != nil {
+		slog.WarnContext(ctx, "failed to prune password history", "error", err, "admin_id", adminID)
+	}
+}
+
+// ensureNotLastSuperAdmin returns a 409 error if only one super admin remains.
+func (s *adminService) ensureNotLastSuperAdmin(ctx context.Context) error {
+	count, err := s.adminRepo.CountByRole(model.RoleSuperAdmin)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count super admins", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	if count <= 1 {
+		return errs.ErrConflict("Cannot remove or demote the last super admin").WithCode(CodeLastSuperAdmin)
+	}
+	return nil
+}
+
+// revokeSessions deletes all refresh tokens for an admin. Failures are logged but not returned
+// because the credential change itself has already been persisted.
+func (s *adminService) revokeSessions(ctx context.Context, adminID uuid.UUID) {
+	if err := s.refreshTokenRepo.DeleteByAdminID(adminID); err != nil {
+		slog.WarnContext(ctx, "failed to revoke refresh tokens", "error", err, "admin_id", adminID)
+	}
+}
+
+// toAdminDetailResponse converts a model.Admin and the teams it manages to dto.AdminDetailResponse.
+func toAdminDetailResponse(admin model.Admin, teamIDs []uuid.UUID) dto.AdminDetailResponse {
+	managed := make([]string, len(teamIDs))
+	for i, teamID := range teamIDs {
+		managed[i] = teamID.String()
+	}
+	return dto.AdminDetailResponse{
+		ID:                 admin.ID.String(),
+		Username:           admin.Username,
+		Role:               admin.Role,
+		TeamIDs:            managed,
+		MustChangePassword: admin.MustChangePassword,
+		CreatedAt:          timefmt.Format(admin.CreatedAt),
+		UpdatedAt:          timefmt.Format(admin.UpdatedAt),
+	}
+}
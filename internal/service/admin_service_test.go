@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// testPasswordPolicy mirrors the default policy, with a cheap bcrypt cost.
+var testPasswordPolicy = PasswordPolicy{MinLength: 8, RequireLower: true, RequireDigit: true, History: 3, BcryptCost: bcrypt.MinCost}
+
+func TestAdminService_Create(t *testing.T) {
+	existingID := uuid.Must(uuid.NewV7())
+	teamID := uuid.Must(uuid.NewV7())
+	deletedTeamID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		req         dto.CreateAdminRequest
+		setup       func(*mocks.MockAdminRepository, *mocks.MockTeamManagerRepository, *mocks.MockTeamRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			req:  dto.CreateAdminRequest{Username: "editor1", Password: "s3cure-passw0rd", Role: model.RoleEditor},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+				ar.EXPECT().FindByUsername("editor1").Return(nil, gorm.ErrRecordNotFound)
+				ar.EXPECT().Create(mock.MatchedBy(func(a *model.Admin) bool {
+					return a.Role == model.RoleEditor &&
+						bcrypt.CompareHashAndPassword([]byte(a.Password), []byte("s3cure-passw0rd")) == nil
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "team manager with teams",
+			req:  dto.CreateAdminRequest{Username: "persija", Password: "s3cure-passw0rd", Role: model.RoleTeamManager, TeamIDs: []string{teamID.String(), teamID.String()}},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+				ar.EXPECT().FindByUsername("persija").Return(nil, gorm.ErrRecordNotFound)
+				tr.EXPECT().FindByIDs([]uuid.UUID{teamID}).Return([]model.Team{{Base: model.Base{ID: teamID}}}, nil)
+				ar.EXPECT().Create(mock.AnythingOfType("*model.Admin")).Return(nil)
+				mr.EXPECT().ReplaceByAdminID(mock.Anything, []uuid.UUID{teamID}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "teams for another role",
+			req:  dto.CreateAdminRequest{Username: "editor1", Password: "s3cure-passw0rd", Role: model.RoleEditor, TeamIDs: []string{teamID.String()}},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+				ar.EXPECT().FindByUsername("editor1").Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "team_ids can only be set for team managers",
+		},
+		{
+			name: "deleted team",
+			req:  dto.CreateAdminRequest{Username: "persija", Password: "s3cure-passw0rd", Role: model.RoleTeamManager, TeamIDs: []string{deletedTeamID.String()}},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+				ar.EXPECT().FindByUsername("persija").Return(nil, gorm.ErrRecordNotFound)
+				tr.EXPECT().FindByIDs([]uuid.UUID{deletedTeamID}).Return([]model.Team{
+					{Base: model.Base{ID: deletedTeamID, DeletedAt: gorm.DeletedAt{Valid: true}}},
+				}, nil)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "not found",
+		},
+		{
+			name: "password breaks the policy",
+			req:  dto.CreateAdminRequest{Username: "editor1", Password: "Short", Role: model.RoleEditor},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Password must be at least 8 characters long, contain a digit",
+		},
+		{
+			name: "username taken",
+			req:  dto.CreateAdminRequest{Username: "admin", Password: "s3cure-passw0rd", Role: model.RoleViewer},
+			setup: func(ar *mocks.MockAdminRepository, mr *mocks.MockTeamManagerRepository, tr *mocks.MockTeamRepository) {
+				ar.EXPECT().FindByUsername("admin").Return(&model.Admin{Base: model.Base{ID: existingID}, Username: "admin"}, nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "Username already taken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+			managerRepo := mocks.NewMockTeamManagerRepository(t)
+			teamRepo := mocks.NewMockTeamRepository(t)
+			tt.setup(adminRepo, managerRepo, teamRepo)
+			svc := NewAdminService(adminRepo, refreshRepo, mocks.NewMockLoginAttemptRepository(t), managerRepo, teamRepo, mocks.NewMockPasswordHistoryRepository(t), testPasswordPolicy)
+
+			result, err := svc.Create(context.Background(), tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.Username, result.Username)
+				assert.Equal(t, tt.req.Role, result.Role)
+			}
+		})
+	}
+}
+
+func TestAdminService_Update(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	newSuperAdmin := func() *model.Admin {
+		return &model.Admin{Base: model.Base{ID: adminID}, Username: "root", Role: model.RoleSuperAdmin}
+	}
+
+	tests := []struct {
+		name        string
+		req         dto.UpdateAdminRequest
+		setup       func(*mocks.MockAdminRepository, *mocks.MockRefreshTokenRepository, *mocks.MockTeamManagerRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "demote super admin when others remain",
+			req:  dto.UpdateAdminRequest{Username: "root", Role: model.RoleEditor},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, mr *mocks.MockTeamManagerRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newSuperAdmin(), nil)
+				ar.EXPECT().CountByRole(model.RoleSuperAdmin).Return(int64(2), nil)
+				ar.EXPECT().Update(mock.AnythingOfType("*model.Admin")).Return(nil)
+				mr.EXPECT().ReplaceByAdminID(adminID, []uuid.UUID(nil)).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "cannot demote last super admin",
+			req:  dto.UpdateAdminRequest{Username: "root", Role: model.RoleViewer},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, mr *mocks.MockTeamManagerRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newSuperAdmin(), nil)
+				ar.EXPECT().CountByRole(model.RoleSuperAdmin).Return(int64(1), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "last super admin",
+		},
+		{
+			name: "password reset revokes sessions",
+			req:  dto.UpdateAdminRequest{Username: "root", Password: "n3w-s3cure-passw0rd", Role: model.RoleSuperAdmin},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, mr *mocks.MockTeamManagerRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newSuperAdmin(), nil)
+				ar.EXPECT().Update(mock.AnythingOfType("*model.Admin")).Return(nil)
+				mr.EXPECT().ReplaceByAdminID(adminID, []uuid.UUID(nil)).Return(nil)
+				rr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			req:  dto.UpdateAdminRequest{Username: "root", Role: model.RoleEditor},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, mr *mocks.MockTeamManagerRepository) {
+				ar.EXPECT().FindByID(adminID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Admin not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+			managerRepo := mocks.NewMockTeamManagerRepository(t)
+			historyRepo := mocks.NewMockPasswordHistoryRepository(t)
+			historyRepo.EXPECT().FindRecentByAdminID(adminID, 3).Return([]model.PasswordHistory{}, nil).Maybe()
+			historyRepo.EXPECT().Create(mock.AnythingOfType("*model.PasswordHistory")).Return(nil).Maybe()
+			historyRepo.EXPECT().Prune(adminID, 3).Return(nil).Maybe()
+			tt.setup(adminRepo, refreshRepo, managerRepo)
+			svc := NewAdminService(adminRepo, refreshRepo, mocks.NewMockLoginAttemptRepository(t), managerRepo, mocks.NewMockTeamRepository(t), historyRepo, testPasswordPolicy)
+
+			result, err := svc.Update(context.Background(), adminID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.Role, result.Role)
+			}
+		})
+	}
+}
+
+func TestAdminService_Delete(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockAdminRepository, *mocks.MockRefreshTokenRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository) {
+				ar.EXPECT().FindByID(adminID).Return(&model.Admin{Base: model.Base{ID: adminID}, Role: model.RoleEditor}, nil)
+				rr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+				ar.EXPECT().Delete(adminID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "cannot delete last super admin",
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository) {
+				ar.EXPECT().FindByID(adminID).Return(&model.Admin{Base: model.Base{ID: adminID}, Role: model.RoleSuperAdmin}, nil)
+				ar.EXPECT().CountByRole(model.RoleSuperAdmin).Return(int64(1), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "last super admin",
+		},
+		{
+			name: "not found",
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository) {
+				ar.EXPECT().FindByID(adminID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Admin not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+			tt.setup(adminRepo, refreshRepo)
+			svc := NewAdminService(adminRepo, refreshRepo, mocks.NewMockLoginAttemptRepository(t), mocks.NewMockTeamManagerRepository(t), mocks.NewMockTeamRepository(t), mocks.NewMockPasswordHistoryRepository(t), testPasswordPolicy)
+
+			err := svc.Delete(context.Background(), adminID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAdminService_ChangePassword(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	hashedPw, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	oldPw, _ := bcrypt.GenerateFromPassword([]byte("0ld-s3cure-passw0rd"), bcrypt.MinCost)
+	newAdmin := func() *model.Admin {
+		return &model.Admin{Base: model.Base{ID: adminID}, Username: "admin", Password: string(hashedPw), MustChangePassword: true}
+	}
+
+	tests := []struct {
+		name        string
+		req         dto.ChangePasswordRequest
+		setup       func(*mocks.MockAdminRepository, *mocks.MockRefreshTokenRepository, *mocks.MockPasswordHistoryRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			req:  dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "n3w-s3cure-passw0rd"},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, hr *mocks.MockPasswordHistoryRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newAdmin(), nil)
+				hr.EXPECT().FindRecentByAdminID(adminID, 3).Return([]model.PasswordHistory{{AdminID: adminID, Hash: string(oldPw)}}, nil)
+				ar.EXPECT().Update(mock.MatchedBy(func(a *model.Admin) bool {
+					return !a.MustChangePassword && a.PasswordChangedAt != nil &&
+						bcrypt.CompareHashAndPassword([]byte(a.Password), []byte("n3w-s3cure-passw0rd")) == nil
+				})).Return(nil)
+				hr.EXPECT().Create(&model.PasswordHistory{AdminID: adminID, Hash: string(hashedPw)}).Return(nil)
+				hr.EXPECT().Prune(adminID, 3).Return(nil)
+				rr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong current password",
+			req:  dto.ChangePasswordRequest{CurrentPassword: "wrong-password", NewPassword: "n3w-s3cure-passw0rd"},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, hr *mocks.MockPasswordHistoryRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newAdmin(), nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Current password is incorrect",
+		},
+		{
+			name: "new password same as current",
+			req:  dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "password123"},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, hr *mocks.MockPasswordHistoryRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newAdmin(), nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "must be different",
+		},
+		{
+			name: "new password breaks the policy",
+			req:  dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "no-digits-here"},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, hr *mocks.MockPasswordHistoryRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newAdmin(), nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Password must contain a digit",
+		},
+		{
+			name: "recently used password",
+			req:  dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "0ld-s3cure-passw0rd"},
+			setup: func(ar *mocks.MockAdminRepository, rr *mocks.MockRefreshTokenRepository, hr *mocks.MockPasswordHistoryRepository) {
+				ar.EXPECT().FindByID(adminID).Return(newAdmin(), nil)
+				hr.EXPECT().FindRecentByAdminID(adminID, 3).Return([]model.PasswordHistory{{AdminID: adminID, Hash: string(oldPw)}}, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "must not be one of the last 3 passwords",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+			historyRepo := mocks.NewMockPasswordHistoryRepository(t)
+			tt.setup(adminRepo, refreshRepo, historyRepo)
+			svc := NewAdminService(adminRepo, refreshRepo, mocks.NewMockLoginAttemptRepository(t), mocks.NewMockTeamManagerRepository(t), mocks.NewMockTeamRepository(t), historyRepo, testPasswordPolicy)
+
+			err := svc.ChangePassword(context.Background(), adminID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAdminService_Unlock(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockAdminRepository, *mocks.MockLoginAttemptRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(ar *mocks.MockAdminRepository, lr *mocks.MockLoginAttemptRepository) {
+				ar.EXPECT().FindByID(adminID).Return(&model.Admin{Base: model.Base{ID: adminID}}, nil)
+				lr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			setup: func(ar *mocks.MockAdminRepository, lr *mocks.MockLoginAttemptRepository) {
+				ar.EXPECT().FindByID(adminID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Admin not found",
+		},
+		{
+			name: "db error on reset",
+			setup: func(ar *mocks.MockAdminRepository, lr *mocks.MockLoginAttemptRepository) {
+				ar.EXPECT().FindByID(adminID).Return(&model.Admin{Base: model.Base{ID: adminID}}, nil)
+				lr.EXPECT().DeleteByAdminID(adminID).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			attemptRepo := mocks.NewMockLoginAttemptRepository(t)
+			tt.setup(adminRepo, attemptRepo)
+			svc := NewAdminService(adminRepo, mocks.NewMockRefreshTokenRepository(t), attemptRepo, mocks.NewMockTeamManagerRepository(t), mocks.NewMockTeamRepository(t), mocks.NewMockPasswordHistoryRepository(t), testPasswordPolicy)
+
+			err := svc.Unlock(context.Background(), adminID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
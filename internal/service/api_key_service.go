@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+const (
+	// apiKeyPrefix marks values as keys of this API, so leaked keys are easy to recognize.
+	apiKeyPrefix = "xyz_"
+	// apiKeyDisplayLength is how many leading characters of a key are stored to tell keys apart.
+	apiKeyDisplayLength = 12
+	// apiKeyTouchInterval limits how often last_used_at is written for a busy key.
+	apiKeyTouchInterval = time.Minute
+)
+
+// APIKeyService defines the contract for managing and authenticating API keys.
+type APIKeyService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.APIKeyResponse, *response.PaginationMeta, error)
+	Create(ctx context.Context, adminID uuid.UUID, req dto.CreateAPIKeyRequest) (*dto.APIKeyCreatedResponse, error)
+	Revoke(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, rawKey string) (*model.APIKey, error)
+}
+
+type apiKeyService struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+// NewAPIKeyService creates a new APIKeyService instance.
+func NewAPIKeyService(apiKeyRepo repository.APIKeyRepository) APIKeyService {
+	return &apiKeyService{apiKeyRepo: apiKeyRepo}
+}
+
+func (s *apiKeyService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.APIKeyResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	keys, err := s.apiKeyRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch api keys", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.apiKeyRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count api keys", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	now := time.Now()
+	keyResponses := make([]dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		keyResponses[i] = toAPIKeyResponse(key, now)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return keyResponses, meta, nil
+}
+
+// Create issues a new key. The plain key is only returned here; just its hash is stored.
+func (s *apiKeyService) Create(ctx context.Context, adminID uuid.UUID, req dto.CreateAPIKeyRequest) (*dto.APIKeyCreatedResponse, error) {
+	rawKey, err := generateAPIKey()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate api key", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	// Keep scopes unique and in a stable order regardless of how they were sent
+	scopes := slices.Clone(req.Scopes)
+	slices.Sort(scopes)
+	scopes = slices.Compact(scopes)
+
+	key := model.APIKey{
+		Name:      req.Name,
+		Prefix:    rawKey[:apiKeyDisplayLength],
+		KeyHash:   hashAPIKey(rawKey),
+		Scopes:    strings.Join(scopes, ","),
+		CreatedBy: adminID,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := s.apiKeyRepo.Create(&key); err != nil {
+		slog.ErrorContext(ctx, "failed to create api key", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return &dto.APIKeyCreatedResponse{
+		Key:    rawKey,
+		APIKey: toAPIKeyResponse(key, time.Now()),
+	}, nil
+}
+
+// Revoke disables a key permanently. Revoking an already revoked key is a no-op.
+func (s *apiKeyService) Revoke(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.apiKeyRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("API key not found").WithCode(CodeAPIKeyNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch api key for revoke", "error", err, "api_key_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.apiKeyRepo.Revoke(id, time.Now()); err != nil {
+		slog.ErrorContext(ctx, "failed to revoke api key", "error", err, "api_key_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// Authenticate resolves a plain key to an active API key and records its use.
+func (s *apiKeyService) Authenticate(ctx context.Context, rawKey string) (*model.APIKey, error) {
+	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
+		return nil, errs.ErrUnauthorized("Invalid API key").WithCode(CodeInvalidAPIKey)
+	}
+
+	key, err := s.apiKeyRepo.FindByHash(hashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrUnauthorized("Invalid API key").WithCode(CodeInvalidAPIKey)
+		}
+		slog.ErrorContext(ctx, "failed to fetch api key", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	now := time.Now()
+	if !key.IsActive(now) {
+		return nil, errs.ErrUnauthorized("API key is revoked or expired").WithCode(CodeInvalidAPIKey)
+	}
+
+	// Usage tracking is informational, so a failed write does not reject the request
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.apiKeyRepo.TouchLastUsed(key.ID, now); err != nil {
+			slog.WarnContext(ctx, "failed to record api key usage", "error", err, "api_key_id", key.ID)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
+
+// generateAPIKey returns a new key: the "xyz_" prefix followed by 32 random bytes encoded as hex.
+func generateAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(b), nil
+}
+
+// hashAPIKey returns the hex-encoded SHA-256 hash under which a key is stored.
+// Keys are long random values, so a fast unsalted hash is sufficient.
+func hashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// toAPIKeyResponse converts a model.APIKey to dto.APIKeyResponse.
+func toAPIKeyResponse(key model.APIKey, now time.Time) dto.APIKeyResponse {
+	resp := dto.APIKeyResponse{
+		ID:        key.ID.String(),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.ScopeList(),
+		CreatedBy: key.CreatedBy.String(),
+		Active:    key.IsActive(now),
+		CreatedAt: timefmt.Format(key.CreatedAt),
+	}
+	if key.ExpiresAt != nil {
+		resp.ExpiresAt = timefmt.Format(*key.ExpiresAt)
+	}
+	if key.LastUsedAt != nil {
+		resp.LastUsedAt = timefmt.Format(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		resp.RevokedAt = timefmt.Format(*key.RevokedAt)
+	}
+	return resp
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestAPIKeyService(t *testing.T) (*apiKeyService, *mocks.MockAPIKeyRepository) {
+	apiKeyRepo := mocks.NewMockAPIKeyRepository(t)
+	svc := &apiKeyService{apiKeyRepo: apiKeyRepo}
+	return svc, apiKeyRepo
+}
+
+func TestAPIKeyService_Create(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		req         dto.CreateAPIKeyRequest
+		setup       func(*mocks.MockAPIKeyRepository)
+		wantErr     bool
+		errContains string
+		wantScopes  []string
+		wantExpiry  bool
+	}{
+		{
+			name: "success without expiry",
+			req:  dto.CreateAPIKeyRequest{Name: "Scoreboard", Scopes: []string{model.ScopeMatchesRead}},
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().Create(mock.MatchedBy(func(k *model.APIKey) bool {
+					return k.Name == "Scoreboard" &&
+						k.CreatedBy == adminID &&
+						k.ExpiresAt == nil &&
+						len(k.KeyHash) == 64 &&
+						strings.HasPrefix(k.Prefix, apiKeyPrefix)
+				})).Return(nil)
+			},
+			wantErr:    false,
+			wantScopes: []string{model.ScopeMatchesRead},
+		},
+		{
+			name: "scopes are deduplicated and sorted, expiry set",
+			req: dto.CreateAPIKeyRequest{
+				Name:          "Partner",
+				Scopes:        []string{model.ScopeTeamsRead, model.ScopeMatchesRead, model.ScopeTeamsRead},
+				ExpiresInDays: 30,
+			},
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().Create(mock.MatchedBy(func(k *model.APIKey) bool {
+					return k.Scopes == "matches:read,teams:read" && k.ExpiresAt != nil
+				})).Return(nil)
+			},
+			wantErr:    false,
+			wantScopes: []string{model.ScopeMatchesRead, model.ScopeTeamsRead},
+			wantExpiry: true,
+		},
+		{
+			name: "db error",
+			req:  dto.CreateAPIKeyRequest{Name: "Scoreboard", Scopes: []string{model.ScopeMatchesRead}},
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().Create(mock.AnythingOfType("*model.APIKey")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, apiKeyRepo := newTestAPIKeyService(t)
+			tt.setup(apiKeyRepo)
+
+			result, err := svc.Create(context.Background(), adminID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, strings.HasPrefix(result.Key, apiKeyPrefix))
+				assert.Equal(t, result.Key[:apiKeyDisplayLength], result.APIKey.Prefix)
+				assert.Equal(t, tt.wantScopes, result.APIKey.Scopes)
+				assert.Equal(t, tt.wantExpiry, result.APIKey.ExpiresAt != "")
+				assert.True(t, result.APIKey.Active)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_Authenticate(t *testing.T) {
+	rawKey := apiKeyPrefix + strings.Repeat("ab", 32)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	recent := time.Now().Add(-10 * time.Second)
+
+	tests := []struct {
+		name        string
+		rawKey      string
+		setup       func(*mocks.MockAPIKeyRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name:   "valid key records usage",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				key := &model.APIKey{Scopes: model.ScopeMatchesRead, ExpiresAt: &future}
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(key, nil)
+				ar.EXPECT().TouchLastUsed(key.ID, mock.AnythingOfType("time.Time")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "recently used key is not touched again",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(&model.APIKey{LastUsedAt: &recent}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "usage tracking failure does not reject the key",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(&model.APIKey{}, nil)
+				ar.EXPECT().TouchLastUsed(mock.Anything, mock.Anything).Return(gorm.ErrInvalidDB)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "malformed key",
+			rawKey:      "not-a-key",
+			setup:       func(ar *mocks.MockAPIKeyRepository) {},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "Invalid API key",
+		},
+		{
+			name:   "unknown key",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "Invalid API key",
+		},
+		{
+			name:   "revoked key",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(&model.APIKey{RevokedAt: &past}, nil)
+			},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "revoked or expired",
+		},
+		{
+			name:   "expired key",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(&model.APIKey{ExpiresAt: &past}, nil)
+			},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "revoked or expired",
+		},
+		{
+			name:   "db error",
+			rawKey: rawKey,
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByHash(hashAPIKey(rawKey)).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, apiKeyRepo := newTestAPIKeyService(t)
+			tt.setup(apiKeyRepo)
+
+			key, err := svc.Authenticate(context.Background(), tt.rawKey)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, key)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_Revoke(t *testing.T) {
+	id := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockAPIKeyRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByID(id).Return(&model.APIKey{}, nil)
+				ar.EXPECT().Revoke(id, mock.AnythingOfType("time.Time")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByID(id).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "API key not found",
+		},
+		{
+			name: "db error on revoke",
+			setup: func(ar *mocks.MockAPIKeyRepository) {
+				ar.EXPECT().FindByID(id).Return(&model.APIKey{}, nil)
+				ar.EXPECT().Revoke(id, mock.AnythingOfType("time.Time")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, apiKeyRepo := newTestAPIKeyService(t)
+			tt.setup(apiKeyRepo)
+
+			err := svc.Revoke(context.Background(), id)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
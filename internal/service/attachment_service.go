@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// MaxAttachmentSize is the largest accepted match attachment, in bytes.
+const MaxAttachmentSize = 4 << 20
+
+// attachmentExtensions maps the accepted attachment types, as detected from the file contents,
+// to the extension their files are stored with.
+var attachmentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// AttachmentFile is a downloaded match attachment.
+type AttachmentFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// AttachmentService defines the contract for documents filed with matches.
+type AttachmentService interface {
+	List(ctx context.Context, matchID uuid.UUID) ([]dto.MatchAttachmentResponse, error)
+	Upload(ctx context.Context, matchID uuid.UUID, fileName string, data []byte) (*dto.MatchAttachmentResponse, error)
+	Download(ctx context.Context, matchID, attachmentID uuid.UUID) (*AttachmentFile, error)
+	Delete(ctx context.Context, matchID, attachmentID uuid.UUID) error
+}
+
+type attachmentService struct {
+	matchRepo      repository.MatchRepository
+	attachmentRepo repository.MatchAttachmentRepository
+	files          storage.Store
+}
+
+// NewAttachmentService creates a new AttachmentService instance. files should be private
+// storage: attachments are only handed out through Download.
+func NewAttachmentService(matchRepo repository.MatchRepository, attachmentRepo repository.MatchAttachmentRepository, files storage.Store) AttachmentService {
+	return &attachmentService{
+		matchRepo:      matchRepo,
+		attachmentRepo: attachmentRepo,
+		files:          files,
+	}
+}
+
+// List returns the attachments of a match, oldest first.
+func (s *attachmentService) List(ctx context.Context, matchID uuid.UUID) ([]dto.MatchAttachmentResponse, error) {
+	if err := s.ensureMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch match attachments", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make([]dto.MatchAttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		result[i] = toAttachmentResponse(a)
+	}
+	return result, nil
+}
+
+// Upload stores a PDF, JPEG or PNG document for a match. The type is detected from the
+// contents rather than trusted from the file name or the client.
+func (s *attachmentService) Upload(ctx context.Context, matchID uuid.UUID, fileName string, data []byte) (*dto.MatchAttachmentResponse, error) {
+	if err := s.ensureMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, errs.ErrBadRequest("File is empty").WithCode(CodeInvalidAttachment)
+	}
+	if len(data) > MaxAttachmentSize {
+		return nil, errs.ErrBadRequest("Attachment must not be larger than 4 MB").WithCode(CodeInvalidAttachment)
+	}
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	ext, ok := attachmentExtensions[contentType]
+	if !ok {
+		return nil, errs.ErrBadRequest("Attachment must be a PDF, JPEG or PNG file").WithCode(CodeInvalidAttachment)
+	}
+
+	attachment := &model.MatchAttachment{
+		Base:        model.Base{ID: uuid.Must(uuid.NewV7())},
+		MatchID:     matchID,
+		FileName:    attachmentFileName(fileName, ext),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}
+	attachment.StorageKey = "matches/" + matchID.String() + "/" + attachment.ID.String() + ext
+	if actor, ok := actorFrom(ctx); ok {
+		attachment.UploadedBy = actor.AdminID
+	}
+
+	if _, err := s.files.Put(ctx, attachment.StorageKey, data, contentType); err != nil {
+		slog.ErrorContext(ctx, "failed to store match attachment", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if err := s.attachmentRepo.Create(attachment); err != nil {
+		slog.ErrorContext(ctx, "failed to create match attachment", "error", err, "match_id", matchID)
+		if err := s.files.Delete(ctx, attachment.StorageKey); err != nil {
+			slog.WarnContext(ctx, "failed to remove stored attachment", "error", err, "key", attachment.StorageKey)
+		}
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toAttachmentResponse(*attachment)
+	return &resp, nil
+}
+
+// Download returns the contents of an attachment of a match.
+func (s *attachmentService) Download(ctx context.Context, matchID, attachmentID uuid.UUID) (*AttachmentFile, error) {
+	attachment, err := s.find(ctx, matchID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.files.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			slog.ErrorContext(ctx, "stored match attachment is missing", "attachment_id", attachmentID, "key", attachment.StorageKey)
+			return nil, errs.ErrNotFound("Attachment not found").WithCode(CodeAttachmentNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to read match attachment", "error", err, "attachment_id", attachmentID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return &AttachmentFile{FileName: attachment.FileName, ContentType: attachment.ContentType, Data: data}, nil
+}
+
+// Delete removes an attachment of a match and its stored file.
+func (s *attachmentService) Delete(ctx context.Context, matchID, attachmentID uuid.UUID) error {
+	attachment, err := s.find(ctx, matchID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.Delete(attachment.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete match attachment", "error", err, "attachment_id", attachmentID)
+		return errs.ErrInternal("Internal server error")
+	}
+	// The record is gone, so a file left behind can no longer be reached
+	if err := s.files.Delete(ctx, attachment.StorageKey); err != nil {
+		slog.WarnContext(ctx, "failed to remove stored attachment", "error", err, "key", attachment.StorageKey)
+	}
+	return nil
+}
+
+// ensureMatch returns a 404 error unless the match exists.
+func (s *attachmentService) ensureMatch(ctx context.Context, matchID uuid.UUID) error {
+	if _, err := s.matchRepo.FindByID(matchID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for attachments", "error", err, "match_id", matchID)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// find returns an attachment, treating one filed with another match as missing.
+func (s *attachmentService) find(ctx context.Context, matchID, attachmentID uuid.UUID) (*model.MatchAttachment, error) {
+	attachment, err := s.attachmentRepo.FindByID(attachmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Attachment not found").WithCode(CodeAttachmentNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match attachment", "error", err, "attachment_id", attachmentID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if attachment.MatchID != matchID {
+		return nil, errs.ErrNotFound("Attachment not found").WithCode(CodeAttachmentNotFound)
+	}
+	return attachment, nil
+}
+
+// attachmentFileName cleans an uploaded file name for use in a download header: directories
+// and control characters are dropped and the extension matches the detected type.
+func attachmentFileName(name, ext string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(strings.TrimSuffix(name, path.Ext(name)))
+	if name == "" || name == "." || name == "/" {
+		name = "attachment"
+	}
+	if len(name) > 100 {
+		name = strings.ToValidUTF8(name[:100], "")
+	}
+	return name + ext
+}
+
+func toAttachmentResponse(a model.MatchAttachment) dto.MatchAttachmentResponse {
+	resp := dto.MatchAttachmentResponse{
+		ID:          a.ID.String(),
+		MatchID:     a.MatchID.String(),
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		DownloadURL: "/api/v1/matches/" + a.MatchID.String() + "/attachments/" + a.ID.String(),
+		CreatedAt:   timefmt.Format(a.CreatedAt),
+	}
+	if a.UploadedBy != uuid.Nil {
+		resp.UploadedBy = a.UploadedBy.String()
+	}
+	return resp
+}
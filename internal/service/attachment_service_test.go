@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// samplePDF is the start of a PDF file, enough for content type detection.
+var samplePDF = []byte("%PDF-1.7\n1 0 obj\n<< /Type /Catalog >>\nendobj\n")
+
+func newTestAttachmentService(t *testing.T) (*attachmentService, *mocks.MockMatchRepository, *mocks.MockMatchAttachmentRepository, *storage.Memory) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	attachmentRepo := mocks.NewMockMatchAttachmentRepository(t)
+	files := storage.NewMemory()
+	svc := &attachmentService{matchRepo: matchRepo, attachmentRepo: attachmentRepo, files: files}
+	return svc, matchRepo, attachmentRepo, files
+}
+
+func TestAttachmentService_Upload(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name         string
+		fileName     string
+		data         []byte
+		wantFileName string
+		wantErr      bool
+		errCode      int
+		errContains  string
+	}{
+		{
+			name:         "referee report",
+			fileName:     "Laporan Wasit.pdf",
+			data:         samplePDF,
+			wantFileName: "Laporan Wasit.pdf",
+		},
+		{
+			name:         "extension follows the detected type and directories are dropped",
+			fileName:     `C:\Users\wasit\report.txt`,
+			data:         samplePDF,
+			wantFileName: "report.pdf",
+		},
+		{
+			name:         "nameless upload",
+			fileName:     "",
+			data:         samplePDF,
+			wantFileName: "attachment.pdf",
+		},
+		{
+			name:        "unsupported type",
+			fileName:    "report.pdf",
+			data:        []byte("<html><script>alert(1)</script></html>"),
+			wantErr:     true,
+			errCode:     400,
+			errContains: "PDF, JPEG or PNG",
+		},
+		{
+			name:        "empty file",
+			fileName:    "report.pdf",
+			data:        []byte{},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "File is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, attachmentRepo, files := newTestAttachmentService(t)
+			matchRepo.EXPECT().FindByID(matchID).Return(&model.Match{Base: model.Base{ID: matchID}}, nil)
+			if !tt.wantErr {
+				attachmentRepo.EXPECT().Create(mock.MatchedBy(func(a *model.MatchAttachment) bool {
+					return a.MatchID == matchID && a.FileName == tt.wantFileName && a.ContentType == "application/pdf" &&
+						a.Size == int64(len(tt.data)) && a.UploadedBy == adminID
+				})).Return(nil)
+			}
+			ctx := WithActor(context.Background(), Actor{AdminID: adminID, Role: model.RoleEditor})
+
+			resp, err := svc.Upload(ctx, matchID, tt.fileName, tt.data)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFileName, resp.FileName)
+			assert.Equal(t, "/api/v1/matches/"+matchID.String()+"/attachments/"+resp.ID, resp.DownloadURL)
+			stored, err := files.Get(ctx, "matches/"+matchID.String()+"/"+resp.ID+".pdf")
+			require.NoError(t, err)
+			assert.Equal(t, tt.data, stored)
+		})
+	}
+}
+
+func TestAttachmentService_Upload_RemovesFileWhenRecordFails(t *testing.T) {
+	svc, matchRepo, attachmentRepo, files := newTestAttachmentService(t)
+	matchID := uuid.Must(uuid.NewV7())
+	matchRepo.EXPECT().FindByID(matchID).Return(&model.Match{Base: model.Base{ID: matchID}}, nil)
+	var key string
+	attachmentRepo.EXPECT().Create(mock.AnythingOfType("*model.MatchAttachment")).RunAndReturn(func(a *model.MatchAttachment) error {
+		key = a.StorageKey
+		return gorm.ErrInvalidDB
+	})
+
+	_, err := svc.Upload(context.Background(), matchID, "report.pdf", samplePDF)
+
+	assert.Error(t, err)
+	_, err = files.Get(context.Background(), key)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestAttachmentService_DownloadAndDelete(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	attachment := &model.MatchAttachment{
+		Base:        model.Base{ID: uuid.Must(uuid.NewV7())},
+		MatchID:     matchID,
+		FileName:    "report.pdf",
+		ContentType: "application/pdf",
+		StorageKey:  "matches/" + matchID.String() + "/report.pdf",
+	}
+
+	t.Run("download", func(t *testing.T) {
+		svc, _, attachmentRepo, files := newTestAttachmentService(t)
+		_, err := files.Put(context.Background(), attachment.StorageKey, samplePDF, "application/pdf")
+		require.NoError(t, err)
+		attachmentRepo.EXPECT().FindByID(attachment.ID).Return(attachment, nil)
+
+		file, err := svc.Download(context.Background(), matchID, attachment.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, "report.pdf", file.FileName)
+		assert.Equal(t, "application/pdf", file.ContentType)
+		assert.Equal(t, samplePDF, file.Data)
+	})
+
+	t.Run("attachment of another match", func(t *testing.T) {
+		svc, _, attachmentRepo, _ := newTestAttachmentService(t)
+		attachmentRepo.EXPECT().FindByID(attachment.ID).Return(attachment, nil)
+
+		_, err := svc.Download(context.Background(), uuid.Must(uuid.NewV7()), attachment.ID)
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeAttachmentNotFound, appErr.ErrorCode)
+	})
+
+	t.Run("delete removes the file", func(t *testing.T) {
+		svc, _, attachmentRepo, files := newTestAttachmentService(t)
+		_, err := files.Put(context.Background(), attachment.StorageKey, samplePDF, "application/pdf")
+		require.NoError(t, err)
+		attachmentRepo.EXPECT().FindByID(attachment.ID).Return(attachment, nil)
+		attachmentRepo.EXPECT().Delete(attachment.ID).Return(nil)
+
+		require.NoError(t, svc.Delete(context.Background(), matchID, attachment.ID))
+
+		_, err = files.Get(context.Background(), attachment.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
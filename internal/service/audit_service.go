@@ -0,0 +1,272 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// auditTables maps each audited entity to its database table.
+var auditTables = map[string]string{
+	model.AuditEntityTeam:        "teams",
+	model.AuditEntityPlayer:      "players",
+	model.AuditEntityMatch:       "matches",
+	model.AuditEntityCompetition: "competitions",
+	model.AuditEntitySeason:      "seasons",
+	model.AuditEntityGroup:       "season_groups",
+	model.AuditEntityStadium:     "stadiums",
+	model.AuditEntityReferee:     "referees",
+	model.AuditEntityCoach:       "coaches",
+	model.AuditEntityAbsence:     "player_absences",
+}
+
+// auditIgnoredColumns are bookkeeping columns left out of the change set.
+var auditIgnoredColumns = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+}
+
+// AuditEntry describes one mutation to be recorded. Before is the entity snapshot taken
+// before the mutation (nil for creates); the after snapshot is loaded by Record.
+type AuditEntry struct {
+	AdminID  uuid.UUID
+	Entity   string
+	EntityID uuid.UUID
+	Action   string
+	Before   map[string]any
+}
+
+// AuditService defines the contract for recording and reviewing the audit trail.
+type AuditService interface {
+	Snapshot(ctx context.Context, entity string, id uuid.UUID) map[string]any
+	Record(ctx context.Context, entry AuditEntry)
+	GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.AuditLogFilterQuery) ([]dto.AuditLogResponse, *response.PaginationMeta, error)
+	PurgeOlderThan(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+type auditService struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewAuditService creates a new AuditService instance.
+func NewAuditService(auditRepo repository.AuditLogRepository) AuditService {
+	return &auditService{auditRepo: auditRepo}
+}
+
+// Snapshot returns the current row of an entity, or nil if it does not exist or cannot be read.
+// Failures are logged rather than returned so auditing never blocks the request itself.
+func (s *auditService) Snapshot(ctx context.Context, entity string, id uuid.UUID) map[string]any {
+	table, ok := auditTables[entity]
+	if !ok {
+		slog.ErrorContext(ctx, "unknown audit entity", "entity", entity)
+		return nil
+	}
+
+	row, err := s.auditRepo.FindRow(table, id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			slog.ErrorContext(ctx, "failed to snapshot entity for audit", "error", err, "entity", entity, "entity_id", id)
+		}
+		return nil
+	}
+	return row
+}
+
+// Record stores an audit log entry with the entity's state after the mutation and the changed fields.
+// The mutation has already been committed, so failures are logged rather than returned.
+func (s *auditService) Record(ctx context.Context, entry AuditEntry) {
+	var after map[string]any
+	if entry.Action != model.AuditActionDelete {
+		after = s.Snapshot(ctx, entry.Entity, entry.EntityID)
+	}
+
+	log := model.AuditLog{
+		AdminID:  entry.AdminID,
+		Entity:   entry.Entity,
+		EntityID: entry.EntityID,
+		Action:   entry.Action,
+		Before:   marshalAuditJSON(ctx, entry.Before),
+		After:    marshalAuditJSON(ctx, after),
+		Changes:  marshalAuditJSON(ctx, auditChanges(entry.Before, after)),
+	}
+
+	if err := s.auditRepo.Create(&log); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit log", "error", err,
+			"admin_id", entry.AdminID, "entity", entry.Entity, "entity_id", entry.EntityID, "action", entry.Action)
+	}
+}
+
+// GetAll returns a paginated list of audit logs, newest first.
+func (s *auditService) GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.AuditLogFilterQuery) ([]dto.AuditLogResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	filter, err := parseAuditLogFilter(filterQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs, err := s.auditRepo.FindAll(filter, pagination.GetOffset(), pagination.PerPage)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch audit logs", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.auditRepo.Count(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count audit logs", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	logResponses := make([]dto.AuditLogResponse, len(logs))
+	for i, log := range logs {
+		logResponses[i] = toAuditLogResponse(log)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return logResponses, meta, nil
+}
+
+// PurgeOlderThan hard-deletes audit logs older than retention and returns how many were removed.
+func (s *auditService) PurgeOlderThan(ctx context.Context, retention time.Duration) (int64, error) {
+	deleted, err := s.auditRepo.DeleteBefore(time.Now().Add(-retention))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to purge old audit logs", "error", err)
+		return 0, errs.ErrInternal("Internal server error")
+	}
+	return deleted, nil
+}
+
+// parseAuditLogFilter converts validated query parameters into a repository filter.
+// The "to" date is inclusive, so the filter bound is the start of the following day.
+func parseAuditLogFilter(query dto.AuditLogFilterQuery) (repository.AuditLogFilter, error) {
+	filter := repository.AuditLogFilter{
+		Entity: query.Entity,
+		Action: query.Action,
+	}
+
+	if query.AdminID != "" {
+		id, err := uuid.Parse(query.AdminID)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid admin_id format")
+		}
+		filter.AdminID = &id
+	}
+	if query.EntityID != "" {
+		id, err := uuid.Parse(query.EntityID)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid entity_id format")
+		}
+		filter.EntityID = &id
+	}
+	if query.From != "" {
+		from, err := time.Parse("2006-01-02", query.From)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid from date, expected YYYY-MM-DD")
+		}
+		filter.From = &from
+	}
+	if query.To != "" {
+		to, err := time.Parse("2006-01-02", query.To)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid to date, expected YYYY-MM-DD")
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errs.ErrBadRequest("from must not be after to")
+	}
+
+	return filter, nil
+}
+
+// auditChanges returns the columns whose values differ between two snapshots as
+// column → {"before": old, "after": new}. Returns nil when nothing changed.
+func auditChanges(before, after map[string]any) map[string]any {
+	changes := make(map[string]any)
+	diff := func(column string) {
+		if auditIgnoredColumns[column] {
+			return
+		}
+		if _, done := changes[column]; done {
+			return
+		}
+		oldValue, newValue := before[column], after[column]
+		oldJSON, _ := json.Marshal(oldValue)
+		newJSON, _ := json.Marshal(newValue)
+		if !bytes.Equal(oldJSON, newJSON) {
+			changes[column] = map[string]any{"before": oldValue, "after": newValue}
+		}
+	}
+	for column := range before {
+		diff(column)
+	}
+	for column := range after {
+		diff(column)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+// marshalAuditJSON encodes a snapshot for a jsonb column; nil maps are stored as NULL.
+func marshalAuditJSON(ctx context.Context, v map[string]any) *string {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode audit snapshot", "error", err)
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+// toAuditLogResponse converts a model.AuditLog to dto.AuditLogResponse.
+func toAuditLogResponse(log model.AuditLog) dto.AuditLogResponse {
+	raw := func(s *string) json.RawMessage {
+		if s == nil {
+			return json.RawMessage("null")
+		}
+		return json.RawMessage(*s)
+	}
+
+	return dto.AuditLogResponse{
+		ID:        log.ID.String(),
+		AdminID:   log.AdminID.String(),
+		Entity:    log.Entity,
+		EntityID:  log.EntityID.String(),
+		Action:    log.Action,
+		Before:    raw(log.Before),
+		After:     raw(log.After),
+		Changes:   raw(log.Changes),
+		CreatedAt: timefmt.Format(log.CreatedAt),
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	teamID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		action      string
+		before      map[string]any
+		after       map[string]any
+		afterErr    error
+		wantBefore  bool
+		wantAfter   bool
+		wantChanges map[string]any // nil = no changes recorded
+	}{
+		{
+			name:       "update records only changed columns",
+			action:     model.AuditActionUpdate,
+			before:     map[string]any{"id": teamID.String(), "name": "Persija", "city": "Jakarta", "updated_at": "2025-01-01"},
+			after:      map[string]any{"id": teamID.String(), "name": "Persija Jakarta", "city": "Jakarta", "updated_at": "2025-01-02"},
+			wantBefore: true,
+			wantAfter:  true,
+			wantChanges: map[string]any{
+				"name": map[string]any{"before": "Persija", "after": "Persija Jakarta"},
+			},
+		},
+		{
+			name:       "create has no before snapshot",
+			action:     model.AuditActionCreate,
+			after:      map[string]any{"id": teamID.String(), "name": "Persija"},
+			wantBefore: false,
+			wantAfter:  true,
+			wantChanges: map[string]any{
+				"id":   map[string]any{"before": nil, "after": teamID.String()},
+				"name": map[string]any{"before": nil, "after": "Persija"},
+			},
+		},
+		{
+			name:       "delete has no after snapshot",
+			action:     model.AuditActionDelete,
+			before:     map[string]any{"name": "Persija"},
+			wantBefore: true,
+			wantAfter:  false,
+			wantChanges: map[string]any{
+				"name": map[string]any{"before": "Persija", "after": nil},
+			},
+		},
+		{
+			name:        "unchanged update",
+			action:      model.AuditActionUpdate,
+			before:      map[string]any{"name": "Persija"},
+			after:       map[string]any{"name": "Persija"},
+			wantBefore:  true,
+			wantAfter:   true,
+			wantChanges: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditRepo := mocks.NewMockAuditLogRepository(t)
+			svc := NewAuditService(auditRepo)
+
+			if tt.action != model.AuditActionDelete {
+				auditRepo.EXPECT().FindRow("teams", teamID).Return(tt.after, tt.afterErr)
+			}
+
+			var saved *model.AuditLog
+			auditRepo.EXPECT().Create(mock.AnythingOfType("*model.AuditLog")).Run(func(log *model.AuditLog) {
+				saved = log
+			}).Return(nil)
+
+			svc.Record(context.Background(), AuditEntry{
+				AdminID:  adminID,
+				Entity:   model.AuditEntityTeam,
+				EntityID: teamID,
+				Action:   tt.action,
+				Before:   tt.before,
+			})
+
+			assert.NotNil(t, saved)
+			assert.Equal(t, adminID, saved.AdminID)
+			assert.Equal(t, tt.action, saved.Action)
+			assert.Equal(t, tt.wantBefore, saved.Before != nil)
+			assert.Equal(t, tt.wantAfter, saved.After != nil)
+
+			if tt.wantChanges == nil {
+				assert.Nil(t, saved.Changes)
+				return
+			}
+			var changes map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(*saved.Changes), &changes))
+			assert.Equal(t, tt.wantChanges, changes)
+		})
+	}
+}
+
+func TestAuditService_Snapshot(t *testing.T) {
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("missing row", func(t *testing.T) {
+		auditRepo := mocks.NewMockAuditLogRepository(t)
+		auditRepo.EXPECT().FindRow("players", id).Return(nil, gorm.ErrRecordNotFound)
+		assert.Nil(t, NewAuditService(auditRepo).Snapshot(context.Background(), model.AuditEntityPlayer, id))
+	})
+
+	t.Run("db error is swallowed", func(t *testing.T) {
+		auditRepo := mocks.NewMockAuditLogRepository(t)
+		auditRepo.EXPECT().FindRow("matches", id).Return(nil, errors.New("db error"))
+		assert.Nil(t, NewAuditService(auditRepo).Snapshot(context.Background(), model.AuditEntityMatch, id))
+	})
+
+	t.Run("unknown entity", func(t *testing.T) {
+		auditRepo := mocks.NewMockAuditLogRepository(t)
+		assert.Nil(t, NewAuditService(auditRepo).Snapshot(context.Background(), "admin", id))
+	})
+}
+
+func TestAuditService_PurgeOlderThan(t *testing.T) {
+	t.Run("deletes logs before the cutoff", func(t *testing.T) {
+		auditRepo := mocks.NewMockAuditLogRepository(t)
+		auditRepo.EXPECT().DeleteBefore(mock.MatchedBy(func(cutoff time.Time) bool {
+			return time.Since(cutoff) > 89*24*time.Hour && time.Since(cutoff) < 91*24*time.Hour
+		})).Return(int64(12), nil)
+
+		deleted, err := NewAuditService(auditRepo).PurgeOlderThan(context.Background(), 90*24*time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), deleted)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		auditRepo := mocks.NewMockAuditLogRepository(t)
+		auditRepo.EXPECT().DeleteBefore(mock.Anything).Return(int64(0), errors.New("db error"))
+
+		_, err := NewAuditService(auditRepo).PurgeOlderThan(context.Background(), time.Hour)
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Internal server error", appErr.Message)
+	})
+}
+
+func TestAuditService_GetAll(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	before := `{"name":"Persija"}`
+
+	tests := []struct {
+		name        string
+		filter      dto.AuditLogFilterQuery
+		setup       func(*mocks.MockAuditLogRepository)
+		wantErr     bool
+		errContains string
+		wantLen     int
+	}{
+		{
+			name:   "success with filters",
+			filter: dto.AuditLogFilterQuery{AdminID: adminID.String(), Entity: model.AuditEntityTeam, From: "2025-01-01", To: "2025-01-31"},
+			setup: func(ar *mocks.MockAuditLogRepository) {
+				match := mock.MatchedBy(func(f repository.AuditLogFilter) bool {
+					return f.AdminID != nil && *f.AdminID == adminID &&
+						f.Entity == model.AuditEntityTeam &&
+						f.From.Format("2006-01-02") == "2025-01-01" &&
+						f.To.Format("2006-01-02") == "2025-02-01" // inclusive end date
+				})
+				ar.EXPECT().FindAll(match, 0, 10).Return([]model.AuditLog{
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, AdminID: adminID, Entity: model.AuditEntityTeam, Action: model.AuditActionDelete, Before: &before},
+				}, nil)
+				ar.EXPECT().Count(match).Return(int64(1), nil)
+			},
+			wantLen: 1,
+		},
+		{
+			name:        "from after to",
+			filter:      dto.AuditLogFilterQuery{From: "2025-02-01", To: "2025-01-01"},
+			setup:       func(ar *mocks.MockAuditLogRepository) {},
+			wantErr:     true,
+			errContains: "from must not be after to",
+		},
+		{
+			name:   "db error",
+			filter: dto.AuditLogFilterQuery{},
+			setup: func(ar *mocks.MockAuditLogRepository) {
+				ar.EXPECT().FindAll(repository.AuditLogFilter{}, 0, 10).Return(nil, errors.New("db error"))
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditRepo := mocks.NewMockAuditLogRepository(t)
+			tt.setup(auditRepo)
+			svc := NewAuditService(auditRepo)
+
+			logs, meta, err := svc.GetAll(context.Background(), dto.PaginationQuery{Page: 1, PerPage: 10}, tt.filter)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, logs, tt.wantLen)
+				assert.Equal(t, int64(tt.wantLen), meta.Total)
+				assert.JSONEq(t, before, string(logs[0].Before))
+				assert.Equal(t, "null", string(logs[0].After))
+			}
+		})
+	}
+}
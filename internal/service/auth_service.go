@@ -1,9 +1,13 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
@@ -14,57 +18,93 @@ import (
 
 // AuthService defines the contract for authentication business logic.
 type AuthService interface {
-	Login(username, password string) (*jwtpkg.TokenPair, *model.Admin, error)
-	RefreshToken(refreshToken string) (*jwtpkg.TokenPair, error)
-	Logout(refreshToken string) error
+	Login(ctx context.Context, username, password string) (*jwtpkg.TokenPair, *model.Admin, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*jwtpkg.TokenPair, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context, adminID uuid.UUID) error
+	PurgeExpiredTokens(ctx context.Context) (int64, error)
+	JWKS() jwtpkg.JWKSet
 }
 
 type authService struct {
 	adminRepo        repository.AdminRepository
 	refreshTokenRepo repository.RefreshTokenRepository
+	loginAttemptRepo repository.LoginAttemptRepository
 	jwtService       *jwtpkg.Service
+	maxLoginAttempts int
+	lockoutDuration  time.Duration
+	passwordMaxAge   time.Duration
 }
 
 // NewAuthService creates a new AuthService instance.
+// After maxLoginAttempts consecutive failed logins an account is locked for lockoutDuration;
+// a maxLoginAttempts of 0 disables the lockout. Passwords older than passwordMaxAge must be
+// changed before anything else (0 = never).
 func NewAuthService(
 	adminRepo repository.AdminRepository,
 	refreshTokenRepo repository.RefreshTokenRepository,
+	loginAttemptRepo repository.LoginAttemptRepository,
 	jwtService *jwtpkg.Service,
+	maxLoginAttempts int,
+	lockoutDuration time.Duration,
+	passwordMaxAge time.Duration,
 ) AuthService {
 	return &authService{
 		adminRepo:        adminRepo,
 		refreshTokenRepo: refreshTokenRepo,
+		loginAttemptRepo: loginAttemptRepo,
 		jwtService:       jwtService,
+		maxLoginAttempts: maxLoginAttempts,
+		lockoutDuration:  lockoutDuration,
+		passwordMaxAge:   passwordMaxAge,
 	}
 }
 
 // Login authenticates an admin and returns a JWT token pair.
-func (s *authService) Login(username, password string) (*jwtpkg.TokenPair, *model.Admin, error) {
+// Locked accounts are rejected before the password is checked.
+func (s *authService) Login(ctx context.Context, username, password string) (*jwtpkg.TokenPair, *model.Admin, error) {
 	admin, err := s.adminRepo.FindByUsername(username)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil, errs.ErrUnauthorized("Invalid username or password")
+			return nil, nil, errs.ErrUnauthorized("Invalid username or password").WithCode(CodeInvalidCredentials)
 		}
-		slog.Error("failed to find admin by username", "error", err)
+		slog.ErrorContext(ctx, "failed to find admin by username", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
+	attempt, err := s.findLoginAttempt(ctx, admin.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if attempt != nil && attempt.IsLocked(time.Now()) {
+		return nil, nil, errs.ErrLocked("Account is locked due to too many failed login attempts, try again later").WithCode(CodeAccountLocked)
+	}
+
 	// Compare password with bcrypt hash
 	if err := bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)); err != nil {
-		return nil, nil, errs.ErrUnauthorized("Invalid username or password")
+		return nil, nil, s.recordFailedLogin(ctx, admin.ID)
 	}
 
+	// A successful login clears the failure counter
+	if attempt != nil {
+		if err := s.loginAttemptRepo.DeleteByAdminID(admin.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to reset login attempts", "error", err, "admin_id", admin.ID)
+		}
+	}
+
+	s.flagExpiredPassword(ctx, admin)
+
 	// Generate access token
-	accessToken, err := s.jwtService.GenerateAccessToken(admin.ID, admin.Username)
+	accessToken, err := s.jwtService.GenerateAccessToken(admin.ID, admin.Username, admin.Role, admin.MustChangePassword)
 	if err != nil {
-		slog.Error("failed to generate access token", "error", err)
+		slog.ErrorContext(ctx, "failed to generate access token", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
 	// Generate refresh token and store in DB
 	refreshTokenStr, expiresAt, err := s.jwtService.GenerateRefreshToken()
 	if err != nil {
-		slog.Error("failed to generate refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to generate refresh token", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -74,7 +114,7 @@ func (s *authService) Login(username, password string) (*jwtpkg.TokenPair, *mode
 		ExpiresAt: expiresAt,
 	}
 	if err := s.refreshTokenRepo.Create(refreshToken); err != nil {
-		slog.Error("failed to store refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to store refresh token", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -87,14 +127,14 @@ func (s *authService) Login(username, password string) (*jwtpkg.TokenPair, *mode
 }
 
 // RefreshToken validates a refresh token and issues a new token pair (token rotation).
-func (s *authService) RefreshToken(refreshTokenStr string) (*jwtpkg.TokenPair, error) {
+func (s *authService) RefreshToken(ctx context.Context, refreshTokenStr string) (*jwtpkg.TokenPair, error) {
 	// Look up refresh token in DB
 	storedToken, err := s.refreshTokenRepo.FindByToken(refreshTokenStr)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrUnauthorized("Invalid refresh token")
+			return nil, errs.ErrUnauthorized("Invalid refresh token").WithCode(CodeInvalidRefreshToken)
 		}
-		slog.Error("failed to find refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to find refresh token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -102,33 +142,35 @@ func (s *authService) RefreshToken(refreshTokenStr string) (*jwtpkg.TokenPair, e
 	if storedToken.IsExpired() {
 		// Clean up expired token
 		_ = s.refreshTokenRepo.DeleteByToken(refreshTokenStr)
-		return nil, errs.ErrUnauthorized("Refresh token has expired")
+		return nil, errs.ErrUnauthorized("Refresh token has expired").WithCode(CodeInvalidRefreshToken)
 	}
 
 	// Look up the admin
 	admin, err := s.adminRepo.FindByID(storedToken.AdminID)
 	if err != nil {
-		slog.Error("failed to find admin for refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to find admin for refresh token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
 	// Token rotation: delete old refresh token, create new one
 	if err := s.refreshTokenRepo.DeleteByToken(refreshTokenStr); err != nil {
-		slog.Error("failed to delete old refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to delete old refresh token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
+	s.flagExpiredPassword(ctx, admin)
+
 	// Generate new access token
-	newAccessToken, err := s.jwtService.GenerateAccessToken(admin.ID, admin.Username)
+	newAccessToken, err := s.jwtService.GenerateAccessToken(admin.ID, admin.Username, admin.Role, admin.MustChangePassword)
 	if err != nil {
-		slog.Error("failed to generate new access token", "error", err)
+		slog.ErrorContext(ctx, "failed to generate new access token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
 	// Generate new refresh token
 	newRefreshTokenStr, expiresAt, err := s.jwtService.GenerateRefreshToken()
 	if err != nil {
-		slog.Error("failed to generate new refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to generate new refresh token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -138,7 +180,7 @@ func (s *authService) RefreshToken(refreshTokenStr string) (*jwtpkg.TokenPair, e
 		ExpiresAt: expiresAt,
 	}
 	if err := s.refreshTokenRepo.Create(newRefreshToken); err != nil {
-		slog.Error("failed to store new refresh token", "error", err)
+		slog.ErrorContext(ctx, "failed to store new refresh token", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -149,10 +191,98 @@ func (s *authService) RefreshToken(refreshTokenStr string) (*jwtpkg.TokenPair, e
 }
 
 // Logout invalidates a refresh token by hard-deleting it from the database.
-func (s *authService) Logout(refreshTokenStr string) error {
+func (s *authService) Logout(ctx context.Context, refreshTokenStr string) error {
 	if err := s.refreshTokenRepo.DeleteByToken(refreshTokenStr); err != nil {
-		slog.Error("failed to delete refresh token on logout", "error", err)
+		slog.ErrorContext(ctx, "failed to delete refresh token on logout", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// LogoutAll invalidates every refresh token of an admin, ending all of their sessions.
+// Access tokens already issued stay valid until they expire.
+func (s *authService) LogoutAll(ctx context.Context, adminID uuid.UUID) error {
+	if err := s.refreshTokenRepo.DeleteByAdminID(adminID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete refresh tokens on logout-all", "error", err, "admin_id", adminID)
 		return errs.ErrInternal("Internal server error")
 	}
 	return nil
 }
+
+// PurgeExpiredTokens hard-deletes expired refresh tokens and returns how many were removed.
+// Expired tokens are already rejected on refresh; this only keeps the table small.
+func (s *authService) PurgeExpiredTokens(ctx context.Context) (int64, error) {
+	deleted, err := s.refreshTokenRepo.DeleteExpired(time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to purge expired refresh tokens", "error", err)
+		return 0, errs.ErrInternal("Internal server error")
+	}
+	return deleted, nil
+}
+
+// findLoginAttempt returns the failed login record of an admin, or nil if there is none
+// or lockout is disabled.
+func (s *authService) findLoginAttempt(ctx context.Context, adminID uuid.UUID) (*model.LoginAttempt, error) {
+	if s.maxLoginAttempts <= 0 {
+		return nil, nil
+	}
+
+	attempt, err := s.loginAttemptRepo.FindByAdminID(adminID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "failed to fetch login attempts", "error", err, "admin_id", adminID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return attempt, nil
+}
+
+// recordFailedLogin increments the failure counter and locks the account once it reaches the limit.
+// The lock is decided from the count the database returns, so concurrent failures cannot slip past it.
+// Returns the error to send to the client: 423 when this failure locked the account, 401 otherwise.
+func (s *authService) recordFailedLogin(ctx context.Context, adminID uuid.UUID) error {
+	invalid := errs.ErrUnauthorized("Invalid username or password").WithCode(CodeInvalidCredentials)
+	if s.maxLoginAttempts <= 0 {
+		return invalid
+	}
+
+	now := time.Now()
+	attempt, err := s.loginAttemptRepo.RecordFailure(adminID, now)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record failed login", "error", err, "admin_id", adminID)
+		return invalid
+	}
+	if attempt.FailedCount < s.maxLoginAttempts {
+		return invalid
+	}
+
+	lockedUntil := now.Add(s.lockoutDuration)
+	if err := s.loginAttemptRepo.Lock(adminID, lockedUntil); err != nil {
+		slog.ErrorContext(ctx, "failed to lock admin account", "error", err, "admin_id", adminID)
+		return invalid
+	}
+
+	slog.WarnContext(ctx, "admin account locked after failed logins",
+		"admin_id", adminID, "failed_count", attempt.FailedCount, "locked_until", lockedUntil)
+	return errs.ErrLocked(fmt.Sprintf("Too many failed login attempts, account locked for %s", s.lockoutDuration)).WithCode(CodeAccountLocked)
+}
+
+// flagExpiredPassword requires the admin to change a password older than the maximum age.
+// The flag is saved so it also shows in the admin list; a failed save is only logged
+// because the issued token carries the flag either way.
+func (s *authService) flagExpiredPassword(ctx context.Context, admin *model.Admin) {
+	if admin.MustChangePassword || s.passwordMaxAge <= 0 || admin.PasswordAge(time.Now()) < s.passwordMaxAge {
+		return
+	}
+	admin.MustChangePassword = true
+	if err := s.adminRepo.Update(admin); err != nil {
+		slog.ErrorContext(ctx, "failed to flag expired password", "error", err, "admin_id", admin.ID)
+	}
+}
+
+// JWKS returns the public keys access tokens can be verified with, including a previous key
+// during its grace window after a rotation.
+func (s *authService) JWKS() jwtpkg.JWKSet {
+	return s.jwtService.JWKS()
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -111,7 +114,7 @@ func TestAuthService_Login(t *testing.T) {
 			svc, adminRepo, refreshRepo, _ := newTestAuthService(t)
 			tt.setup(adminRepo, refreshRepo)
 
-			tokenPair, admin, err := svc.Login(tt.username, tt.password)
+			tokenPair, admin, err := svc.Login(context.Background(), tt.username, tt.password)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -134,6 +137,219 @@ func TestAuthService_Login(t *testing.T) {
 	}
 }
 
+func TestAuthService_Login_PasswordChange(t *testing.T) {
+	hashedPw, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	changedAt := time.Now().AddDate(0, 0, -100)
+	recentlyChangedAt := time.Now().AddDate(0, 0, -10)
+
+	tests := []struct {
+		name       string
+		admin      model.Admin
+		flagged    bool // The flag is saved at login
+		wantChange bool
+	}{
+		{
+			name:       "seeded admin",
+			admin:      model.Admin{Base: model.Base{CreatedAt: time.Now()}, MustChangePassword: true},
+			wantChange: true,
+		},
+		{
+			name:       "expired password",
+			admin:      model.Admin{Base: model.Base{CreatedAt: changedAt}, PasswordChangedAt: &changedAt},
+			flagged:    true,
+			wantChange: true,
+		},
+		{
+			name:       "recent password",
+			admin:      model.Admin{Base: model.Base{CreatedAt: changedAt}, PasswordChangedAt: &recentlyChangedAt},
+			wantChange: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, adminRepo, refreshRepo, jwtService := newTestAuthService(t)
+			svc.passwordMaxAge = 90 * 24 * time.Hour
+			admin := tt.admin
+			admin.ID = uuid.Must(uuid.NewV7())
+			admin.Username = "admin"
+			admin.Password = string(hashedPw)
+			adminRepo.EXPECT().FindByUsername("admin").Return(&admin, nil)
+			if tt.flagged {
+				adminRepo.EXPECT().Update(mock.MatchedBy(func(a *model.Admin) bool { return a.MustChangePassword })).Return(nil)
+			}
+			refreshRepo.EXPECT().Create(mock.AnythingOfType("*model.RefreshToken")).Return(nil)
+
+			tokenPair, result, err := svc.Login(context.Background(), "admin", "password123")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantChange, result.MustChangePassword)
+			claims, err := jwtService.ValidateAccessToken(tokenPair.AccessToken)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantChange, claims.MustChangePassword)
+		})
+	}
+}
+
+func TestAuthService_LoginLockout(t *testing.T) {
+	hashedPw, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+	adminID := uuid.Must(uuid.NewV7())
+	admin := &model.Admin{Base: model.Base{ID: adminID}, Username: "admin", Password: string(hashedPw)}
+	future := time.Now().Add(10 * time.Minute)
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		password    string
+		setup       func(*mocks.MockLoginAttemptRepository, *mocks.MockRefreshTokenRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name:     "first failure creates counter",
+			password: "wrong",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(nil, gorm.ErrRecordNotFound)
+				lr.EXPECT().RecordFailure(adminID, mock.AnythingOfType("time.Time")).
+					Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 1}, nil)
+			},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "Invalid username or password",
+		},
+		{
+			name:     "failure reaching limit locks account",
+			password: "wrong",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 2}, nil)
+				lr.EXPECT().RecordFailure(adminID, mock.AnythingOfType("time.Time")).
+					Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 3}, nil)
+				lr.EXPECT().Lock(adminID, mock.MatchedBy(func(until time.Time) bool {
+					return until.After(time.Now().Add(14 * time.Minute))
+				})).Return(nil)
+			},
+			wantErr:     true,
+			errCode:     423,
+			errContains: "account locked for 15m0s",
+		},
+		{
+			name:     "locked account rejects correct password",
+			password: "password123",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 3, LockedUntil: &future}, nil)
+			},
+			wantErr:     true,
+			errCode:     423,
+			errContains: "Account is locked",
+		},
+		{
+			name:     "failure after expired lock starts a new count",
+			password: "wrong",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 3, LockedUntil: &past}, nil)
+				lr.EXPECT().RecordFailure(adminID, mock.AnythingOfType("time.Time")).
+					Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 1}, nil)
+			},
+			wantErr:     true,
+			errCode:     401,
+			errContains: "Invalid username or password",
+		},
+		{
+			name:     "success resets counter",
+			password: "password123",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(&model.LoginAttempt{AdminID: adminID, FailedCount: 2}, nil)
+				lr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+				rr.EXPECT().Create(mock.AnythingOfType("*model.RefreshToken")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "db error on attempt lookup",
+			password: "password123",
+			setup: func(lr *mocks.MockLoginAttemptRepository, rr *mocks.MockRefreshTokenRepository) {
+				lr.EXPECT().FindByAdminID(adminID).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminRepo := mocks.NewMockAdminRepository(t)
+			refreshRepo := mocks.NewMockRefreshTokenRepository(t)
+			attemptRepo := mocks.NewMockLoginAttemptRepository(t)
+			jwtService := jwtpkg.NewService("test-secret-key-for-unit-testing-256bit", 15*time.Minute, 7*24*time.Hour)
+			svc := NewAuthService(adminRepo, refreshRepo, attemptRepo, jwtService, 3, 15*time.Minute, 0)
+
+			adminRepo.EXPECT().FindByUsername("admin").Return(admin, nil)
+			tt.setup(attemptRepo, refreshRepo)
+
+			tokenPair, _, err := svc.Login(context.Background(), "admin", tt.password)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				assert.Nil(t, tokenPair)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, tokenPair)
+			}
+		})
+	}
+}
+
+func TestAuthService_LoginLockoutConcurrent(t *testing.T) {
+	hashedPw, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	adminID := uuid.Must(uuid.NewV7())
+	admin := &model.Admin{Base: model.Base{ID: adminID}, Username: "admin", Password: string(hashedPw)}
+
+	adminRepo := mocks.NewMockAdminRepository(t)
+	attemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	jwtService := jwtpkg.NewService("test-secret-key-for-unit-testing-256bit", 15*time.Minute, 7*24*time.Hour)
+	svc := NewAuthService(adminRepo, mocks.NewMockRefreshTokenRepository(t), attemptRepo, jwtService, 3, 15*time.Minute, 0)
+
+	// The repository counts atomically, as the database does; every failure sees its own count
+	var count atomic.Int32
+	adminRepo.EXPECT().FindByUsername("admin").Return(admin, nil)
+	attemptRepo.EXPECT().FindByAdminID(adminID).Return(nil, gorm.ErrRecordNotFound)
+	attemptRepo.EXPECT().RecordFailure(adminID, mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(id uuid.UUID, now time.Time) (*model.LoginAttempt, error) {
+			return &model.LoginAttempt{AdminID: id, FailedCount: int(count.Add(1)), LastFailedAt: now}, nil
+		})
+	attemptRepo.EXPECT().Lock(adminID, mock.AnythingOfType("time.Time")).Return(nil)
+
+	const logins = 10
+	codes := make(chan int, logins)
+	var wg sync.WaitGroup
+	for range logins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := svc.Login(context.Background(), "admin", "wrong")
+			var appErr *errs.AppError
+			if assert.ErrorAs(t, err, &appErr) {
+				codes <- appErr.Code
+			}
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	got := map[int]int{}
+	for code := range codes {
+		got[code]++
+	}
+	// Failures one and two are only counted; every later one finds the limit reached
+	assert.Equal(t, map[int]int{401: 2, 423: logins - 2}, got)
+	assert.Equal(t, int32(logins), count.Load())
+}
+
 func TestAuthService_RefreshToken(t *testing.T) {
 	adminID := uuid.Must(uuid.NewV7())
 
@@ -194,7 +410,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			svc, adminRepo, refreshRepo, _ := newTestAuthService(t)
 			tt.setup(adminRepo, refreshRepo)
 
-			tokenPair, err := svc.RefreshToken(tt.token)
+			tokenPair, err := svc.RefreshToken(context.Background(), tt.token)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -246,7 +462,7 @@ func TestAuthService_Logout(t *testing.T) {
 			svc, _, refreshRepo, _ := newTestAuthService(t)
 			tt.setup(refreshRepo)
 
-			err := svc.Logout(tt.token)
+			err := svc.Logout(context.Background(), tt.token)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -261,3 +477,72 @@ func TestAuthService_Logout(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_LogoutAll(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockRefreshTokenRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "successful logout-all",
+			setup: func(rr *mocks.MockRefreshTokenRepository) {
+				rr.EXPECT().DeleteByAdminID(adminID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "db error on delete",
+			setup: func(rr *mocks.MockRefreshTokenRepository) {
+				rr.EXPECT().DeleteByAdminID(adminID).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, refreshRepo, _ := newTestAuthService(t)
+			tt.setup(refreshRepo)
+
+			err := svc.LogoutAll(context.Background(), adminID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_PurgeExpiredTokens(t *testing.T) {
+	t.Run("returns deleted count", func(t *testing.T) {
+		svc, _, refreshRepo, _ := newTestAuthService(t)
+		refreshRepo.EXPECT().DeleteExpired(mock.MatchedBy(func(now time.Time) bool {
+			return time.Since(now) < time.Minute
+		})).Return(int64(3), nil)
+
+		deleted, err := svc.PurgeExpiredTokens(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc, _, refreshRepo, _ := newTestAuthService(t)
+		refreshRepo.EXPECT().DeleteExpired(mock.Anything).Return(int64(0), gorm.ErrInvalidDB)
+
+		_, err := svc.PurgeExpiredTokens(context.Background())
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 500, appErr.Code)
+	})
+}
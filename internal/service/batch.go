@@ -0,0 +1,61 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// maxBatchIDs is the most IDs a listing accepts in its ids parameter, one full page.
+const maxBatchIDs = 100
+
+// parseIDList parses the comma-separated ids parameter of a listing. Repeated IDs are
+// listed once; an empty parameter returns no IDs.
+func parseIDList(raw string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := uuid.Parse(part)
+		if err != nil {
+			return nil, errs.ErrBadRequest("Invalid ids format")
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > maxBatchIDs {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("At most %d ids are allowed", maxBatchIDs))
+	}
+	return ids, nil
+}
+
+// batchPage returns the page holding every record of a listing by IDs.
+func batchPage(pagination dto.PaginationQuery, ids []uuid.UUID) dto.PaginationQuery {
+	pagination.Page = 1
+	pagination.PerPage = len(ids)
+	return pagination
+}
+
+// inRequestOrder sorts the records of a listing by IDs in the order they were asked for.
+// IDs without a record are left out.
+func inRequestOrder[T any](items []T, ids []uuid.UUID, idOf func(T) string) []T {
+	byID := make(map[string]T, len(items))
+	for _, item := range items {
+		byID[idOf(item)] = item
+	}
+	ordered := make([]T, 0, len(items))
+	for _, id := range ids {
+		if item, ok := byID[id.String()]; ok {
+			ordered = append(ordered, item)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strconv"
+	"text/template"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/chat"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+)
+
+// chatResultTemplate renders the result summary posted to chat channels. It is executed with chatResult.
+var chatResultTemplate = template.Must(template.New("chat-result").Funcs(template.FuncMap{"ordinal": ordinal}).Parse(
+	`Full time: {{.Home}} {{.HomeScore}}-{{.AwayScore}} {{.Away}}
+{{- range .Goals}}
+{{.Minute}} {{.Player}} ({{.Team}}){{if .Note}} {{.Note}}{{end}}
+{{- end}}
+{{- if .Positions}}
+{{.Table}}: {{range $i, $p := .Positions}}{{if $i}}, {{end}}{{$p.Team}} {{ordinal $p.Position}} ({{$p.Points}} pts){{end}}
+{{- end}}
+`))
+
+// chatResult is the data of a result summary.
+type chatResult struct {
+	Home, Away           string
+	HomeScore, AwayScore int
+	Goals                []goalLine
+	Table                string // "Table" or "Season table"
+	Positions            []chatPosition
+}
+
+// chatPosition is a team's place in the standings after the result.
+type chatPosition struct {
+	Team     string
+	Position int
+	Points   int
+}
+
+// ChatService defines the contract for result summaries posted to chat channels.
+type ChatService interface {
+	HandleEvent(ctx context.Context, event events.Event) error
+}
+
+type chatService struct {
+	standings StandingsService
+	posters   []chat.Poster
+}
+
+// NewChatService creates a new ChatService that posts to every given channel.
+func NewChatService(standings StandingsService, posters []chat.Poster) ChatService {
+	return &chatService{standings: standings, posters: posters}
+}
+
+// HandleEvent posts a summary of every completed match: score, scorers and the teams'
+// new standings positions, in the match's season when it has one. Other events are ignored.
+func (s *chatService) HandleEvent(ctx context.Context, event events.Event) error {
+	if event.Type != FeedMatchCompleted || len(s.posters) == 0 {
+		return nil
+	}
+	match, ok := event.Data.(dto.MatchResponse)
+	if !ok {
+		return nil
+	}
+
+	home, away := matchTeamNames(match)
+	data := chatResult{
+		Home:      home,
+		Away:      away,
+		HomeScore: match.HomeScore,
+		AwayScore: match.AwayScore,
+		Goals:     goalLines(match),
+		Table:     "Table",
+	}
+	if match.SeasonID != "" {
+		data.Table = "Season table"
+	}
+	// The summary is still worth posting without positions, so a failure is only logged
+	rows, err := s.standings.GetStandings(ctx, dto.SeasonFilterQuery{SeasonID: match.SeasonID})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to fetch standings for chat summary", "error", err, "match_id", match.ID)
+	} else {
+		for _, teamID := range []string{match.HomeTeamID, match.AwayTeamID} {
+			for _, row := range rows {
+				if row.Team.ID == teamID {
+					data.Positions = append(data.Positions, chatPosition{Team: row.Team.Name, Position: row.Position, Points: row.Points})
+				}
+			}
+		}
+	}
+
+	var text bytes.Buffer
+	if err := chatResultTemplate.Execute(&text, data); err != nil {
+		slog.ErrorContext(ctx, "failed to render chat summary", "error", err, "match_id", match.ID)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	failed := 0
+	for _, poster := range s.posters {
+		if err := poster.Post(ctx, text.String()); err != nil {
+			slog.ErrorContext(ctx, "failed to post result summary", "error", err, "channel", poster.Name(), "match_id", match.ID)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errs.ErrInternal("Internal server error")
+	}
+	slog.InfoContext(ctx, "posted result summary", "match_id", match.ID, "channels", len(s.posters))
+	return nil
+}
+
+// ordinal formats a standings position as 1st, 2nd, 3rd, 4th, ..., 11th, 12th, 13th, 21st.
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return strconv.Itoa(n) + suffix
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/chat"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestChatService_HandleEvent(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	seasonID := uuid.Must(uuid.NewV7())
+
+	match := dto.MatchResponse{
+		HomeTeamID: persib.ID.String(),
+		AwayTeamID: persija.ID.String(),
+		HomeScore:  2,
+		AwayScore:  1,
+		HomeTeam:   &dto.TeamResponse{ID: persib.ID.String(), Name: persib.Name},
+		AwayTeam:   &dto.TeamResponse{ID: persija.ID.String(), Name: persija.Name},
+		Events: []dto.MatchEventResponse{
+			{Type: model.EventGoal, Minute: 12, Player: &dto.PlayerResponse{Name: "David da Silva"}, Team: &dto.TeamResponse{Name: persib.Name}},
+			{Type: model.EventOwnGoal, Minute: 40, Player: &dto.PlayerResponse{Name: "Rezaldi Hehanusa"}, Team: &dto.TeamResponse{Name: persija.Name}},
+			{Type: model.EventGoal, Minute: 88, Player: &dto.PlayerResponse{Name: "Marko Simic"}, Team: &dto.TeamResponse{Name: persija.Name}},
+		},
+	}
+	seasonMatch := match
+	seasonMatch.SeasonID = seasonID.String()
+
+	tests := []struct {
+		name     string
+		event    events.Event
+		setup    func(*mocks.MockMatchRepository)
+		wantText string
+	}{
+		{
+			name:  "completed match with standings positions",
+			event: events.Event{Type: FeedMatchCompleted, Data: match},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					completedMatch(persija, arema, 3, 0),
+					completedMatch(persib, persija, 2, 1),
+				}, nil)
+			},
+			wantText: "Full time: Persib Bandung 2-1 Persija Jakarta\n" +
+				"12' David da Silva (Persib Bandung)\n" +
+				"40' Rezaldi Hehanusa (Persija Jakarta) (og)\n" +
+				"88' Marko Simic (Persija Jakarta)\n" +
+				"Table: Persib Bandung 2nd (3 pts), Persija Jakarta 1st (3 pts)\n",
+		},
+		{
+			name:  "season match uses the season table",
+			event: events.Event{Type: FeedMatchCompleted, Data: seasonMatch},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return([]model.Match{
+					completedMatch(persib, persija, 2, 1),
+				}, nil)
+			},
+			wantText: "Full time: Persib Bandung 2-1 Persija Jakarta\n" +
+				"12' David da Silva (Persib Bandung)\n" +
+				"40' Rezaldi Hehanusa (Persija Jakarta) (og)\n" +
+				"88' Marko Simic (Persija Jakarta)\n" +
+				"Season table: Persib Bandung 1st (3 pts), Persija Jakarta 2nd (0 pts)\n",
+		},
+		{
+			name:  "standings error still posts the score",
+			event: events.Event{Type: FeedMatchCompleted, Data: dto.MatchResponse{HomeTeam: match.HomeTeam, AwayTeam: match.AwayTeam}},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantText: "Full time: Persib Bandung 0-0 Persija Jakarta\n",
+		},
+		{
+			name:  "other events are ignored",
+			event: events.Event{Type: FeedMatchResultUpdated, Data: match},
+			setup: func(mr *mocks.MockMatchRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			tt.setup(matchRepo)
+			slack, telegram := chat.NewMemory(), chat.NewMemory()
+			svc := NewChatService(NewStandingsService(matchRepo, nil), []chat.Poster{slack, telegram})
+
+			err := svc.HandleEvent(context.Background(), tt.event)
+
+			assert.NoError(t, err)
+			if tt.wantText == "" {
+				assert.Empty(t, slack.Posted())
+				assert.Empty(t, telegram.Posted())
+				return
+			}
+			assert.Equal(t, []string{tt.wantText}, slack.Posted())
+			assert.Equal(t, []string{tt.wantText}, telegram.Posted())
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	want := map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 102: "102nd", 111: "111th"}
+	for n, s := range want {
+		assert.Equal(t, s, ordinal(n))
+	}
+}
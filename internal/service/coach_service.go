@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// CoachService defines the contract for coaching staff business logic.
+type CoachService interface {
+	GetAllByTeamID(ctx context.Context, teamID uuid.UUID, pagination dto.PaginationQuery) ([]dto.CoachResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.CoachResponse, error)
+	Create(ctx context.Context, teamID uuid.UUID, req dto.CreateCoachRequest) (*dto.CoachResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateCoachRequest) (*dto.CoachResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type coachService struct {
+	coachRepo repository.CoachRepository
+	teamRepo  repository.TeamRepository
+	cache     *ResponseCache
+}
+
+// NewCoachService creates a new CoachService instance.
+// Team listings cached in responseCache are invalidated on every change, as they show the head coach.
+func NewCoachService(coachRepo repository.CoachRepository, teamRepo repository.TeamRepository, responseCache *ResponseCache) CoachService {
+	return &coachService{
+		coachRepo: coachRepo,
+		teamRepo:  teamRepo,
+		cache:     responseCache,
+	}
+}
+
+func (s *coachService) GetAllByTeamID(ctx context.Context, teamID uuid.UUID, pagination dto.PaginationQuery) ([]dto.CoachResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	// Verify team exists
+	if _, err := s.teamRepo.FindByID(teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", teamID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	coaches, err := s.coachRepo.FindAllByTeamID(teamID, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch coaches", "error", err, "team_id", teamID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.coachRepo.CountByTeamID(teamID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count coaches", "error", err, "team_id", teamID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	coachResponses := make([]dto.CoachResponse, len(coaches))
+	for i, coach := range coaches {
+		coachResponses[i] = toCoachResponse(coach)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return coachResponses, meta, nil
+}
+
+func (s *coachService) GetByID(ctx context.Context, id uuid.UUID) (*dto.CoachResponse, error) {
+	coach, err := s.coachRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Coach not found").WithCode(CodeCoachNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch coach", "error", err, "coach_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toCoachResponse(*coach)
+	return &resp, nil
+}
+
+// Create adds a coach to a team's staff. A team can have only one head coach.
+func (s *coachService) Create(ctx context.Context, teamID uuid.UUID, req dto.CreateCoachRequest) (*dto.CoachResponse, error) {
+	if err := validateDateRange("contract_start", req.ContractStart, "contract_end", req.ContractEnd); err != nil {
+		return nil, err
+	}
+
+	// Verify team exists
+	if _, err := s.teamRepo.FindByID(teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team for coach creation", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if req.Role == model.CoachRoleHead {
+		if err := s.ensureNoOtherHeadCoach(ctx, teamID, uuid.Nil); err != nil {
+			return nil, err
+		}
+	}
+
+	coach := model.Coach{
+		TeamID:        teamID,
+		Name:          req.Name,
+		Role:          req.Role,
+		ContractStart: req.ContractStart,
+		ContractEnd:   req.ContractEnd,
+	}
+
+	if err := s.coachRepo.Create(&coach); err != nil {
+		slog.ErrorContext(ctx, "failed to create coach", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixTeams)
+
+	resp := toCoachResponse(coach)
+	return &resp, nil
+}
+
+func (s *coachService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateCoachRequest) (*dto.CoachResponse, error) {
+	if err := validateDateRange("contract_start", req.ContractStart, "contract_end", req.ContractEnd); err != nil {
+		return nil, err
+	}
+
+	coach, err := s.coachRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Coach not found").WithCode(CodeCoachNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch coach for update", "error", err, "coach_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if req.Role == model.CoachRoleHead {
+		if err := s.ensureNoOtherHeadCoach(ctx, coach.TeamID, coach.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	coach.Name = req.Name
+	coach.Role = req.Role
+	coach.ContractStart = req.ContractStart
+	coach.ContractEnd = req.ContractEnd
+
+	if err := s.coachRepo.Update(coach); err != nil {
+		slog.ErrorContext(ctx, "failed to update coach", "error", err, "coach_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixTeams)
+
+	resp := toCoachResponse(*coach)
+	return &resp, nil
+}
+
+// Delete soft-deletes a coach.
+func (s *coachService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.coachRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Coach not found").WithCode(CodeCoachNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch coach for delete", "error", err, "coach_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.coachRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete coach", "error", err, "coach_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixTeams)
+
+	return nil
+}
+
+// ensureNoOtherHeadCoach returns a conflict when the team already has a head coach other than coachID.
+func (s *coachService) ensureNoOtherHeadCoach(ctx context.Context, teamID, coachID uuid.UUID) error {
+	headCoach, err := s.coachRepo.FindHeadCoach(teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		slog.ErrorContext(ctx, "failed to fetch head coach", "error", err, "team_id", teamID)
+		return errs.ErrInternal("Internal server error")
+	}
+	if headCoach.ID != coachID {
+		return errs.ErrConflict(fmt.Sprintf("Team already has a head coach (%s); change their role first", headCoach.Name)).WithCode(CodeHeadCoachExists)
+	}
+	return nil
+}
+
+// validateDateRange checks that the dates given are YYYY-MM-DD and the range does not end
+// before it starts. Either date may be empty; the field names are used in error messages.
+func validateDateRange(startField, start, endField, end string) error {
+	var startDate, endDate time.Time
+	var err error
+	if start != "" {
+		if startDate, err = time.Parse("2006-01-02", start); err != nil {
+			return errs.ErrBadRequest(fmt.Sprintf("Invalid %s format, expected YYYY-MM-DD", startField))
+		}
+	}
+	if end != "" {
+		if endDate, err = time.Parse("2006-01-02", end); err != nil {
+			return errs.ErrBadRequest(fmt.Sprintf("Invalid %s format, expected YYYY-MM-DD", endField))
+		}
+	}
+	if !startDate.IsZero() && !endDate.IsZero() && endDate.Before(startDate) {
+		return errs.ErrBadRequest(fmt.Sprintf("%s must not be before %s", endField, startField))
+	}
+	return nil
+}
+
+// toCoachResponse converts a model.Coach to dto.CoachResponse.
+func toCoachResponse(coach model.Coach) dto.CoachResponse {
+	return dto.CoachResponse{
+		ID:            coach.ID.String(),
+		TeamID:        coach.TeamID.String(),
+		Name:          coach.Name,
+		Role:          coach.Role,
+		ContractStart: coach.ContractStart,
+		ContractEnd:   coach.ContractEnd,
+		CreatedAt:     timefmt.Format(coach.CreatedAt),
+		UpdatedAt:     timefmt.Format(coach.UpdatedAt),
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCoachService_Create(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	team := &model.Team{Base: model.Base{ID: teamID}, Name: "Persija Jakarta"}
+	headCoach := &model.Coach{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: teamID, Name: "Carlos Pena", Role: model.CoachRoleHead}
+
+	tests := []struct {
+		name        string
+		req         dto.CreateCoachRequest
+		setup       func(*mocks.MockCoachRepository, *mocks.MockTeamRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "first head coach",
+			req:  dto.CreateCoachRequest{Name: "Carlos Pena", Role: model.CoachRoleHead, ContractStart: "2025-06-01", ContractEnd: "2027-05-31"},
+			setup: func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(teamID).Return(team, nil)
+				cr.EXPECT().FindHeadCoach(teamID).Return(nil, gorm.ErrRecordNotFound)
+				cr.EXPECT().Create(mock.AnythingOfType("*model.Coach")).Return(nil)
+			},
+		},
+		{
+			name: "assistant alongside a head coach",
+			req:  dto.CreateCoachRequest{Name: "Ricky Nelson", Role: model.CoachRoleAssistant},
+			setup: func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(teamID).Return(team, nil)
+				cr.EXPECT().Create(mock.AnythingOfType("*model.Coach")).Return(nil)
+			},
+		},
+		{
+			name: "second head coach",
+			req:  dto.CreateCoachRequest{Name: "Thomas Doll", Role: model.CoachRoleHead},
+			setup: func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(teamID).Return(team, nil)
+				cr.EXPECT().FindHeadCoach(teamID).Return(headCoach, nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "already has a head coach (Carlos Pena)",
+		},
+		{
+			name:        "contract ends before it starts",
+			req:         dto.CreateCoachRequest{Name: "Carlos Pena", Role: model.CoachRoleHead, ContractStart: "2025-06-01", ContractEnd: "2025-05-31"},
+			setup:       func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "contract_end must not be before contract_start",
+		},
+		{
+			name:        "invalid contract date",
+			req:         dto.CreateCoachRequest{Name: "Carlos Pena", Role: model.CoachRoleHead, ContractEnd: "31-05-2027"},
+			setup:       func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Invalid contract_end format",
+		},
+		{
+			name: "team not found",
+			req:  dto.CreateCoachRequest{Name: "Carlos Pena", Role: model.CoachRoleHead},
+			setup: func(cr *mocks.MockCoachRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(teamID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Team not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coachRepo := mocks.NewMockCoachRepository(t)
+			teamRepo := mocks.NewMockTeamRepository(t)
+			tt.setup(coachRepo, teamRepo)
+			svc := NewCoachService(coachRepo, teamRepo, nil)
+
+			coach, err := svc.Create(context.Background(), teamID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, teamID.String(), coach.TeamID)
+			assert.Equal(t, tt.req.Role, coach.Role)
+		})
+	}
+}
+
+func TestCoachService_Update_HeadCoach(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	headCoach := &model.Coach{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: teamID, Name: "Carlos Pena", Role: model.CoachRoleHead}
+	assistant := &model.Coach{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: teamID, Name: "Ricky Nelson", Role: model.CoachRoleAssistant}
+
+	t.Run("head coach keeps the role", func(t *testing.T) {
+		coachRepo := mocks.NewMockCoachRepository(t)
+		coach := *headCoach
+		coachRepo.EXPECT().FindByID(coach.ID).Return(&coach, nil)
+		coachRepo.EXPECT().FindHeadCoach(teamID).Return(headCoach, nil)
+		coachRepo.EXPECT().Update(&coach).Return(nil)
+		svc := NewCoachService(coachRepo, mocks.NewMockTeamRepository(t), nil)
+
+		resp, err := svc.Update(context.Background(), coach.ID, dto.UpdateCoachRequest{Name: "Carlos Pena", Role: model.CoachRoleHead, ContractEnd: "2028-05-31"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2028-05-31", resp.ContractEnd)
+	})
+
+	t.Run("assistant promoted while the team has a head coach", func(t *testing.T) {
+		coachRepo := mocks.NewMockCoachRepository(t)
+		coach := *assistant
+		coachRepo.EXPECT().FindByID(coach.ID).Return(&coach, nil)
+		coachRepo.EXPECT().FindHeadCoach(teamID).Return(headCoach, nil)
+		svc := NewCoachService(coachRepo, mocks.NewMockTeamRepository(t), nil)
+
+		_, err := svc.Update(context.Background(), coach.ID, dto.UpdateCoachRequest{Name: "Ricky Nelson", Role: model.CoachRoleHead})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 409, appErr.Code)
+	})
+}
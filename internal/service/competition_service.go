@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// CompetitionService defines the contract for competition business logic.
+type CompetitionService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.CompetitionResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.CompetitionResponse, error)
+	Create(ctx context.Context, req dto.CreateCompetitionRequest) (*dto.CompetitionResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateCompetitionRequest) (*dto.CompetitionResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type competitionService struct {
+	competitionRepo repository.CompetitionRepository
+	seasonRepo      repository.SeasonRepository
+	cache           *ResponseCache
+}
+
+// NewCompetitionService creates a new CompetitionService instance.
+// Changing a shootout rule or points rules invalidates the cached standings in responseCache
+// (nil disables caching).
+func NewCompetitionService(competitionRepo repository.CompetitionRepository, seasonRepo repository.SeasonRepository, responseCache *ResponseCache) CompetitionService {
+	return &competitionService{
+		competitionRepo: competitionRepo,
+		seasonRepo:      seasonRepo,
+		cache:           responseCache,
+	}
+}
+
+func (s *competitionService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.CompetitionResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	competitions, err := s.competitionRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch competitions", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.competitionRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count competitions", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	competitionResponses := make([]dto.CompetitionResponse, len(competitions))
+	for i, competition := range competitions {
+		competitionResponses[i] = toCompetitionResponse(competition)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return competitionResponses, meta, nil
+}
+
+func (s *competitionService) GetByID(ctx context.Context, id uuid.UUID) (*dto.CompetitionResponse, error) {
+	competition, err := s.competitionRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Competition not found").WithCode(CodeCompetitionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch competition", "error", err, "competition_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toCompetitionResponse(*competition)
+	return &resp, nil
+}
+
+func (s *competitionService) Create(ctx context.Context, req dto.CreateCompetitionRequest) (*dto.CompetitionResponse, error) {
+	competition := model.Competition{
+		Name:         req.Name,
+		Country:      req.Country,
+		ShootoutRule: req.ShootoutRule,
+	}
+	if competition.ShootoutRule == "" {
+		competition.ShootoutRule = model.ShootoutRuleDraw
+	}
+	if err := applyPointsRules(&competition, req.PointsForWin, req.PointsForDraw, req.Tiebreakers, req.BonusGoals, req.BonusMargin); err != nil {
+		return nil, err
+	}
+
+	if err := s.competitionRepo.Create(&competition); err != nil {
+		slog.ErrorContext(ctx, "failed to create competition", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toCompetitionResponse(competition)
+	return &resp, nil
+}
+
+func (s *competitionService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateCompetitionRequest) (*dto.CompetitionResponse, error) {
+	competition, err := s.competitionRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Competition not found").WithCode(CodeCompetitionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch competition for update", "error", err, "competition_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	previousRule, previousPoints := competition.ShootoutRule, competition.PointsRules()
+	competition.Name = req.Name
+	competition.Country = req.Country
+	if req.ShootoutRule != "" {
+		competition.ShootoutRule = req.ShootoutRule
+	}
+	if err := applyPointsRules(competition, req.PointsForWin, req.PointsForDraw, req.Tiebreakers, req.BonusGoals, req.BonusMargin); err != nil {
+		return nil, err
+	}
+
+	if err := s.competitionRepo.Update(competition); err != nil {
+		slog.ErrorContext(ctx, "failed to update competition", "error", err, "competition_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if competition.ShootoutRule != previousRule || !samePointsRules(competition.PointsRules(), previousPoints) {
+		// Results may now count differently in the standings
+		s.cache.invalidate(ctx, cachePrefixStandings)
+	}
+
+	resp := toCompetitionResponse(*competition)
+	return &resp, nil
+}
+
+// Delete soft-deletes a competition. Competitions that still have seasons cannot be deleted.
+func (s *competitionService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.competitionRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Competition not found").WithCode(CodeCompetitionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch competition for delete", "error", err, "competition_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	seasons, err := s.seasonRepo.CountByCompetitionID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count seasons for competition delete", "error", err, "competition_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	if seasons > 0 {
+		return errs.ErrConflict("Competition still has seasons. Delete them first.").WithCode(CodeCompetitionHasSeasons)
+	}
+
+	if err := s.competitionRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete competition", "error", err, "competition_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// applyPointsRules sets the points rules given in a request on the competition, keeping the
+// ones left out. An empty, non-nil tiebreakers list restores the default order. A draw cannot
+// be worth more than a win.
+func applyPointsRules(competition *model.Competition, win, draw *int, tiebreakers []string, bonusGoals, bonusMargin *int) error {
+	if win != nil {
+		competition.PointsForWin = win
+	}
+	if draw != nil {
+		competition.PointsForDraw = draw
+	}
+	if tiebreakers != nil {
+		competition.Tiebreakers = strings.Join(tiebreakers, ",")
+	}
+	if bonusGoals != nil {
+		competition.BonusGoals = *bonusGoals
+	}
+	if bonusMargin != nil {
+		competition.BonusMargin = *bonusMargin
+	}
+
+	if rules := competition.PointsRules(); rules.Draw > rules.Win {
+		return errs.ErrBadRequest(fmt.Sprintf("A draw cannot be worth more points than a win (%d > %d)", rules.Draw, rules.Win))
+	}
+	return nil
+}
+
+// samePointsRules reports whether a and b rank a table the same way.
+func samePointsRules(a, b model.PointsRules) bool {
+	return a.Win == b.Win && a.Draw == b.Draw && a.BonusGoals == b.BonusGoals &&
+		a.BonusMargin == b.BonusMargin && slices.Equal(a.Tiebreakers, b.Tiebreakers)
+}
+
+// toCompetitionResponse converts a model.Competition to dto.CompetitionResponse.
+func toCompetitionResponse(competition model.Competition) dto.CompetitionResponse {
+	rules := competition.PointsRules()
+	return dto.CompetitionResponse{
+		ID:            competition.ID.String(),
+		Name:          competition.Name,
+		Country:       competition.Country,
+		ShootoutRule:  competition.ShootoutRule,
+		PointsForWin:  rules.Win,
+		PointsForDraw: rules.Draw,
+		Tiebreakers:   rules.Tiebreakers,
+		BonusGoals:    rules.BonusGoals,
+		BonusMargin:   rules.BonusMargin,
+		CreatedAt:     timefmt.Format(competition.CreatedAt),
+		UpdatedAt:     timefmt.Format(competition.UpdatedAt),
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCompetitionService_Delete(t *testing.T) {
+	competitionID := uuid.Must(uuid.NewV7())
+	competition := &model.Competition{Base: model.Base{ID: competitionID}, Name: "Liga 1"}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockCompetitionRepository, *mocks.MockSeasonRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(cr *mocks.MockCompetitionRepository, sr *mocks.MockSeasonRepository) {
+				cr.EXPECT().FindByID(competitionID).Return(competition, nil)
+				sr.EXPECT().CountByCompetitionID(competitionID).Return(int64(0), nil)
+				cr.EXPECT().Delete(competitionID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "competition has seasons",
+			setup: func(cr *mocks.MockCompetitionRepository, sr *mocks.MockSeasonRepository) {
+				cr.EXPECT().FindByID(competitionID).Return(competition, nil)
+				sr.EXPECT().CountByCompetitionID(competitionID).Return(int64(2), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "Competition still has seasons",
+		},
+		{
+			name: "not found",
+			setup: func(cr *mocks.MockCompetitionRepository, sr *mocks.MockSeasonRepository) {
+				cr.EXPECT().FindByID(competitionID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Competition not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			competitionRepo := mocks.NewMockCompetitionRepository(t)
+			seasonRepo := mocks.NewMockSeasonRepository(t)
+			tt.setup(competitionRepo, seasonRepo)
+			svc := NewCompetitionService(competitionRepo, seasonRepo, nil)
+
+			err := svc.Delete(context.Background(), competitionID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCompetitionService_ShootoutRule(t *testing.T) {
+	competitionID := uuid.Must(uuid.NewV7())
+
+	t.Run("new competitions count shootouts as draws by default", func(t *testing.T) {
+		competitionRepo := mocks.NewMockCompetitionRepository(t)
+		competitionRepo.EXPECT().Create(mock.MatchedBy(func(c *model.Competition) bool {
+			return c.ShootoutRule == model.ShootoutRuleDraw
+		})).Return(nil)
+		svc := NewCompetitionService(competitionRepo, mocks.NewMockSeasonRepository(t), nil)
+
+		resp, err := svc.Create(context.Background(), dto.CreateCompetitionRequest{Name: "Piala Indonesia"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ShootoutRuleDraw, resp.ShootoutRule)
+	})
+
+	tests := []struct {
+		name     string
+		rule     string
+		wantRule string
+	}{
+		{name: "update without a rule keeps the current one", rule: "", wantRule: model.ShootoutRuleWin},
+		{name: "update changes the rule", rule: model.ShootoutRuleDraw, wantRule: model.ShootoutRuleDraw},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			competitionRepo := mocks.NewMockCompetitionRepository(t)
+			competitionRepo.EXPECT().FindByID(competitionID).Return(&model.Competition{
+				Base: model.Base{ID: competitionID}, Name: "Piala Indonesia", ShootoutRule: model.ShootoutRuleWin,
+			}, nil)
+			competitionRepo.EXPECT().Update(mock.AnythingOfType("*model.Competition")).Return(nil)
+			svc := NewCompetitionService(competitionRepo, mocks.NewMockSeasonRepository(t), nil)
+
+			resp, err := svc.Update(context.Background(), competitionID, dto.UpdateCompetitionRequest{Name: "Piala Indonesia", ShootoutRule: tt.rule})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRule, resp.ShootoutRule)
+		})
+	}
+}
+
+func TestCompetitionService_PointsRules(t *testing.T) {
+	competitionID := uuid.Must(uuid.NewV7())
+	intPtr := func(v int) *int { return &v }
+
+	t.Run("new competitions use the default rules", func(t *testing.T) {
+		competitionRepo := mocks.NewMockCompetitionRepository(t)
+		competitionRepo.EXPECT().Create(mock.MatchedBy(func(c *model.Competition) bool {
+			return c.PointsForWin == nil && c.PointsForDraw == nil && c.Tiebreakers == ""
+		})).Return(nil)
+		svc := NewCompetitionService(competitionRepo, mocks.NewMockSeasonRepository(t), nil)
+
+		resp, err := svc.Create(context.Background(), dto.CreateCompetitionRequest{Name: "Liga 1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, resp.PointsForWin)
+		assert.Equal(t, 1, resp.PointsForDraw)
+		assert.Equal(t, []string{model.TiebreakerGoalDifference, model.TiebreakerGoalsFor}, resp.Tiebreakers)
+	})
+
+	t.Run("create with custom rules", func(t *testing.T) {
+		competitionRepo := mocks.NewMockCompetitionRepository(t)
+		competitionRepo.EXPECT().Create(mock.MatchedBy(func(c *model.Competition) bool {
+			return *c.PointsForWin == 2 && *c.PointsForDraw == 0 && c.Tiebreakers == "head_to_head,wins" && c.BonusGoals == 4
+		})).Return(nil)
+		svc := NewCompetitionService(competitionRepo, mocks.NewMockSeasonRepository(t), nil)
+
+		resp, err := svc.Create(context.Background(), dto.CreateCompetitionRequest{
+			Name:          "Liga Futsal",
+			PointsForWin:  intPtr(2),
+			PointsForDraw: intPtr(0),
+			Tiebreakers:   []string{model.TiebreakerHeadToHead, model.TiebreakerWins},
+			BonusGoals:    intPtr(4),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.PointsForWin)
+		assert.Equal(t, 0, resp.PointsForDraw)
+		assert.Equal(t, []string{model.TiebreakerHeadToHead, model.TiebreakerWins}, resp.Tiebreakers)
+		assert.Equal(t, 4, resp.BonusGoals)
+	})
+
+	t.Run("a draw cannot be worth more than a win", func(t *testing.T) {
+		svc := NewCompetitionService(mocks.NewMockCompetitionRepository(t), mocks.NewMockSeasonRepository(t), nil)
+
+		_, err := svc.Create(context.Background(), dto.CreateCompetitionRequest{Name: "Liga 1", PointsForDraw: intPtr(4)})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 400, appErr.Code)
+	})
+
+	t.Run("update keeps the rules left out and resets empty tiebreakers", func(t *testing.T) {
+		competitionRepo := mocks.NewMockCompetitionRepository(t)
+		competitionRepo.EXPECT().FindByID(competitionID).Return(&model.Competition{
+			Base: model.Base{ID: competitionID}, Name: "Liga 1", PointsForWin: intPtr(2), Tiebreakers: "head_to_head",
+		}, nil)
+		competitionRepo.EXPECT().Update(mock.AnythingOfType("*model.Competition")).Return(nil)
+		svc := NewCompetitionService(competitionRepo, mocks.NewMockSeasonRepository(t), nil)
+
+		resp, err := svc.Update(context.Background(), competitionID, dto.UpdateCompetitionRequest{Name: "Liga 1", Tiebreakers: []string{}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.PointsForWin)
+		assert.Equal(t, model.DefaultPointsRules.Tiebreakers, resp.Tiebreakers)
+	})
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+)
+
+// ConfirmationService defines the contract for the challenge/confirm flow that
+// protects destructive operations from accidental or replayed requests.
+type ConfirmationService interface {
+	Issue(ctx context.Context, adminID uuid.UUID, req dto.CreateConfirmationRequest) (*dto.ConfirmationResponse, error)
+	Verify(ctx context.Context, adminID uuid.UUID, action string, resourceID uuid.UUID, token string) (*model.ConfirmationToken, error)
+	Restore(ctx context.Context, token *model.ConfirmationToken)
+}
+
+type confirmationService struct {
+	confirmationRepo repository.ConfirmationTokenRepository
+	ttl              time.Duration
+}
+
+// NewConfirmationService creates a new ConfirmationService instance.
+// ttl controls how long an issued token remains valid.
+func NewConfirmationService(confirmationRepo repository.ConfirmationTokenRepository, ttl time.Duration) ConfirmationService {
+	return &confirmationService{
+		confirmationRepo: confirmationRepo,
+		ttl:              ttl,
+	}
+}
+
+// Issue creates a single-use token bound to the admin, action and resource.
+func (s *confirmationService) Issue(ctx context.Context, adminID uuid.UUID, req dto.CreateConfirmationRequest) (*dto.ConfirmationResponse, error) {
+	resourceID, err := uuid.Parse(req.ResourceID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid resource_id format")
+	}
+
+	// Opportunistically purge stale tokens so the table stays small
+	if err := s.confirmationRepo.DeleteExpired(); err != nil {
+		slog.WarnContext(ctx, "failed to purge expired confirmation tokens", "error", err)
+	}
+
+	tokenStr, err := generateConfirmationToken()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate confirmation token", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	token := &model.ConfirmationToken{
+		AdminID:    adminID,
+		Action:     req.Action,
+		ResourceID: resourceID,
+		Token:      tokenStr,
+		ExpiresAt:  time.Now().Add(s.ttl),
+	}
+	if err := s.confirmationRepo.Create(token); err != nil {
+		slog.ErrorContext(ctx, "failed to store confirmation token", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return &dto.ConfirmationResponse{
+		Token:      token.Token,
+		Action:     token.Action,
+		ResourceID: token.ResourceID.String(),
+		ExpiresAt:  timefmt.Format(token.ExpiresAt),
+	}, nil
+}
+
+// Verify consumes a token for the given admin, action and resource and returns it, for
+// Restore. A token is accepted at most once; expired, reused or mismatched tokens are rejected.
+func (s *confirmationService) Verify(ctx context.Context, adminID uuid.UUID, action string, resourceID uuid.UUID, token string) (*model.ConfirmationToken, error) {
+	consumed, err := s.confirmationRepo.Consume(token, adminID, action, resourceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to consume confirmation token", "error", err, "action", action)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if consumed == nil {
+		return nil, errs.ErrForbidden("Invalid or expired confirmation token").WithCode(CodeInvalidConfirmation)
+	}
+	return consumed, nil
+}
+
+// Restore makes a token consumed by Verify usable again until it expires, for an operation
+// that was refused or failed and so did not happen. Failing to restore it only costs the
+// client a new token, so the error is logged.
+func (s *confirmationService) Restore(ctx context.Context, token *model.ConfirmationToken) {
+	if err := s.confirmationRepo.Create(token); err != nil {
+		slog.WarnContext(ctx, "failed to restore confirmation token", "error", err, "action", token.Action)
+	}
+}
+
+// generateConfirmationToken returns 32 random bytes encoded as hex.
+func generateConfirmationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
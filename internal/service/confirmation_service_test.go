@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestConfirmationService(t *testing.T) (*confirmationService, *mocks.MockConfirmationTokenRepository) {
+	confirmationRepo := mocks.NewMockConfirmationTokenRepository(t)
+	svc := &confirmationService{confirmationRepo: confirmationRepo, ttl: 2 * time.Minute}
+	return svc, confirmationRepo
+}
+
+func TestConfirmationService_Issue(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	resourceID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		req         dto.CreateConfirmationRequest
+		setup       func(*mocks.MockConfirmationTokenRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success",
+			req:  dto.CreateConfirmationRequest{Action: model.ConfirmActionTeamDelete, ResourceID: resourceID.String()},
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().DeleteExpired().Return(nil)
+				cr.EXPECT().Create(mock.MatchedBy(func(tok *model.ConfirmationToken) bool {
+					return tok.AdminID == adminID &&
+						tok.ResourceID == resourceID &&
+						tok.Action == model.ConfirmActionTeamDelete &&
+						len(tok.Token) == 64
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "cleanup failure does not block issuing",
+			req:  dto.CreateConfirmationRequest{Action: model.ConfirmActionMatchDelete, ResourceID: resourceID.String()},
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().DeleteExpired().Return(gorm.ErrInvalidDB)
+				cr.EXPECT().Create(mock.AnythingOfType("*model.ConfirmationToken")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "invalid resource id",
+			req:         dto.CreateConfirmationRequest{Action: model.ConfirmActionTeamDelete, ResourceID: "not-a-uuid"},
+			setup:       func(cr *mocks.MockConfirmationTokenRepository) {},
+			wantErr:     true,
+			errContains: "Invalid resource_id",
+		},
+		{
+			name: "db error on create",
+			req:  dto.CreateConfirmationRequest{Action: model.ConfirmActionTeamDelete, ResourceID: resourceID.String()},
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().DeleteExpired().Return(nil)
+				cr.EXPECT().Create(mock.AnythingOfType("*model.ConfirmationToken")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, confirmationRepo := newTestConfirmationService(t)
+			tt.setup(confirmationRepo)
+
+			result, err := svc.Issue(context.Background(), adminID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, result.Token)
+				assert.Equal(t, tt.req.Action, result.Action)
+				assert.Equal(t, tt.req.ResourceID, result.ResourceID)
+			}
+		})
+	}
+}
+
+func TestConfirmationService_Verify(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+	resourceID := uuid.Must(uuid.NewV7())
+	token := &model.ConfirmationToken{AdminID: adminID, Action: model.ConfirmActionTeamDelete, ResourceID: resourceID, Token: "tok"}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockConfirmationTokenRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "valid token consumed",
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, resourceID).Return(token, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown, expired or reused token",
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, resourceID).Return(nil, nil)
+			},
+			wantErr:     true,
+			errCode:     403,
+			errContains: "Invalid or expired confirmation token",
+		},
+		{
+			name: "db error",
+			setup: func(cr *mocks.MockConfirmationTokenRepository) {
+				cr.EXPECT().Consume("tok", adminID, model.ConfirmActionTeamDelete, resourceID).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, confirmationRepo := newTestConfirmationService(t)
+			tt.setup(confirmationRepo)
+
+			consumed, err := svc.Verify(context.Background(), adminID, model.ConfirmActionTeamDelete, resourceID, "tok")
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, token, consumed)
+			}
+		})
+	}
+}
+
+func TestConfirmationService_Restore(t *testing.T) {
+	token := &model.ConfirmationToken{Action: model.ConfirmActionTeamDelete, Token: "tok", ExpiresAt: time.Now().Add(time.Minute)}
+
+	t.Run("token created again", func(t *testing.T) {
+		svc, confirmationRepo := newTestConfirmationService(t)
+		confirmationRepo.EXPECT().Create(token).Return(nil)
+
+		svc.Restore(context.Background(), token)
+	})
+
+	t.Run("db error is only logged", func(t *testing.T) {
+		svc, confirmationRepo := newTestConfirmationService(t)
+		confirmationRepo.EXPECT().Create(token).Return(gorm.ErrInvalidDB)
+
+		svc.Restore(context.Background(), token)
+	})
+}
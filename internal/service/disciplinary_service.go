@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// DisciplinaryService defines the contract for suspensions earned through cards.
+type DisciplinaryService interface {
+	GetMatchSuspensions(ctx context.Context, matchID uuid.UUID) (*dto.MatchSuspensionsResponse, error)
+	CheckEligibility(ctx context.Context, match model.Match, playerIDs []uuid.UUID, labels map[uuid.UUID]string) error
+}
+
+type disciplinaryService struct {
+	matchRepo  repository.MatchRepository
+	eventRepo  repository.MatchEventRepository
+	playerRepo repository.PlayerRepository
+	// yellowCardLimit is the number of accumulated yellow cards that earns a suspension (0 = never)
+	yellowCardLimit int
+}
+
+// NewDisciplinaryService creates a new DisciplinaryService instance.
+func NewDisciplinaryService(
+	matchRepo repository.MatchRepository,
+	eventRepo repository.MatchEventRepository,
+	playerRepo repository.PlayerRepository,
+	yellowCardLimit int,
+) DisciplinaryService {
+	return &disciplinaryService{
+		matchRepo:       matchRepo,
+		eventRepo:       eventRepo,
+		playerRepo:      playerRepo,
+		yellowCardLimit: yellowCardLimit,
+	}
+}
+
+// suspension is a ban a player still has to serve in their team's next matches.
+type suspension struct {
+	teamID  uuid.UUID
+	reason  string
+	matches int
+}
+
+// GetMatchSuspensions lists the players of both teams who are suspended for a match,
+// home team first and by name.
+func (s *disciplinaryService) GetMatchSuspensions(ctx context.Context, matchID uuid.UUID) (*dto.MatchSuspensionsResponse, error) {
+	match, err := s.matchRepo.FindByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for suspensions", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	bans, err := s.suspensions(ctx, *match)
+	if err != nil {
+		return nil, err
+	}
+
+	playerIDs := make([]uuid.UUID, 0, len(bans))
+	for playerID := range bans {
+		playerIDs = append(playerIDs, playerID)
+	}
+	players, err := s.playerRepo.FindByIDs(playerIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch suspended players", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	names := make(map[uuid.UUID]string, len(players))
+	for _, player := range players {
+		names[player.ID] = player.Name
+	}
+
+	resp := &dto.MatchSuspensionsResponse{MatchID: match.ID.String(), Suspensions: make([]dto.SuspensionResponse, 0, len(bans))}
+	for playerID, ban := range bans {
+		resp.Suspensions = append(resp.Suspensions, dto.SuspensionResponse{
+			PlayerID:         playerID.String(),
+			Name:             names[playerID],
+			TeamID:           ban.teamID.String(),
+			Reason:           ban.reason,
+			MatchesRemaining: ban.matches,
+		})
+	}
+	homeTeamID := match.HomeTeamID.String()
+	sort.Slice(resp.Suspensions, func(i, j int) bool {
+		a, b := resp.Suspensions[i], resp.Suspensions[j]
+		if a.TeamID != b.TeamID {
+			return a.TeamID == homeTeamID
+		}
+		return a.Name < b.Name
+	})
+	return resp, nil
+}
+
+// CheckEligibility rejects the first of playerIDs that is suspended for the match.
+// labels name each player in the error, e.g. "Starter #3" or "Event #2".
+func (s *disciplinaryService) CheckEligibility(ctx context.Context, match model.Match, playerIDs []uuid.UUID, labels map[uuid.UUID]string) error {
+	bans, err := s.suspensions(ctx, match)
+	if err != nil {
+		return err
+	}
+	for _, playerID := range playerIDs {
+		if ban, ok := bans[playerID]; ok {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: player is suspended (%s)", labels[playerID], ban.reason)).WithCode(CodePlayerSuspended)
+		}
+	}
+	return nil
+}
+
+// suspensions works out the bans both teams' players carry into a match from the cards of
+// the teams' earlier completed matches in the same season.
+func (s *disciplinaryService) suspensions(ctx context.Context, match model.Match) (map[uuid.UUID]*suspension, error) {
+	matches, err := s.matchRepo.FindByTeamIDs([]uuid.UUID{match.HomeTeamID, match.AwayTeamID}, model.MatchStatusCompleted)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch earlier matches for suspensions", "error", err, "match_id", match.ID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	earlier := make([]model.Match, 0, len(matches))
+	matchIDs := make([]uuid.UUID, 0, len(matches))
+	for _, m := range matches {
+		if m.ID != match.ID && m.MatchDatetime.Before(match.MatchDatetime) && sameSeason(m.SeasonID, match.SeasonID) {
+			earlier = append(earlier, m)
+			matchIDs = append(matchIDs, m.ID)
+		}
+	}
+
+	events, err := s.eventRepo.FindByMatchIDs(matchIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch cards for suspensions", "error", err, "match_id", match.ID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	bans := computeSuspensions(match.HomeTeamID, earlier, events, s.yellowCardLimit)
+	for playerID, ban := range computeSuspensions(match.AwayTeamID, earlier, events, s.yellowCardLimit) {
+		bans[playerID] = ban
+	}
+	return bans, nil
+}
+
+// computeSuspensions walks a team's matches in kick-off order and returns the bans its players
+// still have to serve afterwards. A red card, or two yellow cards in one match, bans the player
+// for the team's next match; so does every yellowCardLimit-th yellow card otherwise received
+// (0 disables accumulation). Each match the team plays counts towards serving a ban.
+func computeSuspensions(teamID uuid.UUID, matches []model.Match, events []model.MatchEvent, yellowCardLimit int) map[uuid.UUID]*suspension {
+	cards := make(map[uuid.UUID][]model.MatchEvent)
+	for _, event := range events {
+		if event.TeamID == teamID && (event.Type == model.EventYellowCard || event.Type == model.EventRedCard) {
+			cards[event.MatchID] = append(cards[event.MatchID], event)
+		}
+	}
+
+	bans := make(map[uuid.UUID]*suspension)
+	ban := func(playerID uuid.UUID, reason string) {
+		if existing, ok := bans[playerID]; ok {
+			existing.matches++
+			existing.reason += ", " + reason
+			return
+		}
+		bans[playerID] = &suspension{teamID: teamID, reason: reason, matches: 1}
+	}
+
+	yellows := make(map[uuid.UUID]int)
+	for _, match := range matches {
+		if match.HomeTeamID != teamID && match.AwayTeamID != teamID {
+			continue
+		}
+		for playerID, existing := range bans {
+			if existing.matches--; existing.matches == 0 {
+				delete(bans, playerID)
+			}
+		}
+
+		matchYellows := make(map[uuid.UUID]int)
+		sentOff := make(map[uuid.UUID]bool)
+		for _, card := range cards[match.ID] {
+			if card.Type == model.EventRedCard {
+				sentOff[card.PlayerID] = true
+			} else {
+				matchYellows[card.PlayerID]++
+			}
+		}
+		for playerID := range sentOff {
+			ban(playerID, "red card")
+		}
+		for playerID, n := range matchYellows {
+			if n >= 2 {
+				if !sentOff[playerID] {
+					ban(playerID, "two yellow cards in one match")
+				}
+				continue
+			}
+			yellows[playerID] += n
+			if yellowCardLimit > 0 && yellows[playerID] >= yellowCardLimit {
+				yellows[playerID] -= yellowCardLimit
+				ban(playerID, fmt.Sprintf("%d yellow cards", yellowCardLimit))
+			}
+		}
+	}
+	return bans
+}
+
+// sameSeason reports whether two matches belong to the same season, or both to none.
+func sameSeason(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
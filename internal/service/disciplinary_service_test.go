@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func cardEvent(match model.Match, teamID, playerID uuid.UUID, cardType string) model.MatchEvent {
+	return model.MatchEvent{MatchID: match.ID, Type: cardType, PlayerID: playerID, TeamID: teamID, Minute: 30}
+}
+
+func TestComputeSuspensions(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	rizky := uuid.Must(uuid.NewV7())
+	marko := uuid.Must(uuid.NewV7())
+
+	first := completedMatch(persija, persib, 1, 0)
+	second := completedMatch(arema, persija, 0, 0)
+	third := completedMatch(persija, arema, 2, 1)
+	elsewhere := completedMatch(persib, arema, 1, 1)
+
+	tests := []struct {
+		name    string
+		matches []model.Match
+		events  []model.MatchEvent
+		limit   int
+		want    map[uuid.UUID]*suspension
+	}{
+		{
+			name:    "red card in the last match",
+			matches: []model.Match{first, second},
+			events:  []model.MatchEvent{cardEvent(second, persija.ID, rizky, model.EventRedCard)},
+			limit:   5,
+			want:    map[uuid.UUID]*suspension{rizky: {teamID: persija.ID, reason: "red card", matches: 1}},
+		},
+		{
+			name:    "ban served in the team's next match",
+			matches: []model.Match{first, second},
+			events:  []model.MatchEvent{cardEvent(first, persija.ID, rizky, model.EventRedCard)},
+			limit:   5,
+			want:    map[uuid.UUID]*suspension{},
+		},
+		{
+			name:    "matches of other teams do not serve the ban",
+			matches: []model.Match{first, elsewhere},
+			events:  []model.MatchEvent{cardEvent(first, persija.ID, rizky, model.EventRedCard)},
+			limit:   5,
+			want:    map[uuid.UUID]*suspension{rizky: {teamID: persija.ID, reason: "red card", matches: 1}},
+		},
+		{
+			name:    "two yellow cards in one match",
+			matches: []model.Match{first},
+			events: []model.MatchEvent{
+				cardEvent(first, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(first, persija.ID, rizky, model.EventYellowCard),
+			},
+			limit: 5,
+			want:  map[uuid.UUID]*suspension{rizky: {teamID: persija.ID, reason: "two yellow cards in one match", matches: 1}},
+		},
+		{
+			name:    "accumulated yellow cards",
+			matches: []model.Match{first, second, third},
+			events: []model.MatchEvent{
+				cardEvent(first, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(second, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(third, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(third, persija.ID, marko, model.EventYellowCard),
+			},
+			limit: 3,
+			want:  map[uuid.UUID]*suspension{rizky: {teamID: persija.ID, reason: "3 yellow cards", matches: 1}},
+		},
+		{
+			name:    "yellow card accumulation disabled",
+			matches: []model.Match{first, second, third},
+			events: []model.MatchEvent{
+				cardEvent(first, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(second, persija.ID, rizky, model.EventYellowCard),
+				cardEvent(third, persija.ID, rizky, model.EventYellowCard),
+			},
+			limit: 0,
+			want:  map[uuid.UUID]*suspension{},
+		},
+		{
+			name:    "cards of the opponent are ignored",
+			matches: []model.Match{first},
+			events:  []model.MatchEvent{cardEvent(first, persib.ID, marko, model.EventRedCard)},
+			limit:   5,
+			want:    map[uuid.UUID]*suspension{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeSuspensions(persija.ID, tt.matches, tt.events, tt.limit))
+		})
+	}
+}
+
+func TestDisciplinaryService_GetMatchSuspensions(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	rizky := uuid.Must(uuid.NewV7())
+	marko := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	otherSeasonID := uuid.Must(uuid.NewV7())
+	kickoff := time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC)
+
+	match := &model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: homeID, AwayTeamID: awayID, SeasonID: &seasonID, MatchDatetime: kickoff}
+	earlier := model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: awayID, AwayTeamID: homeID, SeasonID: &seasonID, MatchDatetime: kickoff.AddDate(0, 0, -7)}
+	lastSeason := model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: homeID, AwayTeamID: awayID, SeasonID: &otherSeasonID, MatchDatetime: kickoff.AddDate(0, 0, -14)}
+	later := model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: homeID, AwayTeamID: awayID, SeasonID: &seasonID, MatchDatetime: kickoff.AddDate(0, 0, 7)}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockMatchRepository, *mocks.MockMatchEventRepository, *mocks.MockPlayerRepository)
+		wantErr     bool
+		errContains string
+		want        []dto.SuspensionResponse
+	}{
+		{
+			name: "only earlier matches of the same season count",
+			setup: func(mr *mocks.MockMatchRepository, er *mocks.MockMatchEventRepository, pr *mocks.MockPlayerRepository) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				mr.EXPECT().FindByTeamIDs([]uuid.UUID{homeID, awayID}, model.MatchStatusCompleted).Return([]model.Match{lastSeason, earlier, later}, nil)
+				er.EXPECT().FindByMatchIDs([]uuid.UUID{earlier.ID}).Return([]model.MatchEvent{
+					cardEvent(earlier, awayID, marko, model.EventRedCard),
+					cardEvent(earlier, homeID, rizky, model.EventRedCard),
+				}, nil)
+				pr.EXPECT().FindByIDs(mock.Anything).Return([]model.Player{
+					{Base: model.Base{ID: rizky}, Name: "Rizky Ridho"},
+					{Base: model.Base{ID: marko}, Name: "Marko Simic"},
+				}, nil)
+			},
+			want: []dto.SuspensionResponse{
+				{PlayerID: rizky.String(), Name: "Rizky Ridho", TeamID: homeID.String(), Reason: "red card", MatchesRemaining: 1},
+				{PlayerID: marko.String(), Name: "Marko Simic", TeamID: awayID.String(), Reason: "red card", MatchesRemaining: 1},
+			},
+		},
+		{
+			name: "no suspensions",
+			setup: func(mr *mocks.MockMatchRepository, er *mocks.MockMatchEventRepository, pr *mocks.MockPlayerRepository) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				mr.EXPECT().FindByTeamIDs([]uuid.UUID{homeID, awayID}, model.MatchStatusCompleted).Return([]model.Match{}, nil)
+				er.EXPECT().FindByMatchIDs([]uuid.UUID{}).Return([]model.MatchEvent{}, nil)
+				pr.EXPECT().FindByIDs([]uuid.UUID{}).Return([]model.Player{}, nil)
+			},
+			want: []dto.SuspensionResponse{},
+		},
+		{
+			name: "match not found",
+			setup: func(mr *mocks.MockMatchRepository, er *mocks.MockMatchEventRepository, pr *mocks.MockPlayerRepository) {
+				mr.EXPECT().FindByID(match.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Match not found",
+		},
+		{
+			name: "db error",
+			setup: func(mr *mocks.MockMatchRepository, er *mocks.MockMatchEventRepository, pr *mocks.MockPlayerRepository) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				mr.EXPECT().FindByTeamIDs([]uuid.UUID{homeID, awayID}, model.MatchStatusCompleted).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			eventRepo := mocks.NewMockMatchEventRepository(t)
+			playerRepo := mocks.NewMockPlayerRepository(t)
+			tt.setup(matchRepo, eventRepo, playerRepo)
+			svc := NewDisciplinaryService(matchRepo, eventRepo, playerRepo, 5)
+
+			resp, err := svc.GetMatchSuspensions(context.Background(), match.ID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, match.ID.String(), resp.MatchID)
+			assert.Equal(t, tt.want, resp.Suspensions)
+		})
+	}
+}
+
+func TestDisciplinaryService_CheckEligibility(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	rizky := uuid.Must(uuid.NewV7())
+	marko := uuid.Must(uuid.NewV7())
+	kickoff := time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC)
+
+	match := model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: homeID, AwayTeamID: awayID, MatchDatetime: kickoff}
+	earlier := model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: homeID, AwayTeamID: awayID, MatchDatetime: kickoff.AddDate(0, 0, -7)}
+
+	matchRepo := mocks.NewMockMatchRepository(t)
+	eventRepo := mocks.NewMockMatchEventRepository(t)
+	matchRepo.EXPECT().FindByTeamIDs([]uuid.UUID{homeID, awayID}, model.MatchStatusCompleted).Return([]model.Match{earlier}, nil)
+	eventRepo.EXPECT().FindByMatchIDs([]uuid.UUID{earlier.ID}).Return([]model.MatchEvent{
+		cardEvent(earlier, homeID, rizky, model.EventYellowCard),
+		cardEvent(earlier, homeID, rizky, model.EventYellowCard),
+	}, nil)
+	svc := NewDisciplinaryService(matchRepo, eventRepo, mocks.NewMockPlayerRepository(t), 5)
+
+	err := svc.CheckEligibility(context.Background(), match, []uuid.UUID{marko, rizky}, map[uuid.UUID]string{
+		marko: "Starter #1",
+		rizky: "Starter #2",
+	})
+
+	var appErr *errs.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+	assert.Equal(t, "Starter #2: player is suspended (two yellow cards in one match)", appErr.Message)
+}
@@ -0,0 +1,87 @@
+package service
+
+// Error codes of the domain errors the API returns, in addition to the generic codes of
+// package errs. Clients branch on them, so they never change once published.
+const (
+	// Authentication and authorization
+	CodeInvalidCredentials     = "INVALID_CREDENTIALS"
+	CodeAccountLocked          = "ACCOUNT_LOCKED"
+	CodeInvalidAccessToken     = "INVALID_ACCESS_TOKEN"
+	CodeInvalidRefreshToken    = "INVALID_REFRESH_TOKEN"
+	CodeInvalidAPIKey          = "INVALID_API_KEY"
+	CodeInsufficientRole       = "INSUFFICIENT_ROLE"
+	CodeAPIKeyScopeMissing     = "API_KEY_SCOPE_MISSING"
+	CodePasswordChangeRequired = "PASSWORD_CHANGE_REQUIRED"
+	CodeConfirmationRequired   = "CONFIRMATION_REQUIRED"
+	CodeInvalidConfirmation    = "INVALID_CONFIRMATION"
+	CodeTeamNotManaged         = "TEAM_NOT_MANAGED"
+	CodeMaintenance            = "MAINTENANCE"
+
+	// Passwords and admin accounts
+	CodePasswordIncorrect = "PASSWORD_INCORRECT"
+	CodePasswordTooWeak   = "PASSWORD_TOO_WEAK"
+	CodePasswordReused    = "PASSWORD_REUSED"
+	CodeUsernameTaken     = "USERNAME_TAKEN"
+	CodeLastSuperAdmin    = "LAST_SUPER_ADMIN"
+
+	// Missing records
+	CodeAbsenceNotFound     = "ABSENCE_NOT_FOUND"
+	CodeAdminNotFound       = "ADMIN_NOT_FOUND"
+	CodeAPIKeyNotFound      = "API_KEY_NOT_FOUND"
+	CodeAttachmentNotFound  = "ATTACHMENT_NOT_FOUND"
+	CodeCoachNotFound       = "COACH_NOT_FOUND"
+	CodeCompetitionNotFound = "COMPETITION_NOT_FOUND"
+	CodeGroupNotFound       = "GROUP_NOT_FOUND"
+	CodeMatchNotFound       = "MATCH_NOT_FOUND"
+	CodeRoundNotFound       = "ROUND_NOT_FOUND"
+	CodePlayerNotFound      = "PLAYER_NOT_FOUND"
+	CodeRefereeNotFound     = "REFEREE_NOT_FOUND"
+	CodeSeasonNotFound      = "SEASON_NOT_FOUND"
+	CodeStadiumNotFound     = "STADIUM_NOT_FOUND"
+	CodeTeamNotFound        = "TEAM_NOT_FOUND"
+	CodeWebhookNotFound     = "WEBHOOK_NOT_FOUND"
+
+	// Conflicts with the current state
+	CodeVersionConflict       = "VERSION_CONFLICT"
+	CodeJerseyConflict        = "JERSEY_CONFLICT"
+	CodeHeadCoachExists       = "HEAD_COACH_EXISTS"
+	CodeScheduleConflict      = "SCHEDULE_CONFLICT"
+	CodeRoundConflict         = "ROUND_CONFLICT"
+	CodeTeamHasPlayers        = "TEAM_HAS_PLAYERS"
+	CodeTeamHasMatches        = "TEAM_HAS_MATCHES"
+	CodeCompetitionHasSeasons = "COMPETITION_HAS_SEASONS"
+	CodeSeasonHasMatches      = "SEASON_HAS_MATCHES"
+	CodeStadiumInUse          = "STADIUM_IN_USE"
+	CodeRefereeAssigned       = "REFEREE_ASSIGNED"
+	CodeTeamNameTaken         = "TEAM_NAME_TAKEN"
+	CodeTeamAbbreviationTaken = "TEAM_ABBREVIATION_TAKEN"
+	CodeTeamInOtherGroup      = "TEAM_IN_OTHER_GROUP"
+
+	// Match rules
+	CodeMatchAlreadyCompleted   = "MATCH_ALREADY_COMPLETED"
+	CodeMatchNotCompleted       = "MATCH_NOT_COMPLETED"
+	CodeResultUndoExpired       = "RESULT_UNDO_EXPIRED"
+	CodeMatchNotStarted         = "MATCH_NOT_STARTED"
+	CodeInvalidStatusTransition = "INVALID_STATUS_TRANSITION"
+	CodeKickoffNotInFuture      = "KICKOFF_NOT_IN_FUTURE"
+	CodeSameTeams               = "SAME_TEAMS"
+	CodeRoundWithoutSeason      = "ROUND_WITHOUT_SEASON"
+	CodeInvalidMatchEvent       = "INVALID_MATCH_EVENT"
+	CodeInvalidShootout         = "INVALID_SHOOTOUT"
+	CodeInvalidManOfTheMatch    = "INVALID_MAN_OF_THE_MATCH"
+	CodePlayerNotInTeam         = "PLAYER_NOT_IN_TEAM"
+	CodePlayerSuspended         = "PLAYER_SUSPENDED"
+	CodePlayerUnavailable       = "PLAYER_UNAVAILABLE"
+	CodeInvalidLineup           = "INVALID_LINEUP"
+	CodeInvalidOfficials        = "INVALID_OFFICIALS"
+	CodeInvalidAttachment       = "INVALID_ATTACHMENT"
+
+	// Players
+	CodePlayerAlreadyInTeam = "PLAYER_ALREADY_IN_TEAM"
+	CodeInvalidImportFile   = "INVALID_IMPORT_FILE"
+	CodeInvalidPhoto        = "INVALID_PHOTO"
+	CodeRosterRulesViolated = "ROSTER_RULES_VIOLATED"
+
+	// Group stage
+	CodeInvalidGroup = "INVALID_GROUP"
+)
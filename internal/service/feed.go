@@ -0,0 +1,43 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+)
+
+// Event types published on the event bus, with their payloads. Streaming endpoints and
+// webhooks deliver them unchanged, so names and payloads are part of the public API.
+const (
+	FeedMatchCreated       = "match.created"        // dto.MatchResponse
+	FeedMatchStatusChanged = "match.status_changed" // dto.MatchStatusChangedEvent
+	FeedMatchGoal          = "match.goal"           // dto.MatchGoalEvent
+	FeedMatchCompleted     = "match.completed"      // dto.MatchResponse, with events
+	FeedMatchResultUpdated = "match.result_updated" // dto.MatchResponse, with events
+	FeedMatchDeleted       = "match.deleted"        // dto.MatchDeletedEvent
+
+	FeedTeamCreated = "team.created" // dto.TeamResponse
+	FeedTeamUpdated = "team.updated" // dto.TeamResponse
+	FeedTeamDeleted = "team.deleted" // dto.TeamDeletedEvent
+
+	FeedPlayerTransferred = "player.transferred" // dto.PlayerTransferredEvent
+)
+
+// EncodeEvent wraps an event in the dto.EventPayload envelope under a new event ID.
+func EncodeEvent(event events.Event) (uuid.UUID, []byte, error) {
+	eventID := uuid.Must(uuid.NewV7())
+	payload, err := json.Marshal(dto.EventPayload{
+		ID:        eventID.String(),
+		Type:      event.Type,
+		CreatedAt: timefmt.Format(event.Time),
+		Data:      event.Data,
+	})
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("encode %s event: %w", event.Type, err)
+	}
+	return eventID, payload, nil
+}
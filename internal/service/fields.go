@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/fields"
+)
+
+// fieldSource lists the columns and associations a response field is made from.
+type fieldSource struct {
+	columns      []string
+	associations []string
+}
+
+// teamFieldSources, playerFieldSources and matchFieldSources map the response fields that
+// are not read from the column of the same name.
+var (
+	teamFieldSources = map[string]fieldSource{
+		"head_coach": {associations: []string{"HeadCoach"}},
+	}
+	playerFieldSources = map[string]fieldSource{
+		"age":  {columns: []string{"birth_date"}},
+		"team": {columns: []string{"team_id"}, associations: []string{"Team"}},
+	}
+	matchFieldSources = map[string]fieldSource{
+		"local_datetime": {columns: []string{"match_datetime"}},
+		"timezone":       {},
+		"home_team":      {columns: []string{"home_team_id"}, associations: []string{"HomeTeam"}},
+		"away_team":      {columns: []string{"away_team_id"}, associations: []string{"AwayTeam"}},
+		"venue":          {columns: []string{"venue_id"}, associations: []string{"Venue"}},
+		"events":         {}, // Only in match details
+	}
+)
+
+// parseFields turns the fields parameter of a listing returning R into the projection that
+// loads only what those fields are made from. Fields must be JSON names of R; an empty
+// parameter loads everything.
+func parseFields[R any](raw string, sources map[string]fieldSource) (repository.Projection, error) {
+	names := fields.Parse(raw)
+	if len(names) == 0 {
+		return repository.Projection{}, nil
+	}
+
+	valid := fields.Names[R]()
+	projection := repository.Projection{Columns: []string{}}
+	for _, name := range names {
+		if !slices.Contains(valid, name) {
+			return repository.Projection{}, errs.ErrBadRequest(fmt.Sprintf("Unknown field %q in fields, expected some of: %s", name, strings.Join(valid, ", ")))
+		}
+		source, ok := sources[name]
+		if !ok {
+			source = fieldSource{columns: []string{name}}
+		}
+		projection.Columns = append(projection.Columns, source.columns...)
+		projection.Associations = append(projection.Associations, source.associations...)
+	}
+	return projection, nil
+}
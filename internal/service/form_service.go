@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// formLength is the number of latest results shown in a form string.
+const formLength = 5
+
+// Results of a match from one team's point of view, as written in form strings.
+const (
+	resultWin  = 'W'
+	resultDraw = 'D'
+	resultLoss = 'L'
+)
+
+// FormService defines the contract for team form and streaks.
+type FormService interface {
+	GetTeamForm(ctx context.Context, teamID uuid.UUID, seasonFilter dto.SeasonFilterQuery) (*dto.TeamStatsResponse, error)
+}
+
+type formService struct {
+	teamRepo  repository.TeamRepository
+	matchRepo repository.MatchRepository
+}
+
+// NewFormService creates a new FormService instance.
+func NewFormService(teamRepo repository.TeamRepository, matchRepo repository.MatchRepository) FormService {
+	return &formService{teamRepo: teamRepo, matchRepo: matchRepo}
+}
+
+// GetTeamForm returns a team's record, latest form and current streaks over its completed
+// matches, optionally limited to one season.
+func (s *formService) GetTeamForm(ctx context.Context, teamID uuid.UUID, seasonFilter dto.SeasonFilterQuery) (*dto.TeamStatsResponse, error) {
+	filter, err := parseSeasonFilter(seasonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.FindByID(teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	filter.TeamID = &teamID
+	matches, err := s.matchRepo.FindAllCompleted(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for team form", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.TeamStatsResponse{Team: toTeamResponse(*team)}
+	for _, match := range matches {
+		goalsFor, goalsAgainst := match.HomeScore, match.AwayScore
+		if match.AwayTeamID == teamID {
+			goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		}
+		resp.Played++
+		resp.GoalsFor += goalsFor
+		resp.GoalsAgainst += goalsAgainst
+		switch matchResult(match, teamID) {
+		case resultWin:
+			resp.Won++
+		case resultDraw:
+			resp.Drawn++
+		default:
+			resp.Lost++
+		}
+	}
+	resp.TeamForm = computeForm(teamResults(matches)[teamID])
+	return resp, nil
+}
+
+// matchResult returns the result of a completed match for teamID, counting shootouts as the
+// match's competition does (see model.Match.Winner).
+func matchResult(match model.Match, teamID uuid.UUID) byte {
+	switch match.Winner() {
+	case uuid.Nil:
+		return resultDraw
+	case teamID:
+		return resultWin
+	default:
+		return resultLoss
+	}
+}
+
+// teamResults returns the results of every team in matches, in the order of matches.
+// Matches must be in kick-off order for the results to be too.
+func teamResults(matches []model.Match) map[uuid.UUID][]byte {
+	results := make(map[uuid.UUID][]byte)
+	for _, match := range matches {
+		results[match.HomeTeamID] = append(results[match.HomeTeamID], matchResult(match, match.HomeTeamID))
+		results[match.AwayTeamID] = append(results[match.AwayTeamID], matchResult(match, match.AwayTeamID))
+	}
+	return results
+}
+
+// computeForm summarises a team's results, oldest first, into its latest form and the
+// streaks still running at its most recent match.
+func computeForm(results []byte) dto.TeamForm {
+	form := dto.TeamForm{Form: string(results[max(len(results)-formLength, 0):])}
+	streak := func(match func(byte) bool) int {
+		n := 0
+		for i := len(results) - 1; i >= 0 && match(results[i]); i-- {
+			n++
+		}
+		return n
+	}
+	form.WinningStreak = streak(func(r byte) bool { return r == resultWin })
+	form.UnbeatenStreak = streak(func(r byte) bool { return r != resultLoss })
+	form.LosingStreak = streak(func(r byte) bool { return r == resultLoss })
+	return form
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestComputeForm(t *testing.T) {
+	tests := []struct {
+		name    string
+		results string
+		want    dto.TeamForm
+	}{
+		{
+			name:    "no matches",
+			results: "",
+			want:    dto.TeamForm{},
+		},
+		{
+			name:    "form keeps the last five results",
+			results: "LLWWDLWW",
+			want:    dto.TeamForm{Form: "WDLWW", WinningStreak: 2, UnbeatenStreak: 2},
+		},
+		{
+			name:    "draws extend the unbeaten streak only",
+			results: "LWWDD",
+			want:    dto.TeamForm{Form: "LWWDD", UnbeatenStreak: 4},
+		},
+		{
+			name:    "losing streak",
+			results: "WLLL",
+			want:    dto.TeamForm{Form: "WLLL", LosingStreak: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeForm([]byte(tt.results)))
+		})
+	}
+}
+
+func TestFormService_GetTeamForm(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	seasonID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		filter      dto.SeasonFilterQuery
+		setup       func(*mocks.MockTeamRepository, *mocks.MockMatchRepository)
+		wantErr     bool
+		errContains string
+		want        *dto.TeamStatsResponse
+	}{
+		{
+			name:   "record and form from home and away matches",
+			filter: dto.SeasonFilterQuery{SeasonID: seasonID.String()},
+			setup: func(tr *mocks.MockTeamRepository, mr *mocks.MockMatchRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID, TeamID: &persija.ID}).Return([]model.Match{
+					completedMatch(persija, persib, 0, 1),
+					completedMatch(arema, persija, 1, 3),
+					completedMatch(persija, arema, 2, 2),
+				}, nil)
+			},
+			want: &dto.TeamStatsResponse{
+				Played: 3, Won: 1, Drawn: 1, Lost: 1, GoalsFor: 5, GoalsAgainst: 4,
+				TeamForm: dto.TeamForm{Form: "LWD", UnbeatenStreak: 2},
+			},
+		},
+		{
+			name: "team without completed matches",
+			setup: func(tr *mocks.MockTeamRepository, mr *mocks.MockMatchRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{TeamID: &persija.ID}).Return([]model.Match{}, nil)
+			},
+			want: &dto.TeamStatsResponse{},
+		},
+		{
+			name:        "invalid season id",
+			filter:      dto.SeasonFilterQuery{SeasonID: "not-a-uuid"},
+			setup:       func(tr *mocks.MockTeamRepository, mr *mocks.MockMatchRepository) {},
+			wantErr:     true,
+			errContains: "Invalid season_id format",
+		},
+		{
+			name: "team not found",
+			setup: func(tr *mocks.MockTeamRepository, mr *mocks.MockMatchRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Team not found",
+		},
+		{
+			name: "db error",
+			setup: func(tr *mocks.MockTeamRepository, mr *mocks.MockMatchRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{TeamID: &persija.ID}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := mocks.NewMockTeamRepository(t)
+			matchRepo := mocks.NewMockMatchRepository(t)
+			tt.setup(teamRepo, matchRepo)
+			svc := NewFormService(teamRepo, matchRepo)
+
+			stats, err := svc.GetTeamForm(context.Background(), persija.ID, tt.filter)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Persija Jakarta", stats.Team.Name)
+			tt.want.Team = stats.Team
+			assert.Equal(t, tt.want, stats)
+		})
+	}
+}
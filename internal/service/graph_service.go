@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// GraphService loads related records in bulk for the GraphQL endpoint. Each method takes the
+// IDs of every parent at one level of a query and returns the related records keyed by parent
+// ID, so a nested selection costs one query per level instead of one per parent.
+// IDs that are malformed or match nothing are simply absent from the result.
+type GraphService interface {
+	TeamsByIDs(ctx context.Context, ids []string) (map[string]dto.TeamResponse, error)
+	PlayersByIDs(ctx context.Context, ids []string) (map[string]dto.PlayerResponse, error)
+	PlayersByTeamIDs(ctx context.Context, teamIDs []string) (map[string][]dto.PlayerResponse, error)
+	MatchesByIDs(ctx context.Context, ids []string) (map[string]dto.MatchResponse, error)
+	MatchesByTeamIDs(ctx context.Context, teamIDs []string, status string) (map[string][]dto.MatchResponse, error)
+	EventsByMatchIDs(ctx context.Context, matchIDs []string) (map[string][]dto.MatchEventResponse, error)
+	GoalsByPlayerIDs(ctx context.Context, playerIDs []string) (map[string][]dto.MatchEventResponse, error)
+}
+
+type graphService struct {
+	teamRepo   repository.TeamRepository
+	playerRepo repository.PlayerRepository
+	matchRepo  repository.MatchRepository
+	eventRepo  repository.MatchEventRepository
+
+	// location is the timezone of local kick-off times in responses
+	location *time.Location
+}
+
+// NewGraphService creates a new GraphService instance.
+func NewGraphService(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	eventRepo repository.MatchEventRepository,
+	location *time.Location,
+) GraphService {
+	return &graphService{
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		matchRepo:  matchRepo,
+		eventRepo:  eventRepo,
+		location:   location,
+	}
+}
+
+func (s *graphService) TeamsByIDs(ctx context.Context, ids []string) (map[string]dto.TeamResponse, error) {
+	teams, err := s.teamRepo.FindByIDs(parseIDs(ids))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load teams", "error", err, "count", len(ids))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string]dto.TeamResponse, len(teams))
+	for _, team := range teams {
+		result[team.ID.String()] = toTeamResponse(team)
+	}
+	return result, nil
+}
+
+func (s *graphService) PlayersByIDs(ctx context.Context, ids []string) (map[string]dto.PlayerResponse, error) {
+	players, err := s.playerRepo.FindByIDs(parseIDs(ids))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load players", "error", err, "count", len(ids))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string]dto.PlayerResponse, len(players))
+	for _, player := range players {
+		result[player.ID.String()] = toPlayerResponse(player)
+	}
+	return result, nil
+}
+
+// PlayersByTeamIDs returns the current squad of each team, ordered by jersey number.
+func (s *graphService) PlayersByTeamIDs(ctx context.Context, teamIDs []string) (map[string][]dto.PlayerResponse, error) {
+	players, err := s.playerRepo.FindByTeamIDs(parseIDs(teamIDs))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load team players", "error", err, "count", len(teamIDs))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string][]dto.PlayerResponse)
+	for _, player := range players {
+		teamID := player.TeamID.String()
+		result[teamID] = append(result[teamID], toPlayerResponse(player))
+	}
+	return result, nil
+}
+
+func (s *graphService) MatchesByIDs(ctx context.Context, ids []string) (map[string]dto.MatchResponse, error) {
+	matches, err := s.matchRepo.FindByIDs(parseIDs(ids))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load matches", "error", err, "count", len(ids))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string]dto.MatchResponse, len(matches))
+	for _, match := range matches {
+		result[match.ID.String()] = toMatchResponse(match, s.location)
+	}
+	return result, nil
+}
+
+// MatchesByTeamIDs returns each team's home and away matches in kick-off order,
+// optionally only those with status. A match between two requested teams is listed under both.
+func (s *graphService) MatchesByTeamIDs(ctx context.Context, teamIDs []string, status string) (map[string][]dto.MatchResponse, error) {
+	if status != "" && !slices.Contains(model.ValidMatchStatuses, status) {
+		return nil, errs.ErrBadRequest("status must be one of " + strings.Join(model.ValidMatchStatuses, ", "))
+	}
+
+	matches, err := s.matchRepo.FindByTeamIDs(parseIDs(teamIDs), status)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load team matches", "error", err, "count", len(teamIDs))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	requested := make(map[string]bool, len(teamIDs))
+	for _, id := range teamIDs {
+		requested[id] = true
+	}
+	result := make(map[string][]dto.MatchResponse)
+	for _, match := range matches {
+		resp := toMatchResponse(match, s.location)
+		for _, teamID := range []string{resp.HomeTeamID, resp.AwayTeamID} {
+			if requested[teamID] {
+				result[teamID] = append(result[teamID], resp)
+			}
+		}
+	}
+	return result, nil
+}
+
+// EventsByMatchIDs returns each match's events in chronological order.
+func (s *graphService) EventsByMatchIDs(ctx context.Context, matchIDs []string) (map[string][]dto.MatchEventResponse, error) {
+	events, err := s.eventRepo.FindByMatchIDs(parseIDs(matchIDs))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load match events", "error", err, "count", len(matchIDs))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string][]dto.MatchEventResponse)
+	for _, event := range events {
+		matchID := event.MatchID.String()
+		result[matchID] = append(result[matchID], toMatchEventResponse(event))
+	}
+	return result, nil
+}
+
+// GoalsByPlayerIDs returns the goals (including converted penalties, excluding own goals)
+// each player has scored, oldest first.
+func (s *graphService) GoalsByPlayerIDs(ctx context.Context, playerIDs []string) (map[string][]dto.MatchEventResponse, error) {
+	events, err := s.eventRepo.FindGoalsByPlayerIDs(parseIDs(playerIDs))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to batch load player goals", "error", err, "count", len(playerIDs))
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	result := make(map[string][]dto.MatchEventResponse)
+	for _, event := range events {
+		playerID := event.PlayerID.String()
+		result[playerID] = append(result[playerID], toMatchEventResponse(event))
+	}
+	return result, nil
+}
+
+// parseIDs converts IDs to UUIDs, dropping duplicates and malformed IDs (which cannot match a record).
+func parseIDs(ids []string) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil || seen[u] {
+			continue
+		}
+		seen[u] = true
+		parsed = append(parsed, u)
+	}
+	return parsed
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGraphService_PlayersByTeamIDs(t *testing.T) {
+	persija := uuid.Must(uuid.NewV7())
+	persib := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		teamIDs     []string
+		setup       func(*mocks.MockPlayerRepository)
+		wantErr     bool
+		errContains string
+		wantCounts  map[string]int
+	}{
+		{
+			name:    "groups players by team in one query",
+			teamIDs: []string{persija.String(), persib.String(), persija.String()},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByTeamIDs([]uuid.UUID{persija, persib}).Return([]model.Player{
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: persija, JerseyNumber: 1},
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: persib, JerseyNumber: 7},
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: persija, JerseyNumber: 9},
+				}, nil)
+			},
+			wantCounts: map[string]int{persija.String(): 2, persib.String(): 1},
+		},
+		{
+			name:    "malformed ids are skipped",
+			teamIDs: []string{"not-a-uuid"},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByTeamIDs([]uuid.UUID{}).Return([]model.Player{}, nil)
+			},
+			wantCounts: map[string]int{},
+		},
+		{
+			name:    "db error",
+			teamIDs: []string{persija.String()},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindByTeamIDs([]uuid.UUID{persija}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerRepo := mocks.NewMockPlayerRepository(t)
+			tt.setup(playerRepo)
+			svc := NewGraphService(mocks.NewMockTeamRepository(t), playerRepo, mocks.NewMockMatchRepository(t), mocks.NewMockMatchEventRepository(t), time.UTC)
+
+			players, err := svc.PlayersByTeamIDs(context.Background(), tt.teamIDs)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, players, len(tt.wantCounts))
+			for teamID, count := range tt.wantCounts {
+				assert.Len(t, players[teamID], count)
+			}
+		})
+	}
+}
+
+func TestGraphService_MatchesByTeamIDs(t *testing.T) {
+	persija := uuid.Must(uuid.NewV7())
+	persib := uuid.Must(uuid.NewV7())
+	arema := uuid.Must(uuid.NewV7())
+	match := func(home, away uuid.UUID) model.Match {
+		return model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, HomeTeamID: home, AwayTeamID: away, Status: model.MatchStatusCompleted}
+	}
+
+	tests := []struct {
+		name        string
+		teamIDs     []string
+		status      string
+		setup       func(*mocks.MockMatchRepository)
+		wantErr     bool
+		errContains string
+		wantCounts  map[string]int
+	}{
+		{
+			name:    "match between two requested teams is listed under both",
+			teamIDs: []string{persija.String(), persib.String()},
+			status:  model.MatchStatusCompleted,
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindByTeamIDs([]uuid.UUID{persija, persib}, model.MatchStatusCompleted).Return([]model.Match{
+					match(persija, persib),
+					match(arema, persija),
+				}, nil)
+			},
+			wantCounts: map[string]int{persija.String(): 2, persib.String(): 1, arema.String(): 0},
+		},
+		{
+			name:        "invalid status",
+			teamIDs:     []string{persija.String()},
+			status:      "finished",
+			setup:       func(mr *mocks.MockMatchRepository) {},
+			wantErr:     true,
+			errContains: "status must be one of",
+		},
+		{
+			name:    "db error",
+			teamIDs: []string{persija.String()},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindByTeamIDs([]uuid.UUID{persija}, "").Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			tt.setup(matchRepo)
+			svc := NewGraphService(mocks.NewMockTeamRepository(t), mocks.NewMockPlayerRepository(t), matchRepo, mocks.NewMockMatchEventRepository(t), time.UTC)
+
+			matches, err := svc.MatchesByTeamIDs(context.Background(), tt.teamIDs, tt.status)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			for teamID, count := range tt.wantCounts {
+				assert.Len(t, matches[teamID], count)
+			}
+		})
+	}
+}
+
+func TestGraphService_GoalsByPlayerIDs(t *testing.T) {
+	scorer := uuid.Must(uuid.NewV7())
+	eventRepo := mocks.NewMockMatchEventRepository(t)
+	eventRepo.EXPECT().FindGoalsByPlayerIDs([]uuid.UUID{scorer}).Return([]model.MatchEvent{
+		{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, PlayerID: scorer, Type: model.EventGoal, Minute: 12},
+		{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, PlayerID: scorer, Type: model.EventPenalty, Minute: 80},
+	}, nil)
+	svc := NewGraphService(mocks.NewMockTeamRepository(t), mocks.NewMockPlayerRepository(t), mocks.NewMockMatchRepository(t), eventRepo, time.UTC)
+
+	goals, err := svc.GoalsByPlayerIDs(context.Background(), []string{scorer.String()})
+
+	assert.NoError(t, err)
+	if assert.Len(t, goals[scorer.String()], 2) {
+		assert.Equal(t, 12, goals[scorer.String()][0].Minute)
+		assert.Equal(t, model.EventPenalty, goals[scorer.String()][1].Type)
+	}
+}
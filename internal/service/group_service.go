@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// GroupService defines the contract for a season's group stage: its groups, their teams and
+// the group tables deciding who advances to the knockout stage.
+type GroupService interface {
+	GetBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]dto.GroupResponse, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.GroupResponse, error)
+	Create(ctx context.Context, seasonID uuid.UUID, req dto.GroupRequest) (*dto.GroupResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.GroupRequest) (*dto.GroupResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetStandings(ctx context.Context, seasonID uuid.UUID) ([]dto.GroupStandingsResponse, error)
+}
+
+type groupService struct {
+	groupRepo  repository.GroupRepository
+	seasonRepo repository.SeasonRepository
+	teamRepo   repository.TeamRepository
+	matchRepo  repository.MatchRepository
+}
+
+// NewGroupService creates a new GroupService instance.
+func NewGroupService(groupRepo repository.GroupRepository, seasonRepo repository.SeasonRepository, teamRepo repository.TeamRepository, matchRepo repository.MatchRepository) GroupService {
+	return &groupService{
+		groupRepo:  groupRepo,
+		seasonRepo: seasonRepo,
+		teamRepo:   teamRepo,
+		matchRepo:  matchRepo,
+	}
+}
+
+// GetBySeasonID returns the groups of a season by name.
+func (s *groupService) GetBySeasonID(ctx context.Context, seasonID uuid.UUID) ([]dto.GroupResponse, error) {
+	groups, err := s.seasonGroups(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.GroupResponse, len(groups))
+	for i, group := range groups {
+		resp[i] = toGroupResponse(group)
+	}
+	return resp, nil
+}
+
+func (s *groupService) GetByID(ctx context.Context, id uuid.UUID) (*dto.GroupResponse, error) {
+	group, err := s.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toGroupResponse(*group)
+	return &resp, nil
+}
+
+// Create adds a group with its teams to a season.
+func (s *groupService) Create(ctx context.Context, seasonID uuid.UUID, req dto.GroupRequest) (*dto.GroupResponse, error) {
+	groups, err := s.seasonGroups(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	group := model.Group{SeasonID: seasonID}
+	if err := s.applyGroupRequest(ctx, &group, groups, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.Create(&group); err != nil {
+		slog.ErrorContext(ctx, "failed to create group", "error", err, "season_id", seasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toGroupResponse(group)
+	return &resp, nil
+}
+
+// Update renames a group and replaces its teams and number of qualifiers.
+func (s *groupService) Update(ctx context.Context, id uuid.UUID, req dto.GroupRequest) (*dto.GroupResponse, error) {
+	group, err := s.findGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.groupRepo.FindBySeasonID(group.SeasonID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch groups", "error", err, "season_id", group.SeasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if err := s.applyGroupRequest(ctx, group, groups, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepo.Update(group); err != nil {
+		slog.ErrorContext(ctx, "failed to update group", "error", err, "group_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toGroupResponse(*group)
+	return &resp, nil
+}
+
+// Delete removes a group, freeing its teams to join another group. Matches are kept.
+func (s *groupService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.findGroup(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.groupRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete group", "error", err, "group_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// GetStandings returns the table of every group of a season, by group name. A group table
+// counts the season's completed matches between teams of the group and marks the top
+// Qualifiers places as advancing; the teams in them are what a knockout draw is seeded from.
+func (s *groupService) GetStandings(ctx context.Context, seasonID uuid.UUID) ([]dto.GroupStandingsResponse, error) {
+	groups, err := s.seasonGroups(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for group standings", "error", err, "season_id", seasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := make([]dto.GroupStandingsResponse, len(groups))
+	for i, group := range groups {
+		teams := make([]model.Team, 0, len(group.Teams))
+		inGroup := make(map[uuid.UUID]bool, len(group.Teams))
+		for _, member := range group.Teams {
+			inGroup[member.TeamID] = true
+			if member.Team != nil {
+				teams = append(teams, *member.Team)
+			} else {
+				teams = append(teams, model.Team{Base: model.Base{ID: member.TeamID}})
+			}
+		}
+
+		var groupMatches []model.Match
+		for _, match := range matches {
+			if inGroup[match.HomeTeamID] && inGroup[match.AwayTeamID] {
+				groupMatches = append(groupMatches, match)
+			}
+		}
+
+		table := dto.GroupStandingsResponse{
+			GroupID:    group.ID.String(),
+			Name:       group.Name,
+			Qualifiers: group.Qualifiers,
+			Standings:  computeStandings(groupMatches, teams...),
+			Qualified:  []dto.TeamResponse{},
+		}
+		for j := range table.Standings {
+			if table.Standings[j].Position <= group.Qualifiers {
+				table.Standings[j].Qualifies = true
+				table.Qualified = append(table.Qualified, table.Standings[j].Team)
+			}
+		}
+		resp[i] = table
+	}
+	return resp, nil
+}
+
+// seasonGroups returns the groups of a season after checking the season exists.
+func (s *groupService) seasonGroups(ctx context.Context, seasonID uuid.UUID) ([]model.Group, error) {
+	if _, err := s.seasonRepo.FindByID(seasonID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Season not found").WithCode(CodeSeasonNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch season", "error", err, "season_id", seasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	groups, err := s.groupRepo.FindBySeasonID(seasonID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch groups", "error", err, "season_id", seasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return groups, nil
+}
+
+func (s *groupService) findGroup(ctx context.Context, id uuid.UUID) (*model.Group, error) {
+	group, err := s.groupRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Group not found").WithCode(CodeGroupNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch group", "error", err, "group_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return group, nil
+}
+
+// applyGroupRequest validates req against the other groups of the season and sets the group's
+// name, qualifiers and teams from it. Teams must exist, be listed once, and not play in
+// another group of the season.
+func (s *groupService) applyGroupRequest(ctx context.Context, group *model.Group, seasonGroups []model.Group, req dto.GroupRequest) error {
+	teamIDs := make([]uuid.UUID, 0, len(req.TeamIDs))
+	for _, raw := range req.TeamIDs {
+		teamID, err := uuid.Parse(raw)
+		if err != nil {
+			return errs.ErrBadRequest("Invalid team_ids format")
+		}
+		if slices.Contains(teamIDs, teamID) {
+			return errs.ErrBadRequest("A team is listed more than once in the group").WithCode(CodeInvalidGroup)
+		}
+		teamIDs = append(teamIDs, teamID)
+	}
+
+	qualifiers := req.Qualifiers
+	if qualifiers == 0 {
+		qualifiers = model.DefaultGroupQualifiers
+	}
+	if qualifiers > len(teamIDs) {
+		return errs.ErrBadRequest(fmt.Sprintf("A group of %d teams cannot have %d qualifiers", len(teamIDs), qualifiers)).WithCode(CodeInvalidGroup)
+	}
+
+	teams, err := s.teamRepo.FindByIDs(teamIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch teams for group", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	byID := make(map[uuid.UUID]model.Team, len(teams))
+	for _, team := range teams {
+		// FindByIDs includes soft-deleted teams, which cannot be drawn into a group
+		if !team.DeletedAt.Valid {
+			byID[team.ID] = team
+		}
+	}
+
+	members := make([]model.GroupTeam, len(teamIDs))
+	for i, teamID := range teamIDs {
+		team, ok := byID[teamID]
+		if !ok {
+			return errs.ErrNotFound(fmt.Sprintf("Team %s not found", teamID)).WithCode(CodeTeamNotFound)
+		}
+		for _, other := range seasonGroups {
+			if other.ID == group.ID {
+				continue
+			}
+			if slices.ContainsFunc(other.Teams, func(member model.GroupTeam) bool { return member.TeamID == teamID }) {
+				return errs.ErrConflict(fmt.Sprintf("%s already plays in %s", team.Name, other.Name)).WithCode(CodeTeamInOtherGroup)
+			}
+		}
+		members[i] = model.GroupTeam{GroupID: group.ID, SeasonID: group.SeasonID, TeamID: teamID, Team: &team}
+	}
+
+	group.Name = req.Name
+	group.Qualifiers = qualifiers
+	group.Teams = members
+	return nil
+}
+
+// toGroupResponse converts a model.Group with its teams to dto.GroupResponse.
+func toGroupResponse(group model.Group) dto.GroupResponse {
+	resp := dto.GroupResponse{
+		ID:         group.ID.String(),
+		SeasonID:   group.SeasonID.String(),
+		Name:       group.Name,
+		Qualifiers: group.Qualifiers,
+		Teams:      make([]dto.TeamResponse, 0, len(group.Teams)),
+		CreatedAt:  timefmt.Format(group.CreatedAt),
+		UpdatedAt:  timefmt.Format(group.UpdatedAt),
+	}
+	for _, member := range group.Teams {
+		if member.Team != nil {
+			resp.Teams = append(resp.Teams, toTeamResponse(*member.Team))
+		} else {
+			resp.Teams = append(resp.Teams, dto.TeamResponse{ID: member.TeamID.String()})
+		}
+	}
+	return resp
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type groupServiceMocks struct {
+	groupRepo  *mocks.MockGroupRepository
+	seasonRepo *mocks.MockSeasonRepository
+	teamRepo   *mocks.MockTeamRepository
+	matchRepo  *mocks.MockMatchRepository
+}
+
+func newTestGroupService(t *testing.T) (GroupService, groupServiceMocks) {
+	m := groupServiceMocks{
+		groupRepo:  mocks.NewMockGroupRepository(t),
+		seasonRepo: mocks.NewMockSeasonRepository(t),
+		teamRepo:   mocks.NewMockTeamRepository(t),
+		matchRepo:  mocks.NewMockMatchRepository(t),
+	}
+	return NewGroupService(m.groupRepo, m.seasonRepo, m.teamRepo, m.matchRepo), m
+}
+
+// sampleGroup returns a group of the season with the given teams.
+func sampleGroup(seasonID uuid.UUID, name string, teams ...*model.Team) model.Group {
+	group := model.Group{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, SeasonID: seasonID, Name: name, Qualifiers: model.DefaultGroupQualifiers}
+	for _, team := range teams {
+		group.Teams = append(group.Teams, model.GroupTeam{GroupID: group.ID, SeasonID: seasonID, TeamID: team.ID, Team: team})
+	}
+	return group
+}
+
+func TestGroupService_Create(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	request := func(qualifiers int, teams ...*model.Team) dto.GroupRequest {
+		req := dto.GroupRequest{Name: "Group B", Qualifiers: qualifiers}
+		for _, team := range teams {
+			req.TeamIDs = append(req.TeamIDs, team.ID.String())
+		}
+		return req
+	}
+
+	t.Run("defaults to two qualifiers", func(t *testing.T) {
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+		m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return(nil, nil)
+		m.teamRepo.EXPECT().FindByIDs([]uuid.UUID{persija.ID, persib.ID}).Return([]model.Team{*persib, *persija}, nil)
+		m.groupRepo.EXPECT().Create(mock.MatchedBy(func(group *model.Group) bool {
+			return group.SeasonID == seasonID && group.Name == "Group B" && group.Qualifiers == 2 &&
+				len(group.Teams) == 2 && group.Teams[0].TeamID == persija.ID && group.Teams[1].SeasonID == seasonID
+		})).Return(nil)
+
+		resp, err := svc.Create(context.Background(), seasonID, request(0, persija, persib))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.Qualifiers)
+		require.Len(t, resp.Teams, 2)
+		assert.Equal(t, "Persija Jakarta", resp.Teams[0].Name)
+		assert.Equal(t, "Persib Bandung", resp.Teams[1].Name)
+	})
+
+	t.Run("team already in another group", func(t *testing.T) {
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+		m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return([]model.Group{sampleGroup(seasonID, "Group A", persib, arema)}, nil)
+		m.teamRepo.EXPECT().FindByIDs([]uuid.UUID{persija.ID, persib.ID}).Return([]model.Team{*persija, *persib}, nil)
+
+		_, err := svc.Create(context.Background(), seasonID, request(0, persija, persib))
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusConflict, appErr.Code)
+		assert.Equal(t, CodeTeamInOtherGroup, appErr.ErrorCode)
+		assert.Equal(t, "Persib Bandung already plays in Group A", appErr.Message)
+	})
+
+	t.Run("more qualifiers than teams", func(t *testing.T) {
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+		m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return(nil, nil)
+
+		_, err := svc.Create(context.Background(), seasonID, request(3, persija, persib))
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.Code)
+		assert.Equal(t, CodeInvalidGroup, appErr.ErrorCode)
+	})
+
+	t.Run("team listed twice", func(t *testing.T) {
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+		m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return(nil, nil)
+
+		_, err := svc.Create(context.Background(), seasonID, request(0, persija, persija))
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeInvalidGroup, appErr.ErrorCode)
+	})
+
+	t.Run("deleted team", func(t *testing.T) {
+		deleted := *arema
+		deleted.DeletedAt = gorm.DeletedAt{Valid: true}
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+		m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return(nil, nil)
+		m.teamRepo.EXPECT().FindByIDs([]uuid.UUID{persija.ID, arema.ID}).Return([]model.Team{*persija, deleted}, nil)
+
+		_, err := svc.Create(context.Background(), seasonID, request(0, persija, arema))
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.Code)
+		assert.Equal(t, CodeTeamNotFound, appErr.ErrorCode)
+	})
+
+	t.Run("season not found", func(t *testing.T) {
+		svc, m := newTestGroupService(t)
+		m.seasonRepo.EXPECT().FindByID(seasonID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.Create(context.Background(), seasonID, request(0, persija, persib))
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeSeasonNotFound, appErr.ErrorCode)
+	})
+}
+
+func TestGroupService_Update_KeepsOwnTeams(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	group := sampleGroup(seasonID, "Group A", persija, persib)
+
+	svc, m := newTestGroupService(t)
+	m.groupRepo.EXPECT().FindByID(group.ID).Return(&group, nil)
+	m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return([]model.Group{group}, nil)
+	m.teamRepo.EXPECT().FindByIDs([]uuid.UUID{persija.ID, persib.ID, arema.ID}).Return([]model.Team{*persija, *persib, *arema}, nil)
+	m.groupRepo.EXPECT().Update(mock.MatchedBy(func(updated *model.Group) bool {
+		return updated.ID == group.ID && updated.Qualifiers == 1 && len(updated.Teams) == 3
+	})).Return(nil)
+
+	resp, err := svc.Update(context.Background(), group.ID, dto.GroupRequest{
+		Name:       "Group A",
+		Qualifiers: 1,
+		TeamIDs:    []string{persija.ID.String(), persib.ID.String(), arema.ID.String()},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Teams, 3)
+	assert.Equal(t, 1, resp.Qualifiers)
+}
+
+func TestGroupService_GetStandings(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	bali := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Bali United"}
+	psm := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "PSM Makassar"}
+	groupA := sampleGroup(seasonID, "Group A", persija, persib, arema)
+	groupB := sampleGroup(seasonID, "Group B", bali, psm)
+	groupB.Qualifiers = 1
+
+	svc, m := newTestGroupService(t)
+	m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+	m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return([]model.Group{groupA, groupB}, nil)
+	m.matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return([]model.Match{
+		completedMatch(persija, persib, 2, 0),
+		completedMatch(persib, arema, 1, 1),
+		completedMatch(bali, psm, 0, 1),
+		// A knockout match between groups does not count for either table
+		completedMatch(persija, psm, 0, 3),
+	}, nil)
+
+	resp, err := svc.GetStandings(context.Background(), seasonID)
+
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+
+	a := resp[0]
+	assert.Equal(t, "Group A", a.Name)
+	require.Len(t, a.Standings, 3)
+	assert.Equal(t, persija.ID.String(), a.Standings[0].Team.ID)
+	assert.Equal(t, 1, a.Standings[0].Played)
+	assert.Equal(t, 3, a.Standings[0].Points)
+	assert.True(t, a.Standings[0].Qualifies)
+	assert.Equal(t, arema.ID.String(), a.Standings[1].Team.ID)
+	assert.True(t, a.Standings[1].Qualifies)
+	assert.False(t, a.Standings[2].Qualifies)
+	require.Len(t, a.Qualified, 2)
+	assert.Equal(t, "Persija Jakarta", a.Qualified[0].Name)
+	assert.Equal(t, "Arema FC", a.Qualified[1].Name)
+
+	b := resp[1]
+	require.Len(t, b.Qualified, 1)
+	assert.Equal(t, "PSM Makassar", b.Qualified[0].Name)
+	assert.Equal(t, 1, b.Standings[0].Played)
+}
+
+func TestGroupService_GetStandings_TeamsYetToPlay(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+
+	svc, m := newTestGroupService(t)
+	m.seasonRepo.EXPECT().FindByID(seasonID).Return(&model.Season{Base: model.Base{ID: seasonID}}, nil)
+	m.groupRepo.EXPECT().FindBySeasonID(seasonID).Return([]model.Group{sampleGroup(seasonID, "Group A", persija, persib)}, nil)
+	m.matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return(nil, nil)
+
+	resp, err := svc.GetStandings(context.Background(), seasonID)
+
+	require.NoError(t, err)
+	require.Len(t, resp[0].Standings, 2)
+	// Level on everything, so by name
+	assert.Equal(t, "Persib Bandung", resp[0].Standings[0].Team.Name)
+	assert.Equal(t, 0, resp[0].Standings[0].Played)
+	assert.Equal(t, 2, resp[0].Standings[1].Position)
+}
+
+func TestGroupService_Delete_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV7())
+	svc, m := newTestGroupService(t)
+	m.groupRepo.EXPECT().FindByID(id).Return(nil, gorm.ErrRecordNotFound)
+
+	err := svc.Delete(context.Background(), id)
+
+	var appErr *errs.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.Code)
+	assert.Equal(t, CodeGroupNotFound, appErr.ErrorCode)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+)
+
+// Health component names reported in the detailed health payload.
+const (
+	ComponentDatabase          = "database"
+	ComponentMigrations        = "migrations"
+	ComponentCache             = "cache"
+	ComponentBackgroundWorkers = "background_workers"
+)
+
+// healthCheckTimeout bounds how long a single component probe may take.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthCheck probes a single component and reports its status.
+type HealthCheck func(ctx context.Context) dto.ComponentHealth
+
+// HealthService defines the contract for reporting application and dependency health.
+type HealthService interface {
+	Details(ctx context.Context) dto.HealthDetailsResponse
+	Ready(ctx context.Context) dto.ReadinessResponse
+	Register(name string, check HealthCheck)
+}
+
+type healthService struct {
+	healthRepo repository.HealthRepository
+	tables     []string
+	startedAt  time.Time
+
+	mu     sync.RWMutex
+	checks map[string]HealthCheck
+}
+
+// NewHealthService creates a new HealthService instance.
+// tables lists the tables that must exist for migrations to be considered complete.
+// Cache and background-worker components report "disabled" until a check is registered for them.
+func NewHealthService(healthRepo repository.HealthRepository, tables []string, startedAt time.Time) HealthService {
+	s := &healthService{
+		healthRepo: healthRepo,
+		tables:     tables,
+		startedAt:  startedAt,
+		checks:     make(map[string]HealthCheck),
+	}
+
+	s.checks[ComponentDatabase] = s.checkDatabase
+	s.checks[ComponentMigrations] = s.checkMigrations
+	s.checks[ComponentCache] = disabledCheck("Cache is not configured")
+	s.checks[ComponentBackgroundWorkers] = disabledCheck("No background workers are running")
+
+	return s
+}
+
+// Register adds or replaces the health check for a component.
+// Subsystems (cache, workers, ...) call this during startup to report their status.
+func (s *healthService) Register(name string, check HealthCheck) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks[name] = check
+}
+
+// Details runs every registered check concurrently and aggregates the results.
+// The overall status is "degraded" if any component is down.
+func (s *healthService) Details(ctx context.Context) dto.HealthDetailsResponse {
+	s.mu.RLock()
+	checks := make(map[string]HealthCheck, len(s.checks))
+	for name, check := range s.checks {
+		checks[name] = check
+	}
+	s.mu.RUnlock()
+
+	var (
+		wg         sync.WaitGroup
+		resultsMu  sync.Mutex
+		components = make(map[string]dto.ComponentHealth, len(checks))
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check HealthCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			result := check(checkCtx)
+
+			resultsMu.Lock()
+			components[name] = result
+			resultsMu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	status := "ok"
+	for _, component := range components {
+		if component.Status == dto.HealthStatusDown {
+			status = "degraded"
+			break
+		}
+	}
+
+	return dto.HealthDetailsResponse{
+		Status:        status,
+		Build:         buildinfo.Get(),
+		StartedAt:     timefmt.Format(s.startedAt),
+		UptimeSeconds: int64(time.Since(s.startedAt).Seconds()),
+		Components:    components,
+	}
+}
+
+// Ready reports whether the instance can serve requests, which requires a working database.
+// The result is public, so error details are logged instead of returned.
+func (s *healthService) Ready(ctx context.Context) dto.ReadinessResponse {
+	checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	database := s.checkDatabase(checkCtx)
+	status := dto.ReadinessReady
+	if database.Status != dto.HealthStatusUp {
+		slog.WarnContext(ctx, "readiness check failed", "component", ComponentDatabase, "error", database.Message)
+		database.Message = "Database unreachable"
+		status = dto.ReadinessNotReady
+	}
+
+	return dto.ReadinessResponse{
+		Status:     status,
+		Components: map[string]dto.ComponentHealth{ComponentDatabase: database},
+		Build:      buildinfo.Get(),
+	}
+}
+
+// checkDatabase pings the database and reports round-trip latency and pool usage.
+func (s *healthService) checkDatabase(ctx context.Context) dto.ComponentHealth {
+	start := time.Now()
+	if err := s.healthRepo.Ping(ctx); err != nil {
+		return dto.ComponentHealth{Status: dto.HealthStatusDown, Message: err.Error()}
+	}
+	latency := time.Since(start)
+
+	health := dto.ComponentHealth{
+		Status:    dto.HealthStatusUp,
+		LatencyMS: float64(latency.Microseconds()) / 1000,
+	}
+
+	if stats, err := s.healthRepo.PoolStats(); err == nil {
+		health.Details = map[string]any{
+			"open_connections": stats.OpenConnections,
+			"in_use":           stats.InUse,
+			"idle":             stats.Idle,
+			"wait_count":       stats.WaitCount,
+		}
+	}
+
+	return health
+}
+
+// checkMigrations verifies that every expected table exists.
+func (s *healthService) checkMigrations(ctx context.Context) dto.ComponentHealth {
+	missing := s.healthRepo.MissingTables(s.tables)
+	if len(missing) > 0 {
+		return dto.ComponentHealth{
+			Status:  dto.HealthStatusDown,
+			Message: "Missing tables: " + strings.Join(missing, ", "),
+		}
+	}
+	return dto.ComponentHealth{
+		Status:  dto.HealthStatusUp,
+		Details: map[string]any{"tables": len(s.tables)},
+	}
+}
+
+// disabledCheck returns a check that always reports the component as disabled.
+func disabledCheck(message string) HealthCheck {
+	return func(ctx context.Context) dto.ComponentHealth {
+		return dto.ComponentHealth{Status: dto.HealthStatusDisabled, Message: message}
+	}
+}
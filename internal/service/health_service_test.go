@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHealthService_Details(t *testing.T) {
+	tables := []string{"admins", "teams"}
+
+	tests := []struct {
+		name       string
+		setup      func(*mocks.MockHealthRepository)
+		wantStatus string
+		wantDB     string
+		wantMigr   string
+	}{
+		{
+			name: "all components healthy",
+			setup: func(hr *mocks.MockHealthRepository) {
+				hr.EXPECT().Ping(mock.Anything).Return(nil)
+				hr.EXPECT().PoolStats().Return(sql.DBStats{OpenConnections: 3, InUse: 1, Idle: 2}, nil)
+				hr.EXPECT().MissingTables(tables).Return([]string{})
+			},
+			wantStatus: "ok",
+			wantDB:     dto.HealthStatusUp,
+			wantMigr:   dto.HealthStatusUp,
+		},
+		{
+			name: "database down",
+			setup: func(hr *mocks.MockHealthRepository) {
+				hr.EXPECT().Ping(mock.Anything).Return(errors.New("connection refused"))
+				hr.EXPECT().MissingTables(tables).Return([]string{})
+			},
+			wantStatus: "degraded",
+			wantDB:     dto.HealthStatusDown,
+			wantMigr:   dto.HealthStatusUp,
+		},
+		{
+			name: "missing tables",
+			setup: func(hr *mocks.MockHealthRepository) {
+				hr.EXPECT().Ping(mock.Anything).Return(nil)
+				hr.EXPECT().PoolStats().Return(sql.DBStats{}, nil)
+				hr.EXPECT().MissingTables(tables).Return([]string{"teams"})
+			},
+			wantStatus: "degraded",
+			wantDB:     dto.HealthStatusUp,
+			wantMigr:   dto.HealthStatusDown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			healthRepo := mocks.NewMockHealthRepository(t)
+			tt.setup(healthRepo)
+			svc := NewHealthService(healthRepo, tables, time.Now().Add(-time.Minute))
+
+			details := svc.Details(context.Background())
+
+			assert.Equal(t, tt.wantStatus, details.Status)
+			assert.Equal(t, tt.wantDB, details.Components[ComponentDatabase].Status)
+			assert.Equal(t, tt.wantMigr, details.Components[ComponentMigrations].Status)
+			assert.Equal(t, dto.HealthStatusDisabled, details.Components[ComponentCache].Status)
+			assert.Equal(t, dto.HealthStatusDisabled, details.Components[ComponentBackgroundWorkers].Status)
+			assert.GreaterOrEqual(t, details.UptimeSeconds, int64(60))
+			assert.NotEmpty(t, details.Build.GoVersion)
+		})
+	}
+}
+
+func TestHealthService_Register(t *testing.T) {
+	healthRepo := mocks.NewMockHealthRepository(t)
+	healthRepo.EXPECT().Ping(mock.Anything).Return(nil)
+	healthRepo.EXPECT().PoolStats().Return(sql.DBStats{}, nil)
+	healthRepo.EXPECT().MissingTables(mock.Anything).Return([]string{})
+
+	svc := NewHealthService(healthRepo, nil, time.Now())
+	svc.Register(ComponentCache, func(ctx context.Context) dto.ComponentHealth {
+		return dto.ComponentHealth{Status: dto.HealthStatusDown, Message: "redis unreachable"}
+	})
+
+	details := svc.Details(context.Background())
+
+	assert.Equal(t, "degraded", details.Status)
+	assert.Equal(t, "redis unreachable", details.Components[ComponentCache].Message)
+}
+
+func TestHealthService_Ready(t *testing.T) {
+	t.Run("ready with pool stats", func(t *testing.T) {
+		healthRepo := mocks.NewMockHealthRepository(t)
+		healthRepo.EXPECT().Ping(mock.Anything).Return(nil)
+		healthRepo.EXPECT().PoolStats().Return(sql.DBStats{OpenConnections: 4, InUse: 1, Idle: 3}, nil)
+		svc := NewHealthService(healthRepo, nil, time.Now())
+
+		result := svc.Ready(context.Background())
+
+		assert.Equal(t, dto.ReadinessReady, result.Status)
+		assert.Equal(t, dto.HealthStatusUp, result.Components[ComponentDatabase].Status)
+		assert.Equal(t, 4, result.Components[ComponentDatabase].Details["open_connections"])
+		assert.Equal(t, buildinfo.Get().Version, result.Build.Version)
+	})
+
+	t.Run("not ready hides the error", func(t *testing.T) {
+		healthRepo := mocks.NewMockHealthRepository(t)
+		healthRepo.EXPECT().Ping(mock.Anything).Return(errors.New("dial tcp 10.0.0.5:5432: connection refused"))
+		svc := NewHealthService(healthRepo, nil, time.Now())
+
+		result := svc.Ready(context.Background())
+
+		assert.Equal(t, dto.ReadinessNotReady, result.Status)
+		assert.Equal(t, dto.HealthStatusDown, result.Components[ComponentDatabase].Status)
+		assert.Equal(t, "Database unreachable", result.Components[ComponentDatabase].Message)
+	})
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// maxStarters is the number of players allowed in a starting lineup.
+const maxStarters = 11
+
+// LineupService defines the contract for match lineup business logic.
+type LineupService interface {
+	GetLineup(ctx context.Context, matchID uuid.UUID) (*dto.MatchLineupResponse, error)
+	SetLineup(ctx context.Context, matchID uuid.UUID, req dto.LineupRequest) (*dto.MatchLineupResponse, error)
+}
+
+type lineupService struct {
+	matchRepo   repository.MatchRepository
+	playerRepo  repository.PlayerRepository
+	lineupRepo  repository.MatchLineupRepository
+	absenceRepo repository.PlayerAbsenceRepository
+	discipline  DisciplinaryService
+	txManager   repository.TxManager
+	location    *time.Location
+}
+
+// NewLineupService creates a new LineupService instance.
+// location is the match timezone, in which the match day is checked against player absences.
+func NewLineupService(
+	matchRepo repository.MatchRepository,
+	playerRepo repository.PlayerRepository,
+	lineupRepo repository.MatchLineupRepository,
+	absenceRepo repository.PlayerAbsenceRepository,
+	discipline DisciplinaryService,
+	txManager repository.TxManager,
+	location *time.Location,
+) LineupService {
+	return &lineupService{
+		matchRepo:   matchRepo,
+		playerRepo:  playerRepo,
+		lineupRepo:  lineupRepo,
+		absenceRepo: absenceRepo,
+		discipline:  discipline,
+		txManager:   txManager,
+		location:    location,
+	}
+}
+
+// GetLineup returns the recorded lineups of both teams for a match.
+func (s *lineupService) GetLineup(ctx context.Context, matchID uuid.UUID) (*dto.MatchLineupResponse, error) {
+	match, err := s.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.lineupRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch lineups", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toMatchLineupResponse(*match, entries)
+	return &resp, nil
+}
+
+// SetLineup records (or replaces) one team's starting XI and substitutes for a match.
+// Every player must belong to the given team, may appear only once, must not be
+// injured or suspended on the match day and must not be serving a ban for cards.
+func (s *lineupService) SetLineup(ctx context.Context, matchID uuid.UUID, req dto.LineupRequest) (*dto.MatchLineupResponse, error) {
+	match, err := s.findMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := uuid.Parse(req.TeamID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid team_id format")
+	}
+	if teamID != match.HomeTeamID && teamID != match.AwayTeamID {
+		return nil, errs.ErrBadRequest("team_id must be either home or away team").WithCode(CodeInvalidLineup)
+	}
+	if len(req.Starters) > maxStarters {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("A lineup cannot have more than %d starters", maxStarters)).WithCode(CodeInvalidLineup)
+	}
+
+	entries := make([]model.MatchLineup, 0, len(req.Starters)+len(req.Substitutes))
+	labels := make(map[uuid.UUID]string)
+	seen := make(map[uuid.UUID]bool)
+	add := func(raw string, starter bool, label string) error {
+		playerID, err := uuid.Parse(raw)
+		if err != nil {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: invalid player id format", label))
+		}
+		if seen[playerID] {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: player is listed more than once", label)).WithCode(CodeInvalidLineup)
+		}
+		seen[playerID] = true
+
+		player, err := s.playerRepo.FindByID(playerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errs.ErrNotFound(fmt.Sprintf("%s: player not found", label))
+			}
+			slog.ErrorContext(ctx, "failed to fetch player for lineup validation", "error", err)
+			return errs.ErrInternal("Internal server error")
+		}
+		if player.TeamID != teamID {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: player does not belong to the specified team", label)).WithCode(CodePlayerNotInTeam)
+		}
+
+		entries = append(entries, model.MatchLineup{
+			MatchID:  matchID,
+			TeamID:   teamID,
+			PlayerID: playerID,
+			Starter:  starter,
+		})
+		labels[playerID] = label
+		return nil
+	}
+
+	for i, raw := range req.Starters {
+		if err := add(raw, true, fmt.Sprintf("Starter #%d", i+1)); err != nil {
+			return nil, err
+		}
+	}
+	for i, raw := range req.Substitutes {
+		if err := add(raw, false, fmt.Sprintf("Substitute #%d", i+1)); err != nil {
+			return nil, err
+		}
+	}
+
+	playerIDs := make([]uuid.UUID, len(entries))
+	for i, entry := range entries {
+		playerIDs[i] = entry.PlayerID
+	}
+	if err := s.checkAvailability(ctx, *match, playerIDs, labels); err != nil {
+		return nil, err
+	}
+	if err := s.discipline.CheckEligibility(ctx, *match, playerIDs, labels); err != nil {
+		return nil, err
+	}
+
+	// Replace the team's previous lineup atomically
+	err = s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+		if err := repos.Lineups.DeleteByMatchIDAndTeamID(matchID, teamID); err != nil {
+			return fmt.Errorf("delete old lineup: %w", err)
+		}
+		if err := repos.Lineups.CreateBatch(entries); err != nil {
+			return fmt.Errorf("create lineup: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save lineup", "error", err, "match_id", matchID, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return s.GetLineup(ctx, matchID)
+}
+
+// checkAvailability rejects a lineup naming a player with an absence covering the match day.
+func (s *lineupService) checkAvailability(ctx context.Context, match model.Match, playerIDs []uuid.UUID, labels map[uuid.UUID]string) error {
+	matchDay := match.MatchDatetime.In(s.location).Format("2006-01-02")
+
+	absences, err := s.absenceRepo.FindActiveByPlayerIDs(playerIDs, matchDay)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch player absences for lineup validation", "error", err, "match_id", match.ID)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	absent := make(map[uuid.UUID]string, len(absences))
+	for _, absence := range absences {
+		absent[absence.PlayerID] = absence.Type
+	}
+	for _, playerID := range playerIDs {
+		if absenceType, ok := absent[playerID]; ok {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: player is unavailable on %s (%s)", labels[playerID], matchDay, absenceType)).WithCode(CodePlayerUnavailable)
+		}
+	}
+	return nil
+}
+
+func (s *lineupService) findMatch(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
+	match, err := s.matchRepo.FindByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for lineup", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return match, nil
+}
+
+// toMatchLineupResponse groups lineup entries by team. A team without entries has a nil lineup.
+func toMatchLineupResponse(match model.Match, entries []model.MatchLineup) dto.MatchLineupResponse {
+	resp := dto.MatchLineupResponse{MatchID: match.ID.String()}
+
+	for _, entry := range entries {
+		var lineup **dto.TeamLineupResponse
+		switch entry.TeamID {
+		case match.HomeTeamID:
+			lineup = &resp.HomeTeam
+		case match.AwayTeamID:
+			lineup = &resp.AwayTeam
+		default:
+			continue // stale entry from before the match teams were changed
+		}
+		if *lineup == nil {
+			*lineup = &dto.TeamLineupResponse{
+				TeamID:      entry.TeamID.String(),
+				Starters:    []dto.LineupPlayerResponse{},
+				Substitutes: []dto.LineupPlayerResponse{},
+			}
+		}
+
+		player := dto.LineupPlayerResponse{PlayerID: entry.PlayerID.String()}
+		if entry.Player != nil {
+			player.Name = entry.Player.Name
+			player.Position = entry.Player.Position
+			player.JerseyNumber = entry.Player.JerseyNumber
+		}
+		if entry.Starter {
+			(*lineup).Starters = append((*lineup).Starters, player)
+		} else {
+			(*lineup).Substitutes = append((*lineup).Substitutes, player)
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestLineupService(t *testing.T) (*lineupService, *mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockMatchLineupRepository) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	playerRepo := mocks.NewMockPlayerRepository(t)
+	lineupRepo := mocks.NewMockMatchLineupRepository(t)
+	absenceRepo := mocks.NewMockPlayerAbsenceRepository(t)
+	absenceRepo.EXPECT().FindActiveByPlayerIDs(mock.Anything, mock.Anything).Return([]model.PlayerAbsence{}, nil).Maybe()
+	eventRepo := mocks.NewMockMatchEventRepository(t)
+	matchRepo.EXPECT().FindByTeamIDs(mock.Anything, model.MatchStatusCompleted).Return([]model.Match{}, nil).Maybe()
+	eventRepo.EXPECT().FindByMatchIDs(mock.Anything).Return([]model.MatchEvent{}, nil).Maybe()
+
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+		return fn(repository.TxRepositories{Matches: matchRepo, Lineups: lineupRepo})
+	}).Maybe()
+
+	svc := &lineupService{
+		matchRepo:   matchRepo,
+		playerRepo:  playerRepo,
+		lineupRepo:  lineupRepo,
+		absenceRepo: absenceRepo,
+		discipline:  NewDisciplinaryService(matchRepo, eventRepo, playerRepo, 5),
+		txManager:   txManager,
+		location:    time.UTC,
+	}
+	return svc, matchRepo, playerRepo, lineupRepo
+}
+
+func TestLineupService_SetLineup(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	starterID := uuid.Must(uuid.NewV7())
+	subID := uuid.Must(uuid.NewV7())
+	outsiderID := uuid.Must(uuid.NewV7())
+
+	match := &model.Match{
+		Base:       model.Base{ID: matchID},
+		HomeTeamID: homeID,
+		AwayTeamID: awayID,
+		Status:     "scheduled",
+	}
+
+	elevenStarters := make([]string, maxStarters+1)
+	for i := range elevenStarters {
+		elevenStarters[i] = uuid.Must(uuid.NewV7()).String()
+	}
+
+	tests := []struct {
+		name        string
+		req         dto.LineupRequest
+		setup       func(*mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockMatchLineupRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: []string{starterID.String()}, Substitutes: []string{subID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				pr.EXPECT().FindByID(starterID).Return(&model.Player{Base: model.Base{ID: starterID}, TeamID: homeID}, nil)
+				pr.EXPECT().FindByID(subID).Return(&model.Player{Base: model.Base{ID: subID}, TeamID: homeID}, nil)
+				lr.EXPECT().DeleteByMatchIDAndTeamID(matchID, homeID).Return(nil)
+				lr.EXPECT().CreateBatch(mock.MatchedBy(func(entries []model.MatchLineup) bool {
+					return len(entries) == 2 && entries[0].Starter && !entries[1].Starter
+				})).Return(nil)
+				lr.EXPECT().FindByMatchID(matchID).Return([]model.MatchLineup{
+					{MatchID: matchID, TeamID: homeID, PlayerID: starterID, Starter: true},
+					{MatchID: matchID, TeamID: homeID, PlayerID: subID, Starter: false},
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "match not found",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: []string{starterID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Match not found",
+		},
+		{
+			name: "team not in match",
+			req:  dto.LineupRequest{TeamID: uuid.Must(uuid.NewV7()).String(), Starters: []string{starterID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "home or away team",
+		},
+		{
+			name: "too many starters",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: elevenStarters},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "more than 11 starters",
+		},
+		{
+			name: "player listed twice",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: []string{starterID.String()}, Substitutes: []string{starterID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				pr.EXPECT().FindByID(starterID).Return(&model.Player{Base: model.Base{ID: starterID}, TeamID: homeID}, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Substitute #1: player is listed more than once",
+		},
+		{
+			name: "player from another team",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: []string{outsiderID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				pr.EXPECT().FindByID(outsiderID).Return(&model.Player{Base: model.Base{ID: outsiderID}, TeamID: awayID}, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "does not belong to the specified team",
+		},
+		{
+			name: "player not found",
+			req:  dto.LineupRequest{TeamID: homeID.String(), Starters: []string{outsiderID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				pr.EXPECT().FindByID(outsiderID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Starter #1: player not found",
+		},
+		{
+			name: "transaction failure",
+			req:  dto.LineupRequest{TeamID: awayID.String(), Starters: []string{starterID.String()}},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, lr *mocks.MockMatchLineupRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				pr.EXPECT().FindByID(starterID).Return(&model.Player{Base: model.Base{ID: starterID}, TeamID: awayID}, nil)
+				lr.EXPECT().DeleteByMatchIDAndTeamID(matchID, awayID).Return(nil)
+				lr.EXPECT().CreateBatch(mock.Anything).Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, playerRepo, lineupRepo := newTestLineupService(t)
+			tt.setup(matchRepo, playerRepo, lineupRepo)
+
+			result, err := svc.SetLineup(context.Background(), matchID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result.HomeTeam)
+				assert.Len(t, result.HomeTeam.Starters, 1)
+				assert.Len(t, result.HomeTeam.Substitutes, 1)
+				assert.Nil(t, result.AwayTeam)
+			}
+		})
+	}
+}
+
+func TestLineupService_SetLineup_UnavailablePlayer(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	homeID := uuid.Must(uuid.NewV7())
+	starterID := uuid.Must(uuid.NewV7())
+	injuredID := uuid.Must(uuid.NewV7())
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	assert.NoError(t, err)
+
+	svc, matchRepo, playerRepo, _ := newTestLineupService(t)
+	absenceRepo := mocks.NewMockPlayerAbsenceRepository(t)
+	svc.absenceRepo = absenceRepo
+	svc.location = jakarta
+
+	// 18:00 UTC is already the next day in Jakarta
+	matchRepo.EXPECT().FindByID(matchID).Return(&model.Match{
+		Base:          model.Base{ID: matchID},
+		HomeTeamID:    homeID,
+		AwayTeamID:    uuid.Must(uuid.NewV7()),
+		MatchDatetime: time.Date(2025, 6, 14, 18, 0, 0, 0, time.UTC),
+	}, nil)
+	playerRepo.EXPECT().FindByID(starterID).Return(&model.Player{Base: model.Base{ID: starterID}, TeamID: homeID}, nil)
+	playerRepo.EXPECT().FindByID(injuredID).Return(&model.Player{Base: model.Base{ID: injuredID}, TeamID: homeID}, nil)
+	absenceRepo.EXPECT().FindActiveByPlayerIDs([]uuid.UUID{starterID, injuredID}, "2025-06-15").Return([]model.PlayerAbsence{
+		{PlayerID: injuredID, Type: model.AbsenceTypeInjury, StartDate: "2025-06-01"},
+	}, nil)
+
+	_, err = svc.SetLineup(context.Background(), matchID, dto.LineupRequest{
+		TeamID:      homeID.String(),
+		Starters:    []string{starterID.String()},
+		Substitutes: []string{injuredID.String()},
+	})
+
+	var appErr *errs.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+	assert.Equal(t, "Substitute #1: player is unavailable on 2025-06-15 (injury)", appErr.Message)
+}
+
+func TestLineupService_GetLineup(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+
+	svc, matchRepo, _, lineupRepo := newTestLineupService(t)
+	matchRepo.EXPECT().FindByID(matchID).Return(&model.Match{
+		Base:       model.Base{ID: matchID},
+		HomeTeamID: homeID,
+		AwayTeamID: awayID,
+	}, nil)
+	lineupRepo.EXPECT().FindByMatchID(matchID).Return([]model.MatchLineup{
+		{TeamID: awayID, PlayerID: uuid.Must(uuid.NewV7()), Starter: true, Player: &model.Player{Name: "Atep", JerseyNumber: 7}},
+	}, nil)
+
+	result, err := svc.GetLineup(context.Background(), matchID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.HomeTeam)
+	assert.NotNil(t, result.AwayTeam)
+	assert.Equal(t, "Atep", result.AwayTeam.Starters[0].Name)
+	assert.Equal(t, 7, result.AwayTeam.Starters[0].JerseyNumber)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+)
+
+// MaintenanceService defines the contract for the runtime maintenance mode switch.
+// The state is kept in memory, so it works while the database is down, and applies to
+// this instance only.
+type MaintenanceService interface {
+	Status() dto.MaintenanceResponse
+	Enable(ctx context.Context, req dto.MaintenanceRequest) dto.MaintenanceResponse
+	Disable(ctx context.Context) dto.MaintenanceResponse
+}
+
+type maintenanceService struct {
+	now func() time.Time
+
+	mu        sync.RWMutex
+	enabled   bool
+	message   string
+	startedAt time.Time
+	endsAt    time.Time // Zero if the end is unknown
+}
+
+// NewMaintenanceService creates a new MaintenanceService instance, in maintenance mode
+// with message from the start if enabled is true.
+func NewMaintenanceService(enabled bool, message string) MaintenanceService {
+	s := &maintenanceService{now: time.Now}
+	if enabled {
+		s.enabled, s.message, s.startedAt = true, message, s.now()
+	}
+	return s
+}
+
+// Status returns whether maintenance mode is on and, if so, what clients are told.
+func (s *maintenanceService) Status() dto.MaintenanceResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.enabled {
+		return dto.MaintenanceResponse{}
+	}
+	resp := dto.MaintenanceResponse{
+		Enabled:   true,
+		Message:   s.message,
+		StartedAt: timefmt.Format(s.startedAt),
+	}
+	if !s.endsAt.IsZero() {
+		resp.EndsAt = timefmt.Format(s.endsAt)
+		if remaining := s.endsAt.Sub(s.now()); remaining > 0 {
+			resp.RetryAfterSeconds = int(math.Ceil(remaining.Seconds()))
+		}
+	}
+	return resp
+}
+
+// Enable switches maintenance mode on, or updates its message and expected end if it is on already.
+func (s *maintenanceService) Enable(ctx context.Context, req dto.MaintenanceRequest) dto.MaintenanceResponse {
+	s.mu.Lock()
+	now := s.now()
+	if !s.enabled {
+		s.enabled, s.startedAt = true, now
+	}
+	s.message = req.Message
+	s.endsAt = time.Time{}
+	if req.DurationMinutes > 0 {
+		s.endsAt = now.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	}
+	s.mu.Unlock()
+
+	actor, _ := actorFrom(ctx)
+	slog.WarnContext(ctx, "maintenance mode enabled", "admin_id", actor.AdminID, "duration_minutes", req.DurationMinutes)
+	return s.Status()
+}
+
+// Disable switches maintenance mode off.
+func (s *maintenanceService) Disable(ctx context.Context) dto.MaintenanceResponse {
+	s.mu.Lock()
+	wasEnabled := s.enabled
+	s.enabled, s.message, s.startedAt, s.endsAt = false, "", time.Time{}, time.Time{}
+	s.mu.Unlock()
+
+	if wasEnabled {
+		actor, _ := actorFrom(ctx)
+		slog.WarnContext(ctx, "maintenance mode disabled", "admin_id", actor.AdminID)
+	}
+	return s.Status()
+}
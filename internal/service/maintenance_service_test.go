@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceService(t *testing.T) {
+	now := time.Date(2025, 1, 15, 22, 0, 0, 0, time.UTC)
+	svc := NewMaintenanceService(false, "").(*maintenanceService)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	assert.Equal(t, dto.MaintenanceResponse{}, svc.Status())
+
+	status := svc.Enable(ctx, dto.MaintenanceRequest{Message: "Database upgrade", DurationMinutes: 30})
+	assert.Equal(t, dto.MaintenanceResponse{
+		Enabled:           true,
+		Message:           "Database upgrade",
+		StartedAt:         "2025-01-15T22:00:00.000Z",
+		EndsAt:            "2025-01-15T22:30:00.000Z",
+		RetryAfterSeconds: 1800,
+	}, status)
+
+	// Extending keeps the start and counts the new duration from now
+	now = now.Add(20 * time.Minute)
+	status = svc.Enable(ctx, dto.MaintenanceRequest{Message: "Almost done", DurationMinutes: 15})
+	assert.Equal(t, "2025-01-15T22:00:00.000Z", status.StartedAt)
+	assert.Equal(t, "2025-01-15T22:35:00.000Z", status.EndsAt)
+	assert.Equal(t, 900, status.RetryAfterSeconds)
+
+	// Past the expected end, clients are no longer told when to retry
+	now = now.Add(time.Hour)
+	status = svc.Status()
+	assert.True(t, status.Enabled)
+	assert.Zero(t, status.RetryAfterSeconds)
+
+	assert.Equal(t, dto.MaintenanceResponse{}, svc.Disable(ctx))
+	assert.False(t, svc.Status().Enabled)
+}
+
+func TestNewMaintenanceService_EnabledAtStartup(t *testing.T) {
+	status := NewMaintenanceService(true, "Migrating").Status()
+
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "Migrating", status.Message)
+	assert.NotEmpty(t, status.StartedAt)
+	assert.Empty(t, status.EndsAt)
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+)
+
+// publishStatusChange announces a match moving from previous to its current status.
+func (s *matchService) publishStatusChange(previous string, match dto.MatchResponse) {
+	s.feed.Publish(FeedMatchStatusChanged, dto.MatchStatusChangedEvent{
+		MatchID:        match.ID,
+		PreviousStatus: previous,
+		Status:         match.Status,
+		Match:          match,
+	})
+}
+
+// publishGoals announces every scoring event of a submitted result in match order,
+// each with the running score. match.Events must be ordered by minute, as loaded by FindByIDWithDetails.
+func (s *matchService) publishGoals(match model.Match) {
+	homeScore, awayScore := 0, 0
+	for _, event := range match.Events {
+		var forHome bool
+		switch event.Type {
+		case model.EventGoal, model.EventPenalty:
+			forHome = event.TeamID == match.HomeTeamID
+		case model.EventOwnGoal:
+			// Own goals count for the opponent of the player's team
+			forHome = event.TeamID != match.HomeTeamID
+		default:
+			continue
+		}
+		if forHome {
+			homeScore++
+		} else {
+			awayScore++
+		}
+
+		s.feed.Publish(FeedMatchGoal, dto.MatchGoalEvent{
+			MatchID:   match.ID.String(),
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+			Event:     toMatchEventResponse(event),
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// drainFeed returns every event already delivered to sub.
+func drainFeed(sub *events.Subscription) []events.Event {
+	var received []events.Event
+	for {
+		select {
+		case e := <-sub.C():
+			received = append(received, e)
+		default:
+			return received
+		}
+	}
+}
+
+func TestMatchService_Feed_StatusChange(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	svc.feed = events.NewBus()
+	sub := svc.feed.Subscribe(10)
+	defer sub.Close()
+
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+	matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+
+	_, err := svc.UpdateStatus(context.Background(), m.ID, dto.MatchStatusRequest{Status: model.MatchStatusLive})
+	assert.NoError(t, err)
+
+	received := drainFeed(sub)
+	if assert.Len(t, received, 1) {
+		assert.Equal(t, FeedMatchStatusChanged, received[0].Type)
+		payload, ok := received[0].Data.(dto.MatchStatusChangedEvent)
+		assert.True(t, ok)
+		assert.Equal(t, model.MatchStatusScheduled, payload.PreviousStatus)
+		assert.Equal(t, model.MatchStatusLive, payload.Status)
+		assert.Equal(t, m.ID.String(), payload.MatchID)
+	}
+}
+
+func TestMatchService_Feed_Result(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	homePlayer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeID}
+	awayPlayer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: awayID}
+
+	req := dto.MatchResultRequest{Events: []dto.MatchEventInput{
+		{Type: model.EventGoal, PlayerID: homePlayer.ID.String(), TeamID: homeID.String(), Minute: "10"},
+		{Type: model.EventYellowCard, PlayerID: awayPlayer.ID.String(), TeamID: awayID.String(), Minute: "30"},
+		{Type: model.EventOwnGoal, PlayerID: homePlayer.ID.String(), TeamID: homeID.String(), Minute: "55"},
+		{Type: model.EventPenalty, PlayerID: awayPlayer.ID.String(), TeamID: awayID.String(), Minute: "80"},
+	}}
+	saved := func(m model.Match) *model.Match {
+		m.Status = model.MatchStatusCompleted
+		m.HomeScore, m.AwayScore = 1, 2
+		for _, in := range req.Events {
+			minute, _, _ := model.ParseEventMinute(string(in.Minute))
+			m.Events = append(m.Events, model.MatchEvent{
+				MatchID:  m.ID,
+				Type:     in.Type,
+				PlayerID: uuid.MustParse(in.PlayerID),
+				TeamID:   uuid.MustParse(in.TeamID),
+				Minute:   minute,
+			})
+		}
+		return &m
+	}
+
+	tests := []struct {
+		name      string
+		status    string
+		replace   bool
+		wantTypes []string
+	}{
+		{
+			name:      "submitted result announces goals, then the status change and completion",
+			status:    model.MatchStatusLive,
+			wantTypes: []string{FeedMatchGoal, FeedMatchGoal, FeedMatchGoal, FeedMatchStatusChanged, FeedMatchCompleted},
+		},
+		{
+			name:      "corrected result is announced once",
+			status:    model.MatchStatusCompleted,
+			replace:   true,
+			wantTypes: []string{FeedMatchResultUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+			svc.feed = events.NewBus()
+			sub := svc.feed.Subscribe(10)
+			defer sub.Close()
+
+			m := sampleMatch(homeID, awayID)
+			m.Status = tt.status
+			matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+			playerRepo.EXPECT().FindByID(homePlayer.ID).Return(homePlayer, nil)
+			playerRepo.EXPECT().FindByID(awayPlayer.ID).Return(awayPlayer, nil)
+			if tt.replace {
+				eventRepo.EXPECT().DeleteByMatchID(m.ID).Return(nil)
+			}
+			eventRepo.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(nil)
+			matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+			matchRepo.EXPECT().FindByIDWithDetails(m.ID).Return(saved(m), nil)
+
+			var err error
+			if tt.replace {
+				_, err = svc.UpdateResult(context.Background(), m.ID, req)
+			} else {
+				_, err = svc.SubmitResult(context.Background(), m.ID, req)
+			}
+			assert.NoError(t, err)
+
+			received := drainFeed(sub)
+			types := make([]string, len(received))
+			for i, e := range received {
+				types[i] = e.Type
+			}
+			assert.Equal(t, tt.wantTypes, types)
+
+			if !tt.replace && len(received) == len(tt.wantTypes) {
+				// Running score: 1-0 goal, 1-1 own goal (counts for away), 1-2 penalty
+				wantScores := [][2]int{{1, 0}, {1, 1}, {1, 2}}
+				for i, want := range wantScores {
+					goal := received[i].Data.(dto.MatchGoalEvent)
+					assert.Equal(t, want, [2]int{goal.HomeScore, goal.AwayScore})
+				}
+				change := received[3].Data.(dto.MatchStatusChangedEvent)
+				assert.Equal(t, model.MatchStatusLive, change.PreviousStatus)
+				assert.Equal(t, model.MatchStatusCompleted, change.Status)
+			}
+		})
+	}
+}
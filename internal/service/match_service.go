@@ -1,35 +1,66 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ical"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
 	"gorm.io/gorm"
 )
 
 // MatchService defines the contract for match business logic.
 type MatchService interface {
-	GetAll(pagination dto.PaginationQuery) ([]dto.MatchResponse, *response.PaginationMeta, error)
-	GetByID(id uuid.UUID) (*dto.MatchResponse, error)
-	Create(req dto.CreateMatchRequest) (*dto.MatchResponse, error)
-	Update(id uuid.UUID, req dto.UpdateMatchRequest) (*dto.MatchResponse, error)
-	Delete(id uuid.UUID) error
-	SubmitResult(matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error)
-	UpdateResult(matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error)
+	GetAll(ctx context.Context, pagination dto.PaginationQuery, query dto.MatchFilterQuery) ([]dto.MatchResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.MatchResponse, error)
+	Create(ctx context.Context, req dto.CreateMatchRequest) (*dto.MatchResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateMatchRequest) (*dto.MatchResponse, error)
+	Patch(ctx context.Context, id uuid.UUID, req dto.PatchMatchRequest) (*dto.MatchResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	SubmitResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error)
+	PreviewResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResultPreviewResponse, error)
+	UpdateResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error)
+	UndoResult(ctx context.Context, matchID uuid.UUID) (*dto.MatchResponse, error)
+	ImportResults(ctx context.Context, req dto.MatchResultImportRequest) (*dto.MatchResultImportResponse, error)
+	ImportResultRows(ctx context.Context, rows [][]string) (*dto.MatchResultImportResponse, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, req dto.MatchStatusRequest) (*dto.MatchResponse, error)
+	AdvanceStatuses(ctx context.Context, resultGrace time.Duration) (int, error)
+	GetArchived(ctx context.Context, pagination dto.PaginationQuery, query dto.MatchFilterQuery) ([]dto.MatchResponse, *response.PaginationMeta, error)
+	ArchiveSeasons(ctx context.Context, years int) (int64, error)
+	GetCalendar(ctx context.Context, query dto.MatchCalendarQuery) (*ical.Calendar, error)
 }
 
 type matchService struct {
-	matchRepo  repository.MatchRepository
-	teamRepo   repository.TeamRepository
-	playerRepo repository.PlayerRepository
-	goalRepo   repository.GoalRepository
+	matchRepo        repository.MatchRepository
+	teamRepo         repository.TeamRepository
+	playerRepo       repository.PlayerRepository
+	seasonRepo       repository.SeasonRepository
+	stadiumRepo      repository.StadiumRepository
+	managerRepo      repository.TeamManagerRepository
+	statusChangeRepo repository.MatchStatusChangeRepository
+	lineupRepo       repository.MatchLineupRepository
+	discipline       DisciplinaryService
+	txManager        repository.TxManager
+	cache            *ResponseCache
+	feed             *events.Bus // live match feed; nil disables publishing
+
+	// conflictWindow is the minimum gap between kick-offs of a team's matches on one date (0 = one match per date)
+	conflictWindow time.Duration
+	// location is the timezone match dates and kick-off times are given in
+	location *time.Location
+	// undoWindow is how long after submission a result can be undone (0 = never)
+	undoWindow time.Duration
 }
 
 // NewMatchService creates a new MatchService instance.
@@ -37,34 +68,86 @@ func NewMatchService(
 	matchRepo repository.MatchRepository,
 	teamRepo repository.TeamRepository,
 	playerRepo repository.PlayerRepository,
-	goalRepo repository.GoalRepository,
+	seasonRepo repository.SeasonRepository,
+	stadiumRepo repository.StadiumRepository,
+	managerRepo repository.TeamManagerRepository,
+	statusChangeRepo repository.MatchStatusChangeRepository,
+	lineupRepo repository.MatchLineupRepository,
+	discipline DisciplinaryService,
+	txManager repository.TxManager,
+	conflictWindow time.Duration,
+	location *time.Location,
+	undoWindow time.Duration,
+	responseCache *ResponseCache,
+	feed *events.Bus,
 ) MatchService {
 	return &matchService{
-		matchRepo:  matchRepo,
-		teamRepo:   teamRepo,
-		playerRepo: playerRepo,
-		goalRepo:   goalRepo,
+		matchRepo:        matchRepo,
+		teamRepo:         teamRepo,
+		playerRepo:       playerRepo,
+		seasonRepo:       seasonRepo,
+		stadiumRepo:      stadiumRepo,
+		managerRepo:      managerRepo,
+		statusChangeRepo: statusChangeRepo,
+		lineupRepo:       lineupRepo,
+		discipline:       discipline,
+		txManager:        txManager,
+		conflictWindow:   conflictWindow,
+		location:         location,
+		undoWindow:       undoWindow,
+		cache:            responseCache,
+		feed:             feed,
 	}
 }
 
-func (s *matchService) GetAll(pagination dto.PaginationQuery) ([]dto.MatchResponse, *response.PaginationMeta, error) {
+func (s *matchService) GetAll(ctx context.Context, pagination dto.PaginationQuery, query dto.MatchFilterQuery) ([]dto.MatchResponse, *response.PaginationMeta, error) {
 	pagination.Sanitize()
 
-	matches, err := s.matchRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	filter, err := s.parseMatchFilter(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(filter.IDs) > 0 {
+		pagination = batchPage(pagination, filter.IDs)
+	}
+	return s.list(ctx, pagination, filter)
+}
+
+// GetArchived lists the matches of archived seasons, which GetAll leaves out.
+func (s *matchService) GetArchived(ctx context.Context, pagination dto.PaginationQuery, query dto.MatchFilterQuery) ([]dto.MatchResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	filter, err := s.parseMatchFilter(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(filter.IDs) > 0 {
+		pagination = batchPage(pagination, filter.IDs)
+	}
+	filter.Archived = true
+	return s.list(ctx, pagination, filter)
+}
+
+// list returns one page of the matches matching filter.
+func (s *matchService) list(ctx context.Context, pagination dto.PaginationQuery, filter repository.MatchFilter) ([]dto.MatchResponse, *response.PaginationMeta, error) {
+	matches, err := s.matchRepo.FindAll(filter, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
 	if err != nil {
-		slog.Error("failed to fetch matches", "error", err)
+		slog.ErrorContext(ctx, "failed to fetch matches", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
-	total, err := s.matchRepo.Count()
+	total, err := s.matchRepo.Count(filter)
 	if err != nil {
-		slog.Error("failed to count matches", "error", err)
+		slog.ErrorContext(ctx, "failed to count matches", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
 	matchResponses := make([]dto.MatchResponse, len(matches))
 	for i, match := range matches {
-		matchResponses[i] = toMatchResponse(match)
+		matchResponses[i] = toMatchResponse(match, s.location)
+	}
+	if len(filter.IDs) > 0 {
+		matchResponses = inRequestOrder(matchResponses, filter.IDs, func(m dto.MatchResponse) string { return m.ID })
 	}
 
 	totalPages := int(total) / pagination.PerPage
@@ -82,21 +165,25 @@ func (s *matchService) GetAll(pagination dto.PaginationQuery) ([]dto.MatchRespon
 	return matchResponses, meta, nil
 }
 
-func (s *matchService) GetByID(id uuid.UUID) (*dto.MatchResponse, error) {
-	match, err := s.matchRepo.FindByIDWithDetails(id)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Match not found")
+func (s *matchService) GetByID(ctx context.Context, id uuid.UUID) (*dto.MatchResponse, error) {
+	resp, err := cached(ctx, s.cache, cachePrefixMatches+"detail:"+id.String(), func() (dto.MatchResponse, error) {
+		match, err := s.matchRepo.FindByIDWithDetails(id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return dto.MatchResponse{}, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+			}
+			slog.ErrorContext(ctx, "failed to fetch match", "error", err, "match_id", id)
+			return dto.MatchResponse{}, errs.ErrInternal("Internal server error")
 		}
-		slog.Error("failed to fetch match", "error", err, "match_id", id)
-		return nil, errs.ErrInternal("Internal server error")
+		return toMatchResponse(*match, s.location), nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	resp := toMatchResponse(*match)
 	return &resp, nil
 }
 
-func (s *matchService) Create(req dto.CreateMatchRequest) (*dto.MatchResponse, error) {
+func (s *matchService) Create(ctx context.Context, req dto.CreateMatchRequest) (*dto.MatchResponse, error) {
 	homeTeamID, err := uuid.Parse(req.HomeTeamID)
 	if err != nil {
 		return nil, errs.ErrBadRequest("Invalid home_team_id format")
@@ -108,64 +195,117 @@ func (s *matchService) Create(req dto.CreateMatchRequest) (*dto.MatchResponse, e
 
 	// Validate: home_team_id != away_team_id
 	if homeTeamID == awayTeamID {
-		return nil, errs.ErrBadRequest("Home team and away team cannot be the same")
+		return nil, errs.ErrBadRequest("Home team and away team cannot be the same").WithCode(CodeSameTeams)
 	}
 
 	// Verify both teams exist
-	if _, err := s.teamRepo.FindByID(homeTeamID); err != nil {
+	homeTeam, err := s.teamRepo.FindByID(homeTeamID)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Home team not found")
+			return nil, errs.ErrNotFound("Home team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch home team", "error", err)
+		slog.ErrorContext(ctx, "failed to fetch home team", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 	if _, err := s.teamRepo.FindByID(awayTeamID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Away team not found")
+			return nil, errs.ErrNotFound("Away team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch away team", "error", err)
+		slog.ErrorContext(ctx, "failed to fetch away team", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
+	seasonID, err := s.resolveSeason(ctx, req.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	venue, err := s.resolveVenue(ctx, req.VenueID, homeTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	kickoff, err := parseKickoff(req.MatchDatetime, s.location)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateUpcomingKickoff(kickoff); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureNoScheduleConflict(ctx, uuid.Nil, homeTeamID, awayTeamID, kickoff); err != nil {
+		return nil, err
+	}
+	round, err := s.resolveRound(ctx, uuid.Nil, seasonID, req.Round, homeTeamID, awayTeamID)
+	if err != nil {
+		return nil, err
+	}
+
 	match := model.Match{
-		HomeTeamID: homeTeamID,
-		AwayTeamID: awayTeamID,
-		MatchDate:  req.MatchDate,
-		MatchTime:  req.MatchTime,
-		Status:     "scheduled",
-		HomeScore:  0,
-		AwayScore:  0,
+		HomeTeamID:    homeTeamID,
+		AwayTeamID:    awayTeamID,
+		SeasonID:      seasonID,
+		Round:         round,
+		VenueID:       stadiumID(venue),
+		MatchDatetime: kickoff,
+		Status:        model.MatchStatusScheduled,
+		HomeScore:     0,
+		AwayScore:     0,
 	}
 
 	if err := s.matchRepo.Create(&match); err != nil {
-		slog.Error("failed to create match", "error", err)
+		slog.ErrorContext(ctx, "failed to create match", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
 
 	// Reload with teams preloaded
 	created, err := s.matchRepo.FindByID(match.ID)
 	if err != nil {
-		slog.Error("failed to reload created match", "error", err)
+		slog.ErrorContext(ctx, "failed to reload created match", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
-	resp := toMatchResponse(*created)
+	resp := toMatchResponse(*created, s.location)
+	s.feed.Publish(FeedMatchCreated, resp)
 	return &resp, nil
 }
 
-func (s *matchService) Update(id uuid.UUID, req dto.UpdateMatchRequest) (*dto.MatchResponse, error) {
+func (s *matchService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateMatchRequest) (*dto.MatchResponse, error) {
+	match, err := s.matchRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for update", "error", err, "match_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return s.update(ctx, match, req)
+}
+
+// Patch changes only the schedule fields present in req and keeps the others.
+func (s *matchService) Patch(ctx context.Context, id uuid.UUID, req dto.PatchMatchRequest) (*dto.MatchResponse, error) {
 	match, err := s.matchRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Match not found")
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
 		}
-		slog.Error("failed to fetch match for update", "error", err, "match_id", id)
+		slog.ErrorContext(ctx, "failed to fetch match for patch", "error", err, "match_id", id)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
-	// Cannot update a completed match schedule
-	if match.Status == "completed" {
-		return nil, errs.ErrBadRequest("Cannot update schedule of a completed match")
+	return s.update(ctx, match, mergeMatchPatch(*match, req))
+}
+
+// update reschedules match according to req and saves it.
+func (s *matchService) update(ctx context.Context, match *model.Match, req dto.UpdateMatchRequest) (*dto.MatchResponse, error) {
+	if err := checkVersion("Match", req.Version, match.Version); err != nil {
+		return nil, err
+	}
+
+	// Only matches that have not kicked off (or were postponed) can be rescheduled
+	if match.Status != model.MatchStatusScheduled && match.Status != model.MatchStatusPostponed {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("Cannot update schedule of a %s match", match.Status)).WithCode(CodeInvalidStatusTransition)
 	}
 
 	homeTeamID, err := uuid.Parse(req.HomeTeamID)
@@ -178,190 +318,1092 @@ func (s *matchService) Update(id uuid.UUID, req dto.UpdateMatchRequest) (*dto.Ma
 	}
 
 	if homeTeamID == awayTeamID {
-		return nil, errs.ErrBadRequest("Home team and away team cannot be the same")
+		return nil, errs.ErrBadRequest("Home team and away team cannot be the same").WithCode(CodeSameTeams)
 	}
 
 	// Verify both teams exist
-	if _, err := s.teamRepo.FindByID(homeTeamID); err != nil {
+	homeTeam, err := s.teamRepo.FindByID(homeTeamID)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Home team not found")
+			return nil, errs.ErrNotFound("Home team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch home team for update", "error", err, "match_id", id)
+		slog.ErrorContext(ctx, "failed to fetch home team for update", "error", err, "match_id", match.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
-	if _, err := s.teamRepo.FindByID(awayTeamID); err != nil {
+	awayTeam, err := s.teamRepo.FindByID(awayTeamID)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Away team not found")
+			return nil, errs.ErrNotFound("Away team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch away team for update", "error", err, "match_id", id)
+		slog.ErrorContext(ctx, "failed to fetch away team for update", "error", err, "match_id", match.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
+	seasonID, err := s.resolveSeason(ctx, req.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	venue, err := s.resolveVenue(ctx, req.VenueID, homeTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	kickoff, err := parseKickoff(req.MatchDatetime, s.location)
+	if err != nil {
+		return nil, err
+	}
+	// Moving a match requires a future kick-off; keeping the current one is allowed
+	if !kickoff.Equal(match.MatchDatetime) {
+		if err := validateUpcomingKickoff(kickoff); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.ensureNoScheduleConflict(ctx, match.ID, homeTeamID, awayTeamID, kickoff); err != nil {
+		return nil, err
+	}
+	round, err := s.resolveRound(ctx, match.ID, seasonID, req.Round, homeTeamID, awayTeamID)
+	if err != nil {
+		return nil, err
+	}
+
 	match.HomeTeamID = homeTeamID
 	match.AwayTeamID = awayTeamID
-	match.MatchDate = req.MatchDate
-	match.MatchTime = req.MatchTime
+	match.HomeTeam = homeTeam
+	match.AwayTeam = awayTeam
+	match.SeasonID = seasonID
+	match.Round = round
+	match.VenueID = stadiumID(venue)
+	match.Venue = venue
+	match.MatchDatetime = kickoff
 
 	if err := s.matchRepo.Update(match); err != nil {
-		slog.Error("failed to update match", "error", err, "match_id", id)
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Match")
+		}
+		slog.ErrorContext(ctx, "failed to update match", "error", err, "match_id", match.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
 
-	resp := toMatchResponse(*match)
+	resp := toMatchResponse(*match, s.location)
 	return &resp, nil
 }
 
-func (s *matchService) Delete(id uuid.UUID) error {
-	_, err := s.matchRepo.FindByID(id)
+func (s *matchService) Delete(ctx context.Context, id uuid.UUID) error {
+	match, err := s.matchRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errs.ErrNotFound("Match not found")
+			return errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
 		}
-		slog.Error("failed to fetch match for delete", "error", err, "match_id", id)
+		slog.ErrorContext(ctx, "failed to fetch match for delete", "error", err, "match_id", id)
 		return errs.ErrInternal("Internal server error")
 	}
 
 	if err := s.matchRepo.Delete(id); err != nil {
-		slog.Error("failed to delete match", "error", err, "match_id", id)
+		slog.ErrorContext(ctx, "failed to delete match", "error", err, "match_id", id)
 		return errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
+	s.feed.Publish(FeedMatchDeleted, dto.MatchDeletedEvent{MatchID: id.String(), Status: match.Status})
+
+	return nil
+}
+
+// ensureNoScheduleConflict returns a 409 error if either team already plays another match on the same date
+// (in the match timezone). With a positive conflict window, only matches kicking off less than the window apart conflict.
+// excludeID is the match being updated (uuid.Nil when creating).
+func (s *matchService) ensureNoScheduleConflict(ctx context.Context, excludeID, homeTeamID, awayTeamID uuid.UUID, kickoff time.Time) error {
+	local := kickoff.In(s.location)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.location)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	teams := []struct {
+		id    uuid.UUID
+		label string
+	}{
+		{homeTeamID, "Home team"},
+		{awayTeamID, "Away team"},
+	}
+
+	for _, team := range teams {
+		matches, err := s.matchRepo.FindByTeamBetween(team.id, dayStart, dayEnd)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check match schedule conflicts", "error", err, "team_id", team.id)
+			return errs.ErrInternal("Internal server error")
+		}
 
+		for _, other := range matches {
+			// Cancelled and postponed matches no longer occupy their original slot
+			if other.ID == excludeID || other.Status == model.MatchStatusCancelled || other.Status == model.MatchStatusPostponed {
+				continue
+			}
+			if s.conflictWindow > 0 && !kickoffsWithin(kickoff, other.MatchDatetime, s.conflictWindow) {
+				continue
+			}
+			otherLocal := other.MatchDatetime.In(s.location)
+			return errs.ErrConflict(fmt.Sprintf("%s already has a match on %s at %s", team.label, otherLocal.Format("2006-01-02"), otherLocal.Format("15:04"))).WithCode(CodeScheduleConflict)
+		}
+	}
 	return nil
 }
 
-// SubmitResult processes match results: validates goals, calculates scores, and transitions match status.
-func (s *matchService) SubmitResult(matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error) {
+// resolveRound validates the round a match is scheduled in: rounds belong to a season, and a
+// team plays at most one match per round (cancelled matches excluded). Returns nil for no round.
+func (s *matchService) resolveRound(ctx context.Context, excludeID uuid.UUID, seasonID *uuid.UUID, round int, homeTeamID, awayTeamID uuid.UUID) (*int, error) {
+	if round == 0 {
+		return nil, nil
+	}
+	if seasonID == nil {
+		return nil, errs.ErrBadRequest("A round can only be set for a match in a season").WithCode(CodeRoundWithoutSeason)
+	}
+
+	matches, err := s.matchRepo.FindSchedule(repository.MatchFilter{SeasonID: seasonID, Round: &round})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check round conflicts", "error", err, "season_id", *seasonID, "round", round)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	for _, other := range matches {
+		if other.ID == excludeID || other.Status == model.MatchStatusCancelled {
+			continue
+		}
+		for _, team := range []struct {
+			id    uuid.UUID
+			label string
+		}{{homeTeamID, "Home team"}, {awayTeamID, "Away team"}} {
+			if other.HomeTeamID == team.id || other.AwayTeamID == team.id {
+				return nil, errs.ErrConflict(fmt.Sprintf("%s already plays in round %d of this season", team.label, round)).WithCode(CodeRoundConflict)
+			}
+		}
+	}
+	return &round, nil
+}
+
+// kickoffsWithin reports whether two kick-off times are less than window apart.
+func kickoffsWithin(a, b time.Time, window time.Duration) bool {
+	gap := a.Sub(b)
+	if gap < 0 {
+		gap = -gap
+	}
+	return gap < window
+}
+
+// calendarEventDuration is the length of a match in the calendar feed (kick-off to final whistle, with margin).
+const calendarEventDuration = 2 * time.Hour
+
+// GetCalendar builds the iCalendar feed of all matches, optionally limited to one team and/or season.
+func (s *matchService) GetCalendar(ctx context.Context, query dto.MatchCalendarQuery) (*ical.Calendar, error) {
+	filter, err := parseSeasonFilter(dto.SeasonFilterQuery{SeasonID: query.SeasonID})
+	if err != nil {
+		return nil, err
+	}
+
+	name := "XYZ Football fixtures"
+	if query.TeamID != "" {
+		teamID, err := uuid.Parse(query.TeamID)
+		if err != nil {
+			return nil, errs.ErrBadRequest("Invalid team_id format")
+		}
+		team, err := s.teamRepo.FindByID(teamID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+			}
+			slog.ErrorContext(ctx, "failed to fetch team for calendar", "error", err, "team_id", teamID)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+		filter.TeamID = &teamID
+		name = team.Name + " fixtures"
+	}
+
+	matches, err := s.matchRepo.FindSchedule(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch matches for calendar", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	cal := &ical.Calendar{
+		ProdID: "-//XYZ//Football API//EN",
+		Name:   name,
+		Events: make([]ical.Event, 0, len(matches)),
+	}
+	for _, match := range matches {
+		cal.Events = append(cal.Events, toCalendarEvent(match))
+	}
+	return cal, nil
+}
+
+// toCalendarEvent converts a match to a calendar event starting at kick-off.
+func toCalendarEvent(match model.Match) ical.Event {
+	home, away := "TBD", "TBD"
+	if match.HomeTeam != nil {
+		home = match.HomeTeam.Name
+	}
+	if match.AwayTeam != nil {
+		away = match.AwayTeam.Name
+	}
+
+	event := ical.Event{
+		UID:         match.ID.String() + "@xyz-football-api",
+		Start:       match.MatchDatetime,
+		End:         match.MatchDatetime.Add(calendarEventDuration),
+		Summary:     home + " vs " + away,
+		Description: "Status: " + match.Status,
+		Status:      ical.StatusConfirmed,
+		Updated:     match.UpdatedAt,
+	}
+
+	switch match.Status {
+	case model.MatchStatusCompleted:
+		event.Summary = fmt.Sprintf("%s %d-%d %s", home, match.HomeScore, match.AwayScore, away)
+	case model.MatchStatusPostponed:
+		event.Summary = "[Postponed] " + event.Summary
+		event.Status = ical.StatusTentative
+	case model.MatchStatusCancelled:
+		event.Status = ical.StatusCancelled
+	}
+
+	// Matches without a venue are played at the home team's ground
+	var location []string
+	switch {
+	case match.Venue != nil:
+		location = []string{match.Venue.Name, match.Venue.City}
+	case match.HomeTeam != nil:
+		location = []string{match.HomeTeam.Address, match.HomeTeam.City}
+	}
+	var parts []string
+	for _, p := range location {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	event.Location = strings.Join(parts, ", ")
+	return event
+}
+
+// SubmitResult processes match results: validates events, calculates scores, and transitions match status.
+func (s *matchService) SubmitResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error) {
+	match, err := s.findForResult(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return s.processResult(ctx, match, req, false)
+}
+
+// PreviewResult validates a result exactly like SubmitResult and returns the score it would give
+// the match without saving anything, so clients can ask for confirmation before submitting.
+func (s *matchService) PreviewResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResultPreviewResponse, error) {
+	match, err := s.findForResult(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	events, players, err := s.applyResult(ctx, match, req)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &dto.MatchResultPreviewResponse{
+		MatchID:           match.ID.String(),
+		HomeScore:         match.HomeScore,
+		AwayScore:         match.AwayScore,
+		HomeShootoutScore: match.HomeShootoutScore,
+		AwayShootoutScore: match.AwayShootoutScore,
+		ScoreLine:         match.ScoreLine(),
+		Events:            make([]dto.MatchReportEvent, len(events)),
+	}
+	if match.HomeTeam != nil {
+		preview.HomeTeam = toTeamResponse(*match.HomeTeam)
+	}
+	if match.AwayTeam != nil {
+		preview.AwayTeam = toTeamResponse(*match.AwayTeam)
+	}
+	for i, event := range events {
+		preview.Events[i] = dto.MatchReportEvent{
+			Type:          event.Type,
+			Marker:        event.GoalMarker(),
+			Minute:        event.Minute,
+			AddedTime:     event.AddedTime,
+			DisplayMinute: event.DisplayMinute(),
+		}
+		team := match.AwayTeam
+		if event.TeamID == match.HomeTeamID {
+			team = match.HomeTeam
+		}
+		if team != nil {
+			preview.Events[i].TeamName = team.Name
+		}
+		if player := players[event.PlayerID]; player != nil {
+			preview.Events[i].PlayerName = player.Name
+		}
+		if event.RelatedPlayerID != nil {
+			if related := players[*event.RelatedPlayerID]; related != nil {
+				preview.Events[i].RelatedPlayerName = related.Name
+			}
+		}
+	}
+	return preview, nil
+}
+
+// findForResult returns the match for a result submission, failing unless the match has
+// kicked off and can be completed.
+func (s *matchService) findForResult(ctx context.Context, matchID uuid.UUID) (*model.Match, error) {
 	match, err := s.matchRepo.FindByID(matchID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Match not found")
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
 		}
-		slog.Error("failed to fetch match for result", "error", err, "match_id", matchID)
+		slog.ErrorContext(ctx, "failed to fetch match for result", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
-	if match.Status == "completed" {
-		return nil, errs.ErrBadRequest("Match result already submitted. Use PUT to update.")
+	if match.Status == model.MatchStatusCompleted {
+		return nil, errs.ErrBadRequest("Match result already submitted. Use PUT to update.").WithCode(CodeMatchAlreadyCompleted)
 	}
-
-	return s.processResult(match, req)
+	if !match.CanTransitionTo(model.MatchStatusCompleted) {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("Cannot submit result of a %s match", match.Status)).WithCode(CodeInvalidStatusTransition)
+	}
+	if match.MatchDatetime.After(time.Now()) {
+		return nil, errs.ErrBadRequest("Cannot submit result of a match that has not kicked off yet").WithCode(CodeMatchNotStarted)
+	}
+	return match, nil
 }
 
 // UpdateResult replaces existing match results with new ones.
-func (s *matchService) UpdateResult(matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error) {
+func (s *matchService) UpdateResult(ctx context.Context, matchID uuid.UUID, req dto.MatchResultRequest) (*dto.MatchResponse, error) {
+	match, err := s.matchRepo.FindByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for result update", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if match.Status != model.MatchStatusCompleted {
+		return nil, errs.ErrBadRequest("Cannot update result of a match that has not been completed. Use POST to submit first.").WithCode(CodeMatchNotCompleted)
+	}
+
+	return s.processResult(ctx, match, req, true)
+}
+
+// UndoResult reverts a result submitted by mistake: the match goes back to scheduled with a
+// 0-0 score and no shootout, and its events are soft-deleted. Only results submitted less
+// than undoWindow ago can be undone; older ones must be corrected with UpdateResult.
+func (s *matchService) UndoResult(ctx context.Context, matchID uuid.UUID) (*dto.MatchResponse, error) {
 	match, err := s.matchRepo.FindByID(matchID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Match not found")
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
 		}
-		slog.Error("failed to fetch match for result update", "error", err, "match_id", matchID)
+		slog.ErrorContext(ctx, "failed to fetch match for result undo", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	if match.Status != model.MatchStatusCompleted {
+		return nil, errs.ErrBadRequest("Cannot undo the result of a match that has not been completed").WithCode(CodeMatchNotCompleted)
+	}
+	if s.undoWindow <= 0 {
+		return nil, errs.ErrBadRequest("Undoing match results is disabled").WithCode(CodeResultUndoExpired)
+	}
 
-	if match.Status != "completed" {
-		return nil, errs.ErrBadRequest("Cannot update result of a match that has not been completed. Use POST to submit first.")
+	changes, err := s.statusChangeRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch match status changes for result undo", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	// The latest completion is the submission; corrections keep the match completed.
+	// Matches completed before status changes were recorded have none and cannot be undone.
+	var submittedAt time.Time
+	for _, change := range changes {
+		if change.ToStatus == model.MatchStatusCompleted {
+			submittedAt = change.CreatedAt
+		}
+	}
+	if submittedAt.IsZero() || time.Since(submittedAt) > s.undoWindow {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("Results can only be undone within %d minutes of submission. Use PUT to correct it.", int(s.undoWindow.Minutes()))).WithCode(CodeResultUndoExpired)
 	}
 
-	// Delete old goals before inserting new ones
-	if err := s.goalRepo.DeleteByMatchID(matchID); err != nil {
-		slog.Error("failed to delete old goals", "error", err, "match_id", matchID)
+	previousStatus := match.Status
+	match.Status = model.MatchStatusScheduled
+	match.HomeScore, match.AwayScore = 0, 0
+	match.HomeShootoutScore, match.AwayShootoutScore = nil, nil
+	match.Attendance, match.TicketsSold, match.TicketRevenue = nil, nil, nil
+	match.ManOfTheMatchID = nil
+	err = s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+		if err := repos.Events.DeleteByMatchID(match.ID); err != nil {
+			return fmt.Errorf("delete events: %w", err)
+		}
+		return saveStatus(repos, match, previousStatus)
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Match")
+		}
+		slog.ErrorContext(ctx, "failed to undo match result", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
 
-	return s.processResult(match, req)
+	resp := toMatchResponse(*match, s.location)
+	s.publishStatusChange(previousStatus, resp)
+	return &resp, nil
 }
 
-// processResult validates goals, calculates scores, and saves everything.
-func (s *matchService) processResult(match *model.Match, req dto.MatchResultRequest) (*dto.MatchResponse, error) {
+// UpdateStatus moves a match along its lifecycle (e.g. scheduled → live, scheduled → postponed).
+// Only transitions allowed by model.Match.CanTransitionTo are accepted; completion goes through SubmitResult.
+func (s *matchService) UpdateStatus(ctx context.Context, id uuid.UUID, req dto.MatchStatusRequest) (*dto.MatchResponse, error) {
+	match, err := s.matchRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for status change", "error", err, "match_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	if req.Status == model.MatchStatusCompleted {
+		return nil, errs.ErrBadRequest("Submit the match result to complete a match").WithCode(CodeInvalidStatusTransition)
+	}
+	if !match.CanTransitionTo(req.Status) {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("Cannot change match status from %s to %s", match.Status, req.Status)).WithCode(CodeInvalidStatusTransition)
+	}
+
+	// A postponed match returning to the schedule must not clash with matches booked in the meantime
+	if req.Status == model.MatchStatusScheduled {
+		if !match.MatchDatetime.After(time.Now()) {
+			return nil, errs.ErrBadRequest("Reschedule the match to a future kick-off before returning it to the schedule").WithCode(CodeKickoffNotInFuture)
+		}
+		if err := s.ensureNoScheduleConflict(ctx, match.ID, match.HomeTeamID, match.AwayTeamID, match.MatchDatetime); err != nil {
+			return nil, err
+		}
+	}
+
+	previousStatus := match.Status
+	match.Status = req.Status
+	err = s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+		return saveStatus(repos, match, previousStatus)
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Match")
+		}
+		slog.ErrorContext(ctx, "failed to update match status", "error", err, "match_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
+
+	resp := toMatchResponse(*match, s.location)
+	s.publishStatusChange(previousStatus, resp)
+	return &resp, nil
+}
+
+// AdvanceStatuses moves matches along their lifecycle as kick-off times pass: scheduled matches
+// go live at kick-off, and scheduled or live matches still without a result resultGrace after
+// kick-off are flagged awaiting_result so admins can chase the missing result. Returns how many
+// matches changed status; matches changed by an admin in the meantime are left for the next run.
+func (s *matchService) AdvanceStatuses(ctx context.Context, resultGrace time.Duration) (int, error) {
+	now := time.Now()
+	overdue := now.Add(-resultGrace)
+	steps := []struct {
+		from, to string
+		before   time.Time
+	}{
+		{model.MatchStatusScheduled, model.MatchStatusAwaitingResult, overdue},
+		{model.MatchStatusLive, model.MatchStatusAwaitingResult, overdue},
+		{model.MatchStatusScheduled, model.MatchStatusLive, now},
+	}
+
+	changed := 0
+	defer func() {
+		if changed > 0 {
+			s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
+		}
+	}()
+	for _, step := range steps {
+		matches, err := s.matchRepo.FindSchedule(repository.MatchFilter{Status: step.from, To: &step.before})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to fetch kicked-off matches", "error", err, "status", step.from)
+			return changed, errs.ErrInternal("Internal server error")
+		}
+		for i := range matches {
+			match := &matches[i]
+			match.Status = step.to
+			err := s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+				return saveStatus(repos, match, step.from)
+			})
+			if err != nil {
+				if isVersionConflict(err) {
+					continue
+				}
+				slog.ErrorContext(ctx, "failed to advance match status", "error", err, "match_id", match.ID, "status", step.to)
+				return changed, errs.ErrInternal("Internal server error")
+			}
+			changed++
+			s.publishStatusChange(step.from, toMatchResponse(*match, s.location))
+		}
+	}
+	return changed, nil
+}
+
+// ArchiveSeasons archives the completed matches of seasons that ended more than years ago,
+// in the match timezone. Archived matches and their events are kept, but left out of match
+// lists, standings, reports and statistics; GetArchived lists them. Returns how many matches
+// were archived.
+func (s *matchService) ArchiveSeasons(ctx context.Context, years int) (int64, error) {
+	cutoff := time.Now().In(s.location).AddDate(-years, 0, 0).Format("2006-01-02")
+	archived, err := s.matchRepo.ArchiveSeasonsEndedBefore(cutoff)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to archive matches of old seasons", "error", err, "ended_before", cutoff)
+		return 0, errs.ErrInternal("Internal server error")
+	}
+	if archived > 0 {
+		s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
+	}
+	return archived, nil
+}
+
+// maxSubstitutionsPerTeam is the number of substitutions a team may make in one match.
+const maxSubstitutionsPerTeam = 5
+
+// resultEntry is a match event input paired with the label used in validation errors.
+type resultEntry struct {
+	label string
+	input dto.MatchEventInput
+}
+
+// resultEntries normalizes the request into a single event list.
+// Legacy goal-only payloads are converted to goal, penalty or own goal events by their type.
+func resultEntries(req dto.MatchResultRequest) ([]resultEntry, error) {
+	if len(req.Events) > 0 && len(req.Goals) > 0 {
+		return nil, errs.ErrBadRequest("Provide either events or goals, not both").WithCode(CodeInvalidMatchEvent)
+	}
+
+	entries := make([]resultEntry, 0, len(req.Events)+len(req.Goals))
+	for i, e := range req.Events {
+		entries = append(entries, resultEntry{label: fmt.Sprintf("Event #%d", i+1), input: e})
+	}
+	for i, g := range req.Goals {
+		entries = append(entries, resultEntry{
+			label: fmt.Sprintf("Goal #%d", i+1),
+			input: dto.MatchEventInput{Type: goalEventType(g.Type), PlayerID: g.PlayerID, TeamID: g.TeamID, Minute: g.Minute},
+		})
+	}
+	return entries, nil
+}
+
+// goalEventType returns the event type a legacy goal of the given type is recorded as.
+func goalEventType(goalType string) string {
+	if eventType, ok := model.GoalTypeEvents[goalType]; ok {
+		return eventType
+	}
+	return model.EventGoal
+}
+
+// applyResult validates a result's events against the match and sets the match's scores,
+// shootout and status as the result would, without saving anything. It returns the events to
+// record and the players involved, by ID.
+func (s *matchService) applyResult(ctx context.Context, match *model.Match, req dto.MatchResultRequest) ([]model.MatchEvent, map[uuid.UUID]*model.Player, error) {
+	// Team managers may only record results of their own teams' matches
+	if err := ensureManagesTeam(ctx, s.managerRepo, "You can only submit results for your own teams' matches", match.HomeTeamID, match.AwayTeamID); err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := resultEntries(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	homeScore := 0
 	awayScore := 0
-	goals := make([]model.Goal, 0, len(req.Goals))
+	events := make([]model.MatchEvent, 0, len(entries))
+	players := make(map[uuid.UUID]*model.Player)
+	yellowCards := make(map[uuid.UUID]int)
+	redCards := make(map[uuid.UUID]bool)
+	substitutions := make(map[uuid.UUID]int)
+
+	// Everyone involved in an event, in order of first appearance, for the suspension check
+	var participants []uuid.UUID
+	labels := make(map[uuid.UUID]string)
+	involve := func(playerID uuid.UUID, label string) {
+		if _, ok := labels[playerID]; !ok {
+			participants = append(participants, playerID)
+			labels[playerID] = label
+		}
+	}
 
-	for i, goalInput := range req.Goals {
-		playerID, err := uuid.Parse(goalInput.PlayerID)
+	for _, entry := range entries {
+		in := entry.input
+
+		playerID, err := uuid.Parse(in.PlayerID)
 		if err != nil {
-			return nil, errs.ErrBadRequest(fmt.Sprintf("Goal #%d: invalid player_id format", i+1))
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: invalid player_id format", entry.label))
 		}
-		teamID, err := uuid.Parse(goalInput.TeamID)
+		teamID, err := uuid.Parse(in.TeamID)
 		if err != nil {
-			return nil, errs.ErrBadRequest(fmt.Sprintf("Goal #%d: invalid team_id format", i+1))
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: invalid team_id format", entry.label))
 		}
 
 		// Validate team_id is either home or away team
 		if teamID != match.HomeTeamID && teamID != match.AwayTeamID {
-			return nil, errs.ErrBadRequest(fmt.Sprintf("Goal #%d: team_id must be either home or away team", i+1))
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: team_id must be either home or away team", entry.label)).WithCode(CodeInvalidMatchEvent)
 		}
 
 		// Validate player belongs to the specified team
-		player, err := s.playerRepo.FindByID(playerID)
+		if err := s.checkEventPlayer(ctx, players, playerID, teamID, entry.label, "player"); err != nil {
+			return nil, nil, err
+		}
+		involve(playerID, entry.label)
+
+		minute, addedTime, err := model.ParseEventMinute(string(in.Minute))
 		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, errs.ErrNotFound(fmt.Sprintf("Goal #%d: player not found", i+1))
-			}
-			slog.Error("failed to fetch player for goal validation", "error", err)
-			return nil, errs.ErrInternal("Internal server error")
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: minute must be a match minute such as 67, or 90+3 in added time", entry.label)).WithCode(CodeInvalidMatchEvent)
 		}
-		if player.TeamID != teamID {
-			return nil, errs.ErrBadRequest(fmt.Sprintf("Goal #%d: player does not belong to the specified team", i+1))
+
+		event := model.MatchEvent{
+			MatchID:   match.ID,
+			Type:      in.Type,
+			PlayerID:  playerID,
+			TeamID:    teamID,
+			Minute:    minute,
+			AddedTime: addedTime,
 		}
 
-		// Count scores
-		if teamID == match.HomeTeamID {
-			homeScore++
-		} else {
-			awayScore++
+		if in.Type != model.EventSubstitution && in.RelatedPlayerID != "" {
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: related_player_id is only allowed for substitutions", entry.label)).WithCode(CodeInvalidMatchEvent)
 		}
 
-		goals = append(goals, model.Goal{
-			MatchID:  match.ID,
-			PlayerID: playerID,
-			TeamID:   teamID,
-			Minute:   goalInput.Minute,
-		})
+		switch in.Type {
+		case model.EventGoal, model.EventPenalty:
+			if teamID == match.HomeTeamID {
+				homeScore++
+			} else {
+				awayScore++
+			}
+		case model.EventOwnGoal:
+			// Own goals count for the opponent of the player's team
+			if teamID == match.HomeTeamID {
+				awayScore++
+			} else {
+				homeScore++
+			}
+		case model.EventYellowCard:
+			yellowCards[playerID]++
+			if yellowCards[playerID] > 2 {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: player cannot receive more than two yellow cards", entry.label)).WithCode(CodeInvalidMatchEvent)
+			}
+		case model.EventRedCard:
+			if redCards[playerID] {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: player has already been sent off", entry.label)).WithCode(CodeInvalidMatchEvent)
+			}
+			redCards[playerID] = true
+		case model.EventSubstitution:
+			if in.RelatedPlayerID == "" {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: related_player_id is required for substitutions", entry.label)).WithCode(CodeInvalidMatchEvent)
+			}
+			relatedID, err := uuid.Parse(in.RelatedPlayerID)
+			if err != nil {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: invalid related_player_id format", entry.label))
+			}
+			if relatedID == playerID {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: a player cannot substitute themselves", entry.label)).WithCode(CodeInvalidMatchEvent)
+			}
+			if err := s.checkEventPlayer(ctx, players, relatedID, teamID, entry.label, "related player"); err != nil {
+				return nil, nil, err
+			}
+			involve(relatedID, entry.label)
+			substitutions[teamID]++
+			if substitutions[teamID] > maxSubstitutionsPerTeam {
+				return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: a team cannot make more than %d substitutions", entry.label, maxSubstitutionsPerTeam)).WithCode(CodeInvalidMatchEvent)
+			}
+			event.RelatedPlayerID = &relatedID
+		default:
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("%s: unsupported event type %q", entry.label, in.Type)).WithCode(CodeInvalidMatchEvent)
+		}
+
+		events = append(events, event)
 	}
 
-	// Batch insert goals
-	if len(goals) > 0 {
-		if err := s.goalRepo.CreateBatch(goals); err != nil {
-			slog.Error("failed to create goals", "error", err)
-			return nil, errs.ErrInternal("Internal server error")
+	// A player serving a ban for cards in earlier matches cannot have taken part
+	if err := s.discipline.CheckEligibility(ctx, *match, participants, labels); err != nil {
+		return nil, nil, err
+	}
+
+	// A shootout can only settle a draw, and someone has to win it
+	match.HomeShootoutScore, match.AwayShootoutScore = nil, nil
+	if shootout := req.Shootout; shootout != nil {
+		if homeScore != awayScore {
+			return nil, nil, errs.ErrBadRequest(fmt.Sprintf("Penalty shootout is only possible after a draw, the result is %d-%d", homeScore, awayScore)).WithCode(CodeInvalidShootout)
+		}
+		if shootout.HomeScore == shootout.AwayScore {
+			return nil, nil, errs.ErrBadRequest("Penalty shootout must have a winner").WithCode(CodeInvalidShootout)
 		}
+		match.HomeShootoutScore, match.AwayShootoutScore = &shootout.HomeScore, &shootout.AwayScore
+	}
+
+	manOfTheMatch, err := s.checkManOfTheMatch(ctx, match, players, req.ManOfTheMatchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	match.ManOfTheMatchID = manOfTheMatch
+
+	match.Attendance = req.Attendance
+	match.TicketsSold, match.TicketRevenue = nil, nil
+	if ticketing := req.Ticketing; ticketing != nil {
+		match.TicketsSold, match.TicketRevenue = &ticketing.TicketsSold, &ticketing.Revenue
 	}
 
-	// Update match scores and status
 	match.HomeScore = homeScore
 	match.AwayScore = awayScore
-	match.Status = "completed"
+	match.Status = model.MatchStatusCompleted
+	return events, players, nil
+}
 
-	if err := s.matchRepo.Update(match); err != nil {
-		slog.Error("failed to update match with results", "error", err)
+// processResult validates events, calculates scores (see applyResult), and saves everything.
+// All writes (removing old events when replacing, inserting new events, updating the match)
+// run in a single transaction so a failure part-way leaves the stored result untouched.
+func (s *matchService) processResult(ctx context.Context, match *model.Match, req dto.MatchResultRequest, replace bool) (*dto.MatchResponse, error) {
+	previousStatus := match.Status
+	events, _, err := s.applyResult(ctx, match, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+		// Delete old events before inserting new ones
+		if replace {
+			if err := repos.Events.DeleteByMatchID(match.ID); err != nil {
+				return fmt.Errorf("delete old events: %w", err)
+			}
+		}
+		if len(events) > 0 {
+			if err := repos.Events.CreateBatch(events); err != nil {
+				return fmt.Errorf("create events: %w", err)
+			}
+		}
+		if err := saveStatus(repos, match, previousStatus); err != nil {
+			return fmt.Errorf("update match: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Match")
+		}
+		slog.ErrorContext(ctx, "failed to save match result", "error", err, "match_id", match.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixMatches, cachePrefixStandings)
 
 	// Reload with full details
 	updated, err := s.matchRepo.FindByIDWithDetails(match.ID)
 	if err != nil {
-		slog.Error("failed to reload match after result", "error", err)
+		slog.ErrorContext(ctx, "failed to reload match after result", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
-	resp := toMatchResponse(*updated)
+	resp := toMatchResponse(*updated, s.location)
+	if replace {
+		// Corrections replace the whole timeline, so goals are not announced again
+		s.feed.Publish(FeedMatchResultUpdated, resp)
+	} else {
+		s.publishGoals(*updated)
+		s.publishStatusChange(previousStatus, resp)
+		s.feed.Publish(FeedMatchCompleted, resp)
+	}
 	return &resp, nil
 }
 
-// toMatchResponse converts a model.Match to dto.MatchResponse.
-func toMatchResponse(match model.Match) dto.MatchResponse {
+// saveStatus saves a match whose status may have changed from previousStatus, recording the
+// change for the match timeline. Runs within the caller's transaction.
+func saveStatus(repos repository.TxRepositories, match *model.Match, previousStatus string) error {
+	if err := repos.Matches.Update(match); err != nil {
+		return err
+	}
+	if match.Status == previousStatus {
+		return nil
+	}
+	change := &model.MatchStatusChange{MatchID: match.ID, FromStatus: previousStatus, ToStatus: match.Status}
+	if err := repos.StatusChanges.Create(change); err != nil {
+		return fmt.Errorf("record status change: %w", err)
+	}
+	return nil
+}
+
+// checkEventPlayer verifies that a player referenced by an event exists and belongs to teamID.
+// Players are cached so each one is looked up at most once per result submission.
+func (s *matchService) checkEventPlayer(ctx context.Context, cache map[uuid.UUID]*model.Player, playerID, teamID uuid.UUID, label, role string) error {
+	player, ok := cache[playerID]
+	if !ok {
+		var err error
+		player, err = s.playerRepo.FindByID(playerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errs.ErrNotFound(fmt.Sprintf("%s: %s not found", label, role))
+			}
+			slog.ErrorContext(ctx, "failed to fetch player for event validation", "error", err)
+			return errs.ErrInternal("Internal server error")
+		}
+		cache[playerID] = player
+	}
+	if player.TeamID != teamID {
+		return errs.ErrBadRequest(fmt.Sprintf("%s: %s does not belong to the specified team", label, role)).WithCode(CodePlayerNotInTeam)
+	}
+	return nil
+}
+
+// checkManOfTheMatch verifies that the man of the match of a result plays for one of the
+// match's teams and, if that team's lineup was recorded, was named in it. It returns nil
+// when the result names no man of the match.
+func (s *matchService) checkManOfTheMatch(ctx context.Context, match *model.Match, cache map[uuid.UUID]*model.Player, raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	playerID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid man_of_the_match_id format")
+	}
+
+	player, ok := cache[playerID]
+	if !ok {
+		player, err = s.playerRepo.FindByID(playerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.ErrNotFound("Man of the match: player not found")
+			}
+			slog.ErrorContext(ctx, "failed to fetch man of the match", "error", err, "player_id", playerID)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+		cache[playerID] = player
+	}
+	if player.TeamID != match.HomeTeamID && player.TeamID != match.AwayTeamID {
+		return nil, errs.ErrBadRequest("Man of the match must play for one of the two teams").WithCode(CodeInvalidManOfTheMatch)
+	}
+
+	lineups, err := s.lineupRepo.FindByMatchID(match.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch lineups for man of the match", "error", err, "match_id", match.ID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	named, recorded := false, false
+	for _, entry := range lineups {
+		if entry.TeamID == player.TeamID {
+			recorded = true
+			named = named || entry.PlayerID == playerID
+		}
+	}
+	if recorded && !named {
+		return nil, errs.ErrBadRequest("Man of the match must be in the team's lineup").WithCode(CodeInvalidManOfTheMatch)
+	}
+	return &playerID, nil
+}
+
+// parseMatchFilter converts the listing query to a repository filter.
+// Dates are whole days in the match timezone; date_to includes the matches of that day.
+func (s *matchService) parseMatchFilter(query dto.MatchFilterQuery) (repository.MatchFilter, error) {
+	filter, err := parseSeasonFilter(dto.SeasonFilterQuery{SeasonID: query.SeasonID})
+	if err != nil {
+		return filter, err
+	}
+	filter.Status = query.Status
+	if filter.IDs, err = parseIDList(query.IDs); err != nil {
+		return filter, err
+	}
+	if filter.Projection, err = parseFields[dto.MatchResponse](query.Fields, matchFieldSources); err != nil {
+		return filter, err
+	}
+	if query.Round != 0 {
+		filter.Round = &query.Round
+	}
+
+	if query.TeamID != "" {
+		teamID, err := uuid.Parse(query.TeamID)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid team_id format")
+		}
+		filter.TeamID = &teamID
+	}
+	if query.DateFrom != "" {
+		from, err := time.ParseInLocation("2006-01-02", query.DateFrom, s.location)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid date_from, expected YYYY-MM-DD")
+		}
+		filter.From = &from
+	}
+	if query.DateTo != "" {
+		to, err := time.ParseInLocation("2006-01-02", query.DateTo, s.location)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid date_to, expected YYYY-MM-DD")
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, errs.ErrBadRequest("date_from must not be after date_to")
+	}
+
+	return filter, nil
+}
+
+// mergeMatchPatch returns the full update that applies patch to match.
+func mergeMatchPatch(match model.Match, patch dto.PatchMatchRequest) dto.UpdateMatchRequest {
+	req := dto.UpdateMatchRequest{
+		HomeTeamID:    match.HomeTeamID.String(),
+		AwayTeamID:    match.AwayTeamID.String(),
+		MatchDatetime: match.MatchDatetime.UTC().Format(time.RFC3339Nano),
+		Version:       patch.Version,
+	}
+	if match.SeasonID != nil {
+		req.SeasonID = match.SeasonID.String()
+	}
+	if match.Round != nil {
+		req.Round = *match.Round
+	}
+	if patch.HomeTeamID != nil {
+		req.HomeTeamID = *patch.HomeTeamID
+	}
+	if patch.AwayTeamID != nil {
+		req.AwayTeamID = *patch.AwayTeamID
+	}
+	if patch.MatchDatetime != nil {
+		req.MatchDatetime = *patch.MatchDatetime
+	}
+	if patch.SeasonID != nil {
+		req.SeasonID = *patch.SeasonID
+		if req.SeasonID == "" {
+			req.Round = 0
+		}
+	}
+	if patch.Round != nil {
+		req.Round = *patch.Round
+	}
+	switch {
+	case patch.VenueID != nil:
+		req.VenueID = *patch.VenueID
+	case match.VenueID != nil && strings.EqualFold(req.HomeTeamID, match.HomeTeamID.String()):
+		req.VenueID = match.VenueID.String()
+	}
+	return req
+}
+
+// resolveSeason parses an optional season_id and verifies the season exists.
+// Returns nil when no season is given.
+func (s *matchService) resolveSeason(ctx context.Context, raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	seasonID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid season_id format")
+	}
+	if _, err := s.seasonRepo.FindByID(seasonID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Season not found").WithCode(CodeSeasonNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch season", "error", err, "season_id", seasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return &seasonID, nil
+}
+
+// resolveVenue parses an optional venue_id and returns the stadium it refers to. Without one,
+// the match is played at the home team's stadium; nil is returned when it has none either.
+func (s *matchService) resolveVenue(ctx context.Context, raw string, homeTeam *model.Team) (*model.Stadium, error) {
+	var venueID uuid.UUID
+	switch {
+	case raw != "":
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, errs.ErrBadRequest("Invalid venue_id format")
+		}
+		venueID = id
+	case homeTeam.StadiumID != nil:
+		venueID = *homeTeam.StadiumID
+	default:
+		return nil, nil
+	}
+
+	venue, err := s.stadiumRepo.FindByID(venueID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Venue not found").WithCode(CodeStadiumNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch venue", "error", err, "stadium_id", venueID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return venue, nil
+}
+
+// stadiumID returns the ID of stadium, or nil without a stadium.
+func stadiumID(stadium *model.Stadium) *uuid.UUID {
+	if stadium == nil {
+		return nil
+	}
+	return &stadium.ID
+}
+
+// maxScheduleAhead is how far in the future a match can be scheduled; it catches mistyped years.
+const maxScheduleAhead = 2 * 365 * 24 * time.Hour
+
+// parseKickoff parses an ISO 8601 kick-off time. Values without a UTC offset are read in loc.
+func parseKickoff(value string, loc *time.Location) (time.Time, error) {
+	if t, err := timefmt.Parse(value, loc); err == nil {
+		return t.UTC(), nil
+	}
+	return time.Time{}, errs.ErrBadRequest("Invalid match_datetime, expected ISO 8601 such as 2025-06-15T19:30:00+07:00")
+}
+
+// validateUpcomingKickoff rejects kick-off times in the past or implausibly far ahead.
+func validateUpcomingKickoff(kickoff time.Time) error {
+	now := time.Now()
+	if !kickoff.After(now) {
+		return errs.ErrBadRequest("match_datetime must be in the future").WithCode(CodeKickoffNotInFuture)
+	}
+	if kickoff.After(now.Add(maxScheduleAhead)) {
+		return errs.ErrBadRequest("match_datetime cannot be more than 2 years ahead")
+	}
+	return nil
+}
+
+// kickoffTimes formats a kick-off as RFC 3339 in the API timezone and in loc.
+func kickoffTimes(kickoff time.Time, loc *time.Location) (instant, local string) {
+	return timefmt.Format(kickoff), timefmt.FormatIn(kickoff, loc)
+}
+
+// toMatchResponse converts a model.Match to dto.MatchResponse, with local times in loc.
+func toMatchResponse(match model.Match, loc *time.Location) dto.MatchResponse {
+	kickoffAt, local := kickoffTimes(match.MatchDatetime, loc)
 	resp := dto.MatchResponse{
-		ID:         match.ID.String(),
-		HomeTeamID: match.HomeTeamID.String(),
-		AwayTeamID: match.AwayTeamID.String(),
-		MatchDate:  match.MatchDate,
-		MatchTime:  match.MatchTime,
-		HomeScore:  match.HomeScore,
-		AwayScore:  match.AwayScore,
-		Status:     match.Status,
-		CreatedAt:  match.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:  match.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:                match.ID.String(),
+		HomeTeamID:        match.HomeTeamID.String(),
+		AwayTeamID:        match.AwayTeamID.String(),
+		MatchDatetime:     kickoffAt,
+		LocalDatetime:     local,
+		Timezone:          loc.String(),
+		HomeScore:         match.HomeScore,
+		AwayScore:         match.AwayScore,
+		HomeShootoutScore: match.HomeShootoutScore,
+		AwayShootoutScore: match.AwayShootoutScore,
+		Attendance:        match.Attendance,
+		Status:            match.Status,
+		Version:           match.Version,
+		CreatedAt:         timefmt.Format(match.CreatedAt),
+		UpdatedAt:         timefmt.Format(match.UpdatedAt),
+	}
+
+	if match.SeasonID != nil {
+		resp.SeasonID = match.SeasonID.String()
+	}
+	resp.Round = match.Round
+	if match.VenueID != nil {
+		resp.VenueID = match.VenueID.String()
+	}
+	if match.ArchivedAt != nil {
+		resp.ArchivedAt = timefmt.Format(*match.ArchivedAt)
+	}
+	if match.ManOfTheMatchID != nil {
+		resp.ManOfTheMatchID = match.ManOfTheMatchID.String()
+	}
+	if match.TicketsSold != nil && match.TicketRevenue != nil {
+		resp.Ticketing = &dto.TicketingResponse{TicketsSold: *match.TicketsSold, Revenue: *match.TicketRevenue}
 	}
 
 	if match.HomeTeam != nil {
@@ -372,34 +1414,48 @@ func toMatchResponse(match model.Match) dto.MatchResponse {
 		awayTeam := toTeamResponse(*match.AwayTeam)
 		resp.AwayTeam = &awayTeam
 	}
+	if match.Venue != nil {
+		venue := toStadiumResponse(*match.Venue)
+		resp.Venue = &venue
+	}
 
-	if len(match.Goals) > 0 {
-		resp.Goals = make([]dto.GoalResponse, len(match.Goals))
-		for i, goal := range match.Goals {
-			resp.Goals[i] = toGoalResponse(goal)
+	if len(match.Events) > 0 {
+		resp.Events = make([]dto.MatchEventResponse, len(match.Events))
+		for i, event := range match.Events {
+			resp.Events[i] = toMatchEventResponse(event)
 		}
 	}
 
 	return resp
 }
 
-// toGoalResponse converts a model.Goal to dto.GoalResponse.
-func toGoalResponse(goal model.Goal) dto.GoalResponse {
-	resp := dto.GoalResponse{
-		ID:        goal.ID.String(),
-		MatchID:   goal.MatchID.String(),
-		PlayerID:  goal.PlayerID.String(),
-		TeamID:    goal.TeamID.String(),
-		Minute:    goal.Minute,
-		CreatedAt: goal.CreatedAt.Format("2006-01-02T15:04:05Z"),
+// toMatchEventResponse converts a model.MatchEvent to dto.MatchEventResponse.
+func toMatchEventResponse(event model.MatchEvent) dto.MatchEventResponse {
+	resp := dto.MatchEventResponse{
+		ID:            event.ID.String(),
+		MatchID:       event.MatchID.String(),
+		Type:          event.Type,
+		PlayerID:      event.PlayerID.String(),
+		TeamID:        event.TeamID.String(),
+		Minute:        event.Minute,
+		AddedTime:     event.AddedTime,
+		DisplayMinute: event.DisplayMinute(),
+		CreatedAt:     timefmt.Format(event.CreatedAt),
 	}
 
-	if goal.Player != nil {
-		playerResp := toPlayerResponse(*goal.Player)
+	if event.RelatedPlayerID != nil {
+		resp.RelatedPlayerID = event.RelatedPlayerID.String()
+	}
+	if event.Player != nil {
+		playerResp := toPlayerResponse(*event.Player)
 		resp.Player = &playerResp
 	}
-	if goal.Team != nil {
-		teamResp := toTeamResponse(*goal.Team)
+	if event.RelatedPlayer != nil {
+		relatedResp := toPlayerResponse(*event.RelatedPlayer)
+		resp.RelatedPlayer = &relatedResp
+	}
+	if event.Team != nil {
+		teamResp := toTeamResponse(*event.Team)
 		resp.Team = &teamResp
 	}
 
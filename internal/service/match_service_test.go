@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -8,24 +10,47 @@ import (
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ical"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
-func newTestMatchService(t *testing.T) (*matchService, *mocks.MockMatchRepository, *mocks.MockTeamRepository, *mocks.MockPlayerRepository, *mocks.MockGoalRepository) {
+func newTestMatchService(t *testing.T) (*matchService, *mocks.MockMatchRepository, *mocks.MockTeamRepository, *mocks.MockPlayerRepository, *mocks.MockMatchEventRepository) {
 	matchRepo := mocks.NewMockMatchRepository(t)
 	teamRepo := mocks.NewMockTeamRepository(t)
 	playerRepo := mocks.NewMockPlayerRepository(t)
-	goalRepo := mocks.NewMockGoalRepository(t)
+	eventRepo := mocks.NewMockMatchEventRepository(t)
+	seasonRepo := mocks.NewMockSeasonRepository(t)
+	matchRepo.EXPECT().FindByTeamIDs(mock.Anything, model.MatchStatusCompleted).Return([]model.Match{}, nil).Maybe()
+	eventRepo.EXPECT().FindByMatchIDs(mock.Anything).Return([]model.MatchEvent{}, nil).Maybe()
+
+	statusChangeRepo := mocks.NewMockMatchStatusChangeRepository(t)
+	statusChangeRepo.EXPECT().Create(mock.AnythingOfType("*model.MatchStatusChange")).Return(nil).Maybe()
+
+	// The transaction manager hands the same repository mocks to the unit of work,
+	// so tests set expectations on matchRepo/eventRepo regardless of transaction boundaries.
+	txManager := mocks.NewMockTxManager(t)
+	txManager.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+		return fn(repository.TxRepositories{Matches: matchRepo, Events: eventRepo, StatusChanges: statusChangeRepo})
+	}).Maybe()
+
 	svc := &matchService{
 		matchRepo:  matchRepo,
 		teamRepo:   teamRepo,
 		playerRepo: playerRepo,
-		goalRepo:   goalRepo,
+		seasonRepo: seasonRepo,
+		discipline: NewDisciplinaryService(matchRepo, eventRepo, playerRepo, 5),
+		txManager:  txManager,
+		location:   time.UTC,
+		undoWindow: time.Hour,
 	}
-	return svc, matchRepo, teamRepo, playerRepo, goalRepo
+	return svc, matchRepo, teamRepo, playerRepo, eventRepo
 }
 
 func sampleMatch(homeTeamID, awayTeamID uuid.UUID) model.Match {
@@ -37,37 +62,64 @@ func sampleMatch(homeTeamID, awayTeamID uuid.UUID) model.Match {
 		},
 		HomeTeamID: homeTeamID,
 		AwayTeamID: awayTeamID,
-		MatchDate:  "2026-03-15",
-		MatchTime:  "19:30",
-		HomeScore:  0,
-		AwayScore:  0,
-		Status:     "scheduled",
+		// Kicked off in the past, so results can be submitted
+		MatchDatetime: time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC),
+		HomeScore:     0,
+		AwayScore:     0,
+		Status:        "scheduled",
 	}
 }
 
 func TestMatchService_GetAll(t *testing.T) {
 	homeID := uuid.Must(uuid.NewV7())
 	awayID := uuid.Must(uuid.NewV7())
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
-		name    string
-		setup   func(*mocks.MockMatchRepository)
-		wantErr bool
-		wantLen int
+		name        string
+		query       dto.MatchFilterQuery
+		setup       func(*mocks.MockMatchRepository)
+		wantErr     bool
+		errContains string
+		wantLen     int
 	}{
 		{
 			name: "success",
 			setup: func(mr *mocks.MockMatchRepository) {
 				matches := []model.Match{sampleMatch(homeID, awayID)}
-				mr.EXPECT().FindAll(0, 10, "created_at", "desc").Return(matches, nil)
-				mr.EXPECT().Count().Return(int64(1), nil)
+				mr.EXPECT().FindAll(repository.MatchFilter{}, 0, 10, "created_at", "desc").Return(matches, nil)
+				mr.EXPECT().Count(repository.MatchFilter{}).Return(int64(1), nil)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "status, team and date range",
+			query: dto.MatchFilterQuery{
+				Status:   model.MatchStatusScheduled,
+				TeamID:   homeID.String(),
+				DateFrom: "2026-03-01",
+				DateTo:   "2026-03-31",
+			},
+			setup: func(mr *mocks.MockMatchRepository) {
+				// date_to is inclusive, so the range ends at the start of the next day
+				filter := repository.MatchFilter{TeamID: &homeID, Status: model.MatchStatusScheduled, From: &from, To: &to}
+				mr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return([]model.Match{sampleMatch(homeID, awayID)}, nil)
+				mr.EXPECT().Count(filter).Return(int64(1), nil)
 			},
 			wantLen: 1,
 		},
+		{
+			name:        "date_from after date_to",
+			query:       dto.MatchFilterQuery{DateFrom: "2026-04-01", DateTo: "2026-03-01"},
+			setup:       func(mr *mocks.MockMatchRepository) {},
+			wantErr:     true,
+			errContains: "date_from must not be after date_to",
+		},
 		{
 			name: "db error",
 			setup: func(mr *mocks.MockMatchRepository) {
-				mr.EXPECT().FindAll(0, 10, "created_at", "desc").Return(nil, gorm.ErrInvalidDB)
+				mr.EXPECT().FindAll(repository.MatchFilter{}, 0, 10, "created_at", "desc").Return(nil, gorm.ErrInvalidDB)
 			},
 			wantErr: true,
 		},
@@ -79,10 +131,13 @@ func TestMatchService_GetAll(t *testing.T) {
 			tt.setup(matchRepo)
 
 			pagination := dto.PaginationQuery{Page: 1, PerPage: 10, SortBy: "created_at", SortOrder: "desc"}
-			matches, meta, err := svc.GetAll(pagination)
+			matches, meta, err := svc.GetAll(context.Background(), pagination, tt.query)
 
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.Len(t, matches, tt.wantLen)
@@ -102,45 +157,121 @@ func TestMatchService_Create(t *testing.T) {
 	awayTeam.ID = awayID
 	awayTeam.Name = "Persib Bandung"
 
+	// Kick-off next year, so always in the future; the test service uses UTC as match timezone
+	year := time.Now().Year() + 1
+	kickoff := fmt.Sprintf("%d-03-15T19:30:00+07:00", year)
+	dayStart := time.Date(year, 3, 15, 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
 	tests := []struct {
 		name        string
 		req         dto.CreateMatchRequest
 		setup       func(*mocks.MockMatchRepository, *mocks.MockTeamRepository)
 		wantErr     bool
+		errCode     int
 		errContains string
 	}{
 		{
 			name: "success",
 			req: dto.CreateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: awayID.String(),
-				MatchDate:  "2026-03-15",
-				MatchTime:  "19:30",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: kickoff,
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+				mr.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return(nil, nil)
+				mr.EXPECT().FindByTeamBetween(awayID, dayStart, dayEnd).Return(nil, nil)
+				mr.EXPECT().Create(mock.MatchedBy(func(m *model.Match) bool {
+					return m.MatchDatetime.Equal(time.Date(year, 3, 15, 12, 30, 0, 0, time.UTC))
+				})).Return(nil)
+				mr.EXPECT().FindByID(mock.AnythingOfType("uuid.UUID")).Return(&model.Match{
+					Base:          model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+					HomeTeamID:    homeID,
+					AwayTeamID:    awayID,
+					MatchDatetime: time.Date(year, 3, 15, 12, 30, 0, 0, time.UTC),
+					Status:        "scheduled",
+					HomeTeam:      &homeTeam,
+					AwayTeam:      &awayTeam,
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "kick-off without offset is read in the match timezone",
+			req: dto.CreateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: fmt.Sprintf("%d-03-15T19:30", year),
 			},
 			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
 				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
 				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
-				mr.EXPECT().Create(mock.AnythingOfType("*model.Match")).Return(nil)
+				mr.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return(nil, nil)
+				mr.EXPECT().FindByTeamBetween(awayID, dayStart, dayEnd).Return(nil, nil)
+				mr.EXPECT().Create(mock.MatchedBy(func(m *model.Match) bool {
+					return m.MatchDatetime.Equal(time.Date(year, 3, 15, 19, 30, 0, 0, time.UTC))
+				})).Return(nil)
 				mr.EXPECT().FindByID(mock.AnythingOfType("uuid.UUID")).Return(&model.Match{
-					Base:       model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: time.Now(), UpdatedAt: time.Now()},
-					HomeTeamID: homeID,
-					AwayTeamID: awayID,
-					MatchDate:  "2026-03-15",
-					MatchTime:  "19:30",
-					Status:     "scheduled",
-					HomeTeam:   &homeTeam,
-					AwayTeam:   &awayTeam,
+					Base:          model.Base{ID: uuid.Must(uuid.NewV7())},
+					MatchDatetime: time.Date(year, 3, 15, 19, 30, 0, 0, time.UTC),
+					Status:        "scheduled",
 				}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "kick-off in the past",
+			req: dto.CreateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: "2020-03-15T19:30:00Z",
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "must be in the future",
+		},
+		{
+			name: "kick-off too far ahead",
+			req: dto.CreateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: fmt.Sprintf("%d-03-15T19:30:00Z", year+5),
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "more than 2 years ahead",
+		},
+		{
+			name: "invalid kick-off format",
+			req: dto.CreateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: "15/03/2027 19:30",
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Invalid match_datetime",
+		},
 		{
 			name: "same team",
 			req: dto.CreateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: homeID.String(),
-				MatchDate:  "2026-03-15",
-				MatchTime:  "19:30",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    homeID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup:       func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {},
 			wantErr:     true,
@@ -149,10 +280,9 @@ func TestMatchService_Create(t *testing.T) {
 		{
 			name: "home team not found",
 			req: dto.CreateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: awayID.String(),
-				MatchDate:  "2026-03-15",
-				MatchTime:  "19:30",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
 				tr.EXPECT().FindByID(homeID).Return(nil, gorm.ErrRecordNotFound)
@@ -163,10 +293,9 @@ func TestMatchService_Create(t *testing.T) {
 		{
 			name: "away team not found",
 			req: dto.CreateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: awayID.String(),
-				MatchDate:  "2026-03-15",
-				MatchTime:  "19:30",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
 				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
@@ -175,13 +304,31 @@ func TestMatchService_Create(t *testing.T) {
 			wantErr:     true,
 			errContains: "Away team not found",
 		},
+		{
+			name: "away team already plays that day",
+			req: dto.CreateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: kickoff,
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+				mr.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return(nil, nil)
+				mr.EXPECT().FindByTeamBetween(awayID, dayStart, dayEnd).Return([]model.Match{
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, MatchDatetime: dayStart.Add(15 * time.Hour)},
+				}, nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: fmt.Sprintf("Away team already has a match on %d-03-15 at 15:00", year),
+		},
 		{
 			name: "invalid home team id",
 			req: dto.CreateMatchRequest{
-				HomeTeamID: "not-a-uuid",
-				AwayTeamID: awayID.String(),
-				MatchDate:  "2026-03-15",
-				MatchTime:  "19:30",
+				HomeTeamID:    "not-a-uuid",
+				AwayTeamID:    awayID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup:       func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {},
 			wantErr:     true,
@@ -194,13 +341,16 @@ func TestMatchService_Create(t *testing.T) {
 			svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
 			tt.setup(matchRepo, teamRepo)
 
-			result, err := svc.Create(tt.req)
+			result, err := svc.Create(context.Background(), tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
 				var appErr *errs.AppError
 				assert.ErrorAs(t, err, &appErr)
 				assert.Contains(t, appErr.Message, tt.errContains)
+				if tt.errCode != 0 {
+					assert.Equal(t, tt.errCode, appErr.Code)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -222,6 +372,7 @@ func TestMatchService_Delete(t *testing.T) {
 		setup       func(*mocks.MockMatchRepository)
 		wantErr     bool
 		errContains string
+		wantEvent   *dto.MatchDeletedEvent
 	}{
 		{
 			name: "success",
@@ -231,7 +382,20 @@ func TestMatchService_Delete(t *testing.T) {
 				mr.EXPECT().FindByID(matchID).Return(&m, nil)
 				mr.EXPECT().Delete(matchID).Return(nil)
 			},
-			wantErr: false,
+			wantErr:   false,
+			wantEvent: &dto.MatchDeletedEvent{MatchID: matchID.String(), Status: model.MatchStatusScheduled},
+		},
+		{
+			name: "completed match",
+			setup: func(mr *mocks.MockMatchRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				m.Status = model.MatchStatusCompleted
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				mr.EXPECT().Delete(matchID).Return(nil)
+			},
+			wantErr:   false,
+			wantEvent: &dto.MatchDeletedEvent{MatchID: matchID.String(), Status: model.MatchStatusCompleted},
 		},
 		{
 			name: "not found",
@@ -241,14 +405,28 @@ func TestMatchService_Delete(t *testing.T) {
 			wantErr:     true,
 			errContains: "Match not found",
 		},
+		{
+			name: "db error publishes nothing",
+			setup: func(mr *mocks.MockMatchRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				mr.EXPECT().Delete(matchID).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc, matchRepo, _, _, _ := newTestMatchService(t)
+			svc.feed = events.NewBus()
+			sub := svc.feed.Subscribe(4)
+			defer sub.Close()
 			tt.setup(matchRepo)
 
-			err := svc.Delete(matchID)
+			err := svc.Delete(context.Background(), matchID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -258,11 +436,64 @@ func TestMatchService_Delete(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+			received := drainFeed(sub)
+			if tt.wantEvent == nil {
+				assert.Empty(t, received)
+			} else if assert.Len(t, received, 1) {
+				assert.Equal(t, FeedMatchDeleted, received[0].Type)
+				assert.Equal(t, *tt.wantEvent, received[0].Data)
+			}
 			matchRepo.AssertExpectations(t)
 		})
 	}
 }
 
+// Deleting a completed match takes its result out of the ratings and the standings history,
+// like undoing the result does.
+func TestMatchService_Delete_DropsResult(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name       string
+		status     string
+		wantReplay bool
+	}{
+		{name: "completed match", status: model.MatchStatusCompleted, wantReplay: true},
+		{name: "scheduled match", status: model.MatchStatusScheduled, wantReplay: false},
+		{name: "cancelled match", status: model.MatchStatusCancelled, wantReplay: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, _, _ := newTestMatchService(t)
+			svc.feed = events.NewBus()
+			sub := svc.feed.Subscribe(4)
+			defer sub.Close()
+
+			m := sampleMatch(homeID, awayID)
+			m.Status = tt.status
+			matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+			matchRepo.EXPECT().Delete(m.ID).Return(nil)
+
+			ratings, ratingMatchRepo, ratingRepo, _ := newTestRatingService(t)
+			history, _, snapshotRepo, _ := newTestStandingsHistoryService(t)
+			if tt.wantReplay {
+				ratingMatchRepo.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return([]model.Match{}, nil).Twice()
+				ratingRepo.EXPECT().ReplaceAll([]model.TeamRating{}).Return(nil)
+				snapshotRepo.EXPECT().DeleteByMatchID(m.ID).Return(nil)
+			}
+
+			require.NoError(t, svc.Delete(context.Background(), m.ID))
+
+			received := drainFeed(sub)
+			require.Len(t, received, 1)
+			assert.NoError(t, ratings.HandleEvent(context.Background(), received[0]))
+			assert.NoError(t, history.HandleEvent(context.Background(), received[0]))
+		})
+	}
+}
+
 func TestMatchService_SubmitResult(t *testing.T) {
 	homeID := uuid.Must(uuid.NewV7())
 	awayID := uuid.Must(uuid.NewV7())
@@ -279,38 +510,39 @@ func TestMatchService_SubmitResult(t *testing.T) {
 	tests := []struct {
 		name        string
 		req         dto.MatchResultRequest
-		setup       func(*mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockGoalRepository)
+		setup       func(*mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockMatchEventRepository)
 		wantErr     bool
 		errContains string
+		errorCode   string
 	}{
 		{
 			name: "success 2-1",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: 23},
-					{PlayerID: playerAwayID.String(), TeamID: awayID.String(), Minute: 45},
-					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: 78},
+					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: "23"},
+					{PlayerID: playerAwayID.String(), TeamID: awayID.String(), Minute: "45"},
+					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: "78"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "scheduled"
 				mr.EXPECT().FindByID(matchID).Return(&m, nil)
 
-				// Validate players
+				// Validate players (each player is looked up once even when scoring twice)
 				pr.EXPECT().FindByID(playerHomeID).Return(&model.Player{
 					Base:   model.Base{ID: playerHomeID},
 					TeamID: homeID,
 					Name:   "Bambang",
-				}, nil).Times(2)
+				}, nil).Once()
 				pr.EXPECT().FindByID(playerAwayID).Return(&model.Player{
 					Base:   model.Base{ID: playerAwayID},
 					TeamID: awayID,
 					Name:   "Atep",
 				}, nil)
 
-				gr.EXPECT().CreateBatch(mock.AnythingOfType("[]model.Goal")).Return(nil)
+				gr.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(nil)
 				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
 
 				// Reload with details
@@ -328,10 +560,10 @@ func TestMatchService_SubmitResult(t *testing.T) {
 			name: "match already completed",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: 10},
+					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: "10"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "completed"
@@ -339,15 +571,16 @@ func TestMatchService_SubmitResult(t *testing.T) {
 			},
 			wantErr:     true,
 			errContains: "Match result already submitted",
+			errorCode:   CodeMatchAlreadyCompleted,
 		},
 		{
 			name: "player does not belong to team",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: 23},
+					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: "23"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "scheduled"
@@ -362,15 +595,16 @@ func TestMatchService_SubmitResult(t *testing.T) {
 			},
 			wantErr:     true,
 			errContains: "Goal #1: player does not belong to the specified team",
+			errorCode:   CodePlayerNotInTeam,
 		},
 		{
 			name: "goal team not in match",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerHomeID.String(), TeamID: uuid.Must(uuid.NewV7()).String(), Minute: 23},
+					{PlayerID: playerHomeID.String(), TeamID: uuid.Must(uuid.NewV7()).String(), Minute: "23"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "scheduled"
@@ -378,34 +612,37 @@ func TestMatchService_SubmitResult(t *testing.T) {
 			},
 			wantErr:     true,
 			errContains: "Goal #1: team_id must be either home or away team",
+			errorCode:   CodeInvalidMatchEvent,
 		},
 		{
 			name: "match not found",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: 10},
+					{PlayerID: playerHomeID.String(), TeamID: homeID.String(), Minute: "10"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				mr.EXPECT().FindByID(matchID).Return(nil, gorm.ErrRecordNotFound)
 			},
 			wantErr:     true,
 			errContains: "Match not found",
+			errorCode:   CodeMatchNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc, matchRepo, _, playerRepo, goalRepo := newTestMatchService(t)
-			tt.setup(matchRepo, playerRepo, goalRepo)
+			svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+			tt.setup(matchRepo, playerRepo, eventRepo)
 
-			result, err := svc.SubmitResult(matchID, tt.req)
+			result, err := svc.SubmitResult(context.Background(), matchID, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
 				var appErr *errs.AppError
 				assert.ErrorAs(t, err, &appErr)
 				assert.Contains(t, appErr.Message, tt.errContains)
+				assert.Equal(t, tt.errorCode, appErr.ErrorCode)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
@@ -415,7 +652,330 @@ func TestMatchService_SubmitResult(t *testing.T) {
 			}
 			matchRepo.AssertExpectations(t)
 			playerRepo.AssertExpectations(t)
-			goalRepo.AssertExpectations(t)
+			eventRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMatchService_SubmitResult_Events(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	matchID := uuid.Must(uuid.NewV7())
+	homePlayer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeID}
+	homeBench := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeID}
+	awayPlayer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: awayID}
+
+	event := func(eventType string, player *model.Player, minute dto.EventMinute) dto.MatchEventInput {
+		return dto.MatchEventInput{Type: eventType, PlayerID: player.ID.String(), TeamID: player.TeamID.String(), Minute: minute}
+	}
+	substitution := func(off, on *model.Player, minute dto.EventMinute) dto.MatchEventInput {
+		e := event(model.EventSubstitution, off, minute)
+		e.RelatedPlayerID = on.ID.String()
+		return e
+	}
+	stubPlayers := func(pr *mocks.MockPlayerRepository) {
+		for _, p := range []*model.Player{homePlayer, homeBench, awayPlayer} {
+			pr.EXPECT().FindByID(p.ID).Return(p, nil).Maybe()
+		}
+	}
+
+	tests := []struct {
+		name          string
+		events        []dto.MatchEventInput
+		goals         []dto.GoalInput
+		shootout      *dto.ShootoutInput
+		wantErr       bool
+		errContains   string
+		wantHomeScore int
+		wantAwayScore int
+	}{
+		{
+			name: "own goal counts for opponent, cards and substitutions do not score",
+			events: []dto.MatchEventInput{
+				event(model.EventGoal, homePlayer, "10"),
+				event(model.EventYellowCard, awayPlayer, "20"),
+				event(model.EventOwnGoal, homePlayer, "30"),
+				event(model.EventPenalty, awayPlayer, "40"),
+				substitution(homePlayer, homeBench, "60"),
+				event(model.EventRedCard, awayPlayer, "80"),
+			},
+			wantHomeScore: 1,
+			wantAwayScore: 2,
+		},
+		{
+			name: "legacy goals with own goal and penalty types",
+			goals: []dto.GoalInput{
+				{Type: model.GoalOpenPlay, PlayerID: homePlayer.ID.String(), TeamID: homeID.String(), Minute: "10"},
+				{Type: model.GoalOwnGoal, PlayerID: homePlayer.ID.String(), TeamID: homeID.String(), Minute: "30"},
+				{Type: model.GoalPenalty, PlayerID: awayPlayer.ID.String(), TeamID: awayID.String(), Minute: "40"},
+				{PlayerID: awayPlayer.ID.String(), TeamID: awayID.String(), Minute: "50"},
+			},
+			wantHomeScore: 1,
+			wantAwayScore: 3,
+		},
+		{
+			name:          "draw settled by a shootout",
+			events:        []dto.MatchEventInput{event(model.EventGoal, homePlayer, "10"), event(model.EventGoal, awayPlayer, "80")},
+			shootout:      &dto.ShootoutInput{HomeScore: 4, AwayScore: 3},
+			wantHomeScore: 1,
+			wantAwayScore: 1,
+		},
+		{
+			name:        "shootout after a win",
+			events:      []dto.MatchEventInput{event(model.EventGoal, homePlayer, "10")},
+			shootout:    &dto.ShootoutInput{HomeScore: 4, AwayScore: 3},
+			wantErr:     true,
+			errContains: "Penalty shootout is only possible after a draw, the result is 1-0",
+		},
+		{
+			name:        "shootout without a winner",
+			shootout:    &dto.ShootoutInput{HomeScore: 3, AwayScore: 3},
+			wantErr:     true,
+			errContains: "Penalty shootout must have a winner",
+		},
+		{
+			name:        "events and legacy goals together",
+			events:      []dto.MatchEventInput{event(model.EventGoal, homePlayer, "10")},
+			goals:       []dto.GoalInput{{PlayerID: homePlayer.ID.String(), TeamID: homeID.String(), Minute: "20"}},
+			wantErr:     true,
+			errContains: "either events or goals",
+		},
+		{
+			name:        "substitution without incoming player",
+			events:      []dto.MatchEventInput{event(model.EventSubstitution, homePlayer, "60")},
+			wantErr:     true,
+			errContains: "Event #1: related_player_id is required for substitutions",
+		},
+		{
+			name:        "substitute from the other team",
+			events:      []dto.MatchEventInput{substitution(homePlayer, awayPlayer, "60")},
+			wantErr:     true,
+			errContains: "Event #1: related player does not belong to the specified team",
+		},
+		{
+			name: "related player on a goal",
+			events: []dto.MatchEventInput{func() dto.MatchEventInput {
+				e := event(model.EventGoal, homePlayer, "10")
+				e.RelatedPlayerID = homeBench.ID.String()
+				return e
+			}()},
+			wantErr:     true,
+			errContains: "Event #1: related_player_id is only allowed for substitutions",
+		},
+		{
+			name: "third yellow card",
+			events: []dto.MatchEventInput{
+				event(model.EventYellowCard, awayPlayer, "10"),
+				event(model.EventYellowCard, awayPlayer, "20"),
+				event(model.EventYellowCard, awayPlayer, "30"),
+			},
+			wantErr:     true,
+			errContains: "Event #3: player cannot receive more than two yellow cards",
+		},
+		{
+			name: "second red card",
+			events: []dto.MatchEventInput{
+				event(model.EventRedCard, awayPlayer, "10"),
+				event(model.EventRedCard, awayPlayer, "20"),
+			},
+			wantErr:     true,
+			errContains: "Event #2: player has already been sent off",
+		},
+		{
+			name: "goals in added time and extra time",
+			events: []dto.MatchEventInput{
+				event(model.EventGoal, homePlayer, "45+2"),
+				event(model.EventGoal, awayPlayer, "90+4"),
+				event(model.EventGoal, awayPlayer, "112"),
+			},
+			wantHomeScore: 1,
+			wantAwayScore: 2,
+		},
+		{
+			name:        "added time in the middle of a half",
+			events:      []dto.MatchEventInput{event(model.EventGoal, homePlayer, "46+2")},
+			wantErr:     true,
+			errContains: "Event #1: minute must be a match minute such as 67, or 90+3 in added time",
+		},
+		{
+			name:        "minute after extra time",
+			events:      []dto.MatchEventInput{event(model.EventGoal, homePlayer, "121")},
+			wantErr:     true,
+			errContains: "Event #1: minute must be a match minute",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+
+			m := sampleMatch(homeID, awayID)
+			m.ID = matchID
+			m.Status = "scheduled"
+			matchRepo.EXPECT().FindByID(matchID).Return(&m, nil)
+			stubPlayers(playerRepo)
+
+			if !tt.wantErr {
+				eventRepo.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(nil)
+				matchRepo.EXPECT().Update(mock.MatchedBy(func(saved *model.Match) bool {
+					if tt.shootout != nil && (saved.HomeShootoutScore == nil || *saved.HomeShootoutScore != tt.shootout.HomeScore ||
+						saved.AwayShootoutScore == nil || *saved.AwayShootoutScore != tt.shootout.AwayScore) {
+						return false
+					}
+					return saved.HomeScore == tt.wantHomeScore && saved.AwayScore == tt.wantAwayScore && saved.Status == "completed"
+				})).Return(nil)
+				matchRepo.EXPECT().FindByIDWithDetails(matchID).Return(&m, nil)
+			}
+
+			_, err := svc.SubmitResult(context.Background(), matchID, dto.MatchResultRequest{Events: tt.events, Goals: tt.goals, Shootout: tt.shootout})
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, 400, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMatchService_SubmitResult_AddedTime(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	matchID := uuid.Must(uuid.NewV7())
+	player := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeID}
+
+	svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+	m := sampleMatch(homeID, awayID)
+	m.ID = matchID
+	m.Status = "scheduled"
+	matchRepo.EXPECT().FindByID(matchID).Return(&m, nil)
+	playerRepo.EXPECT().FindByID(player.ID).Return(player, nil)
+
+	var stored []model.MatchEvent
+	eventRepo.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).
+		Run(func(events []model.MatchEvent) { stored = events }).
+		Return(nil)
+	matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+	matchRepo.EXPECT().FindByIDWithDetails(matchID).RunAndReturn(func(uuid.UUID) (*model.Match, error) {
+		details := m
+		details.Events = stored
+		return &details, nil
+	})
+
+	result, err := svc.SubmitResult(context.Background(), matchID, dto.MatchResultRequest{
+		Goals: []dto.GoalInput{{PlayerID: player.ID.String(), TeamID: homeID.String(), Minute: "90+3"}},
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, stored, 1) {
+		assert.Equal(t, 90, stored[0].Minute)
+		assert.Equal(t, 3, stored[0].AddedTime)
+	}
+	if assert.Len(t, result.Events, 1) {
+		assert.Equal(t, 90, result.Events[0].Minute)
+		assert.Equal(t, 3, result.Events[0].AddedTime)
+		assert.Equal(t, "90+3'", result.Events[0].DisplayMinute)
+	}
+}
+
+func TestMatchService_SubmitResult_Attendance(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	attendance := 48500
+
+	tests := []struct {
+		name          string
+		req           dto.MatchResultRequest
+		wantTicketing *dto.TicketingResponse
+	}{
+		{name: "attendance only", req: dto.MatchResultRequest{Attendance: &attendance}},
+		{
+			name:          "with ticketing",
+			req:           dto.MatchResultRequest{Attendance: &attendance, Ticketing: &dto.TicketingInput{TicketsSold: 51200, Revenue: 1280000}},
+			wantTicketing: &dto.TicketingResponse{TicketsSold: 51200, Revenue: 1280000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, _, _ := newTestMatchService(t)
+			m := sampleMatch(homeID, awayID)
+			m.Status = model.MatchStatusLive
+			matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+
+			var saved model.Match
+			matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).
+				Run(func(match *model.Match) { saved = *match }).
+				Return(nil)
+			matchRepo.EXPECT().FindByIDWithDetails(m.ID).RunAndReturn(func(uuid.UUID) (*model.Match, error) {
+				return &saved, nil
+			})
+
+			resp, err := svc.SubmitResult(context.Background(), m.ID, tt.req)
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.Attendance)
+			assert.Equal(t, attendance, *resp.Attendance)
+			assert.Equal(t, tt.wantTicketing, resp.Ticketing)
+		})
+	}
+}
+
+func TestMatchService_SubmitResult_ManOfTheMatch(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	star := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: awayID, Name: "Marko Simic"}
+	outsider := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: uuid.Must(uuid.NewV7())}
+	named := model.MatchLineup{TeamID: awayID, PlayerID: star.ID, Starter: true}
+	other := model.MatchLineup{TeamID: awayID, PlayerID: uuid.Must(uuid.NewV7()), Starter: true}
+	homeOnly := model.MatchLineup{TeamID: homeID, PlayerID: uuid.Must(uuid.NewV7()), Starter: true}
+
+	tests := []struct {
+		name     string
+		player   *model.Player
+		lineups  []model.MatchLineup
+		wantCode string
+	}{
+		{name: "in the lineup", player: star, lineups: []model.MatchLineup{named, other}},
+		{name: "lineup of the team not recorded", player: star, lineups: []model.MatchLineup{homeOnly}},
+		{name: "left out of the lineup", player: star, lineups: []model.MatchLineup{other}, wantCode: CodeInvalidManOfTheMatch},
+		{name: "player of another team", player: outsider, wantCode: CodeInvalidManOfTheMatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, playerRepo, _ := newTestMatchService(t)
+			lineupRepo := mocks.NewMockMatchLineupRepository(t)
+			svc.lineupRepo = lineupRepo
+			m := sampleMatch(homeID, awayID)
+			m.Status = model.MatchStatusLive
+			matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+			playerRepo.EXPECT().FindByID(tt.player.ID).Return(tt.player, nil)
+			lineupRepo.EXPECT().FindByMatchID(m.ID).Return(tt.lineups, nil).Maybe()
+
+			var saved model.Match
+			if tt.wantCode == "" {
+				matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).
+					Run(func(match *model.Match) { saved = *match }).
+					Return(nil)
+				matchRepo.EXPECT().FindByIDWithDetails(m.ID).RunAndReturn(func(uuid.UUID) (*model.Match, error) {
+					return &saved, nil
+				})
+			}
+
+			resp, err := svc.SubmitResult(context.Background(), m.ID, dto.MatchResultRequest{ManOfTheMatchID: tt.player.ID.String()})
+
+			if tt.wantCode != "" {
+				var appErr *errs.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.ErrorCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.player.ID.String(), resp.ManOfTheMatchID)
 		})
 	}
 }
@@ -432,7 +992,7 @@ func TestMatchService_UpdateResult(t *testing.T) {
 	tests := []struct {
 		name        string
 		req         dto.MatchResultRequest
-		setup       func(*mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockGoalRepository)
+		setup       func(*mocks.MockMatchRepository, *mocks.MockPlayerRepository, *mocks.MockMatchEventRepository)
 		wantErr     bool
 		errContains string
 	}{
@@ -440,10 +1000,10 @@ func TestMatchService_UpdateResult(t *testing.T) {
 			name: "success update",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: 55},
+					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: "55"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "completed"
@@ -456,7 +1016,7 @@ func TestMatchService_UpdateResult(t *testing.T) {
 					Name:   "Bambang",
 				}, nil)
 
-				gr.EXPECT().CreateBatch(mock.AnythingOfType("[]model.Goal")).Return(nil)
+				gr.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(nil)
 				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
 
 				updatedMatch := m
@@ -467,14 +1027,54 @@ func TestMatchService_UpdateResult(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "goal insert failure aborts without updating match",
+			req: dto.MatchResultRequest{
+				Goals: []dto.GoalInput{
+					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: "55"},
+				},
+			},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				m.Status = "completed"
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				pr.EXPECT().FindByID(playerID).Return(&model.Player{
+					Base:   model.Base{ID: playerID},
+					TeamID: homeID,
+				}, nil)
+
+				gr.EXPECT().DeleteByMatchID(matchID).Return(nil)
+				gr.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+		{
+			name: "invalid goal does not touch stored result",
+			req: dto.MatchResultRequest{
+				Goals: []dto.GoalInput{
+					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: "55"},
+				},
+			},
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				m.Status = "completed"
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				pr.EXPECT().FindByID(playerID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "player not found",
+		},
 		{
 			name: "match not completed yet",
 			req: dto.MatchResultRequest{
 				Goals: []dto.GoalInput{
-					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: 10},
+					{PlayerID: playerID.String(), TeamID: homeID.String(), Minute: "10"},
 				},
 			},
-			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockGoalRepository) {
+			setup: func(mr *mocks.MockMatchRepository, pr *mocks.MockPlayerRepository, gr *mocks.MockMatchEventRepository) {
 				m := sampleMatch(homeID, awayID)
 				m.ID = matchID
 				m.Status = "scheduled" // not completed
@@ -487,10 +1087,10 @@ func TestMatchService_UpdateResult(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc, matchRepo, _, playerRepo, goalRepo := newTestMatchService(t)
-			tt.setup(matchRepo, playerRepo, goalRepo)
+			svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+			tt.setup(matchRepo, playerRepo, eventRepo)
 
-			result, err := svc.UpdateResult(matchID, tt.req)
+			result, err := svc.UpdateResult(context.Background(), matchID, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -503,7 +1103,7 @@ func TestMatchService_UpdateResult(t *testing.T) {
 			}
 			matchRepo.AssertExpectations(t)
 			playerRepo.AssertExpectations(t)
-			goalRepo.AssertExpectations(t)
+			eventRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -519,6 +1119,11 @@ func TestMatchService_Update(t *testing.T) {
 	awayTeam := sampleTeam()
 	awayTeam.ID = newAwayID
 
+	year := time.Now().Year() + 1
+	kickoff := fmt.Sprintf("%d-04-01T20:00:00Z", year)
+	dayStart := time.Date(year, 4, 1, 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
 	tests := []struct {
 		name        string
 		req         dto.UpdateMatchRequest
@@ -529,10 +1134,9 @@ func TestMatchService_Update(t *testing.T) {
 		{
 			name: "success",
 			req: dto.UpdateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: newAwayID.String(),
-				MatchDate:  "2026-04-01",
-				MatchTime:  "20:00",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    newAwayID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
 				m := sampleMatch(homeID, awayID)
@@ -540,17 +1144,56 @@ func TestMatchService_Update(t *testing.T) {
 				mr.EXPECT().FindByID(matchID).Return(&m, nil)
 				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
 				tr.EXPECT().FindByID(newAwayID).Return(&awayTeam, nil)
+				// The match itself is returned for the home team and must not count as a conflict
+				mr.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return([]model.Match{m}, nil)
+				mr.EXPECT().FindByTeamBetween(newAwayID, dayStart, dayEnd).Return(nil, nil)
 				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "cannot update completed match",
+			name: "home team already plays that day",
 			req: dto.UpdateMatchRequest{
-				HomeTeamID: homeID.String(),
-				AwayTeamID: newAwayID.String(),
-				MatchDate:  "2026-04-01",
-				MatchTime:  "20:00",
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    newAwayID.String(),
+				MatchDatetime: kickoff,
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(newAwayID).Return(&awayTeam, nil)
+				mr.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return([]model.Match{
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, MatchDatetime: dayStart.Add(13 * time.Hour)},
+				}, nil)
+			},
+			wantErr:     true,
+			errContains: "Home team already has a match",
+		},
+		{
+			name: "cannot move match into the past",
+			req: dto.UpdateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    newAwayID.String(),
+				MatchDatetime: "2026-03-01T20:00:00Z",
+			},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(newAwayID).Return(&awayTeam, nil)
+			},
+			wantErr:     true,
+			errContains: "must be in the future",
+		},
+		{
+			name: "cannot update completed match",
+			req: dto.UpdateMatchRequest{
+				HomeTeamID:    homeID.String(),
+				AwayTeamID:    newAwayID.String(),
+				MatchDatetime: kickoff,
 			},
 			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
 				m := sampleMatch(homeID, awayID)
@@ -568,7 +1211,116 @@ func TestMatchService_Update(t *testing.T) {
 			svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
 			tt.setup(matchRepo, teamRepo)
 
-			result, err := svc.Update(matchID, tt.req)
+			result, err := svc.Update(context.Background(), matchID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+			matchRepo.AssertExpectations(t)
+			teamRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMatchService_Patch(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	newAwayID := uuid.Must(uuid.NewV7())
+	matchID := uuid.Must(uuid.NewV7())
+
+	homeTeam := sampleTeam()
+	homeTeam.ID = homeID
+	awayTeam := sampleTeam()
+	awayTeam.ID = awayID
+	newAwayTeam := sampleTeam()
+	newAwayTeam.ID = newAwayID
+
+	year := time.Now().Year() + 1
+	kickoff := fmt.Sprintf("%d-04-01T20:00:00Z", year)
+	newDayStart := time.Date(year, 4, 1, 0, 0, 0, 0, time.UTC)
+	oldDayStart := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	newAway := newAwayID.String()
+	sameAsHome := homeID.String()
+
+	tests := []struct {
+		name        string
+		req         dto.PatchMatchRequest
+		setup       func(*mocks.MockMatchRepository, *mocks.MockTeamRepository)
+		check       func(*testing.T, *dto.MatchResponse)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "only kick-off keeps teams",
+			req:  dto.PatchMatchRequest{MatchDatetime: &kickoff},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+				mr.EXPECT().FindByTeamBetween(homeID, newDayStart, newDayStart.AddDate(0, 0, 1)).Return(nil, nil)
+				mr.EXPECT().FindByTeamBetween(awayID, newDayStart, newDayStart.AddDate(0, 0, 1)).Return(nil, nil)
+				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+			},
+			check: func(t *testing.T, result *dto.MatchResponse) {
+				assert.Equal(t, homeID.String(), result.HomeTeamID)
+				assert.Equal(t, awayID.String(), result.AwayTeamID)
+				assert.Equal(t, fmt.Sprintf("%d-04-01T20:00:00.000Z", year), result.MatchDatetime)
+			},
+		},
+		{
+			name: "only away team keeps past kick-off",
+			req:  dto.PatchMatchRequest{AwayTeamID: &newAway},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+				tr.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+				tr.EXPECT().FindByID(newAwayID).Return(&newAwayTeam, nil)
+				mr.EXPECT().FindByTeamBetween(homeID, oldDayStart, oldDayStart.AddDate(0, 0, 1)).Return([]model.Match{m}, nil)
+				mr.EXPECT().FindByTeamBetween(newAwayID, oldDayStart, oldDayStart.AddDate(0, 0, 1)).Return(nil, nil)
+				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+			},
+			check: func(t *testing.T, result *dto.MatchResponse) {
+				assert.Equal(t, newAwayID.String(), result.AwayTeamID)
+				assert.Equal(t, "2026-03-15T19:30:00.000Z", result.MatchDatetime)
+			},
+		},
+		{
+			name: "same team on both sides",
+			req:  dto.PatchMatchRequest{AwayTeamID: &sameAsHome},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				m := sampleMatch(homeID, awayID)
+				m.ID = matchID
+				mr.EXPECT().FindByID(matchID).Return(&m, nil)
+			},
+			wantErr:     true,
+			errContains: "cannot be the same",
+		},
+		{
+			name: "not found",
+			req:  dto.PatchMatchRequest{MatchDatetime: &kickoff},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindByID(matchID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Match not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+			tt.setup(matchRepo, teamRepo)
+
+			result, err := svc.Patch(context.Background(), matchID, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -578,9 +1330,763 @@ func TestMatchService_Update(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
+				tt.check(t, result)
 			}
 			matchRepo.AssertExpectations(t)
 			teamRepo.AssertExpectations(t)
 		})
 	}
 }
+
+func TestMatchService_Venue(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	matchID := uuid.Must(uuid.NewV7())
+	homeStadium := &model.Stadium{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Jakarta International Stadium", City: "Jakarta"}
+	neutral := &model.Stadium{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Stadion Manahan", City: "Surakarta"}
+
+	homeTeam := sampleTeam()
+	homeTeam.ID = homeID
+	homeTeam.StadiumID = &homeStadium.ID
+	awayTeam := sampleTeam()
+	awayTeam.ID = awayID
+
+	year := time.Now().Year() + 1
+	kickoff := fmt.Sprintf("%d-03-15T19:30:00Z", year)
+	dayStart := time.Date(year, 3, 15, 0, 0, 0, 0, time.UTC)
+	oldDayStart := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	noConflicts := func(mr *mocks.MockMatchRepository, day time.Time) {
+		mr.EXPECT().FindByTeamBetween(mock.AnythingOfType("uuid.UUID"), day, day.AddDate(0, 0, 1)).Return(nil, nil)
+	}
+
+	t.Run("create defaults to the home team's stadium", func(t *testing.T) {
+		svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+
+		teamRepo.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+		teamRepo.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+		stadiumRepo.EXPECT().FindByID(homeStadium.ID).Return(homeStadium, nil)
+		noConflicts(matchRepo, dayStart)
+		matchRepo.EXPECT().Create(mock.MatchedBy(func(m *model.Match) bool {
+			return m.VenueID != nil && *m.VenueID == homeStadium.ID
+		})).Return(nil)
+		matchRepo.EXPECT().FindByID(mock.AnythingOfType("uuid.UUID")).Return(&model.Match{
+			Base:    model.Base{ID: matchID},
+			VenueID: &homeStadium.ID,
+			Venue:   homeStadium,
+		}, nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateMatchRequest{
+			HomeTeamID: homeID.String(), AwayTeamID: awayID.String(), MatchDatetime: kickoff,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, homeStadium.ID.String(), result.VenueID)
+		assert.Equal(t, "Jakarta International Stadium", result.Venue.Name)
+	})
+
+	t.Run("create at an unknown venue", func(t *testing.T) {
+		svc, _, teamRepo, _, _ := newTestMatchService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+
+		teamRepo.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+		teamRepo.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+		stadiumRepo.EXPECT().FindByID(neutral.ID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.Create(context.Background(), dto.CreateMatchRequest{
+			HomeTeamID: homeID.String(), AwayTeamID: awayID.String(), MatchDatetime: kickoff, VenueID: neutral.ID.String(),
+		})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+		assert.Contains(t, appErr.Message, "Venue not found")
+	})
+
+	t.Run("patch keeps a neutral venue", func(t *testing.T) {
+		svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+
+		m := sampleMatch(homeID, awayID)
+		m.ID = matchID
+		m.VenueID = &neutral.ID
+		matchRepo.EXPECT().FindByID(matchID).Return(&m, nil)
+		teamRepo.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+		teamRepo.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+		stadiumRepo.EXPECT().FindByID(neutral.ID).Return(neutral, nil)
+		noConflicts(matchRepo, dayStart)
+		matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+
+		result, err := svc.Patch(context.Background(), matchID, dto.PatchMatchRequest{MatchDatetime: &kickoff})
+
+		assert.NoError(t, err)
+		assert.Equal(t, neutral.ID.String(), result.VenueID)
+		assert.Equal(t, "Stadion Manahan", result.Venue.Name)
+	})
+
+	t.Run("patch swapping home team moves to the new home stadium", func(t *testing.T) {
+		svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+
+		m := sampleMatch(awayID, homeID)
+		m.ID = matchID
+		m.VenueID = &neutral.ID
+		swappedHome, swappedAway := homeID.String(), awayID.String()
+		matchRepo.EXPECT().FindByID(matchID).Return(&m, nil)
+		teamRepo.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+		teamRepo.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+		stadiumRepo.EXPECT().FindByID(homeStadium.ID).Return(homeStadium, nil)
+		noConflicts(matchRepo, oldDayStart)
+		matchRepo.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+
+		result, err := svc.Patch(context.Background(), matchID, dto.PatchMatchRequest{HomeTeamID: &swappedHome, AwayTeamID: &swappedAway})
+
+		assert.NoError(t, err)
+		assert.Equal(t, homeStadium.ID.String(), result.VenueID)
+	})
+}
+
+func TestMatchService_Round(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	otherID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	round := 12
+
+	homeTeam := sampleTeam()
+	homeTeam.ID = homeID
+	awayTeam := sampleTeam()
+	awayTeam.ID = awayID
+
+	year := time.Now().Year() + 1
+	kickoff := fmt.Sprintf("%d-03-15T19:30:00Z", year)
+	dayStart := time.Date(year, 3, 15, 0, 0, 0, 0, time.UTC)
+	roundFilter := repository.MatchFilter{SeasonID: &seasonID, Round: &round}
+
+	// setup prepares a create in the season that passes every check before the round
+	setup := func(t *testing.T) (*matchService, *mocks.MockMatchRepository) {
+		svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+		teamRepo.EXPECT().FindByID(homeID).Return(&homeTeam, nil)
+		teamRepo.EXPECT().FindByID(awayID).Return(&awayTeam, nil)
+		svc.seasonRepo.(*mocks.MockSeasonRepository).EXPECT().FindByID(seasonID).Return(&model.Season{}, nil).Maybe()
+		matchRepo.EXPECT().FindByTeamBetween(mock.AnythingOfType("uuid.UUID"), dayStart, dayStart.AddDate(0, 0, 1)).Return(nil, nil)
+		return svc, matchRepo
+	}
+
+	t.Run("create in a round", func(t *testing.T) {
+		svc, matchRepo := setup(t)
+		// A cancelled match of the home team no longer takes its slot in the round
+		cancelled := sampleMatch(homeID, otherID)
+		cancelled.Status = model.MatchStatusCancelled
+		matchRepo.EXPECT().FindSchedule(roundFilter).Return([]model.Match{cancelled}, nil)
+		matchRepo.EXPECT().Create(mock.MatchedBy(func(m *model.Match) bool {
+			return m.Round != nil && *m.Round == round
+		})).Return(nil)
+		matchRepo.EXPECT().FindByID(mock.AnythingOfType("uuid.UUID")).Return(&model.Match{SeasonID: &seasonID, Round: &round}, nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateMatchRequest{
+			HomeTeamID: homeID.String(), AwayTeamID: awayID.String(), MatchDatetime: kickoff, SeasonID: seasonID.String(), Round: round,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.Round)
+		assert.Equal(t, round, *result.Round)
+	})
+
+	t.Run("team already plays in the round", func(t *testing.T) {
+		svc, matchRepo := setup(t)
+		matchRepo.EXPECT().FindSchedule(roundFilter).Return([]model.Match{sampleMatch(otherID, awayID)}, nil)
+
+		_, err := svc.Create(context.Background(), dto.CreateMatchRequest{
+			HomeTeamID: homeID.String(), AwayTeamID: awayID.String(), MatchDatetime: kickoff, SeasonID: seasonID.String(), Round: round,
+		})
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 409, appErr.Code)
+		assert.Equal(t, CodeRoundConflict, appErr.ErrorCode)
+		assert.Contains(t, appErr.Message, "Away team already plays in round 12")
+	})
+
+	t.Run("round without a season", func(t *testing.T) {
+		svc, _ := setup(t)
+
+		_, err := svc.Create(context.Background(), dto.CreateMatchRequest{
+			HomeTeamID: homeID.String(), AwayTeamID: awayID.String(), MatchDatetime: kickoff, Round: round,
+		})
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 400, appErr.Code)
+		assert.Equal(t, CodeRoundWithoutSeason, appErr.ErrorCode)
+	})
+
+	t.Run("patch leaving the season leaves the round", func(t *testing.T) {
+		m := sampleMatch(homeID, awayID)
+		m.SeasonID = &seasonID
+		m.Round = &round
+		noSeason := ""
+
+		req := mergeMatchPatch(m, dto.PatchMatchRequest{SeasonID: &noSeason})
+		assert.Zero(t, req.Round)
+
+		req = mergeMatchPatch(m, dto.PatchMatchRequest{})
+		assert.Equal(t, round, req.Round)
+	})
+}
+
+func TestMatchService_ScheduleConflictWindow(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name      string
+		otherTime string
+		wantErr   bool
+	}{
+		{name: "kick-offs far enough apart", otherTime: "12:00", wantErr: false},
+		{name: "kick-offs too close", otherTime: "17:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, _, _ := newTestMatchService(t)
+			svc.conflictWindow = 4 * time.Hour
+
+			dayStart := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+			dayEnd := dayStart.AddDate(0, 0, 1)
+			otherKickoff, _ := time.Parse("2006-01-02 15:04", "2026-03-15 "+tt.otherTime)
+			matchRepo.EXPECT().FindByTeamBetween(homeID, dayStart, dayEnd).Return([]model.Match{
+				{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, MatchDatetime: otherKickoff},
+			}, nil)
+			matchRepo.EXPECT().FindByTeamBetween(awayID, dayStart, dayEnd).Return(nil, nil).Maybe()
+
+			err := svc.ensureNoScheduleConflict(context.Background(), uuid.Nil, homeID, awayID, time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC))
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, 409, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMatchService_UpdateStatus(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	matchID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		current     string
+		target      string
+		setup       func(*mocks.MockMatchRepository, *model.Match)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name:    "scheduled to live",
+			current: model.MatchStatusScheduled,
+			target:  model.MatchStatusLive,
+			setup: func(mr *mocks.MockMatchRepository, m *model.Match) {
+				mr.EXPECT().Update(mock.MatchedBy(func(saved *model.Match) bool {
+					return saved.Status == model.MatchStatusLive
+				})).Return(nil)
+			},
+		},
+		{
+			name:    "postponed back to scheduled checks conflicts",
+			current: model.MatchStatusPostponed,
+			target:  model.MatchStatusScheduled,
+			setup: func(mr *mocks.MockMatchRepository, m *model.Match) {
+				m.MatchDatetime = time.Now().AddDate(0, 1, 0)
+				mr.EXPECT().FindByTeamBetween(homeID, mock.Anything, mock.Anything).Return([]model.Match{*m}, nil)
+				mr.EXPECT().FindByTeamBetween(awayID, mock.Anything, mock.Anything).Return(nil, nil)
+				mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).Return(nil)
+			},
+		},
+		{
+			name:    "postponed back to scheduled clashes",
+			current: model.MatchStatusPostponed,
+			target:  model.MatchStatusScheduled,
+			setup: func(mr *mocks.MockMatchRepository, m *model.Match) {
+				m.MatchDatetime = time.Now().AddDate(0, 1, 0)
+				mr.EXPECT().FindByTeamBetween(homeID, mock.Anything, mock.Anything).Return([]model.Match{
+					{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, MatchDatetime: m.MatchDatetime, Status: model.MatchStatusScheduled},
+				}, nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "Home team already has a match",
+		},
+		{
+			name:        "postponed match with past kick-off must be rescheduled first",
+			current:     model.MatchStatusPostponed,
+			target:      model.MatchStatusScheduled,
+			setup:       func(mr *mocks.MockMatchRepository, m *model.Match) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Reschedule the match to a future kick-off",
+		},
+		{
+			name:        "cancelled is final",
+			current:     model.MatchStatusCancelled,
+			target:      model.MatchStatusScheduled,
+			setup:       func(mr *mocks.MockMatchRepository, m *model.Match) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "from cancelled to scheduled",
+		},
+		{
+			name:        "live cannot be cancelled",
+			current:     model.MatchStatusLive,
+			target:      model.MatchStatusCancelled,
+			setup:       func(mr *mocks.MockMatchRepository, m *model.Match) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "from live to cancelled",
+		},
+		{
+			name:        "completed requires a result",
+			current:     model.MatchStatusLive,
+			target:      model.MatchStatusCompleted,
+			setup:       func(mr *mocks.MockMatchRepository, m *model.Match) {},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Submit the match result",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, _, _ := newTestMatchService(t)
+			m := sampleMatch(homeID, awayID)
+			m.ID = matchID
+			m.Status = tt.current
+			matchRepo.EXPECT().FindByID(matchID).Return(&m, nil)
+			tt.setup(matchRepo, &m)
+
+			result, err := svc.UpdateStatus(context.Background(), matchID, dto.MatchStatusRequest{Status: tt.target})
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.target, result.Status)
+			}
+		})
+	}
+}
+
+func TestMatchService_AdvanceStatuses(t *testing.T) {
+	homeID := uuid.Must(uuid.NewV7())
+	awayID := uuid.Must(uuid.NewV7())
+	grace := 3 * time.Hour
+	withStatus := func(status string, kickoff time.Time) model.Match {
+		m := sampleMatch(homeID, awayID)
+		m.ID = uuid.Must(uuid.NewV7())
+		m.Status = status
+		m.MatchDatetime = kickoff
+		return m
+	}
+	isStatus := func(id uuid.UUID, status string) any {
+		return mock.MatchedBy(func(saved *model.Match) bool { return saved.ID == id && saved.Status == status })
+	}
+	filter := func(status string) any {
+		return mock.MatchedBy(func(f repository.MatchFilter) bool { return f.Status == status && f.To != nil })
+	}
+
+	t.Run("flags overdue matches and starts kicked-off ones", func(t *testing.T) {
+		svc, matchRepo, _, _, _ := newTestMatchService(t)
+		missed := withStatus(model.MatchStatusScheduled, time.Now().Add(-5*time.Hour))
+		unfinished := withStatus(model.MatchStatusLive, time.Now().Add(-4*time.Hour))
+		started := withStatus(model.MatchStatusScheduled, time.Now().Add(-10*time.Minute))
+
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusScheduled)).Return([]model.Match{missed}, nil).Once()
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusLive)).Return([]model.Match{unfinished}, nil).Once()
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusScheduled)).Return([]model.Match{started}, nil).Once()
+		matchRepo.EXPECT().Update(isStatus(missed.ID, model.MatchStatusAwaitingResult)).Return(nil)
+		matchRepo.EXPECT().Update(isStatus(unfinished.ID, model.MatchStatusAwaitingResult)).Return(nil)
+		matchRepo.EXPECT().Update(isStatus(started.ID, model.MatchStatusLive)).Return(nil)
+
+		changed, err := svc.AdvanceStatuses(context.Background(), grace)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, changed)
+	})
+
+	t.Run("matches changed concurrently are skipped", func(t *testing.T) {
+		svc, matchRepo, _, _, _ := newTestMatchService(t)
+		started := withStatus(model.MatchStatusScheduled, time.Now().Add(-10*time.Minute))
+
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusScheduled)).Return(nil, nil).Once()
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusLive)).Return(nil, nil).Once()
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusScheduled)).Return([]model.Match{started}, nil).Once()
+		matchRepo.EXPECT().Update(isStatus(started.ID, model.MatchStatusLive)).Return(repository.ErrVersionConflict)
+
+		changed, err := svc.AdvanceStatuses(context.Background(), grace)
+		assert.NoError(t, err)
+		assert.Zero(t, changed)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc, matchRepo, _, _, _ := newTestMatchService(t)
+		matchRepo.EXPECT().FindSchedule(filter(model.MatchStatusScheduled)).Return(nil, gorm.ErrInvalidDB)
+
+		_, err := svc.AdvanceStatuses(context.Background(), grace)
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Internal server error", appErr.Message)
+	})
+}
+
+func TestMatchService_ArchiveSeasons(t *testing.T) {
+	cutoff := time.Now().UTC().AddDate(-3, 0, 0).Format("2006-01-02")
+
+	t.Run("archives seasons that ended before the cutoff", func(t *testing.T) {
+		svc, matchRepo, _, _, _ := newTestMatchService(t)
+		matchRepo.EXPECT().ArchiveSeasonsEndedBefore(cutoff).Return(int64(120), nil)
+
+		archived, err := svc.ArchiveSeasons(context.Background(), 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(120), archived)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc, matchRepo, _, _, _ := newTestMatchService(t)
+		matchRepo.EXPECT().ArchiveSeasonsEndedBefore(cutoff).Return(int64(0), gorm.ErrInvalidDB)
+
+		_, err := svc.ArchiveSeasons(context.Background(), 3)
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Internal server error", appErr.Message)
+	})
+}
+
+func TestMatchService_GetArchived(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	archivedAt := time.Date(2031, 7, 1, 3, 0, 0, 0, time.UTC)
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	m.ArchivedAt = &archivedAt
+	archivedOnly := mock.MatchedBy(func(f repository.MatchFilter) bool { return f.Archived && f.Status == model.MatchStatusCompleted })
+	matchRepo.EXPECT().FindAll(archivedOnly, 0, 10, "created_at", "desc").Return([]model.Match{m}, nil)
+	matchRepo.EXPECT().Count(archivedOnly).Return(int64(1), nil)
+
+	matches, meta, err := svc.GetArchived(context.Background(), dto.PaginationQuery{}, dto.MatchFilterQuery{Status: model.MatchStatusCompleted})
+
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "2031-07-01T03:00:00.000Z", matches[0].ArchivedAt)
+	assert.Equal(t, int64(1), meta.Total)
+}
+
+func TestMatchService_SubmitResult_TeamManager(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	managerRepo := mocks.NewMockTeamManagerRepository(t)
+	svc.managerRepo = managerRepo
+	managerID := uuid.Must(uuid.NewV7())
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+	managerRepo.EXPECT().FindTeamIDsByAdminID(managerID).Return([]uuid.UUID{uuid.Must(uuid.NewV7())}, nil)
+
+	ctx := WithActor(context.Background(), Actor{AdminID: managerID, Role: model.RoleTeamManager})
+	_, err := svc.SubmitResult(ctx, m.ID, dto.MatchResultRequest{})
+
+	var appErr *errs.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 403, appErr.Code)
+	assert.Equal(t, "You can only submit results for your own teams' matches", appErr.Message)
+}
+
+func TestMatchService_SubmitResult_CancelledMatch(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	m.Status = model.MatchStatusCancelled
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+
+	_, err := svc.SubmitResult(context.Background(), m.ID, dto.MatchResultRequest{})
+
+	var appErr *errs.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+	assert.Contains(t, appErr.Message, "Cannot submit result of a cancelled match")
+}
+
+func TestMatchService_SubmitResult_BeforeKickoff(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	m.MatchDatetime = time.Now().Add(time.Hour)
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+
+	_, err := svc.SubmitResult(context.Background(), m.ID, dto.MatchResultRequest{})
+
+	var appErr *errs.AppError
+	assert.ErrorAs(t, err, &appErr)
+	assert.Equal(t, 400, appErr.Code)
+	assert.Contains(t, appErr.Message, "has not kicked off yet")
+}
+
+func TestMatchService_PreviewResult(t *testing.T) {
+	svc, matchRepo, _, playerRepo, _ := newTestMatchService(t)
+	homeTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Garuda FC"}
+	awayTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Rajawali FC"}
+	scorer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeTeam.ID, Name: "Bambang"}
+	m := sampleMatch(homeTeam.ID, awayTeam.ID)
+	m.HomeTeam, m.AwayTeam = homeTeam, awayTeam
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+	playerRepo.EXPECT().FindByID(scorer.ID).Return(scorer, nil)
+	// No CreateBatch or Update: the mocks fail the test if anything is saved
+
+	preview, err := svc.PreviewResult(context.Background(), m.ID, dto.MatchResultRequest{
+		Events: []dto.MatchEventInput{
+			{Type: model.EventGoal, PlayerID: scorer.ID.String(), TeamID: homeTeam.ID.String(), Minute: "12"},
+			{Type: model.EventOwnGoal, PlayerID: scorer.ID.String(), TeamID: homeTeam.ID.String(), Minute: "45+2"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, preview.HomeScore)
+	assert.Equal(t, 1, preview.AwayScore)
+	assert.Equal(t, "Garuda FC", preview.HomeTeam.Name)
+	require.Len(t, preview.Events, 2)
+	assert.Equal(t, "Bambang", preview.Events[0].PlayerName)
+	assert.Equal(t, "Garuda FC", preview.Events[0].TeamName)
+	assert.Equal(t, "45+2'", preview.Events[1].DisplayMinute)
+	assert.Equal(t, "OG", preview.Events[1].Marker)
+}
+
+func TestMatchService_PreviewResult_AlreadyCompleted(t *testing.T) {
+	svc, matchRepo, _, _, _ := newTestMatchService(t)
+	m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+	m.Status = model.MatchStatusCompleted
+	matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+
+	_, err := svc.PreviewResult(context.Background(), m.ID, dto.MatchResultRequest{})
+
+	var appErr *errs.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, CodeMatchAlreadyCompleted, appErr.ErrorCode)
+}
+
+func TestMatchService_UndoResult(t *testing.T) {
+	completedAgo := func(d time.Duration) []model.MatchStatusChange {
+		return []model.MatchStatusChange{
+			{FromStatus: model.MatchStatusScheduled, ToStatus: model.MatchStatusLive, Base: model.Base{CreatedAt: time.Now().Add(-d - time.Hour)}},
+			{FromStatus: model.MatchStatusLive, ToStatus: model.MatchStatusCompleted, Base: model.Base{CreatedAt: time.Now().Add(-d)}},
+		}
+	}
+	shootout, attendance := 4, 30000
+
+	tests := []struct {
+		name       string
+		status     string
+		changes    []model.MatchStatusChange
+		undoWindow time.Duration
+		wantCode   string
+	}{
+		{name: "within the window", status: model.MatchStatusCompleted, changes: completedAgo(10 * time.Minute), undoWindow: time.Hour},
+		{name: "window passed", status: model.MatchStatusCompleted, changes: completedAgo(2 * time.Hour), undoWindow: time.Hour, wantCode: CodeResultUndoExpired},
+		{name: "completion not recorded", status: model.MatchStatusCompleted, undoWindow: time.Hour, wantCode: CodeResultUndoExpired},
+		{name: "undo disabled", status: model.MatchStatusCompleted, undoWindow: 0, wantCode: CodeResultUndoExpired},
+		{name: "match not completed", status: model.MatchStatusLive, undoWindow: time.Hour, wantCode: CodeMatchNotCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _, _, eventRepo := newTestMatchService(t)
+			statusChangeRepo := mocks.NewMockMatchStatusChangeRepository(t)
+			svc.statusChangeRepo = statusChangeRepo
+			svc.undoWindow = tt.undoWindow
+
+			m := sampleMatch(uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()))
+			m.Status = tt.status
+			m.HomeScore, m.AwayScore = 2, 2
+			m.HomeShootoutScore, m.AwayShootoutScore = &shootout, &shootout
+			m.Attendance = &attendance
+			matchRepo.EXPECT().FindByID(m.ID).Return(&m, nil)
+			statusChangeRepo.EXPECT().FindByMatchID(m.ID).Return(tt.changes, nil).Maybe()
+			if tt.wantCode == "" {
+				eventRepo.EXPECT().DeleteByMatchID(m.ID).Return(nil)
+				matchRepo.EXPECT().Update(mock.MatchedBy(func(updated *model.Match) bool {
+					return updated.Status == model.MatchStatusScheduled && updated.HomeScore == 0 && updated.AwayScore == 0 &&
+						updated.HomeShootoutScore == nil && updated.AwayShootoutScore == nil && updated.Attendance == nil
+				})).Return(nil)
+			}
+
+			resp, err := svc.UndoResult(context.Background(), m.ID)
+
+			if tt.wantCode != "" {
+				var appErr *errs.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.ErrorCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, model.MatchStatusScheduled, resp.Status)
+			assert.Equal(t, 0, resp.HomeScore)
+		})
+	}
+}
+
+func TestMatchService_GetCalendar(t *testing.T) {
+	homeTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Garuda FC", Address: "Jl. Merdeka 1", City: "Jakarta"}
+	awayTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Rajawali FC"}
+
+	withTeams := func(status string) model.Match {
+		m := sampleMatch(homeTeam.ID, awayTeam.ID)
+		m.HomeTeam, m.AwayTeam = homeTeam, awayTeam
+		m.Status = status
+		return m
+	}
+	completed := withTeams(model.MatchStatusCompleted)
+	completed.HomeScore, completed.AwayScore = 2, 1
+
+	tests := []struct {
+		name        string
+		query       dto.MatchCalendarQuery
+		setup       func(*mocks.MockMatchRepository, *mocks.MockTeamRepository)
+		wantErr     bool
+		errCode     int
+		wantName    string
+		wantSummary []string
+		wantStatus  []string
+	}{
+		{
+			name: "all matches",
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindSchedule(repository.MatchFilter{}).Return([]model.Match{
+					withTeams(model.MatchStatusScheduled),
+					completed,
+					withTeams(model.MatchStatusPostponed),
+					withTeams(model.MatchStatusCancelled),
+				}, nil)
+			},
+			wantName:    "XYZ Football fixtures",
+			wantSummary: []string{"Garuda FC vs Rajawali FC", "Garuda FC 2-1 Rajawali FC", "[Postponed] Garuda FC vs Rajawali FC", "Garuda FC vs Rajawali FC"},
+			wantStatus:  []string{ical.StatusConfirmed, ical.StatusConfirmed, ical.StatusTentative, ical.StatusCancelled},
+		},
+		{
+			name:  "filtered by team",
+			query: dto.MatchCalendarQuery{TeamID: awayTeam.ID.String()},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(awayTeam.ID).Return(awayTeam, nil)
+				mr.EXPECT().FindSchedule(repository.MatchFilter{TeamID: &awayTeam.ID}).Return([]model.Match{withTeams(model.MatchStatusScheduled)}, nil)
+			},
+			wantName:    "Rajawali FC fixtures",
+			wantSummary: []string{"Garuda FC vs Rajawali FC"},
+			wantStatus:  []string{ical.StatusConfirmed},
+		},
+		{
+			name:  "team not found",
+			query: dto.MatchCalendarQuery{TeamID: awayTeam.ID.String()},
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(awayTeam.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+			errCode: 404,
+		},
+		{
+			name: "db error",
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindSchedule(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+			errCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, teamRepo, _, _ := newTestMatchService(t)
+			tt.setup(matchRepo, teamRepo)
+
+			cal, err := svc.GetCalendar(context.Background(), tt.query)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, cal.Name)
+			assert.Len(t, cal.Events, len(tt.wantSummary))
+			for i, event := range cal.Events {
+				assert.Equal(t, tt.wantSummary[i], event.Summary)
+				assert.Equal(t, tt.wantStatus[i], event.Status)
+				assert.Equal(t, time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC), event.Start)
+				assert.Equal(t, 2*time.Hour, event.End.Sub(event.Start))
+				assert.Equal(t, "Jl. Merdeka 1, Jakarta", event.Location)
+			}
+		})
+	}
+}
+
+func TestSaveStatus_RecordsChanges(t *testing.T) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	statusChangeRepo := mocks.NewMockMatchStatusChangeRepository(t)
+	repos := repository.TxRepositories{Matches: matchRepo, StatusChanges: statusChangeRepo}
+	match := &model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Status: model.MatchStatusLive}
+
+	matchRepo.EXPECT().Update(match).Return(nil).Twice()
+	statusChangeRepo.EXPECT().Create(&model.MatchStatusChange{
+		MatchID:    match.ID,
+		FromStatus: model.MatchStatusScheduled,
+		ToStatus:   model.MatchStatusLive,
+	}).Return(nil).Once()
+
+	assert.NoError(t, saveStatus(repos, match, model.MatchStatusScheduled))
+	// Saving without a status change, e.g. a corrected result, records nothing
+	assert.NoError(t, saveStatus(repos, match, model.MatchStatusLive))
+}
+
+func TestMatchTimestamps(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+	kickoff := time.Date(2025, 6, 15, 12, 30, 0, 250*int(time.Millisecond), time.UTC)
+	match := model.Match{MatchDatetime: kickoff, Status: model.MatchStatusScheduled}
+	match.CreatedAt = time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	t.Run("UTC by default", func(t *testing.T) {
+		resp := toMatchResponse(match, jakarta)
+		assert.Equal(t, "2025-06-15T12:30:00.250Z", resp.MatchDatetime)
+		assert.Equal(t, "2025-06-15T19:30:00.250+07:00", resp.LocalDatetime)
+		assert.Equal(t, "2025-01-15T10:30:00.000Z", resp.CreatedAt)
+	})
+
+	t.Run("configured timezone", func(t *testing.T) {
+		timefmt.SetLocation(jakarta)
+		t.Cleanup(func() { timefmt.SetLocation(time.UTC) })
+
+		resp := toMatchResponse(match, time.UTC)
+		assert.Equal(t, "2025-06-15T19:30:00.250+07:00", resp.MatchDatetime)
+		assert.Equal(t, "2025-06-15T12:30:00.250Z", resp.LocalDatetime)
+		assert.Equal(t, "2025-01-15T17:30:00.000+07:00", resp.CreatedAt)
+	})
+
+	t.Run("parsing accepts Z, offsets and fractions", func(t *testing.T) {
+		for _, value := range []string{
+			"2025-06-15T12:30:00.250Z",
+			"2025-06-15T19:30:00.250+07:00",
+			"2025-06-15T12:30:00.25+00:00",
+		} {
+			parsed, err := parseKickoff(value, jakarta)
+			require.NoError(t, err, value)
+			assert.True(t, parsed.Equal(kickoff), value)
+		}
+
+		parsed, err := parseKickoff("2025-06-15T19:30", jakarta)
+		require.NoError(t, err)
+		assert.True(t, parsed.Equal(kickoff.Truncate(time.Minute)))
+	})
+}
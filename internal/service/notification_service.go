@@ -0,0 +1,329 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/mailer"
+	"gorm.io/gorm"
+)
+
+// Email templates. Each is executed with the matching *Mail struct below.
+var (
+	resultMailTemplate = template.Must(template.New("result").Parse(
+		`{{.Home}} {{.HomeScore}} - {{.AwayScore}} {{.Away}}
+
+Kick-off: {{.Kickoff}}
+{{- if .Venue}}
+Venue:    {{.Venue}}
+{{- end}}
+{{- if .Goals}}
+
+Goals:
+{{- range .Goals}}
+  {{printf "%5s" .Minute}} {{.Player}} ({{.Team}}){{if .Note}} {{.Note}}{{end}}
+{{- end}}
+{{- end}}
+`))
+
+	postponedMailTemplate = template.Must(template.New("postponed").Parse(
+		`{{.Home}} vs {{.Away}}, scheduled for {{.Kickoff}}, has been postponed.
+{{- if .Venue}}
+Venue: {{.Venue}}
+{{- end}}
+
+The new kick-off time will be announced once the match is rescheduled.
+`))
+
+	digestMailTemplate = template.Must(template.New("digest").Parse(
+		`League table as of {{.Date}}
+
+Pos  Team                        P    W    D    L    GD  Pts
+{{- range .Rows}}
+{{printf "%3d" .Position}}  {{printf "%-26.26s" .Team.Name}} {{printf "%3d  %3d  %3d  %3d  %4d  %3d" .Played .Won .Drawn .Lost .GoalDifference .Points}}
+{{- else}}
+No completed matches yet.
+{{- end}}
+`))
+)
+
+// resultMail is the data of a "result submitted" email.
+type resultMail struct {
+	Home, Away           string
+	HomeScore, AwayScore int
+	Kickoff, Venue       string
+	Goals                []goalLine
+}
+
+// goalLine is one goal listed in a result email.
+type goalLine struct {
+	Minute       string // e.g. "67'" or "90+3'"
+	Player, Team string
+	Note         string // "(pen)" or "(og)"
+}
+
+// postponedMail is the data of a "match postponed" email.
+type postponedMail struct {
+	Home, Away     string
+	Kickoff, Venue string
+}
+
+// digestMail is the data of a standings digest email.
+type digestMail struct {
+	Date string
+	Rows []dto.StandingResponse
+}
+
+// NotificationService defines the contract for email notifications and admins' preferences.
+type NotificationService interface {
+	GetPreferences(ctx context.Context, adminID uuid.UUID) (*dto.NotificationPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, adminID uuid.UUID, req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error)
+	HandleEvent(ctx context.Context, event events.Event) error
+	SendStandingsDigest(ctx context.Context) error
+}
+
+type notificationService struct {
+	prefRepo  repository.NotificationPreferenceRepository
+	standings StandingsService
+	mailer    mailer.Mailer
+	// recipients receive every notification regardless of preferences, e.g. an operations mailing list
+	recipients []string
+	// location is the timezone the digest date is given in
+	location *time.Location
+}
+
+// NewNotificationService creates a new NotificationService instance.
+func NewNotificationService(
+	prefRepo repository.NotificationPreferenceRepository,
+	standings StandingsService,
+	m mailer.Mailer,
+	recipients []string,
+	location *time.Location,
+) NotificationService {
+	return &notificationService{
+		prefRepo:   prefRepo,
+		standings:  standings,
+		mailer:     m,
+		recipients: recipients,
+		location:   location,
+	}
+}
+
+// GetPreferences returns the admin's notification settings; all notifications are off until set.
+func (s *notificationService) GetPreferences(ctx context.Context, adminID uuid.UUID) (*dto.NotificationPreferencesResponse, error) {
+	pref, err := s.findPreference(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toNotificationPreferencesResponse(*pref)
+	return &resp, nil
+}
+
+// UpdatePreferences replaces the admin's notification settings.
+func (s *notificationService) UpdatePreferences(ctx context.Context, adminID uuid.UUID, req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" && (req.ResultSubmitted || req.MatchPostponed || req.StandingsDigest) {
+		return nil, errs.ErrBadRequest("Email is required to receive notifications")
+	}
+
+	pref, err := s.findPreference(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	pref.Email = email
+	pref.ResultSubmitted = req.ResultSubmitted
+	pref.MatchPostponed = req.MatchPostponed
+	pref.StandingsDigest = req.StandingsDigest
+
+	if err := s.prefRepo.Save(pref); err != nil {
+		slog.ErrorContext(ctx, "failed to save notification preferences", "error", err, "admin_id", adminID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toNotificationPreferencesResponse(*pref)
+	return &resp, nil
+}
+
+// HandleEvent emails subscribers about events they can be notified of: submitted results
+// and postponed matches. Other events are ignored.
+func (s *notificationService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case FeedMatchCompleted:
+		match, ok := event.Data.(dto.MatchResponse)
+		if !ok {
+			return nil
+		}
+		home, away := matchTeamNames(match)
+		data := resultMail{
+			Home:      home,
+			Away:      away,
+			HomeScore: match.HomeScore,
+			AwayScore: match.AwayScore,
+			Kickoff:   formatKickoff(match),
+			Venue:     venueName(match),
+			Goals:     goalLines(match),
+		}
+		subject := fmt.Sprintf("Result: %s %d-%d %s", home, match.HomeScore, match.AwayScore, away)
+		return s.notify(ctx, model.NotificationResultSubmitted, subject, resultMailTemplate, data)
+
+	case FeedMatchStatusChanged:
+		change, ok := event.Data.(dto.MatchStatusChangedEvent)
+		if !ok || change.Status != model.MatchStatusPostponed {
+			return nil
+		}
+		home, away := matchTeamNames(change.Match)
+		data := postponedMail{Home: home, Away: away, Kickoff: formatKickoff(change.Match), Venue: venueName(change.Match)}
+		return s.notify(ctx, model.NotificationMatchPostponed, "Postponed: "+home+" vs "+away, postponedMailTemplate, data)
+	}
+	return nil
+}
+
+// SendStandingsDigest emails the current league table to digest subscribers.
+func (s *notificationService) SendStandingsDigest(ctx context.Context) error {
+	rows, err := s.standings.GetStandings(ctx, dto.SeasonFilterQuery{})
+	if err != nil {
+		return err
+	}
+	date := time.Now().In(s.location).Format("Monday 2 January 2006")
+	return s.notify(ctx, model.NotificationStandingsDigest, "League table, "+date, digestMailTemplate, digestMail{Date: date, Rows: rows})
+}
+
+// notify renders a template and mails it separately to each subscriber of the notification
+// and each configured recipient, so addresses are not disclosed to one another.
+// Every failed delivery is logged; the others are still attempted.
+func (s *notificationService) notify(ctx context.Context, notification, subject string, tmpl *template.Template, data any) error {
+	subscribers, err := s.prefRepo.FindSubscribers(notification)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch notification subscribers", "error", err, "notification", notification)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	seen := make(map[string]bool)
+	recipients := make([]string, 0, len(s.recipients)+len(subscribers))
+	for _, addr := range s.recipients {
+		if !seen[strings.ToLower(addr)] {
+			seen[strings.ToLower(addr)] = true
+			recipients = append(recipients, addr)
+		}
+	}
+	for _, pref := range subscribers {
+		if !seen[pref.Email] {
+			seen[pref.Email] = true
+			recipients = append(recipients, pref.Email)
+		}
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		slog.ErrorContext(ctx, "failed to render notification", "error", err, "notification", notification)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	failed := 0
+	for _, to := range recipients {
+		msg := mailer.Message{To: []string{to}, Subject: subject, Body: body.String()}
+		if err := s.mailer.Send(ctx, msg); err != nil {
+			slog.ErrorContext(ctx, "failed to send notification", "error", err, "notification", notification, "to", to)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errs.ErrInternal("Internal server error")
+	}
+	slog.InfoContext(ctx, "sent notification", "notification", notification, "recipients", len(recipients))
+	return nil
+}
+
+// findPreference returns the admin's stored preferences, or an unsaved empty set if there are none.
+func (s *notificationService) findPreference(ctx context.Context, adminID uuid.UUID) (*model.NotificationPreference, error) {
+	pref, err := s.prefRepo.FindByAdminID(adminID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &model.NotificationPreference{AdminID: adminID}, nil
+		}
+		slog.ErrorContext(ctx, "failed to fetch notification preferences", "error", err, "admin_id", adminID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return pref, nil
+}
+
+// matchTeamNames returns the names of a match's teams, falling back to their IDs.
+func matchTeamNames(match dto.MatchResponse) (home, away string) {
+	home, away = match.HomeTeamID, match.AwayTeamID
+	if match.HomeTeam != nil {
+		home = match.HomeTeam.Name
+	}
+	if match.AwayTeam != nil {
+		away = match.AwayTeam.Name
+	}
+	return home, away
+}
+
+// venueName returns the name of a match's venue, or "" if it has none.
+func venueName(match dto.MatchResponse) string {
+	if match.Venue == nil {
+		return ""
+	}
+	return match.Venue.Name
+}
+
+// formatKickoff renders the local kick-off time of a match for humans.
+func formatKickoff(match dto.MatchResponse) string {
+	kickoff, err := time.Parse(time.RFC3339, match.LocalDatetime)
+	if err != nil {
+		return match.LocalDatetime
+	}
+	return kickoff.Format("Monday 2 January 2006, 15:04") + " (" + match.Timezone + ")"
+}
+
+// goalLines lists the scoring events of a match in order. Own goals are attributed to the
+// player who scored them, with the team they play for.
+func goalLines(match dto.MatchResponse) []goalLine {
+	var lines []goalLine
+	for _, event := range match.Events {
+		var note string
+		switch event.Type {
+		case model.EventGoal:
+		case model.EventPenalty:
+			note = "(pen)"
+		case model.EventOwnGoal:
+			note = "(og)"
+		default:
+			continue
+		}
+		line := goalLine{Minute: model.FormatEventMinute(event.Minute, event.AddedTime), Player: event.PlayerID, Team: event.TeamID, Note: note}
+		if event.Player != nil {
+			line.Player = event.Player.Name
+		}
+		if event.Team != nil {
+			line.Team = event.Team.Name
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// toNotificationPreferencesResponse converts a model.NotificationPreference to its response.
+func toNotificationPreferencesResponse(pref model.NotificationPreference) dto.NotificationPreferencesResponse {
+	return dto.NotificationPreferencesResponse{
+		Email:           pref.Email,
+		ResultSubmitted: pref.ResultSubmitted,
+		MatchPostponed:  pref.MatchPostponed,
+		StandingsDigest: pref.StandingsDigest,
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestNotificationService_UpdatePreferences(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		req         dto.UpdateNotificationPreferencesRequest
+		setup       func(*mocks.MockNotificationPreferenceRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "creates preferences on first update",
+			req:  dto.UpdateNotificationPreferencesRequest{Email: " Admin@Example.com ", ResultSubmitted: true},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {
+				pr.EXPECT().FindByAdminID(adminID).Return(nil, gorm.ErrRecordNotFound)
+				pr.EXPECT().Save(mock.MatchedBy(func(p *model.NotificationPreference) bool {
+					return p.AdminID == adminID && p.Email == "admin@example.com" && p.ResultSubmitted && !p.StandingsDigest
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "email is required when subscribing",
+			req:         dto.UpdateNotificationPreferencesRequest{StandingsDigest: true},
+			setup:       func(pr *mocks.MockNotificationPreferenceRepository) {},
+			wantErr:     true,
+			errContains: "Email is required",
+		},
+		{
+			name: "db error",
+			req:  dto.UpdateNotificationPreferencesRequest{Email: "admin@example.com", MatchPostponed: true},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {
+				pr.EXPECT().FindByAdminID(adminID).Return(&model.NotificationPreference{AdminID: adminID}, nil)
+				pr.EXPECT().Save(mock.AnythingOfType("*model.NotificationPreference")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+			tt.setup(prefRepo)
+			svc := NewNotificationService(prefRepo, nil, mailer.NewMemory(), nil, time.UTC)
+
+			result, err := svc.UpdatePreferences(context.Background(), adminID, tt.req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "admin@example.com", result.Email)
+			assert.True(t, result.ResultSubmitted)
+		})
+	}
+}
+
+func TestNotificationService_HandleEvent(t *testing.T) {
+	match := dto.MatchResponse{
+		HomeTeamID:    "home",
+		AwayTeamID:    "away",
+		LocalDatetime: "2025-06-15T19:30:00+07:00",
+		Timezone:      "Asia/Jakarta",
+		HomeScore:     2,
+		AwayScore:     1,
+		HomeTeam:      &dto.TeamResponse{Name: "Persija Jakarta"},
+		AwayTeam:      &dto.TeamResponse{Name: "Persib Bandung"},
+		Events: []dto.MatchEventResponse{
+			{Type: model.EventGoal, Minute: 12, Player: &dto.PlayerResponse{Name: "Marko Simic"}, Team: &dto.TeamResponse{Name: "Persija Jakarta"}},
+			{Type: model.EventYellowCard, Minute: 30},
+			{Type: model.EventPenalty, Minute: 77, Player: &dto.PlayerResponse{Name: "David da Silva"}, Team: &dto.TeamResponse{Name: "Persib Bandung"}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		event        events.Event
+		setup        func(*mocks.MockNotificationPreferenceRepository)
+		wantTo       []string
+		wantSubject  string
+		wantContains []string
+	}{
+		{
+			name:  "completed match mails each recipient once",
+			event: events.Event{Type: FeedMatchCompleted, Data: match},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {
+				pr.EXPECT().FindSubscribers(model.NotificationResultSubmitted).Return([]model.NotificationPreference{
+					{Email: "ops@example.com"},
+					{Email: "coach@example.com"},
+				}, nil)
+			},
+			wantTo:       []string{"ops@example.com", "coach@example.com"},
+			wantSubject:  "Result: Persija Jakarta 2-1 Persib Bandung",
+			wantContains: []string{"12' Marko Simic (Persija Jakarta)", "77' David da Silva (Persib Bandung) (pen)"},
+		},
+		{
+			name:  "postponed match",
+			event: events.Event{Type: FeedMatchStatusChanged, Data: dto.MatchStatusChangedEvent{Status: model.MatchStatusPostponed, Match: match}},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {
+				pr.EXPECT().FindSubscribers(model.NotificationMatchPostponed).Return(nil, nil)
+			},
+			wantTo:       []string{"ops@example.com"},
+			wantSubject:  "Postponed: Persija Jakarta vs Persib Bandung",
+			wantContains: []string{"Sunday 15 June 2025, 19:30 (Asia/Jakarta), has been postponed"},
+		},
+		{
+			name:  "other status changes are ignored",
+			event: events.Event{Type: FeedMatchStatusChanged, Data: dto.MatchStatusChangedEvent{Status: model.MatchStatusLive, Match: match}},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {},
+		},
+		{
+			name:  "other events are ignored",
+			event: events.Event{Type: FeedTeamCreated, Data: dto.TeamResponse{}},
+			setup: func(pr *mocks.MockNotificationPreferenceRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+			tt.setup(prefRepo)
+			mail := mailer.NewMemory()
+			svc := NewNotificationService(prefRepo, nil, mail, []string{"ops@example.com"}, time.UTC)
+
+			err := svc.HandleEvent(context.Background(), tt.event)
+
+			assert.NoError(t, err)
+			sent := mail.Sent()
+			assert.Len(t, sent, len(tt.wantTo))
+			for i, msg := range sent {
+				assert.Equal(t, []string{tt.wantTo[i]}, msg.To)
+				assert.Equal(t, tt.wantSubject, msg.Subject)
+				for _, want := range tt.wantContains {
+					assert.Contains(t, msg.Body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationService_SendStandingsDigest(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+
+	matchRepo := mocks.NewMockMatchRepository(t)
+	matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+		completedMatch(persija, persib, 3, 0),
+	}, nil)
+	prefRepo := mocks.NewMockNotificationPreferenceRepository(t)
+	prefRepo.EXPECT().FindSubscribers(model.NotificationStandingsDigest).Return([]model.NotificationPreference{
+		{Email: "admin@example.com"},
+	}, nil)
+	mail := mailer.NewMemory()
+	svc := NewNotificationService(prefRepo, NewStandingsService(matchRepo, nil), mail, nil, time.UTC)
+
+	err := svc.SendStandingsDigest(context.Background())
+
+	assert.NoError(t, err)
+	sent := mail.Sent()
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, []string{"admin@example.com"}, sent[0].To)
+		assert.Contains(t, sent[0].Subject, "League table, ")
+		assert.Regexp(t, `  1  Persija Jakarta\s+  1    1    0    0     3    3`, sent[0].Body)
+		assert.Regexp(t, `  2  Persib Bandung\s+  1    0    0    1    -3    0`, sent[0].Body)
+	}
+}
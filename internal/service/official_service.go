@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// maxAssistants is the number of assistant referees a match can have.
+const maxAssistants = 2
+
+// OfficialService defines the contract for match officiating assignments.
+type OfficialService interface {
+	GetOfficials(ctx context.Context, matchID uuid.UUID) (*dto.MatchOfficialsResponse, error)
+	SetOfficials(ctx context.Context, matchID uuid.UUID, req dto.MatchOfficialsRequest) (*dto.MatchOfficialsResponse, error)
+}
+
+type officialService struct {
+	matchRepo    repository.MatchRepository
+	refereeRepo  repository.RefereeRepository
+	officialRepo repository.MatchOfficialRepository
+}
+
+// NewOfficialService creates a new OfficialService instance.
+func NewOfficialService(
+	matchRepo repository.MatchRepository,
+	refereeRepo repository.RefereeRepository,
+	officialRepo repository.MatchOfficialRepository,
+) OfficialService {
+	return &officialService{
+		matchRepo:    matchRepo,
+		refereeRepo:  refereeRepo,
+		officialRepo: officialRepo,
+	}
+}
+
+// GetOfficials returns the referee and assistants assigned to a match.
+func (s *officialService) GetOfficials(ctx context.Context, matchID uuid.UUID) (*dto.MatchOfficialsResponse, error) {
+	if err := s.ensureMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+
+	officials, err := s.officialRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch match officials", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toMatchOfficialsResponse(matchID, officials)
+	return &resp, nil
+}
+
+// SetOfficials assigns (or replaces) the referee and assistants of a match.
+// Every official must exist and may hold only one role in the match.
+func (s *officialService) SetOfficials(ctx context.Context, matchID uuid.UUID, req dto.MatchOfficialsRequest) (*dto.MatchOfficialsResponse, error) {
+	if err := s.ensureMatch(ctx, matchID); err != nil {
+		return nil, err
+	}
+	if len(req.AssistantIDs) > maxAssistants {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("A match cannot have more than %d assistants", maxAssistants)).WithCode(CodeInvalidOfficials)
+	}
+
+	officials := make([]model.MatchOfficial, 0, 1+len(req.AssistantIDs))
+	seen := make(map[uuid.UUID]bool)
+	add := func(raw, role, label string) error {
+		refereeID, err := uuid.Parse(raw)
+		if err != nil {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: invalid referee id format", label))
+		}
+		if seen[refereeID] {
+			return errs.ErrBadRequest(fmt.Sprintf("%s: referee is assigned more than once", label)).WithCode(CodeInvalidOfficials)
+		}
+		seen[refereeID] = true
+
+		referee, err := s.refereeRepo.FindByID(refereeID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errs.ErrNotFound(fmt.Sprintf("%s: referee not found", label))
+			}
+			slog.ErrorContext(ctx, "failed to fetch referee for assignment", "error", err, "referee_id", refereeID)
+			return errs.ErrInternal("Internal server error")
+		}
+
+		officials = append(officials, model.MatchOfficial{
+			MatchID:   matchID,
+			RefereeID: refereeID,
+			Role:      role,
+			Referee:   referee,
+		})
+		return nil
+	}
+
+	if err := add(req.RefereeID, model.OfficialRoleReferee, "Referee"); err != nil {
+		return nil, err
+	}
+	for i, raw := range req.AssistantIDs {
+		if err := add(raw, model.OfficialRoleAssistant, fmt.Sprintf("Assistant #%d", i+1)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.officialRepo.ReplaceByMatchID(matchID, officials); err != nil {
+		slog.ErrorContext(ctx, "failed to save match officials", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toMatchOfficialsResponse(matchID, officials)
+	return &resp, nil
+}
+
+func (s *officialService) ensureMatch(ctx context.Context, matchID uuid.UUID) error {
+	if _, err := s.matchRepo.FindByID(matchID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for officials", "error", err, "match_id", matchID)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// toMatchOfficialsResponse groups a match's officials by role.
+func toMatchOfficialsResponse(matchID uuid.UUID, officials []model.MatchOfficial) dto.MatchOfficialsResponse {
+	resp := dto.MatchOfficialsResponse{
+		MatchID:    matchID.String(),
+		Assistants: []dto.RefereeResponse{},
+	}
+	for _, official := range officials {
+		referee := dto.RefereeResponse{ID: official.RefereeID.String()}
+		if official.Referee != nil {
+			referee = toRefereeResponse(*official.Referee)
+		}
+		switch official.Role {
+		case model.OfficialRoleReferee:
+			resp.Referee = &referee
+		case model.OfficialRoleAssistant:
+			resp.Assistants = append(resp.Assistants, referee)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestOfficialService_SetOfficials(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	match := &model.Match{Base: model.Base{ID: matchID}}
+	newReferee := func(name string) *model.Referee {
+		return &model.Referee{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: name}
+	}
+	referee := newReferee("Thoriq Alkatiri")
+	assistant1 := newReferee("Bambang Syamsudar")
+	assistant2 := newReferee("Nurhadi")
+
+	tests := []struct {
+		name        string
+		req         dto.MatchOfficialsRequest
+		setup       func(*mocks.MockMatchRepository, *mocks.MockRefereeRepository, *mocks.MockMatchOfficialRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "referee and assistants",
+			req: dto.MatchOfficialsRequest{
+				RefereeID:    referee.ID.String(),
+				AssistantIDs: []string{assistant1.ID.String(), assistant2.ID.String()},
+			},
+			setup: func(mr *mocks.MockMatchRepository, rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				rr.EXPECT().FindByID(referee.ID).Return(referee, nil)
+				rr.EXPECT().FindByID(assistant1.ID).Return(assistant1, nil)
+				rr.EXPECT().FindByID(assistant2.ID).Return(assistant2, nil)
+				or.EXPECT().ReplaceByMatchID(matchID, mock.MatchedBy(func(officials []model.MatchOfficial) bool {
+					return len(officials) == 3 &&
+						officials[0].Role == model.OfficialRoleReferee && officials[0].RefereeID == referee.ID &&
+						officials[1].Role == model.OfficialRoleAssistant && officials[2].Role == model.OfficialRoleAssistant
+				})).Return(nil)
+			},
+		},
+		{
+			name: "referee also listed as assistant",
+			req: dto.MatchOfficialsRequest{
+				RefereeID:    referee.ID.String(),
+				AssistantIDs: []string{referee.ID.String()},
+			},
+			setup: func(mr *mocks.MockMatchRepository, rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				rr.EXPECT().FindByID(referee.ID).Return(referee, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "Assistant #1: referee is assigned more than once",
+		},
+		{
+			name: "too many assistants",
+			req: dto.MatchOfficialsRequest{
+				RefereeID:    referee.ID.String(),
+				AssistantIDs: []string{uuid.NewString(), uuid.NewString(), uuid.NewString()},
+			},
+			setup: func(mr *mocks.MockMatchRepository, rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "more than 2 assistants",
+		},
+		{
+			name: "unknown referee",
+			req:  dto.MatchOfficialsRequest{RefereeID: referee.ID.String()},
+			setup: func(mr *mocks.MockMatchRepository, rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				mr.EXPECT().FindByID(matchID).Return(match, nil)
+				rr.EXPECT().FindByID(referee.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Referee: referee not found",
+		},
+		{
+			name: "match not found",
+			req:  dto.MatchOfficialsRequest{RefereeID: referee.ID.String()},
+			setup: func(mr *mocks.MockMatchRepository, rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				mr.EXPECT().FindByID(matchID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Match not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			refereeRepo := mocks.NewMockRefereeRepository(t)
+			officialRepo := mocks.NewMockMatchOfficialRepository(t)
+			tt.setup(matchRepo, refereeRepo, officialRepo)
+			svc := NewOfficialService(matchRepo, refereeRepo, officialRepo)
+
+			officials, err := svc.SetOfficials(context.Background(), matchID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Thoriq Alkatiri", officials.Referee.Name)
+			assert.Len(t, officials.Assistants, 2)
+		})
+	}
+}
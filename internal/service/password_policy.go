@@ -0,0 +1,74 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordPolicy holds the rules admin passwords must follow.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// History is how many previous passwords cannot be reused (0 = only the current one)
+	History int
+	// BcryptCost is the cost of new password hashes (0 = bcrypt.DefaultCost)
+	BcryptCost int
+}
+
+// Validate returns a 400 error listing every rule the password breaks.
+func (p PasswordPolicy) Validate(password string) error {
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	var broken []string
+	if len([]rune(password)) < p.MinLength {
+		broken = append(broken, fmt.Sprintf("be at least %d characters long", p.MinLength))
+	}
+	if p.RequireUpper && !upper {
+		broken = append(broken, "contain an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		broken = append(broken, "contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		broken = append(broken, "contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		broken = append(broken, "contain a symbol")
+	}
+	if len(broken) > 0 {
+		return errs.ErrBadRequest("Password must " + strings.Join(broken, ", ")).WithCode(CodePasswordTooWeak)
+	}
+	return nil
+}
+
+// Hash returns the bcrypt hash of a password at the policy's cost.
+func (p PasswordPolicy) Hash(password string) (string, error) {
+	cost := p.BcryptCost
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -1,61 +1,146 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/imaging"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
 	"gorm.io/gorm"
 )
 
 // PlayerService defines the contract for player business logic.
 type PlayerService interface {
-	GetAllByTeamID(teamID uuid.UUID, pagination dto.PaginationQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error)
-	GetByID(id uuid.UUID) (*dto.PlayerResponse, error)
-	Create(teamID uuid.UUID, req dto.CreatePlayerRequest) (*dto.PlayerResponse, error)
-	Update(id uuid.UUID, req dto.UpdatePlayerRequest) (*dto.PlayerResponse, error)
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.PlayerFilterQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error)
+	GetAllByTeamID(ctx context.Context, teamID uuid.UUID, pagination dto.PaginationQuery, availability dto.AvailabilityQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.PlayerResponse, error)
+	GetBySlug(ctx context.Context, slug string) (*dto.PlayerResponse, error)
+	Create(ctx context.Context, teamID uuid.UUID, req dto.CreatePlayerRequest) (*dto.PlayerResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdatePlayerRequest) (*dto.PlayerResponse, error)
+	Patch(ctx context.Context, id uuid.UUID, req dto.PatchPlayerRequest) (*dto.PlayerResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Transfer(ctx context.Context, id uuid.UUID, req dto.TransferPlayerRequest) (*dto.PlayerResponse, error)
+	Import(ctx context.Context, teamID uuid.UUID, rows [][]string) (*dto.PlayerImportResponse, error)
+	UploadPhoto(ctx context.Context, id uuid.UUID, data []byte) (*dto.PlayerResponse, error)
 }
 
+// unmanagedPlayerMessage is returned to team managers changing players of other teams.
+const unmanagedPlayerMessage = "You can only manage players of your own teams"
+
 type playerService struct {
-	playerRepo repository.PlayerRepository
-	teamRepo   repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	teamRepo    repository.TeamRepository
+	managerRepo repository.TeamManagerRepository
+	txManager   repository.TxManager
+	rules       RosterRules
+	cache       *ResponseCache
+	feed        *events.Bus
+	photos      storage.Store
 }
 
 // NewPlayerService creates a new PlayerService instance.
-func NewPlayerService(playerRepo repository.PlayerRepository, teamRepo repository.TeamRepository) PlayerService {
+// Team managers may only change players of their own teams (see ensureManagesTeam).
+// Player changes invalidate cached match details, which embed players, and cached team listings,
+// which can be sorted by player count (nil disables caching).
+// Squads must follow rules when players join a team or change position, nationality or birth date.
+// Transfers are published to feed (nil disables publishing).
+func NewPlayerService(playerRepo repository.PlayerRepository, teamRepo repository.TeamRepository, managerRepo repository.TeamManagerRepository, txManager repository.TxManager, rules RosterRules, responseCache *ResponseCache, feed *events.Bus, photos storage.Store) PlayerService {
 	return &playerService{
-		playerRepo: playerRepo,
-		teamRepo:   teamRepo,
+		playerRepo:  playerRepo,
+		teamRepo:    teamRepo,
+		managerRepo: managerRepo,
+		txManager:   txManager,
+		rules:       rules,
+		cache:       responseCache,
+		feed:        feed,
+		photos:      photos,
 	}
 }
 
-func (s *playerService) GetAllByTeamID(teamID uuid.UUID, pagination dto.PaginationQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error) {
+// GetAll returns players across all teams, narrowed by the optional search filters.
+func (s *playerService) GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.PlayerFilterQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	filter, err := parsePlayerFilter(filterQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(filter.IDs) > 0 {
+		pagination = batchPage(pagination, filter.IDs)
+	}
+
+	players, err := s.playerRepo.FindAll(filter, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to search players", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.playerRepo.Count(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count players", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	playerResponses := make([]dto.PlayerResponse, len(players))
+	for i, player := range players {
+		playerResponses[i] = toPlayerResponse(player)
+	}
+	if len(filter.IDs) > 0 {
+		playerResponses = inRequestOrder(playerResponses, filter.IDs, func(p dto.PlayerResponse) string { return p.ID })
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return playerResponses, meta, nil
+}
+
+// GetAllByTeamID returns a page of the team's players, optionally only those available on a date.
+func (s *playerService) GetAllByTeamID(ctx context.Context, teamID uuid.UUID, pagination dto.PaginationQuery, availability dto.AvailabilityQuery) ([]dto.PlayerResponse, *response.PaginationMeta, error) {
 	pagination.Sanitize()
 
 	// Verify team exists
 	if _, err := s.teamRepo.FindByID(teamID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil, errs.ErrNotFound("Team not found")
+			return nil, nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch team", "error", err, "team_id", teamID)
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", teamID)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
-	players, err := s.playerRepo.FindAllByTeamID(teamID, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	filter := repository.PlayerFilter{TeamID: &teamID, AvailableOn: availability.AvailableOn}
+	players, err := s.playerRepo.FindAll(filter, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
 	if err != nil {
-		slog.Error("failed to fetch players", "error", err, "team_id", teamID)
+		slog.ErrorContext(ctx, "failed to fetch players", "error", err, "team_id", teamID)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
-	total, err := s.playerRepo.CountByTeamID(teamID)
+	total, err := s.playerRepo.Count(filter)
 	if err != nil {
-		slog.Error("failed to count players", "error", err, "team_id", teamID)
+		slog.ErrorContext(ctx, "failed to count players", "error", err, "team_id", teamID)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -79,13 +164,28 @@ func (s *playerService) GetAllByTeamID(teamID uuid.UUID, pagination dto.Paginati
 	return playerResponses, meta, nil
 }
 
-func (s *playerService) GetByID(id uuid.UUID) (*dto.PlayerResponse, error) {
+func (s *playerService) GetByID(ctx context.Context, id uuid.UUID) (*dto.PlayerResponse, error) {
 	player, err := s.playerRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Player not found")
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
 		}
-		slog.Error("failed to fetch player", "error", err, "player_id", id)
+		slog.ErrorContext(ctx, "failed to fetch player", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toPlayerResponse(*player)
+	return &resp, nil
+}
+
+// GetBySlug returns the player with the given slug, e.g. "marko-simic".
+func (s *playerService) GetBySlug(ctx context.Context, slug string) (*dto.PlayerResponse, error) {
+	player, err := s.playerRepo.FindBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player by slug", "error", err, "slug", slug)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -95,113 +195,712 @@ func (s *playerService) GetByID(id uuid.UUID) (*dto.PlayerResponse, error) {
 
 // Create adds a new player to a team.
 // Jersey number uniqueness per team is validated here (service layer) per PRD design.
-func (s *playerService) Create(teamID uuid.UUID, req dto.CreatePlayerRequest) (*dto.PlayerResponse, error) {
+func (s *playerService) Create(ctx context.Context, teamID uuid.UUID, req dto.CreatePlayerRequest) (*dto.PlayerResponse, error) {
+	if err := checkBirthDate(req.BirthDate); err != nil {
+		return nil, err
+	}
+
 	// Verify team exists
 	if _, err := s.teamRepo.FindByID(teamID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Team not found")
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch team for player creation", "error", err, "team_id", teamID)
+		slog.ErrorContext(ctx, "failed to fetch team for player creation", "error", err, "team_id", teamID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, teamID); err != nil {
+		return nil, err
+	}
 
 	// Check jersey number uniqueness within the team (non-soft-deleted players only)
 	existing, err := s.playerRepo.FindByTeamIDAndJerseyNumber(teamID, req.JerseyNumber)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		slog.Error("failed to check jersey number uniqueness", "error", err)
+		slog.ErrorContext(ctx, "failed to check jersey number uniqueness", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 	if existing != nil {
-		return nil, errs.ErrConflict("Jersey number already used in this team")
+		return nil, errs.ErrConflict("Jersey number already used in this team").WithCode(CodeJerseyConflict)
 	}
 
 	player := model.Player{
-		TeamID:       teamID,
-		Name:         req.Name,
-		Height:       req.Height,
-		Weight:       req.Weight,
-		Position:     req.Position,
-		JerseyNumber: req.JerseyNumber,
+		TeamID:        teamID,
+		Name:          req.Name,
+		Height:        req.Height,
+		Weight:        req.Weight,
+		Position:      req.Position,
+		JerseyNumber:  req.JerseyNumber,
+		Nationality:   req.Nationality,
+		BirthDate:     req.BirthDate,
+		PreferredFoot: req.PreferredFoot,
+		MarketValue:   req.MarketValue,
+	}
+	if err := s.checkRoster(ctx, teamID, nil, player); err != nil {
+		return nil, err
+	}
+	if player.Slug, err = s.playerSlug(ctx, req.Name, ""); err != nil {
+		return nil, err
 	}
 
 	if err := s.playerRepo.Create(&player); err != nil {
-		slog.Error("failed to create player", "error", err)
+		slog.ErrorContext(ctx, "failed to create player", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams)
 
 	resp := toPlayerResponse(player)
 	return &resp, nil
 }
 
-func (s *playerService) Update(id uuid.UUID, req dto.UpdatePlayerRequest) (*dto.PlayerResponse, error) {
+func (s *playerService) Update(ctx context.Context, id uuid.UUID, req dto.UpdatePlayerRequest) (*dto.PlayerResponse, error) {
 	player, err := s.playerRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Player not found")
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
 		}
-		slog.Error("failed to fetch player for update", "error", err, "player_id", id)
+		slog.ErrorContext(ctx, "failed to fetch player for update", "error", err, "player_id", id)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
+	return s.update(ctx, player, req)
+}
+
+// Patch changes only the fields present in req and keeps the others.
+func (s *playerService) Patch(ctx context.Context, id uuid.UUID, req dto.PatchPlayerRequest) (*dto.PlayerResponse, error) {
+	player, err := s.playerRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player for patch", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return s.update(ctx, player, mergePlayerPatch(*player, req))
+}
+
+// update replaces the editable fields of player with req and saves it.
+func (s *playerService) update(ctx context.Context, player *model.Player, req dto.UpdatePlayerRequest) (*dto.PlayerResponse, error) {
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, player.TeamID); err != nil {
+		return nil, err
+	}
+	if err := checkVersion("Player", req.Version, player.Version); err != nil {
+		return nil, err
+	}
+
 	// Check jersey number uniqueness if it changed
 	if req.JerseyNumber != player.JerseyNumber {
 		existing, err := s.playerRepo.FindByTeamIDAndJerseyNumber(player.TeamID, req.JerseyNumber)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			slog.Error("failed to check jersey number uniqueness", "error", err)
+			slog.ErrorContext(ctx, "failed to check jersey number uniqueness", "error", err)
 			return nil, errs.ErrInternal("Internal server error")
 		}
 		if existing != nil {
-			return nil, errs.ErrConflict("Jersey number already used in this team")
+			return nil, errs.ErrConflict("Jersey number already used in this team").WithCode(CodeJerseyConflict)
 		}
 	}
 
+	if err := checkBirthDate(req.BirthDate); err != nil {
+		return nil, err
+	}
+
+	if req.Position != player.Position || req.Nationality != player.Nationality || req.BirthDate != player.BirthDate {
+		changed := *player
+		changed.Position = req.Position
+		changed.Nationality = req.Nationality
+		changed.BirthDate = req.BirthDate
+		if err := s.checkRoster(ctx, player.TeamID, player, changed); err != nil {
+			return nil, err
+		}
+	}
+
+	slug, err := s.playerSlug(ctx, req.Name, player.Slug)
+	if err != nil {
+		return nil, err
+	}
+
 	player.Name = req.Name
+	player.Slug = slug
 	player.Height = req.Height
 	player.Weight = req.Weight
 	player.Position = req.Position
 	player.JerseyNumber = req.JerseyNumber
+	player.Nationality = req.Nationality
+	player.BirthDate = req.BirthDate
+	player.PreferredFoot = req.PreferredFoot
+	player.MarketValue = req.MarketValue
 
 	if err := s.playerRepo.Update(player); err != nil {
-		slog.Error("failed to update player", "error", err, "player_id", id)
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Player")
+		}
+		slog.ErrorContext(ctx, "failed to update player", "error", err, "player_id", player.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches)
 
 	resp := toPlayerResponse(*player)
 	return &resp, nil
 }
 
-func (s *playerService) Delete(id uuid.UUID) error {
-	_, err := s.playerRepo.FindByID(id)
+// playerSlug returns the slug of a player named name, keeping current (the player's slug, empty
+// when creating) if it still matches the name. Clashing slugs, including the reserved ones not
+// saved yet, get a numeric suffix.
+func (s *playerService) playerSlug(ctx context.Context, name, current string, reserved ...string) (string, error) {
+	slug, err := uniqueSlug(name, current, "player", s.playerRepo.FindSlugs, reserved...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch player slugs", "error", err)
+		return "", errs.ErrInternal("Internal server error")
+	}
+	return slug, nil
+}
+
+func (s *playerService) Delete(ctx context.Context, id uuid.UUID) error {
+	player, err := s.playerRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errs.ErrNotFound("Player not found")
+			return errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
 		}
-		slog.Error("failed to fetch player for delete", "error", err, "player_id", id)
+		slog.ErrorContext(ctx, "failed to fetch player for delete", "error", err, "player_id", id)
 		return errs.ErrInternal("Internal server error")
 	}
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, player.TeamID); err != nil {
+		return err
+	}
 
 	if err := s.playerRepo.Delete(id); err != nil {
-		slog.Error("failed to delete player", "error", err, "player_id", id)
+		slog.ErrorContext(ctx, "failed to delete player", "error", err, "player_id", id)
 		return errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches)
 
 	return nil
 }
 
+// Transfer moves a player to another team, optionally under a new jersey number.
+// The number must be free in the new team; past match events and lineups keep the old team.
+func (s *playerService) Transfer(ctx context.Context, id uuid.UUID, req dto.TransferPlayerRequest) (*dto.PlayerResponse, error) {
+	toTeamID, err := uuid.Parse(req.TeamID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid team_id format")
+	}
+
+	player, err := s.playerRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player for transfer", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, player.TeamID); err != nil {
+		return nil, err
+	}
+	if player.TeamID == toTeamID {
+		return nil, errs.ErrBadRequest("Player already belongs to this team").WithCode(CodePlayerAlreadyInTeam)
+	}
+
+	if _, err := s.teamRepo.FindByID(toTeamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team for player transfer", "error", err, "team_id", toTeamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	jerseyNumber := player.JerseyNumber
+	if req.JerseyNumber > 0 {
+		jerseyNumber = req.JerseyNumber
+	}
+	existing, err := s.playerRepo.FindByTeamIDAndJerseyNumber(toTeamID, jerseyNumber)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "failed to check jersey number uniqueness", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if existing != nil {
+		return nil, errs.ErrConflict("Jersey number already used in this team").WithCode(CodeJerseyConflict)
+	}
+	if err := s.checkRoster(ctx, toTeamID, nil, *player); err != nil {
+		return nil, err
+	}
+
+	fromTeamID := player.TeamID
+	player.TeamID = toTeamID
+	player.JerseyNumber = jerseyNumber
+	player.Team = nil
+
+	if err := s.playerRepo.Update(player); err != nil {
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Player")
+		}
+		slog.ErrorContext(ctx, "failed to transfer player", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches)
+
+	resp := toPlayerResponse(*player)
+	s.feed.Publish(FeedPlayerTransferred, dto.PlayerTransferredEvent{
+		Player:     resp,
+		FromTeamID: fromTeamID.String(),
+		ToTeamID:   toTeamID.String(),
+	})
+	return &resp, nil
+}
+
+// checkRoster returns a 409 error if the team's squad breaks the roster rules once the joining
+// players are added to it and leaving (nil if none) is removed, e.g. the old version of a changed player.
+func (s *playerService) checkRoster(ctx context.Context, teamID uuid.UUID, leaving *model.Player, joining ...model.Player) error {
+	if !s.rules.enabled() {
+		return nil
+	}
+	players, err := s.playerRepo.FindByTeamIDs([]uuid.UUID{teamID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch squad for roster rules", "error", err, "team_id", teamID)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	before := s.rules.count(players)
+	after := before
+	if leaving != nil {
+		after = s.rules.with(after, *leaving, -1)
+	}
+	for _, player := range joining {
+		after = s.rules.with(after, player, 1)
+	}
+	return s.rules.check(before, after)
+}
+
+// parsePlayerFilter converts the player search query parameters into a repository filter.
+func parsePlayerFilter(query dto.PlayerFilterQuery) (repository.PlayerFilter, error) {
+	var filter repository.PlayerFilter
+	if query.HeightMin > 0 && query.HeightMax > 0 && query.HeightMin > query.HeightMax {
+		return filter, errs.ErrBadRequest("height_min must not be greater than height_max")
+	}
+	if query.WeightMin > 0 && query.WeightMax > 0 && query.WeightMin > query.WeightMax {
+		return filter, errs.ErrBadRequest("weight_min must not be greater than weight_max")
+	}
+	if query.AgeMin > 0 && query.AgeMax > 0 && query.AgeMin > query.AgeMax {
+		return filter, errs.ErrBadRequest("age_min must not be greater than age_max")
+	}
+	if query.TeamID != "" {
+		teamID, err := uuid.Parse(query.TeamID)
+		if err != nil {
+			return filter, errs.ErrBadRequest("Invalid team_id format")
+		}
+		filter.TeamID = &teamID
+	}
+	ids, err := parseIDList(query.IDs)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.IDs = ids
+	if filter.Projection, err = parseFields[dto.PlayerResponse](query.Fields, playerFieldSources); err != nil {
+		return filter, err
+	}
+	filter.Name = strings.TrimSpace(query.Name)
+	filter.Position = query.Position
+	filter.AvailableOn = query.AvailableOn
+	filter.JerseyNumber = query.JerseyNumber
+	filter.HeightMin = query.HeightMin
+	filter.HeightMax = query.HeightMax
+	filter.WeightMin = query.WeightMin
+	filter.WeightMax = query.WeightMax
+	filter.Nationality = strings.ToUpper(query.Nationality)
+	filter.PreferredFoot = query.PreferredFoot
+
+	// A player is at least age_min once their birthday that many years ago has passed, and at
+	// most age_max until the day before turning age_max + 1
+	today := time.Now()
+	if query.AgeMin > 0 {
+		filter.BornOnOrBefore = today.AddDate(-query.AgeMin, 0, 0).Format(time.DateOnly)
+	}
+	if query.AgeMax > 0 {
+		filter.BornAfter = today.AddDate(-query.AgeMax-1, 0, 0).Format(time.DateOnly)
+	}
+	return filter, nil
+}
+
+// checkBirthDate rejects birth dates in the future. An empty date is unknown and accepted.
+func checkBirthDate(birthDate string) error {
+	if birthDate != "" && birthDate > time.Now().Format(time.DateOnly) {
+		return errs.ErrBadRequest("birth_date cannot be in the future")
+	}
+	return nil
+}
+
+// maxPlayerImportRows is the maximum number of data rows accepted in one import file.
+const maxPlayerImportRows = 200
+
+// playerImportColumns are the header names an import file must contain, in any order.
+var playerImportColumns = []string{"name", "position", "jersey_number", "height", "weight"}
+
+// Import creates players for a team from spreadsheet rows; the first row is the header.
+// Every row is validated (required fields, position, jersey number unique within the team and the file)
+// and reported individually; all valid rows are inserted in a single transaction.
+func (s *playerService) Import(ctx context.Context, teamID uuid.UUID, rows [][]string) (*dto.PlayerImportResponse, error) {
+	if _, err := s.teamRepo.FindByID(teamID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team for player import", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, teamID); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, errs.ErrBadRequest("File is empty").WithCode(CodeInvalidImportFile)
+	}
+	columns, err := importHeader(rows[0], playerImportColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	// Row numbers in the report match the spreadsheet: the header is row 1
+	type numberedRow struct {
+		number int
+		cells  []string
+	}
+	var data []numberedRow
+	for i, cells := range rows[1:] {
+		if !slices.ContainsFunc(cells, func(c string) bool { return c != "" }) {
+			continue
+		}
+		data = append(data, numberedRow{number: i + 2, cells: cells})
+	}
+	if len(data) == 0 {
+		return nil, errs.ErrBadRequest("File contains no player rows").WithCode(CodeInvalidImportFile)
+	}
+	if len(data) > maxPlayerImportRows {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("File contains %d player rows, at most %d are allowed", len(data), maxPlayerImportRows)).WithCode(CodeInvalidImportFile)
+	}
+
+	numbers, err := s.playerRepo.FindJerseyNumbersByTeamID(teamID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch jersey numbers for player import", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	taken := make(map[int]int, len(numbers)) // jersey number -> file row using it (0 = existing player)
+	for _, n := range numbers {
+		taken[n] = 0
+	}
+
+	result := &dto.PlayerImportResponse{
+		TotalRows: len(data),
+		Created:   []dto.PlayerResponse{},
+		Errors:    []dto.PlayerImportError{},
+	}
+	players := make([]model.Player, 0, len(data))
+	for _, row := range data {
+		player, rowErrs := parsePlayerImportRow(row.cells, columns)
+		if player.JerseyNumber > 0 {
+			if other, ok := taken[player.JerseyNumber]; ok {
+				msg := fmt.Sprintf("jersey number %d is already used in this team", player.JerseyNumber)
+				if other > 0 {
+					msg = fmt.Sprintf("jersey number %d is already used by row %d", player.JerseyNumber, other)
+				}
+				rowErrs = append(rowErrs, dto.PlayerImportError{Field: "jersey_number", Message: msg})
+			}
+		}
+
+		if len(rowErrs) > 0 {
+			for _, e := range rowErrs {
+				e.Row = row.number
+				result.Errors = append(result.Errors, e)
+			}
+			result.Failed++
+			continue
+		}
+
+		taken[player.JerseyNumber] = row.number
+		player.TeamID = teamID
+		players = append(players, player)
+	}
+
+	if len(players) > 0 {
+		if err := s.checkRoster(ctx, teamID, nil, players...); err != nil {
+			return nil, err
+		}
+		// Players sharing a name within the file get different slugs too
+		slugs := make([]string, 0, len(players))
+		for i := range players {
+			slug, err := s.playerSlug(ctx, players[i].Name, "", slugs...)
+			if err != nil {
+				return nil, err
+			}
+			players[i].Slug = slug
+			slugs = append(slugs, slug)
+		}
+		err := s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+			return repos.Players.CreateBatch(players)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to import players", "error", err, "team_id", teamID)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+		s.cache.invalidate(ctx, cachePrefixTeams)
+	}
+
+	for _, p := range players {
+		result.Created = append(result.Created, toPlayerResponse(p))
+	}
+	result.Imported = len(players)
+	return result, nil
+}
+
+// Player photo sizes, in pixels: the longest side of the full photo and the side of the
+// square thumbnail.
+const (
+	playerPhotoSize     = 800
+	playerThumbnailSize = 160
+)
+
+// UploadPhoto stores a resized copy of a player's headshot and a square thumbnail, replacing
+// any earlier photo. The URLs carry the upload time so clients and CDNs fetch the new photo.
+func (s *playerService) UploadPhoto(ctx context.Context, id uuid.UUID, data []byte) (*dto.PlayerResponse, error) {
+	player, err := s.playerRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player for photo upload", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if err := ensureManagesTeam(ctx, s.managerRepo, unmanagedPlayerMessage, player.TeamID); err != nil {
+		return nil, err
+	}
+
+	img, err := imaging.Decode(data)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooLarge) {
+			return nil, errs.ErrBadRequest(fmt.Sprintf("Photo must not have more than %d megapixels", imaging.MaxPixels/1_000_000)).WithCode(CodeInvalidPhoto)
+		}
+		return nil, errs.ErrBadRequest("Photo must be a JPEG, PNG or GIF image").WithCode(CodeInvalidPhoto)
+	}
+	photo, err := imaging.JPEG(imaging.Fit(img, playerPhotoSize))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode player photo", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	thumbnail, err := imaging.JPEG(imaging.Square(img, playerThumbnailSize))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode player thumbnail", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	version := "?v=" + strconv.FormatInt(time.Now().Unix(), 10)
+	photoURL, err := s.photos.Put(ctx, "players/"+id.String()+"/photo.jpg", photo, "image/jpeg")
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to store player photo", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	thumbnailURL, err := s.photos.Put(ctx, "players/"+id.String()+"/thumbnail.jpg", thumbnail, "image/jpeg")
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to store player thumbnail", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	player.PhotoURL = photoURL + version
+	player.ThumbnailURL = thumbnailURL + version
+	if err := s.playerRepo.Update(player); err != nil {
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Player")
+		}
+		slog.ErrorContext(ctx, "failed to save player photo", "error", err, "player_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toPlayerResponse(*player)
+	return &resp, nil
+}
+
+// importHeader maps every column of an import file's header row to its index, failing if one
+// of the required columns is missing.
+// Header names are matched case-insensitively; spaces and dashes count as underscores.
+func importHeader(header []string, required []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+
+	var missing []string
+	for _, col := range required {
+		if _, ok := columns[col]; !ok {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errs.ErrBadRequest("Missing column(s): " + strings.Join(missing, ", ")).WithCode(CodeInvalidImportFile)
+	}
+	return columns, nil
+}
+
+// parsePlayerImportRow builds a player from one data row, collecting every invalid field.
+// The returned errors have no row number set.
+func parsePlayerImportRow(cells []string, columns map[string]int) (model.Player, []dto.PlayerImportError) {
+	cell := func(col string) string {
+		if i, ok := columns[col]; ok && i < len(cells) {
+			return cells[i]
+		}
+		return ""
+	}
+
+	var rowErrs []dto.PlayerImportError
+	positiveInt := func(col string) int {
+		raw := cell(col)
+		if raw == "" {
+			rowErrs = append(rowErrs, dto.PlayerImportError{Field: col, Message: col + " is required"})
+			return 0
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			// Spreadsheets may store whole numbers as decimals ("9.0")
+			f, ferr := strconv.ParseFloat(raw, 64)
+			if ferr != nil || f != float64(int(f)) {
+				rowErrs = append(rowErrs, dto.PlayerImportError{Field: col, Message: col + " must be a whole number"})
+				return 0
+			}
+			n = int(f)
+		}
+		if n <= 0 {
+			rowErrs = append(rowErrs, dto.PlayerImportError{Field: col, Message: col + " must be greater than 0"})
+			return 0
+		}
+		return n
+	}
+
+	player := model.Player{Name: cell("name")}
+	if player.Name == "" {
+		rowErrs = append(rowErrs, dto.PlayerImportError{Field: "name", Message: "name is required"})
+	}
+
+	player.Position = strings.ToLower(cell("position"))
+	switch {
+	case player.Position == "":
+		rowErrs = append(rowErrs, dto.PlayerImportError{Field: "position", Message: "position is required"})
+	case !slices.Contains(model.ValidPositions, player.Position):
+		rowErrs = append(rowErrs, dto.PlayerImportError{
+			Field:   "position",
+			Message: "position must be one of " + strings.Join(model.ValidPositions, ", "),
+		})
+	}
+
+	player.JerseyNumber = positiveInt("jersey_number")
+	player.Height = positiveInt("height")
+	player.Weight = positiveInt("weight")
+
+	// nationality, birth_date, preferred_foot and market_value are optional columns
+	player.Nationality = strings.ToUpper(cell("nationality"))
+	if player.Nationality != "" && !isCountryCode(player.Nationality) {
+		rowErrs = append(rowErrs, dto.PlayerImportError{Field: "nationality", Message: "nationality must be a two-letter country code such as ID"})
+	}
+
+	player.BirthDate = cell("birth_date")
+	if player.BirthDate != "" {
+		if _, err := time.Parse(time.DateOnly, player.BirthDate); err != nil {
+			rowErrs = append(rowErrs, dto.PlayerImportError{Field: "birth_date", Message: "birth_date must be a date in YYYY-MM-DD format"})
+		} else if checkBirthDate(player.BirthDate) != nil {
+			rowErrs = append(rowErrs, dto.PlayerImportError{Field: "birth_date", Message: "birth_date cannot be in the future"})
+		}
+	}
+
+	player.PreferredFoot = strings.ToLower(cell("preferred_foot"))
+	if player.PreferredFoot != "" && !slices.Contains(model.ValidPreferredFeet, player.PreferredFoot) {
+		rowErrs = append(rowErrs, dto.PlayerImportError{
+			Field:   "preferred_foot",
+			Message: "preferred_foot must be one of " + strings.Join(model.ValidPreferredFeet, ", "),
+		})
+	}
+
+	if raw := cell("market_value"); raw != "" {
+		value, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || value < 0 {
+			rowErrs = append(rowErrs, dto.PlayerImportError{Field: "market_value", Message: "market_value must be a whole number of euros"})
+		}
+		player.MarketValue = value
+	}
+	return player, rowErrs
+}
+
+// isCountryCode reports whether code has the shape of an ISO 3166-1 alpha-2 code.
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// mergePlayerPatch returns the full update that applies patch to player.
+func mergePlayerPatch(player model.Player, patch dto.PatchPlayerRequest) dto.UpdatePlayerRequest {
+	req := dto.UpdatePlayerRequest{
+		Name:          player.Name,
+		Height:        player.Height,
+		Weight:        player.Weight,
+		Position:      player.Position,
+		JerseyNumber:  player.JerseyNumber,
+		Nationality:   player.Nationality,
+		BirthDate:     player.BirthDate,
+		PreferredFoot: player.PreferredFoot,
+		MarketValue:   player.MarketValue,
+		Version:       patch.Version,
+	}
+	if patch.Name != nil {
+		req.Name = *patch.Name
+	}
+	if patch.Height != nil {
+		req.Height = *patch.Height
+	}
+	if patch.Weight != nil {
+		req.Weight = *patch.Weight
+	}
+	if patch.Position != nil {
+		req.Position = *patch.Position
+	}
+	if patch.JerseyNumber != nil {
+		req.JerseyNumber = *patch.JerseyNumber
+	}
+	if patch.Nationality != nil {
+		req.Nationality = *patch.Nationality
+	}
+	if patch.BirthDate != nil {
+		req.BirthDate = *patch.BirthDate
+	}
+	if patch.PreferredFoot != nil {
+		req.PreferredFoot = *patch.PreferredFoot
+	}
+	if patch.MarketValue != nil {
+		req.MarketValue = *patch.MarketValue
+	}
+	return req
+}
+
 // toPlayerResponse converts a model.Player to dto.PlayerResponse.
 func toPlayerResponse(player model.Player) dto.PlayerResponse {
 	resp := dto.PlayerResponse{
-		ID:           player.ID.String(),
-		TeamID:       player.TeamID.String(),
-		Name:         player.Name,
-		Height:       player.Height,
-		Weight:       player.Weight,
-		Position:     player.Position,
-		JerseyNumber: player.JerseyNumber,
-		CreatedAt:    player.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:    player.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:            player.ID.String(),
+		TeamID:        player.TeamID.String(),
+		Name:          player.Name,
+		Slug:          player.Slug,
+		Height:        player.Height,
+		Weight:        player.Weight,
+		Position:      player.Position,
+		JerseyNumber:  player.JerseyNumber,
+		Nationality:   player.Nationality,
+		BirthDate:     player.BirthDate,
+		PreferredFoot: player.PreferredFoot,
+		MarketValue:   player.MarketValue,
+		PhotoURL:      player.PhotoURL,
+		ThumbnailURL:  player.ThumbnailURL,
+		Version:       player.Version,
+		CreatedAt:     timefmt.Format(player.CreatedAt),
+		UpdatedAt:     timefmt.Format(player.UpdatedAt),
 	}
 
+	if age, ok := player.AgeOn(time.Now()); ok {
+		resp.Age = &age
+	}
 	if player.Team != nil {
 		teamResp := toTeamResponse(*player.Team)
 		resp.Team = &teamResp
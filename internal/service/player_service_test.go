@@ -1,6 +1,12 @@
 package service
 
 import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
 	"testing"
 	"time"
 
@@ -8,7 +14,11 @@ import (
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/imaging"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
@@ -17,6 +27,7 @@ import (
 func newTestPlayerService(t *testing.T) (*playerService, *mocks.MockPlayerRepository, *mocks.MockTeamRepository) {
 	playerRepo := mocks.NewMockPlayerRepository(t)
 	teamRepo := mocks.NewMockTeamRepository(t)
+	playerRepo.EXPECT().FindSlugs(mock.Anything).Return(nil, nil).Maybe()
 	svc := &playerService{playerRepo: playerRepo, teamRepo: teamRepo}
 	return svc, playerRepo, teamRepo
 }
@@ -37,28 +48,151 @@ func samplePlayer(teamID uuid.UUID) model.Player {
 	}
 }
 
-func TestPlayerService_GetAllByTeamID(t *testing.T) {
+func TestPlayerService_GetAll(t *testing.T) {
 	teamID := uuid.Must(uuid.NewV7())
-	team := sampleTeam()
-	team.ID = teamID
 
 	tests := []struct {
 		name    string
-		setup   func(*mocks.MockPlayerRepository, *mocks.MockTeamRepository)
+		filter  dto.PlayerFilterQuery
+		setup   func(*mocks.MockPlayerRepository)
 		wantErr bool
+		errCode int
 		wantLen int
+	}{
+		{
+			name: "success without filters",
+			setup: func(pr *mocks.MockPlayerRepository) {
+				players := []model.Player{samplePlayer(teamID), samplePlayer(uuid.Must(uuid.NewV7()))}
+				pr.EXPECT().FindAll(repository.PlayerFilter{}, 0, 10, "created_at", "desc").Return(players, nil)
+				pr.EXPECT().Count(repository.PlayerFilter{}).Return(int64(2), nil)
+			},
+			wantErr: false,
+			wantLen: 2,
+		},
+		{
+			name: "filters are passed to repository",
+			filter: dto.PlayerFilterQuery{
+				TeamID: teamID.String(), Name: " bambang ", Position: "penyerang",
+				HeightMin: 170, HeightMax: 190, WeightMax: 80,
+			},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				filter := repository.PlayerFilter{
+					TeamID: &teamID, Name: "bambang", Position: "penyerang",
+					HeightMin: 170, HeightMax: 190, WeightMax: 80,
+				}
+				pr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return([]model.Player{samplePlayer(teamID)}, nil)
+				pr.EXPECT().Count(filter).Return(int64(1), nil)
+			},
+			wantErr: false,
+			wantLen: 1,
+		},
+		{
+			name:   "ages are turned into birth date bounds",
+			filter: dto.PlayerFilterQuery{Nationality: "id", PreferredFoot: "left", AgeMin: 18, AgeMax: 22},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				today := time.Now()
+				filter := repository.PlayerFilter{
+					Nationality: "ID", PreferredFoot: "left",
+					BornOnOrBefore: today.AddDate(-18, 0, 0).Format(time.DateOnly),
+					BornAfter:      today.AddDate(-23, 0, 0).Format(time.DateOnly),
+				}
+				pr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return(nil, nil)
+				pr.EXPECT().Count(filter).Return(int64(0), nil)
+			},
+			wantErr: false,
+			wantLen: 0,
+		},
+		{
+			name:    "invalid age range",
+			filter:  dto.PlayerFilterQuery{AgeMin: 23, AgeMax: 21},
+			setup:   func(pr *mocks.MockPlayerRepository) {},
+			wantErr: true,
+			errCode: 400,
+		},
+		{
+			name:    "invalid height range",
+			filter:  dto.PlayerFilterQuery{HeightMin: 190, HeightMax: 170},
+			setup:   func(pr *mocks.MockPlayerRepository) {},
+			wantErr: true,
+			errCode: 400,
+		},
+		{
+			name:    "invalid weight range",
+			filter:  dto.PlayerFilterQuery{WeightMin: 90, WeightMax: 60},
+			setup:   func(pr *mocks.MockPlayerRepository) {},
+			wantErr: true,
+			errCode: 400,
+		},
+		{
+			name: "db error on find",
+			setup: func(pr *mocks.MockPlayerRepository) {
+				pr.EXPECT().FindAll(repository.PlayerFilter{}, 0, 10, "created_at", "desc").Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+			errCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, teamRepo := newTestPlayerService(t)
+			tt.setup(playerRepo)
+
+			pagination := dto.PaginationQuery{Page: 1, PerPage: 10, SortBy: "created_at", SortOrder: "desc"}
+			players, meta, err := svc.GetAll(context.Background(), pagination, tt.filter)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, players, tt.wantLen)
+				assert.NotNil(t, meta)
+			}
+			playerRepo.AssertExpectations(t)
+			teamRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlayerService_GetAllByTeamID(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	team := sampleTeam()
+	team.ID = teamID
+
+	tests := []struct {
+		name         string
+		availability dto.AvailabilityQuery
+		setup        func(*mocks.MockPlayerRepository, *mocks.MockTeamRepository)
+		wantErr      bool
+		wantLen      int
 	}{
 		{
 			name: "success with players",
 			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
 				tr.EXPECT().FindByID(teamID).Return(&team, nil)
 				players := []model.Player{samplePlayer(teamID), samplePlayer(teamID)}
-				pr.EXPECT().FindAllByTeamID(teamID, 0, 10, "created_at", "desc").Return(players, nil)
-				pr.EXPECT().CountByTeamID(teamID).Return(int64(2), nil)
+				filter := repository.PlayerFilter{TeamID: &teamID}
+				pr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return(players, nil)
+				pr.EXPECT().Count(filter).Return(int64(2), nil)
 			},
 			wantErr: false,
 			wantLen: 2,
 		},
+		{
+			name:         "only players available on a date",
+			availability: dto.AvailabilityQuery{AvailableOn: "2025-06-15"},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				players := []model.Player{samplePlayer(teamID)}
+				filter := repository.PlayerFilter{TeamID: &teamID, AvailableOn: "2025-06-15"}
+				pr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return(players, nil)
+				pr.EXPECT().Count(filter).Return(int64(1), nil)
+			},
+			wantErr: false,
+			wantLen: 1,
+		},
 		{
 			name: "team not found",
 			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
@@ -74,7 +208,7 @@ func TestPlayerService_GetAllByTeamID(t *testing.T) {
 			tt.setup(playerRepo, teamRepo)
 
 			pagination := dto.PaginationQuery{Page: 1, PerPage: 10, SortBy: "created_at", SortOrder: "desc"}
-			players, meta, err := svc.GetAllByTeamID(teamID, pagination)
+			players, meta, err := svc.GetAllByTeamID(context.Background(), teamID, pagination, tt.availability)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -121,7 +255,7 @@ func TestPlayerService_GetByID(t *testing.T) {
 			svc, playerRepo, _ := newTestPlayerService(t)
 			tt.setup(playerRepo)
 
-			result, err := svc.GetByID(player.ID)
+			result, err := svc.GetByID(context.Background(), player.ID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -138,6 +272,38 @@ func TestPlayerService_GetByID(t *testing.T) {
 	}
 }
 
+func TestPlayerService_GetByID_Age(t *testing.T) {
+	today := time.Now()
+	tests := []struct {
+		name      string
+		birthDate string
+		wantAge   int // No age without a birth date
+	}{
+		{name: "birthday tomorrow", birthDate: today.AddDate(-23, 0, 1).Format(time.DateOnly), wantAge: 22},
+		{name: "birthday today", birthDate: today.AddDate(-23, 0, 0).Format(time.DateOnly), wantAge: 23},
+		{name: "unknown birth date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, _ := newTestPlayerService(t)
+			player := samplePlayer(uuid.Must(uuid.NewV7()))
+			player.BirthDate = tt.birthDate
+			playerRepo.EXPECT().FindByID(player.ID).Return(&player, nil)
+
+			result, err := svc.GetByID(context.Background(), player.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.birthDate, result.BirthDate)
+			if tt.birthDate == "" {
+				assert.Nil(t, result.Age)
+			} else if assert.NotNil(t, result.Age) {
+				assert.Equal(t, tt.wantAge, *result.Age)
+			}
+		})
+	}
+}
+
 func TestPlayerService_Create(t *testing.T) {
 	teamID := uuid.Must(uuid.NewV7())
 	team := sampleTeam()
@@ -206,7 +372,7 @@ func TestPlayerService_Create(t *testing.T) {
 			svc, playerRepo, teamRepo := newTestPlayerService(t)
 			tt.setup(playerRepo, teamRepo)
 
-			result, err := svc.Create(teamID, tt.req)
+			result, err := svc.Create(context.Background(), teamID, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -297,6 +463,28 @@ func TestPlayerService_Update(t *testing.T) {
 			wantErr:     true,
 			errContains: "Player not found",
 		},
+		{
+			name: "stale version",
+			req:  dto.UpdatePlayerRequest{Name: "Test", Height: 175, Weight: 70, Position: "bertahan", JerseyNumber: 20, Version: 1},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				playerCopy := player
+				playerCopy.Version = 3
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+			},
+			wantErr:     true,
+			errContains: "modified by another request",
+		},
+		{
+			name: "concurrent update wins the race",
+			req:  dto.UpdatePlayerRequest{Name: "Test", Height: 175, Weight: 70, Position: "bertahan", JerseyNumber: 20},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				pr.EXPECT().Update(mock.AnythingOfType("*model.Player")).Return(repository.ErrVersionConflict)
+			},
+			wantErr:     true,
+			errContains: "modified by another request",
+		},
 	}
 
 	for _, tt := range tests {
@@ -304,7 +492,7 @@ func TestPlayerService_Update(t *testing.T) {
 			svc, playerRepo, _ := newTestPlayerService(t)
 			tt.setup(playerRepo)
 
-			result, err := svc.Update(player.ID, tt.req)
+			result, err := svc.Update(context.Background(), player.ID, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -321,6 +509,184 @@ func TestPlayerService_Update(t *testing.T) {
 	}
 }
 
+func TestPlayerService_Patch(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	player := samplePlayer(teamID)
+	name := "Bambang Updated"
+	jersey := 7
+
+	tests := []struct {
+		name        string
+		req         dto.PatchPlayerRequest
+		setup       func(*mocks.MockPlayerRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "only name keeps jersey number",
+			req:  dto.PatchPlayerRequest{Name: &name},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				pr.EXPECT().Update(mock.AnythingOfType("*model.Player")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "jersey number taken",
+			req:  dto.PatchPlayerRequest{JerseyNumber: &jersey},
+			setup: func(pr *mocks.MockPlayerRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				otherPlayer := samplePlayer(teamID)
+				otherPlayer.JerseyNumber = jersey
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(teamID, jersey).Return(&otherPlayer, nil)
+			},
+			wantErr:     true,
+			errContains: "Jersey number already used",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, _ := newTestPlayerService(t)
+			tt.setup(playerRepo)
+
+			result, err := svc.Patch(context.Background(), player.ID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, name, result.Name)
+				assert.Equal(t, player.JerseyNumber, result.JerseyNumber)
+				assert.Equal(t, player.Position, result.Position)
+			}
+			playerRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlayerService_Transfer(t *testing.T) {
+	fromTeamID := uuid.Must(uuid.NewV7())
+	toTeam := sampleTeam()
+	player := samplePlayer(fromTeamID)
+
+	tests := []struct {
+		name        string
+		req         dto.TransferPlayerRequest
+		setup       func(*mocks.MockPlayerRepository, *mocks.MockTeamRepository)
+		wantErr     bool
+		errContains string
+		wantJersey  int
+	}{
+		{
+			name: "success keeps jersey number",
+			req:  dto.TransferPlayerRequest{TeamID: toTeam.ID.String()},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				tr.EXPECT().FindByID(toTeam.ID).Return(&toTeam, nil)
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(toTeam.ID, 20).Return(nil, gorm.ErrRecordNotFound)
+				pr.EXPECT().Update(mock.MatchedBy(func(p *model.Player) bool {
+					return p.TeamID == toTeam.ID && p.JerseyNumber == 20
+				})).Return(nil)
+			},
+			wantErr:    false,
+			wantJersey: 20,
+		},
+		{
+			name: "success with new jersey number",
+			req:  dto.TransferPlayerRequest{TeamID: toTeam.ID.String(), JerseyNumber: 9},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				tr.EXPECT().FindByID(toTeam.ID).Return(&toTeam, nil)
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(toTeam.ID, 9).Return(nil, gorm.ErrRecordNotFound)
+				pr.EXPECT().Update(mock.AnythingOfType("*model.Player")).Return(nil)
+			},
+			wantErr:    false,
+			wantJersey: 9,
+		},
+		{
+			name: "jersey number taken in new team",
+			req:  dto.TransferPlayerRequest{TeamID: toTeam.ID.String()},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				tr.EXPECT().FindByID(toTeam.ID).Return(&toTeam, nil)
+				other := samplePlayer(toTeam.ID)
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(toTeam.ID, 20).Return(&other, nil)
+			},
+			wantErr:     true,
+			errContains: "Jersey number already used",
+		},
+		{
+			name: "same team",
+			req:  dto.TransferPlayerRequest{TeamID: fromTeamID.String()},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+			},
+			wantErr:     true,
+			errContains: "already belongs to this team",
+		},
+		{
+			name: "team not found",
+			req:  dto.TransferPlayerRequest{TeamID: toTeam.ID.String()},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				tr.EXPECT().FindByID(toTeam.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Team not found",
+		},
+		{
+			name: "player not found",
+			req:  dto.TransferPlayerRequest{TeamID: toTeam.ID.String()},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository) {
+				pr.EXPECT().FindByID(player.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Player not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, teamRepo := newTestPlayerService(t)
+			svc.feed = events.NewBus()
+			sub := svc.feed.Subscribe(4)
+			defer sub.Close()
+			tt.setup(playerRepo, teamRepo)
+
+			result, err := svc.Transfer(context.Background(), player.ID, tt.req)
+
+			received := drainFeed(sub)
+			if tt.wantErr {
+				assert.Error(t, err)
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				assert.Empty(t, received)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, toTeam.ID.String(), result.TeamID)
+				assert.Equal(t, tt.wantJersey, result.JerseyNumber)
+				if assert.Len(t, received, 1) {
+					assert.Equal(t, FeedPlayerTransferred, received[0].Type)
+					event := received[0].Data.(dto.PlayerTransferredEvent)
+					assert.Equal(t, fromTeamID.String(), event.FromTeamID)
+					assert.Equal(t, toTeam.ID.String(), event.ToTeamID)
+				}
+			}
+		})
+	}
+}
+
 func TestPlayerService_Delete(t *testing.T) {
 	teamID := uuid.Must(uuid.NewV7())
 	playerID := uuid.Must(uuid.NewV7())
@@ -356,7 +722,7 @@ func TestPlayerService_Delete(t *testing.T) {
 			svc, playerRepo, _ := newTestPlayerService(t)
 			tt.setup(playerRepo)
 
-			err := svc.Delete(playerID)
+			err := svc.Delete(context.Background(), playerID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -370,3 +736,571 @@ func TestPlayerService_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayerService_Delete_TeamManager(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	managerID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name    string
+		actor   Actor
+		setup   func(*mocks.MockPlayerRepository, *mocks.MockTeamManagerRepository, uuid.UUID)
+		wantErr bool
+	}{
+		{
+			name:  "manager of the player's team",
+			actor: Actor{AdminID: managerID, Role: model.RoleTeamManager},
+			setup: func(pr *mocks.MockPlayerRepository, mr *mocks.MockTeamManagerRepository, playerID uuid.UUID) {
+				mr.EXPECT().FindTeamIDsByAdminID(managerID).Return([]uuid.UUID{uuid.Must(uuid.NewV7()), teamID}, nil)
+				pr.EXPECT().Delete(playerID).Return(nil)
+			},
+		},
+		{
+			name:  "manager of another team",
+			actor: Actor{AdminID: managerID, Role: model.RoleTeamManager},
+			setup: func(pr *mocks.MockPlayerRepository, mr *mocks.MockTeamManagerRepository, playerID uuid.UUID) {
+				mr.EXPECT().FindTeamIDsByAdminID(managerID).Return([]uuid.UUID{uuid.Must(uuid.NewV7())}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "editors are not restricted",
+			actor: Actor{AdminID: managerID, Role: model.RoleEditor},
+			setup: func(pr *mocks.MockPlayerRepository, mr *mocks.MockTeamManagerRepository, playerID uuid.UUID) {
+				pr.EXPECT().Delete(playerID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, _ := newTestPlayerService(t)
+			managerRepo := mocks.NewMockTeamManagerRepository(t)
+			svc.managerRepo = managerRepo
+			player := samplePlayer(teamID)
+			playerRepo.EXPECT().FindByID(player.ID).Return(&player, nil)
+			tt.setup(playerRepo, managerRepo, player.ID)
+
+			err := svc.Delete(WithActor(context.Background(), tt.actor), player.ID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, 403, appErr.Code)
+				assert.Equal(t, "You can only manage players of your own teams", appErr.Message)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPlayerService_Import(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	team := sampleTeam()
+	team.ID = teamID
+	header := []string{"Name", "Position", "Jersey Number", "Height", "Weight"}
+
+	tests := []struct {
+		name         string
+		rows         [][]string
+		setup        func(*mocks.MockPlayerRepository, *mocks.MockTeamRepository, *mocks.MockTxManager)
+		wantErr      bool
+		errContains  string
+		wantImported int
+		wantErrors   []dto.PlayerImportError
+	}{
+		{
+			name: "valid rows imported, invalid rows reported",
+			rows: [][]string{
+				header,
+				{"Marko Simic", "penyerang", "9", "185", "80"},
+				{"", "kiper", "0", "abc", "70.5"},
+				{},
+				{"Riko Simanjuntak", "Gelandang", "20", "165", "60"},
+				{"Duplicate", "bertahan", "9", "180", "75"},
+				{"Existing", "bertahan", "4", "180", "75"},
+			},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				pr.EXPECT().FindJerseyNumbersByTeamID(teamID).Return([]int{4}, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+					return fn(repository.TxRepositories{Players: pr})
+				})
+				pr.EXPECT().CreateBatch(mock.MatchedBy(func(players []model.Player) bool {
+					return len(players) == 2 && players[0].JerseyNumber == 9 && players[1].Position == "gelandang" && players[1].TeamID == teamID
+				})).Return(nil)
+			},
+			wantImported: 2,
+			wantErrors: []dto.PlayerImportError{
+				{Row: 3, Field: "name", Message: "name is required"},
+				{Row: 3, Field: "position", Message: "position must be one of penyerang, gelandang, bertahan, penjaga_gawang"},
+				{Row: 3, Field: "jersey_number", Message: "jersey_number must be greater than 0"},
+				{Row: 3, Field: "height", Message: "height must be a whole number"},
+				{Row: 3, Field: "weight", Message: "weight must be a whole number"},
+				{Row: 6, Field: "jersey_number", Message: "jersey number 9 is already used by row 2"},
+				{Row: 7, Field: "jersey_number", Message: "jersey number 4 is already used in this team"},
+			},
+		},
+		{
+			name: "no valid rows skips insert",
+			rows: [][]string{header, {"Player", "striker", "10", "180", "75"}},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				pr.EXPECT().FindJerseyNumbersByTeamID(teamID).Return(nil, nil)
+			},
+			wantImported: 0,
+			wantErrors: []dto.PlayerImportError{
+				{Row: 2, Field: "position", Message: "position must be one of penyerang, gelandang, bertahan, penjaga_gawang"},
+			},
+		},
+		{
+			name: "missing columns",
+			rows: [][]string{{"name", "position"}, {"Player", "penyerang"}},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+			},
+			wantErr:     true,
+			errContains: "Missing column(s): jersey_number, height, weight",
+		},
+		{
+			name: "header only",
+			rows: [][]string{header, {"", ""}},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+			},
+			wantErr:     true,
+			errContains: "no player rows",
+		},
+		{
+			name: "team not found",
+			rows: [][]string{header},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Team not found",
+		},
+		{
+			name: "insert failure",
+			rows: [][]string{header, {"Marko Simic", "penyerang", "9", "185", "80"}},
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				pr.EXPECT().FindJerseyNumbersByTeamID(teamID).Return(nil, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).Return(gorm.ErrInvalidTransaction)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, teamRepo := newTestPlayerService(t)
+			txManager := mocks.NewMockTxManager(t)
+			svc.txManager = txManager
+			tt.setup(playerRepo, teamRepo, txManager)
+
+			result, err := svc.Import(context.Background(), teamID, tt.rows)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantImported, result.Imported)
+			assert.Len(t, result.Created, tt.wantImported)
+			assert.Equal(t, tt.wantErrors, result.Errors)
+			assert.Equal(t, result.TotalRows, result.Imported+result.Failed)
+		})
+	}
+}
+
+func TestPlayerService_Slug(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	team := sampleTeam()
+	team.ID = teamID
+	// Built without newTestPlayerService, whose repository has no players with a slug
+	newService := func(t *testing.T) (*playerService, *mocks.MockPlayerRepository, *mocks.MockTeamRepository) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		teamRepo := mocks.NewMockTeamRepository(t)
+		return &playerService{playerRepo: playerRepo, teamRepo: teamRepo}, playerRepo, teamRepo
+	}
+
+	t.Run("namesakes in an import get their own slugs", func(t *testing.T) {
+		svc, playerRepo, teamRepo := newService(t)
+		txManager := mocks.NewMockTxManager(t)
+		svc.txManager = txManager
+		teamRepo.EXPECT().FindByID(teamID).Return(&team, nil)
+		playerRepo.EXPECT().FindJerseyNumbersByTeamID(teamID).Return(nil, nil)
+		playerRepo.EXPECT().FindSlugs("marko-simic").Return([]string{"marko-simic"}, nil)
+		txManager.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+			return fn(repository.TxRepositories{Players: playerRepo})
+		})
+		playerRepo.EXPECT().CreateBatch(mock.Anything).Return(nil)
+
+		result, err := svc.Import(context.Background(), teamID, [][]string{
+			{"Name", "Position", "Jersey Number", "Height", "Weight"},
+			{"Marko Simic", "penyerang", "9", "185", "80"},
+			{"Marko Šimić", "penyerang", "19", "183", "78"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "marko-simic-2", result.Created[0].Slug)
+		assert.Equal(t, "marko-simic-3", result.Created[1].Slug)
+	})
+
+	t.Run("lookup of an unknown slug", func(t *testing.T) {
+		svc, playerRepo, _ := newService(t)
+		playerRepo.EXPECT().FindBySlug("marko-simic").Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.GetBySlug(context.Background(), "marko-simic")
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+		assert.Equal(t, CodePlayerNotFound, appErr.ErrorCode)
+	})
+}
+
+// sampleSquad returns players of a team: outfield home players, then goalkeepers, then foreign outfield players.
+func sampleSquad(teamID uuid.UUID, outfield, goalkeepers, foreign int) []model.Player {
+	var players []model.Player
+	add := func(n int, position, nationality string) {
+		for range n {
+			player := samplePlayer(teamID)
+			player.Position = position
+			player.Nationality = nationality
+			player.JerseyNumber = len(players) + 1
+			players = append(players, player)
+		}
+	}
+	add(outfield, "gelandang", "ID")
+	add(goalkeepers, model.PositionGoalkeeper, "ID")
+	add(foreign, "penyerang", "BR")
+	return players
+}
+
+func TestPlayerService_RosterRules(t *testing.T) {
+	rules := RosterRules{MaxSquadSize: 25, MaxForeignPlayers: 4, MinGoalkeepers: 3, HomeNationality: "ID"}
+	team := sampleTeam()
+	defender, midfielder := "bertahan", "gelandang"
+
+	tests := []struct {
+		name       string
+		squad      []model.Player
+		req        dto.CreatePlayerRequest
+		wantFields []string // Rules reported as broken; none means the player is created
+	}{
+		{
+			name:  "room left",
+			squad: sampleSquad(team.ID, 18, 3, 2),
+			req:   dto.CreatePlayerRequest{Position: "penyerang", Nationality: "JP"},
+		},
+		{
+			name:       "squad full",
+			squad:      sampleSquad(team.ID, 21, 3, 1),
+			req:        dto.CreatePlayerRequest{Position: model.PositionGoalkeeper},
+			wantFields: []string{"squad_size"},
+		},
+		{
+			name:       "too many foreign players",
+			squad:      sampleSquad(team.ID, 10, 3, 4),
+			req:        dto.CreatePlayerRequest{Position: "penyerang", Nationality: "br"},
+			wantFields: []string{"foreign_players"},
+		},
+		{
+			name:  "players without nationality are not foreign",
+			squad: sampleSquad(team.ID, 10, 3, 4),
+			req:   dto.CreatePlayerRequest{Position: "penyerang"},
+		},
+		{
+			name:       "last places kept for goalkeepers",
+			squad:      sampleSquad(team.ID, 22, 1, 0),
+			req:        dto.CreatePlayerRequest{Position: "bertahan"},
+			wantFields: []string{"goalkeepers"},
+		},
+		{
+			name:  "goalkeeper takes a kept place",
+			squad: sampleSquad(team.ID, 22, 1, 0),
+			req:   dto.CreatePlayerRequest{Position: model.PositionGoalkeeper},
+		},
+		{
+			name:       "every broken rule is reported",
+			squad:      sampleSquad(team.ID, 19, 2, 4),
+			req:        dto.CreatePlayerRequest{Position: "penyerang", Nationality: "NL"},
+			wantFields: []string{"squad_size", "foreign_players"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, teamRepo := newTestPlayerService(t)
+			svc.rules = rules
+			tt.req.Name, tt.req.Height, tt.req.Weight, tt.req.JerseyNumber = "New Player", 180, 75, 99
+			teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+			playerRepo.EXPECT().FindByTeamIDAndJerseyNumber(team.ID, 99).Return(nil, gorm.ErrRecordNotFound)
+			playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(tt.squad, nil)
+			if len(tt.wantFields) == 0 {
+				playerRepo.EXPECT().Create(mock.AnythingOfType("*model.Player")).Return(nil)
+			}
+
+			_, err := svc.Create(context.Background(), team.ID, tt.req)
+
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var appErr *errs.AppError
+			if assert.ErrorAs(t, err, &appErr) {
+				assert.Equal(t, 409, appErr.Code)
+				assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+				var fields []string
+				for _, field := range appErr.Errors {
+					fields = append(fields, field.Field)
+				}
+				assert.Equal(t, tt.wantFields, fields)
+			}
+		})
+	}
+
+	t.Run("goalkeeper changing position when the squad is full", func(t *testing.T) {
+		svc, playerRepo, _ := newTestPlayerService(t)
+		svc.rules = rules
+		squad := sampleSquad(team.ID, 22, 3, 0)
+		keeper := squad[22]
+		playerRepo.EXPECT().FindByID(keeper.ID).Return(&keeper, nil)
+		playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(squad, nil)
+
+		_, err := svc.Patch(context.Background(), keeper.ID, dto.PatchPlayerRequest{Position: &defender})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+	})
+
+	t.Run("squad over a limit can still change without getting worse", func(t *testing.T) {
+		svc, playerRepo, _ := newTestPlayerService(t)
+		svc.rules = rules
+		squad := sampleSquad(team.ID, 20, 3, 6)
+		foreigner := squad[len(squad)-1]
+		playerRepo.EXPECT().FindByID(foreigner.ID).Return(&foreigner, nil)
+		playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(squad, nil)
+		playerRepo.EXPECT().Update(mock.AnythingOfType("*model.Player")).Return(nil)
+
+		result, err := svc.Patch(context.Background(), foreigner.ID, dto.PatchPlayerRequest{Position: &midfielder})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "gelandang", result.Position)
+	})
+
+	t.Run("transfer into a full squad", func(t *testing.T) {
+		svc, playerRepo, teamRepo := newTestPlayerService(t)
+		svc.rules = rules
+		player := samplePlayer(uuid.Must(uuid.NewV7()))
+		playerRepo.EXPECT().FindByID(player.ID).Return(&player, nil)
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		playerRepo.EXPECT().FindByTeamIDAndJerseyNumber(team.ID, 99).Return(nil, gorm.ErrRecordNotFound)
+		playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(sampleSquad(team.ID, 22, 3, 0), nil)
+
+		_, err := svc.Transfer(context.Background(), player.ID, dto.TransferPlayerRequest{TeamID: team.ID.String(), JerseyNumber: 99})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+		assert.Equal(t, "squad_size", appErr.Errors[0].Field)
+	})
+
+	t.Run("import that would overfill the squad inserts nothing", func(t *testing.T) {
+		svc, playerRepo, teamRepo := newTestPlayerService(t)
+		svc.rules = rules
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		playerRepo.EXPECT().FindJerseyNumbersByTeamID(team.ID).Return(nil, nil)
+		playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(sampleSquad(team.ID, 21, 3, 0), nil)
+
+		_, err := svc.Import(context.Background(), team.ID, [][]string{
+			{"name", "position", "jersey_number", "height", "weight", "nationality"},
+			{"Marko Simic", "penyerang", "90", "185", "80", "HR"},
+			{"Riko Simanjuntak", "gelandang", "91", "165", "60", ""},
+		})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+	})
+}
+
+func TestPlayerService_UploadPhoto(t *testing.T) {
+	// A wide PNG with a transparent background, as exported from an image editor
+	src := image.NewNRGBA(image.Rect(0, 0, 1600, 1000))
+	for x := 600; x < 1000; x++ {
+		for y := 300; y < 700; y++ {
+			src.Set(x, y, color.NRGBA{R: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	photo := buf.Bytes()
+
+	tests := []struct {
+		name        string
+		data        []byte
+		setup       func(*mocks.MockPlayerRepository, *model.Player)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "stores resized photo and thumbnail",
+			data: photo,
+			setup: func(pr *mocks.MockPlayerRepository, p *model.Player) {
+				pr.EXPECT().FindByID(p.ID).Return(p, nil)
+				pr.EXPECT().Update(mock.MatchedBy(func(saved *model.Player) bool {
+					return strings.HasPrefix(saved.PhotoURL, "memory://players/"+p.ID.String()+"/photo.jpg?v=") &&
+						strings.HasPrefix(saved.ThumbnailURL, "memory://players/"+p.ID.String()+"/thumbnail.jpg?v=")
+				})).Return(nil)
+			},
+		},
+		{
+			name: "not an image",
+			data: []byte("name,position\nBambang,penyerang\n"),
+			setup: func(pr *mocks.MockPlayerRepository, p *model.Player) {
+				pr.EXPECT().FindByID(p.ID).Return(p, nil)
+			},
+			wantErr:     true,
+			errCode:     400,
+			errContains: "JPEG, PNG or GIF",
+		},
+		{
+			name: "player not found",
+			data: photo,
+			setup: func(pr *mocks.MockPlayerRepository, p *model.Player) {
+				pr.EXPECT().FindByID(p.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Player not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, _ := newTestPlayerService(t)
+			uploads := storage.NewMemory()
+			svc.photos = uploads
+			player := samplePlayer(uuid.Must(uuid.NewV7()))
+			tt.setup(playerRepo, &player)
+
+			resp, err := svc.UploadPhoto(context.Background(), player.ID, tt.data)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, player.PhotoURL, resp.PhotoURL)
+
+			stored, ok := uploads.File("players/" + player.ID.String() + "/photo.jpg")
+			assert.True(t, ok)
+			assert.Equal(t, "image/jpeg", stored.ContentType)
+			img, err := imaging.Decode(stored.Data)
+			assert.NoError(t, err)
+			assert.Equal(t, image.Pt(800, 500), img.Bounds().Size())
+
+			stored, ok = uploads.File("players/" + player.ID.String() + "/thumbnail.jpg")
+			assert.True(t, ok)
+			img, err = imaging.Decode(stored.Data)
+			assert.NoError(t, err)
+			assert.Equal(t, image.Pt(160, 160), img.Bounds().Size())
+			// Transparent areas are flattened onto white, the red square stays in the center
+			r, g, b, _ := img.At(2, 2).RGBA()
+			assert.Greater(t, min(r, g, b)>>8, uint32(240))
+			r, g, _, _ = img.At(80, 80).RGBA()
+			assert.Greater(t, r>>8, uint32(180))
+			assert.Less(t, g>>8, uint32(40))
+		})
+	}
+}
+
+func TestPlayerService_RosterRules_YouthPlayers(t *testing.T) {
+	rules := RosterRules{MaxSquadSize: 25, MinYouthPlayers: 3, YouthAge: 23}
+	team := sampleTeam()
+	youngster := time.Now().AddDate(-20, 0, 0).Format(time.DateOnly)
+	veteran := time.Now().AddDate(-30, 0, 0).Format(time.DateOnly)
+
+	t.Run("last places kept for youth players", func(t *testing.T) {
+		for _, tt := range []struct {
+			birthDate string
+			wantErr   bool
+		}{
+			{birthDate: youngster},
+			{birthDate: veteran, wantErr: true},
+			{birthDate: "", wantErr: true},
+		} {
+			svc, playerRepo, teamRepo := newTestPlayerService(t)
+			svc.rules = rules
+			teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+			playerRepo.EXPECT().FindByTeamIDAndJerseyNumber(team.ID, 99).Return(nil, gorm.ErrRecordNotFound)
+			playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(sampleSquad(team.ID, 22, 0, 0), nil)
+			if !tt.wantErr {
+				playerRepo.EXPECT().Create(mock.AnythingOfType("*model.Player")).Return(nil)
+			}
+
+			_, err := svc.Create(context.Background(), team.ID, dto.CreatePlayerRequest{
+				Name: "New Player", Height: 180, Weight: 75, Position: "gelandang", JerseyNumber: 99, BirthDate: tt.birthDate,
+			})
+
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				continue
+			}
+			var appErr *errs.AppError
+			if assert.ErrorAs(t, err, &appErr) {
+				assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+				assert.Equal(t, []errs.FieldError{{
+					Field:   "youth_players",
+					Message: "Squad must keep places for at least 3 players under 23, it has 0",
+				}}, appErr.Errors)
+			}
+		}
+	})
+
+	t.Run("youth player's birth date corrected when the squad is full", func(t *testing.T) {
+		svc, playerRepo, _ := newTestPlayerService(t)
+		svc.rules = rules
+		squad := sampleSquad(team.ID, 25, 0, 0)
+		for i := range 3 {
+			squad[i].BirthDate = youngster
+		}
+		player := squad[0]
+		playerRepo.EXPECT().FindByID(player.ID).Return(&player, nil)
+		playerRepo.EXPECT().FindByTeamIDs([]uuid.UUID{team.ID}).Return(squad, nil)
+
+		_, err := svc.Patch(context.Background(), player.ID, dto.PatchPlayerRequest{BirthDate: &veteran})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeRosterRulesViolated, appErr.ErrorCode)
+	})
+
+	t.Run("birth date in the future", func(t *testing.T) {
+		svc, _, _ := newTestPlayerService(t)
+		tomorrow := time.Now().AddDate(0, 0, 1).Format(time.DateOnly)
+
+		_, err := svc.Create(context.Background(), team.ID, dto.CreatePlayerRequest{
+			Name: "New Player", Height: 180, Weight: 75, Position: "gelandang", JerseyNumber: 99, BirthDate: tomorrow,
+		})
+
+		var appErr *errs.AppError
+		if assert.ErrorAs(t, err, &appErr) {
+			assert.Equal(t, 400, appErr.Code)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// headToHeadLength is the number of latest meetings of two teams a prediction looks at.
+const headToHeadLength = 10
+
+// Outcomes of a match, as predicted and as played. They are decided by the score alone;
+// a penalty shootout does not change them.
+const (
+	outcomeHomeWin = "home_win"
+	outcomeDraw    = "draw"
+	outcomeAwayWin = "away_win"
+)
+
+// PredictionHistory is what a PredictionStrategy knows about a match: results of its teams
+// from before kick-off, oldest first, without associations.
+type PredictionHistory struct {
+	Match      model.Match
+	HeadToHead []model.Match // Latest meetings of the two teams, at either ground
+	HomeRecent []model.Match // Latest matches of the home team
+	AwayRecent []model.Match // Latest matches of the away team
+}
+
+// PredictionOdds are the probabilities of the outcomes of a match; they add up to 1.
+type PredictionOdds struct {
+	HomeWin float64
+	Draw    float64
+	AwayWin float64
+}
+
+// PredictionStrategy estimates the outcome of a match from its history. The prediction
+// service takes any strategy, so a better model can replace the default without changing
+// the endpoint; responses name the strategy used.
+type PredictionStrategy interface {
+	// Name identifies the strategy in responses, e.g. "form_and_head_to_head".
+	Name() string
+	Predict(history PredictionHistory) PredictionOdds
+}
+
+// PredictionService defines the contract for match predictions.
+type PredictionService interface {
+	GetPrediction(ctx context.Context, matchID uuid.UUID) (*dto.MatchPredictionResponse, error)
+}
+
+type predictionService struct {
+	matchRepo repository.MatchRepository
+	strategy  PredictionStrategy
+}
+
+// NewPredictionService creates a new PredictionService predicting with strategy.
+func NewPredictionService(matchRepo repository.MatchRepository, strategy PredictionStrategy) PredictionService {
+	return &predictionService{matchRepo: matchRepo, strategy: strategy}
+}
+
+// GetPrediction estimates the outcome of a match from its teams' results before kick-off.
+// A completed match gets the prediction it had before it was played, with its actual outcome.
+func (s *predictionService) GetPrediction(ctx context.Context, matchID uuid.UUID) (*dto.MatchPredictionResponse, error) {
+	match, err := s.matchRepo.FindByID(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for prediction", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	history := PredictionHistory{Match: *match}
+	for _, part := range []struct {
+		matches    *[]model.Match
+		teamID     uuid.UUID
+		opponentID *uuid.UUID
+		limit      int
+	}{
+		{&history.HeadToHead, match.HomeTeamID, &match.AwayTeamID, headToHeadLength},
+		{&history.HomeRecent, match.HomeTeamID, nil, formLength},
+		{&history.AwayRecent, match.AwayTeamID, nil, formLength},
+	} {
+		filter := repository.MatchFilter{
+			TeamID:     &part.teamID,
+			OpponentID: part.opponentID,
+			Status:     model.MatchStatusCompleted,
+			To:         &match.MatchDatetime,
+			Projection: repository.Projection{Columns: []string{"home_team_id", "away_team_id", "home_score", "away_score", "match_datetime"}},
+		}
+		matches, err := s.matchRepo.FindAll(filter, 0, part.limit, "match_datetime", "desc")
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to fetch match history for prediction", "error", err, "match_id", matchID)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+		slices.Reverse(matches)
+		*part.matches = matches
+	}
+
+	odds := s.strategy.Predict(history)
+	resp := &dto.MatchPredictionResponse{
+		MatchID:  match.ID.String(),
+		Strategy: s.strategy.Name(),
+		HomeWin:  roundOdds(odds.HomeWin),
+		Draw:     roundOdds(odds.Draw),
+		AwayWin:  roundOdds(odds.AwayWin),
+		HomeForm: string(outcomeLetters(history.HomeRecent, match.HomeTeamID)),
+		AwayForm: string(outcomeLetters(history.AwayRecent, match.AwayTeamID)),
+	}
+	if match.HomeTeam != nil {
+		resp.HomeTeam = toTeamResponse(*match.HomeTeam)
+	}
+	if match.AwayTeam != nil {
+		resp.AwayTeam = toTeamResponse(*match.AwayTeam)
+	}
+
+	resp.Predicted = outcomeHomeWin
+	if odds.Draw > odds.HomeWin && odds.Draw >= odds.AwayWin {
+		resp.Predicted = outcomeDraw
+	} else if odds.AwayWin > odds.HomeWin {
+		resp.Predicted = outcomeAwayWin
+	}
+
+	resp.HeadToHead.Played = len(history.HeadToHead)
+	for _, meeting := range history.HeadToHead {
+		switch scoreWinner(meeting) {
+		case match.HomeTeamID:
+			resp.HeadToHead.HomeTeamWins++
+		case uuid.Nil:
+			resp.HeadToHead.Draws++
+		default:
+			resp.HeadToHead.AwayTeamWins++
+		}
+	}
+
+	if match.Status == model.MatchStatusCompleted {
+		resp.Actual = outcome(*match)
+		correct := resp.Actual == resp.Predicted
+		resp.Correct = &correct
+	}
+	return resp, nil
+}
+
+// formAndHeadToHead is the default PredictionStrategy. It starts from the usual shares of
+// home wins, draws and away wins, worth priorWeight matches, and adds one match of evidence
+// for each latest result of either team and each latest meeting of the two: a home team win
+// or an away team loss counts towards a home win, and so on.
+type formAndHeadToHead struct{}
+
+// predictionPrior are the shares of the outcomes of a match without any history of its
+// teams, with the usual home advantage.
+var predictionPrior = PredictionOdds{HomeWin: 0.45, Draw: 0.27, AwayWin: 0.28}
+
+// priorWeight is the number of matches the prior is worth next to the teams' results.
+const priorWeight = 4
+
+// NewFormAndHeadToHeadStrategy returns the default PredictionStrategy, which weighs the
+// recent form of both teams and their latest meetings.
+func NewFormAndHeadToHeadStrategy() PredictionStrategy {
+	return formAndHeadToHead{}
+}
+
+// Name returns "form_and_head_to_head".
+func (formAndHeadToHead) Name() string { return "form_and_head_to_head" }
+
+// Predict returns the prior shares updated with the teams' results.
+func (formAndHeadToHead) Predict(history PredictionHistory) PredictionOdds {
+	home, away := history.Match.HomeTeamID, history.Match.AwayTeamID
+	odds := PredictionOdds{
+		HomeWin: predictionPrior.HomeWin * priorWeight,
+		Draw:    predictionPrior.Draw * priorWeight,
+		AwayWin: predictionPrior.AwayWin * priorWeight,
+	}
+	count := func(matches []model.Match, teamID uuid.UUID, win, loss *float64) {
+		for _, match := range matches {
+			switch scoreWinner(match) {
+			case teamID:
+				*win++
+			case uuid.Nil:
+				odds.Draw++
+			default:
+				*loss++
+			}
+		}
+	}
+	count(history.HomeRecent, home, &odds.HomeWin, &odds.AwayWin)
+	count(history.AwayRecent, away, &odds.AwayWin, &odds.HomeWin)
+	count(history.HeadToHead, home, &odds.HomeWin, &odds.AwayWin)
+
+	total := odds.HomeWin + odds.Draw + odds.AwayWin
+	return PredictionOdds{HomeWin: odds.HomeWin / total, Draw: odds.Draw / total, AwayWin: odds.AwayWin / total}
+}
+
+// scoreWinner returns the team that won a match on the score, or uuid.Nil for a draw.
+func scoreWinner(match model.Match) uuid.UUID {
+	switch {
+	case match.HomeScore > match.AwayScore:
+		return match.HomeTeamID
+	case match.AwayScore > match.HomeScore:
+		return match.AwayTeamID
+	default:
+		return uuid.Nil
+	}
+}
+
+// outcome returns the outcome of a completed match on the score.
+func outcome(match model.Match) string {
+	switch scoreWinner(match) {
+	case match.HomeTeamID:
+		return outcomeHomeWin
+	case uuid.Nil:
+		return outcomeDraw
+	default:
+		return outcomeAwayWin
+	}
+}
+
+// outcomeLetters writes the results of matches on the score for teamID as a form string.
+func outcomeLetters(matches []model.Match, teamID uuid.UUID) []byte {
+	letters := make([]byte, len(matches))
+	for i, match := range matches {
+		switch scoreWinner(match) {
+		case teamID:
+			letters[i] = resultWin
+		case uuid.Nil:
+			letters[i] = resultDraw
+		default:
+			letters[i] = resultLoss
+		}
+	}
+	return letters
+}
+
+// roundOdds rounds a probability to three decimals.
+func roundOdds(p float64) float64 {
+	return math.Round(p*1000) / 1000
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestFormAndHeadToHeadStrategy_Predict(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	match := model.Match{HomeTeamID: persija.ID, AwayTeamID: persib.ID}
+	strategy := NewFormAndHeadToHeadStrategy()
+
+	t.Run("no history gives the prior", func(t *testing.T) {
+		odds := strategy.Predict(PredictionHistory{Match: match})
+		assert.InDelta(t, 0.45, odds.HomeWin, 1e-9)
+		assert.InDelta(t, 0.27, odds.Draw, 1e-9)
+		assert.InDelta(t, 0.28, odds.AwayWin, 1e-9)
+	})
+
+	t.Run("away team in form favours an away win", func(t *testing.T) {
+		odds := strategy.Predict(PredictionHistory{
+			Match:      match,
+			HomeRecent: []model.Match{completedMatch(persija, persib, 0, 1), completedMatch(persib, persija, 2, 0)},
+			AwayRecent: []model.Match{completedMatch(persija, persib, 0, 1), completedMatch(persib, persija, 2, 0)},
+			HeadToHead: []model.Match{completedMatch(persija, persib, 0, 1), completedMatch(persib, persija, 2, 0)},
+		})
+		assert.Greater(t, odds.AwayWin, odds.HomeWin)
+		assert.InDelta(t, 1, odds.HomeWin+odds.Draw+odds.AwayWin, 1e-9)
+	})
+}
+
+func TestPredictionService_GetPrediction(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	kickoff := time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC)
+
+	newMatch := func(status string, homeScore, awayScore int) *model.Match {
+		match := completedMatch(persija, persib, homeScore, awayScore)
+		match.Status = status
+		match.MatchDatetime = kickoff
+		return &match
+	}
+	// History as the repository returns it, latest first.
+	expectHistory := func(mr *mocks.MockMatchRepository) {
+		mr.EXPECT().FindAll(mock.MatchedBy(func(f repository.MatchFilter) bool {
+			return f.OpponentID != nil && *f.TeamID == persija.ID && *f.OpponentID == persib.ID && f.Status == model.MatchStatusCompleted && f.To.Equal(kickoff)
+		}), 0, headToHeadLength, "match_datetime", "desc").Return([]model.Match{completedMatch(persija, persib, 2, 0)}, nil)
+		mr.EXPECT().FindAll(mock.MatchedBy(func(f repository.MatchFilter) bool {
+			return f.OpponentID == nil && *f.TeamID == persija.ID
+		}), 0, formLength, "match_datetime", "desc").Return([]model.Match{
+			completedMatch(persija, arema, 1, 1),
+			completedMatch(arema, persija, 0, 1),
+			completedMatch(persija, persib, 2, 0),
+		}, nil)
+		mr.EXPECT().FindAll(mock.MatchedBy(func(f repository.MatchFilter) bool {
+			return f.OpponentID == nil && *f.TeamID == persib.ID
+		}), 0, formLength, "match_datetime", "desc").Return([]model.Match{
+			completedMatch(persib, arema, 0, 0),
+			completedMatch(persija, persib, 2, 0),
+		}, nil)
+	}
+	isFalse := false
+
+	tests := []struct {
+		name        string
+		match       *model.Match
+		setup       func(*mocks.MockMatchRepository, *model.Match)
+		wantErr     bool
+		errContains string
+		want        *dto.MatchPredictionResponse
+	}{
+		{
+			name:  "scheduled match",
+			match: newMatch(model.MatchStatusScheduled, 0, 0),
+			setup: func(mr *mocks.MockMatchRepository, match *model.Match) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				expectHistory(mr)
+			},
+			want: &dto.MatchPredictionResponse{
+				Strategy: "form_and_head_to_head", HomeWin: 0.58, Draw: 0.308, AwayWin: 0.112, Predicted: "home_win",
+				HeadToHead: dto.HeadToHeadRecord{Played: 1, HomeTeamWins: 1},
+				HomeForm:   "WWD", AwayForm: "LD",
+			},
+		},
+		{
+			name:  "completed match against the prediction",
+			match: newMatch(model.MatchStatusCompleted, 0, 0),
+			setup: func(mr *mocks.MockMatchRepository, match *model.Match) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				expectHistory(mr)
+			},
+			want: &dto.MatchPredictionResponse{
+				Strategy: "form_and_head_to_head", HomeWin: 0.58, Draw: 0.308, AwayWin: 0.112, Predicted: "home_win",
+				HeadToHead: dto.HeadToHeadRecord{Played: 1, HomeTeamWins: 1},
+				HomeForm:   "WWD", AwayForm: "LD", Actual: "draw", Correct: &isFalse,
+			},
+		},
+		{
+			name:  "match not found",
+			match: newMatch(model.MatchStatusScheduled, 0, 0),
+			setup: func(mr *mocks.MockMatchRepository, match *model.Match) {
+				mr.EXPECT().FindByID(match.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Match not found",
+		},
+		{
+			name:  "db error",
+			match: newMatch(model.MatchStatusScheduled, 0, 0),
+			setup: func(mr *mocks.MockMatchRepository, match *model.Match) {
+				mr.EXPECT().FindByID(match.ID).Return(match, nil)
+				mr.EXPECT().FindAll(mock.Anything, 0, headToHeadLength, "match_datetime", "desc").Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			tt.setup(matchRepo, tt.match)
+			svc := NewPredictionService(matchRepo, NewFormAndHeadToHeadStrategy())
+
+			prediction, err := svc.GetPrediction(context.Background(), tt.match.ID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Persija Jakarta", prediction.HomeTeam.Name)
+			assert.Equal(t, "Persib Bandung", prediction.AwayTeam.Name)
+			tt.want.MatchID = tt.match.ID.String()
+			tt.want.HomeTeam, tt.want.AwayTeam = prediction.HomeTeam, prediction.AwayTeam
+			assert.Equal(t, tt.want, prediction)
+		})
+	}
+}
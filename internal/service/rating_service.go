@@ -0,0 +1,212 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// initialRating is the Elo rating of a team before its first completed match.
+const initialRating = 1500
+
+// RatingService defines the contract for Elo team ratings.
+type RatingService interface {
+	GetRatings(ctx context.Context) ([]dto.TeamRatingResponse, error)
+	GetHistory(ctx context.Context, query dto.RatingHistoryQuery) (*dto.RatingHistoryResponse, error)
+	Recalculate(ctx context.Context) error
+	HandleEvent(ctx context.Context, event events.Event) error
+}
+
+type ratingService struct {
+	matchRepo  repository.MatchRepository
+	ratingRepo repository.TeamRatingRepository
+	teamRepo   repository.TeamRepository
+	kFactor    float64
+
+	// mu keeps replays from overlapping, as each one replaces every rating.
+	mu sync.Mutex
+}
+
+// NewRatingService creates a new RatingService. kFactor is the most rating points a team
+// can win or lose in one match.
+func NewRatingService(matchRepo repository.MatchRepository, ratingRepo repository.TeamRatingRepository, teamRepo repository.TeamRepository, kFactor int) RatingService {
+	return &ratingService{matchRepo: matchRepo, ratingRepo: ratingRepo, teamRepo: teamRepo, kFactor: float64(kFactor)}
+}
+
+// GetRatings returns the current rating of every team, highest first. Teams without a
+// completed match are listed with the starting rating.
+func (s *ratingService) GetRatings(ctx context.Context) ([]dto.TeamRatingResponse, error) {
+	current, err := s.ratingRepo.FindCurrent()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch team ratings", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	// A limit of -1 lists every team
+	teams, err := s.teamRepo.FindAll(repository.TeamFilter{}, 0, -1, "name", "asc")
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch teams for ratings", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	byTeam := make(map[uuid.UUID]repository.CurrentRating, len(current))
+	for _, rating := range current {
+		byTeam[rating.TeamID] = rating
+	}
+	ratings := make([]dto.TeamRatingResponse, 0, len(teams))
+	for _, team := range teams {
+		row := dto.TeamRatingResponse{Team: toTeamResponse(team), Rating: initialRating}
+		if rating, ok := byTeam[team.ID]; ok {
+			row.Rating = roundRating(rating.Rating)
+			row.Played = rating.Played
+			row.LastChange = roundRating(rating.Change)
+		}
+		ratings = append(ratings, row)
+	}
+	// Teams are listed by name, which breaks ties
+	slices.SortStableFunc(ratings, func(a, b dto.TeamRatingResponse) int {
+		return cmp.Compare(b.Rating, a.Rating)
+	})
+	for i := range ratings {
+		ratings[i].Position = i + 1
+	}
+	return ratings, nil
+}
+
+// GetHistory returns a team's rating after each of its completed matches, oldest first.
+func (s *ratingService) GetHistory(ctx context.Context, query dto.RatingHistoryQuery) (*dto.RatingHistoryResponse, error) {
+	teamID, err := uuid.Parse(query.TeamID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid team_id format")
+	}
+
+	team, err := s.teamRepo.FindByID(teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	ratings, err := s.ratingRepo.FindByTeamID(teamID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch rating history", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.RatingHistoryResponse{
+		Team:    toTeamResponse(*team),
+		Rating:  initialRating,
+		Matches: make([]dto.RatingHistoryEntry, len(ratings)),
+	}
+	for i, rating := range ratings {
+		resp.Matches[i] = dto.RatingHistoryEntry{
+			MatchID:    rating.MatchID.String(),
+			OpponentID: rating.OpponentID.String(),
+			PlayedAt:   timefmt.Format(rating.PlayedAt),
+			Rating:     roundRating(rating.Rating),
+			Change:     roundRating(rating.Change),
+		}
+		resp.Rating = resp.Matches[i].Rating
+	}
+	return resp, nil
+}
+
+// Recalculate replays every completed match in kick-off order, archived ones included, and
+// replaces all ratings with the result. Replaying from the start keeps the ratings after a
+// corrected or undone result right as well as the ratings of the match itself.
+//
+// Each match moves the ratings of its teams by kFactor times the difference between the
+// actual result, 1 for a win, 0.5 for a draw and 0 for a loss, and the expected one,
+// 1 / (1 + 10^((opponent - team) / 400)). The score decides, not a penalty shootout.
+func (s *ratingService) Recalculate(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matches []model.Match
+	for _, archived := range []bool{false, true} {
+		filter := repository.MatchFilter{
+			Status:     model.MatchStatusCompleted,
+			Archived:   archived,
+			Projection: repository.Projection{Columns: []string{"home_team_id", "away_team_id", "home_score", "away_score", "match_datetime"}},
+		}
+		found, err := s.matchRepo.FindAll(filter, 0, -1, "match_datetime", "asc")
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to fetch matches for ratings", "error", err)
+			return errs.ErrInternal("Internal server error")
+		}
+		matches = append(matches, found...)
+	}
+	slices.SortStableFunc(matches, func(a, b model.Match) int {
+		return cmp.Or(a.MatchDatetime.Compare(b.MatchDatetime), cmp.Compare(a.ID.String(), b.ID.String()))
+	})
+
+	current := make(map[uuid.UUID]float64)
+	rating := func(teamID uuid.UUID) float64 {
+		if r, ok := current[teamID]; ok {
+			return r
+		}
+		return initialRating
+	}
+	ratings := make([]model.TeamRating, 0, 2*len(matches))
+	for _, match := range matches {
+		home, away := rating(match.HomeTeamID), rating(match.AwayTeamID)
+		expected := 1 / (1 + math.Pow(10, (away-home)/400))
+		actual := 0.5
+		switch scoreWinner(match) {
+		case match.HomeTeamID:
+			actual = 1
+		case match.AwayTeamID:
+			actual = 0
+		}
+		change := s.kFactor * (actual - expected)
+		current[match.HomeTeamID], current[match.AwayTeamID] = home+change, away-change
+
+		ratings = append(ratings,
+			model.TeamRating{MatchID: match.ID, TeamID: match.HomeTeamID, OpponentID: match.AwayTeamID, PlayedAt: match.MatchDatetime, Rating: home + change, Change: change},
+			model.TeamRating{MatchID: match.ID, TeamID: match.AwayTeamID, OpponentID: match.HomeTeamID, PlayedAt: match.MatchDatetime, Rating: away - change, Change: -change},
+		)
+	}
+
+	if err := s.ratingRepo.ReplaceAll(ratings); err != nil {
+		slog.ErrorContext(ctx, "failed to store team ratings", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// HandleEvent replays the ratings after every completed, corrected, undone or deleted result.
+// Other events are ignored.
+func (s *ratingService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case FeedMatchCompleted, FeedMatchResultUpdated:
+		return s.Recalculate(ctx)
+	case FeedMatchStatusChanged:
+		if change, ok := event.Data.(dto.MatchStatusChangedEvent); ok && change.PreviousStatus == model.MatchStatusCompleted {
+			return s.Recalculate(ctx)
+		}
+	case FeedMatchDeleted:
+		if deleted, ok := event.Data.(dto.MatchDeletedEvent); ok && deleted.Status == model.MatchStatusCompleted {
+			return s.Recalculate(ctx)
+		}
+	}
+	return nil
+}
+
+// roundRating rounds a rating or a change in rating to one decimal.
+func roundRating(rating float64) float64 {
+	return math.Round(rating*10) / 10
+}
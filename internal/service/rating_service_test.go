@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestRatingService(t *testing.T) (RatingService, *mocks.MockMatchRepository, *mocks.MockTeamRatingRepository, *mocks.MockTeamRepository) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	ratingRepo := mocks.NewMockTeamRatingRepository(t)
+	teamRepo := mocks.NewMockTeamRepository(t)
+	return NewRatingService(matchRepo, ratingRepo, teamRepo, 20), matchRepo, ratingRepo, teamRepo
+}
+
+func TestRatingService_Recalculate(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	kickoff := time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC)
+
+	latest := completedMatch(persija, persib, 1, 0)
+	latest.MatchDatetime = kickoff
+	// Archived matches are replayed too, in kick-off order
+	archived := completedMatch(arema, persib, 2, 0)
+	archived.MatchDatetime = kickoff.AddDate(-1, 0, 0)
+
+	svc, matchRepo, ratingRepo, _ := newTestRatingService(t)
+	matchRepo.EXPECT().FindAll(mock.MatchedBy(func(f repository.MatchFilter) bool {
+		return !f.Archived && f.Status == model.MatchStatusCompleted
+	}), 0, -1, "match_datetime", "asc").Return([]model.Match{latest}, nil)
+	matchRepo.EXPECT().FindAll(mock.MatchedBy(func(f repository.MatchFilter) bool {
+		return f.Archived && f.Status == model.MatchStatusCompleted
+	}), 0, -1, "match_datetime", "asc").Return([]model.Match{archived}, nil)
+
+	var stored []model.TeamRating
+	ratingRepo.EXPECT().ReplaceAll(mock.Anything).
+		Run(func(ratings []model.TeamRating) { stored = ratings }).
+		Return(nil)
+
+	require.NoError(t, svc.Recalculate(context.Background()))
+	require.Len(t, stored, 4)
+
+	// Equal ratings: the winner takes half the K factor
+	assert.Equal(t, archived.ID, stored[0].MatchID)
+	assert.Equal(t, arema.ID, stored[0].TeamID)
+	assert.Equal(t, persib.ID, stored[0].OpponentID)
+	assert.InDelta(t, 1510, stored[0].Rating, 1e-9)
+	assert.InDelta(t, 10, stored[0].Change, 1e-9)
+	assert.InDelta(t, 1490, stored[1].Rating, 1e-9)
+
+	// Persib were expected to lose, so Persija win a little less
+	assert.Equal(t, latest.ID, stored[2].MatchID)
+	assert.Equal(t, persija.ID, stored[2].TeamID)
+	assert.Equal(t, kickoff, stored[2].PlayedAt)
+	assert.InDelta(t, 9.712, stored[2].Change, 1e-3)
+	assert.InDelta(t, 1509.712, stored[2].Rating, 1e-3)
+	assert.Equal(t, persib.ID, stored[3].TeamID)
+	assert.InDelta(t, -9.712, stored[3].Change, 1e-3)
+	assert.InDelta(t, 1480.288, stored[3].Rating, 1e-3)
+}
+
+func TestRatingService_HandleEvent(t *testing.T) {
+	t.Run("undone result replays the ratings", func(t *testing.T) {
+		svc, matchRepo, ratingRepo, _ := newTestRatingService(t)
+		matchRepo.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return([]model.Match{}, nil).Twice()
+		ratingRepo.EXPECT().ReplaceAll([]model.TeamRating{}).Return(nil)
+
+		err := svc.HandleEvent(context.Background(), events.Event{
+			Type: FeedMatchStatusChanged,
+			Data: dto.MatchStatusChangedEvent{PreviousStatus: model.MatchStatusCompleted, Status: model.MatchStatusScheduled},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("other status changes are ignored", func(t *testing.T) {
+		svc, _, _, _ := newTestRatingService(t)
+
+		err := svc.HandleEvent(context.Background(), events.Event{
+			Type: FeedMatchStatusChanged,
+			Data: dto.MatchStatusChangedEvent{PreviousStatus: model.MatchStatusScheduled, Status: model.MatchStatusLive},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc, matchRepo, _, _ := newTestRatingService(t)
+		matchRepo.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return(nil, gorm.ErrInvalidDB)
+
+		err := svc.HandleEvent(context.Background(), events.Event{Type: FeedMatchCompleted, Data: dto.MatchResponse{}})
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Internal server error", appErr.Message)
+	})
+}
+
+func TestRatingService_GetRatings(t *testing.T) {
+	persija := model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+
+	svc, _, ratingRepo, teamRepo := newTestRatingService(t)
+	ratingRepo.EXPECT().FindCurrent().Return([]repository.CurrentRating{
+		{TeamID: persija.ID, Rating: 1509.712, Change: 9.712, Played: 1},
+		{TeamID: persib.ID, Rating: 1480.288, Change: -9.712, Played: 2},
+	}, nil)
+	teamRepo.EXPECT().FindAll(repository.TeamFilter{}, 0, -1, "name", "asc").Return([]model.Team{arema, persib, persija}, nil)
+
+	ratings, err := svc.GetRatings(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, ratings, 3)
+	assert.Equal(t, 1, ratings[0].Position)
+	assert.Equal(t, "Persija Jakarta", ratings[0].Team.Name)
+	assert.Equal(t, 1509.7, ratings[0].Rating)
+	assert.Equal(t, 9.7, ratings[0].LastChange)
+	assert.Equal(t, 1, ratings[0].Played)
+	// Teams without a completed match keep the starting rating
+	assert.Equal(t, "Arema FC", ratings[1].Team.Name)
+	assert.Equal(t, 1500.0, ratings[1].Rating)
+	assert.Equal(t, 0, ratings[1].Played)
+	assert.Equal(t, 3, ratings[2].Position)
+	assert.Equal(t, -9.7, ratings[2].LastChange)
+}
+
+func TestRatingService_GetHistory(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	opponentID, matchID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	kickoff := time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		teamID      string
+		setup       func(*mocks.MockTeamRatingRepository, *mocks.MockTeamRepository)
+		wantErr     bool
+		errContains string
+		want        *dto.RatingHistoryResponse
+	}{
+		{
+			name:   "ratings after each match",
+			teamID: persija.ID.String(),
+			setup: func(rr *mocks.MockTeamRatingRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				rr.EXPECT().FindByTeamID(persija.ID).Return([]model.TeamRating{
+					{MatchID: matchID, TeamID: persija.ID, OpponentID: opponentID, PlayedAt: kickoff, Rating: 1509.712, Change: 9.712},
+				}, nil)
+			},
+			want: &dto.RatingHistoryResponse{
+				Rating: 1509.7,
+				Matches: []dto.RatingHistoryEntry{
+					{MatchID: matchID.String(), OpponentID: opponentID.String(), PlayedAt: "2025-03-01T19:00:00.000Z", Rating: 1509.7, Change: 9.7},
+				},
+			},
+		},
+		{
+			name:   "team without matches",
+			teamID: persija.ID.String(),
+			setup: func(rr *mocks.MockTeamRatingRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				rr.EXPECT().FindByTeamID(persija.ID).Return(nil, nil)
+			},
+			want: &dto.RatingHistoryResponse{Rating: 1500, Matches: []dto.RatingHistoryEntry{}},
+		},
+		{
+			name:        "invalid team id",
+			teamID:      "not-a-uuid",
+			setup:       func(rr *mocks.MockTeamRatingRepository, tr *mocks.MockTeamRepository) {},
+			wantErr:     true,
+			errContains: "Invalid team_id format",
+		},
+		{
+			name:   "team not found",
+			teamID: persija.ID.String(),
+			setup: func(rr *mocks.MockTeamRatingRepository, tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByID(persija.ID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Team not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, ratingRepo, teamRepo := newTestRatingService(t)
+			tt.setup(ratingRepo, teamRepo)
+
+			history, err := svc.GetHistory(context.Background(), dto.RatingHistoryQuery{TeamID: tt.teamID})
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Persija Jakarta", history.Team.Name)
+			tt.want.Team = history.Team
+			assert.Equal(t, tt.want, history)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// RefereeService defines the contract for referee business logic.
+type RefereeService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.RefereeResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.RefereeResponse, error)
+	Create(ctx context.Context, req dto.CreateRefereeRequest) (*dto.RefereeResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateRefereeRequest) (*dto.RefereeResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetMatches(ctx context.Context, id uuid.UUID, pagination dto.PaginationQuery) ([]dto.RefereeMatchResponse, *response.PaginationMeta, error)
+}
+
+type refereeService struct {
+	refereeRepo  repository.RefereeRepository
+	officialRepo repository.MatchOfficialRepository
+
+	// location is the timezone local kick-off times are given in
+	location *time.Location
+}
+
+// NewRefereeService creates a new RefereeService instance.
+func NewRefereeService(refereeRepo repository.RefereeRepository, officialRepo repository.MatchOfficialRepository, location *time.Location) RefereeService {
+	return &refereeService{
+		refereeRepo:  refereeRepo,
+		officialRepo: officialRepo,
+		location:     location,
+	}
+}
+
+func (s *refereeService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.RefereeResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	referees, err := s.refereeRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch referees", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.refereeRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count referees", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	refereeResponses := make([]dto.RefereeResponse, len(referees))
+	for i, referee := range referees {
+		refereeResponses[i] = toRefereeResponse(referee)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return refereeResponses, meta, nil
+}
+
+func (s *refereeService) GetByID(ctx context.Context, id uuid.UUID) (*dto.RefereeResponse, error) {
+	referee, err := s.refereeRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Referee not found").WithCode(CodeRefereeNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch referee", "error", err, "referee_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toRefereeResponse(*referee)
+	return &resp, nil
+}
+
+func (s *refereeService) Create(ctx context.Context, req dto.CreateRefereeRequest) (*dto.RefereeResponse, error) {
+	referee := model.Referee{
+		Name:    req.Name,
+		Country: req.Country,
+	}
+
+	if err := s.refereeRepo.Create(&referee); err != nil {
+		slog.ErrorContext(ctx, "failed to create referee", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toRefereeResponse(referee)
+	return &resp, nil
+}
+
+func (s *refereeService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateRefereeRequest) (*dto.RefereeResponse, error) {
+	referee, err := s.refereeRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Referee not found").WithCode(CodeRefereeNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch referee for update", "error", err, "referee_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	referee.Name = req.Name
+	referee.Country = req.Country
+
+	if err := s.refereeRepo.Update(referee); err != nil {
+		slog.ErrorContext(ctx, "failed to update referee", "error", err, "referee_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toRefereeResponse(*referee)
+	return &resp, nil
+}
+
+// Delete soft-deletes a referee. A referee still assigned to matches cannot be deleted.
+func (s *refereeService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.refereeRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Referee not found").WithCode(CodeRefereeNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch referee for delete", "error", err, "referee_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	assignments, err := s.officialRepo.CountByRefereeID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count assignments for referee delete", "error", err, "referee_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	if assignments > 0 {
+		return errs.ErrConflict(fmt.Sprintf("Referee is assigned to %d matches; reassign them first", assignments)).WithCode(CodeRefereeAssigned)
+	}
+
+	if err := s.refereeRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete referee", "error", err, "referee_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// GetMatches returns the matches the referee is assigned to, with the role held in each,
+// latest kick-off first.
+func (s *refereeService) GetMatches(ctx context.Context, id uuid.UUID, pagination dto.PaginationQuery) ([]dto.RefereeMatchResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	if _, err := s.refereeRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errs.ErrNotFound("Referee not found").WithCode(CodeRefereeNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch referee for matches", "error", err, "referee_id", id)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	assignments, err := s.officialRepo.FindByRefereeID(id, pagination.GetOffset(), pagination.PerPage)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch referee matches", "error", err, "referee_id", id)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.officialRepo.CountByRefereeID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count referee matches", "error", err, "referee_id", id)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	matches := make([]dto.RefereeMatchResponse, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.Match == nil {
+			continue
+		}
+		matches = append(matches, dto.RefereeMatchResponse{
+			Role:  assignment.Role,
+			Match: toMatchResponse(*assignment.Match, s.location),
+		})
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return matches, meta, nil
+}
+
+// toRefereeResponse converts a model.Referee to dto.RefereeResponse.
+func toRefereeResponse(referee model.Referee) dto.RefereeResponse {
+	return dto.RefereeResponse{
+		ID:        referee.ID.String(),
+		Name:      referee.Name,
+		Country:   referee.Country,
+		CreatedAt: timefmt.Format(referee.CreatedAt),
+		UpdatedAt: timefmt.Format(referee.UpdatedAt),
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRefereeService_Delete(t *testing.T) {
+	refereeID := uuid.Must(uuid.NewV7())
+	referee := &model.Referee{Base: model.Base{ID: refereeID}, Name: "Thoriq Alkatiri"}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockRefereeRepository, *mocks.MockMatchOfficialRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				rr.EXPECT().FindByID(refereeID).Return(referee, nil)
+				or.EXPECT().CountByRefereeID(refereeID).Return(int64(0), nil)
+				rr.EXPECT().Delete(refereeID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "assigned to matches",
+			setup: func(rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				rr.EXPECT().FindByID(refereeID).Return(referee, nil)
+				or.EXPECT().CountByRefereeID(refereeID).Return(int64(3), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "assigned to 3 matches",
+		},
+		{
+			name: "not found",
+			setup: func(rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				rr.EXPECT().FindByID(refereeID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Referee not found",
+		},
+		{
+			name: "db error",
+			setup: func(rr *mocks.MockRefereeRepository, or *mocks.MockMatchOfficialRepository) {
+				rr.EXPECT().FindByID(refereeID).Return(referee, nil)
+				or.EXPECT().CountByRefereeID(refereeID).Return(int64(0), gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refereeRepo := mocks.NewMockRefereeRepository(t)
+			officialRepo := mocks.NewMockMatchOfficialRepository(t)
+			tt.setup(refereeRepo, officialRepo)
+			svc := NewRefereeService(refereeRepo, officialRepo, time.UTC)
+
+			err := svc.Delete(context.Background(), refereeID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRefereeService_GetMatches(t *testing.T) {
+	refereeID := uuid.Must(uuid.NewV7())
+	referee := &model.Referee{Base: model.Base{ID: refereeID}, Name: "Thoriq Alkatiri"}
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	match := completedMatch(persija, persib, 2, 1)
+	pagination := dto.PaginationQuery{Page: 1, PerPage: 10}
+
+	t.Run("assignments with roles", func(t *testing.T) {
+		refereeRepo := mocks.NewMockRefereeRepository(t)
+		officialRepo := mocks.NewMockMatchOfficialRepository(t)
+		refereeRepo.EXPECT().FindByID(refereeID).Return(referee, nil)
+		officialRepo.EXPECT().FindByRefereeID(refereeID, 0, 10).Return([]model.MatchOfficial{
+			{MatchID: match.ID, RefereeID: refereeID, Role: model.OfficialRoleAssistant, Match: &match},
+		}, nil)
+		officialRepo.EXPECT().CountByRefereeID(refereeID).Return(int64(1), nil)
+		svc := NewRefereeService(refereeRepo, officialRepo, time.UTC)
+
+		matches, meta, err := svc.GetMatches(context.Background(), refereeID, pagination)
+
+		assert.NoError(t, err)
+		assert.Len(t, matches, 1)
+		assert.Equal(t, model.OfficialRoleAssistant, matches[0].Role)
+		assert.Equal(t, "Persija Jakarta", matches[0].Match.HomeTeam.Name)
+		assert.Equal(t, int64(1), meta.Total)
+		assert.Equal(t, 1, meta.TotalPages)
+	})
+
+	t.Run("referee not found", func(t *testing.T) {
+		refereeRepo := mocks.NewMockRefereeRepository(t)
+		officialRepo := mocks.NewMockMatchOfficialRepository(t)
+		refereeRepo.EXPECT().FindByID(refereeID).Return(nil, gorm.ErrRecordNotFound)
+		svc := NewRefereeService(refereeRepo, officialRepo, time.UTC)
+
+		_, _, err := svc.GetMatches(context.Background(), refereeID, pagination)
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+	})
+}
@@ -1,11 +1,17 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
@@ -14,55 +20,68 @@ import (
 
 // ReportService defines the contract for match report business logic.
 type ReportService interface {
-	GetMatchReports(pagination dto.PaginationQuery) ([]dto.MatchReportListItem, *response.PaginationMeta, error)
-	GetMatchReportByID(matchID uuid.UUID) (*dto.MatchReportResponse, error)
+	GetMatchReports(ctx context.Context, pagination dto.PaginationQuery, seasonFilter dto.SeasonFilterQuery) ([]dto.MatchReportListItem, *response.PaginationMeta, error)
+	GetAllMatchReports(ctx context.Context, seasonFilter dto.SeasonFilterQuery) ([]dto.MatchReportListItem, error)
+	GetMatchReportByID(ctx context.Context, matchID uuid.UUID) (*dto.MatchReportResponse, error)
+	GetRoundReport(ctx context.Context, round int, seasonFilter dto.SeasonFilterQuery) (*dto.RoundReportResponse, error)
+	GetGoalsByTeam(ctx context.Context, query dto.SeasonReportQuery) (*dto.GoalsByTeamResponse, error)
+	GetAttendance(ctx context.Context, seasonFilter dto.SeasonFilterQuery) (*dto.AttendanceReportResponse, error)
+	GetManOfTheMatchLeaderboard(ctx context.Context, query dto.SeasonReportQuery) (*dto.ManOfTheMatchLeaderboardResponse, error)
 }
 
 type reportService struct {
-	matchRepo repository.MatchRepository
-	goalRepo  repository.GoalRepository
+	matchRepo    repository.MatchRepository
+	eventRepo    repository.MatchEventRepository
+	lineupRepo   repository.MatchLineupRepository
+	officialRepo repository.MatchOfficialRepository
+	// location is the timezone local kick-off times are reported in
+	location *time.Location
 }
 
 // NewReportService creates a new ReportService instance.
-func NewReportService(matchRepo repository.MatchRepository, goalRepo repository.GoalRepository) ReportService {
+func NewReportService(
+	matchRepo repository.MatchRepository,
+	eventRepo repository.MatchEventRepository,
+	lineupRepo repository.MatchLineupRepository,
+	officialRepo repository.MatchOfficialRepository,
+	location *time.Location,
+) ReportService {
 	return &reportService{
-		matchRepo: matchRepo,
-		goalRepo:  goalRepo,
+		matchRepo:    matchRepo,
+		eventRepo:    eventRepo,
+		lineupRepo:   lineupRepo,
+		officialRepo: officialRepo,
+		location:     location,
 	}
 }
 
-// GetMatchReports returns a paginated list of all completed match reports.
-func (s *reportService) GetMatchReports(pagination dto.PaginationQuery) ([]dto.MatchReportListItem, *response.PaginationMeta, error) {
+// GetMatchReports returns a paginated list of completed match reports, optionally limited to one season,
+// with the total wins of both teams.
+func (s *reportService) GetMatchReports(ctx context.Context, pagination dto.PaginationQuery, seasonFilter dto.SeasonFilterQuery) ([]dto.MatchReportListItem, *response.PaginationMeta, error) {
 	pagination.Sanitize()
 
-	matches, err := s.matchRepo.FindCompletedMatches(pagination.GetOffset(), pagination.PerPage)
+	filter, err := parseSeasonFilter(seasonFilter)
 	if err != nil {
-		slog.Error("failed to fetch completed matches for report", "error", err)
+		return nil, nil, err
+	}
+
+	matches, err := s.matchRepo.FindCompletedWithWins(filter, pagination.GetOffset(), pagination.PerPage)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for report", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
-	total, err := s.matchRepo.CountCompletedMatches()
+	total, err := s.matchRepo.CountCompletedMatches(filter)
 	if err != nil {
-		slog.Error("failed to count completed matches", "error", err)
+		slog.ErrorContext(ctx, "failed to count completed matches", "error", err)
 		return nil, nil, errs.ErrInternal("Internal server error")
 	}
 
 	items := make([]dto.MatchReportListItem, len(matches))
 	for i, match := range matches {
-		items[i] = dto.MatchReportListItem{
-			MatchID:     match.ID.String(),
-			MatchDate:   match.MatchDate,
-			MatchTime:   match.MatchTime,
-			HomeScore:   match.HomeScore,
-			AwayScore:   match.AwayScore,
-			MatchResult: computeMatchResult(match.HomeScore, match.AwayScore),
-		}
-		if match.HomeTeam != nil {
-			items[i].HomeTeam = toTeamResponse(*match.HomeTeam)
-		}
-		if match.AwayTeam != nil {
-			items[i].AwayTeam = toTeamResponse(*match.AwayTeam)
-		}
+		items[i] = toMatchReportListItem(match.Match, s.location)
+		items[i].HomeTeamTotalWins = match.HomeTeamWins
+		items[i].AwayTeamTotalWins = match.AwayTeamWins
 	}
 
 	totalPages := int(total) / pagination.PerPage
@@ -80,24 +99,235 @@ func (s *reportService) GetMatchReports(pagination dto.PaginationQuery) ([]dto.M
 	return items, meta, nil
 }
 
+// GetAllMatchReports returns every completed match report (unpaginated, oldest first),
+// optionally limited to one season. Used for file exports, which leave out the teams' total wins.
+func (s *reportService) GetAllMatchReports(ctx context.Context, seasonFilter dto.SeasonFilterQuery) ([]dto.MatchReportListItem, error) {
+	filter, err := parseSeasonFilter(seasonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.FindAllCompleted(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for report export", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	items := make([]dto.MatchReportListItem, len(matches))
+	for i, match := range matches {
+		items[i] = toMatchReportListItem(match, s.location)
+	}
+	return items, nil
+}
+
+// GetRoundReport summarizes the results of a round, optionally in one season; without a
+// season the round is combined across seasons. Fails when no match is scheduled in the round.
+func (s *reportService) GetRoundReport(ctx context.Context, round int, seasonFilter dto.SeasonFilterQuery) (*dto.RoundReportResponse, error) {
+	filter, err := parseSeasonFilter(seasonFilter)
+	if err != nil {
+		return nil, err
+	}
+	filter.Round = &round
+
+	total, err := s.matchRepo.Count(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count round matches", "error", err, "round", round)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	if total == 0 {
+		return nil, errs.ErrNotFound(fmt.Sprintf("No matches are scheduled in round %d", round)).WithCode(CodeRoundNotFound)
+	}
+
+	matches, err := s.matchRepo.FindAllCompleted(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for round report", "error", err, "round", round)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.RoundReportResponse{
+		Round:     round,
+		SeasonID:  seasonFilter.SeasonID,
+		Matches:   int(total),
+		Completed: len(matches),
+		Results:   make([]dto.MatchReportListItem, len(matches)),
+	}
+	for i, match := range matches {
+		resp.Goals += match.HomeScore + match.AwayScore
+		switch match.Winner() {
+		case uuid.Nil:
+			resp.Draws++
+		case match.HomeTeamID:
+			resp.HomeWins++
+		default:
+			resp.AwayWins++
+		}
+		resp.Results[i] = toMatchReportListItem(match, s.location)
+	}
+	return resp, nil
+}
+
+// GetGoalsByTeam returns the goals every team scored and conceded per round of a season,
+// from its completed matches, as one series per team aligned with the season's rounds.
+func (s *reportService) GetGoalsByTeam(ctx context.Context, query dto.SeasonReportQuery) (*dto.GoalsByTeamResponse, error) {
+	filter, err := parseSeasonFilter(dto.SeasonFilterQuery{SeasonID: query.SeasonID})
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := s.matchRepo.GoalsByTeamAndRound(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to total goals by team and round", "error", err, "season_id", query.SeasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	rounds := []int{}
+	for _, g := range goals {
+		if !slices.Contains(rounds, g.Round) {
+			rounds = append(rounds, g.Round)
+		}
+	}
+	slices.Sort(rounds)
+
+	resp := &dto.GoalsByTeamResponse{SeasonID: query.SeasonID, Rounds: rounds, Teams: []dto.TeamGoalSeries{}}
+	// Rows come ordered by team, so a team's rounds are consecutive
+	for _, g := range goals {
+		last := len(resp.Teams) - 1
+		if last < 0 || resp.Teams[last].TeamID != g.TeamID.String() {
+			resp.Teams = append(resp.Teams, dto.TeamGoalSeries{
+				TeamID:   g.TeamID.String(),
+				TeamName: g.TeamName,
+				Scored:   make([]*int, len(rounds)),
+				Conceded: make([]*int, len(rounds)),
+			})
+			last++
+		}
+		series := &resp.Teams[last]
+		i := slices.Index(rounds, g.Round)
+		series.Scored[i], series.Conceded[i] = &g.Scored, &g.Conceded
+		series.TotalScored += g.Scored
+		series.TotalConceded += g.Conceded
+	}
+	return resp, nil
+}
+
+// GetManOfTheMatchLeaderboard ranks the players of a season by the man of the match awards
+// of its completed matches, most first. Players with as many awards share a position.
+func (s *reportService) GetManOfTheMatchLeaderboard(ctx context.Context, query dto.SeasonReportQuery) (*dto.ManOfTheMatchLeaderboardResponse, error) {
+	filter, err := parseSeasonFilter(dto.SeasonFilterQuery{SeasonID: query.SeasonID})
+	if err != nil {
+		return nil, err
+	}
+
+	awards, err := s.matchRepo.CountManOfTheMatchAwards(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count man of the match awards", "error", err, "season_id", query.SeasonID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.ManOfTheMatchLeaderboardResponse{
+		SeasonID: query.SeasonID,
+		Players:  make([]dto.ManOfTheMatchLeaderEntry, len(awards)),
+	}
+	for i, award := range awards {
+		position := i + 1
+		if i > 0 && award.Awards == awards[i-1].Awards {
+			position = resp.Players[i-1].Position
+		}
+		resp.Players[i] = dto.ManOfTheMatchLeaderEntry{
+			Position:   position,
+			PlayerID:   award.PlayerID.String(),
+			PlayerName: award.PlayerName,
+			TeamID:     award.TeamID.String(),
+			TeamName:   award.TeamName,
+			Awards:     award.Awards,
+		}
+	}
+	return resp, nil
+}
+
+// GetAttendance summarizes the attendance recorded at completed matches, optionally limited
+// to one season: the overall average, and the averages of every home team and stadium.
+func (s *reportService) GetAttendance(ctx context.Context, seasonFilter dto.SeasonFilterQuery) (*dto.AttendanceReportResponse, error) {
+	filter, err := parseSeasonFilter(seasonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.matchRepo.AttendanceByHomeTeam(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to total attendance by team", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	venues, err := s.matchRepo.AttendanceByVenue(filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to total attendance by venue", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.AttendanceReportResponse{
+		SeasonID: seasonFilter.SeasonID,
+		Teams:    make([]dto.TeamAttendance, len(teams)),
+		Venues:   make([]dto.VenueAttendance, len(venues)),
+	}
+	// Every match has one home team, so the teams add up to the overall figures
+	for i, team := range teams {
+		resp.Matches += team.Matches
+		resp.TotalAttendance += team.Total
+		resp.Teams[i] = dto.TeamAttendance{
+			TeamID:            team.ID.String(),
+			TeamName:          team.Name,
+			Matches:           team.Matches,
+			TotalAttendance:   team.Total,
+			AverageAttendance: averageAttendance(team.Total, team.Matches),
+			HighestAttendance: team.Highest,
+		}
+	}
+	resp.AverageAttendance = averageAttendance(resp.TotalAttendance, resp.Matches)
+	for i, venue := range venues {
+		resp.Venues[i] = dto.VenueAttendance{
+			VenueID:           venue.ID.String(),
+			VenueName:         venue.Name,
+			Capacity:          venue.Capacity,
+			Matches:           venue.Matches,
+			TotalAttendance:   venue.Total,
+			AverageAttendance: averageAttendance(venue.Total, venue.Matches),
+			HighestAttendance: venue.Highest,
+		}
+		if venue.Capacity > 0 {
+			occupancy := math.Round(float64(venue.Total)/float64(venue.Matches)/float64(venue.Capacity)*1000) / 10
+			resp.Venues[i].Occupancy = &occupancy
+		}
+	}
+	return resp, nil
+}
+
+// averageAttendance returns the attendance per match, rounded to the nearest spectator.
+func averageAttendance(total int64, matches int) int {
+	if matches == 0 {
+		return 0
+	}
+	return int(math.Round(float64(total) / float64(matches)))
+}
+
 // GetMatchReportByID returns a detailed report for a single completed match.
-// Includes: match result, goal list, top scorer, and accumulated total wins for both teams.
-func (s *reportService) GetMatchReportByID(matchID uuid.UUID) (*dto.MatchReportResponse, error) {
+// Includes: match result, goal list, event timeline, lineups, officials, top scorer, and accumulated total wins for both teams.
+func (s *reportService) GetMatchReportByID(ctx context.Context, matchID uuid.UUID) (*dto.MatchReportResponse, error) {
 	match, err := s.matchRepo.FindByIDWithDetails(matchID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Match not found")
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
 		}
-		slog.Error("failed to fetch match for report", "error", err, "match_id", matchID)
+		slog.ErrorContext(ctx, "failed to fetch match for report", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
-	if match.Status != "completed" {
-		return nil, errs.ErrBadRequest("Match has not been completed yet")
+	if match.Status != model.MatchStatusCompleted {
+		return nil, errs.ErrBadRequest("Match has not been completed yet").WithCode(CodeMatchNotCompleted)
 	}
 
-	// Build goal list for report
-	reportGoals := make([]dto.MatchReportGoal, len(match.Goals))
+	// Build goal list and full event timeline for report
+	reportGoals := make([]dto.MatchReportGoal, 0, len(match.Events))
+	reportEvents := make([]dto.MatchReportEvent, len(match.Events))
 	// Track goal counts per player for top scorer calculation
 	type playerGoalCount struct {
 		PlayerName string
@@ -106,31 +336,66 @@ func (s *reportService) GetMatchReportByID(matchID uuid.UUID) (*dto.MatchReportR
 	}
 	playerGoals := make(map[uuid.UUID]*playerGoalCount)
 
-	for i, goal := range match.Goals {
+	for i, event := range match.Events {
 		playerName := ""
 		teamName := ""
-		if goal.Player != nil {
-			playerName = goal.Player.Name
+		if event.Player != nil {
+			playerName = event.Player.Name
+		}
+		if event.Team != nil {
+			teamName = event.Team.Name
+		}
+
+		reportEvents[i] = dto.MatchReportEvent{
+			Type:          event.Type,
+			Marker:        event.GoalMarker(),
+			PlayerName:    playerName,
+			TeamName:      teamName,
+			Minute:        event.Minute,
+			AddedTime:     event.AddedTime,
+			DisplayMinute: event.DisplayMinute(),
 		}
-		if goal.Team != nil {
-			teamName = goal.Team.Name
+		if event.RelatedPlayer != nil {
+			reportEvents[i].RelatedPlayerName = event.RelatedPlayer.Name
 		}
 
-		reportGoals[i] = dto.MatchReportGoal{
-			PlayerName: playerName,
-			TeamName:   teamName,
-			Minute:     goal.Minute,
+		if !event.IsScoring() {
+			continue
 		}
 
+		// Own goals are credited to the opponent and never count towards the scorer's tally
+		if event.Type == model.EventOwnGoal {
+			reportGoals = append(reportGoals, dto.MatchReportGoal{
+				Type:          event.Type,
+				Marker:        event.GoalMarker(),
+				PlayerName:    playerName,
+				TeamName:      opponentName(match, event.TeamID),
+				Minute:        event.Minute,
+				AddedTime:     event.AddedTime,
+				DisplayMinute: event.DisplayMinute(),
+			})
+			continue
+		}
+
+		reportGoals = append(reportGoals, dto.MatchReportGoal{
+			Type:          event.Type,
+			Marker:        event.GoalMarker(),
+			PlayerName:    playerName,
+			TeamName:      teamName,
+			Minute:        event.Minute,
+			AddedTime:     event.AddedTime,
+			DisplayMinute: event.DisplayMinute(),
+		})
+
 		// Accumulate goal count per player
-		if _, exists := playerGoals[goal.PlayerID]; !exists {
-			playerGoals[goal.PlayerID] = &playerGoalCount{
+		if _, exists := playerGoals[event.PlayerID]; !exists {
+			playerGoals[event.PlayerID] = &playerGoalCount{
 				PlayerName: playerName,
 				TeamName:   teamName,
 				Count:      0,
 			}
 		}
-		playerGoals[goal.PlayerID].Count++
+		playerGoals[event.PlayerID].Count++
 	}
 
 	// Determine top scorer (player with most goals in this match)
@@ -148,28 +413,48 @@ func (s *reportService) GetMatchReportByID(matchID uuid.UUID) (*dto.MatchReportR
 	}
 
 	// Calculate accumulated total wins for both teams across ALL completed matches
-	homeTeamWins, err := s.matchRepo.CountWins(match.HomeTeamID)
+	wins, err := s.matchRepo.CountWinsByTeamIDs([]uuid.UUID{match.HomeTeamID, match.AwayTeamID})
 	if err != nil {
-		slog.Error("failed to count home team wins", "error", err)
+		slog.ErrorContext(ctx, "failed to count team wins", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
-	awayTeamWins, err := s.matchRepo.CountWins(match.AwayTeamID)
+
+	lineupEntries, err := s.lineupRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch lineups for report", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	lineups := toMatchLineupResponse(*match, lineupEntries)
+
+	officialEntries, err := s.officialRepo.FindByMatchID(matchID)
 	if err != nil {
-		slog.Error("failed to count away team wins", "error", err)
+		slog.ErrorContext(ctx, "failed to fetch officials for report", "error", err, "match_id", matchID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	officials := toMatchOfficialsResponse(matchID, officialEntries)
 
+	kickoffAt, local := kickoffTimes(match.MatchDatetime, s.location)
 	report := &dto.MatchReportResponse{
 		MatchID:           match.ID.String(),
-		MatchDate:         match.MatchDate,
-		MatchTime:         match.MatchTime,
+		MatchDatetime:     kickoffAt,
+		LocalDatetime:     local,
+		Timezone:          s.location.String(),
 		HomeScore:         match.HomeScore,
 		AwayScore:         match.AwayScore,
-		MatchResult:       computeMatchResult(match.HomeScore, match.AwayScore),
+		HomeShootoutScore: match.HomeShootoutScore,
+		AwayShootoutScore: match.AwayShootoutScore,
+		ScoreLine:         match.ScoreLine(),
+		MatchResult:       computeMatchResult(*match),
 		Goals:             reportGoals,
+		Events:            reportEvents,
+		HomeLineup:        lineups.HomeTeam,
+		AwayLineup:        lineups.AwayTeam,
+		Referee:           officials.Referee,
+		Assistants:        officials.Assistants,
 		TopScorer:         topScorer,
-		HomeTeamTotalWins: homeTeamWins,
-		AwayTeamTotalWins: awayTeamWins,
+		ManOfTheMatch:     toManOfTheMatchResponse(match.ManOfTheMatch),
+		HomeTeamTotalWins: wins[match.HomeTeamID],
+		AwayTeamTotalWins: wins[match.AwayTeamID],
 	}
 
 	if match.HomeTeam != nil {
@@ -178,18 +463,79 @@ func (s *reportService) GetMatchReportByID(matchID uuid.UUID) (*dto.MatchReportR
 	if match.AwayTeam != nil {
 		report.AwayTeam = toTeamResponse(*match.AwayTeam)
 	}
+	if match.Venue != nil {
+		venue := toStadiumResponse(*match.Venue)
+		report.Venue = &venue
+	}
 
 	return report, nil
 }
 
-// computeMatchResult determines the match outcome string.
-func computeMatchResult(homeScore, awayScore int) string {
-	switch {
-	case homeScore > awayScore:
+// toManOfTheMatchResponse describes the man of the match of a report; nil if none was chosen.
+func toManOfTheMatchResponse(player *model.Player) *dto.ManOfTheMatchResponse {
+	if player == nil {
+		return nil
+	}
+	resp := &dto.ManOfTheMatchResponse{PlayerID: player.ID.String(), PlayerName: player.Name}
+	if player.Team != nil {
+		resp.TeamName = player.Team.Name
+	}
+	return resp
+}
+
+// opponentName returns the name of the team playing against teamID in the match.
+func opponentName(match *model.Match, teamID uuid.UUID) string {
+	opponent := match.HomeTeam
+	if teamID == match.HomeTeamID {
+		opponent = match.AwayTeam
+	}
+	if opponent == nil {
+		return ""
+	}
+	return opponent.Name
+}
+
+// toMatchReportListItem converts a completed model.Match to a dto.MatchReportListItem, with local times in loc.
+func toMatchReportListItem(match model.Match, loc *time.Location) dto.MatchReportListItem {
+	kickoffAt, local := kickoffTimes(match.MatchDatetime, loc)
+	item := dto.MatchReportListItem{
+		MatchID:           match.ID.String(),
+		MatchDatetime:     kickoffAt,
+		LocalDatetime:     local,
+		Timezone:          loc.String(),
+		HomeScore:         match.HomeScore,
+		AwayScore:         match.AwayScore,
+		HomeShootoutScore: match.HomeShootoutScore,
+		AwayShootoutScore: match.AwayShootoutScore,
+		ScoreLine:         match.ScoreLine(),
+		MatchResult:       computeMatchResult(match),
+		Round:             match.Round,
+	}
+	if match.SeasonID != nil {
+		item.SeasonID = match.SeasonID.String()
+	}
+	if match.HomeTeam != nil {
+		item.HomeTeam = toTeamResponse(*match.HomeTeam)
+	}
+	if match.AwayTeam != nil {
+		item.AwayTeam = toTeamResponse(*match.AwayTeam)
+	}
+	if match.Venue != nil {
+		venue := toStadiumResponse(*match.Venue)
+		item.Venue = &venue
+	}
+	return item
+}
+
+// computeMatchResult determines the match outcome string. A shootout decides the outcome only
+// if the competition counts shootout wins (see model.Match.Winner).
+func computeMatchResult(match model.Match) string {
+	switch match.Winner() {
+	case uuid.Nil:
+		return "Draw"
+	case match.HomeTeamID:
 		return "Home Win"
-	case awayScore > homeScore:
-		return "Away Win"
 	default:
-		return "Draw"
+		return "Away Win"
 	}
 }
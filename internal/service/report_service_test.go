@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,16 +9,22 @@ import (
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
-func newTestReportService(t *testing.T) (*reportService, *mocks.MockMatchRepository, *mocks.MockGoalRepository) {
+func newTestReportService(t *testing.T) (*reportService, *mocks.MockMatchRepository, *mocks.MockMatchLineupRepository) {
 	matchRepo := mocks.NewMockMatchRepository(t)
-	goalRepo := mocks.NewMockGoalRepository(t)
-	svc := &reportService{matchRepo: matchRepo, goalRepo: goalRepo}
-	return svc, matchRepo, goalRepo
+	eventRepo := mocks.NewMockMatchEventRepository(t)
+	lineupRepo := mocks.NewMockMatchLineupRepository(t)
+	officialRepo := mocks.NewMockMatchOfficialRepository(t)
+	officialRepo.EXPECT().FindByMatchID(mock.Anything).Return([]model.MatchOfficial{}, nil).Maybe()
+	svc := &reportService{matchRepo: matchRepo, eventRepo: eventRepo, lineupRepo: lineupRepo, officialRepo: officialRepo, location: time.FixedZone("WIB", 7*60*60)}
+	return svc, matchRepo, lineupRepo
 }
 
 func TestReportService_GetMatchReports(t *testing.T) {
@@ -42,37 +49,40 @@ func TestReportService_GetMatchReports(t *testing.T) {
 		{
 			name: "success with completed matches",
 			setup: func(mr *mocks.MockMatchRepository) {
-				matches := []model.Match{
+				matches := []repository.MatchWithWins{
 					{
-						Base:       model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: time.Now(), UpdatedAt: time.Now()},
-						HomeTeamID: homeID,
-						AwayTeamID: awayID,
-						MatchDate:  "2026-03-15",
-						MatchTime:  "19:30",
-						HomeScore:  2,
-						AwayScore:  1,
-						Status:     "completed",
-						HomeTeam:   &homeTeam,
-						AwayTeam:   &awayTeam,
+						Match: model.Match{
+							Base:          model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+							HomeTeamID:    homeID,
+							AwayTeamID:    awayID,
+							MatchDatetime: time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC),
+							HomeScore:     2,
+							AwayScore:     1,
+							Status:        "completed",
+							HomeTeam:      &homeTeam,
+							AwayTeam:      &awayTeam,
+						},
+						HomeTeamWins: 7,
+						AwayTeamWins: 4,
 					},
 				}
-				mr.EXPECT().FindCompletedMatches(0, 10).Return(matches, nil)
-				mr.EXPECT().CountCompletedMatches().Return(int64(1), nil)
+				mr.EXPECT().FindCompletedWithWins(repository.MatchFilter{}, 0, 10).Return(matches, nil)
+				mr.EXPECT().CountCompletedMatches(repository.MatchFilter{}).Return(int64(1), nil)
 			},
 			wantLen: 1,
 		},
 		{
 			name: "success empty",
 			setup: func(mr *mocks.MockMatchRepository) {
-				mr.EXPECT().FindCompletedMatches(0, 10).Return([]model.Match{}, nil)
-				mr.EXPECT().CountCompletedMatches().Return(int64(0), nil)
+				mr.EXPECT().FindCompletedWithWins(repository.MatchFilter{}, 0, 10).Return([]repository.MatchWithWins{}, nil)
+				mr.EXPECT().CountCompletedMatches(repository.MatchFilter{}).Return(int64(0), nil)
 			},
 			wantLen: 0,
 		},
 		{
 			name: "db error",
 			setup: func(mr *mocks.MockMatchRepository) {
-				mr.EXPECT().FindCompletedMatches(0, 10).Return(nil, gorm.ErrInvalidDB)
+				mr.EXPECT().FindCompletedWithWins(repository.MatchFilter{}, 0, 10).Return(nil, gorm.ErrInvalidDB)
 			},
 			wantErr: true,
 		},
@@ -84,7 +94,7 @@ func TestReportService_GetMatchReports(t *testing.T) {
 			tt.setup(matchRepo)
 
 			pagination := dto.PaginationQuery{Page: 1, PerPage: 10, SortBy: "created_at", SortOrder: "desc"}
-			reports, meta, err := svc.GetMatchReports(pagination)
+			reports, meta, err := svc.GetMatchReports(context.Background(), pagination, dto.SeasonFilterQuery{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -94,6 +104,11 @@ func TestReportService_GetMatchReports(t *testing.T) {
 				if tt.wantLen > 0 {
 					assert.NotNil(t, meta)
 					assert.Equal(t, "Home Win", reports[0].MatchResult)
+					assert.Equal(t, "2026-03-15T19:30:00.000Z", reports[0].MatchDatetime)
+					assert.Equal(t, "2026-03-16T02:30:00.000+07:00", reports[0].LocalDatetime)
+					assert.Equal(t, "WIB", reports[0].Timezone)
+					assert.Equal(t, 7, reports[0].HomeTeamTotalWins)
+					assert.Equal(t, 4, reports[0].AwayTeamTotalWins)
 				}
 			}
 			matchRepo.AssertExpectations(t)
@@ -101,6 +116,72 @@ func TestReportService_GetMatchReports(t *testing.T) {
 	}
 }
 
+func TestReportService_GetAllMatchReports(t *testing.T) {
+	home := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	away := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	seasonID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		filter      dto.SeasonFilterQuery
+		setup       func(*mocks.MockMatchRepository)
+		wantErr     bool
+		errContains string
+		wantResults []string
+	}{
+		{
+			name:   "all completed matches of a season",
+			filter: dto.SeasonFilterQuery{SeasonID: seasonID.String()},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return([]model.Match{
+					completedMatch(home, away, 2, 1),
+					completedMatch(away, home, 1, 1),
+					completedMatch(home, away, 0, 3),
+				}, nil)
+			},
+			wantResults: []string{"Home Win", "Draw", "Away Win"},
+		},
+		{
+			name:        "invalid season id",
+			filter:      dto.SeasonFilterQuery{SeasonID: "not-a-uuid"},
+			setup:       func(mr *mocks.MockMatchRepository) {},
+			wantErr:     true,
+			errContains: "Invalid season_id",
+		},
+		{
+			name: "db error",
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, matchRepo, _ := newTestReportService(t)
+			tt.setup(matchRepo)
+
+			reports, err := svc.GetAllMatchReports(context.Background(), tt.filter)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+			assert.NoError(t, err)
+			results := make([]string, len(reports))
+			for i, r := range reports {
+				results[i] = r.MatchResult
+			}
+			assert.Equal(t, tt.wantResults, results)
+			assert.Equal(t, "Persija Jakarta", reports[0].HomeTeam.Name)
+		})
+	}
+}
+
 func TestReportService_GetMatchReportByID(t *testing.T) {
 	homeID := uuid.Must(uuid.NewV7())
 	awayID := uuid.Must(uuid.NewV7())
@@ -124,25 +205,30 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 		errContains string
 		wantResult  string // expected match_result
 		wantTopGoal int    // expected top scorer goals
+		wantWins    [2]int // expected total wins of the home and away team
+		wantGoals   int    // expected goal list length (0 = not checked)
+		wantEvents  int    // expected event timeline length (0 = not checked)
+		lineups     []model.MatchLineup
+		wantLineup  bool // expect both lineups to be present
 	}{
 		{
 			name: "success home win with top scorer",
 			setup: func(mr *mocks.MockMatchRepository) {
 				mr.EXPECT().FindByIDWithDetails(matchID).Return(&model.Match{
-					Base:       model.Base{ID: matchID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
-					HomeTeamID: homeID,
-					AwayTeamID: awayID,
-					MatchDate:  "2026-03-15",
-					MatchTime:  "19:30",
-					HomeScore:  2,
-					AwayScore:  1,
-					Status:     "completed",
-					HomeTeam:   &homeTeam,
-					AwayTeam:   &awayTeam,
-					Goals: []model.Goal{
+					Base:          model.Base{ID: matchID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+					HomeTeamID:    homeID,
+					AwayTeamID:    awayID,
+					MatchDatetime: time.Date(2026, 3, 15, 19, 30, 0, 0, time.UTC),
+					HomeScore:     2,
+					AwayScore:     1,
+					Status:        "completed",
+					HomeTeam:      &homeTeam,
+					AwayTeam:      &awayTeam,
+					Events: []model.MatchEvent{
 						{
 							Base:     model.Base{ID: uuid.Must(uuid.NewV7())},
 							MatchID:  matchID,
+							Type:     model.EventGoal,
 							PlayerID: playerHomeID,
 							TeamID:   homeID,
 							Minute:   23,
@@ -152,6 +238,7 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 						{
 							Base:     model.Base{ID: uuid.Must(uuid.NewV7())},
 							MatchID:  matchID,
+							Type:     model.EventGoal,
 							PlayerID: playerAwayID,
 							TeamID:   awayID,
 							Minute:   45,
@@ -161,6 +248,7 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 						{
 							Base:     model.Base{ID: uuid.Must(uuid.NewV7())},
 							MatchID:  matchID,
+							Type:     model.EventGoal,
 							PlayerID: playerHomeID,
 							TeamID:   homeID,
 							Minute:   78,
@@ -169,30 +257,35 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 						},
 					},
 				}, nil)
-				mr.EXPECT().CountWins(homeID).Return(5, nil)
-				mr.EXPECT().CountWins(awayID).Return(3, nil)
+				mr.EXPECT().CountWinsByTeamIDs([]uuid.UUID{homeID, awayID}).Return(map[uuid.UUID]int{homeID: 5, awayID: 3}, nil)
+			},
+			lineups: []model.MatchLineup{
+				{MatchID: matchID, TeamID: homeID, PlayerID: playerHomeID, Starter: true, Player: &model.Player{Name: "Bambang"}},
+				{MatchID: matchID, TeamID: awayID, PlayerID: playerAwayID, Starter: false, Player: &model.Player{Name: "Atep"}},
 			},
 			wantResult:  "Home Win",
 			wantTopGoal: 2,
+			wantWins:    [2]int{5, 3},
+			wantLineup:  true,
 		},
 		{
 			name: "success draw",
 			setup: func(mr *mocks.MockMatchRepository) {
 				mr.EXPECT().FindByIDWithDetails(matchID).Return(&model.Match{
-					Base:       model.Base{ID: matchID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
-					HomeTeamID: homeID,
-					AwayTeamID: awayID,
-					MatchDate:  "2026-03-20",
-					MatchTime:  "20:00",
-					HomeScore:  1,
-					AwayScore:  1,
-					Status:     "completed",
-					HomeTeam:   &homeTeam,
-					AwayTeam:   &awayTeam,
-					Goals: []model.Goal{
+					Base:          model.Base{ID: matchID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+					HomeTeamID:    homeID,
+					AwayTeamID:    awayID,
+					MatchDatetime: time.Date(2026, 3, 20, 20, 0, 0, 0, time.UTC),
+					HomeScore:     1,
+					AwayScore:     1,
+					Status:        "completed",
+					HomeTeam:      &homeTeam,
+					AwayTeam:      &awayTeam,
+					Events: []model.MatchEvent{
 						{
 							Base:     model.Base{ID: uuid.Must(uuid.NewV7())},
 							MatchID:  matchID,
+							Type:     model.EventGoal,
 							PlayerID: playerHomeID,
 							TeamID:   homeID,
 							Minute:   30,
@@ -202,6 +295,7 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 						{
 							Base:     model.Base{ID: uuid.Must(uuid.NewV7())},
 							MatchID:  matchID,
+							Type:     model.EventGoal,
 							PlayerID: playerAwayID,
 							TeamID:   awayID,
 							Minute:   60,
@@ -210,11 +304,53 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 						},
 					},
 				}, nil)
-				mr.EXPECT().CountWins(homeID).Return(2, nil)
-				mr.EXPECT().CountWins(awayID).Return(2, nil)
+				mr.EXPECT().CountWinsByTeamIDs([]uuid.UUID{homeID, awayID}).Return(map[uuid.UUID]int{homeID: 2, awayID: 2}, nil)
 			},
 			wantResult:  "Draw",
 			wantTopGoal: 1,
+			wantWins:    [2]int{2, 2},
+		},
+		{
+			name: "own goal credited to opponent and excluded from top scorer",
+			setup: func(mr *mocks.MockMatchRepository) {
+				subID := uuid.Must(uuid.NewV7())
+				mr.EXPECT().FindByIDWithDetails(matchID).Return(&model.Match{
+					Base:       model.Base{ID: matchID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+					HomeTeamID: homeID,
+					AwayTeamID: awayID,
+					HomeScore:  2,
+					AwayScore:  0,
+					Status:     "completed",
+					HomeTeam:   &homeTeam,
+					AwayTeam:   &awayTeam,
+					Events: []model.MatchEvent{
+						{
+							Type: model.EventPenalty, PlayerID: playerHomeID, TeamID: homeID, Minute: 10,
+							Player: &model.Player{Base: model.Base{ID: playerHomeID}, Name: "Bambang"}, Team: &homeTeam,
+						},
+						{
+							Type: model.EventYellowCard, PlayerID: playerAwayID, TeamID: awayID, Minute: 30,
+							Player: &model.Player{Base: model.Base{ID: playerAwayID}, Name: "Atep"}, Team: &awayTeam,
+						},
+						{
+							Type: model.EventOwnGoal, PlayerID: playerAwayID, TeamID: awayID, Minute: 55,
+							Player: &model.Player{Base: model.Base{ID: playerAwayID}, Name: "Atep"}, Team: &awayTeam,
+						},
+						{
+							Type: model.EventSubstitution, PlayerID: playerAwayID, RelatedPlayerID: &subID, TeamID: awayID, Minute: 60,
+							Player:        &model.Player{Base: model.Base{ID: playerAwayID}, Name: "Atep"},
+							RelatedPlayer: &model.Player{Base: model.Base{ID: subID}, Name: "Zulham"},
+							Team:          &awayTeam,
+						},
+					},
+				}, nil)
+				mr.EXPECT().CountWinsByTeamIDs([]uuid.UUID{homeID, awayID}).Return(map[uuid.UUID]int{homeID: 1}, nil)
+			},
+			wantResult:  "Home Win",
+			wantTopGoal: 1,
+			wantWins:    [2]int{1, 0},
+			wantGoals:   2,
+			wantEvents:  4,
 		},
 		{
 			name: "match not found",
@@ -241,10 +377,11 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc, matchRepo, _ := newTestReportService(t)
+			svc, matchRepo, lineupRepo := newTestReportService(t)
 			tt.setup(matchRepo)
+			lineupRepo.EXPECT().FindByMatchID(matchID).Return(tt.lineups, nil).Maybe()
 
-			report, err := svc.GetMatchReportByID(matchID)
+			report, err := svc.GetMatchReportByID(context.Background(), matchID)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -255,33 +392,294 @@ func TestReportService_GetMatchReportByID(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, report)
 				assert.Equal(t, tt.wantResult, report.MatchResult)
+				assert.Equal(t, tt.wantWins, [2]int{report.HomeTeamTotalWins, report.AwayTeamTotalWins})
 				if report.TopScorer != nil {
 					assert.Equal(t, tt.wantTopGoal, report.TopScorer.GoalsInMatch)
 				}
+				if tt.wantGoals > 0 {
+					assert.Len(t, report.Goals, tt.wantGoals)
+					for _, g := range report.Goals {
+						switch g.Type {
+						case model.EventOwnGoal:
+							assert.Equal(t, homeTeam.Name, g.TeamName)
+							assert.Equal(t, "OG", g.Marker)
+						case model.EventPenalty:
+							assert.Equal(t, "PEN", g.Marker)
+						default:
+							assert.Empty(t, g.Marker)
+						}
+					}
+				}
+				if tt.wantEvents > 0 {
+					assert.Len(t, report.Events, tt.wantEvents)
+				}
+				if tt.wantLineup {
+					assert.NotNil(t, report.HomeLineup)
+					assert.Len(t, report.HomeLineup.Starters, 1)
+					assert.NotNil(t, report.AwayLineup)
+					assert.Len(t, report.AwayLineup.Substitutes, 1)
+				}
 			}
 			matchRepo.AssertExpectations(t)
 		})
 	}
 }
 
+func TestReportService_GetMatchReportByID_Officials(t *testing.T) {
+	svc, matchRepo, lineupRepo := newTestReportService(t)
+	officialRepo := mocks.NewMockMatchOfficialRepository(t)
+	svc.officialRepo = officialRepo
+
+	homeTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	awayTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	match := &model.Match{
+		Base:       model.Base{ID: uuid.Must(uuid.NewV7())},
+		HomeTeamID: homeTeam.ID, AwayTeamID: awayTeam.ID,
+		HomeTeam: homeTeam, AwayTeam: awayTeam,
+		Status: model.MatchStatusCompleted,
+	}
+	referee := &model.Referee{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Thoriq Alkatiri", Country: "Indonesia"}
+	assistant := &model.Referee{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Bambang Sugiarto"}
+
+	matchRepo.EXPECT().FindByIDWithDetails(match.ID).Return(match, nil)
+	matchRepo.EXPECT().CountWinsByTeamIDs(mock.Anything).Return(map[uuid.UUID]int{}, nil)
+	lineupRepo.EXPECT().FindByMatchID(match.ID).Return(nil, nil)
+	officialRepo.EXPECT().FindByMatchID(match.ID).Return([]model.MatchOfficial{
+		{MatchID: match.ID, RefereeID: assistant.ID, Role: model.OfficialRoleAssistant, Referee: assistant},
+		{MatchID: match.ID, RefereeID: referee.ID, Role: model.OfficialRoleReferee, Referee: referee},
+	}, nil)
+
+	report, err := svc.GetMatchReportByID(context.Background(), match.ID)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, report.Referee) {
+		assert.Equal(t, "Thoriq Alkatiri", report.Referee.Name)
+	}
+	if assert.Len(t, report.Assistants, 1) {
+		assert.Equal(t, "Bambang Sugiarto", report.Assistants[0].Name)
+	}
+}
+
+func TestReportService_GetMatchReportByID_ManOfTheMatch(t *testing.T) {
+	svc, matchRepo, lineupRepo := newTestReportService(t)
+
+	homeTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	awayTeam := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	star := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: awayTeam.ID, Name: "Ciro Alves", Team: awayTeam}
+	match := &model.Match{
+		Base:       model.Base{ID: uuid.Must(uuid.NewV7())},
+		HomeTeamID: homeTeam.ID, AwayTeamID: awayTeam.ID,
+		HomeTeam: homeTeam, AwayTeam: awayTeam,
+		Status:          model.MatchStatusCompleted,
+		ManOfTheMatchID: &star.ID,
+		ManOfTheMatch:   star,
+	}
+	matchRepo.EXPECT().FindByIDWithDetails(match.ID).Return(match, nil)
+	matchRepo.EXPECT().CountWinsByTeamIDs(mock.Anything).Return(map[uuid.UUID]int{}, nil)
+	lineupRepo.EXPECT().FindByMatchID(match.ID).Return(nil, nil)
+
+	report, err := svc.GetMatchReportByID(context.Background(), match.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, &dto.ManOfTheMatchResponse{PlayerID: star.ID.String(), PlayerName: "Ciro Alves", TeamName: "Persib Bandung"}, report.ManOfTheMatch)
+}
+
 // TestComputeMatchResult tests the match result computation helper.
+func TestReportService_GetRoundReport(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	bali := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Bali United"}
+	seasonID := uuid.Must(uuid.NewV7())
+	round := 3
+	filter := repository.MatchFilter{SeasonID: &seasonID, Round: &round}
+
+	t.Run("summarizes the completed matches", func(t *testing.T) {
+		svc, matchRepo, _ := newTestReportService(t)
+		first, second := completedMatch(persija, persib, 2, 1), completedMatch(arema, bali, 1, 1)
+		first.Round, second.Round = &round, &round
+		matchRepo.EXPECT().Count(filter).Return(3, nil)
+		matchRepo.EXPECT().FindAllCompleted(filter).Return([]model.Match{first, second}, nil)
+
+		resp, err := svc.GetRoundReport(context.Background(), round, dto.SeasonFilterQuery{SeasonID: seasonID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, round, resp.Round)
+		assert.Equal(t, seasonID.String(), resp.SeasonID)
+		assert.Equal(t, 3, resp.Matches)
+		assert.Equal(t, 2, resp.Completed)
+		assert.Equal(t, 5, resp.Goals)
+		assert.Equal(t, 1, resp.HomeWins)
+		assert.Zero(t, resp.AwayWins)
+		assert.Equal(t, 1, resp.Draws)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "Persija Jakarta", resp.Results[0].HomeTeam.Name)
+		assert.Equal(t, &round, resp.Results[0].Round)
+	})
+
+	t.Run("round without matches", func(t *testing.T) {
+		svc, matchRepo, _ := newTestReportService(t)
+		matchRepo.EXPECT().Count(filter).Return(0, nil)
+
+		_, err := svc.GetRoundReport(context.Background(), round, dto.SeasonFilterQuery{SeasonID: seasonID.String()})
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+		assert.Equal(t, CodeRoundNotFound, appErr.ErrorCode)
+	})
+}
+
+func TestReportService_GetGoalsByTeam(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	arema, persija := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	filter := repository.MatchFilter{SeasonID: &seasonID}
+
+	t.Run("aligns every team with the rounds", func(t *testing.T) {
+		svc, matchRepo, _ := newTestReportService(t)
+		matchRepo.EXPECT().GoalsByTeamAndRound(filter).Return([]repository.TeamRoundGoals{
+			{TeamID: arema, TeamName: "Arema FC", Round: 1, Scored: 2, Conceded: 1},
+			{TeamID: arema, TeamName: "Arema FC", Round: 3, Scored: 0, Conceded: 0},
+			{TeamID: persija, TeamName: "Persija Jakarta", Round: 2, Scored: 4, Conceded: 3},
+		}, nil)
+
+		resp, err := svc.GetGoalsByTeam(context.Background(), dto.SeasonReportQuery{SeasonID: seasonID.String()})
+
+		require.NoError(t, err)
+		goals := func(n int) *int { return &n }
+		assert.Equal(t, seasonID.String(), resp.SeasonID)
+		assert.Equal(t, []int{1, 2, 3}, resp.Rounds)
+		require.Len(t, resp.Teams, 2)
+		assert.Equal(t, "Arema FC", resp.Teams[0].TeamName)
+		assert.Equal(t, []*int{goals(2), nil, goals(0)}, resp.Teams[0].Scored)
+		assert.Equal(t, []*int{goals(1), nil, goals(0)}, resp.Teams[0].Conceded)
+		assert.Equal(t, 2, resp.Teams[0].TotalScored)
+		assert.Equal(t, 1, resp.Teams[0].TotalConceded)
+		assert.Equal(t, persija.String(), resp.Teams[1].TeamID)
+		assert.Equal(t, []*int{nil, goals(4), nil}, resp.Teams[1].Scored)
+		assert.Equal(t, 3, resp.Teams[1].TotalConceded)
+	})
+
+	t.Run("season without results", func(t *testing.T) {
+		svc, matchRepo, _ := newTestReportService(t)
+		matchRepo.EXPECT().GoalsByTeamAndRound(filter).Return(nil, nil)
+
+		resp, err := svc.GetGoalsByTeam(context.Background(), dto.SeasonReportQuery{SeasonID: seasonID.String()})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Rounds)
+		assert.NotNil(t, resp.Rounds)
+		assert.NotNil(t, resp.Teams)
+	})
+}
+
+func TestReportService_GetManOfTheMatchLeaderboard(t *testing.T) {
+	svc, matchRepo, _ := newTestReportService(t)
+	seasonID := uuid.Must(uuid.NewV7())
+	award := func(name string, awards int) repository.ManOfTheMatchAwards {
+		return repository.ManOfTheMatchAwards{PlayerID: uuid.Must(uuid.NewV7()), PlayerName: name, TeamID: uuid.Must(uuid.NewV7()), TeamName: "Persija Jakarta", Awards: awards}
+	}
+	matchRepo.EXPECT().CountManOfTheMatchAwards(repository.MatchFilter{SeasonID: &seasonID}).Return([]repository.ManOfTheMatchAwards{
+		award("Marko Simic", 4), award("Riko Simanjuntak", 2), award("Witan Sulaeman", 2), award("Evan Dimas", 1),
+	}, nil)
+
+	resp, err := svc.GetManOfTheMatchLeaderboard(context.Background(), dto.SeasonReportQuery{SeasonID: seasonID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, seasonID.String(), resp.SeasonID)
+	var positions []int
+	for _, entry := range resp.Players {
+		positions = append(positions, entry.Position)
+	}
+	assert.Equal(t, []int{1, 2, 2, 4}, positions, "players with as many awards share a position")
+	assert.Equal(t, "Marko Simic", resp.Players[0].PlayerName)
+	assert.Equal(t, 4, resp.Players[0].Awards)
+}
+
+func TestReportService_GetAttendance(t *testing.T) {
+	svc, matchRepo, _ := newTestReportService(t)
+	persija, arema := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	gbk, kanjuruhan := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	matchRepo.EXPECT().AttendanceByHomeTeam(repository.MatchFilter{}).Return([]repository.AttendanceStats{
+		{ID: persija, Name: "Persija Jakarta", Matches: 2, Total: 95000, Highest: 60000},
+		{ID: arema, Name: "Arema FC", Matches: 3, Total: 40001, Highest: 15000},
+	}, nil)
+	matchRepo.EXPECT().AttendanceByVenue(repository.MatchFilter{}).Return([]repository.AttendanceStats{
+		{ID: gbk, Name: "Gelora Bung Karno", Capacity: 77193, Matches: 2, Total: 95000, Highest: 60000},
+		{ID: kanjuruhan, Name: "Kanjuruhan", Matches: 3, Total: 40001, Highest: 15000},
+	}, nil)
+
+	resp, err := svc.GetAttendance(context.Background(), dto.SeasonFilterQuery{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, resp.Matches)
+	assert.Equal(t, int64(135001), resp.TotalAttendance)
+	assert.Equal(t, 27000, resp.AverageAttendance)
+	require.Len(t, resp.Teams, 2)
+	assert.Equal(t, 47500, resp.Teams[0].AverageAttendance)
+	assert.Equal(t, 13334, resp.Teams[1].AverageAttendance)
+	require.Len(t, resp.Venues, 2)
+	require.NotNil(t, resp.Venues[0].Occupancy)
+	assert.Equal(t, 61.5, *resp.Venues[0].Occupancy)
+	// Without a capacity there is no occupancy
+	assert.Nil(t, resp.Venues[1].Occupancy)
+}
+
 func TestComputeMatchResult(t *testing.T) {
+	competition := func(rule string) *model.Season {
+		return &model.Season{Competition: &model.Competition{ShootoutRule: rule}}
+	}
+	pens := func(n int) *int { return &n }
+
 	tests := []struct {
-		name      string
-		homeScore int
-		awayScore int
-		want      string
+		name          string
+		homeScore     int
+		awayScore     int
+		homeShootout  *int
+		awayShootout  *int
+		season        *model.Season
+		want          string
+		wantScoreLine string
 	}{
-		{name: "home win", homeScore: 3, awayScore: 1, want: "Home Win"},
-		{name: "away win", homeScore: 0, awayScore: 2, want: "Away Win"},
-		{name: "draw", homeScore: 1, awayScore: 1, want: "Draw"},
-		{name: "draw zero", homeScore: 0, awayScore: 0, want: "Draw"},
+		{name: "home win", homeScore: 3, awayScore: 1, want: "Home Win", wantScoreLine: "3-1"},
+		{name: "away win", homeScore: 0, awayScore: 2, want: "Away Win", wantScoreLine: "0-2"},
+		{name: "draw", homeScore: 1, awayScore: 1, want: "Draw", wantScoreLine: "1-1"},
+		{name: "draw zero", homeScore: 0, awayScore: 0, want: "Draw", wantScoreLine: "0-0"},
+		{
+			name: "shootout win counted by the competition", homeScore: 2, awayScore: 2,
+			homeShootout: pens(4), awayShootout: pens(3), season: competition(model.ShootoutRuleWin),
+			want: "Home Win", wantScoreLine: "2-2, 4-3 on pens",
+		},
+		{
+			name: "away shootout win counted by the competition", homeScore: 1, awayScore: 1,
+			homeShootout: pens(2), awayShootout: pens(4), season: competition(model.ShootoutRuleWin),
+			want: "Away Win", wantScoreLine: "1-1, 2-4 on pens",
+		},
+		{
+			name: "shootout in a competition where it stays a draw", homeScore: 2, awayScore: 2,
+			homeShootout: pens(4), awayShootout: pens(3), season: competition(model.ShootoutRuleDraw),
+			want: "Draw", wantScoreLine: "2-2, 4-3 on pens",
+		},
+		{
+			name: "shootout outside any season stays a draw", homeScore: 0, awayScore: 0,
+			homeShootout: pens(5), awayShootout: pens(4),
+			want: "Draw", wantScoreLine: "0-0, 5-4 on pens",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := computeMatchResult(tt.homeScore, tt.awayScore)
-			assert.Equal(t, tt.want, result)
+			match := model.Match{
+				HomeTeamID:        uuid.Must(uuid.NewV7()),
+				AwayTeamID:        uuid.Must(uuid.NewV7()),
+				HomeScore:         tt.homeScore,
+				AwayScore:         tt.awayScore,
+				HomeShootoutScore: tt.homeShootout,
+				AwayShootoutScore: tt.awayShootout,
+				Season:            tt.season,
+			}
+			assert.Equal(t, tt.want, computeMatchResult(match))
+			assert.Equal(t, tt.wantScoreLine, match.ScoreLine())
 		})
 	}
 }
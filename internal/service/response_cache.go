@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/cache"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+)
+
+// Cache key prefixes. Writes drop every prefix whose responses may embed the changed data:
+// match details embed teams and players, standings embed teams and match results.
+const (
+	cachePrefixTeams     = "teams:"
+	cachePrefixMatches   = "matches:"
+	cachePrefixStandings = "standings:"
+)
+
+// ResponseCache caches read-heavy service responses as JSON for a fixed TTL.
+// A nil *ResponseCache disables caching. Cache failures are logged and never fail a request.
+type ResponseCache struct {
+	cache   cache.Cache
+	backend string
+	ttl     time.Duration
+}
+
+// NewResponseCache creates a ResponseCache on top of c. backend names the store for health reporting.
+func NewResponseCache(c cache.Cache, backend string, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{cache: c, backend: backend, ttl: ttl}
+}
+
+// HealthCheck returns a check reporting whether the cache backend is reachable.
+func (rc *ResponseCache) HealthCheck() HealthCheck {
+	return func(ctx context.Context) dto.ComponentHealth {
+		start := time.Now()
+		if err := rc.cache.Ping(ctx); err != nil {
+			return dto.ComponentHealth{Status: dto.HealthStatusDown, Message: err.Error()}
+		}
+		return dto.ComponentHealth{
+			Status:    dto.HealthStatusUp,
+			LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+			Details:   map[string]any{"backend": rc.backend, "ttl": rc.ttl.String()},
+		}
+	}
+}
+
+// invalidate drops every cached response under the given prefixes.
+func (rc *ResponseCache) invalidate(ctx context.Context, prefixes ...string) {
+	if rc == nil {
+		return
+	}
+	for _, prefix := range prefixes {
+		if err := rc.cache.DeletePrefix(ctx, prefix); err != nil {
+			slog.WarnContext(ctx, "failed to invalidate cache", "error", err, "prefix", prefix)
+		}
+	}
+}
+
+// cachedPage is a paginated listing as stored in the cache.
+type cachedPage[T any] struct {
+	Items []T                      `json:"items"`
+	Meta  *response.PaginationMeta `json:"meta"`
+}
+
+// cached returns the response stored under key, or calls load and caches its result.
+// Errors returned by load are never cached.
+func cached[T any](ctx context.Context, rc *ResponseCache, key string, load func() (T, error)) (T, error) {
+	if rc == nil {
+		return load()
+	}
+
+	data, ok, err := rc.cache.Get(ctx, key)
+	switch {
+	case err != nil:
+		slog.WarnContext(ctx, "failed to read cache", "error", err, "key", key)
+	case ok:
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		slog.WarnContext(ctx, "failed to decode cached response", "error", err, "key", key)
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	store(ctx, rc, key, value)
+	return value, nil
+}
+
+// store caches value under key, replacing any stored response. Used to warm the cache ahead of requests.
+func store[T any](ctx context.Context, rc *ResponseCache, key string, value T) {
+	if rc == nil {
+		return
+	}
+	if data, err := json.Marshal(value); err != nil {
+		slog.WarnContext(ctx, "failed to encode response for cache", "error", err, "key", key)
+	} else if err := rc.cache.Set(ctx, key, data, rc.ttl); err != nil {
+		slog.WarnContext(ctx, "failed to write cache", "error", err, "key", key)
+	}
+}
+
+// cacheKey builds a key from a prefix and a hash of the request parameters.
+func cacheKey(prefix string, params ...any) string {
+	data, _ := json.Marshal(params)
+	sum := sha256.Sum256(data)
+	return prefix + hex.EncodeToString(sum[:16])
+}
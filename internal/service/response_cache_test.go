@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestResponseCache_Standings(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		setup func(*mocks.MockMatchRepository, *mocks.MockTeamRepository)
+		// between runs after the first read and before the second
+		between     func(t *testing.T, teamSvc TeamService)
+		wantErrs    [2]bool
+		wantLengths [2]int
+	}{
+		{
+			name: "second read is served from cache",
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					completedMatch(persija, persib, 2, 1),
+				}, nil).Once()
+			},
+			between:     func(t *testing.T, teamSvc TeamService) {},
+			wantLengths: [2]int{2, 2},
+		},
+		{
+			name: "team update invalidates cached standings",
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					completedMatch(persija, persib, 2, 1),
+				}, nil).Once()
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{}, nil).Once()
+				tr.EXPECT().FindByID(persija.ID).Return(persija, nil)
+				tr.EXPECT().FindByName("Persija").Return(persija, nil)
+				tr.EXPECT().FindSlugs("persija").Return(nil, nil)
+				tr.EXPECT().Update(persija).Return(nil)
+			},
+			between: func(t *testing.T, teamSvc TeamService) {
+				_, err := teamSvc.Update(context.Background(), persija.ID, dto.UpdateTeamRequest{Name: "Persija"})
+				assert.NoError(t, err)
+			},
+			wantLengths: [2]int{2, 0},
+		},
+		{
+			name: "errors are not cached",
+			setup: func(mr *mocks.MockMatchRepository, tr *mocks.MockTeamRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB).Once()
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					completedMatch(persija, persib, 0, 0),
+				}, nil).Once()
+			},
+			between:     func(t *testing.T, teamSvc TeamService) {},
+			wantErrs:    [2]bool{true, false},
+			wantLengths: [2]int{0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			teamRepo := mocks.NewMockTeamRepository(t)
+			tt.setup(matchRepo, teamRepo)
+
+			rc := NewResponseCache(cache.NewMemory(), "memory", time.Minute)
+			standingsSvc := NewStandingsService(matchRepo, rc)
+			teamSvc := NewTeamService(teamRepo, nil, matchRepo, nil, nil, rc, nil)
+
+			for i := range 2 {
+				if i == 1 {
+					tt.between(t, teamSvc)
+				}
+				standings, err := standingsSvc.GetStandings(ctx, dto.SeasonFilterQuery{})
+				if tt.wantErrs[i] {
+					assert.Error(t, err)
+					continue
+				}
+				assert.NoError(t, err)
+				assert.Len(t, standings, tt.wantLengths[i])
+			}
+		})
+	}
+}
+
+func TestStandingsService_WarmCache(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	seasonID := uuid.Must(uuid.NewV7())
+	ctx := context.Background()
+
+	inSeason := completedMatch(persija, persib, 2, 1)
+	inSeason.SeasonID = &seasonID
+	friendly := completedMatch(persija, arema, 0, 0)
+
+	t.Run("all-time and season tables are served from cache", func(t *testing.T) {
+		matchRepo := mocks.NewMockMatchRepository(t)
+		matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{inSeason, friendly}, nil).Once()
+		svc := NewStandingsService(matchRepo, NewResponseCache(cache.NewMemory(), "memory", time.Minute))
+
+		warmed, err := svc.WarmCache(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, warmed)
+
+		all, err := svc.GetStandings(ctx, dto.SeasonFilterQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		season, err := svc.GetStandings(ctx, dto.SeasonFilterQuery{SeasonID: seasonID.String()})
+		assert.NoError(t, err)
+		assert.Len(t, season, 2)
+		assert.Equal(t, persija.ID.String(), season[0].Team.ID)
+	})
+
+	t.Run("no-op without a cache", func(t *testing.T) {
+		warmed, err := NewStandingsService(mocks.NewMockMatchRepository(t), nil).WarmCache(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, warmed)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		matchRepo := mocks.NewMockMatchRepository(t)
+		matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+		svc := NewStandingsService(matchRepo, NewResponseCache(cache.NewMemory(), "memory", time.Minute))
+
+		_, err := svc.WarmCache(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestResponseCache_TeamListSortedByPlayerCount(t *testing.T) {
+	team := sampleTeam()
+	toTeam := sampleTeam()
+	toTeam.Name = "Persib Bandung"
+	player := samplePlayer(team.ID)
+	ctx := context.Background()
+	pagination := dto.PaginationQuery{SortBy: "player_count"}
+
+	tests := []struct {
+		name  string
+		setup func(*mocks.MockPlayerRepository, *mocks.MockTeamRepository, *mocks.MockTxManager)
+		// write runs after the first read and before the second
+		write     func(t *testing.T, playerSvc *playerService)
+		wantReads int
+	}{
+		{
+			name:      "second read is served from cache",
+			setup:     func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {},
+			write:     func(t *testing.T, playerSvc *playerService) {},
+			wantReads: 1,
+		},
+		{
+			name: "player create invalidates cached team list",
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(team.ID).Return(&team, nil)
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(team.ID, 7).Return(nil, gorm.ErrRecordNotFound)
+				pr.EXPECT().Create(mock.AnythingOfType("*model.Player")).Return(nil)
+			},
+			write: func(t *testing.T, playerSvc *playerService) {
+				_, err := playerSvc.Create(context.Background(), team.ID, dto.CreatePlayerRequest{
+					Name: "Riko Simanjuntak", Height: 165, Weight: 60, Position: "gelandang", JerseyNumber: 7,
+				})
+				assert.NoError(t, err)
+			},
+			wantReads: 2,
+		},
+		{
+			name: "player import invalidates cached team list",
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(team.ID).Return(&team, nil)
+				pr.EXPECT().FindJerseyNumbersByTeamID(team.ID).Return(nil, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+					return fn(repository.TxRepositories{Players: pr})
+				})
+				pr.EXPECT().CreateBatch(mock.Anything).Return(nil)
+			},
+			write: func(t *testing.T, playerSvc *playerService) {
+				_, err := playerSvc.Import(context.Background(), team.ID, [][]string{
+					{"name", "position", "jersey_number", "height", "weight"},
+					{"Marko Simic", "penyerang", "9", "185", "80"},
+				})
+				assert.NoError(t, err)
+			},
+			wantReads: 2,
+		},
+		{
+			name: "player transfer invalidates cached team list",
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				tr.EXPECT().FindByID(toTeam.ID).Return(&toTeam, nil)
+				pr.EXPECT().FindByTeamIDAndJerseyNumber(toTeam.ID, player.JerseyNumber).Return(nil, gorm.ErrRecordNotFound)
+				pr.EXPECT().Update(mock.AnythingOfType("*model.Player")).Return(nil)
+			},
+			write: func(t *testing.T, playerSvc *playerService) {
+				_, err := playerSvc.Transfer(context.Background(), player.ID, dto.TransferPlayerRequest{TeamID: toTeam.ID.String()})
+				assert.NoError(t, err)
+			},
+			wantReads: 2,
+		},
+		{
+			name: "player delete invalidates cached team list",
+			setup: func(pr *mocks.MockPlayerRepository, tr *mocks.MockTeamRepository, tx *mocks.MockTxManager) {
+				playerCopy := player
+				pr.EXPECT().FindByID(player.ID).Return(&playerCopy, nil)
+				pr.EXPECT().Delete(player.ID).Return(nil)
+			},
+			write: func(t *testing.T, playerSvc *playerService) {
+				assert.NoError(t, playerSvc.Delete(context.Background(), player.ID))
+			},
+			wantReads: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerSvc, playerRepo, teamRepo := newTestPlayerService(t)
+			txManager := mocks.NewMockTxManager(t)
+			tt.setup(playerRepo, teamRepo, txManager)
+			teamRepo.EXPECT().FindAll(repository.TeamFilter{}, 0, 10, "player_count", "desc").Return([]model.Team{team, toTeam}, nil).Times(tt.wantReads)
+			teamRepo.EXPECT().Count(repository.TeamFilter{}).Return(2, nil).Times(tt.wantReads)
+
+			rc := NewResponseCache(cache.NewMemory(), "memory", time.Minute)
+			playerSvc.txManager = txManager
+			playerSvc.cache = rc
+			teamSvc := NewTeamService(teamRepo, nil, nil, nil, nil, rc, nil)
+
+			for i := range 2 {
+				if i == 1 {
+					tt.write(t, playerSvc)
+				}
+				teams, _, err := teamSvc.GetAll(ctx, pagination, dto.TeamFilterQuery{})
+				assert.NoError(t, err)
+				assert.Len(t, teams, 2)
+			}
+		})
+	}
+}
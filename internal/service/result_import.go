@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// maxResultImportMatches is the maximum number of match results accepted in one import.
+const maxResultImportMatches = 50
+
+// resultImportColumns are the header names a result import file must contain, in any order.
+// A file may add related_player_id, for substitutions, and home_shootout_score and
+// away_shootout_score, given on any row of a match settled by a shootout, and attendance,
+// given on any row of the match.
+var resultImportColumns = []string{"match_id", "type", "player_id", "team_id", "minute"}
+
+// resultImport is the result of one match to import.
+type resultImport struct {
+	dto.MatchResultImportItem
+	row int   // First file row of the match; 0 for JSON imports
+	err error // Set if the file rows of the match are invalid
+}
+
+// ImportResults submits the results of several matches, each exactly like SubmitResult and in
+// its own transaction. A match that fails is reported in the response and leaves the others
+// unaffected, so staff can correct and resend only the failed ones.
+func (s *matchService) ImportResults(ctx context.Context, req dto.MatchResultImportRequest) (*dto.MatchResultImportResponse, error) {
+	imports := make([]resultImport, len(req.Results))
+	for i, item := range req.Results {
+		imports[i] = resultImport{MatchResultImportItem: item}
+	}
+	return s.importResults(ctx, imports)
+}
+
+// ImportResultRows imports match results from spreadsheet rows; the first row is the header.
+// Every row holds one event of the match in its match_id column, in match order, and a match
+// without events, such as a goalless draw, has a single row with only its match_id.
+func (s *matchService) ImportResultRows(ctx context.Context, rows [][]string) (*dto.MatchResultImportResponse, error) {
+	if len(rows) == 0 {
+		return nil, errs.ErrBadRequest("File is empty").WithCode(CodeInvalidImportFile)
+	}
+	columns, err := importHeader(rows[0], resultImportColumns)
+	if err != nil {
+		return nil, err
+	}
+	cell := func(cells []string, col string) string {
+		if i, ok := columns[col]; ok && i < len(cells) {
+			return cells[i]
+		}
+		return ""
+	}
+
+	var imports []*resultImport
+	byMatch := make(map[string]*resultImport)
+	for i, cells := range rows[1:] {
+		if !slices.ContainsFunc(cells, func(c string) bool { return c != "" }) {
+			continue
+		}
+		// Row numbers in the report match the spreadsheet: the header is row 1
+		number := i + 2
+
+		matchID := cell(cells, "match_id")
+		imp, ok := byMatch[matchID]
+		if !ok {
+			imp = &resultImport{MatchResultImportItem: dto.MatchResultImportItem{MatchID: matchID}, row: number}
+			byMatch[matchID] = imp
+			imports = append(imports, imp)
+		}
+		if imp.err != nil {
+			continue
+		}
+		if matchID == "" {
+			imp.err = errs.ErrBadRequest(fmt.Sprintf("Row %d: match_id is required", number)).WithCode(CodeInvalidImportFile)
+			continue
+		}
+
+		if home, away := cell(cells, "home_shootout_score"), cell(cells, "away_shootout_score"); home != "" || away != "" {
+			homeScore, homeErr := strconv.Atoi(home)
+			awayScore, awayErr := strconv.Atoi(away)
+			if homeErr != nil || awayErr != nil || homeScore < 0 || awayScore < 0 {
+				imp.err = errs.ErrBadRequest(fmt.Sprintf("Row %d: home_shootout_score and away_shootout_score must both be numbers of 0 or more", number)).WithCode(CodeInvalidShootout)
+				continue
+			}
+			imp.Shootout = &dto.ShootoutInput{HomeScore: homeScore, AwayScore: awayScore}
+		}
+
+		if raw := cell(cells, "attendance"); raw != "" {
+			attendance, err := strconv.Atoi(raw)
+			if err != nil || attendance < 0 {
+				imp.err = errs.ErrBadRequest(fmt.Sprintf("Row %d: attendance must be a number of 0 or more", number)).WithCode(CodeInvalidImportFile)
+				continue
+			}
+			imp.Attendance = &attendance
+		}
+
+		event := dto.MatchEventInput{
+			Type:            cell(cells, "type"),
+			PlayerID:        cell(cells, "player_id"),
+			RelatedPlayerID: cell(cells, "related_player_id"),
+			TeamID:          cell(cells, "team_id"),
+			Minute:          dto.EventMinute(cell(cells, "minute")),
+		}
+		if event == (dto.MatchEventInput{}) {
+			continue
+		}
+		if event.Type == "" {
+			event.Type = "goal"
+		}
+		imp.Events = append(imp.Events, event)
+	}
+
+	if len(imports) == 0 {
+		return nil, errs.ErrBadRequest("File contains no match results").WithCode(CodeInvalidImportFile)
+	}
+	if len(imports) > maxResultImportMatches {
+		return nil, errs.ErrBadRequest(fmt.Sprintf("File contains results of %d matches, at most %d are allowed", len(imports), maxResultImportMatches)).WithCode(CodeInvalidImportFile)
+	}
+
+	flat := make([]resultImport, len(imports))
+	for i, imp := range imports {
+		flat[i] = *imp
+	}
+	return s.importResults(ctx, flat)
+}
+
+// importResults submits each match's result in turn, collecting the failures.
+func (s *matchService) importResults(ctx context.Context, imports []resultImport) (*dto.MatchResultImportResponse, error) {
+	result := &dto.MatchResultImportResponse{
+		Total:     len(imports),
+		Submitted: []dto.MatchResponse{},
+		Errors:    []dto.MatchResultImportError{},
+	}
+	for _, imp := range imports {
+		match, err := s.importResult(ctx, imp)
+		if err != nil {
+			// Internal errors are logged where they happen and reported like the others
+			var appErr *errs.AppError
+			if !errors.As(err, &appErr) {
+				appErr = errs.ErrInternal("Internal server error")
+			}
+			result.Errors = append(result.Errors, dto.MatchResultImportError{
+				MatchID: imp.MatchID,
+				Row:     imp.row,
+				Code:    appErr.ErrorCode,
+				Message: appErr.Message,
+			})
+			result.Failed++
+			continue
+		}
+		result.Submitted = append(result.Submitted, *match)
+	}
+	result.Imported = len(result.Submitted)
+	return result, nil
+}
+
+// importResult submits the result of one match to import.
+func (s *matchService) importResult(ctx context.Context, imp resultImport) (*dto.MatchResponse, error) {
+	if imp.err != nil {
+		return nil, imp.err
+	}
+	matchID, err := uuid.Parse(imp.MatchID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid match_id format")
+	}
+	return s.SubmitResult(ctx, matchID, imp.MatchResultRequest)
+}
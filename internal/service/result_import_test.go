@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// stubResultImport makes the repositories accept results of the given scheduled matches, saving
+// the scores each one is completed with into scores.
+func stubResultImport(mr *mocks.MockMatchRepository, er *mocks.MockMatchEventRepository, scores map[uuid.UUID][2]int, matches ...*model.Match) {
+	for _, m := range matches {
+		mr.EXPECT().FindByID(m.ID).Return(m, nil).Maybe()
+		mr.EXPECT().FindByIDWithDetails(m.ID).Return(m, nil).Maybe()
+	}
+	er.EXPECT().CreateBatch(mock.AnythingOfType("[]model.MatchEvent")).Return(nil).Maybe()
+	mr.EXPECT().Update(mock.AnythingOfType("*model.Match")).RunAndReturn(func(m *model.Match) error {
+		scores[m.ID] = [2]int{m.HomeScore, m.AwayScore}
+		return nil
+	}).Maybe()
+}
+
+func TestMatchService_ImportResults(t *testing.T) {
+	svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+
+	homeID, awayID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	scorer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: homeID}
+	playerRepo.EXPECT().FindByID(scorer.ID).Return(scorer, nil)
+
+	scheduled, completed := sampleMatch(homeID, awayID), sampleMatch(homeID, awayID)
+	completed.Status = model.MatchStatusCompleted
+	scores := make(map[uuid.UUID][2]int)
+	stubResultImport(matchRepo, eventRepo, scores, &scheduled, &completed)
+
+	goal := dto.MatchEventInput{Type: model.EventGoal, PlayerID: scorer.ID.String(), TeamID: homeID.String(), Minute: "12"}
+	result, err := svc.ImportResults(context.Background(), dto.MatchResultImportRequest{Results: []dto.MatchResultImportItem{
+		{MatchID: scheduled.ID.String(), MatchResultRequest: dto.MatchResultRequest{Events: []dto.MatchEventInput{goal}}},
+		{MatchID: completed.ID.String()},
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, 1, result.Imported)
+	assert.Equal(t, 1, result.Failed)
+	require.Len(t, result.Submitted, 1)
+	assert.Equal(t, scheduled.ID.String(), result.Submitted[0].ID)
+	assert.Equal(t, [2]int{1, 0}, scores[scheduled.ID])
+	assert.Equal(t, []dto.MatchResultImportError{{
+		MatchID: completed.ID.String(),
+		Code:    CodeMatchAlreadyCompleted,
+		Message: "Match result already submitted. Use PUT to update.",
+	}}, result.Errors)
+}
+
+func TestMatchService_ImportResultRows(t *testing.T) {
+	homeID, awayID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	scorer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: awayID}
+	header := []string{"Match ID", "type", "player_id", "team_id", "minute", "home_shootout_score", "away_shootout_score"}
+
+	t.Run("groups rows by match", func(t *testing.T) {
+		svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+		playerRepo.EXPECT().FindByID(scorer.ID).Return(scorer, nil)
+		win, draw, bad := sampleMatch(homeID, awayID), sampleMatch(homeID, awayID), sampleMatch(homeID, awayID)
+		scores := make(map[uuid.UUID][2]int)
+		stubResultImport(matchRepo, eventRepo, scores, &win, &draw)
+
+		result, err := svc.ImportResultRows(context.Background(), [][]string{
+			header,
+			{win.ID.String(), "", scorer.ID.String(), awayID.String(), "30"},
+			{draw.ID.String(), "", "", "", "", "4", "3"},
+			{},
+			{win.ID.String(), model.EventGoal, scorer.ID.String(), awayID.String(), "90+2"},
+			{bad.ID.String(), "", "", "", "", "4"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, result.Total)
+		assert.Equal(t, 2, result.Imported)
+		assert.Equal(t, [2]int{0, 2}, scores[win.ID], "both rows of the match count, the one without a type as a goal")
+		assert.Equal(t, [2]int{0, 0}, scores[draw.ID])
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, bad.ID.String(), result.Errors[0].MatchID)
+		assert.Equal(t, 6, result.Errors[0].Row)
+		assert.Equal(t, CodeInvalidShootout, result.Errors[0].Code)
+	})
+
+	t.Run("missing minute fails the match", func(t *testing.T) {
+		svc, matchRepo, _, playerRepo, eventRepo := newTestMatchService(t)
+		playerRepo.EXPECT().FindByID(scorer.ID).Return(scorer, nil)
+		match := sampleMatch(homeID, awayID)
+		stubResultImport(matchRepo, eventRepo, map[uuid.UUID][2]int{}, &match)
+
+		result, err := svc.ImportResultRows(context.Background(), [][]string{
+			header,
+			{match.ID.String(), model.EventGoal, scorer.ID.String(), awayID.String(), ""},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, 2, result.Errors[0].Row)
+		assert.Equal(t, CodeInvalidMatchEvent, result.Errors[0].Code)
+	})
+
+	t.Run("attendance on any row of the match", func(t *testing.T) {
+		svc, matchRepo, _, _, eventRepo := newTestMatchService(t)
+		match, bad := sampleMatch(homeID, awayID), sampleMatch(homeID, awayID)
+		stubResultImport(matchRepo, eventRepo, map[uuid.UUID][2]int{}, &match, &bad)
+
+		result, err := svc.ImportResultRows(context.Background(), [][]string{
+			{"match_id", "type", "player_id", "team_id", "minute", "attendance"},
+			{match.ID.String(), "", "", "", "", "48500"},
+			{bad.ID.String(), "", "", "", "", "many"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		require.NotNil(t, match.Attendance)
+		assert.Equal(t, 48500, *match.Attendance)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, 3, result.Errors[0].Row)
+		assert.Equal(t, CodeInvalidImportFile, result.Errors[0].Code)
+	})
+
+	invalidFiles := []struct {
+		name        string
+		rows        [][]string
+		errContains string
+	}{
+		{name: "empty", rows: nil, errContains: "File is empty"},
+		{name: "missing columns", rows: [][]string{{"match_id", "minute"}}, errContains: "Missing column(s): type, player_id, team_id"},
+		{name: "no results", rows: [][]string{header, {}}, errContains: "File contains no match results"},
+	}
+	for _, tt := range invalidFiles {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _, _, _ := newTestMatchService(t)
+			_, err := svc.ImportResultRows(context.Background(), tt.rows)
+			var appErr *errs.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, CodeInvalidImportFile, appErr.ErrorCode)
+			assert.Contains(t, appErr.Message, tt.errContains)
+		})
+	}
+}
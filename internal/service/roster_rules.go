@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// RosterRules holds the league's squad regulations, checked whenever a player joins a team
+// or changes position, nationality or birth date. A zero limit disables its rule. Players leaving a team
+// are never rejected, so a squad breaking the rules can always be brought back within them.
+type RosterRules struct {
+	MaxSquadSize      int
+	MaxForeignPlayers int
+	// MinGoalkeepers is how many goalkeepers a squad must include: outfield players cannot take
+	// the places still needed for them. Only applies with MaxSquadSize.
+	MinGoalkeepers int
+	// MinYouthPlayers is how many players younger than YouthAge (e.g. 23 for under-23s) a squad
+	// must include, kept like the goalkeepers' places. Players without a birth date do not count.
+	// Only applies with MaxSquadSize.
+	MinYouthPlayers int
+	YouthAge        int
+	// HomeNationality is the league's country; players of other nationalities count as foreign,
+	// players without a nationality do not.
+	HomeNationality string
+}
+
+// squad is the make-up of a team's squad as far as the roster rules are concerned.
+type squad struct {
+	size        int
+	foreign     int
+	goalkeepers int
+	youth       int
+}
+
+// enabled reports whether any rule applies, so squads need not be read otherwise.
+func (r RosterRules) enabled() bool {
+	return r.MaxSquadSize > 0 || r.MaxForeignPlayers > 0
+}
+
+// count returns the make-up of a squad.
+func (r RosterRules) count(players []model.Player) squad {
+	var s squad
+	for _, p := range players {
+		s = r.with(s, p, 1)
+	}
+	return s
+}
+
+// with returns the squad with player added (n = 1) or removed (n = -1).
+func (r RosterRules) with(s squad, player model.Player, n int) squad {
+	s.size += n
+	if player.Nationality != "" && !strings.EqualFold(player.Nationality, r.HomeNationality) {
+		s.foreign += n
+	}
+	if player.Position == model.PositionGoalkeeper {
+		s.goalkeepers += n
+	}
+	if r.isYouth(player) {
+		s.youth += n
+	}
+	return s
+}
+
+// isYouth reports whether the player is eligible as a youth player today.
+func (r RosterRules) isYouth(player model.Player) bool {
+	age, ok := player.AgeOn(time.Now())
+	return ok && age < r.YouthAge
+}
+
+// places is the number of squad places taken, counting those kept for missing goalkeepers and
+// youth players. A place is kept for each, as the missing goalkeepers need not be young.
+func (r RosterRules) places(s squad) int {
+	return s.size + max(r.MinGoalkeepers-s.goalkeepers, 0) + max(r.MinYouthPlayers-s.youth, 0)
+}
+
+// check returns a 409 error listing every rule the squad breaks after a change, except rules
+// the squad already broke at least as badly before it.
+func (r RosterRules) check(before, after squad) error {
+	var fields []errs.FieldError
+	if r.MaxSquadSize > 0 && after.size > r.MaxSquadSize && after.size > before.size {
+		fields = append(fields, errs.FieldError{
+			Field:   "squad_size",
+			Message: fmt.Sprintf("Squad would have %d players, at most %d are allowed", after.size, r.MaxSquadSize),
+		})
+	}
+	if r.MaxForeignPlayers > 0 && after.foreign > r.MaxForeignPlayers && after.foreign > before.foreign {
+		fields = append(fields, errs.FieldError{
+			Field:   "foreign_players",
+			Message: fmt.Sprintf("Squad would have %d foreign players, at most %d are allowed", after.foreign, r.MaxForeignPlayers),
+		})
+	}
+	// Kept places are only at stake when the squad still fits and the change takes one of them
+	keptPlaceTaken := r.MaxSquadSize > 0 && after.size <= r.MaxSquadSize &&
+		r.places(after) > r.MaxSquadSize && r.places(after) > r.places(before)
+	if keptPlaceTaken && after.goalkeepers < r.MinGoalkeepers {
+		fields = append(fields, errs.FieldError{
+			Field:   "goalkeepers",
+			Message: fmt.Sprintf("Squad must keep places for at least %d goalkeepers, it has %d", r.MinGoalkeepers, after.goalkeepers),
+		})
+	}
+	if keptPlaceTaken && after.youth < r.MinYouthPlayers {
+		fields = append(fields, errs.FieldError{
+			Field:   "youth_players",
+			Message: fmt.Sprintf("Squad must keep places for at least %d players under %d, it has %d", r.MinYouthPlayers, r.YouthAge, after.youth),
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return errs.ErrConflict("Squad would break the roster rules").WithCode(CodeRosterRulesViolated).WithFields(fields)
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// SeasonService defines the contract for season business logic.
+type SeasonService interface {
+	GetAllByCompetitionID(ctx context.Context, competitionID uuid.UUID, pagination dto.PaginationQuery) ([]dto.SeasonResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.SeasonResponse, error)
+	Create(ctx context.Context, competitionID uuid.UUID, req dto.CreateSeasonRequest) (*dto.SeasonResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateSeasonRequest) (*dto.SeasonResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type seasonService struct {
+	seasonRepo      repository.SeasonRepository
+	competitionRepo repository.CompetitionRepository
+}
+
+// NewSeasonService creates a new SeasonService instance.
+func NewSeasonService(seasonRepo repository.SeasonRepository, competitionRepo repository.CompetitionRepository) SeasonService {
+	return &seasonService{
+		seasonRepo:      seasonRepo,
+		competitionRepo: competitionRepo,
+	}
+}
+
+func (s *seasonService) GetAllByCompetitionID(ctx context.Context, competitionID uuid.UUID, pagination dto.PaginationQuery) ([]dto.SeasonResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	// Verify competition exists
+	if _, err := s.competitionRepo.FindByID(competitionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errs.ErrNotFound("Competition not found").WithCode(CodeCompetitionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch competition", "error", err, "competition_id", competitionID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	seasons, err := s.seasonRepo.FindAllByCompetitionID(competitionID, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch seasons", "error", err, "competition_id", competitionID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.seasonRepo.CountByCompetitionID(competitionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count seasons", "error", err, "competition_id", competitionID)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	seasonResponses := make([]dto.SeasonResponse, len(seasons))
+	for i, season := range seasons {
+		seasonResponses[i] = toSeasonResponse(season)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return seasonResponses, meta, nil
+}
+
+func (s *seasonService) GetByID(ctx context.Context, id uuid.UUID) (*dto.SeasonResponse, error) {
+	season, err := s.seasonRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Season not found").WithCode(CodeSeasonNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch season", "error", err, "season_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toSeasonResponse(*season)
+	return &resp, nil
+}
+
+// Create adds a new season to a competition.
+func (s *seasonService) Create(ctx context.Context, competitionID uuid.UUID, req dto.CreateSeasonRequest) (*dto.SeasonResponse, error) {
+	if err := validateSeasonDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	// Verify competition exists
+	if _, err := s.competitionRepo.FindByID(competitionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Competition not found").WithCode(CodeCompetitionNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch competition for season creation", "error", err, "competition_id", competitionID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	season := model.Season{
+		CompetitionID: competitionID,
+		Name:          req.Name,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+	}
+
+	if err := s.seasonRepo.Create(&season); err != nil {
+		slog.ErrorContext(ctx, "failed to create season", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toSeasonResponse(season)
+	return &resp, nil
+}
+
+func (s *seasonService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateSeasonRequest) (*dto.SeasonResponse, error) {
+	if err := validateSeasonDates(req.StartDate, req.EndDate); err != nil {
+		return nil, err
+	}
+
+	season, err := s.seasonRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Season not found").WithCode(CodeSeasonNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch season for update", "error", err, "season_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	season.Name = req.Name
+	season.StartDate = req.StartDate
+	season.EndDate = req.EndDate
+
+	if err := s.seasonRepo.Update(season); err != nil {
+		slog.ErrorContext(ctx, "failed to update season", "error", err, "season_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toSeasonResponse(*season)
+	return &resp, nil
+}
+
+// Delete soft-deletes a season. Seasons that still have matches assigned cannot be deleted.
+func (s *seasonService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.seasonRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Season not found").WithCode(CodeSeasonNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch season for delete", "error", err, "season_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	matches, err := s.seasonRepo.CountMatches(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count matches for season delete", "error", err, "season_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	if matches > 0 {
+		return errs.ErrConflict("Season still has matches assigned").WithCode(CodeSeasonHasMatches)
+	}
+
+	if err := s.seasonRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete season", "error", err, "season_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// validateSeasonDates checks that both dates are YYYY-MM-DD and the season does not end before it starts.
+func validateSeasonDates(startDate, endDate string) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return errs.ErrBadRequest("Invalid start_date format, expected YYYY-MM-DD")
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return errs.ErrBadRequest("Invalid end_date format, expected YYYY-MM-DD")
+	}
+	if end.Before(start) {
+		return errs.ErrBadRequest("end_date must not be before start_date")
+	}
+	return nil
+}
+
+// parseSeasonFilter converts the season_id query parameter into a repository filter.
+func parseSeasonFilter(query dto.SeasonFilterQuery) (repository.MatchFilter, error) {
+	var filter repository.MatchFilter
+	if query.SeasonID == "" {
+		return filter, nil
+	}
+	seasonID, err := uuid.Parse(query.SeasonID)
+	if err != nil {
+		return filter, errs.ErrBadRequest("Invalid season_id format")
+	}
+	filter.SeasonID = &seasonID
+	return filter, nil
+}
+
+// toSeasonResponse converts a model.Season to dto.SeasonResponse.
+func toSeasonResponse(season model.Season) dto.SeasonResponse {
+	resp := dto.SeasonResponse{
+		ID:            season.ID.String(),
+		CompetitionID: season.CompetitionID.String(),
+		Name:          season.Name,
+		StartDate:     season.StartDate,
+		EndDate:       season.EndDate,
+		CreatedAt:     timefmt.Format(season.CreatedAt),
+		UpdatedAt:     timefmt.Format(season.UpdatedAt),
+	}
+
+	if season.Competition != nil {
+		competitionResp := toCompetitionResponse(*season.Competition)
+		resp.Competition = &competitionResp
+	}
+
+	return resp
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestSeasonService(t *testing.T) (*seasonService, *mocks.MockSeasonRepository, *mocks.MockCompetitionRepository) {
+	seasonRepo := mocks.NewMockSeasonRepository(t)
+	competitionRepo := mocks.NewMockCompetitionRepository(t)
+	svc := &seasonService{seasonRepo: seasonRepo, competitionRepo: competitionRepo}
+	return svc, seasonRepo, competitionRepo
+}
+
+func TestSeasonService_Create(t *testing.T) {
+	competitionID := uuid.Must(uuid.NewV7())
+	competition := &model.Competition{
+		Base: model.Base{ID: competitionID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		Name: "Liga 1",
+	}
+
+	tests := []struct {
+		name        string
+		req         dto.CreateSeasonRequest
+		setup       func(*mocks.MockSeasonRepository, *mocks.MockCompetitionRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success",
+			req:  dto.CreateSeasonRequest{Name: "2025/26", StartDate: "2025-08-01", EndDate: "2026-05-31"},
+			setup: func(sr *mocks.MockSeasonRepository, cr *mocks.MockCompetitionRepository) {
+				cr.EXPECT().FindByID(competitionID).Return(competition, nil)
+				sr.EXPECT().Create(mock.AnythingOfType("*model.Season")).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "invalid start date",
+			req:         dto.CreateSeasonRequest{Name: "2025/26", StartDate: "01-08-2025", EndDate: "2026-05-31"},
+			setup:       func(sr *mocks.MockSeasonRepository, cr *mocks.MockCompetitionRepository) {},
+			wantErr:     true,
+			errContains: "Invalid start_date",
+		},
+		{
+			name:        "ends before it starts",
+			req:         dto.CreateSeasonRequest{Name: "2025/26", StartDate: "2026-05-31", EndDate: "2025-08-01"},
+			setup:       func(sr *mocks.MockSeasonRepository, cr *mocks.MockCompetitionRepository) {},
+			wantErr:     true,
+			errContains: "end_date must not be before start_date",
+		},
+		{
+			name: "competition not found",
+			req:  dto.CreateSeasonRequest{Name: "2025/26", StartDate: "2025-08-01", EndDate: "2026-05-31"},
+			setup: func(sr *mocks.MockSeasonRepository, cr *mocks.MockCompetitionRepository) {
+				cr.EXPECT().FindByID(competitionID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Competition not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, seasonRepo, competitionRepo := newTestSeasonService(t)
+			tt.setup(seasonRepo, competitionRepo)
+
+			result, err := svc.Create(context.Background(), competitionID, tt.req)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.Name, result.Name)
+				assert.Equal(t, competitionID.String(), result.CompetitionID)
+			}
+		})
+	}
+}
+
+func TestSeasonService_Delete(t *testing.T) {
+	seasonID := uuid.Must(uuid.NewV7())
+	season := &model.Season{Base: model.Base{ID: seasonID}, Name: "2025/26"}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockSeasonRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(sr *mocks.MockSeasonRepository) {
+				sr.EXPECT().FindByID(seasonID).Return(season, nil)
+				sr.EXPECT().CountMatches(seasonID).Return(int64(0), nil)
+				sr.EXPECT().Delete(seasonID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "season has matches",
+			setup: func(sr *mocks.MockSeasonRepository) {
+				sr.EXPECT().FindByID(seasonID).Return(season, nil)
+				sr.EXPECT().CountMatches(seasonID).Return(int64(3), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "Season still has matches assigned",
+		},
+		{
+			name: "not found",
+			setup: func(sr *mocks.MockSeasonRepository) {
+				sr.EXPECT().FindByID(seasonID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Season not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, seasonRepo, _ := newTestSeasonService(t)
+			tt.setup(seasonRepo)
+
+			err := svc.Delete(context.Background(), seasonID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package service
+
+import "github.com/mhakimsaputra17/xyz-football-api/pkg/slug"
+
+// uniqueSlug returns a slug for name that no other record uses. current is the record's slug
+// (empty when creating), kept while it still derives from the name so renames that do not
+// change the slug keep URLs stable. findSlugs returns the slugs in use that could clash, and
+// reserved lists slugs given out but not saved yet, e.g. earlier rows of an import. fallback
+// is the base for names without any letters or digits.
+func uniqueSlug(name, current, fallback string, findSlugs func(base string) ([]string, error), reserved ...string) (string, error) {
+	base := slug.Make(name)
+	if base == "" {
+		base = fallback
+	}
+	if current != "" && slug.Derives(current, base) {
+		return current, nil
+	}
+
+	taken, err := findSlugs(base)
+	if err != nil {
+		return "", err
+	}
+	return slug.Unique(base, append(taken, reserved...)), nil
+}
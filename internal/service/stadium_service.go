@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// StadiumService defines the contract for stadium business logic.
+type StadiumService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.StadiumResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.StadiumResponse, error)
+	Create(ctx context.Context, req dto.CreateStadiumRequest) (*dto.StadiumResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateStadiumRequest) (*dto.StadiumResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type stadiumService struct {
+	stadiumRepo repository.StadiumRepository
+	cache       *ResponseCache
+}
+
+// NewStadiumService creates a new StadiumService instance.
+// Changes invalidate the cached match listings in responseCache (nil disables caching), which embed venues.
+func NewStadiumService(stadiumRepo repository.StadiumRepository, responseCache *ResponseCache) StadiumService {
+	return &stadiumService{
+		stadiumRepo: stadiumRepo,
+		cache:       responseCache,
+	}
+}
+
+func (s *stadiumService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.StadiumResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	stadiums, err := s.stadiumRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch stadiums", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.stadiumRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count stadiums", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	stadiumResponses := make([]dto.StadiumResponse, len(stadiums))
+	for i, stadium := range stadiums {
+		stadiumResponses[i] = toStadiumResponse(stadium)
+	}
+
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+
+	meta := &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+
+	return stadiumResponses, meta, nil
+}
+
+func (s *stadiumService) GetByID(ctx context.Context, id uuid.UUID) (*dto.StadiumResponse, error) {
+	stadium, err := s.stadiumRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Stadium not found").WithCode(CodeStadiumNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch stadium", "error", err, "stadium_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toStadiumResponse(*stadium)
+	return &resp, nil
+}
+
+func (s *stadiumService) Create(ctx context.Context, req dto.CreateStadiumRequest) (*dto.StadiumResponse, error) {
+	stadium := model.Stadium{
+		Name:     req.Name,
+		City:     req.City,
+		Capacity: req.Capacity,
+	}
+
+	if err := s.stadiumRepo.Create(&stadium); err != nil {
+		slog.ErrorContext(ctx, "failed to create stadium", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toStadiumResponse(stadium)
+	return &resp, nil
+}
+
+func (s *stadiumService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateStadiumRequest) (*dto.StadiumResponse, error) {
+	stadium, err := s.stadiumRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Stadium not found").WithCode(CodeStadiumNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch stadium for update", "error", err, "stadium_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	stadium.Name = req.Name
+	stadium.City = req.City
+	stadium.Capacity = req.Capacity
+
+	if err := s.stadiumRepo.Update(stadium); err != nil {
+		slog.ErrorContext(ctx, "failed to update stadium", "error", err, "stadium_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	s.cache.invalidate(ctx, cachePrefixMatches)
+
+	resp := toStadiumResponse(*stadium)
+	return &resp, nil
+}
+
+// Delete soft-deletes a stadium. A stadium that is still a team's home stadium or the venue
+// of a match cannot be deleted.
+func (s *stadiumService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.stadiumRepo.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrNotFound("Stadium not found").WithCode(CodeStadiumNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch stadium for delete", "error", err, "stadium_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	teams, matches, err := s.stadiumRepo.CountReferences(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count references for stadium delete", "error", err, "stadium_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	if teams > 0 || matches > 0 {
+		return errs.ErrConflict(fmt.Sprintf("Stadium is the home of %d teams and the venue of %d matches; reassign them first", teams, matches)).WithCode(CodeStadiumInUse)
+	}
+
+	if err := s.stadiumRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete stadium", "error", err, "stadium_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	return nil
+}
+
+// toStadiumResponse converts a model.Stadium to dto.StadiumResponse.
+func toStadiumResponse(stadium model.Stadium) dto.StadiumResponse {
+	return dto.StadiumResponse{
+		ID:        stadium.ID.String(),
+		Name:      stadium.Name,
+		City:      stadium.City,
+		Capacity:  stadium.Capacity,
+		CreatedAt: timefmt.Format(stadium.CreatedAt),
+		UpdatedAt: timefmt.Format(stadium.UpdatedAt),
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestStadiumService_Delete(t *testing.T) {
+	stadiumID := uuid.Must(uuid.NewV7())
+	stadium := &model.Stadium{Base: model.Base{ID: stadiumID}, Name: "Jakarta International Stadium"}
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockStadiumRepository)
+		wantErr     bool
+		errCode     int
+		errContains string
+	}{
+		{
+			name: "success",
+			setup: func(sr *mocks.MockStadiumRepository) {
+				sr.EXPECT().FindByID(stadiumID).Return(stadium, nil)
+				sr.EXPECT().CountReferences(stadiumID).Return(int64(0), int64(0), nil)
+				sr.EXPECT().Delete(stadiumID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "home stadium of a team",
+			setup: func(sr *mocks.MockStadiumRepository) {
+				sr.EXPECT().FindByID(stadiumID).Return(stadium, nil)
+				sr.EXPECT().CountReferences(stadiumID).Return(int64(1), int64(0), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "home of 1 teams",
+		},
+		{
+			name: "venue of matches",
+			setup: func(sr *mocks.MockStadiumRepository) {
+				sr.EXPECT().FindByID(stadiumID).Return(stadium, nil)
+				sr.EXPECT().CountReferences(stadiumID).Return(int64(0), int64(4), nil)
+			},
+			wantErr:     true,
+			errCode:     409,
+			errContains: "venue of 4 matches",
+		},
+		{
+			name: "not found",
+			setup: func(sr *mocks.MockStadiumRepository) {
+				sr.EXPECT().FindByID(stadiumID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errCode:     404,
+			errContains: "Stadium not found",
+		},
+		{
+			name: "db error",
+			setup: func(sr *mocks.MockStadiumRepository) {
+				sr.EXPECT().FindByID(stadiumID).Return(stadium, nil)
+				sr.EXPECT().CountReferences(stadiumID).Return(int64(0), int64(0), gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errCode:     500,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stadiumRepo := mocks.NewMockStadiumRepository(t)
+			tt.setup(stadiumRepo)
+			svc := NewStadiumService(stadiumRepo, nil)
+
+			err := svc.Delete(context.Background(), stadiumID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.errCode, appErr.Code)
+				assert.Contains(t, appErr.Message, tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
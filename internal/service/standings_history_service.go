@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// StandingsHistoryService defines the contract for recording league tables after every
+// completed match and charting a team's position over time.
+type StandingsHistoryService interface {
+	GetHistory(ctx context.Context, query dto.StandingHistoryQuery) (*dto.StandingHistoryResponse, error)
+	RecordSnapshot(ctx context.Context, matchID uuid.UUID, seasonID *uuid.UUID) error
+	Rebuild(ctx context.Context) error
+	HandleEvent(ctx context.Context, event events.Event) error
+}
+
+type standingsHistoryService struct {
+	standings    StandingsService
+	matchRepo    repository.MatchRepository
+	snapshotRepo repository.StandingSnapshotRepository
+	teamRepo     repository.TeamRepository
+}
+
+// NewStandingsHistoryService creates a new StandingsHistoryService instance.
+func NewStandingsHistoryService(standings StandingsService, matchRepo repository.MatchRepository, snapshotRepo repository.StandingSnapshotRepository, teamRepo repository.TeamRepository) StandingsHistoryService {
+	return &standingsHistoryService{standings: standings, matchRepo: matchRepo, snapshotRepo: snapshotRepo, teamRepo: teamRepo}
+}
+
+// GetHistory returns a team's position in the season table, or the all-time table without
+// a season, after every round it was recorded for, oldest first. When several matches end
+// the same round the table after the last one is reported.
+func (s *standingsHistoryService) GetHistory(ctx context.Context, query dto.StandingHistoryQuery) (*dto.StandingHistoryResponse, error) {
+	teamID, err := uuid.Parse(query.TeamID)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid team_id format")
+	}
+	filter, err := parseSeasonFilter(dto.SeasonFilterQuery{SeasonID: query.SeasonID})
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.FindByID(teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	snapshots, err := s.snapshotRepo.FindByTeamID(teamID, filter.SeasonID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch standings history", "error", err, "team_id", teamID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.StandingHistoryResponse{
+		Team:     toTeamResponse(*team),
+		SeasonID: query.SeasonID,
+		Rounds:   []dto.StandingHistoryEntry{},
+	}
+	for _, snapshot := range snapshots {
+		entry := dto.StandingHistoryEntry{
+			Round:          snapshot.Round,
+			MatchID:        snapshot.MatchID.String(),
+			Position:       snapshot.Position,
+			Played:         snapshot.Played,
+			Points:         snapshot.Points,
+			GoalDifference: snapshot.GoalDifference,
+			RecordedAt:     timefmt.Format(snapshot.CreatedAt),
+		}
+		// Snapshots are oldest first, so a later one for the same round replaces the earlier
+		if last := len(resp.Rounds) - 1; last >= 0 && resp.Rounds[last].Round == entry.Round {
+			resp.Rounds[last] = entry
+			continue
+		}
+		resp.Rounds = append(resp.Rounds, entry)
+	}
+	return resp, nil
+}
+
+// RecordSnapshot stores the all-time table and, for a match in a season, the season table as
+// they stand after the match, replacing any tables recorded for it before.
+func (s *standingsHistoryService) RecordSnapshot(ctx context.Context, matchID uuid.UUID, seasonID *uuid.UUID) error {
+	tables := []*uuid.UUID{nil}
+	if seasonID != nil {
+		tables = append(tables, seasonID)
+	}
+
+	var snapshots []model.StandingSnapshot
+	for _, tableSeasonID := range tables {
+		var filter dto.SeasonFilterQuery
+		if tableSeasonID != nil {
+			filter.SeasonID = tableSeasonID.String()
+		}
+		rows, err := s.standings.GetStandings(ctx, filter)
+		if err != nil {
+			return err
+		}
+		snapshots = append(snapshots, tableSnapshots(matchID, tableSeasonID, rows)...)
+	}
+
+	if err := s.snapshotRepo.ReplaceByMatchID(matchID, snapshots); err != nil {
+		slog.ErrorContext(ctx, "failed to record standings snapshot", "error", err, "match_id", matchID)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// Rebuild replays every completed match in kick-off order and replaces all recorded tables
+// with the tables as they stood after each one, for results saved without going through the
+// event feed, such as imported ones.
+func (s *standingsHistoryService) Rebuild(ctx context.Context) error {
+	matches, err := s.matchRepo.FindAllCompleted(repository.MatchFilter{})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for standings history", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	var snapshots []model.StandingSnapshot
+	seasons := make(map[uuid.UUID][]model.Match)
+	for i, match := range matches {
+		snapshots = append(snapshots, tableSnapshots(match.ID, nil, computeStandings(matches[:i+1]))...)
+		if match.SeasonID != nil {
+			played := append(seasons[*match.SeasonID], match)
+			seasons[*match.SeasonID] = played
+			snapshots = append(snapshots, tableSnapshots(match.ID, match.SeasonID, computeStandings(played))...)
+		}
+	}
+
+	if err := s.snapshotRepo.ReplaceAll(snapshots); err != nil {
+		slog.ErrorContext(ctx, "failed to rebuild standings history", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// tableSnapshots turns the rows of a table, the season table or the all-time one when
+// seasonID is nil, into the snapshots recorded after a match. The round is the most matches
+// any team has played.
+func tableSnapshots(matchID uuid.UUID, seasonID *uuid.UUID, rows []dto.StandingResponse) []model.StandingSnapshot {
+	round := 0
+	for _, row := range rows {
+		round = max(round, row.Played)
+	}
+	snapshots := make([]model.StandingSnapshot, 0, len(rows))
+	for _, row := range rows {
+		teamID, err := uuid.Parse(row.Team.ID)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, model.StandingSnapshot{
+			MatchID:        matchID,
+			SeasonID:       seasonID,
+			TeamID:         teamID,
+			Round:          round,
+			Position:       row.Position,
+			Played:         row.Played,
+			Points:         row.Points,
+			GoalDifference: row.GoalDifference,
+		})
+	}
+	return snapshots
+}
+
+// HandleEvent records the tables after every completed or corrected result and removes
+// them when a result is undone or its match deleted. Other events are ignored.
+func (s *standingsHistoryService) HandleEvent(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case FeedMatchCompleted, FeedMatchResultUpdated:
+		match, ok := event.Data.(dto.MatchResponse)
+		if !ok {
+			return nil
+		}
+		matchID, err := uuid.Parse(match.ID)
+		if err != nil {
+			return nil
+		}
+		var seasonID *uuid.UUID
+		if id, err := uuid.Parse(match.SeasonID); err == nil {
+			seasonID = &id
+		}
+		return s.RecordSnapshot(ctx, matchID, seasonID)
+
+	case FeedMatchStatusChanged:
+		change, ok := event.Data.(dto.MatchStatusChangedEvent)
+		if !ok || change.PreviousStatus != model.MatchStatusCompleted {
+			return nil
+		}
+		return s.removeSnapshots(ctx, change.MatchID)
+
+	case FeedMatchDeleted:
+		deleted, ok := event.Data.(dto.MatchDeletedEvent)
+		if !ok || deleted.Status != model.MatchStatusCompleted {
+			return nil
+		}
+		return s.removeSnapshots(ctx, deleted.MatchID)
+	}
+	return nil
+}
+
+// removeSnapshots deletes the tables recorded after the match with the given ID.
+func (s *standingsHistoryService) removeSnapshots(ctx context.Context, id string) error {
+	matchID, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+	if err := s.snapshotRepo.DeleteByMatchID(matchID); err != nil {
+		slog.ErrorContext(ctx, "failed to remove standings snapshot", "error", err, "match_id", matchID)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestStandingsHistoryService(t *testing.T) (StandingsHistoryService, *mocks.MockMatchRepository, *mocks.MockStandingSnapshotRepository, *mocks.MockTeamRepository) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	snapshotRepo := mocks.NewMockStandingSnapshotRepository(t)
+	teamRepo := mocks.NewMockTeamRepository(t)
+	svc := NewStandingsHistoryService(NewStandingsService(matchRepo, nil), matchRepo, snapshotRepo, teamRepo)
+	return svc, matchRepo, snapshotRepo, teamRepo
+}
+
+func TestStandingsHistoryService_HandleEvent_RecordsTables(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	matchID, seasonID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	svc, matchRepo, snapshotRepo, _ := newTestStandingsHistoryService(t)
+	matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+		completedMatch(persija, arema, 3, 0),
+		completedMatch(persib, persija, 2, 1),
+	}, nil)
+	matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return([]model.Match{
+		completedMatch(persib, persija, 2, 1),
+	}, nil)
+
+	var recorded []model.StandingSnapshot
+	snapshotRepo.EXPECT().ReplaceByMatchID(matchID, mock.Anything).
+		Run(func(_ uuid.UUID, snapshots []model.StandingSnapshot) { recorded = snapshots }).
+		Return(nil)
+
+	err := svc.HandleEvent(context.Background(), events.Event{
+		Type: FeedMatchCompleted,
+		Data: dto.MatchResponse{ID: matchID.String(), SeasonID: seasonID.String()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, recorded, 5)
+	// All-time table first: Persija have played twice, so it is round 2
+	assert.Nil(t, recorded[0].SeasonID)
+	assert.Equal(t, persija.ID, recorded[0].TeamID)
+	assert.Equal(t, 1, recorded[0].Position)
+	assert.Equal(t, 2, recorded[0].Round)
+	assert.Equal(t, 3, recorded[0].Points)
+	assert.Equal(t, 2, recorded[0].GoalDifference)
+	// Then the season table, after its first round
+	assert.Equal(t, &seasonID, recorded[3].SeasonID)
+	assert.Equal(t, persib.ID, recorded[3].TeamID)
+	assert.Equal(t, 1, recorded[3].Round)
+	for _, snapshot := range recorded {
+		assert.Equal(t, matchID, snapshot.MatchID)
+	}
+}
+
+func TestStandingsHistoryService_HandleEvent_UndoneResult(t *testing.T) {
+	matchID := uuid.Must(uuid.NewV7())
+	svc, _, snapshotRepo, _ := newTestStandingsHistoryService(t)
+	snapshotRepo.EXPECT().DeleteByMatchID(matchID).Return(nil)
+
+	err := svc.HandleEvent(context.Background(), events.Event{
+		Type: FeedMatchStatusChanged,
+		Data: dto.MatchStatusChangedEvent{MatchID: matchID.String(), PreviousStatus: model.MatchStatusCompleted, Status: model.MatchStatusScheduled},
+	})
+	require.NoError(t, err)
+
+	// Other status changes leave the history alone
+	err = svc.HandleEvent(context.Background(), events.Event{
+		Type: FeedMatchStatusChanged,
+		Data: dto.MatchStatusChangedEvent{MatchID: matchID.String(), PreviousStatus: model.MatchStatusScheduled, Status: model.MatchStatusLive},
+	})
+	require.NoError(t, err)
+}
+
+func TestStandingsHistoryService_Rebuild(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	seasonID := uuid.Must(uuid.NewV7())
+
+	opener := completedMatch(persija, persib, 2, 1)
+	opener.SeasonID = &seasonID
+	friendly := completedMatch(persija, arema, 0, 0)
+	second := completedMatch(persib, arema, 1, 0)
+	second.SeasonID = &seasonID
+
+	t.Run("replays the tables after every match", func(t *testing.T) {
+		svc, matchRepo, snapshotRepo, _ := newTestStandingsHistoryService(t)
+		matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{opener, friendly, second}, nil)
+		var recorded []model.StandingSnapshot
+		snapshotRepo.EXPECT().ReplaceAll(mock.Anything).
+			Run(func(snapshots []model.StandingSnapshot) { recorded = snapshots }).
+			Return(nil)
+
+		require.NoError(t, svc.Rebuild(context.Background()))
+
+		// Opener: all-time and season tables of two teams; friendly: all-time table of three;
+		// second: all-time and season tables of three
+		require.Len(t, recorded, 2+2+3+3+3)
+		after := func(matchID uuid.UUID, seasonID *uuid.UUID) []model.StandingSnapshot {
+			var rows []model.StandingSnapshot
+			for _, snapshot := range recorded {
+				if snapshot.MatchID == matchID && (snapshot.SeasonID == nil) == (seasonID == nil) {
+					rows = append(rows, snapshot)
+				}
+			}
+			return rows
+		}
+
+		openerSeason := after(opener.ID, &seasonID)
+		require.Len(t, openerSeason, 2)
+		assert.Equal(t, persija.ID, openerSeason[0].TeamID)
+		assert.Equal(t, 1, openerSeason[0].Round)
+		assert.Equal(t, 3, openerSeason[0].Points)
+
+		// The friendly counts in the all-time table only
+		friendlyAllTime := after(friendly.ID, nil)
+		require.Len(t, friendlyAllTime, 3)
+		assert.Equal(t, persija.ID, friendlyAllTime[0].TeamID)
+		assert.Equal(t, 2, friendlyAllTime[0].Round)
+		assert.Equal(t, 4, friendlyAllTime[0].Points)
+		assert.Empty(t, after(friendly.ID, &seasonID))
+
+		secondSeason := after(second.ID, &seasonID)
+		require.Len(t, secondSeason, 3)
+		// Persija top the season on goal difference; Persib's two matches make it round 2
+		assert.Equal(t, persija.ID, secondSeason[0].TeamID)
+		assert.Equal(t, 1, secondSeason[0].Played)
+		assert.Equal(t, persib.ID, secondSeason[1].TeamID)
+		assert.Equal(t, 2, secondSeason[1].Played)
+		assert.Equal(t, 3, secondSeason[1].Points)
+		for _, snapshot := range secondSeason {
+			assert.Equal(t, &seasonID, snapshot.SeasonID)
+			assert.Equal(t, 2, snapshot.Round)
+		}
+	})
+
+	t.Run("no completed matches clears the history", func(t *testing.T) {
+		svc, matchRepo, snapshotRepo, _ := newTestStandingsHistoryService(t)
+		matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{}, nil)
+		snapshotRepo.EXPECT().ReplaceAll([]model.StandingSnapshot(nil)).Return(nil)
+
+		require.NoError(t, svc.Rebuild(context.Background()))
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		svc, matchRepo, _, _ := newTestStandingsHistoryService(t)
+		matchRepo.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+
+		err := svc.Rebuild(context.Background())
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Internal server error", appErr.Message)
+	})
+}
+
+func TestStandingsHistoryService_GetHistory(t *testing.T) {
+	teamID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	recordedAt := time.Date(2025, 6, 15, 14, 30, 0, 0, time.UTC)
+	snapshot := func(round, position, points int) model.StandingSnapshot {
+		recordedAt = recordedAt.Add(time.Hour)
+		return model.StandingSnapshot{
+			Base:     model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: recordedAt},
+			MatchID:  uuid.Must(uuid.NewV7()),
+			SeasonID: &seasonID,
+			TeamID:   teamID,
+			Round:    round,
+			Position: position,
+			Played:   round,
+			Points:   points,
+		}
+	}
+
+	t.Run("latest table of every round", func(t *testing.T) {
+		svc, _, snapshotRepo, teamRepo := newTestStandingsHistoryService(t)
+		teamRepo.EXPECT().FindByID(teamID).Return(&model.Team{Base: model.Base{ID: teamID}, Name: "Persija Jakarta"}, nil)
+		snapshots := []model.StandingSnapshot{snapshot(1, 4, 0), snapshot(1, 2, 3), snapshot(2, 1, 6)}
+		snapshotRepo.EXPECT().FindByTeamID(teamID, &seasonID).Return(snapshots, nil)
+
+		resp, err := svc.GetHistory(context.Background(), dto.StandingHistoryQuery{TeamID: teamID.String(), SeasonID: seasonID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Persija Jakarta", resp.Team.Name)
+		assert.Equal(t, seasonID.String(), resp.SeasonID)
+		require.Len(t, resp.Rounds, 2)
+		assert.Equal(t, 1, resp.Rounds[0].Round)
+		assert.Equal(t, 2, resp.Rounds[0].Position)
+		assert.Equal(t, snapshots[1].MatchID.String(), resp.Rounds[0].MatchID)
+		assert.Equal(t, 2, resp.Rounds[1].Round)
+		assert.Equal(t, 1, resp.Rounds[1].Position)
+		assert.Equal(t, "2025-06-15T17:30:00.000Z", resp.Rounds[1].RecordedAt)
+	})
+
+	t.Run("all-time table without history", func(t *testing.T) {
+		svc, _, snapshotRepo, teamRepo := newTestStandingsHistoryService(t)
+		teamRepo.EXPECT().FindByID(teamID).Return(&model.Team{Base: model.Base{ID: teamID}}, nil)
+		snapshotRepo.EXPECT().FindByTeamID(teamID, (*uuid.UUID)(nil)).Return(nil, nil)
+
+		resp, err := svc.GetHistory(context.Background(), dto.StandingHistoryQuery{TeamID: teamID.String()})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.SeasonID)
+		assert.NotNil(t, resp.Rounds)
+		assert.Empty(t, resp.Rounds)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		svc, _, _, teamRepo := newTestStandingsHistoryService(t)
+		teamRepo.EXPECT().FindByID(teamID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.GetHistory(context.Background(), dto.StandingHistoryQuery{TeamID: teamID.String()})
+
+		var appErr *errs.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.Code)
+		assert.Equal(t, CodeTeamNotFound, appErr.ErrorCode)
+	})
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// StandingsService defines the contract for computing league tables.
+type StandingsService interface {
+	GetStandings(ctx context.Context, seasonFilter dto.SeasonFilterQuery) ([]dto.StandingResponse, error)
+	WarmCache(ctx context.Context) (int, error)
+}
+
+type standingsService struct {
+	matchRepo repository.MatchRepository
+	cache     *ResponseCache
+}
+
+// NewStandingsService creates a new StandingsService instance.
+// Computed tables are cached in responseCache (nil disables caching).
+func NewStandingsService(matchRepo repository.MatchRepository, responseCache *ResponseCache) StandingsService {
+	return &standingsService{matchRepo: matchRepo, cache: responseCache}
+}
+
+// GetStandings computes the league table from completed matches, optionally limited to one season.
+// Only teams that have played at least one completed match appear in the table.
+func (s *standingsService) GetStandings(ctx context.Context, seasonFilter dto.SeasonFilterQuery) ([]dto.StandingResponse, error) {
+	filter, err := parseSeasonFilter(seasonFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	return cached(ctx, s.cache, cacheKey(cachePrefixStandings, filter), func() ([]dto.StandingResponse, error) {
+		matches, err := s.matchRepo.FindAllCompleted(filter)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to fetch completed matches for standings", "error", err)
+			return nil, errs.ErrInternal("Internal server error")
+		}
+		return computeStandings(matches), nil
+	})
+}
+
+// WarmCache recomputes the all-time table and the table of every season with completed matches
+// and stores them in the cache, so requests after a result change or TTL expiry are served
+// without a database round trip. Returns how many tables were cached; a no-op without a cache.
+func (s *standingsService) WarmCache(ctx context.Context) (int, error) {
+	if s.cache == nil {
+		return 0, nil
+	}
+
+	matches, err := s.matchRepo.FindAllCompleted(repository.MatchFilter{})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch completed matches for standings", "error", err)
+		return 0, errs.ErrInternal("Internal server error")
+	}
+
+	bySeason := make(map[uuid.UUID][]model.Match)
+	for _, match := range matches {
+		if match.SeasonID != nil {
+			bySeason[*match.SeasonID] = append(bySeason[*match.SeasonID], match)
+		}
+	}
+
+	store(ctx, s.cache, cacheKey(cachePrefixStandings, repository.MatchFilter{}), computeStandings(matches))
+	for seasonID, seasonMatches := range bySeason {
+		filter := repository.MatchFilter{SeasonID: &seasonID}
+		store(ctx, s.cache, cacheKey(cachePrefixStandings, filter), computeStandings(seasonMatches))
+	}
+	return len(bySeason) + 1, nil
+}
+
+// computeStandings aggregates match results into ranked table rows with each team's form.
+// Matches must be in kick-off order. Every match awards points by its competition's rules, so a
+// table across competitions adds up each one's points. Ties are broken by the tiebreakers of the
+// table's competition, or the default ones when it spans several, then by team name.
+// The given teams are listed even if they have not played yet, as in a group table.
+func computeStandings(matches []model.Match, teams ...model.Team) []dto.StandingResponse {
+	rows := make(map[uuid.UUID]*dto.StandingResponse)
+	row := func(teamID uuid.UUID, team *model.Team) *dto.StandingResponse {
+		if r, ok := rows[teamID]; ok {
+			return r
+		}
+		r := &dto.StandingResponse{Team: dto.TeamResponse{ID: teamID.String()}}
+		if team != nil {
+			r.Team = toTeamResponse(*team)
+		}
+		rows[teamID] = r
+		return r
+	}
+	for i := range teams {
+		row(teams[i].ID, &teams[i])
+	}
+
+	for _, match := range matches {
+		home := row(match.HomeTeamID, match.HomeTeam)
+		away := row(match.AwayTeamID, match.AwayTeam)
+
+		home.Played++
+		away.Played++
+		home.GoalsFor += match.HomeScore
+		home.GoalsAgainst += match.AwayScore
+		away.GoalsFor += match.AwayScore
+		away.GoalsAgainst += match.HomeScore
+
+		// A shootout only decides the winner if the competition counts shootout wins
+		switch match.Winner() {
+		case match.HomeTeamID:
+			home.Won++
+			away.Lost++
+		case match.AwayTeamID:
+			away.Won++
+			home.Lost++
+		default:
+			home.Drawn++
+			away.Drawn++
+		}
+
+		homePoints, awayPoints := matchPoints(match)
+		homeBonus, awayBonus := bonusPoints(match)
+		home.Points += homePoints + homeBonus
+		away.Points += awayPoints + awayBonus
+		home.BonusPoints += homeBonus
+		away.BonusPoints += awayBonus
+	}
+
+	results := teamResults(matches)
+	standings := make([]dto.StandingResponse, 0, len(rows))
+	for teamID, r := range rows {
+		r.GoalDifference = r.GoalsFor - r.GoalsAgainst
+		r.TeamForm = computeForm(results[teamID])
+		standings = append(standings, *r)
+	}
+
+	rankStandings(standings, matches, tableRules(matches))
+	return standings
+}
+
+// matchPoints returns the points the home and away team earn for the result of a match, by the
+// rules of its competition, without bonus points.
+func matchPoints(match model.Match) (home, away int) {
+	rules := match.PointsRules()
+	switch match.Winner() {
+	case match.HomeTeamID:
+		return rules.Win, 0
+	case match.AwayTeamID:
+		return 0, rules.Win
+	default:
+		return rules.Draw, rules.Draw
+	}
+}
+
+// bonusPoints returns the bonus points the home and away team earn in a match under its
+// competition's bonus rules: one for scoring at least BonusGoals and one for winning by at
+// least BonusMargin goals. Shootout goals don't count.
+func bonusPoints(match model.Match) (home, away int) {
+	rules := match.PointsRules()
+	if rules.BonusGoals > 0 {
+		if match.HomeScore >= rules.BonusGoals {
+			home++
+		}
+		if match.AwayScore >= rules.BonusGoals {
+			away++
+		}
+	}
+	if rules.BonusMargin > 0 {
+		if match.HomeScore-match.AwayScore >= rules.BonusMargin {
+			home++
+		}
+		if match.AwayScore-match.HomeScore >= rules.BonusMargin {
+			away++
+		}
+	}
+	return home, away
+}
+
+// tableRules returns the rules ranking a table of matches: those of their competition when
+// they all belong to the same one, otherwise the default rules.
+func tableRules(matches []model.Match) model.PointsRules {
+	var competition *model.Competition
+	for _, match := range matches {
+		if match.Season == nil || match.Season.Competition == nil {
+			return model.DefaultPointsRules
+		}
+		if competition != nil && competition.ID != match.Season.Competition.ID {
+			return model.DefaultPointsRules
+		}
+		competition = match.Season.Competition
+	}
+	if competition == nil {
+		return model.DefaultPointsRules
+	}
+	return competition.PointsRules()
+}
+
+// rankStandings sorts the rows by points, then rules' tiebreakers, then team name, and numbers
+// their positions.
+func rankStandings(standings []dto.StandingResponse, matches []model.Match, rules model.PointsRules) {
+	var headToHead map[string][2]int
+	if i := slices.Index(rules.Tiebreakers, model.TiebreakerHeadToHead); i >= 0 {
+		headToHead = headToHeadRecords(standings, matches, rules.Tiebreakers[:i])
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		for _, tiebreaker := range rules.Tiebreakers {
+			if tiebreaker == model.TiebreakerHeadToHead {
+				ha, hb := headToHead[a.Team.ID], headToHead[b.Team.ID]
+				if ha != hb {
+					return ha[0] > hb[0] || (ha[0] == hb[0] && ha[1] > hb[1])
+				}
+				continue
+			}
+			if va, vb := tiebreakValue(a, tiebreaker), tiebreakValue(b, tiebreaker); va != vb {
+				return va > vb
+			}
+		}
+		return a.Team.Name < b.Team.Name
+	})
+
+	for i := range standings {
+		standings[i].Position = i + 1
+	}
+}
+
+// tiebreakValue returns a row's value for a tiebreaker other than head-to-head; higher ranks first.
+func tiebreakValue(row dto.StandingResponse, tiebreaker string) int {
+	switch tiebreaker {
+	case model.TiebreakerGoalDifference:
+		return row.GoalDifference
+	case model.TiebreakerGoalsFor:
+		return row.GoalsFor
+	case model.TiebreakerWins:
+		return row.Won
+	default:
+		return 0
+	}
+}
+
+// headToHeadRecords returns, by team ID, the points and goal difference of each team in the
+// matches between teams level on points and on the tiebreakers applied before head-to-head.
+// Teams level with no one are left out.
+func headToHeadRecords(standings []dto.StandingResponse, matches []model.Match, before []string) map[string][2]int {
+	level := make(map[string]string, len(standings)) // Team ID to the key of the teams it is level with
+	counts := make(map[string]int)
+	for _, row := range standings {
+		key := strconv.Itoa(row.Points)
+		for _, tiebreaker := range before {
+			key += "/" + strconv.Itoa(tiebreakValue(row, tiebreaker))
+		}
+		level[row.Team.ID] = key
+		counts[key]++
+	}
+
+	records := make(map[string][2]int)
+	for _, match := range matches {
+		home, away := match.HomeTeamID.String(), match.AwayTeamID.String()
+		if level[home] != level[away] || counts[level[home]] < 2 {
+			continue
+		}
+		homePoints, awayPoints := matchPoints(match)
+		h, a := records[home], records[away]
+		h[0] += homePoints
+		h[1] += match.HomeScore - match.AwayScore
+		a[0] += awayPoints
+		a[1] += match.AwayScore - match.HomeScore
+		records[home], records[away] = h, a
+	}
+	return records
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func completedMatch(home, away *model.Team, homeScore, awayScore int) model.Match {
+	return model.Match{
+		Base:       model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		HomeTeamID: home.ID,
+		AwayTeamID: away.ID,
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+		Status:     "completed",
+		HomeTeam:   home,
+		AwayTeam:   away,
+	}
+}
+
+func TestStandingsService_GetStandings(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	seasonID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		filter      dto.SeasonFilterQuery
+		setup       func(*mocks.MockMatchRepository)
+		wantErr     bool
+		errContains string
+		wantOrder   []string
+		wantPoints  []int
+	}{
+		{
+			name:   "ranks by points then goal difference",
+			filter: dto.SeasonFilterQuery{},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					completedMatch(persija, persib, 2, 1),
+					completedMatch(persib, arema, 3, 0),
+					completedMatch(arema, persija, 1, 1),
+				}, nil)
+			},
+			wantOrder:  []string{"Persija Jakarta", "Persib Bandung", "Arema FC"},
+			wantPoints: []int{4, 3, 1},
+		},
+		{
+			name:   "shootout wins count only where the competition says so",
+			filter: dto.SeasonFilterQuery{},
+			setup: func(mr *mocks.MockMatchRepository) {
+				pens := func(match model.Match, home, away int, rule string) model.Match {
+					match.HomeShootoutScore, match.AwayShootoutScore = &home, &away
+					match.Season = &model.Season{Competition: &model.Competition{ShootoutRule: rule}}
+					return match
+				}
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return([]model.Match{
+					pens(completedMatch(persija, persib, 1, 1), 3, 4, model.ShootoutRuleWin),
+					pens(completedMatch(persija, arema, 0, 0), 5, 4, model.ShootoutRuleDraw),
+				}, nil)
+			},
+			wantOrder:  []string{"Persib Bandung", "Persija Jakarta", "Arema FC"},
+			wantPoints: []int{3, 1, 1},
+		},
+		{
+			name:   "filtered by season",
+			filter: dto.SeasonFilterQuery{SeasonID: seasonID.String()},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{SeasonID: &seasonID}).Return([]model.Match{}, nil)
+			},
+			wantOrder:  []string{},
+			wantPoints: []int{},
+		},
+		{
+			name:        "invalid season id",
+			filter:      dto.SeasonFilterQuery{SeasonID: "not-a-uuid"},
+			setup:       func(mr *mocks.MockMatchRepository) {},
+			wantErr:     true,
+			errContains: "Invalid season_id",
+		},
+		{
+			name:   "db error",
+			filter: dto.SeasonFilterQuery{},
+			setup: func(mr *mocks.MockMatchRepository) {
+				mr.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchRepo := mocks.NewMockMatchRepository(t)
+			tt.setup(matchRepo)
+			svc := NewStandingsService(matchRepo, nil)
+
+			standings, err := svc.GetStandings(context.Background(), tt.filter)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, standings, len(tt.wantOrder))
+			for i, row := range standings {
+				assert.Equal(t, i+1, row.Position)
+				assert.Equal(t, tt.wantOrder[i], row.Team.Name)
+				assert.Equal(t, tt.wantPoints[i], row.Points)
+			}
+		})
+	}
+}
+
+func TestComputeStandings_PointsRules(t *testing.T) {
+	persija := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	persib := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	arema := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arema FC"}
+	bali := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Bali United"}
+	in := func(competition *model.Competition, match model.Match) model.Match {
+		match.Season = &model.Season{CompetitionID: competition.ID, Competition: competition}
+		return match
+	}
+	names := func(standings []dto.StandingResponse) []string {
+		var order []string
+		for _, row := range standings {
+			order = append(order, row.Team.Name)
+		}
+		return order
+	}
+	intPtr := func(v int) *int { return &v }
+
+	t.Run("points per result and bonus points", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, PointsForWin: intPtr(2), BonusGoals: 4}
+
+		standings := computeStandings([]model.Match{
+			in(competition, completedMatch(persija, persib, 4, 4)),
+			in(competition, completedMatch(persija, arema, 2, 0)),
+		})
+
+		assert.Equal(t, []string{"Persija Jakarta", "Persib Bandung", "Arema FC"}, names(standings))
+		assert.Equal(t, 4, standings[0].Points) // A draw, a win and a bonus point for four goals
+		assert.Equal(t, 1, standings[0].BonusPoints)
+		assert.Equal(t, 2, standings[1].Points)
+		assert.Equal(t, 0, standings[2].Points)
+	})
+
+	t.Run("winning margin bonus", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, BonusMargin: 3}
+
+		standings := computeStandings([]model.Match{in(competition, completedMatch(persija, persib, 0, 3))})
+
+		assert.Equal(t, "Persib Bandung", standings[0].Team.Name)
+		assert.Equal(t, 4, standings[0].Points)
+		assert.Equal(t, 1, standings[0].BonusPoints)
+	})
+
+	matches := func(competition *model.Competition) []model.Match {
+		return []model.Match{
+			in(competition, completedMatch(persija, persib, 1, 0)),
+			in(competition, completedMatch(persib, arema, 4, 0)),
+			in(competition, completedMatch(persija, bali, 0, 1)),
+		}
+	}
+
+	t.Run("goal difference by default", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}}
+
+		standings := computeStandings(matches(competition))
+
+		assert.Equal(t, []string{"Persib Bandung", "Bali United", "Persija Jakarta", "Arema FC"}, names(standings))
+	})
+
+	t.Run("head-to-head among the teams level on points", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Tiebreakers: "head_to_head,goal_difference"}
+
+		standings := computeStandings(matches(competition))
+
+		// Level on 3 points, Persija and Bali beat Persib between them, and Bali beat Persija
+		assert.Equal(t, []string{"Bali United", "Persija Jakarta", "Persib Bandung", "Arema FC"}, names(standings))
+	})
+
+	t.Run("tables across competitions keep the default tiebreakers", func(t *testing.T) {
+		headToHead := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Tiebreakers: "head_to_head"}
+		table := matches(headToHead)
+		table[1].Season = nil
+
+		standings := computeStandings(table)
+
+		assert.Equal(t, []string{"Persib Bandung", "Bali United", "Persija Jakarta", "Arema FC"}, names(standings))
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"gorm.io/gorm"
+)
+
+// StatsService defines the contract for aggregated statistics.
+type StatsService interface {
+	GetPlayerStats(ctx context.Context, playerID uuid.UUID) (*dto.PlayerStatsResponse, error)
+}
+
+type statsService struct {
+	playerRepo repository.PlayerRepository
+	statsRepo  repository.StatsRepository
+}
+
+// NewStatsService creates a new StatsService instance.
+func NewStatsService(playerRepo repository.PlayerRepository, statsRepo repository.StatsRepository) StatsService {
+	return &statsService{playerRepo: playerRepo, statsRepo: statsRepo}
+}
+
+// GetPlayerStats returns a player's appearances and goals over completed matches,
+// in total and per season. The aggregation runs in the database.
+func (s *statsService) GetPlayerStats(ctx context.Context, playerID uuid.UUID) (*dto.PlayerStatsResponse, error) {
+	player, err := s.playerRepo.FindByID(playerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Player not found").WithCode(CodePlayerNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch player", "error", err, "player_id", playerID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	rows, err := s.statsRepo.PlayerStats(playerID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to aggregate player stats", "error", err, "player_id", playerID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.PlayerStatsResponse{
+		Player:  toPlayerResponse(*player),
+		Seasons: make([]dto.PlayerSeasonStats, 0, len(rows)),
+	}
+	for _, row := range rows {
+		if row.Total {
+			resp.PlayerStatLine = toPlayerStatLine(row)
+			continue
+		}
+		season := dto.PlayerSeasonStats{PlayerStatLine: toPlayerStatLine(row)}
+		if row.SeasonID != nil {
+			season.SeasonID = row.SeasonID.String()
+		}
+		if row.SeasonName != nil {
+			season.SeasonName = *row.SeasonName
+		}
+		resp.Seasons = append(resp.Seasons, season)
+	}
+	return resp, nil
+}
+
+func toPlayerStatLine(row repository.PlayerStatsRow) dto.PlayerStatLine {
+	line := dto.PlayerStatLine{
+		MatchesPlayed:   row.MatchesPlayed,
+		Goals:           row.Goals,
+		FirstGoalMinute: row.FirstGoalMinute,
+		LastGoalMinute:  row.LastGoalMinute,
+	}
+	if row.MatchesPlayed > 0 {
+		line.GoalsPerMatch = math.Round(float64(row.Goals)/float64(row.MatchesPlayed)*100) / 100
+	}
+	return line
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestStatsService_GetPlayerStats(t *testing.T) {
+	playerID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	player := &model.Player{Base: model.Base{ID: playerID}, Name: "Marko Simic"}
+	minute := func(m int) *int { return &m }
+	seasonName := "2025/26"
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockPlayerRepository, *mocks.MockStatsRepository)
+		wantErr     bool
+		errContains string
+		want        *dto.PlayerStatsResponse
+	}{
+		{
+			name: "total and per-season rows",
+			setup: func(pr *mocks.MockPlayerRepository, sr *mocks.MockStatsRepository) {
+				pr.EXPECT().FindByID(playerID).Return(player, nil)
+				sr.EXPECT().PlayerStats(playerID).Return([]repository.PlayerStatsRow{
+					{Total: true, MatchesPlayed: 3, Goals: 2, FirstGoalMinute: minute(4), LastGoalMinute: minute(88)},
+					{SeasonID: &seasonID, SeasonName: &seasonName, MatchesPlayed: 2, Goals: 2, FirstGoalMinute: minute(4), LastGoalMinute: minute(88)},
+					{MatchesPlayed: 1},
+				}, nil)
+			},
+			want: &dto.PlayerStatsResponse{
+				PlayerStatLine: dto.PlayerStatLine{MatchesPlayed: 3, Goals: 2, GoalsPerMatch: 0.67, FirstGoalMinute: minute(4), LastGoalMinute: minute(88)},
+				Seasons: []dto.PlayerSeasonStats{
+					{SeasonID: seasonID.String(), SeasonName: "2025/26", PlayerStatLine: dto.PlayerStatLine{MatchesPlayed: 2, Goals: 2, GoalsPerMatch: 1, FirstGoalMinute: minute(4), LastGoalMinute: minute(88)}},
+					{PlayerStatLine: dto.PlayerStatLine{MatchesPlayed: 1}},
+				},
+			},
+		},
+		{
+			name: "no appearances",
+			setup: func(pr *mocks.MockPlayerRepository, sr *mocks.MockStatsRepository) {
+				pr.EXPECT().FindByID(playerID).Return(player, nil)
+				sr.EXPECT().PlayerStats(playerID).Return([]repository.PlayerStatsRow{{Total: true}}, nil)
+			},
+			want: &dto.PlayerStatsResponse{Seasons: []dto.PlayerSeasonStats{}},
+		},
+		{
+			name: "player not found",
+			setup: func(pr *mocks.MockPlayerRepository, sr *mocks.MockStatsRepository) {
+				pr.EXPECT().FindByID(playerID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr:     true,
+			errContains: "Player not found",
+		},
+		{
+			name: "db error",
+			setup: func(pr *mocks.MockPlayerRepository, sr *mocks.MockStatsRepository) {
+				pr.EXPECT().FindByID(playerID).Return(player, nil)
+				sr.EXPECT().PlayerStats(playerID).Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerRepo := mocks.NewMockPlayerRepository(t)
+			statsRepo := mocks.NewMockStatsRepository(t)
+			tt.setup(playerRepo, statsRepo)
+			svc := NewStatsService(playerRepo, statsRepo)
+
+			stats, err := svc.GetPlayerStats(context.Background(), playerID)
+
+			if tt.wantErr {
+				var appErr *errs.AppError
+				assert.ErrorAs(t, err, &appErr)
+				assert.Contains(t, appErr.Message, tt.errContains)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Marko Simic", stats.Player.Name)
+			assert.Equal(t, tt.want.PlayerStatLine, stats.PlayerStatLine)
+			assert.Equal(t, tt.want.Seasons, stats.Seasons)
+		})
+	}
+}
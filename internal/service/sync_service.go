@@ -0,0 +1,534 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/footballapi"
+	"gorm.io/gorm"
+)
+
+// SyncCounts counts what a sync did with the records of one kind.
+type SyncCounts struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	Skipped   int // Players and matches of teams the provider did not list for the season
+}
+
+// SyncReport is the outcome of a sync.
+type SyncReport struct {
+	Provider    string
+	Competition model.Competition
+	Season      model.Season
+	Teams       SyncCounts
+	Players     SyncCounts
+	Matches     SyncCounts
+}
+
+// SyncService defines the contract for importing a competition from an external football
+// data provider.
+type SyncService interface {
+	Sync(ctx context.Context, competition string, season int) (*SyncReport, error)
+}
+
+type syncService struct {
+	provider        footballapi.Provider
+	refRepo         repository.ExternalRefRepository
+	competitionRepo repository.CompetitionRepository
+	seasonRepo      repository.SeasonRepository
+	teamRepo        repository.TeamRepository
+	playerRepo      repository.PlayerRepository
+	matchRepo       repository.MatchRepository
+	eventRepo       repository.MatchEventRepository
+	ratings         RatingService
+	history         StandingsHistoryService
+	cache           *ResponseCache
+}
+
+// NewSyncService creates a new SyncService importing from provider.
+// Changed results replay the ratings and rebuild the standings history, and any change drops
+// the cached responses (nil disables caching).
+func NewSyncService(provider footballapi.Provider, refRepo repository.ExternalRefRepository, competitionRepo repository.CompetitionRepository, seasonRepo repository.SeasonRepository, teamRepo repository.TeamRepository, playerRepo repository.PlayerRepository, matchRepo repository.MatchRepository, eventRepo repository.MatchEventRepository, ratings RatingService, history StandingsHistoryService, responseCache *ResponseCache) SyncService {
+	return &syncService{
+		provider:        provider,
+		refRepo:         refRepo,
+		competitionRepo: competitionRepo,
+		seasonRepo:      seasonRepo,
+		teamRepo:        teamRepo,
+		playerRepo:      playerRepo,
+		matchRepo:       matchRepo,
+		eventRepo:       eventRepo,
+		ratings:         ratings,
+		history:         history,
+		cache:           responseCache,
+	}
+}
+
+// Sync imports a season of a competition from the provider: the competition, the season,
+// its teams with their squads and its fixtures with their results. competition is the
+// provider's code or ID of the competition and season the year it starts in.
+//
+// Every imported record is mapped to its ID at the provider, so a sync run again updates
+// the records it imported before instead of creating new ones; the provider's data wins over
+// local edits of the fields it fills. A team the provider lists under the name of an existing
+// team is taken to be that team, as is a player named like one in the team's squad. Records
+// deleted here are created again. Each record is saved together with its mapping, and the
+// records saved before a sync fails are kept, as running it again picks up where it stopped.
+//
+// Results are saved directly rather than submitted, so no feed events are published for them.
+// Instead, once a sync has changed any result, the ratings are replayed and the standings
+// history rebuilt, as they would be for results submitted here.
+//
+// Sync runs outside of requests, so its errors describe what failed rather than being API
+// errors.
+func (s *syncService) Sync(ctx context.Context, competition string, season int) (*SyncReport, error) {
+	source, err := s.provider.Competition(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.provider.Teams(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+	players, err := s.provider.Players(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.provider.Matches(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SyncReport{Provider: s.provider.Name()}
+	if err := s.syncCompetition(source, report); err != nil {
+		return nil, fmt.Errorf("competition %s: %w", source.Name, err)
+	}
+
+	imported := make(map[string]*model.Team, len(teams))
+	for _, team := range teams {
+		local, err := s.syncTeam(team, &report.Teams)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %w", team.Name, err)
+		}
+		imported[team.ID] = local
+	}
+
+	if err := s.syncPlayers(players, imported, &report.Players); err != nil {
+		return nil, err
+	}
+
+	resultsChanged := false
+	for _, match := range matches {
+		home, away := imported[match.HomeTeamID], imported[match.AwayTeamID]
+		if home == nil || away == nil {
+			report.Matches.Skipped++
+			continue
+		}
+		changed, err := s.syncMatch(match, home, away, report.Season.ID, &report.Matches)
+		resultsChanged = resultsChanged || changed
+		if err != nil {
+			// A run picking up from here would not see the results saved so far change
+			return nil, errors.Join(fmt.Errorf("match %s: %w", match.ID, err), s.afterSync(ctx, report, resultsChanged))
+		}
+	}
+
+	if err := s.afterSync(ctx, report, resultsChanged); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "competition synced", "provider", report.Provider, "competition", report.Competition.Name,
+		"season", report.Season.Name, "teams_created", report.Teams.Created, "players_created", report.Players.Created,
+		"matches_created", report.Matches.Created)
+	return report, nil
+}
+
+// afterSync drops the cached responses after a sync that saved anything and, when it changed
+// a result, replays the ratings and rebuilds the standings history.
+func (s *syncService) afterSync(ctx context.Context, report *SyncReport, resultsChanged bool) error {
+	saved := 0
+	for _, counts := range []SyncCounts{report.Teams, report.Players, report.Matches} {
+		saved += counts.Created + counts.Updated
+	}
+	if saved > 0 {
+		s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches, cachePrefixStandings)
+	}
+	if !resultsChanged {
+		return nil
+	}
+	if err := s.ratings.Recalculate(ctx); err != nil {
+		return fmt.Errorf("replaying ratings: %w", err)
+	}
+	if err := s.history.Rebuild(ctx); err != nil {
+		return fmt.Errorf("rebuilding standings history: %w", err)
+	}
+	return nil
+}
+
+// syncCompetition imports the competition and its season into report.
+func (s *syncService) syncCompetition(source *footballapi.Competition, report *SyncReport) error {
+	refs, err := s.refRepo.FindAll(s.provider.Name(), model.ExternalRefCompetition)
+	if err != nil {
+		return err
+	}
+	var competition *model.Competition
+	if id, ok := refs[source.ID]; ok {
+		if competition, err = s.competitionRepo.FindByID(id); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+	if competition == nil {
+		competition = &model.Competition{Name: source.Name, Country: source.Country, ShootoutRule: model.ShootoutRuleDraw}
+		if err := s.competitionRepo.Create(competition); err != nil {
+			return err
+		}
+		if err := s.saveRef(model.ExternalRefCompetition, source.ID, competition.ID); err != nil {
+			return err
+		}
+	} else if competition.Name != source.Name || competition.Country != source.Country {
+		competition.Name, competition.Country = source.Name, source.Country
+		if err := s.competitionRepo.Update(competition); err != nil {
+			return err
+		}
+	}
+	report.Competition = *competition
+
+	if refs, err = s.refRepo.FindAll(s.provider.Name(), model.ExternalRefSeason); err != nil {
+		return err
+	}
+	var season *model.Season
+	if id, ok := refs[source.Season.ID]; ok {
+		if season, err = s.seasonRepo.FindByID(id); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+	name := seasonName(source.Season.StartDate, source.Season.EndDate)
+	if season == nil {
+		season = &model.Season{CompetitionID: competition.ID, Name: name, StartDate: source.Season.StartDate, EndDate: source.Season.EndDate}
+		if err := s.seasonRepo.Create(season); err != nil {
+			return err
+		}
+		if err := s.saveRef(model.ExternalRefSeason, source.Season.ID, season.ID); err != nil {
+			return err
+		}
+	} else if season.Name != name || season.StartDate != source.Season.StartDate || season.EndDate != source.Season.EndDate {
+		season.Name, season.StartDate, season.EndDate = name, source.Season.StartDate, source.Season.EndDate
+		if err := s.seasonRepo.Update(season); err != nil {
+			return err
+		}
+	}
+	report.Season = *season
+	return nil
+}
+
+// syncTeam imports a team, or updates the team imported or named like it before.
+func (s *syncService) syncTeam(source footballapi.Team, counts *SyncCounts) (*model.Team, error) {
+	team, err := s.teamRepo.FindByExternalRef(s.provider.Name(), source.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	mapped := team != nil
+	if team == nil {
+		if team, err = s.teamRepo.FindByName(source.Name); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	if team == nil {
+		team = &model.Team{}
+	}
+
+	created := team.ID == uuid.Nil
+	changed := applyTeam(team, source)
+	abbreviation := team.Abbreviation
+	if err := s.applyAbbreviation(team, source.Abbreviation); err != nil {
+		return nil, err
+	}
+	changed = changed || team.Abbreviation != abbreviation
+	if mapped && !changed {
+		counts.Unchanged++
+		return team, nil
+	}
+
+	if team.Slug, err = uniqueSlug(team.Name, team.Slug, "team", s.teamRepo.FindSlugs); err != nil {
+		return nil, err
+	}
+	if err := s.teamRepo.Upsert(team, s.provider.Name(), source.ID); err != nil {
+		return nil, err
+	}
+	countSaved(counts, created)
+	return team, nil
+}
+
+// applyTeam copies what the provider knows about a team into it and reports whether that
+// changed anything. Blank fields at the provider leave the team's own values.
+func applyTeam(team *model.Team, source footballapi.Team) bool {
+	changed := false
+	set := func(field *string, value string) {
+		if value != "" && *field != value {
+			*field, changed = value, true
+		}
+	}
+	set(&team.Name, source.Name)
+	set(&team.ShortName, source.ShortName)
+	set(&team.City, source.City)
+	set(&team.Address, source.Address)
+	set(&team.LogoURL, source.LogoURL)
+	if source.Founded > 0 && team.FoundedYear != source.Founded {
+		team.FoundedYear, changed = source.Founded, true
+	}
+	return changed
+}
+
+// applyAbbreviation gives a team the provider's abbreviation unless another team has it.
+func (s *syncService) applyAbbreviation(team *model.Team, abbreviation string) error {
+	abbreviation = strings.ToUpper(abbreviation)
+	if abbreviation == "" || abbreviation == team.Abbreviation {
+		return nil
+	}
+	if _, err := s.teamRepo.FindByAbbreviation(abbreviation); !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	team.Abbreviation = abbreviation
+	return nil
+}
+
+// syncMatch imports a fixture between two imported teams, or updates the match imported for
+// it before, and reports whether that changed a result: completed the match, changed its
+// score or took a completed match out of completed.
+func (s *syncService) syncMatch(source footballapi.Match, home, away *model.Team, seasonID uuid.UUID, counts *SyncCounts) (bool, error) {
+	match, err := s.matchRepo.FindByExternalRef(s.provider.Name(), source.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	created := match == nil
+	if created {
+		// New matches are played at the home team's stadium, as when scheduled here
+		match = &model.Match{VenueID: home.StadiumID}
+	}
+
+	wasCompleted, homeScore, awayScore := match.Status == model.MatchStatusCompleted, match.HomeScore, match.AwayScore
+	if !applyMatch(match, source, home.ID, away.ID, seasonID) && !created {
+		counts.Unchanged++
+		return false, nil
+	}
+	completed := match.Status == model.MatchStatusCompleted
+	resultChanged := wasCompleted != completed || (completed && (match.HomeScore != homeScore || match.AwayScore != awayScore))
+
+	// The provider has no match events, so the events of a result it overrides or undoes no
+	// longer add up to the score
+	if wasCompleted && resultChanged {
+		if err := s.eventRepo.DeleteByMatchID(match.ID); err != nil {
+			return false, err
+		}
+	}
+	if err := s.matchRepo.Upsert(match, s.provider.Name(), source.ID); err != nil {
+		return false, err
+	}
+	countSaved(counts, created)
+	return resultChanged, nil
+}
+
+// applyMatch copies a fixture into a match and reports whether that changed anything.
+func applyMatch(match *model.Match, source footballapi.Match, homeID, awayID, seasonID uuid.UUID) bool {
+	var round *int
+	if source.Round > 0 {
+		round = &source.Round
+	}
+	status := syncStatus(source.Status)
+	// The status job flags matches without a result, which the provider still has as scheduled
+	if status == model.MatchStatusScheduled && match.Status == model.MatchStatusAwaitingResult {
+		status = match.Status
+	}
+	homeScore, awayScore := 0, 0
+	if source.HomeScore != nil && source.AwayScore != nil {
+		homeScore, awayScore = *source.HomeScore, *source.AwayScore
+	}
+
+	changed := match.HomeTeamID != homeID || match.AwayTeamID != awayID ||
+		match.SeasonID == nil || *match.SeasonID != seasonID ||
+		!match.MatchDatetime.Equal(source.Kickoff) ||
+		(match.Round == nil) != (round == nil) || (round != nil && *match.Round != *round) ||
+		match.Status != status || match.HomeScore != homeScore || match.AwayScore != awayScore
+
+	match.HomeTeamID, match.AwayTeamID, match.SeasonID = homeID, awayID, &seasonID
+	match.MatchDatetime, match.Round, match.Status = source.Kickoff, round, status
+	match.HomeScore, match.AwayScore = homeScore, awayScore
+	return changed
+}
+
+// syncStatus maps a provider's match status to a match status.
+func syncStatus(status string) string {
+	switch status {
+	case footballapi.StatusLive:
+		return model.MatchStatusLive
+	case footballapi.StatusFinished:
+		return model.MatchStatusCompleted
+	case footballapi.StatusPostponed:
+		return model.MatchStatusPostponed
+	case footballapi.StatusCancelled:
+		return model.MatchStatusCancelled
+	default:
+		return model.MatchStatusScheduled
+	}
+}
+
+// importedSquad holds the current players of an imported team during a sync.
+type importedSquad struct {
+	byName  map[string]*model.Player // By lower-cased name
+	numbers map[int]uuid.UUID        // Player wearing each jersey number
+}
+
+// syncPlayers imports the squads of the imported teams. A player the provider lists in
+// another team than before has moved there.
+func (s *syncService) syncPlayers(players []footballapi.Player, imported map[string]*model.Team, counts *SyncCounts) error {
+	teamIDs := make([]uuid.UUID, 0, len(imported))
+	squads := make(map[uuid.UUID]*importedSquad, len(imported))
+	for _, team := range imported {
+		teamIDs = append(teamIDs, team.ID)
+		squads[team.ID] = &importedSquad{byName: map[string]*model.Player{}, numbers: map[int]uuid.UUID{}}
+	}
+	current, err := s.playerRepo.FindByTeamIDs(teamIDs)
+	if err != nil {
+		return fmt.Errorf("loading squads: %w", err)
+	}
+	for i := range current {
+		player := &current[i]
+		squads[player.TeamID].byName[strings.ToLower(player.Name)] = player
+		squads[player.TeamID].numbers[player.JerseyNumber] = player.ID
+	}
+
+	for _, source := range players {
+		team := imported[source.TeamID]
+		if team == nil {
+			counts.Skipped++
+			continue
+		}
+		if err := s.syncPlayer(source, team.ID, squads, counts); err != nil {
+			return fmt.Errorf("player %s: %w", source.Name, err)
+		}
+	}
+	return nil
+}
+
+// syncPlayer imports a player of an imported team, or updates the player imported or named
+// like them before.
+func (s *syncService) syncPlayer(source footballapi.Player, teamID uuid.UUID, squads map[uuid.UUID]*importedSquad, counts *SyncCounts) error {
+	to := squads[teamID]
+	player, err := s.playerRepo.FindByExternalRef(s.provider.Name(), source.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	mapped := player != nil
+	if player == nil {
+		player = to.byName[strings.ToLower(source.Name)]
+	}
+	if player == nil {
+		// Outfield players of unknown position start as midfielders
+		player = &model.Player{TeamID: teamID, Position: "gelandang"}
+	}
+
+	created := player.ID == uuid.Nil
+	from := squads[player.TeamID] // nil when the player is in a team not imported
+	number := to.jerseyNumber(player, teamID, source.Number)
+	position := syncPosition(source.Position)
+	name := player.Name
+	changed := player.TeamID != teamID || player.JerseyNumber != number ||
+		(source.Name != "" && source.Name != player.Name) ||
+		(position != "" && position != player.Position) ||
+		(source.DateOfBirth != "" && source.DateOfBirth != player.BirthDate)
+	if mapped && !changed {
+		counts.Unchanged++
+		return nil
+	}
+
+	if from != nil && from.numbers[player.JerseyNumber] == player.ID {
+		delete(from.numbers, player.JerseyNumber)
+	}
+	player.TeamID, player.JerseyNumber = teamID, number
+	if source.Name != "" {
+		player.Name = source.Name
+	}
+	if position != "" {
+		player.Position = position
+	}
+	if source.DateOfBirth != "" {
+		player.BirthDate = source.DateOfBirth
+	}
+	if created || player.Name != name {
+		if player.Slug, err = uniqueSlug(player.Name, player.Slug, "player", s.playerRepo.FindSlugs); err != nil {
+			return err
+		}
+	}
+	if err := s.playerRepo.Upsert(player, s.provider.Name(), source.ID); err != nil {
+		return err
+	}
+	to.numbers[player.JerseyNumber] = player.ID
+	to.byName[strings.ToLower(player.Name)] = player
+	countSaved(counts, created)
+	return nil
+}
+
+// jerseyNumber picks the number player wears in the squad of teamID: the provider's number
+// unless a teammate wears it, else the player's own if they are in the squad already and
+// nobody else wears it, else the lowest free number.
+func (q *importedSquad) jerseyNumber(player *model.Player, teamID uuid.UUID, number int) int {
+	free := func(n int) bool {
+		id, taken := q.numbers[n]
+		return n > 0 && (!taken || (player.ID != uuid.Nil && id == player.ID))
+	}
+	if free(number) {
+		return number
+	}
+	if player.TeamID == teamID && player.ID != uuid.Nil && free(player.JerseyNumber) {
+		return player.JerseyNumber
+	}
+	n := 1
+	for !free(n) {
+		n++
+	}
+	return n
+}
+
+// syncPosition maps a provider's player position to a player position, or "" if unknown.
+func syncPosition(position string) string {
+	switch position {
+	case footballapi.PositionGoalkeeper:
+		return model.PositionGoalkeeper
+	case footballapi.PositionDefender:
+		return "bertahan"
+	case footballapi.PositionMidfielder:
+		return "gelandang"
+	case footballapi.PositionForward:
+		return "penyerang"
+	}
+	return ""
+}
+
+// countSaved counts a record the sync created or updated.
+func countSaved(counts *SyncCounts, created bool) {
+	if created {
+		counts.Created++
+	} else {
+		counts.Updated++
+	}
+}
+
+// saveRef maps a provider's ID of a record to the record.
+func (s *syncService) saveRef(entityType, externalID string, entityID uuid.UUID) error {
+	return s.refRepo.Save(&model.ExternalRef{Provider: s.provider.Name(), EntityType: entityType, ExternalID: externalID, EntityID: entityID})
+}
+
+// seasonName names a season after the years it spans, e.g. "2024/25", or "2025" for a
+// season within one year.
+func seasonName(startDate, endDate string) string {
+	if len(startDate) < 4 || len(endDate) < 4 || startDate[:4] == endDate[:4] {
+		return startDate[:min(4, len(startDate))]
+	}
+	return startDate[:4] + "/" + endDate[2:4]
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/cache"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/footballapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type syncMocks struct {
+	refs         *mocks.MockExternalRefRepository
+	competitions *mocks.MockCompetitionRepository
+	seasons      *mocks.MockSeasonRepository
+	teams        *mocks.MockTeamRepository
+	players      *mocks.MockPlayerRepository
+	matches      *mocks.MockMatchRepository
+	events       *mocks.MockMatchEventRepository
+	ratings      *mocks.MockTeamRatingRepository
+	snapshots    *mocks.MockStandingSnapshotRepository
+	cache        *cache.Memory
+}
+
+func newTestSyncService(t *testing.T, provider footballapi.Provider) (SyncService, syncMocks) {
+	m := syncMocks{
+		refs:         mocks.NewMockExternalRefRepository(t),
+		competitions: mocks.NewMockCompetitionRepository(t),
+		seasons:      mocks.NewMockSeasonRepository(t),
+		teams:        mocks.NewMockTeamRepository(t),
+		players:      mocks.NewMockPlayerRepository(t),
+		matches:      mocks.NewMockMatchRepository(t),
+		events:       mocks.NewMockMatchEventRepository(t),
+		ratings:      mocks.NewMockTeamRatingRepository(t),
+		snapshots:    mocks.NewMockStandingSnapshotRepository(t),
+		cache:        cache.NewMemory(),
+	}
+	ratings := NewRatingService(m.matches, m.ratings, m.teams, 20)
+	history := NewStandingsHistoryService(NewStandingsService(m.matches, nil), m.matches, m.snapshots, m.teams)
+	responseCache := NewResponseCache(m.cache, "memory", time.Minute)
+	return NewSyncService(provider, m.refs, m.competitions, m.seasons, m.teams, m.players, m.matches, m.events, ratings, history, responseCache), m
+}
+
+// expectReplay expects a sync to replay the ratings and rebuild the standings history from
+// the given completed matches.
+func (m syncMocks) expectReplay(completed ...model.Match) {
+	m.matches.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return(completed, nil).Once()
+	m.matches.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return([]model.Match{}, nil).Once()
+	m.ratings.EXPECT().ReplaceAll(mock.Anything).Return(nil)
+	m.matches.EXPECT().FindAllCompleted(repository.MatchFilter{}).Return(completed, nil)
+	m.snapshots.EXPECT().ReplaceAll(mock.Anything).Return(nil)
+}
+
+// cacheEntries stores a response under every cache prefix a sync may drop, and returns a
+// function reporting which of them are still cached.
+func (m syncMocks) cacheEntries(t *testing.T) func() []string {
+	prefixes := []string{cachePrefixTeams, cachePrefixMatches, cachePrefixStandings}
+	for _, prefix := range prefixes {
+		require.NoError(t, m.cache.Set(context.Background(), prefix+"list", []byte("[]"), time.Minute))
+	}
+	return func() []string {
+		var cached []string
+		for _, prefix := range prefixes {
+			if _, ok, _ := m.cache.Get(context.Background(), prefix+"list"); ok {
+				cached = append(cached, prefix)
+			}
+		}
+		return cached
+	}
+}
+
+func newTestProvider() *footballapi.Memory {
+	kickoff := time.Date(2024, 8, 16, 19, 0, 0, 0, time.UTC)
+	two, one := 2, 1
+	return footballapi.NewMemory(
+		footballapi.Competition{ID: "2021", Name: "Premier League", Country: "England",
+			Season: footballapi.Season{ID: "2287", StartDate: "2024-08-16", EndDate: "2025-05-25"}},
+		[]footballapi.Team{
+			{ID: "57", Name: "Arsenal FC", ShortName: "Arsenal", Abbreviation: "ars", Founded: 1886},
+			{ID: "61", Name: "Chelsea FC", ShortName: "Chelsea", Abbreviation: "CHE", Founded: 1905},
+		},
+		[]footballapi.Player{
+			{ID: "7784", TeamID: "57", Name: "Bukayo Saka", Position: footballapi.PositionForward, Number: 7, DateOfBirth: "2001-09-05"},
+			{ID: "8004", TeamID: "61", Name: "Cole Palmer", Position: footballapi.PositionMidfielder, Number: 20},
+			{ID: "3402", TeamID: "61", Name: "Robert Sánchez", Position: footballapi.PositionGoalkeeper, Number: 1},
+			{ID: "9999", TeamID: "99", Name: "Unlisted Player"},
+		},
+		[]footballapi.Match{
+			{ID: "1001", HomeTeamID: "57", AwayTeamID: "61", Kickoff: kickoff, Round: 1, Status: footballapi.StatusFinished, HomeScore: &two, AwayScore: &one},
+			{ID: "1002", HomeTeamID: "61", AwayTeamID: "57", Kickoff: kickoff.AddDate(0, 0, 7), Round: 2, Status: footballapi.StatusScheduled},
+			// A team the provider did not list for the season
+			{ID: "1003", HomeTeamID: "57", AwayTeamID: "99", Kickoff: kickoff.AddDate(0, 0, 14), Round: 3, Status: footballapi.StatusScheduled},
+		},
+	)
+}
+
+// upserted gives records saved through an Upsert mock an ID, as creating them would.
+func upserted(id *uuid.UUID) {
+	if *id == uuid.Nil {
+		*id = uuid.Must(uuid.NewV7())
+	}
+}
+
+func TestSyncService_Sync_FirstImport(t *testing.T) {
+	svc, m := newTestSyncService(t, newTestProvider())
+	m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(map[string]uuid.UUID{}, nil)
+	m.refs.EXPECT().FindAll("memory", model.ExternalRefSeason).Return(map[string]uuid.UUID{}, nil)
+	m.refs.EXPECT().Save(mock.Anything).Return(nil).Twice()
+	m.competitions.EXPECT().Create(mock.Anything).Run(func(c *model.Competition) { c.ID = uuid.Must(uuid.NewV7()) }).Return(nil)
+	var season *model.Season
+	m.seasons.EXPECT().Create(mock.Anything).Run(func(s *model.Season) {
+		s.ID = uuid.Must(uuid.NewV7())
+		season = s
+	}).Return(nil)
+
+	stadiumID := uuid.Must(uuid.NewV7())
+	m.teams.EXPECT().FindByExternalRef("memory", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+	m.teams.EXPECT().FindByName("Arsenal FC").Return(nil, gorm.ErrRecordNotFound)
+	// An existing team of the same name is linked rather than duplicated
+	chelsea := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Chelsea FC", Slug: "chelsea-fc", Abbreviation: "CHE", StadiumID: &stadiumID}
+	m.teams.EXPECT().FindByName("Chelsea FC").Return(chelsea, nil)
+	m.teams.EXPECT().FindSlugs("arsenal-fc").Return(nil, nil)
+	m.teams.EXPECT().FindByAbbreviation("ARS").Return(nil, gorm.ErrRecordNotFound)
+	var arsenal *model.Team
+	m.teams.EXPECT().Upsert(mock.Anything, "memory", "57").Run(func(team *model.Team, _, _ string) {
+		upserted(&team.ID)
+		arsenal = team
+	}).Return(nil)
+	m.teams.EXPECT().Upsert(chelsea, "memory", "61").Return(nil)
+
+	// Chelsea's goalkeeper is linked by name; Palmer's number is taken, so he gets the lowest free one
+	sanchez := model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: chelsea.ID, Name: "Robert Sánchez",
+		Slug: "robert-sanchez", Position: model.PositionGoalkeeper, JerseyNumber: 1}
+	james := model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: chelsea.ID, Name: "Reece James", Position: "bertahan", JerseyNumber: 20}
+	m.players.EXPECT().FindByTeamIDs(mock.Anything).Return([]model.Player{sanchez, james}, nil)
+	m.players.EXPECT().FindByExternalRef("memory", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+	m.players.EXPECT().FindSlugs("bukayo-saka").Return(nil, nil)
+	m.players.EXPECT().FindSlugs("cole-palmer").Return(nil, nil)
+	saved := map[string]model.Player{}
+	m.players.EXPECT().Upsert(mock.Anything, "memory", mock.Anything).Run(func(player *model.Player, _, externalID string) {
+		upserted(&player.ID)
+		saved[externalID] = *player
+	}).Return(nil)
+
+	var created []*model.Match
+	m.matches.EXPECT().FindByExternalRef("memory", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+	m.matches.EXPECT().Upsert(mock.Anything, "memory", mock.Anything).Run(func(match *model.Match, _, _ string) {
+		upserted(&match.ID)
+		created = append(created, match)
+	}).Return(nil)
+	// The imported result is rated and recorded in the standings history
+	m.expectReplay()
+	cached := m.cacheEntries(t)
+
+	report, err := svc.Sync(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	assert.Empty(t, cached())
+	assert.Equal(t, "Premier League", report.Competition.Name)
+	assert.Equal(t, "2024/25", report.Season.Name)
+	assert.Equal(t, report.Competition.ID, season.CompetitionID)
+	assert.Equal(t, SyncCounts{Created: 1, Updated: 1}, report.Teams)
+	assert.Equal(t, SyncCounts{Created: 2, Updated: 1, Skipped: 1}, report.Players)
+	assert.Equal(t, SyncCounts{Created: 2, Skipped: 1}, report.Matches)
+
+	assert.Equal(t, "arsenal-fc", arsenal.Slug)
+	assert.Equal(t, "ARS", arsenal.Abbreviation)
+	assert.Equal(t, 1886, arsenal.FoundedYear)
+	assert.Equal(t, "Chelsea", chelsea.ShortName)
+
+	assert.Equal(t, arsenal.ID, saved["7784"].TeamID)
+	assert.Equal(t, "bukayo-saka", saved["7784"].Slug)
+	assert.Equal(t, "penyerang", saved["7784"].Position)
+	assert.Equal(t, 7, saved["7784"].JerseyNumber)
+	assert.Equal(t, "2001-09-05", saved["7784"].BirthDate)
+	assert.Equal(t, 2, saved["8004"].JerseyNumber)
+	assert.Equal(t, sanchez.ID, saved["3402"].ID)
+	assert.Equal(t, "robert-sanchez", saved["3402"].Slug)
+
+	require.Len(t, created, 2)
+	assert.Equal(t, arsenal.ID, created[0].HomeTeamID)
+	assert.Equal(t, chelsea.ID, created[0].AwayTeamID)
+	assert.Equal(t, model.MatchStatusCompleted, created[0].Status)
+	assert.Equal(t, 2, created[0].HomeScore)
+	assert.Equal(t, 1, created[0].AwayScore)
+	assert.Equal(t, season.ID, *created[0].SeasonID)
+	// Played at the home team's stadium
+	assert.Nil(t, created[0].VenueID)
+	assert.Equal(t, &stadiumID, created[1].VenueID)
+	assert.Equal(t, model.MatchStatusScheduled, created[1].Status)
+}
+
+func TestSyncService_Sync_Rerun(t *testing.T) {
+	provider := newTestProvider()
+	source, _ := provider.Competition(context.Background(), "PL", 2024)
+	sourceTeams, _ := provider.Teams(context.Background(), "PL", 2024)
+	sourcePlayers, _ := provider.Players(context.Background(), "PL", 2024)
+	sourceMatches, _ := provider.Matches(context.Background(), "PL", 2024)
+
+	competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Premier League", Country: "England"}
+	season := &model.Season{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, CompetitionID: competition.ID, Name: "2024/25", StartDate: "2024-08-16", EndDate: "2025-05-25"}
+	arsenal := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Slug: "arsenal-fc", Abbreviation: "ARS"}
+	chelsea := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Slug: "chelsea-fc", Abbreviation: "CHE"}
+	applyTeam(arsenal, sourceTeams[0])
+	applyTeam(chelsea, sourceTeams[1])
+	saka := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: arsenal.ID, Name: "Bukayo Saka", Slug: "bukayo-saka",
+		Position: "penyerang", JerseyNumber: 7, BirthDate: "2001-09-05"}
+	palmer := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: chelsea.ID, Name: "Cole Palmer", Slug: "cole-palmer",
+		Position: "gelandang", JerseyNumber: 20}
+	sanchez := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: chelsea.ID, Name: "Robert Sánchez", Slug: "robert-sanchez",
+		Position: model.PositionGoalkeeper, JerseyNumber: 1}
+	first := &model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}}
+	applyMatch(first, sourceMatches[0], arsenal.ID, chelsea.ID, season.ID)
+	// The status job flagged the second match as awaiting its result
+	second := &model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}}
+	applyMatch(second, sourceMatches[1], chelsea.ID, arsenal.ID, season.ID)
+	second.Status = model.MatchStatusAwaitingResult
+	// The first result was corrected at the provider, and Palmer moved to Arsenal
+	three := 3
+	sourceMatches[0].HomeScore = &three
+	sourcePlayers[1].TeamID, sourcePlayers[1].Number = "57", 10
+	provider = footballapi.NewMemory(*source, sourceTeams, sourcePlayers, sourceMatches)
+
+	svc, m := newTestSyncService(t, provider)
+	m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(map[string]uuid.UUID{"2021": competition.ID}, nil)
+	m.refs.EXPECT().FindAll("memory", model.ExternalRefSeason).Return(map[string]uuid.UUID{"2287": season.ID}, nil)
+	m.competitions.EXPECT().FindByID(competition.ID).Return(competition, nil)
+	m.seasons.EXPECT().FindByID(season.ID).Return(season, nil)
+	m.teams.EXPECT().FindByExternalRef("memory", "57").Return(arsenal, nil)
+	m.teams.EXPECT().FindByExternalRef("memory", "61").Return(chelsea, nil)
+	m.players.EXPECT().FindByTeamIDs(mock.Anything).Return([]model.Player{*saka, *palmer, *sanchez}, nil)
+	m.players.EXPECT().FindByExternalRef("memory", "7784").Return(saka, nil)
+	m.players.EXPECT().FindByExternalRef("memory", "8004").Return(palmer, nil)
+	m.players.EXPECT().FindByExternalRef("memory", "3402").Return(sanchez, nil)
+	m.players.EXPECT().Upsert(palmer, "memory", "8004").Return(nil)
+	m.matches.EXPECT().FindByExternalRef("memory", "1001").Return(first, nil)
+	m.matches.EXPECT().FindByExternalRef("memory", "1002").Return(second, nil)
+	m.matches.EXPECT().Upsert(first, "memory", "1001").Return(nil)
+	// The corrected score no longer matches the goals recorded for the first result
+	m.events.EXPECT().DeleteByMatchID(first.ID).Return(nil)
+	m.expectReplay(*first)
+
+	report, err := svc.Sync(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, SyncCounts{Unchanged: 2}, report.Teams)
+	assert.Equal(t, SyncCounts{Updated: 1, Unchanged: 2, Skipped: 1}, report.Players)
+	assert.Equal(t, SyncCounts{Updated: 1, Unchanged: 1, Skipped: 1}, report.Matches)
+	assert.Equal(t, arsenal.ID, palmer.TeamID)
+	assert.Equal(t, 10, palmer.JerseyNumber)
+	assert.Equal(t, 3, first.HomeScore)
+	assert.Equal(t, model.MatchStatusAwaitingResult, second.Status)
+}
+
+// A sync that changes a result replays the ratings, rebuilds the standings history and drops
+// the goals recorded for a result it overrides; any saved change drops the cached responses.
+func TestSyncService_Sync_Results(t *testing.T) {
+	kickoff := time.Date(2024, 8, 16, 19, 0, 0, 0, time.UTC)
+	two, one := 2, 1
+	source := footballapi.Competition{ID: "2021", Name: "Premier League", Country: "England",
+		Season: footballapi.Season{ID: "2287", StartDate: "2024-08-16", EndDate: "2025-05-25"}}
+	sourceTeams := []footballapi.Team{{ID: "57", Name: "Arsenal FC"}, {ID: "61", Name: "Chelsea FC"}}
+	finished := footballapi.Match{ID: "1001", HomeTeamID: "57", AwayTeamID: "61", Kickoff: kickoff, Round: 1,
+		Status: footballapi.StatusFinished, HomeScore: &two, AwayScore: &one}
+	scheduled := footballapi.Match{ID: "1001", HomeTeamID: "57", AwayTeamID: "61", Kickoff: kickoff, Round: 1,
+		Status: footballapi.StatusScheduled}
+
+	tests := []struct {
+		name   string
+		stored footballapi.Match // What the previous sync imported
+		// edit changes the stored match locally before the sync
+		edit        func(*model.Match)
+		source      footballapi.Match
+		wantSaved   bool
+		wantReplay  bool
+		wantDeleted bool // Events of the stored result dropped
+	}{
+		{name: "nothing changed", stored: finished, source: finished},
+		{name: "kick-off moved", stored: scheduled, source: func() footballapi.Match {
+			m := scheduled
+			m.Kickoff = kickoff.Add(time.Hour)
+			return m
+		}(), wantSaved: true},
+		{name: "fixture played", stored: scheduled, source: finished, wantSaved: true, wantReplay: true},
+		{name: "score corrected at the provider", stored: finished, source: func() footballapi.Match {
+			m := finished
+			m.AwayScore = &two
+			return m
+		}(), wantSaved: true, wantReplay: true, wantDeleted: true},
+		{name: "locally submitted score overwritten", stored: finished, edit: func(m *model.Match) { m.HomeScore = 4 },
+			source: finished, wantSaved: true, wantReplay: true, wantDeleted: true},
+		{name: "result undone", stored: finished, source: func() footballapi.Match {
+			m := finished
+			m.Status, m.HomeScore, m.AwayScore = footballapi.StatusPostponed, nil, nil
+			return m
+		}(), wantSaved: true, wantReplay: true, wantDeleted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Premier League", Country: "England"}
+			season := &model.Season{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, CompetitionID: competition.ID, Name: "2024/25", StartDate: "2024-08-16", EndDate: "2025-05-25"}
+			arsenal := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arsenal FC"}
+			chelsea := &model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Chelsea FC"}
+			match := &model.Match{Base: model.Base{ID: uuid.Must(uuid.NewV7())}}
+			applyMatch(match, tt.stored, arsenal.ID, chelsea.ID, season.ID)
+			if tt.edit != nil {
+				tt.edit(match)
+			}
+
+			provider := footballapi.NewMemory(source, sourceTeams, nil, []footballapi.Match{tt.source})
+			svc, m := newTestSyncService(t, provider)
+			m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(map[string]uuid.UUID{"2021": competition.ID}, nil)
+			m.refs.EXPECT().FindAll("memory", model.ExternalRefSeason).Return(map[string]uuid.UUID{"2287": season.ID}, nil)
+			m.competitions.EXPECT().FindByID(competition.ID).Return(competition, nil)
+			m.seasons.EXPECT().FindByID(season.ID).Return(season, nil)
+			m.teams.EXPECT().FindByExternalRef("memory", "57").Return(arsenal, nil)
+			m.teams.EXPECT().FindByExternalRef("memory", "61").Return(chelsea, nil)
+			m.players.EXPECT().FindByTeamIDs(mock.Anything).Return(nil, nil)
+			m.matches.EXPECT().FindByExternalRef("memory", "1001").Return(match, nil)
+			if tt.wantSaved {
+				m.matches.EXPECT().Upsert(match, "memory", "1001").Return(nil)
+			}
+			if tt.wantDeleted {
+				m.events.EXPECT().DeleteByMatchID(match.ID).Return(nil)
+			}
+			if tt.wantReplay {
+				m.expectReplay()
+			}
+			cached := m.cacheEntries(t)
+
+			_, err := svc.Sync(context.Background(), "PL", 2024)
+
+			require.NoError(t, err)
+			if tt.wantSaved {
+				assert.Empty(t, cached())
+			} else {
+				assert.Len(t, cached(), 3)
+			}
+		})
+	}
+
+	t.Run("failed sync replays the results saved before", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Premier League", Country: "England"}
+		season := &model.Season{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, CompetitionID: competition.ID, Name: "2024/25", StartDate: "2024-08-16", EndDate: "2025-05-25"}
+		next := scheduled
+		next.ID, next.Kickoff = "1002", kickoff.AddDate(0, 0, 7)
+		provider := footballapi.NewMemory(source, sourceTeams, nil, []footballapi.Match{finished, next})
+		svc, m := newTestSyncService(t, provider)
+		m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(map[string]uuid.UUID{"2021": competition.ID}, nil)
+		m.refs.EXPECT().FindAll("memory", model.ExternalRefSeason).Return(map[string]uuid.UUID{"2287": season.ID}, nil)
+		m.competitions.EXPECT().FindByID(competition.ID).Return(competition, nil)
+		m.seasons.EXPECT().FindByID(season.ID).Return(season, nil)
+		m.teams.EXPECT().FindByExternalRef("memory", "57").Return(&model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arsenal FC"}, nil)
+		m.teams.EXPECT().FindByExternalRef("memory", "61").Return(&model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Chelsea FC"}, nil)
+		m.players.EXPECT().FindByTeamIDs(mock.Anything).Return(nil, nil)
+		m.matches.EXPECT().FindByExternalRef("memory", "1001").Return(nil, gorm.ErrRecordNotFound)
+		m.matches.EXPECT().Upsert(mock.Anything, "memory", "1001").Return(nil)
+		m.matches.EXPECT().FindByExternalRef("memory", "1002").Return(nil, gorm.ErrInvalidDB)
+		m.expectReplay()
+		cached := m.cacheEntries(t)
+
+		report, err := svc.Sync(context.Background(), "PL", 2024)
+
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, gorm.ErrInvalidDB)
+		assert.Empty(t, cached())
+	})
+
+	t.Run("replay error", func(t *testing.T) {
+		competition := &model.Competition{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Premier League", Country: "England"}
+		season := &model.Season{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, CompetitionID: competition.ID, Name: "2024/25", StartDate: "2024-08-16", EndDate: "2025-05-25"}
+		provider := footballapi.NewMemory(source, sourceTeams, nil, []footballapi.Match{finished})
+		svc, m := newTestSyncService(t, provider)
+		m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(map[string]uuid.UUID{"2021": competition.ID}, nil)
+		m.refs.EXPECT().FindAll("memory", model.ExternalRefSeason).Return(map[string]uuid.UUID{"2287": season.ID}, nil)
+		m.competitions.EXPECT().FindByID(competition.ID).Return(competition, nil)
+		m.seasons.EXPECT().FindByID(season.ID).Return(season, nil)
+		m.teams.EXPECT().FindByExternalRef("memory", "57").Return(&model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Arsenal FC"}, nil)
+		m.teams.EXPECT().FindByExternalRef("memory", "61").Return(&model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Chelsea FC"}, nil)
+		m.players.EXPECT().FindByTeamIDs(mock.Anything).Return(nil, nil)
+		m.matches.EXPECT().FindByExternalRef("memory", "1001").Return(nil, gorm.ErrRecordNotFound)
+		m.matches.EXPECT().Upsert(mock.Anything, "memory", "1001").Return(nil)
+		m.matches.EXPECT().FindAll(mock.Anything, 0, -1, "match_datetime", "asc").Return(nil, gorm.ErrInvalidDB)
+
+		report, err := svc.Sync(context.Background(), "PL", 2024)
+
+		assert.Nil(t, report)
+		assert.ErrorContains(t, err, "replaying ratings")
+	})
+}
+
+func TestSyncService_Sync_DBError(t *testing.T) {
+	svc, m := newTestSyncService(t, newTestProvider())
+	m.refs.EXPECT().FindAll("memory", model.ExternalRefCompetition).Return(nil, gorm.ErrInvalidDB)
+
+	report, err := svc.Sync(context.Background(), "PL", 2024)
+
+	assert.Nil(t, report)
+	assert.ErrorIs(t, err, gorm.ErrInvalidDB)
+	assert.Contains(t, err.Error(), "competition Premier League")
+}
+
+func TestImportedSquad_JerseyNumber(t *testing.T) {
+	teamID, otherTeamID := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	holder := uuid.Must(uuid.NewV7())
+	player := &model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: teamID, JerseyNumber: 4}
+	squad := &importedSquad{numbers: map[int]uuid.UUID{1: holder, 2: holder, 4: player.ID, 9: holder}}
+
+	assert.Equal(t, 10, squad.jerseyNumber(player, teamID, 10), "free provider number")
+	assert.Equal(t, 4, squad.jerseyNumber(player, teamID, 9), "keeps own number")
+	assert.Equal(t, 4, squad.jerseyNumber(player, teamID, 0), "unknown provider number")
+	// A new or arriving player takes the lowest free number
+	assert.Equal(t, 3, squad.jerseyNumber(&model.Player{}, teamID, 9))
+	assert.Equal(t, 3, squad.jerseyNumber(&model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: otherTeamID, JerseyNumber: 1}, teamID, 0))
+}
+
+func TestSeasonName(t *testing.T) {
+	assert.Equal(t, "2024/25", seasonName("2024-08-16", "2025-05-25"))
+	assert.Equal(t, "2025", seasonName("2025-02-14", "2025-12-07"))
+	assert.Equal(t, "1999/00", seasonName("1999-08-07", "2000-05-14"))
+}
@@ -1,55 +1,107 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
 	"gorm.io/gorm"
 )
 
 // TeamService defines the contract for team business logic.
 type TeamService interface {
-	GetAll(pagination dto.PaginationQuery) ([]dto.TeamResponse, *response.PaginationMeta, error)
-	GetByID(id uuid.UUID) (*dto.TeamResponse, error)
-	Create(req dto.CreateTeamRequest) (*dto.TeamResponse, error)
-	Update(id uuid.UUID, req dto.UpdateTeamRequest) (*dto.TeamResponse, error)
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.TeamFilterQuery) ([]dto.TeamResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.TeamResponse, error)
+	GetBySlug(ctx context.Context, slug string) (*dto.TeamResponse, error)
+	Create(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateTeamRequest) (*dto.TeamResponse, error)
+	Patch(ctx context.Context, id uuid.UUID, req dto.PatchTeamRequest) (*dto.TeamResponse, error)
+	Delete(ctx context.Context, id uuid.UUID, cascade bool) error
 }
 
 type teamService struct {
-	teamRepo repository.TeamRepository
+	teamRepo    repository.TeamRepository
+	playerRepo  repository.PlayerRepository
+	matchRepo   repository.MatchRepository
+	stadiumRepo repository.StadiumRepository
+	txManager   repository.TxManager
+	cache       *ResponseCache
+	feed        *events.Bus // nil disables publishing
 }
 
 // NewTeamService creates a new TeamService instance.
-func NewTeamService(teamRepo repository.TeamRepository) TeamService {
-	return &teamService{teamRepo: teamRepo}
+// Team listings are cached in responseCache (nil disables caching); changes are published on feed.
+func NewTeamService(
+	teamRepo repository.TeamRepository,
+	playerRepo repository.PlayerRepository,
+	matchRepo repository.MatchRepository,
+	stadiumRepo repository.StadiumRepository,
+	txManager repository.TxManager,
+	responseCache *ResponseCache,
+	feed *events.Bus,
+) TeamService {
+	return &teamService{
+		teamRepo:    teamRepo,
+		playerRepo:  playerRepo,
+		matchRepo:   matchRepo,
+		stadiumRepo: stadiumRepo,
+		txManager:   txManager,
+		cache:       responseCache,
+		feed:        feed,
+	}
 }
 
-func (s *teamService) GetAll(pagination dto.PaginationQuery) ([]dto.TeamResponse, *response.PaginationMeta, error) {
+func (s *teamService) GetAll(ctx context.Context, pagination dto.PaginationQuery, filterQuery dto.TeamFilterQuery) ([]dto.TeamResponse, *response.PaginationMeta, error) {
 	pagination.Sanitize()
 
-	teams, err := s.teamRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	filter, err := parseTeamFilter(filterQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(filter.IDs) > 0 {
+		pagination = batchPage(pagination, filter.IDs)
+	}
+
+	page, err := cached(ctx, s.cache, cacheKey(cachePrefixTeams+"list:", pagination, filter), func() (cachedPage[dto.TeamResponse], error) {
+		return s.findAll(ctx, pagination, filter)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return page.Items, page.Meta, nil
+}
+
+// findAll loads one page of teams from the database.
+func (s *teamService) findAll(ctx context.Context, pagination dto.PaginationQuery, filter repository.TeamFilter) (cachedPage[dto.TeamResponse], error) {
+	teams, err := s.teamRepo.FindAll(filter, pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
 	if err != nil {
-		slog.Error("failed to fetch teams", "error", err)
-		return nil, nil, errs.ErrInternal("Internal server error")
+		slog.ErrorContext(ctx, "failed to fetch teams", "error", err)
+		return cachedPage[dto.TeamResponse]{}, errs.ErrInternal("Internal server error")
 	}
 
-	total, err := s.teamRepo.Count()
+	total, err := s.teamRepo.Count(filter)
 	if err != nil {
-		slog.Error("failed to count teams", "error", err)
-		return nil, nil, errs.ErrInternal("Internal server error")
+		slog.ErrorContext(ctx, "failed to count teams", "error", err)
+		return cachedPage[dto.TeamResponse]{}, errs.ErrInternal("Internal server error")
 	}
 
 	teamResponses := make([]dto.TeamResponse, len(teams))
 	for i, team := range teams {
 		teamResponses[i] = toTeamResponse(team)
 	}
+	if len(filter.IDs) > 0 {
+		teamResponses = inRequestOrder(teamResponses, filter.IDs, func(t dto.TeamResponse) string { return t.ID })
+	}
 
 	totalPages := int(total) / pagination.PerPage
 	if int(total)%pagination.PerPage > 0 {
@@ -63,16 +115,16 @@ func (s *teamService) GetAll(pagination dto.PaginationQuery) ([]dto.TeamResponse
 		TotalPages: totalPages,
 	}
 
-	return teamResponses, meta, nil
+	return cachedPage[dto.TeamResponse]{Items: teamResponses, Meta: meta}, nil
 }
 
-func (s *teamService) GetByID(id uuid.UUID) (*dto.TeamResponse, error) {
+func (s *teamService) GetByID(ctx context.Context, id uuid.UUID) (*dto.TeamResponse, error) {
 	team, err := s.teamRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Team not found")
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch team", "error", err, "team_id", id)
+		slog.ErrorContext(ctx, "failed to fetch team", "error", err, "team_id", id)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
@@ -80,77 +132,401 @@ func (s *teamService) GetByID(id uuid.UUID) (*dto.TeamResponse, error) {
 	return &resp, nil
 }
 
-func (s *teamService) Create(req dto.CreateTeamRequest) (*dto.TeamResponse, error) {
+// GetBySlug returns the team with the given slug, e.g. "persija-jakarta".
+func (s *teamService) GetBySlug(ctx context.Context, slug string) (*dto.TeamResponse, error) {
+	team, err := s.teamRepo.FindBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team by slug", "error", err, "slug", slug)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toTeamResponse(*team)
+	return &resp, nil
+}
+
+func (s *teamService) Create(ctx context.Context, req dto.CreateTeamRequest) (*dto.TeamResponse, error) {
+	if err := s.ensureNameAvailable(ctx, req.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+	abbreviation := strings.ToUpper(req.Abbreviation)
+	if err := s.ensureAbbreviationAvailable(ctx, abbreviation, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	stadiumID, err := s.resolveStadium(ctx, req.StadiumID)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := s.teamSlug(ctx, req.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
 	team := model.Team{
-		Name:        req.Name,
-		LogoURL:     req.LogoURL,
-		FoundedYear: req.FoundedYear,
-		Address:     req.Address,
-		City:        req.City,
+		Name:           req.Name,
+		Slug:           slug,
+		LogoURL:        req.LogoURL,
+		FoundedYear:    req.FoundedYear,
+		Address:        req.Address,
+		City:           req.City,
+		StadiumID:      stadiumID,
+		ShortName:      req.ShortName,
+		Abbreviation:   abbreviation,
+		PrimaryColor:   strings.ToUpper(req.PrimaryColor),
+		SecondaryColor: strings.ToUpper(req.SecondaryColor),
+		WebsiteURL:     req.WebsiteURL,
+		FacebookURL:    req.FacebookURL,
+		InstagramURL:   req.InstagramURL,
+		XURL:           req.XURL,
 	}
 
 	if err := s.teamRepo.Create(&team); err != nil {
-		slog.Error("failed to create team", "error", err)
+		if errors.Is(err, repository.ErrDuplicateTeamName) {
+			return nil, errTeamNameTaken()
+		}
+		if errors.Is(err, repository.ErrDuplicateTeamAbbreviation) {
+			return nil, errTeamAbbreviationTaken()
+		}
+		slog.ErrorContext(ctx, "failed to create team", "error", err)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams)
 
 	resp := toTeamResponse(team)
+	s.feed.Publish(FeedTeamCreated, resp)
 	return &resp, nil
 }
 
-func (s *teamService) Update(id uuid.UUID, req dto.UpdateTeamRequest) (*dto.TeamResponse, error) {
+func (s *teamService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateTeamRequest) (*dto.TeamResponse, error) {
 	team, err := s.teamRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errs.ErrNotFound("Team not found")
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch team for update", "error", err, "team_id", id)
+		slog.ErrorContext(ctx, "failed to fetch team for update", "error", err, "team_id", id)
 		return nil, errs.ErrInternal("Internal server error")
 	}
 
+	return s.update(ctx, team, req)
+}
+
+// Patch changes only the fields present in req and keeps the others.
+func (s *teamService) Patch(ctx context.Context, id uuid.UUID, req dto.PatchTeamRequest) (*dto.TeamResponse, error) {
+	team, err := s.teamRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch team for patch", "error", err, "team_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return s.update(ctx, team, mergeTeamPatch(*team, req))
+}
+
+// update replaces the editable fields of team with req and saves it.
+func (s *teamService) update(ctx context.Context, team *model.Team, req dto.UpdateTeamRequest) (*dto.TeamResponse, error) {
+	if err := checkVersion("Team", req.Version, team.Version); err != nil {
+		return nil, err
+	}
+	if err := s.ensureNameAvailable(ctx, req.Name, team.ID); err != nil {
+		return nil, err
+	}
+	abbreviation := strings.ToUpper(req.Abbreviation)
+	if err := s.ensureAbbreviationAvailable(ctx, abbreviation, team.ID); err != nil {
+		return nil, err
+	}
+
+	stadiumID, err := s.resolveStadium(ctx, req.StadiumID)
+	if err != nil {
+		return nil, err
+	}
+	slug, err := s.teamSlug(ctx, req.Name, team.Slug)
+	if err != nil {
+		return nil, err
+	}
+
 	team.Name = req.Name
+	team.Slug = slug
 	team.LogoURL = req.LogoURL
 	team.FoundedYear = req.FoundedYear
 	team.Address = req.Address
 	team.City = req.City
+	team.StadiumID = stadiumID
+	team.ShortName = req.ShortName
+	team.Abbreviation = abbreviation
+	team.PrimaryColor = strings.ToUpper(req.PrimaryColor)
+	team.SecondaryColor = strings.ToUpper(req.SecondaryColor)
+	team.WebsiteURL = req.WebsiteURL
+	team.FacebookURL = req.FacebookURL
+	team.InstagramURL = req.InstagramURL
+	team.XURL = req.XURL
 
 	if err := s.teamRepo.Update(team); err != nil {
-		slog.Error("failed to update team", "error", err, "team_id", id)
+		if isVersionConflict(err) {
+			return nil, errVersionConflict("Team")
+		}
+		if errors.Is(err, repository.ErrDuplicateTeamName) {
+			return nil, errTeamNameTaken()
+		}
+		if errors.Is(err, repository.ErrDuplicateTeamAbbreviation) {
+			return nil, errTeamAbbreviationTaken()
+		}
+		slog.ErrorContext(ctx, "failed to update team", "error", err, "team_id", team.ID)
 		return nil, errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches, cachePrefixStandings)
 
 	resp := toTeamResponse(*team)
+	s.feed.Publish(FeedTeamUpdated, resp)
 	return &resp, nil
 }
 
-func (s *teamService) Delete(id uuid.UUID) error {
+// ensureNameAvailable returns a 409 error if another non-deleted team has the name, ignoring case.
+// exceptID is the team being updated (uuid.Nil when creating). The unique index on lower(name)
+// catches requests racing past this check.
+func (s *teamService) ensureNameAvailable(ctx context.Context, name string, exceptID uuid.UUID) error {
+	existing, err := s.teamRepo.FindByName(name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "failed to check team name uniqueness", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	if existing != nil && existing.ID != exceptID {
+		return errTeamNameTaken()
+	}
+	return nil
+}
+
+// teamSlug returns the slug of a team named name, keeping current (the team's slug, empty when
+// creating) if it still matches the name. Clashing slugs get a numeric suffix.
+func (s *teamService) teamSlug(ctx context.Context, name, current string) (string, error) {
+	slug, err := uniqueSlug(name, current, "team", s.teamRepo.FindSlugs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch team slugs", "error", err)
+		return "", errs.ErrInternal("Internal server error")
+	}
+	return slug, nil
+}
+
+func errTeamNameTaken() error {
+	return errs.ErrConflict("Team name already taken").WithCode(CodeTeamNameTaken)
+}
+
+// ensureAbbreviationAvailable returns a 409 error if another non-deleted team has the
+// abbreviation, which must be in upper case. Teams without one never conflict.
+func (s *teamService) ensureAbbreviationAvailable(ctx context.Context, abbreviation string, exceptID uuid.UUID) error {
+	if abbreviation == "" {
+		return nil
+	}
+	existing, err := s.teamRepo.FindByAbbreviation(abbreviation)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "failed to check team abbreviation uniqueness", "error", err)
+		return errs.ErrInternal("Internal server error")
+	}
+	if existing != nil && existing.ID != exceptID {
+		return errTeamAbbreviationTaken()
+	}
+	return nil
+}
+
+func errTeamAbbreviationTaken() error {
+	return errs.ErrConflict("Team abbreviation already taken").WithCode(CodeTeamAbbreviationTaken)
+}
+
+// Delete soft-deletes a team. A team with matches still to be played cannot be deleted.
+// A team with players is only deleted with cascade, which soft-deletes its players in the same transaction.
+// Completed and cancelled matches keep referring to the deleted team.
+func (s *teamService) Delete(ctx context.Context, id uuid.UUID, cascade bool) error {
 	_, err := s.teamRepo.FindByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errs.ErrNotFound("Team not found")
+			return errs.ErrNotFound("Team not found").WithCode(CodeTeamNotFound)
 		}
-		slog.Error("failed to fetch team for delete", "error", err, "team_id", id)
+		slog.ErrorContext(ctx, "failed to fetch team for delete", "error", err, "team_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+
+	upcoming, err := s.matchRepo.CountUpcomingByTeamID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count upcoming matches for team delete", "error", err, "team_id", id)
 		return errs.ErrInternal("Internal server error")
 	}
+	if upcoming > 0 {
+		return errs.ErrConflict(fmt.Sprintf("Team has %d scheduled, live, awaiting_result or postponed matches; cancel or delete them first", upcoming)).WithCode(CodeTeamHasMatches)
+	}
+
+	if !cascade {
+		players, err := s.playerRepo.CountByTeamID(id)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to count players for team delete", "error", err, "team_id", id)
+			return errs.ErrInternal("Internal server error")
+		}
+		if players > 0 {
+			return errs.ErrConflict(fmt.Sprintf("Team has %d players; delete them first or use cascade=true", players)).WithCode(CodeTeamHasPlayers)
+		}
+	}
 
-	if err := s.teamRepo.Delete(id); err != nil {
-		slog.Error("failed to delete team", "error", err, "team_id", id)
+	var playersDeleted int64
+	err = s.txManager.WithinTransaction(func(repos repository.TxRepositories) error {
+		if cascade {
+			deleted, err := repos.Players.DeleteByTeamID(id)
+			if err != nil {
+				return err
+			}
+			playersDeleted = deleted
+		}
+		return repos.Teams.Delete(id)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete team", "error", err, "team_id", id, "cascade", cascade)
 		return errs.ErrInternal("Internal server error")
 	}
+	s.cache.invalidate(ctx, cachePrefixTeams, cachePrefixMatches, cachePrefixStandings)
+	s.feed.Publish(FeedTeamDeleted, dto.TeamDeletedEvent{ID: id.String(), PlayersDeleted: playersDeleted})
 
 	return nil
 }
 
+// mergeTeamPatch returns the full update that applies patch to team.
+func mergeTeamPatch(team model.Team, patch dto.PatchTeamRequest) dto.UpdateTeamRequest {
+	req := dto.UpdateTeamRequest{
+		Name:           team.Name,
+		LogoURL:        team.LogoURL,
+		FoundedYear:    team.FoundedYear,
+		Address:        team.Address,
+		City:           team.City,
+		ShortName:      team.ShortName,
+		Abbreviation:   team.Abbreviation,
+		PrimaryColor:   team.PrimaryColor,
+		SecondaryColor: team.SecondaryColor,
+		WebsiteURL:     team.WebsiteURL,
+		FacebookURL:    team.FacebookURL,
+		InstagramURL:   team.InstagramURL,
+		XURL:           team.XURL,
+		Version:        patch.Version,
+	}
+	if team.StadiumID != nil {
+		req.StadiumID = team.StadiumID.String()
+	}
+	if patch.Name != nil {
+		req.Name = *patch.Name
+	}
+	if patch.LogoURL != nil {
+		req.LogoURL = *patch.LogoURL
+	}
+	if patch.FoundedYear != nil {
+		req.FoundedYear = *patch.FoundedYear
+	}
+	if patch.Address != nil {
+		req.Address = *patch.Address
+	}
+	if patch.City != nil {
+		req.City = *patch.City
+	}
+	if patch.StadiumID != nil {
+		req.StadiumID = *patch.StadiumID
+	}
+	if patch.ShortName != nil {
+		req.ShortName = *patch.ShortName
+	}
+	if patch.Abbreviation != nil {
+		req.Abbreviation = *patch.Abbreviation
+	}
+	if patch.PrimaryColor != nil {
+		req.PrimaryColor = *patch.PrimaryColor
+	}
+	if patch.SecondaryColor != nil {
+		req.SecondaryColor = *patch.SecondaryColor
+	}
+	if patch.WebsiteURL != nil {
+		req.WebsiteURL = *patch.WebsiteURL
+	}
+	if patch.FacebookURL != nil {
+		req.FacebookURL = *patch.FacebookURL
+	}
+	if patch.InstagramURL != nil {
+		req.InstagramURL = *patch.InstagramURL
+	}
+	if patch.XURL != nil {
+		req.XURL = *patch.XURL
+	}
+	return req
+}
+
+// resolveStadium parses an optional stadium_id and verifies the stadium exists.
+// Returns nil when no stadium is given.
+func (s *teamService) resolveStadium(ctx context.Context, raw string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	stadiumID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errs.ErrBadRequest("Invalid stadium_id format")
+	}
+	if _, err := s.stadiumRepo.FindByID(stadiumID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Stadium not found").WithCode(CodeStadiumNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch stadium", "error", err, "stadium_id", stadiumID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return &stadiumID, nil
+}
+
+// parseTeamFilter converts the team search query parameters into a repository filter.
+func parseTeamFilter(query dto.TeamFilterQuery) (repository.TeamFilter, error) {
+	if query.FoundedYearFrom > 0 && query.FoundedYearTo > 0 && query.FoundedYearFrom > query.FoundedYearTo {
+		return repository.TeamFilter{}, errs.ErrBadRequest("founded_year_from must not be after founded_year_to")
+	}
+	ids, err := parseIDList(query.IDs)
+	if err != nil {
+		return repository.TeamFilter{}, err
+	}
+	projection, err := parseFields[dto.TeamResponse](query.Fields, teamFieldSources)
+	if err != nil {
+		return repository.TeamFilter{}, err
+	}
+	return repository.TeamFilter{
+		IDs:             ids,
+		Projection:      projection,
+		Search:          strings.TrimSpace(query.Search),
+		City:            strings.TrimSpace(query.City),
+		FoundedYearFrom: query.FoundedYearFrom,
+		FoundedYearTo:   query.FoundedYearTo,
+	}, nil
+}
+
 // toTeamResponse converts a model.Team to dto.TeamResponse.
 func toTeamResponse(team model.Team) dto.TeamResponse {
-	return dto.TeamResponse{
-		ID:          team.ID.String(),
-		Name:        team.Name,
-		LogoURL:     team.LogoURL,
-		FoundedYear: team.FoundedYear,
-		Address:     team.Address,
-		City:        team.City,
-		CreatedAt:   team.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:   team.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	resp := dto.TeamResponse{
+		ID:             team.ID.String(),
+		Name:           team.Name,
+		Slug:           team.Slug,
+		LogoURL:        team.LogoURL,
+		FoundedYear:    team.FoundedYear,
+		Address:        team.Address,
+		City:           team.City,
+		ShortName:      team.ShortName,
+		Abbreviation:   team.Abbreviation,
+		PrimaryColor:   team.PrimaryColor,
+		SecondaryColor: team.SecondaryColor,
+		WebsiteURL:     team.WebsiteURL,
+		FacebookURL:    team.FacebookURL,
+		InstagramURL:   team.InstagramURL,
+		XURL:           team.XURL,
+		Version:        team.Version,
+		CreatedAt:      timefmt.Format(team.CreatedAt),
+		UpdatedAt:      timefmt.Format(team.UpdatedAt),
+	}
+	if team.StadiumID != nil {
+		resp.StadiumID = team.StadiumID.String()
+	}
+	if team.HeadCoach != nil {
+		headCoach := toCoachResponse(*team.HeadCoach)
+		resp.HeadCoach = &headCoach
 	}
+	return resp
 }
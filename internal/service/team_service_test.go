@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
 	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +19,7 @@ import (
 
 func newTestTeamService(t *testing.T) (*teamService, *mocks.MockTeamRepository) {
 	teamRepo := mocks.NewMockTeamRepository(t)
+	teamRepo.EXPECT().FindSlugs(mock.Anything).Return(nil, nil).Maybe()
 	svc := &teamService{teamRepo: teamRepo}
 	return svc, teamRepo
 }
@@ -38,6 +42,7 @@ func sampleTeam() model.Team {
 func TestTeamService_GetAll(t *testing.T) {
 	tests := []struct {
 		name    string
+		filter  dto.TeamFilterQuery
 		setup   func(*mocks.MockTeamRepository)
 		wantErr bool
 		wantLen int
@@ -46,8 +51,8 @@ func TestTeamService_GetAll(t *testing.T) {
 			name: "success with teams",
 			setup: func(tr *mocks.MockTeamRepository) {
 				teams := []model.Team{sampleTeam(), sampleTeam()}
-				tr.EXPECT().FindAll(0, 10, "created_at", "desc").Return(teams, nil)
-				tr.EXPECT().Count().Return(int64(2), nil)
+				tr.EXPECT().FindAll(repository.TeamFilter{}, 0, 10, "created_at", "desc").Return(teams, nil)
+				tr.EXPECT().Count(repository.TeamFilter{}).Return(int64(2), nil)
 			},
 			wantErr: false,
 			wantLen: 2,
@@ -55,24 +60,41 @@ func TestTeamService_GetAll(t *testing.T) {
 		{
 			name: "success empty list",
 			setup: func(tr *mocks.MockTeamRepository) {
-				tr.EXPECT().FindAll(0, 10, "created_at", "desc").Return([]model.Team{}, nil)
-				tr.EXPECT().Count().Return(int64(0), nil)
+				tr.EXPECT().FindAll(repository.TeamFilter{}, 0, 10, "created_at", "desc").Return([]model.Team{}, nil)
+				tr.EXPECT().Count(repository.TeamFilter{}).Return(int64(0), nil)
 			},
 			wantErr: false,
 			wantLen: 0,
 		},
+		{
+			name:   "filters are passed to repository",
+			filter: dto.TeamFilterQuery{Search: "  persija ", City: "Jakarta", FoundedYearFrom: 1900, FoundedYearTo: 1950},
+			setup: func(tr *mocks.MockTeamRepository) {
+				filter := repository.TeamFilter{Search: "persija", City: "Jakarta", FoundedYearFrom: 1900, FoundedYearTo: 1950}
+				tr.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return([]model.Team{sampleTeam()}, nil)
+				tr.EXPECT().Count(filter).Return(int64(1), nil)
+			},
+			wantErr: false,
+			wantLen: 1,
+		},
+		{
+			name:    "invalid founded year range",
+			filter:  dto.TeamFilterQuery{FoundedYearFrom: 2000, FoundedYearTo: 1990},
+			setup:   func(tr *mocks.MockTeamRepository) {},
+			wantErr: true,
+		},
 		{
 			name: "db error on find",
 			setup: func(tr *mocks.MockTeamRepository) {
-				tr.EXPECT().FindAll(0, 10, "created_at", "desc").Return(nil, gorm.ErrInvalidDB)
+				tr.EXPECT().FindAll(repository.TeamFilter{}, 0, 10, "created_at", "desc").Return(nil, gorm.ErrInvalidDB)
 			},
 			wantErr: true,
 		},
 		{
 			name: "db error on count",
 			setup: func(tr *mocks.MockTeamRepository) {
-				tr.EXPECT().FindAll(0, 10, "created_at", "desc").Return([]model.Team{}, nil)
-				tr.EXPECT().Count().Return(int64(0), gorm.ErrInvalidDB)
+				tr.EXPECT().FindAll(repository.TeamFilter{}, 0, 10, "created_at", "desc").Return([]model.Team{}, nil)
+				tr.EXPECT().Count(repository.TeamFilter{}).Return(int64(0), gorm.ErrInvalidDB)
 			},
 			wantErr: true,
 		},
@@ -84,7 +106,7 @@ func TestTeamService_GetAll(t *testing.T) {
 			tt.setup(teamRepo)
 
 			pagination := dto.PaginationQuery{Page: 1, PerPage: 10, SortBy: "created_at", SortOrder: "desc"}
-			teams, meta, err := svc.GetAll(pagination)
+			teams, meta, err := svc.GetAll(context.Background(), pagination, tt.filter)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -98,6 +120,71 @@ func TestTeamService_GetAll(t *testing.T) {
 	}
 }
 
+func TestTeamService_GetAll_ByIDs(t *testing.T) {
+	first, second := sampleTeam(), sampleTeam()
+	missing := uuid.Must(uuid.NewV7())
+
+	t.Run("in request order on one page", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		filter := repository.TeamFilter{IDs: []uuid.UUID{second.ID, missing, first.ID}}
+		teamRepo.EXPECT().FindAll(filter, 0, 3, "created_at", "desc").Return([]model.Team{first, second}, nil)
+		teamRepo.EXPECT().Count(filter).Return(int64(2), nil)
+
+		ids := fmt.Sprintf("%s, %s,%s,%s", second.ID, missing, first.ID, second.ID)
+		teams, meta, err := svc.GetAll(context.Background(), dto.PaginationQuery{Page: 4}, dto.TeamFilterQuery{IDs: ids})
+
+		assert.NoError(t, err)
+		if assert.Len(t, teams, 2) {
+			assert.Equal(t, second.ID.String(), teams[0].ID)
+			assert.Equal(t, first.ID.String(), teams[1].ID)
+		}
+		assert.Equal(t, 1, meta.Page)
+		assert.Equal(t, int64(2), meta.Total)
+	})
+
+	t.Run("invalid or too many ids", func(t *testing.T) {
+		svc, _ := newTestTeamService(t)
+		tooMany := first.ID.String()
+		for range maxBatchIDs {
+			tooMany += "," + uuid.Must(uuid.NewV7()).String()
+		}
+
+		for _, ids := range []string{"persija", tooMany} {
+			_, _, err := svc.GetAll(context.Background(), dto.PaginationQuery{}, dto.TeamFilterQuery{IDs: ids})
+			var appErr *errs.AppError
+			assert.ErrorAs(t, err, &appErr)
+			assert.Equal(t, 400, appErr.Code)
+		}
+	})
+}
+
+func TestTeamService_GetAll_Fields(t *testing.T) {
+	t.Run("loads only what the fields need", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		filter := repository.TeamFilter{Projection: repository.Projection{
+			Columns:      []string{"id", "name"},
+			Associations: []string{"HeadCoach"},
+		}}
+		teamRepo.EXPECT().FindAll(filter, 0, 10, "created_at", "desc").Return([]model.Team{sampleTeam()}, nil)
+		teamRepo.EXPECT().Count(filter).Return(int64(1), nil)
+
+		_, _, err := svc.GetAll(context.Background(), dto.PaginationQuery{}, dto.TeamFilterQuery{Fields: "id, name,head_coach,name"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		svc, _ := newTestTeamService(t)
+
+		_, _, err := svc.GetAll(context.Background(), dto.PaginationQuery{}, dto.TeamFilterQuery{Fields: "name,colour"})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 400, appErr.Code)
+		assert.Contains(t, appErr.Message, `"colour"`)
+	})
+}
+
 func TestTeamService_GetByID(t *testing.T) {
 	team := sampleTeam()
 
@@ -141,7 +228,7 @@ func TestTeamService_GetByID(t *testing.T) {
 			svc, teamRepo := newTestTeamService(t)
 			tt.setup(teamRepo)
 
-			result, err := svc.GetByID(tt.id)
+			result, err := svc.GetByID(context.Background(), tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -158,6 +245,19 @@ func TestTeamService_GetByID(t *testing.T) {
 	}
 }
 
+func TestTeamService_GetByID_HeadCoach(t *testing.T) {
+	team := sampleTeam()
+	team.HeadCoach = &model.Coach{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, TeamID: team.ID, Name: "Carlos Pena", Role: model.CoachRoleHead}
+	svc, teamRepo := newTestTeamService(t)
+	teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+
+	result, err := svc.GetByID(context.Background(), team.ID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result.HeadCoach)
+	assert.Equal(t, "Carlos Pena", result.HeadCoach.Name)
+}
+
 func TestTeamService_Create(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -175,6 +275,7 @@ func TestTeamService_Create(t *testing.T) {
 				City:        "Jakarta",
 			},
 			setup: func(tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
 				tr.EXPECT().Create(mock.AnythingOfType("*model.Team")).Return(nil)
 			},
 			wantErr: false,
@@ -185,6 +286,7 @@ func TestTeamService_Create(t *testing.T) {
 				Name: "Persija Jakarta",
 			},
 			setup: func(tr *mocks.MockTeamRepository) {
+				tr.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
 				tr.EXPECT().Create(mock.AnythingOfType("*model.Team")).Return(gorm.ErrInvalidDB)
 			},
 			wantErr: true,
@@ -196,7 +298,7 @@ func TestTeamService_Create(t *testing.T) {
 			svc, teamRepo := newTestTeamService(t)
 			tt.setup(teamRepo)
 
-			result, err := svc.Create(tt.req)
+			result, err := svc.Create(context.Background(), tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -210,6 +312,218 @@ func TestTeamService_Create(t *testing.T) {
 	}
 }
 
+func TestTeamService_Create_DuplicateName(t *testing.T) {
+	existing := sampleTeam()
+
+	t.Run("name taken by another team, ignoring case", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamRepo.EXPECT().FindByName("persija jakarta").Return(&existing, nil)
+
+		_, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "persija jakarta"})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 409, appErr.Code)
+		assert.Equal(t, CodeTeamNameTaken, appErr.ErrorCode)
+	})
+
+	t.Run("concurrent create wins the race", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamRepo.EXPECT().FindByName("Persija Jakarta").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().Create(mock.AnythingOfType("*model.Team")).Return(repository.ErrDuplicateTeamName)
+
+		_, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "Persija Jakarta"})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 409, appErr.Code)
+		assert.Equal(t, CodeTeamNameTaken, appErr.ErrorCode)
+	})
+}
+
+func TestTeamService_Abbreviation(t *testing.T) {
+	existing := sampleTeam()
+	existing.Abbreviation = "PSJ"
+
+	t.Run("stored in upper case with the colors", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamRepo.EXPECT().FindByName("Persib Bandung").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().FindByAbbreviation("PSB").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().Create(mock.MatchedBy(func(team *model.Team) bool {
+			return team.Abbreviation == "PSB" && team.PrimaryColor == "#0055A4" && team.SecondaryColor == "#FFFFFF"
+		})).Return(nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateTeamRequest{
+			Name: "Persib Bandung", ShortName: "Persib", Abbreviation: "psb", PrimaryColor: "#0055a4", SecondaryColor: "#ffffff",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Persib", result.ShortName)
+		assert.Equal(t, "PSB", result.Abbreviation)
+		assert.Equal(t, "#0055A4", result.PrimaryColor)
+	})
+
+	t.Run("taken by another team", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamRepo.EXPECT().FindByName("Persis Solo").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().FindByAbbreviation("PSJ").Return(&existing, nil)
+
+		_, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "Persis Solo", Abbreviation: "Psj"})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 409, appErr.Code)
+		assert.Equal(t, CodeTeamAbbreviationTaken, appErr.ErrorCode)
+	})
+
+	t.Run("kept by the team itself", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		team := existing
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		teamRepo.EXPECT().FindByName(team.Name).Return(&team, nil)
+		teamRepo.EXPECT().FindByAbbreviation("PSJ").Return(&team, nil)
+		teamRepo.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
+		city := "Jakarta Utara"
+
+		result, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{City: &city})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "PSJ", result.Abbreviation)
+	})
+
+	t.Run("concurrent update wins the race", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		team := sampleTeam()
+		abbreviation := "PSJ"
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		teamRepo.EXPECT().FindByName(team.Name).Return(&team, nil)
+		teamRepo.EXPECT().FindByAbbreviation("PSJ").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(repository.ErrDuplicateTeamAbbreviation)
+
+		_, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{Abbreviation: &abbreviation})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, CodeTeamAbbreviationTaken, appErr.ErrorCode)
+	})
+}
+
+func TestTeamService_Slug(t *testing.T) {
+	// Built without newTestTeamService, whose repository has no teams with a slug
+	newService := func(t *testing.T) (*teamService, *mocks.MockTeamRepository) {
+		teamRepo := mocks.NewMockTeamRepository(t)
+		return &teamService{teamRepo: teamRepo}, teamRepo
+	}
+
+	t.Run("made from the name on create", func(t *testing.T) {
+		svc, teamRepo := newService(t)
+		teamRepo.EXPECT().FindByName("Persija Jakarta").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().FindSlugs("persija-jakarta").Return(nil, nil)
+		teamRepo.EXPECT().Create(mock.MatchedBy(func(team *model.Team) bool { return team.Slug == "persija-jakarta" })).Return(nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "Persija Jakarta"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "persija-jakarta", result.Slug)
+	})
+
+	t.Run("numbered when taken", func(t *testing.T) {
+		svc, teamRepo := newService(t)
+		teamRepo.EXPECT().FindByName("PSM Makassar!").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().FindSlugs("psm-makassar").Return([]string{"psm-makassar", "psm-makassar-2"}, nil)
+		teamRepo.EXPECT().Create(mock.AnythingOfType("*model.Team")).Return(nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "PSM Makassar!"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "psm-makassar-3", result.Slug)
+	})
+
+	t.Run("kept while the name matches", func(t *testing.T) {
+		svc, teamRepo := newService(t)
+		team := sampleTeam()
+		team.Slug = "persija-jakarta-2"
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		teamRepo.EXPECT().FindByName("PERSIJA JAKARTA").Return(&team, nil)
+		teamRepo.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
+		name := "PERSIJA JAKARTA"
+
+		result, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{Name: &name})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "persija-jakarta-2", result.Slug)
+	})
+
+	t.Run("follows a rename", func(t *testing.T) {
+		svc, teamRepo := newService(t)
+		team := sampleTeam()
+		team.Slug = "persija-jakarta"
+		teamRepo.EXPECT().FindByID(team.ID).Return(&team, nil)
+		teamRepo.EXPECT().FindByName("Persija").Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().FindSlugs("persija").Return(nil, nil)
+		teamRepo.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
+		name := "Persija"
+
+		result, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{Name: &name})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "persija", result.Slug)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		svc, teamRepo := newService(t)
+		team := sampleTeam()
+		team.Slug = "persija-jakarta"
+		teamRepo.EXPECT().FindBySlug("persija-jakarta").Return(&team, nil)
+		teamRepo.EXPECT().FindBySlug("persib-bandung").Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := svc.GetBySlug(context.Background(), "persija-jakarta")
+		assert.NoError(t, err)
+		assert.Equal(t, team.ID.String(), result.ID)
+
+		_, err = svc.GetBySlug(context.Background(), "persib-bandung")
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+		assert.Equal(t, CodeTeamNotFound, appErr.ErrorCode)
+	})
+}
+
+func TestTeamService_Create_Stadium(t *testing.T) {
+	stadiumID := uuid.Must(uuid.NewV7())
+
+	t.Run("home stadium", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+		stadiumRepo.EXPECT().FindByID(stadiumID).Return(&model.Stadium{Base: model.Base{ID: stadiumID}}, nil)
+		teamRepo.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().Create(mock.MatchedBy(func(team *model.Team) bool {
+			return team.StadiumID != nil && *team.StadiumID == stadiumID
+		})).Return(nil)
+
+		result, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "Persija Jakarta", StadiumID: stadiumID.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, stadiumID.String(), result.StadiumID)
+	})
+
+	t.Run("unknown stadium", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		stadiumRepo := mocks.NewMockStadiumRepository(t)
+		svc.stadiumRepo = stadiumRepo
+		teamRepo.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+		stadiumRepo.EXPECT().FindByID(stadiumID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.Create(context.Background(), dto.CreateTeamRequest{Name: "Persija Jakarta", StadiumID: stadiumID.String()})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, 404, appErr.Code)
+		assert.Contains(t, appErr.Message, "Stadium not found")
+	})
+}
+
 func TestTeamService_Update(t *testing.T) {
 	team := sampleTeam()
 
@@ -234,6 +548,7 @@ func TestTeamService_Update(t *testing.T) {
 			setup: func(tr *mocks.MockTeamRepository) {
 				teamCopy := team
 				tr.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+				tr.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
 				tr.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
 			},
 			wantErr: false,
@@ -248,6 +563,56 @@ func TestTeamService_Update(t *testing.T) {
 			wantErr:     true,
 			errContains: "Team not found",
 		},
+		{
+			name: "stale version",
+			id:   team.ID,
+			req:  dto.UpdateTeamRequest{Name: "Persija Updated", Version: 1},
+			setup: func(tr *mocks.MockTeamRepository) {
+				teamCopy := team
+				teamCopy.Version = 2
+				tr.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+			},
+			wantErr:     true,
+			errContains: "modified by another request",
+		},
+		{
+			name: "concurrent update wins the race",
+			id:   team.ID,
+			req:  dto.UpdateTeamRequest{Name: "Persija Updated", Version: 1},
+			setup: func(tr *mocks.MockTeamRepository) {
+				teamCopy := team
+				teamCopy.Version = 1
+				tr.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+				tr.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+				tr.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(repository.ErrVersionConflict)
+			},
+			wantErr:     true,
+			errContains: "modified by another request",
+		},
+		{
+			name: "name taken by another team",
+			id:   team.ID,
+			req:  dto.UpdateTeamRequest{Name: "PERSIB BANDUNG"},
+			setup: func(tr *mocks.MockTeamRepository) {
+				teamCopy := team
+				tr.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+				tr.EXPECT().FindByName("PERSIB BANDUNG").Return(&model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}, nil)
+			},
+			wantErr:     true,
+			errContains: "Team name already taken",
+		},
+		{
+			name: "keeping its own name in another case",
+			id:   team.ID,
+			req:  dto.UpdateTeamRequest{Name: "PERSIJA JAKARTA"},
+			setup: func(tr *mocks.MockTeamRepository) {
+				teamCopy := team
+				tr.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+				tr.EXPECT().FindByName("PERSIJA JAKARTA").Return(&teamCopy, nil)
+				tr.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -255,7 +620,7 @@ func TestTeamService_Update(t *testing.T) {
 			svc, teamRepo := newTestTeamService(t)
 			tt.setup(teamRepo)
 
-			result, err := svc.Update(tt.id, tt.req)
+			result, err := svc.Update(context.Background(), tt.id, tt.req)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -272,31 +637,122 @@ func TestTeamService_Update(t *testing.T) {
 	}
 }
 
+func TestTeamService_Patch(t *testing.T) {
+	team := sampleTeam()
+	city := "Bandung"
+
+	t.Run("only given fields change", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamCopy := team
+		teamRepo.EXPECT().FindByID(team.ID).Return(&teamCopy, nil)
+		teamRepo.EXPECT().FindByName(mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+		teamRepo.EXPECT().Update(mock.AnythingOfType("*model.Team")).Return(nil)
+
+		result, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{City: &city})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bandung", result.City)
+		assert.Equal(t, team.Name, result.Name)
+		assert.Equal(t, team.LogoURL, result.LogoURL)
+		assert.Equal(t, team.FoundedYear, result.FoundedYear)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, teamRepo := newTestTeamService(t)
+		teamRepo.EXPECT().FindByID(team.ID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := svc.Patch(context.Background(), team.ID, dto.PatchTeamRequest{City: &city})
+
+		var appErr *errs.AppError
+		assert.ErrorAs(t, err, &appErr)
+		assert.Equal(t, "Team not found", appErr.Message)
+	})
+}
+
 func TestTeamService_Delete(t *testing.T) {
 	teamID := uuid.Must(uuid.NewV7())
+	team := sampleTeam()
+	team.ID = teamID
 
 	tests := []struct {
 		name        string
 		id          uuid.UUID
-		setup       func(*mocks.MockTeamRepository)
+		cascade     bool
+		setup       func(*mocks.MockTeamRepository, *mocks.MockPlayerRepository, *mocks.MockMatchRepository, *mocks.MockTxManager)
 		wantErr     bool
 		errContains string
 	}{
 		{
-			name: "success",
+			name: "success without players",
 			id:   teamID,
-			setup: func(tr *mocks.MockTeamRepository) {
-				team := sampleTeam()
-				team.ID = teamID
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
 				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				mr.EXPECT().CountUpcomingByTeamID(teamID).Return(0, nil)
+				pr.EXPECT().CountByTeamID(teamID).Return(0, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+					return fn(repository.TxRepositories{Teams: tr, Players: pr})
+				})
 				tr.EXPECT().Delete(teamID).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:    "cascade deletes players with the team",
+			id:      teamID,
+			cascade: true,
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				mr.EXPECT().CountUpcomingByTeamID(teamID).Return(0, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+					return fn(repository.TxRepositories{Teams: tr, Players: pr})
+				})
+				pr.EXPECT().DeleteByTeamID(teamID).Return(23, nil)
+				tr.EXPECT().Delete(teamID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "players block delete without cascade",
+			id:   teamID,
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				mr.EXPECT().CountUpcomingByTeamID(teamID).Return(0, nil)
+				pr.EXPECT().CountByTeamID(teamID).Return(23, nil)
+			},
+			wantErr:     true,
+			errContains: "Team has 23 players",
+		},
+		{
+			name:    "upcoming matches block delete even with cascade",
+			id:      teamID,
+			cascade: true,
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				mr.EXPECT().CountUpcomingByTeamID(teamID).Return(2, nil)
+			},
+			wantErr:     true,
+			errContains: "Team has 2 scheduled, live, awaiting_result or postponed matches",
+		},
+		{
+			name:    "transaction failure",
+			id:      teamID,
+			cascade: true,
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
+				tr.EXPECT().FindByID(teamID).Return(&team, nil)
+				mr.EXPECT().CountUpcomingByTeamID(teamID).Return(0, nil)
+				tx.EXPECT().WithinTransaction(mock.Anything).RunAndReturn(func(fn func(repository.TxRepositories) error) error {
+					return fn(repository.TxRepositories{Teams: tr, Players: pr})
+				})
+				pr.EXPECT().DeleteByTeamID(teamID).Return(23, nil)
+				tr.EXPECT().Delete(teamID).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
 		{
 			name: "not found",
 			id:   uuid.Must(uuid.NewV7()),
-			setup: func(tr *mocks.MockTeamRepository) {
+			setup: func(tr *mocks.MockTeamRepository, pr *mocks.MockPlayerRepository, mr *mocks.MockMatchRepository, tx *mocks.MockTxManager) {
 				tr.EXPECT().FindByID(mock.AnythingOfType("uuid.UUID")).Return(nil, gorm.ErrRecordNotFound)
 			},
 			wantErr:     true,
@@ -307,9 +763,13 @@ func TestTeamService_Delete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			svc, teamRepo := newTestTeamService(t)
-			tt.setup(teamRepo)
+			playerRepo := mocks.NewMockPlayerRepository(t)
+			matchRepo := mocks.NewMockMatchRepository(t)
+			txManager := mocks.NewMockTxManager(t)
+			svc.playerRepo, svc.matchRepo, svc.txManager = playerRepo, matchRepo, txManager
+			tt.setup(teamRepo, playerRepo, matchRepo, txManager)
 
-			err := svc.Delete(tt.id)
+			err := svc.Delete(context.Background(), tt.id, tt.cascade)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// TimelineTypeStatusChange is the timeline entry type of a match status change.
+const TimelineTypeStatusChange = "status_change"
+
+// statusChangeTexts describes what a move to each status means on a ticker.
+var statusChangeTexts = map[string]string{
+	model.MatchStatusScheduled:      "Match rescheduled",
+	model.MatchStatusLive:           "Kick-off",
+	model.MatchStatusAwaitingResult: "Awaiting the result",
+	model.MatchStatusCompleted:      "Full time",
+	model.MatchStatusPostponed:      "Match postponed",
+	model.MatchStatusCancelled:      "Match cancelled",
+}
+
+// TimelineService defines the contract for match timelines.
+type TimelineService interface {
+	GetTimeline(ctx context.Context, matchID uuid.UUID) (*dto.MatchTimelineResponse, error)
+}
+
+type timelineService struct {
+	matchRepo        repository.MatchRepository
+	statusChangeRepo repository.MatchStatusChangeRepository
+}
+
+// NewTimelineService creates a new TimelineService instance.
+func NewTimelineService(matchRepo repository.MatchRepository, statusChangeRepo repository.MatchStatusChangeRepository) TimelineService {
+	return &timelineService{
+		matchRepo:        matchRepo,
+		statusChangeRepo: statusChangeRepo,
+	}
+}
+
+// GetTimeline combines the events and status changes of a match into one chronological feed.
+// Events are placed by match minute and status changes by the minutes between kick-off and
+// the change, except full time, which always comes last.
+func (s *timelineService) GetTimeline(ctx context.Context, matchID uuid.UUID) (*dto.MatchTimelineResponse, error) {
+	match, err := s.matchRepo.FindByIDWithDetails(matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Match not found").WithCode(CodeMatchNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch match for timeline", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	changes, err := s.statusChangeRepo.FindByMatchID(matchID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch match status changes", "error", err, "match_id", matchID)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := &dto.MatchTimelineResponse{
+		MatchID:   match.ID.String(),
+		Status:    match.Status,
+		HomeScore: match.HomeScore,
+		AwayScore: match.AwayScore,
+		Entries:   timelineEntries(*match, changes),
+	}
+	if match.HomeTeam != nil {
+		homeTeam := toTeamResponse(*match.HomeTeam)
+		resp.HomeTeam = &homeTeam
+	}
+	if match.AwayTeam != nil {
+		awayTeam := toTeamResponse(*match.AwayTeam)
+		resp.AwayTeam = &awayTeam
+	}
+	return resp, nil
+}
+
+// timelineEntries merges the match's events, which must be in match order, with its status
+// changes, oldest first.
+func timelineEntries(match model.Match, changes []model.MatchStatusChange) []dto.MatchTimelineEntry {
+	type item struct {
+		minute, addedTime int
+		last              bool // full time goes after every event, however early it was recorded
+		entry             dto.MatchTimelineEntry
+	}
+	items := make([]item, 0, len(changes)+len(match.Events))
+
+	// Status changes come first so a change and an event in the same minute keep that order
+	for _, change := range changes {
+		minute := int(math.Floor(change.CreatedAt.Sub(match.MatchDatetime).Minutes()))
+		entry := dto.MatchTimelineEntry{
+			Type:       TimelineTypeStatusChange,
+			Minute:     minute,
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			OccurredAt: timefmt.Format(change.CreatedAt),
+			Text:       statusChangeTexts[change.ToStatus],
+		}
+		if minute >= 0 {
+			entry.DisplayMinute = model.FormatEventMinute(minute, 0)
+		}
+		if change.ToStatus == model.MatchStatusCompleted {
+			entry.Score = match.ScoreLine()
+			entry.Text += " " + entry.Score
+		}
+		items = append(items, item{minute: minute, last: change.ToStatus == model.MatchStatusCompleted, entry: entry})
+	}
+
+	homeScore, awayScore := 0, 0
+	for _, event := range match.Events {
+		entry := dto.MatchTimelineEntry{
+			Type:          event.Type,
+			Minute:        event.Minute,
+			AddedTime:     event.AddedTime,
+			DisplayMinute: event.DisplayMinute(),
+			TeamID:        event.TeamID.String(),
+			PlayerID:      event.PlayerID.String(),
+		}
+		if event.Team != nil {
+			entry.TeamName = event.Team.Name
+		}
+		if event.Player != nil {
+			entry.PlayerName = event.Player.Name
+		}
+		if event.RelatedPlayerID != nil {
+			entry.RelatedPlayerID = event.RelatedPlayerID.String()
+		}
+		if event.RelatedPlayer != nil {
+			entry.RelatedPlayerName = event.RelatedPlayer.Name
+		}
+
+		if event.IsScoring() {
+			// Own goals count for the opponent of the scorer's team
+			if (event.TeamID == match.HomeTeamID) != (event.Type == model.EventOwnGoal) {
+				homeScore++
+			} else {
+				awayScore++
+			}
+			entry.Score = strconv.Itoa(homeScore) + "-" + strconv.Itoa(awayScore)
+		}
+		entry.Text = timelineEventText(entry)
+		items = append(items, item{minute: event.Minute, addedTime: event.AddedTime, entry: entry})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.last != b.last {
+			return b.last
+		}
+		if a.minute != b.minute {
+			return a.minute < b.minute
+		}
+		return a.addedTime < b.addedTime
+	})
+
+	entries := make([]dto.MatchTimelineEntry, len(items))
+	for i, it := range items {
+		entries[i] = it.entry
+	}
+	return entries
+}
+
+// timelineEventText returns the ticker line of a match event.
+func timelineEventText(entry dto.MatchTimelineEntry) string {
+	who := fmt.Sprintf("%s (%s)", entry.PlayerName, entry.TeamName)
+	switch entry.Type {
+	case model.EventGoal:
+		return "Goal! " + who + " " + entry.Score
+	case model.EventPenalty:
+		return "Penalty scored by " + who + " " + entry.Score
+	case model.EventOwnGoal:
+		return "Own goal by " + who + " " + entry.Score
+	case model.EventYellowCard:
+		return "Yellow card for " + who
+	case model.EventRedCard:
+		return "Red card for " + who
+	case model.EventSubstitution:
+		return fmt.Sprintf("Substitution for %s: %s on, %s off", entry.TeamName, entry.RelatedPlayerName, entry.PlayerName)
+	}
+	return who
+}
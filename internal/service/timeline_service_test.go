@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestTimelineService_GetTimeline(t *testing.T) {
+	kickOff := time.Date(2026, 3, 15, 12, 30, 0, 0, time.UTC)
+	home := model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persija Jakarta"}
+	away := model.Team{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Persib Bandung"}
+	simic := model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Marko Simic"}
+	riko := model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Riko Simanjuntak"}
+	ciro := model.Player{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Name: "Ciro Alves"}
+
+	event := func(eventType string, minute, addedTime int, team model.Team, player model.Player) model.MatchEvent {
+		return model.MatchEvent{
+			Base:      model.Base{ID: uuid.Must(uuid.NewV7())},
+			Type:      eventType,
+			Minute:    minute,
+			AddedTime: addedTime,
+			TeamID:    team.ID,
+			Team:      &team,
+			PlayerID:  player.ID,
+			Player:    &player,
+		}
+	}
+	sub := event(model.EventSubstitution, 60, 0, home, simic)
+	sub.RelatedPlayerID = &riko.ID
+	sub.RelatedPlayer = &riko
+
+	match := &model.Match{
+		Base:          model.Base{ID: uuid.Must(uuid.NewV7())},
+		HomeTeamID:    home.ID,
+		AwayTeamID:    away.ID,
+		HomeTeam:      &home,
+		AwayTeam:      &away,
+		MatchDatetime: kickOff,
+		HomeScore:     1,
+		AwayScore:     1,
+		Status:        model.MatchStatusCompleted,
+		Events: []model.MatchEvent{
+			event(model.EventGoal, 12, 0, home, simic),
+			event(model.EventYellowCard, 45, 2, away, ciro),
+			sub,
+			event(model.EventOwnGoal, 90, 3, home, riko),
+		},
+	}
+	change := func(from, to string, at time.Time) model.MatchStatusChange {
+		return model.MatchStatusChange{Base: model.Base{ID: uuid.Must(uuid.NewV7()), CreatedAt: at}, MatchID: match.ID, FromStatus: from, ToStatus: to}
+	}
+	changes := []model.MatchStatusChange{
+		change(model.MatchStatusScheduled, model.MatchStatusPostponed, kickOff.Add(-48*time.Hour)),
+		change(model.MatchStatusPostponed, model.MatchStatusScheduled, kickOff.Add(-24*time.Hour)),
+		change(model.MatchStatusScheduled, model.MatchStatusLive, kickOff.Add(30*time.Second)),
+		change(model.MatchStatusLive, model.MatchStatusAwaitingResult, kickOff.Add(2*time.Hour)),
+		// Results are often submitted before the last events would suggest
+		change(model.MatchStatusAwaitingResult, model.MatchStatusCompleted, kickOff.Add(80*time.Minute)),
+	}
+
+	matchRepo := mocks.NewMockMatchRepository(t)
+	statusChangeRepo := mocks.NewMockMatchStatusChangeRepository(t)
+	matchRepo.EXPECT().FindByIDWithDetails(match.ID).Return(match, nil)
+	statusChangeRepo.EXPECT().FindByMatchID(match.ID).Return(changes, nil)
+	svc := NewTimelineService(matchRepo, statusChangeRepo)
+
+	timeline, err := svc.GetTimeline(context.Background(), match.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, match.ID.String(), timeline.MatchID)
+	assert.Equal(t, "Persija Jakarta", timeline.HomeTeam.Name)
+	assert.Equal(t, 1, timeline.HomeScore)
+
+	var texts, minutes []string
+	for _, entry := range timeline.Entries {
+		texts = append(texts, entry.Text)
+		minutes = append(minutes, entry.DisplayMinute)
+	}
+	assert.Equal(t, []string{
+		"Match postponed",
+		"Match rescheduled",
+		"Kick-off",
+		"Goal! Marko Simic (Persija Jakarta) 1-0",
+		"Yellow card for Ciro Alves (Persib Bandung)",
+		"Substitution for Persija Jakarta: Riko Simanjuntak on, Marko Simic off",
+		"Own goal by Riko Simanjuntak (Persija Jakarta) 1-1",
+		"Awaiting the result",
+		"Full time 1-1",
+	}, texts)
+	assert.Equal(t, []string{"", "", "0'", "12'", "45+2'", "60'", "90+3'", "120'", "80'"}, minutes)
+
+	postponed := timeline.Entries[0]
+	assert.Equal(t, TimelineTypeStatusChange, postponed.Type)
+	assert.Equal(t, -48*60, postponed.Minute)
+	assert.Equal(t, "2026-03-13T12:30:00.000Z", postponed.OccurredAt)
+	assert.Equal(t, "1-1", timeline.Entries[len(timeline.Entries)-1].Score)
+}
+
+func TestTimelineService_GetTimeline_NotFound(t *testing.T) {
+	matchRepo := mocks.NewMockMatchRepository(t)
+	statusChangeRepo := mocks.NewMockMatchStatusChangeRepository(t)
+	id := uuid.Must(uuid.NewV7())
+	matchRepo.EXPECT().FindByIDWithDetails(id).Return(nil, gorm.ErrRecordNotFound)
+	svc := NewTimelineService(matchRepo, statusChangeRepo)
+
+	_, err := svc.GetTimeline(context.Background(), id)
+
+	var appErr *errs.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.Code)
+	assert.Equal(t, CodeMatchNotFound, appErr.ErrorCode)
+}
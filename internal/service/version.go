@@ -0,0 +1,29 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+)
+
+// checkVersion rejects an update made against an outdated copy of a record. expected is the
+// version the client last read; 0 means the client sent none and the check is skipped.
+func checkVersion(entity string, expected, current int) error {
+	if expected != 0 && expected != current {
+		return errs.ErrConflict(fmt.Sprintf("%s was modified by another request (now version %d); reload it and try again", entity, current)).WithCode(CodeVersionConflict)
+	}
+	return nil
+}
+
+// isVersionConflict reports whether a write lost the race against another write
+// to the same record between reading and saving it.
+func isVersionConflict(err error) bool {
+	return errors.Is(err, repository.ErrVersionConflict)
+}
+
+// errVersionConflict is the 409 returned when isVersionConflict.
+func errVersionConflict(entity string) error {
+	return errs.ErrConflict(entity + " was modified by another request; reload it and try again").WithCode(CodeVersionConflict)
+}
@@ -0,0 +1,477 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/response"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/timefmt"
+	"gorm.io/gorm"
+)
+
+// Webhook request headers.
+const (
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookDelivery  = "X-Webhook-Delivery"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+const (
+	// webhookBatchSize is how many due deliveries one dispatch round claims and sends concurrently.
+	webhookBatchSize = 20
+	// webhookFirstRetry is the delay before the second attempt; it doubles per attempt up to webhookMaxRetry.
+	webhookFirstRetry = 30 * time.Second
+	webhookMaxRetry   = time.Hour
+	// webhookMaxErrorLength caps the error message kept in the delivery log.
+	webhookMaxErrorLength = 500
+)
+
+// WebhookService defines the contract for webhook management and delivery.
+type WebhookService interface {
+	GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.WebhookResponse, *response.PaginationMeta, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*dto.WebhookResponse, error)
+	Create(ctx context.Context, adminID uuid.UUID, req dto.CreateWebhookRequest) (*dto.WebhookCreatedResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req dto.UpdateWebhookRequest) (*dto.WebhookResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, id uuid.UUID, pagination dto.PaginationQuery) ([]dto.WebhookDeliveryResponse, *response.PaginationMeta, error)
+	// Enqueue queues a delivery of event for every active webhook subscribed to its type.
+	Enqueue(ctx context.Context, event events.Event) error
+	// DispatchDue sends one batch of due deliveries and returns how many were attempted.
+	DispatchDue(ctx context.Context) (int, error)
+}
+
+type webhookService struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	client       *http.Client
+	timeout      time.Duration
+	maxAttempts  int
+}
+
+// NewWebhookService creates a new WebhookService instance.
+// timeout bounds each delivery request; a delivery is given up after maxAttempts failed attempts.
+func NewWebhookService(
+	webhookRepo repository.WebhookRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	timeout time.Duration,
+	maxAttempts int,
+) WebhookService {
+	return &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		client:       newWebhookClient(timeout),
+		timeout:      timeout,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// newWebhookClient returns an HTTP client that does not follow redirects:
+// a redirected POST would be replayed as GET, so 3xx answers count as failures.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func (s *webhookService) GetAll(ctx context.Context, pagination dto.PaginationQuery) ([]dto.WebhookResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	webhooks, err := s.webhookRepo.FindAll(pagination.GetOffset(), pagination.PerPage, pagination.SortBy, pagination.SortOrder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch webhooks", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.webhookRepo.Count()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count webhooks", "error", err)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	webhookResponses := make([]dto.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		webhookResponses[i] = toWebhookResponse(webhook)
+	}
+
+	return webhookResponses, paginationMeta(pagination, total), nil
+}
+
+func (s *webhookService) GetByID(ctx context.Context, id uuid.UUID) (*dto.WebhookResponse, error) {
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toWebhookResponse(*webhook)
+	return &resp, nil
+}
+
+// Create registers a webhook with a new signing secret. The secret is only returned here.
+func (s *webhookService) Create(ctx context.Context, adminID uuid.UUID, req dto.CreateWebhookRequest) (*dto.WebhookCreatedResponse, error) {
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to generate webhook secret", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	webhook := model.Webhook{
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      joinEventTypes(req.Events),
+		Secret:      secret,
+		Active:      true,
+		CreatedBy:   adminID,
+	}
+
+	if err := s.webhookRepo.Create(&webhook); err != nil {
+		slog.ErrorContext(ctx, "failed to create webhook", "error", err)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	return &dto.WebhookCreatedResponse{
+		Secret:  secret,
+		Webhook: toWebhookResponse(webhook),
+	}, nil
+}
+
+// Update changes a webhook's URL, description, events and active flag. The secret is kept.
+func (s *webhookService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	webhook, err := s.findWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.URL = req.URL
+	webhook.Description = req.Description
+	webhook.Events = joinEventTypes(req.Events)
+	webhook.Active = *req.Active
+
+	if err := s.webhookRepo.Update(webhook); err != nil {
+		slog.ErrorContext(ctx, "failed to update webhook", "error", err, "webhook_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+
+	resp := toWebhookResponse(*webhook)
+	return &resp, nil
+}
+
+// Delete removes a webhook. Its pending deliveries are marked failed when they come due.
+func (s *webhookService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.findWebhook(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(id); err != nil {
+		slog.ErrorContext(ctx, "failed to delete webhook", "error", err, "webhook_id", id)
+		return errs.ErrInternal("Internal server error")
+	}
+	return nil
+}
+
+// GetDeliveries returns a webhook's delivery log, newest first.
+func (s *webhookService) GetDeliveries(ctx context.Context, id uuid.UUID, pagination dto.PaginationQuery) ([]dto.WebhookDeliveryResponse, *response.PaginationMeta, error) {
+	pagination.Sanitize()
+
+	if _, err := s.findWebhook(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	deliveries, err := s.deliveryRepo.FindByWebhookID(id, pagination.GetOffset(), pagination.PerPage)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch webhook deliveries", "error", err, "webhook_id", id)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	total, err := s.deliveryRepo.CountByWebhookID(id)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count webhook deliveries", "error", err, "webhook_id", id)
+		return nil, nil, errs.ErrInternal("Internal server error")
+	}
+
+	deliveryResponses := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryResponses[i] = toWebhookDeliveryResponse(delivery)
+	}
+
+	return deliveryResponses, paginationMeta(pagination, total), nil
+}
+
+func (s *webhookService) Enqueue(ctx context.Context, event events.Event) error {
+	webhooks, err := s.webhookRepo.FindActive()
+	if err != nil {
+		return fmt.Errorf("find active webhooks: %w", err)
+	}
+
+	var subscribed []model.Webhook
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(event.Type) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	eventID, payload, err := EncodeEvent(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]model.WebhookDelivery, len(subscribed))
+	for i, webhook := range subscribed {
+		deliveries[i] = model.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       eventID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        model.DeliveryStatusPending,
+			NextAttemptAt: &now,
+		}
+	}
+	if err := s.deliveryRepo.CreateBatch(deliveries); err != nil {
+		return fmt.Errorf("queue webhook deliveries: %w", err)
+	}
+
+	slog.DebugContext(ctx, "queued webhook deliveries", "event_type", event.Type, "event_id", eventID, "count", len(deliveries))
+	return nil
+}
+
+func (s *webhookService) DispatchDue(ctx context.Context) (int, error) {
+	// Claimed deliveries are hidden from other instances until the lease runs out,
+	// which must outlast the slowest request of the batch
+	deliveries, err := s.deliveryRepo.ClaimDue(time.Now(), s.timeout+time.Minute, webhookBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("claim due webhook deliveries: %w", err)
+	}
+
+	webhooks := make(map[uuid.UUID]*model.Webhook)
+	for _, d := range deliveries {
+		if _, ok := webhooks[d.WebhookID]; ok {
+			continue
+		}
+		webhook, err := s.webhookRepo.FindByID(d.WebhookID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("find webhook %s: %w", d.WebhookID, err)
+		}
+		webhooks[d.WebhookID] = webhook // nil when deleted
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *model.WebhookDelivery) {
+			defer wg.Done()
+			s.attempt(ctx, webhooks[delivery.WebhookID], delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// attempt sends one delivery and records the outcome: success, a retry with backoff, or failure.
+func (s *webhookService) attempt(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) {
+	now := time.Now()
+	switch {
+	case webhook == nil:
+		delivery.Status = model.DeliveryStatusFailed
+		delivery.LastError = "Webhook was deleted"
+		delivery.NextAttemptAt = nil
+	case !webhook.Active:
+		delivery.Status = model.DeliveryStatusFailed
+		delivery.LastError = "Webhook is inactive"
+		delivery.NextAttemptAt = nil
+	default:
+		delivery.Attempts++
+		statusCode, err := s.send(ctx, webhook, delivery, now)
+		delivery.LastStatusCode = statusCode
+		switch {
+		case err == nil:
+			delivery.Status = model.DeliveryStatusSucceeded
+			delivery.LastError = ""
+			delivery.DeliveredAt = &now
+			delivery.NextAttemptAt = nil
+		case delivery.Attempts >= s.maxAttempts:
+			delivery.Status = model.DeliveryStatusFailed
+			delivery.LastError = truncate(err.Error(), webhookMaxErrorLength)
+			delivery.NextAttemptAt = nil
+		default:
+			next := now.Add(webhookRetryDelay(delivery.Attempts))
+			delivery.LastError = truncate(err.Error(), webhookMaxErrorLength)
+			delivery.NextAttemptAt = &next
+		}
+	}
+
+	if err := s.deliveryRepo.Update(delivery); err != nil {
+		// The lease expires and the delivery is attempted again, so receivers may see it twice
+		slog.ErrorContext(ctx, "failed to record webhook delivery", "error", err, "delivery_id", delivery.ID)
+		return
+	}
+	if delivery.Status == model.DeliveryStatusFailed {
+		slog.WarnContext(ctx, "webhook delivery failed", "delivery_id", delivery.ID, "webhook_id", delivery.WebhookID,
+			"event_type", delivery.EventType, "attempts", delivery.Attempts, "error", delivery.LastError)
+	}
+}
+
+// send POSTs the payload with its signature headers. Any non-2xx answer is an error.
+func (s *webhookService) send(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "xyz-football-api-webhook")
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookDelivery, delivery.ID.String())
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, "sha256="+SignWebhookPayload(webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with secret.
+// Receivers recompute it to verify that a delivery is authentic and unmodified.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay returns the wait after the given number of failed attempts:
+// 30s, 1m, 2m, 4m, ... capped at one hour.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookFirstRetry
+	for i := 1; i < attempts && delay < webhookMaxRetry; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxRetry)
+}
+
+// findWebhook loads a webhook, mapping a missing record to 404.
+func (s *webhookService) findWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook, err := s.webhookRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotFound("Webhook not found").WithCode(CodeWebhookNotFound)
+		}
+		slog.ErrorContext(ctx, "failed to fetch webhook", "error", err, "webhook_id", id)
+		return nil, errs.ErrInternal("Internal server error")
+	}
+	return webhook, nil
+}
+
+// generateWebhookSecret returns a new signing secret: "whsec_" followed by 32 random bytes encoded as hex.
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// joinEventTypes stores event types unique and sorted, regardless of how they were sent.
+func joinEventTypes(eventTypes []string) string {
+	sorted := slices.Clone(eventTypes)
+	slices.Sort(sorted)
+	return strings.Join(slices.Compact(sorted), ",")
+}
+
+// truncate shortens s to at most n bytes.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+// paginationMeta builds the pagination metadata for a page of total items.
+func paginationMeta(pagination dto.PaginationQuery, total int64) *response.PaginationMeta {
+	totalPages := int(total) / pagination.PerPage
+	if int(total)%pagination.PerPage > 0 {
+		totalPages++
+	}
+	return &response.PaginationMeta{
+		Page:       pagination.Page,
+		PerPage:    pagination.PerPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// toWebhookResponse converts a model.Webhook to dto.WebhookResponse.
+func toWebhookResponse(webhook model.Webhook) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		ID:          webhook.ID.String(),
+		URL:         webhook.URL,
+		Description: webhook.Description,
+		Events:      webhook.EventList(),
+		Active:      webhook.Active,
+		CreatedBy:   webhook.CreatedBy.String(),
+		CreatedAt:   timefmt.Format(webhook.CreatedAt),
+		UpdatedAt:   timefmt.Format(webhook.UpdatedAt),
+	}
+}
+
+// toWebhookDeliveryResponse converts a model.WebhookDelivery to dto.WebhookDeliveryResponse.
+func toWebhookDeliveryResponse(delivery model.WebhookDelivery) dto.WebhookDeliveryResponse {
+	resp := dto.WebhookDeliveryResponse{
+		ID:             delivery.ID.String(),
+		EventID:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		Payload:        json.RawMessage(delivery.Payload),
+		CreatedAt:      timefmt.Format(delivery.CreatedAt),
+	}
+	if delivery.NextAttemptAt != nil {
+		resp.NextAttemptAt = timefmt.Format(*delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		resp.DeliveredAt = timefmt.Format(*delivery.DeliveredAt)
+	}
+	return resp
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/dto"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/mocks"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func newTestWebhookService(t *testing.T) (*webhookService, *mocks.MockWebhookRepository, *mocks.MockWebhookDeliveryRepository) {
+	webhookRepo := mocks.NewMockWebhookRepository(t)
+	deliveryRepo := mocks.NewMockWebhookDeliveryRepository(t)
+	svc := &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		client:       newWebhookClient(2 * time.Second),
+		timeout:      2 * time.Second,
+		maxAttempts:  3,
+	}
+	return svc, webhookRepo, deliveryRepo
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	adminID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name        string
+		setup       func(*mocks.MockWebhookRepository)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "success returns the secret once",
+			setup: func(wr *mocks.MockWebhookRepository) {
+				wr.EXPECT().Create(mock.MatchedBy(func(w *model.Webhook) bool {
+					return w.Events == "match.completed,team.created" &&
+						w.Active &&
+						w.CreatedBy == adminID &&
+						strings.HasPrefix(w.Secret, "whsec_")
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			setup: func(wr *mocks.MockWebhookRepository) {
+				wr.EXPECT().Create(mock.AnythingOfType("*model.Webhook")).Return(gorm.ErrInvalidDB)
+			},
+			wantErr:     true,
+			errContains: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, webhookRepo, _ := newTestWebhookService(t)
+			tt.setup(webhookRepo)
+
+			result, err := svc.Create(context.Background(), adminID, dto.CreateWebhookRequest{
+				URL:    "https://erp.example.com/hooks",
+				Events: []string{FeedTeamCreated, FeedMatchCompleted, FeedTeamCreated},
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Secret, len("whsec_")+64)
+				assert.Equal(t, []string{FeedMatchCompleted, FeedTeamCreated}, result.Webhook.Events)
+			}
+		})
+	}
+}
+
+func TestWebhookService_Enqueue(t *testing.T) {
+	completedHook := model.Webhook{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Events: "match.completed", Active: true}
+	teamHook := model.Webhook{Base: model.Base{ID: uuid.Must(uuid.NewV7())}, Events: "team.created,team.deleted", Active: true}
+
+	tests := []struct {
+		name      string
+		eventType string
+		setup     func(*mocks.MockWebhookRepository, *mocks.MockWebhookDeliveryRepository)
+		wantErr   bool
+	}{
+		{
+			name:      "queues only for subscribed webhooks",
+			eventType: FeedMatchCompleted,
+			setup: func(wr *mocks.MockWebhookRepository, dr *mocks.MockWebhookDeliveryRepository) {
+				wr.EXPECT().FindActive().Return([]model.Webhook{completedHook, teamHook}, nil)
+				dr.EXPECT().CreateBatch(mock.MatchedBy(func(d []model.WebhookDelivery) bool {
+					var payload dto.EventPayload
+					return len(d) == 1 &&
+						d[0].WebhookID == completedHook.ID &&
+						d[0].Status == model.DeliveryStatusPending &&
+						d[0].NextAttemptAt != nil &&
+						json.Unmarshal([]byte(d[0].Payload), &payload) == nil &&
+						payload.ID == d[0].EventID.String() &&
+						payload.Type == FeedMatchCompleted
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "no subscribers queues nothing",
+			eventType: FeedMatchGoal,
+			setup: func(wr *mocks.MockWebhookRepository, dr *mocks.MockWebhookDeliveryRepository) {
+				wr.EXPECT().FindActive().Return([]model.Webhook{completedHook, teamHook}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "db error",
+			eventType: FeedMatchCompleted,
+			setup: func(wr *mocks.MockWebhookRepository, dr *mocks.MockWebhookDeliveryRepository) {
+				wr.EXPECT().FindActive().Return(nil, gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, webhookRepo, deliveryRepo := newTestWebhookService(t)
+			tt.setup(webhookRepo, deliveryRepo)
+
+			err := svc.Enqueue(context.Background(), events.Event{ID: 1, Type: tt.eventType, Data: map[string]string{"id": "x"}, Time: time.Now()})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookService_DispatchDue(t *testing.T) {
+	const payload = `{"id":"evt","type":"match.completed","created_at":"2025-01-15T10:30:00Z","data":{}}`
+
+	tests := []struct {
+		name         string
+		status       int
+		attempts     int
+		deleted      bool
+		inactive     bool
+		wantStatus   string
+		wantAttempts int
+		wantRetry    bool
+	}{
+		{name: "2xx succeeds", status: http.StatusNoContent, wantStatus: model.DeliveryStatusSucceeded, wantAttempts: 1},
+		{name: "5xx is retried", status: http.StatusBadGateway, wantStatus: model.DeliveryStatusPending, wantAttempts: 1, wantRetry: true},
+		{name: "redirect is not followed", status: http.StatusFound, wantStatus: model.DeliveryStatusPending, wantAttempts: 1, wantRetry: true},
+		{name: "last attempt fails", status: http.StatusInternalServerError, attempts: 2, wantStatus: model.DeliveryStatusFailed, wantAttempts: 3},
+		{name: "deleted webhook fails", deleted: true, wantStatus: model.DeliveryStatusFailed},
+		{name: "inactive webhook fails", inactive: true, wantStatus: model.DeliveryStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			svc, webhookRepo, deliveryRepo := newTestWebhookService(t)
+			webhook := &model.Webhook{
+				Base:   model.Base{ID: uuid.Must(uuid.NewV7())},
+				URL:    server.URL,
+				Events: FeedMatchCompleted,
+				Secret: "whsec_test",
+				Active: !tt.inactive,
+			}
+			delivery := model.WebhookDelivery{
+				Base:      model.Base{ID: uuid.Must(uuid.NewV7())},
+				WebhookID: webhook.ID,
+				EventType: FeedMatchCompleted,
+				Payload:   payload,
+				Status:    model.DeliveryStatusPending,
+				Attempts:  tt.attempts,
+			}
+
+			deliveryRepo.EXPECT().ClaimDue(mock.Anything, mock.Anything, webhookBatchSize).Return([]model.WebhookDelivery{delivery}, nil)
+			if tt.deleted {
+				webhookRepo.EXPECT().FindByID(webhook.ID).Return(nil, gorm.ErrRecordNotFound)
+			} else {
+				webhookRepo.EXPECT().FindByID(webhook.ID).Return(webhook, nil)
+			}
+			var saved *model.WebhookDelivery
+			deliveryRepo.EXPECT().Update(mock.AnythingOfType("*model.WebhookDelivery")).
+				Run(func(d *model.WebhookDelivery) { saved = d }).Return(nil)
+
+			n, err := svc.DispatchDue(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, tt.wantStatus, saved.Status)
+			assert.Equal(t, tt.wantAttempts, saved.Attempts)
+			assert.Equal(t, tt.wantRetry, saved.NextAttemptAt != nil)
+			if tt.deleted || tt.inactive {
+				assert.Nil(t, received)
+				assert.NotEmpty(t, saved.LastError)
+				return
+			}
+
+			assert.Equal(t, tt.status, saved.LastStatusCode)
+			assert.Equal(t, payload, string(body))
+			assert.Equal(t, FeedMatchCompleted, received.Header.Get(HeaderWebhookEvent))
+			assert.Equal(t, delivery.ID.String(), received.Header.Get(HeaderWebhookDelivery))
+			timestamp := received.Header.Get(HeaderWebhookTimestamp)
+			assert.Equal(t, "sha256="+SignWebhookPayload("whsec_test", timestamp, []byte(payload)), received.Header.Get(HeaderWebhookSignature))
+		})
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 10, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, webhookRetryDelay(tt.attempts))
+	}
+}
@@ -0,0 +1,260 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/graph"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/handler"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/middleware"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/migrate"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/repository"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/router"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/service"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/errtrack"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/events"
+	jwtpkg "github.com/mhakimsaputra17/xyz-football-api/pkg/jwt"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/mailer"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/ratelimit"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/storage"
+	"gorm.io/gorm"
+)
+
+// App is the full API wired to a test database the way cmd/api wires it, without
+// rate limiting, caching, background jobs or outgoing notifications.
+type App struct {
+	DB     *gorm.DB
+	Router *gin.Engine
+	Bus    *events.Bus
+	// Uploads and Attachments keep uploaded player photos and match attachments in memory
+	Uploads     *storage.Memory
+	Attachments *storage.Memory
+	// Maintenance switches maintenance mode, which starts off
+	Maintenance service.MaintenanceService
+	// Spec, when set, makes Do fail the test on responses that do not match the documentation
+	Spec *Spec
+
+	jwt *jwtpkg.Service
+}
+
+// NewApp wires the API to db.
+func NewApp(t testing.TB, db *gorm.DB) *App {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	if err := handler.RegisterValidators(); err != nil {
+		t.Fatalf("register validators: %v", err)
+	}
+	loc := time.UTC
+
+	adminRepo := repository.NewAdminRepository(db)
+	teamRepo := repository.NewTeamRepository(db)
+	teamManagerRepo := repository.NewTeamManagerRepository(db)
+	playerRepo := repository.NewPlayerRepository(db)
+	coachRepo := repository.NewCoachRepository(db)
+	absenceRepo := repository.NewPlayerAbsenceRepository(db)
+	matchRepo := repository.NewMatchRepository(db)
+	eventRepo := repository.NewMatchEventRepository(db)
+	lineupRepo := repository.NewMatchLineupRepository(db)
+	statusChangeRepo := repository.NewMatchStatusChangeRepository(db)
+	snapshotRepo := repository.NewStandingSnapshotRepository(db)
+	ratingRepo := repository.NewTeamRatingRepository(db)
+	attachmentRepo := repository.NewMatchAttachmentRepository(db)
+	competitionRepo := repository.NewCompetitionRepository(db)
+	seasonRepo := repository.NewSeasonRepository(db)
+	groupRepo := repository.NewGroupRepository(db)
+	stadiumRepo := repository.NewStadiumRepository(db)
+	refereeRepo := repository.NewRefereeRepository(db)
+	officialRepo := repository.NewMatchOfficialRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(db)
+	passwordHistoryRepo := repository.NewPasswordHistoryRepository(db)
+	notificationPrefRepo := repository.NewNotificationPreferenceRepository(db)
+	confirmationRepo := repository.NewConfirmationTokenRepository(db)
+	healthRepo := repository.NewHealthRepository(db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
+	txManager := repository.NewTxManager(db)
+
+	app := &App{DB: db, Bus: events.NewBus(), Uploads: storage.NewMemory(), Attachments: storage.NewMemory()}
+	jwtService := jwtpkg.NewService("integration-test-secret-that-is-long-enough", 15*time.Minute, 24*time.Hour)
+	app.jwt = jwtService
+	passwordPolicy := service.PasswordPolicy{MinLength: 8}
+
+	authService := service.NewAuthService(adminRepo, refreshTokenRepo, loginAttemptRepo, jwtService, 5, 15*time.Minute, 0)
+	teamService := service.NewTeamService(teamRepo, playerRepo, matchRepo, stadiumRepo, txManager, nil, app.Bus)
+	coachService := service.NewCoachService(coachRepo, teamRepo, nil)
+	absenceService := service.NewAbsenceService(absenceRepo, playerRepo)
+	playerService := service.NewPlayerService(playerRepo, teamRepo, teamManagerRepo, txManager, service.RosterRules{}, nil, app.Bus, app.Uploads)
+	disciplinaryService := service.NewDisciplinaryService(matchRepo, eventRepo, playerRepo, 5)
+	matchService := service.NewMatchService(matchRepo, teamRepo, playerRepo, seasonRepo, stadiumRepo, teamManagerRepo, statusChangeRepo, lineupRepo, disciplinaryService, txManager, 0, loc, time.Hour, nil, app.Bus)
+	lineupService := service.NewLineupService(matchRepo, playerRepo, lineupRepo, absenceRepo, disciplinaryService, txManager, loc)
+	timelineService := service.NewTimelineService(matchRepo, statusChangeRepo)
+	attachmentService := service.NewAttachmentService(matchRepo, attachmentRepo, app.Attachments)
+	officialService := service.NewOfficialService(matchRepo, refereeRepo, officialRepo)
+	reportService := service.NewReportService(matchRepo, eventRepo, lineupRepo, officialRepo, loc)
+	standingsService := service.NewStandingsService(matchRepo, nil)
+	standingsHistoryService := service.NewStandingsHistoryService(standingsService, matchRepo, snapshotRepo, teamRepo)
+	ratingService := service.NewRatingService(matchRepo, ratingRepo, teamRepo, 20)
+	statsService := service.NewStatsService(playerRepo, statsRepo)
+	formService := service.NewFormService(teamRepo, matchRepo)
+	predictionService := service.NewPredictionService(matchRepo, service.NewFormAndHeadToHeadStrategy())
+	competitionService := service.NewCompetitionService(competitionRepo, seasonRepo, nil)
+	seasonService := service.NewSeasonService(seasonRepo, competitionRepo)
+	groupService := service.NewGroupService(groupRepo, seasonRepo, teamRepo, matchRepo)
+	stadiumService := service.NewStadiumService(stadiumRepo, nil)
+	refereeService := service.NewRefereeService(refereeRepo, officialRepo, loc)
+	confirmationService := service.NewConfirmationService(confirmationRepo, 5*time.Minute)
+	adminService := service.NewAdminService(adminRepo, refreshTokenRepo, loginAttemptRepo, teamManagerRepo, teamRepo, passwordHistoryRepo, passwordPolicy)
+	auditService := service.NewAuditService(auditLogRepo)
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+	webhookService := service.NewWebhookService(webhookRepo, webhookDeliveryRepo, 5*time.Second, 3)
+	notificationService := service.NewNotificationService(notificationPrefRepo, standingsService, mailer.Noop{}, nil, loc)
+	healthService := service.NewHealthService(healthRepo, migrate.Tables(), time.Now())
+	app.Maintenance = service.NewMaintenanceService(false, "")
+	graphService := service.NewGraphService(teamRepo, playerRepo, matchRepo, eventRepo, loc)
+	queryBinder := handler.NewQueryBinder(true)
+
+	graphSchema, err := graph.NewSchema(graph.Services{
+		Teams:     teamService,
+		Players:   playerService,
+		Matches:   matchService,
+		Reports:   reportService,
+		Standings: standingsService,
+		Graph:     graphService,
+	})
+	if err != nil {
+		t.Fatalf("build GraphQL schema: %v", err)
+	}
+
+	app.Router = router.Setup(
+		handler.NewDocsHandler("", "", ""),
+		errtrack.Nop{},
+		middleware.CORSPolicy{},
+		middleware.BodyPolicy{MaxBytes: 1 << 20, MaxUploadBytes: 10 << 20},
+		middleware.CompressionPolicy{},
+		jwtService,
+		confirmationService,
+		auditService,
+		apiKeyService,
+		app.Maintenance,
+		nil,
+		ratelimit.Rule{},
+		ratelimit.Rule{},
+		handler.NewAuthHandler(authService),
+		handler.NewTeamHandler(teamService, formService, queryBinder),
+		handler.NewPlayerHandler(playerService, statsService, queryBinder),
+		handler.NewCoachHandler(coachService, queryBinder),
+		handler.NewAbsenceHandler(absenceService, queryBinder),
+		handler.NewMatchHandler(matchService, lineupService, officialService, disciplinaryService, timelineService, attachmentService, predictionService, app.Bus, queryBinder),
+		handler.NewReportHandler(reportService, standingsService, standingsHistoryService, ratingService, "XYZ Football", []string{"Referee", "Match Commissioner"}, queryBinder),
+		handler.NewCompetitionHandler(competitionService, queryBinder),
+		handler.NewSeasonHandler(seasonService, groupService, queryBinder),
+		handler.NewStadiumHandler(stadiumService, queryBinder),
+		handler.NewRefereeHandler(refereeService, queryBinder),
+		handler.NewConfirmationHandler(confirmationService),
+		handler.NewHealthHandler(healthService, app.Maintenance),
+		handler.NewAdminHandler(adminService, queryBinder),
+		handler.NewAuditLogHandler(auditService, queryBinder),
+		handler.NewAPIKeyHandler(apiKeyService, queryBinder),
+		handler.NewWebhookHandler(webhookService, queryBinder),
+		handler.NewNotificationHandler(notificationService),
+		handler.NewGraphQLHandler(graphSchema),
+		handler.NewMaintenanceHandler(app.Maintenance),
+	)
+	return app
+}
+
+// Response is a recorded API response.
+type Response struct {
+	Code   int
+	Header http.Header
+	Body   []byte
+}
+
+// Decode unmarshals the response envelope's data into v and fails the test if it cannot.
+func (r *Response) Decode(t testing.TB, v any) {
+	t.Helper()
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(r.Body, &envelope); err != nil {
+		t.Fatalf("decode response %s: %v", r.Body, err)
+	}
+	if err := json.Unmarshal(envelope.Data, v); err != nil {
+		t.Fatalf("decode response data %s: %v", envelope.Data, err)
+	}
+}
+
+// Do sends a request to the API with body encoded as JSON (unless nil) and token as the
+// bearer token (unless empty), and returns the response.
+func (a *App) Do(t testing.TB, method, path, token string, body any) *Response {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("encode request body: %v", err)
+		}
+		reader = bytes.NewReader(payload)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return a.Send(t, req)
+}
+
+// Send serves req, for requests Do cannot build such as those with extra headers.
+// Like Do, it validates the response against Spec when set.
+func (a *App) Send(t testing.TB, req *http.Request) *Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	a.Router.ServeHTTP(rec, req)
+	resp := &Response{Code: rec.Code, Header: rec.Header(), Body: rec.Body.Bytes()}
+	if a.Spec != nil {
+		if err := a.Spec.ValidateResponse(req.Method, req.URL.Path, resp); err != nil {
+			t.Errorf("contract violation: %v", err)
+		}
+	}
+	return resp
+}
+
+// Token returns an access token for an admin with the given role without logging in,
+// so the admin need not exist in the database.
+func (a *App) Token(t testing.TB, role string) string {
+	t.Helper()
+	token, err := a.jwt.GenerateAccessToken(uuid.Must(uuid.NewV7()), "test-"+role, role, false)
+	if err != nil {
+		t.Fatalf("generate access token: %v", err)
+	}
+	return token
+}
+
+// Login logs in through the API and returns the access token.
+func (a *App) Login(t testing.TB, username, password string) string {
+	t.Helper()
+	resp := a.Do(t, http.MethodPost, "/api/v1/auth/login", "", map[string]string{"username": username, "password": password})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("login as %s: status %d: %s", username, resp.Code, resp.Body)
+	}
+	var tokens struct {
+		AccessToken string `json:"access_token"`
+	}
+	resp.Decode(t, &tokens)
+	return tokens.AccessToken
+}
@@ -0,0 +1,105 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mhakimsaputra17/xyz-football-api/internal/model"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// Fixtures inserts test data straight into the database, bypassing the service layer.
+type Fixtures struct {
+	t  testing.TB
+	db *gorm.DB
+	n  int // Counter for unique names
+}
+
+// NewFixtures returns fixtures that write to db and fail t on errors.
+func NewFixtures(t testing.TB, db *gorm.DB) *Fixtures {
+	return &Fixtures{t: t, db: db}
+}
+
+// create inserts value or fails the test.
+func (f *Fixtures) create(value any) {
+	f.t.Helper()
+	if err := f.db.Create(value).Error; err != nil {
+		f.t.Fatalf("create fixture %T: %v", value, err)
+	}
+}
+
+// Admin creates an admin account with the given role that can log in with password.
+func (f *Fixtures) Admin(username, password, role string) *model.Admin {
+	f.t.Helper()
+	// The minimum cost keeps logins fast; the cost does not matter for tests
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		f.t.Fatalf("hash password: %v", err)
+	}
+	admin := &model.Admin{Username: username, Password: string(hash), Role: role}
+	f.create(admin)
+	return admin
+}
+
+// Team creates a team with the given name.
+func (f *Fixtures) Team(name string) *model.Team {
+	f.t.Helper()
+	team := &model.Team{Name: name, City: "Jakarta", FoundedYear: 1928}
+	f.create(team)
+	return team
+}
+
+// Player creates a player of the team with the given jersey number.
+func (f *Fixtures) Player(team *model.Team, jerseyNumber int) *model.Player {
+	f.t.Helper()
+	f.n++
+	player := &model.Player{
+		TeamID:       team.ID,
+		Name:         fmt.Sprintf("Player %d", f.n),
+		Height:       175,
+		Weight:       70,
+		Position:     "gelandang",
+		JerseyNumber: jerseyNumber,
+	}
+	f.create(player)
+	return player
+}
+
+// Season creates a season of a new competition running from start to end (YYYY-MM-DD).
+func (f *Fixtures) Season(name, start, end string) *model.Season {
+	f.t.Helper()
+	f.n++
+	competition := &model.Competition{Name: fmt.Sprintf("Competition %d", f.n), Country: "Indonesia"}
+	f.create(competition)
+	season := &model.Season{CompetitionID: competition.ID, Name: name, StartDate: start, EndDate: end}
+	f.create(season)
+	return season
+}
+
+// Match creates a scheduled match between the teams kicking off at kickoff.
+func (f *Fixtures) Match(home, away *model.Team, kickoff time.Time) *model.Match {
+	f.t.Helper()
+	match := &model.Match{HomeTeamID: home.ID, AwayTeamID: away.ID, MatchDatetime: kickoff, Status: model.MatchStatusScheduled}
+	f.create(match)
+	return match
+}
+
+// CompletedMatch creates a completed match with the given score, optionally in a season.
+// It has no events, so the score is not backed by goals.
+func (f *Fixtures) CompletedMatch(home, away *model.Team, homeScore, awayScore int, seasonID *uuid.UUID, kickoff time.Time) *model.Match {
+	f.t.Helper()
+	match := &model.Match{
+		HomeTeamID:    home.ID,
+		AwayTeamID:    away.ID,
+		SeasonID:      seasonID,
+		MatchDatetime: kickoff,
+		HomeScore:     homeScore,
+		AwayScore:     awayScore,
+		Status:        model.MatchStatusCompleted,
+	}
+	f.create(match)
+	return match
+}
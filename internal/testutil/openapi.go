@@ -0,0 +1,334 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/swaggo/swag"
+)
+
+// Spec is the OpenAPI (Swagger 2.0) document of the API, generated from the handlers' swag
+// annotations the way `swag init` generates docs/. Contract tests compare the router's real
+// behavior against it, so annotations that drift from the code fail the tests.
+type Spec struct {
+	BasePath    string                          `json:"basePath"`
+	Paths       map[string]map[string]operation `json:"paths"` // Path template → lower-case method
+	Definitions map[string]*schema              `json:"definitions"`
+}
+
+type operation struct {
+	Security  []map[string][]string `json:"security"`
+	Responses map[string]struct {
+		Schema *schema `json:"schema"`
+	} `json:"responses"`
+}
+
+// schema is the subset of JSON Schema that swag generates.
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	AllOf                []*schema          `json:"allOf"`
+	Required             []string           `json:"required"`
+	Enum                 []any              `json:"enum"`
+}
+
+var (
+	specOnce sync.Once
+	spec     *Spec
+	specErr  error
+)
+
+// LoadSpec generates the spec from the annotations in the source tree, once per test binary.
+func LoadSpec(t testing.TB) *Spec {
+	t.Helper()
+	specOnce.Do(func() { spec, specErr = generateSpec() })
+	if specErr != nil {
+		t.Fatalf("generate OpenAPI spec: %v", specErr)
+	}
+	return spec
+}
+
+// generateSpec parses the annotations like `swag init -g cmd/api/main.go --parseInternal`.
+func generateSpec() (*Spec, error) {
+	root, err := moduleRoot()
+	if err != nil {
+		return nil, err
+	}
+	parser := swag.New(swag.SetDebugger(discardDebugger{}))
+	parser.ParseInternal = true
+	if err := parser.ParseAPI(root, "cmd/api/main.go", 100); err != nil {
+		return nil, err
+	}
+
+	// The JSON round trip reduces the parser's output to the fields validation needs
+	raw, err := json.Marshal(parser.GetSwagger())
+	if err != nil {
+		return nil, err
+	}
+	var s Spec
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// moduleRoot returns the directory of go.mod above the working directory, which go test sets to the package's.
+func moduleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+type discardDebugger struct{}
+
+func (discardDebugger) Printf(string, ...any) {}
+
+// Operation identifies a documented endpoint by method and full path template,
+// e.g. "GET /api/v1/teams/{id}".
+type Operation struct {
+	Method string
+	Path   string
+	Secure bool // Requires a bearer token or an API key
+}
+
+func (o Operation) String() string { return o.Method + " " + o.Path }
+
+// Operations lists every documented endpoint, sorted by path and method.
+func (s *Spec) Operations() []Operation {
+	var ops []Operation
+	for path, methods := range s.Paths {
+		for method, op := range methods {
+			ops = append(ops, Operation{Method: strings.ToUpper(method), Path: s.BasePath + path, Secure: len(op.Security) > 0})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Path != ops[j].Path {
+			return ops[i].Path < ops[j].Path
+		}
+		return ops[i].Method < ops[j].Method
+	})
+	return ops
+}
+
+// ValidateResponse checks a response to method and path (the request path, without query)
+// against the documented operation: the status code must be documented, and JSON bodies must
+// match the documented schema, without undocumented fields. Other bodies are not checked.
+func (s *Spec) ValidateResponse(method, path string, resp *Response) error {
+	template, op, ok := s.find(method, path)
+	if !ok {
+		return fmt.Errorf("%s %s is not documented", method, path)
+	}
+	documented, ok := op.Responses[strconv.Itoa(resp.Code)]
+	if !ok {
+		documented, ok = op.Responses["default"]
+	}
+	if !ok {
+		return fmt.Errorf("%s %s: status %d is not documented", method, template, resp.Code)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if documented.Schema == nil || len(resp.Body) == 0 || mediaType != "application/json" {
+		return nil
+	}
+	var body any
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return fmt.Errorf("%s %s: status %d: invalid JSON: %v", method, template, resp.Code, err)
+	}
+	if problems := s.validate(documented.Schema, body, "body"); len(problems) > 0 {
+		return fmt.Errorf("%s %s: status %d does not match the documented schema:\n\t%s",
+			method, template, resp.Code, strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+// find returns the operation documented for a request path. Static segments win over
+// parameters, so /matches/calendar.ics is not taken for /matches/{id}. Paths outside the
+// base path, such as /.well-known/jwks.json, are looked up as they are.
+func (s *Spec) find(method, path string) (string, operation, bool) {
+	path = strings.TrimPrefix(path, s.BasePath)
+	segments := strings.Split(path, "/")
+	best, bestParams := "", -1
+	for template := range s.Paths {
+		params, ok := matchTemplate(strings.Split(template, "/"), segments)
+		if ok && (bestParams < 0 || params < bestParams) {
+			best, bestParams = template, params
+		}
+	}
+	if bestParams < 0 {
+		return "", operation{}, false
+	}
+	op, ok := s.Paths[best][strings.ToLower(method)]
+	return s.BasePath + best, op, ok
+}
+
+// matchTemplate reports whether path segments match template segments and how many parameters matched.
+func matchTemplate(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+	params := 0
+	for i, part := range template {
+		switch {
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			params++
+		case part != segments[i]:
+			return 0, false
+		}
+	}
+	return params, true
+}
+
+// resolve follows $ref and merges allOf into a single schema; later parts override the
+// properties of earlier ones, as with the envelope and its typed data.
+func (s *Spec) resolve(sc *schema) *schema {
+	for sc.Ref != "" {
+		def, ok := s.Definitions[strings.TrimPrefix(sc.Ref, "#/definitions/")]
+		if !ok {
+			return &schema{}
+		}
+		sc = def
+	}
+	if len(sc.AllOf) == 0 {
+		return sc
+	}
+	merged := &schema{Type: "object", Properties: map[string]*schema{}}
+	for _, part := range sc.AllOf {
+		part = s.resolve(part)
+		for name, prop := range part.Properties {
+			merged.Properties[name] = prop
+		}
+		merged.Required = append(merged.Required, part.Required...)
+	}
+	return merged
+}
+
+// validate returns the differences between a decoded JSON value and its schema, prefixed with path.
+// null is accepted for any type, as nil slices and pointers encode to it and Swagger 2.0 cannot say
+// which fields are nullable.
+func (s *Spec) validate(sc *schema, value any, path string) []string {
+	sc = s.resolve(sc)
+	if value == nil {
+		return nil
+	}
+	typ := sc.Type
+	if typ == "" && sc.Properties != nil {
+		typ = "object"
+	}
+
+	mismatch := func() []string {
+		return []string{fmt.Sprintf("%s: got %s, documented as %s", path, jsonType(value), typ)}
+	}
+	switch typ {
+	case "":
+		return nil // Undocumented shape, e.g. an interface{} field
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return mismatch()
+		}
+		return s.validateObject(sc, obj, path)
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return mismatch()
+		}
+		if sc.Items == nil {
+			return nil
+		}
+		var problems []string
+		for i, item := range items {
+			problems = append(problems, s.validate(sc.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return problems
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		if len(sc.Enum) > 0 && !slices.Contains(sc.Enum, any(str)) {
+			return []string{fmt.Sprintf("%s: %q is not one of the documented values %v", path, str, sc.Enum)}
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != float64(int64(n)) {
+			return mismatch()
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return mismatch()
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return mismatch()
+		}
+	}
+	return nil
+}
+
+// validateObject checks the fields of a JSON object. Objects without documented properties
+// (maps and free-form objects) accept any fields.
+func (s *Spec) validateObject(sc *schema, obj map[string]any, path string) []string {
+	var problems []string
+	for _, name := range sc.Required {
+		if _, ok := obj[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s.%s: required field is missing", path, name))
+		}
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fieldPath := path + "." + name
+		switch prop, ok := sc.Properties[name]; {
+		case ok:
+			problems = append(problems, s.validate(prop, obj[name], fieldPath)...)
+		case sc.AdditionalProperties != nil:
+			problems = append(problems, s.validate(sc.AdditionalProperties, obj[name], fieldPath)...)
+		case len(sc.Properties) > 0:
+			problems = append(problems, fieldPath+": field is not documented")
+		}
+	}
+	return problems
+}
+
+// jsonType names the JSON type of a decoded value for error messages.
+func jsonType(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return "null"
+}
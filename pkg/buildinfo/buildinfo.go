@@ -0,0 +1,59 @@
+// Package buildinfo describes the running build: its version, commit and build time.
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Build metadata. Override at build time with:
+//
+//	go build -ldflags "-X github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo.Version=v1.2.3 \
+//		-X github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo.Commit=$(git rev-parse HEAD) \
+//		-X github.com/mhakimsaputra17/xyz-football-api/pkg/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildTime = ""
+)
+
+// Info describes the running build.
+type Info struct {
+	Version   string `json:"version" example:"v1.2.3"`
+	Commit    string `json:"commit" example:"4f2a9c1"`
+	BuildTime string `json:"build_time" example:"2025-01-15T10:30:00Z"`
+	GoVersion string `json:"go_version" example:"go1.25.3"`
+}
+
+// Get returns the build metadata, falling back to VCS information embedded
+// by the Go toolchain when ldflags were not provided.
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			}
+		}
+	}
+
+	return info
+}
+
+// LogAttrs returns the build metadata as slog key-value pairs.
+func (i Info) LogAttrs() []any {
+	return []any{"version", i.Version, "commit", i.Commit, "build_time", i.BuildTime, "go_version", i.GoVersion}
+}
@@ -0,0 +1,19 @@
+// Package cache provides a byte-oriented key/value cache with TTLs, backed by process memory or Redis.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache stores values under string keys. Keys sharing a prefix can be invalidated together.
+type Cache interface {
+	// Get returns the value stored under key; ok is false on a miss.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// DeletePrefix removes every key starting with prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+	// Ping reports whether the backend is reachable.
+	Ping(ctx context.Context) error
+}
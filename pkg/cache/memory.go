@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often expired entries are dropped from memory.
+const sweepInterval = time.Minute
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// Memory keeps entries in process memory. Each instance has its own cache,
+// so invalidations are not seen by other instances; use Redis when running several.
+type Memory struct {
+	mu        sync.RWMutex
+	entries   map[string]entry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemory creates a new in-memory cache.
+func NewMemory() *Memory {
+	return &Memory{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored under key if it has not expired.
+func (m *Memory) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.RLock()
+	e, ok := m.entries[key]
+	m.mu.RUnlock()
+
+	if !ok || !m.now().Before(e.expiresAt) {
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set stores value under key for ttl.
+func (m *Memory) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+	m.entries[key] = entry{value: value, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// DeletePrefix removes every key starting with prefix.
+func (m *Memory) DeletePrefix(_ context.Context, prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.entries, key)
+		}
+	}
+	return nil
+}
+
+// Ping always succeeds.
+func (m *Memory) Ping(context.Context) error {
+	return nil
+}
+
+// sweep drops expired entries so keys that are never read again do not accumulate.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, e := range m.entries {
+		if !now.Before(e.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+)
+
+// scanBatchSize is the COUNT hint used when scanning keys to delete.
+const scanBatchSize = "200"
+
+// Redis stores entries in Redis so every instance shares the cache and its invalidations.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedis creates a cache storing its keys under the given prefix.
+func NewRedis(client *redis.Client, prefix string) *Redis {
+	return &Redis{client: client, prefix: prefix}
+}
+
+// Get returns the value stored under key.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.client.Do(ctx, "GET", r.prefix+key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	value, ok := reply.(string)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected redis reply %v", reply)
+	}
+	return []byte(value), true, nil
+}
+
+// Set stores value under key for ttl.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := r.client.Do(ctx, "SET", r.prefix+key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// DeletePrefix removes every key starting with prefix, scanning in batches so Redis is never blocked.
+func (r *Redis) DeletePrefix(ctx context.Context, prefix string) error {
+	pattern := escapeGlob(r.prefix+prefix) + "*"
+	cursor := "0"
+	for {
+		reply, err := r.client.Do(ctx, "SCAN", cursor, "MATCH", pattern, "COUNT", scanBatchSize)
+		if err != nil {
+			return err
+		}
+		page, ok := reply.([]any)
+		if !ok || len(page) != 2 {
+			return fmt.Errorf("unexpected redis reply %v", reply)
+		}
+		cursor, _ = page[0].(string)
+		keys, _ := page[1].([]any)
+
+		if len(keys) > 0 {
+			args := make([]string, 0, len(keys)+1)
+			args = append(args, "DEL")
+			for _, key := range keys {
+				if s, ok := key.(string); ok {
+					args = append(args, s)
+				}
+			}
+			if _, err := r.client.Do(ctx, args...); err != nil {
+				return err
+			}
+		}
+		if cursor == "0" || cursor == "" {
+			return nil
+		}
+	}
+}
+
+// Ping checks that Redis is reachable.
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx)
+}
+
+// escapeGlob escapes the characters SCAN MATCH treats as patterns.
+func escapeGlob(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`).Replace(s)
+}
@@ -0,0 +1,49 @@
+// Package chat posts plain-text messages to team chat tools such as Slack and Telegram.
+package chat
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// postTimeout bounds one request to a chat API.
+const postTimeout = 10 * time.Second
+
+// Poster posts messages to one chat channel.
+type Poster interface {
+	// Name identifies the channel in logs, e.g. "slack".
+	Name() string
+	// Post sends text. It returns once the chat API accepted the message.
+	Post(ctx context.Context, text string) error
+}
+
+// Memory is an in-memory Poster that keeps every message it is given, for tests
+// and local development.
+type Memory struct {
+	mu     sync.Mutex
+	posted []string
+}
+
+// NewMemory creates an empty in-memory poster.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Name returns "memory".
+func (m *Memory) Name() string { return "memory" }
+
+// Post stores the message.
+func (m *Memory) Post(_ context.Context, text string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.posted = append(m.posted, text)
+	return nil
+}
+
+// Posted returns the messages posted so far, oldest first.
+func (m *Memory) Posted() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.posted...)
+}
@@ -0,0 +1,56 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Slack posts to a channel through a Slack incoming webhook.
+type Slack struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlack creates a poster for an incoming webhook URL, e.g. https://hooks.slack.com/services/T000/B000/XXXX.
+func NewSlack(webhookURL string) (*Slack, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("slack webhook url must be an https:// url")
+	}
+	return &Slack{webhookURL: webhookURL, client: &http.Client{Timeout: postTimeout}}, nil
+}
+
+// Name returns "slack".
+func (s *Slack) Name() string { return "slack" }
+
+// Post sends text as a message. Slack renders *bold* and _italic_ markup in it.
+func (s *Slack) Post(ctx context.Context, text string) error {
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		// Slack explains the failure in a short plain-text body, e.g. "invalid_token"
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 256))
+		return fmt.Errorf("slack responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// telegramAPI is the base URL of the Telegram Bot API.
+const telegramAPI = "https://api.telegram.org"
+
+// Telegram posts to a chat, group or channel as a Telegram bot.
+type Telegram struct {
+	endpoint string
+	chatID   string
+	client   *http.Client
+}
+
+// NewTelegram creates a poster for the bot with token (from @BotFather) that sends to chatID,
+// a numeric chat ID or a channel's @username. The bot must be a member of the chat.
+func NewTelegram(token, chatID string) (*Telegram, error) {
+	if token == "" || strings.ContainsAny(token, "/?# ") {
+		return nil, errors.New("invalid telegram bot token")
+	}
+	if chatID == "" {
+		return nil, errors.New("telegram chat id is required")
+	}
+	return &Telegram{
+		endpoint: telegramAPI + "/bot" + token + "/sendMessage",
+		chatID:   chatID,
+		client:   &http.Client{Timeout: postTimeout},
+	}, nil
+}
+
+// Name returns "telegram".
+func (t *Telegram) Name() string { return "telegram" }
+
+// Post sends text as a plain-text message without link previews.
+func (t *Telegram) Post(ctx context.Context, text string) error {
+	payload, err := json.Marshal(map[string]any{
+		"chat_id":                  t.chatID,
+		"text":                     text,
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// Drop the URL from the error; it contains the bot token
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telegram: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil || !result.OK {
+		return fmt.Errorf("telegram responded with status %d: %s", resp.StatusCode, result.Description)
+	}
+	return nil
+}
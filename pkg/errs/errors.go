@@ -3,11 +3,14 @@ package errs
 import "net/http"
 
 // AppError represents an application-level error with an HTTP status code.
-// Errors carry context about how they should be presented to the client.
+// Errors carry context about how they should be presented to the client: ErrorCode is a
+// stable machine-readable code such as TEAM_NOT_FOUND for clients to branch on, while
+// Message is for humans and may change.
 type AppError struct {
-	Code    int          `json:"-"`
-	Message string       `json:"message"`
-	Errors  []FieldError `json:"errors,omitempty"`
+	Code      int          `json:"-"`
+	ErrorCode string       `json:"code"`
+	Message   string       `json:"message"`
+	Errors    []FieldError `json:"errors,omitempty"`
 }
 
 // FieldError represents a validation error on a specific field.
@@ -21,10 +24,16 @@ func (e *AppError) Error() string {
 }
 
 // New creates a new AppError with the given HTTP status code and message.
+// Its error code is the generic one of the status, e.g. NOT_FOUND for 404.
 func New(code int, message string) *AppError {
+	errorCode, ok := statusCodes[code]
+	if !ok {
+		errorCode = CodeError
+	}
 	return &AppError{
-		Code:    code,
-		Message: message,
+		Code:      code,
+		ErrorCode: errorCode,
+		Message:   message,
 	}
 }
 
@@ -34,6 +43,12 @@ func (e *AppError) WithFields(fields []FieldError) *AppError {
 	return e
 }
 
+// WithCode replaces the generic error code with a more specific one.
+func (e *AppError) WithCode(code string) *AppError {
+	e.ErrorCode = code
+	return e
+}
+
 // --- Predefined error constructors ---
 
 // ErrBadRequest returns a 400 error.
@@ -61,13 +76,38 @@ func ErrConflict(message string) *AppError {
 	return New(http.StatusConflict, message)
 }
 
+// ErrPayloadTooLarge returns a 413 error.
+func ErrPayloadTooLarge(message string) *AppError {
+	return New(http.StatusRequestEntityTooLarge, message)
+}
+
+// ErrUnsupportedMediaType returns a 415 error.
+func ErrUnsupportedMediaType(message string) *AppError {
+	return New(http.StatusUnsupportedMediaType, message)
+}
+
+// ErrLocked returns a 423 error.
+func ErrLocked(message string) *AppError {
+	return New(http.StatusLocked, message)
+}
+
+// ErrTooManyRequests returns a 429 error.
+func ErrTooManyRequests(message string) *AppError {
+	return New(http.StatusTooManyRequests, message)
+}
+
 // ErrInternal returns a 500 error.
 // The actual error detail should be logged server-side; only a generic message goes to the client.
 func ErrInternal(message string) *AppError {
 	return New(http.StatusInternalServerError, message)
 }
 
+// ErrServiceUnavailable returns a 503 error.
+func ErrServiceUnavailable(message string) *AppError {
+	return New(http.StatusServiceUnavailable, message)
+}
+
 // ErrValidation returns a 400 error with field-level details.
 func ErrValidation(fields []FieldError) *AppError {
-	return New(http.StatusBadRequest, "Validation failed").WithFields(fields)
+	return New(http.StatusBadRequest, "Validation failed").WithFields(fields).WithCode(CodeValidationFailed)
 }
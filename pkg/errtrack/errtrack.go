@@ -0,0 +1,30 @@
+// Package errtrack reports unexpected errors, such as recovered panics, to an
+// external error tracker so they are noticed without waiting for user reports.
+package errtrack
+
+import (
+	"context"
+	"net/http"
+)
+
+// Event is one error occurrence.
+type Event struct {
+	Err     error
+	Stack   []byte            // Goroutine stack trace, if captured
+	Request *http.Request     // Request being served when the error occurred, if any
+	Tags    map[string]string // Searchable key/value pairs, e.g. request_id
+	// Fingerprint decides which events the tracker groups into one issue; empty uses its default grouping.
+	Fingerprint []string
+}
+
+// Tracker sends error events to an error tracker.
+// Capture must not block the caller on network I/O.
+type Tracker interface {
+	Capture(ctx context.Context, event Event)
+}
+
+// Nop is a Tracker that discards every event, used when no tracker is configured.
+type Nop struct{}
+
+// Capture discards the event.
+func (Nop) Capture(context.Context, Event) {}
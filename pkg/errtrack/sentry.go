@@ -0,0 +1,192 @@
+package errtrack
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// sentryQueueSize bounds the events waiting to be sent; further events are dropped.
+const sentryQueueSize = 64
+
+// sentryTimeout bounds one request to the Sentry API.
+const sentryTimeout = 5 * time.Second
+
+// Sentry is a Tracker that sends events to Sentry through its envelope HTTP API.
+// Events are queued and sent by a single background goroutine, so Capture never
+// waits on the network; when Sentry is slow or down, events beyond the queue are dropped.
+type Sentry struct {
+	dsn         string
+	endpoint    string
+	auth        string
+	environment string
+	release     string
+	serverName  string
+	client      *http.Client
+	queue       chan sentryEvent
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Exception   sentryExceptions  `json:"exception"`
+	Request     *sentryRequest    `json:"request,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+	Fingerprint []string          `json:"fingerprint,omitempty"`
+}
+
+type sentryExceptions struct {
+	Values []sentryException `json:"values"`
+}
+
+type sentryException struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sentryRequest struct {
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	QueryString string `json:"query_string,omitempty"`
+}
+
+// ParseSentryDSN validates a DSN of the form https://<public key>@<host>/<project id>
+// and returns the envelope endpoint and the X-Sentry-Auth header value.
+func ParseSentryDSN(dsn string) (endpoint, auth string, err error) {
+	u, err := url.Parse(dsn)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return "", "", fmt.Errorf("sentry DSN must look like https://<key>@<host>/<project id>")
+	}
+	path := strings.TrimSuffix(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	project := path[slash+1:]
+	if project == "" {
+		return "", "", fmt.Errorf("sentry DSN has no project id")
+	}
+
+	endpoint = fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, path[:slash], project)
+	auth = fmt.Sprintf("Sentry sentry_version=7, sentry_client=xyz-football-api/1.0, sentry_key=%s", u.User.Username())
+	return endpoint, auth, nil
+}
+
+// NewSentry returns a Sentry tracker for dsn. Events are labelled with environment and release.
+func NewSentry(dsn, environment, release string) (*Sentry, error) {
+	endpoint, auth, err := ParseSentryDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	hostname, _ := os.Hostname()
+
+	s := &Sentry{
+		dsn:         dsn,
+		endpoint:    endpoint,
+		auth:        auth,
+		environment: environment,
+		release:     release,
+		serverName:  hostname,
+		client:      &http.Client{Timeout: sentryTimeout},
+		queue:       make(chan sentryEvent, sentryQueueSize),
+	}
+	go s.run()
+	return s, nil
+}
+
+// Capture queues event for sending.
+func (s *Sentry) Capture(ctx context.Context, event Event) {
+	e := sentryEvent{
+		EventID:     newEventID(),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339Nano),
+		Platform:    "go",
+		Level:       "error",
+		Environment: s.environment,
+		Release:     s.release,
+		ServerName:  s.serverName,
+		Tags:        event.Tags,
+		Fingerprint: event.Fingerprint,
+	}
+	if event.Err != nil {
+		e.Exception.Values = []sentryException{{Type: fmt.Sprintf("%T", event.Err), Value: event.Err.Error()}}
+	}
+	if len(event.Stack) > 0 {
+		e.Extra = map[string]string{"stack": string(event.Stack)}
+	}
+	if r := event.Request; r != nil {
+		e.Request = &sentryRequest{
+			Method:      r.Method,
+			URL:         "http://" + r.Host + r.URL.Path,
+			QueryString: r.URL.RawQuery,
+		}
+	}
+
+	select {
+	case s.queue <- e:
+	default:
+		slog.WarnContext(ctx, "error tracker queue full, dropping event", "event_id", e.EventID)
+	}
+}
+
+// run sends queued events one at a time.
+func (s *Sentry) run() {
+	for e := range s.queue {
+		if err := s.send(e); err != nil {
+			slog.Warn("failed to send event to sentry", "error", err, "event_id", e.EventID)
+		}
+	}
+}
+
+// send posts one event as an envelope: a header line, an item header line and the event.
+func (s *Sentry) send(e sentryEvent) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	header, _ := json.Marshal(map[string]string{"event_id": e.EventID, "dsn": s.dsn})
+	item, _ := json.Marshal(map[string]any{"type": "event", "length": len(payload)})
+
+	var body bytes.Buffer
+	body.Write(header)
+	body.WriteByte('\n')
+	body.Write(item)
+	body.WriteByte('\n')
+	body.Write(payload)
+	body.WriteByte('\n')
+
+	req, err := http.NewRequest(http.MethodPost, s.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sentry responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// newEventID returns a random 32-character hex ID as Sentry expects.
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,153 @@
+package errtrack
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSentryDSN(t *testing.T) {
+	tests := []struct {
+		name         string
+		dsn          string
+		wantEndpoint string
+		wantErr      bool
+	}{
+		{name: "sentry.io", dsn: "https://abc123@o1.ingest.sentry.io/42", wantEndpoint: "https://o1.ingest.sentry.io/api/42/envelope/"},
+		{name: "self-hosted under a path", dsn: "http://abc123@sentry.local:9000/sentry/7/", wantEndpoint: "http://sentry.local:9000/sentry/api/7/envelope/"},
+		{name: "missing key", dsn: "https://sentry.io/42", wantErr: true},
+		{name: "missing project", dsn: "https://abc123@sentry.io/", wantErr: true},
+		{name: "wrong scheme", dsn: "ftp://abc123@sentry.io/42", wantErr: true},
+		{name: "not a url", dsn: "::", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, auth, err := ParseSentryDSN(tt.dsn)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEndpoint, endpoint)
+			assert.Equal(t, "Sentry sentry_version=7, sentry_client=xyz-football-api/1.0, sentry_key=abc123", auth)
+		})
+	}
+}
+
+// envelope is a received envelope split into its three JSON lines.
+type envelope struct {
+	contentType, auth, path string
+	header                  map[string]string
+	item                    map[string]any
+	event                   map[string]any
+	eventLength             int
+}
+
+func TestSentry_Capture(t *testing.T) {
+	received := make(chan envelope, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var env envelope
+		env.contentType, env.auth, env.path = r.Header.Get("Content-Type"), r.Header.Get("X-Sentry-Auth"), r.URL.Path
+
+		lines := bufio.NewScanner(r.Body)
+		for i, target := range []any{&env.header, &env.item, &env.event} {
+			if !lines.Scan() {
+				t.Errorf("envelope has %d lines, want 3", i)
+				break
+			}
+			if i == 2 {
+				env.eventLength = len(lines.Bytes())
+			}
+			assert.NoError(t, json.Unmarshal(lines.Bytes(), target))
+		}
+		received <- env
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "http://", "http://abc123@", 1) + "/42"
+	s, err := NewSentry(dsn, "production", "v1.2.3")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/v1/teams?page=2", nil)
+	s.Capture(context.Background(), Event{
+		Err:         errors.New("boom"),
+		Stack:       []byte("goroutine 1 [running]"),
+		Request:     req,
+		Tags:        map[string]string{"request_id": "req-1"},
+		Fingerprint: []string{"panic", "/api/v1/teams"},
+	})
+
+	var env envelope
+	select {
+	case env = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no envelope received")
+	}
+
+	assert.Equal(t, "/api/42/envelope/", env.path)
+	assert.Equal(t, "application/x-sentry-envelope", env.contentType)
+	assert.Contains(t, env.auth, "sentry_key=abc123")
+
+	eventID := env.event["event_id"].(string)
+	assert.Len(t, eventID, 32)
+	assert.Equal(t, map[string]string{"event_id": eventID, "dsn": dsn}, env.header)
+	assert.Equal(t, "event", env.item["type"])
+	assert.Equal(t, float64(env.eventLength), env.item["length"])
+
+	assert.Equal(t, "error", env.event["level"])
+	assert.Equal(t, "go", env.event["platform"])
+	assert.Equal(t, "production", env.event["environment"])
+	assert.Equal(t, "v1.2.3", env.event["release"])
+	assert.Equal(t, map[string]any{"values": []any{map[string]any{"type": "*errors.errorString", "value": "boom"}}}, env.event["exception"])
+	assert.Equal(t, map[string]any{"method": "GET", "url": "http://api.example.com/api/v1/teams", "query_string": "page=2"}, env.event["request"])
+	assert.Equal(t, map[string]any{"request_id": "req-1"}, env.event["tags"])
+	assert.Equal(t, map[string]any{"stack": "goroutine 1 [running]"}, env.event["extra"])
+	assert.Equal(t, []any{"panic", "/api/v1/teams"}, env.event["fingerprint"])
+	_, err = time.Parse(time.RFC3339Nano, env.event["timestamp"].(string))
+	assert.NoError(t, err)
+}
+
+func TestSentry_SendRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	endpoint, auth, err := ParseSentryDSN(strings.Replace(server.URL, "http://", "http://abc123@", 1) + "/42")
+	require.NoError(t, err)
+	s := &Sentry{endpoint: endpoint, auth: auth, client: server.Client()}
+
+	assert.EqualError(t, s.send(sentryEvent{EventID: newEventID()}), "sentry responded with status 429")
+}
+
+func TestSentry_CaptureDropsWhenQueueFull(t *testing.T) {
+	// No sender is running, so the queue never drains
+	s := &Sentry{queue: make(chan sentryEvent, 1)}
+
+	done := make(chan struct{})
+	go func() {
+		s.Capture(context.Background(), Event{Err: errors.New("first")})
+		s.Capture(context.Background(), Event{Err: errors.New("second")})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Capture blocked on a full queue")
+	}
+	assert.Len(t, s.queue, 1)
+	assert.Equal(t, "first", (<-s.queue).Exception.Values[0].Value)
+}
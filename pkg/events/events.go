@@ -0,0 +1,88 @@
+// Package events is an in-process publish/subscribe bus for live updates.
+// Services publish once; every streaming transport (Server-Sent Events, WebSocket, ...)
+// subscribes to the same bus. Events are not persisted and only reach subscribers of this instance.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Event is a single published update. ID increases with every event on a bus,
+// so clients can tell whether they missed any.
+type Event struct {
+	ID   uint64
+	Type string
+	Data any
+	Time time.Time
+}
+
+// Bus fans published events out to all current subscribers. A nil *Bus discards everything.
+type Bus struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	lastID uint64
+}
+
+// NewBus creates an empty event bus.
+func NewBus() *Bus {
+	return &Bus{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscription receives events published after it was created.
+type Subscription struct {
+	bus *Bus
+	ch  chan Event
+}
+
+// C returns the channel events are delivered on. It is closed when the subscription
+// is closed, or dropped because the subscriber could not keep up.
+func (s *Subscription) C() <-chan Event {
+	return s.ch
+}
+
+// Close stops delivery and releases the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.remove(s)
+}
+
+// Subscribe registers a subscriber whose channel buffers up to buffer events.
+func (b *Bus) Subscribe(buffer int) *Subscription {
+	s := &Subscription{bus: b, ch: make(chan Event, buffer)}
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+	return s
+}
+
+// Publish delivers an event to every subscriber without blocking. A subscriber whose
+// buffer is full is dropped (its channel closed) rather than stalling the publisher;
+// streaming clients reconnect and reload current state.
+func (b *Bus) Publish(eventType string, data any) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	event := Event{ID: b.lastID, Type: eventType, Data: data, Time: time.Now()}
+	for s := range b.subs {
+		select {
+		case s.ch <- event:
+		default:
+			b.remove(s)
+		}
+	}
+}
+
+// remove unregisters s and closes its channel. Callers must hold b.mu.
+func (b *Bus) remove(s *Subscription) {
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		close(s.ch)
+	}
+}
@@ -0,0 +1,222 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsTimeout bounds dialing and each publish round trip.
+const natsTimeout = 5 * time.Second
+
+// NATSPublisher publishes to a NATS server with the core (non-JetStream) text protocol,
+// without an external dependency. Every publish is followed by a PING, so it only returns
+// once the server has processed the message. Plain TCP only; TLS is not supported.
+type NATSPublisher struct {
+	addr  string
+	name  string
+	user  string
+	pass  string
+	token string
+
+	mu         sync.Mutex
+	conn       net.Conn
+	r          *bufio.Reader
+	maxPayload int
+}
+
+// NewNATSPublisher creates a publisher for a nats://[user:password@|token@]host[:port] URL.
+// name identifies the connection on the server. The connection is opened lazily on first publish.
+func NewNATSPublisher(rawURL, name string) (*NATSPublisher, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "nats" || u.Host == "" {
+		return nil, fmt.Errorf("invalid nats url, expected nats://[user:password@]host:port")
+	}
+
+	p := &NATSPublisher{addr: u.Host, name: name}
+	if u.Port() == "" {
+		p.addr = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	if u.User != nil {
+		if pass, ok := u.User.Password(); ok {
+			p.user, p.pass = u.User.Username(), pass
+		} else {
+			p.token = u.User.Username()
+		}
+	}
+	return p, nil
+}
+
+// Publish sends payload on the subject topic and waits for the server to acknowledge it.
+// A broken connection is re-established once before giving up.
+func (p *NATSPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	if topic == "" || strings.ContainsAny(topic, " \t\r\n*>") {
+		return fmt.Errorf("nats: invalid subject %q", topic)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if p.conn == nil {
+			if err = p.connect(ctx); err != nil {
+				return err
+			}
+		}
+		if p.maxPayload > 0 && len(payload) > p.maxPayload {
+			return fmt.Errorf("nats: payload of %d bytes exceeds the server limit of %d", len(payload), p.maxPayload)
+		}
+
+		if err = p.publish(ctx, topic, payload); err == nil {
+			return nil
+		}
+		p.disconnect()
+
+		var serverErr natsError
+		if errors.As(err, &serverErr) {
+			return err
+		}
+	}
+	return err
+}
+
+// Close closes the connection, if one is open.
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.disconnect()
+	return nil
+}
+
+// natsError is an -ERR sent by the server. The server closes the connection after most of them.
+type natsError string
+
+func (e natsError) Error() string {
+	return "nats: " + string(e)
+}
+
+// natsInfo is the subset of the server's INFO message the publisher needs.
+type natsInfo struct {
+	MaxPayload  int  `json:"max_payload"`
+	TLSRequired bool `json:"tls_required"`
+}
+
+// connect dials the server, reads its INFO, sends CONNECT and waits for the PONG
+// that confirms the connection was accepted.
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: natsTimeout}
+	nc, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return fmt.Errorf("connect to nats: %w", err)
+	}
+	p.conn, p.r = nc, bufio.NewReader(nc)
+	if err := p.setDeadline(ctx); err != nil {
+		p.disconnect()
+		return err
+	}
+
+	line, err := p.readLine()
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		p.disconnect()
+		return fmt.Errorf("nats: expected INFO from server: %v", err)
+	}
+	var info natsInfo
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		p.disconnect()
+		return fmt.Errorf("nats: invalid INFO: %w", err)
+	}
+	if info.TLSRequired {
+		p.disconnect()
+		return errors.New("nats: server requires TLS, which is not supported")
+	}
+	p.maxPayload = info.MaxPayload
+
+	options, _ := json.Marshal(map[string]any{
+		"verbose":    false,
+		"pedantic":   false,
+		"lang":       "go",
+		"name":       p.name,
+		"user":       p.user,
+		"pass":       p.pass,
+		"auth_token": p.token,
+	})
+	if _, err := fmt.Fprintf(p.conn, "CONNECT %s\r\nPING\r\n", options); err != nil {
+		p.disconnect()
+		return err
+	}
+	if err := p.waitPong(); err != nil {
+		p.disconnect()
+		return fmt.Errorf("nats connect: %w", err)
+	}
+	return nil
+}
+
+// publish writes PUB followed by PING in one write and waits for the PONG.
+func (p *NATSPublisher) publish(ctx context.Context, topic string, payload []byte) error {
+	if err := p.setDeadline(ctx); err != nil {
+		return err
+	}
+
+	msg := make([]byte, 0, len(topic)+len(payload)+32)
+	msg = append(msg, "PUB "+topic+" "+strconv.Itoa(len(payload))+"\r\n"...)
+	msg = append(msg, payload...)
+	msg = append(msg, "\r\nPING\r\n"...)
+	if _, err := p.conn.Write(msg); err != nil {
+		return err
+	}
+	return p.waitPong()
+}
+
+// waitPong reads until the server answers our PING, answering the server's own PINGs on the way.
+func (p *NATSPublisher) waitPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := p.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return natsError(strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")), "'"))
+		default:
+			// +OK and updated INFO messages need no answer
+		}
+	}
+}
+
+func (p *NATSPublisher) readLine() (string, error) {
+	line, err := p.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (p *NATSPublisher) setDeadline(ctx context.Context) error {
+	deadline := time.Now().Add(natsTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	return p.conn.SetDeadline(deadline)
+}
+
+func (p *NATSPublisher) disconnect() {
+	if p.conn != nil {
+		p.conn.Close()
+		p.conn, p.r = nil, nil
+	}
+}
@@ -0,0 +1,284 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// natsSession is one client connection seen by fakeNATS.
+type natsSession struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func (s *natsSession) readLine() string {
+	line, err := s.r.ReadString('\n')
+	if err != nil {
+		return ""
+	}
+	assert.True(s.t, strings.HasSuffix(line, "\r\n"), "line %q ends with CRLF", line)
+	return strings.TrimSuffix(line, "\r\n")
+}
+
+func (s *natsSession) write(msg string) {
+	_, _ = s.conn.Write([]byte(msg))
+}
+
+// handshake sends INFO, then reads CONNECT and PING and answers PONG. It returns the CONNECT options.
+func (s *natsSession) handshake(info string) map[string]any {
+	s.write("INFO " + info + "\r\n")
+	connect := s.readLine()
+	var options map[string]any
+	assert.NoError(s.t, json.Unmarshal([]byte(strings.TrimPrefix(connect, "CONNECT ")), &options), connect)
+	assert.Equal(s.t, "PING", s.readLine())
+	s.write("PONG\r\n")
+	return options
+}
+
+// readPub reads a PUB frame and the PING following it, returning the subject and payload.
+// It runs on the server goroutine, so failures are reported with assert rather than require.
+func (s *natsSession) readPub() (string, string) {
+	line := s.readLine()
+	fields := strings.Fields(line)
+	if !assert.Len(s.t, fields, 3, line) || !assert.Equal(s.t, "PUB", fields[0]) {
+		return "", ""
+	}
+	size, err := strconv.Atoi(fields[2])
+	if !assert.NoError(s.t, err) {
+		return "", ""
+	}
+	payload := make([]byte, size+2)
+	if _, err := io.ReadFull(s.r, payload); !assert.NoError(s.t, err) {
+		return "", ""
+	}
+	assert.Equal(s.t, "\r\n", string(payload[size:]))
+	assert.Equal(s.t, "PING", s.readLine())
+	return fields[1], string(payload[:size])
+}
+
+// fakeNATS serves each accepted connection with the next handler and returns the server URL.
+func fakeNATS(t *testing.T, userinfo string, handlers ...func(s *natsSession)) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for _, handle := range handlers {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			handle(&natsSession{t: t, conn: conn, r: bufio.NewReader(conn)})
+			conn.Close()
+		}
+	}()
+	return "nats://" + userinfo + ln.Addr().String()
+}
+
+const natsInfoJSON = `{"server_id":"test","max_payload":64}`
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	options := make(chan map[string]any, 1)
+	type pub struct{ subject, payload string }
+	pubs := make(chan pub, 2)
+
+	url := fakeNATS(t, "api:secret@", func(s *natsSession) {
+		options <- s.handshake(natsInfoJSON)
+		for range 2 {
+			subject, payload := s.readPub()
+			pubs <- pub{subject, payload}
+			s.write("+OK\r\nPONG\r\n")
+		}
+	})
+	p, err := NewNATSPublisher(url, "xyz-football-api")
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.NoError(t, p.Publish(context.Background(), "football.match.updated", []byte(`{"id":1}`)))
+	// The payload is length-prefixed, so line breaks inside it are passed through unchanged
+	require.NoError(t, p.Publish(context.Background(), "football.goal.scored", []byte("a\r\nb")))
+
+	got := <-options
+	assert.Equal(t, "xyz-football-api", got["name"])
+	assert.Equal(t, "api", got["user"])
+	assert.Equal(t, "secret", got["pass"])
+	assert.Equal(t, false, got["verbose"])
+	assert.Equal(t, pub{"football.match.updated", `{"id":1}`}, <-pubs)
+	assert.Equal(t, pub{"football.goal.scored", "a\r\nb"}, <-pubs)
+}
+
+func TestNATSPublisher_TokenAuth(t *testing.T) {
+	options := make(chan map[string]any, 1)
+	url := fakeNATS(t, "s3cr3t@", func(s *natsSession) {
+		options <- s.handshake(natsInfoJSON)
+		s.readPub()
+		s.write("PONG\r\n")
+	})
+	p, err := NewNATSPublisher(url, "api")
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.NoError(t, p.Publish(context.Background(), "football.test", []byte("x")))
+	got := <-options
+	assert.Equal(t, "s3cr3t", got["auth_token"])
+	assert.Equal(t, "", got["user"])
+}
+
+func TestNATSPublisher_AnswersServerPing(t *testing.T) {
+	pong := make(chan string, 1)
+	url := fakeNATS(t, "", func(s *natsSession) {
+		s.handshake(natsInfoJSON)
+		s.readPub()
+		// The server pings before acknowledging; the publisher must answer and keep waiting
+		s.write("PING\r\n")
+		pong <- s.readLine()
+		s.write("PONG\r\n")
+	})
+	p, err := NewNATSPublisher(url, "api")
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.NoError(t, p.Publish(context.Background(), "football.test", []byte("x")))
+	assert.Equal(t, "PONG", <-pong)
+}
+
+func TestNATSPublisher_ReconnectsOnce(t *testing.T) {
+	published := make(chan string, 1)
+	url := fakeNATS(t, "",
+		func(s *natsSession) {
+			s.handshake(natsInfoJSON)
+			// Dropped before the publish arrives, e.g. by a server restart
+		},
+		func(s *natsSession) {
+			s.handshake(natsInfoJSON)
+			_, payload := s.readPub()
+			published <- payload
+			s.write("PONG\r\n")
+		},
+	)
+	p, err := NewNATSPublisher(url, "api")
+	require.NoError(t, err)
+	defer p.Close()
+
+	require.NoError(t, p.connect(context.Background()))
+	require.NoError(t, p.Publish(context.Background(), "football.test", []byte("again")))
+	assert.Equal(t, "again", <-published)
+}
+
+func TestNATSPublisher_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(s *natsSession)
+		subject string
+		payload string
+		wantErr string
+	}{
+		{
+			name: "server error is not retried",
+			handler: func(s *natsSession) {
+				s.handshake(natsInfoJSON)
+				s.readPub()
+				s.write("-ERR 'Permissions Violation for Publish to football.test'\r\n")
+			},
+			subject: "football.test",
+			payload: "x",
+			wantErr: "nats: Permissions Violation for Publish to football.test",
+		},
+		{
+			name: "authorization rejected",
+			handler: func(s *natsSession) {
+				s.write("INFO " + natsInfoJSON + "\r\n")
+				s.readLine()
+				s.readLine()
+				s.write("-ERR 'Authorization Violation'\r\n")
+			},
+			subject: "football.test",
+			payload: "x",
+			wantErr: "nats connect: nats: Authorization Violation",
+		},
+		{
+			name:    "payload over the server limit",
+			handler: func(s *natsSession) { s.handshake(natsInfoJSON) },
+			subject: "football.test",
+			payload: strings.Repeat("x", 65),
+			wantErr: "exceeds the server limit of 64",
+		},
+		{
+			name:    "tls required",
+			handler: func(s *natsSession) { s.write(`INFO {"tls_required":true}` + "\r\n") },
+			subject: "football.test",
+			payload: "x",
+			wantErr: "requires TLS",
+		},
+		{
+			name:    "not a nats server",
+			handler: func(s *natsSession) { s.write("HTTP/1.1 400 Bad Request\r\n") },
+			subject: "football.test",
+			payload: "x",
+			wantErr: "expected INFO",
+		},
+		{
+			name:    "wildcard subject",
+			subject: "football.>",
+			wantErr: "invalid subject",
+		},
+		{
+			name:    "subject with spaces",
+			subject: "football test",
+			wantErr: "invalid subject",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handlers []func(s *natsSession)
+			if tt.handler != nil {
+				handlers = append(handlers, tt.handler)
+			}
+			p, err := NewNATSPublisher(fakeNATS(t, "", handlers...), "api")
+			require.NoError(t, err)
+			defer p.Close()
+
+			err = p.Publish(context.Background(), tt.subject, []byte(tt.payload))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestNewNATSPublisher(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		wantAddr string
+		wantErr  bool
+	}{
+		{name: "default port", url: "nats://broker", wantAddr: "broker:4222"},
+		{name: "explicit port", url: "nats://user:pw@broker:4333", wantAddr: "broker:4333"},
+		{name: "wrong scheme", url: "tls://broker:4222", wantErr: true},
+		{name: "no host", url: "nats://", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewNATSPublisher(tt.url, "api")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAddr, p.addr)
+		})
+	}
+}
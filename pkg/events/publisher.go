@@ -0,0 +1,21 @@
+package events
+
+import "context"
+
+// Publisher sends encoded events to an external message broker, so consumers outside
+// this service (analytics, data pipelines) can follow them as a stream.
+type Publisher interface {
+	// Publish sends payload on topic. It returns once the broker accepted the message.
+	Publish(ctx context.Context, topic string, payload []byte) error
+	// Close releases the connection to the broker.
+	Close() error
+}
+
+// Noop is the Publisher used when no broker is configured. It discards every event.
+type Noop struct{}
+
+// Publish discards the payload.
+func (Noop) Publish(context.Context, string, []byte) error { return nil }
+
+// Close does nothing.
+func (Noop) Close() error { return nil }
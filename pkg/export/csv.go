@@ -0,0 +1,42 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// WriteCSV writes the header row and all rows of t as CSV.
+// Text cells that a spreadsheet would evaluate as a formula are prefixed with a quote.
+func WriteCSV(w io.Writer, t Table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.Columns); err != nil {
+		return err
+	}
+
+	record := make([]string, len(t.Columns))
+	for _, row := range t.Rows {
+		for i := range record {
+			record[i] = neutralizeFormula(cell(row, i))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// neutralizeFormula guards against CSV injection: user-supplied text starting with
+// =, +, -, @ or a control character would otherwise run as a formula when opened.
+// Numbers such as negative goal differences are left untouched.
+func neutralizeFormula(s string) string {
+	if s == "" || isNumber(s) {
+		return s
+	}
+	if strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
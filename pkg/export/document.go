@@ -0,0 +1,192 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Document layout in points: A4 portrait with a coloured band carrying the brand on every page.
+const (
+	docWidth       = 595.0
+	docHeight      = 842.0
+	docMargin      = 42.0
+	docUsableWidth = docWidth - 2*docMargin
+	docBandHeight  = 40.0
+
+	docTitleSize   = 16.0
+	docHeadingSize = 11.0
+	docTextSize    = 9.0
+	docLineHeight  = docTextSize * 1.6
+	docLabelWidth  = 110.0 // Indent of field values, past the longest expected label
+
+	// docSignatureHeight is the room a signature block needs: space to sign, the line and the role.
+	docSignatureHeight = 70.0
+	docSignatureGap    = 24.0
+)
+
+// brandColor is the fill of the band and the section rules, as PDF RGB components.
+const brandColor = "0.09 0.27 0.49"
+
+// Document is a printable, portrait report made of headed sections, such as an official
+// match report. Every page carries Brand in a band at the top and is numbered.
+type Document struct {
+	Brand    string // Organization the document is issued by
+	Title    string
+	Subtitle string
+	Footer   string // Optional line printed at the bottom left of every page
+	Sections []Section
+	// Signatures lists who signs the document, e.g. "Referee"; each gets a line at the end
+	Signatures []string
+}
+
+// Section is a headed block of a Document holding label/value fields, a table, or both,
+// fields first. A table without rows prints Empty instead.
+type Section struct {
+	Heading string
+	Fields  []Field
+	Table   *Table // Title and Subtitle are not printed
+	Empty   string
+}
+
+// Field is a labelled value in a Document section.
+type Field struct {
+	Label, Value string
+}
+
+// WriteDocument renders d as a paginated A4 PDF. Text is set in Helvetica, tables in a
+// monospaced font so columns line up without font metrics; a table's header row is repeated
+// when it continues on a new page. Characters outside Latin-1 are replaced with "?".
+func WriteDocument(w io.Writer, d Document) error {
+	doc := newPDFDocument()
+	resources := doc.addFonts()
+	l := &docLayout{brand: d.Brand}
+	l.newPage()
+
+	l.y -= docTitleSize * 1.5
+	pdfText(l.page, fontTitle, docTitleSize, docMargin, l.y, d.Title)
+	if d.Subtitle != "" {
+		l.y -= docTextSize * 1.8
+		pdfText(l.page, fontFooter, docTextSize, docMargin, l.y, d.Subtitle)
+	}
+	l.y -= docTextSize
+
+	for _, section := range d.Sections {
+		l.section(section)
+	}
+	if len(d.Signatures) > 0 {
+		l.signatures(d.Signatures)
+	}
+
+	var kids []string
+	for i, page := range l.pages {
+		if d.Footer != "" {
+			pdfText(page, fontFooter, footerSize, docMargin, docMargin-footerSize*2, d.Footer)
+		}
+		number := fmt.Sprintf("Page %d of %d", i+1, len(l.pages))
+		x := docWidth - docMargin - float64(len(number))*footerSize*courierAdvance
+		pdfText(page, fontBody, footerSize, x, docMargin-footerSize*2, number)
+		kids = append(kids, doc.addPage(page.Bytes(), docWidth, docHeight, resources))
+	}
+	doc.setPages(kids)
+
+	_, err := w.Write(doc.bytes())
+	return err
+}
+
+// docLayout tracks the pages of a Document being laid out and the position on the current one.
+type docLayout struct {
+	brand string
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64 // Baseline of the last line drawn
+}
+
+// newPage starts a page with the brand band.
+func (l *docLayout) newPage() {
+	l.page = &bytes.Buffer{}
+	l.pages = append(l.pages, l.page)
+	fmt.Fprintf(l.page, "%s rg 0 %.2f %.2f %.2f re f\n", brandColor, docHeight-docBandHeight, docWidth, docBandHeight)
+	l.page.WriteString("1 g\n")
+	pdfText(l.page, fontTitle, titleSize, docMargin, docHeight-docBandHeight/2-titleSize/3, l.brand)
+	l.page.WriteString("0 g\n")
+	l.y = docHeight - docBandHeight - docMargin/2
+}
+
+// ensure starts a new page unless height points fit above the bottom margin.
+func (l *docLayout) ensure(height float64) bool {
+	if l.y-height >= docMargin+footerSize {
+		return false
+	}
+	l.newPage()
+	return true
+}
+
+// section draws a section heading with a rule, then its fields and table.
+func (l *docLayout) section(s Section) {
+	// Keep the heading with at least two lines of its content
+	l.ensure(docHeadingSize*3 + docLineHeight*2)
+	l.y -= docHeadingSize * 2
+	pdfText(l.page, fontTitle, docHeadingSize, docMargin, l.y, s.Heading)
+	ruleY := l.y - docHeadingSize*0.5
+	fmt.Fprintf(l.page, "%s RG 1 w %.2f %.2f m %.2f %.2f l S 0 G\n", brandColor, docMargin, ruleY, docWidth-docMargin, ruleY)
+	l.y -= docHeadingSize * 0.5
+
+	for _, field := range s.Fields {
+		l.ensure(docLineHeight)
+		l.y -= docLineHeight
+		pdfText(l.page, fontTitle, docTextSize, docMargin, l.y, field.Label)
+		pdfText(l.page, fontFooter, docTextSize, docMargin+docLabelWidth, l.y, field.Value)
+	}
+
+	if s.Table == nil {
+		return
+	}
+	if len(s.Table.Rows) == 0 {
+		if s.Empty != "" {
+			l.ensure(docLineHeight)
+			l.y -= docLineHeight
+			pdfText(l.page, fontFooter, docTextSize, docMargin, l.y, s.Empty)
+		}
+		return
+	}
+	l.table(*s.Table)
+}
+
+// table draws t with its header row, repeating the header on every page it continues on.
+func (l *docLayout) table(t Table) {
+	widths, fontSize := pdfColumns(t, docUsableWidth)
+	fontSize = min(fontSize, docTextSize)
+	lineHeight := fontSize * 1.4
+	numeric := numericColumns(t)
+
+	header := func() {
+		l.y -= lineHeight * 1.2
+		pdfText(l.page, fontHeader, fontSize, docMargin, l.y, pdfLine(t.Columns, widths, nil))
+		ruleY := l.y - lineHeight*0.4
+		fmt.Fprintf(l.page, "0.5 w %.2f %.2f m %.2f %.2f l S\n", docMargin, ruleY, docWidth-docMargin, ruleY)
+		l.y -= lineHeight * 0.3
+	}
+	l.ensure(lineHeight * 3)
+	header()
+	for _, row := range t.Rows {
+		if l.ensure(lineHeight) {
+			header()
+		}
+		l.y -= lineHeight
+		pdfText(l.page, fontBody, fontSize, docMargin, l.y, pdfLine(row, widths, numeric))
+	}
+}
+
+// signatures draws a row of signature lines, one per role, with the role printed below.
+func (l *docLayout) signatures(roles []string) {
+	l.ensure(docSignatureHeight + docHeadingSize)
+	l.y -= docSignatureHeight
+	width := (docUsableWidth - docSignatureGap*float64(len(roles)-1)) / float64(len(roles))
+	for i, role := range roles {
+		x := docMargin + float64(i)*(width+docSignatureGap)
+		fmt.Fprintf(l.page, "0.5 w %.2f %.2f m %.2f %.2f l S\n", x, l.y, x+width, l.y)
+		pdfText(l.page, fontFooter, docTextSize, x, l.y-docTextSize*1.4, role)
+	}
+	l.y -= docTextSize * 1.4
+}
@@ -0,0 +1,61 @@
+// Package export renders tabular data as downloadable files (CSV or PDF).
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format identifies a file format a Table can be rendered in.
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	FormatPDF Format = "pdf"
+)
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPDF:
+		return "application/pdf"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Write renders t to w in format f.
+func (f Format) Write(w io.Writer, t Table) error {
+	switch f {
+	case FormatCSV:
+		return WriteCSV(w, t)
+	case FormatPDF:
+		return WritePDF(w, t)
+	default:
+		return fmt.Errorf("unsupported export format %q", f)
+	}
+}
+
+// Table is a titled grid of text cells. Every row should have one cell per column.
+type Table struct {
+	Title    string
+	Subtitle string // Optional line printed below the title in documents (not in CSV)
+	Columns  []string
+	Rows     [][]string
+}
+
+// cell returns the cell of row at column i, or "" when the row is short.
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// isNumber reports whether s is an integer or decimal number.
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return err == nil
+}
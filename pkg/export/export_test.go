@@ -0,0 +1,238 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	kidsPattern     = regexp.MustCompile(`^<< /Type /Pages /Kids \[((?:\d+ 0 R ?)*)\] /Count (\d+) >>$`)
+	refPattern      = regexp.MustCompile(`(\d+) 0 R`)
+	contentsPattern = regexp.MustCompile(`^<< /Type /Page /Parent 2 0 R /MediaBox \[0 0 \d+ \d+\] /Resources .* /Contents (\d+) 0 R >>$`)
+	streamPattern   = regexp.MustCompile(`^<< /Length (\d+) >>\nstream\n`)
+	textPattern     = regexp.MustCompile(`\(((?:[^()\\]|\\.)*)\) Tj`)
+)
+
+// parsePDF checks the structure of a PDF written by pdfDocument: header, cross-reference
+// table, trailer, catalog, page tree and stream lengths. It returns the text shown on each page.
+func parsePDF(t *testing.T, data []byte) [][]string {
+	t.Helper()
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")), "header")
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")), "end of file marker")
+
+	tail := data[bytes.LastIndex(data, []byte("startxref\n"))+len("startxref\n"):]
+	xref, err := strconv.Atoi(string(tail[:bytes.IndexByte(tail, '\n')]))
+	require.NoError(t, err)
+	table := string(data[xref:])
+	require.True(t, strings.HasPrefix(table, "xref\n0 "), "xref table at startxref")
+
+	var size int
+	_, err = fmt.Sscanf(table, "xref\n0 %d\n", &size)
+	require.NoError(t, err)
+	entries := table[strings.Index(table, "\n0000000000 65535 f \n")+1:]
+	objects := make(map[int]string, size-1)
+	for i := 1; i < size; i++ {
+		entry := entries[i*20 : (i+1)*20]
+		require.Regexp(t, `^\d{10} 00000 n \n$`, entry)
+		offset, _ := strconv.Atoi(entry[:10])
+		object := string(data[offset:])
+		start := fmt.Sprintf("%d 0 obj\n", i)
+		require.True(t, strings.HasPrefix(object, start), "object %d at its xref offset", i)
+		objects[i] = object[len(start):strings.Index(object, "\nendobj\n")]
+	}
+	assert.Contains(t, entries, fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R >>", size))
+	assert.Equal(t, "<< /Type /Catalog /Pages 2 0 R >>", objects[1])
+
+	kids := kidsPattern.FindStringSubmatch(objects[2])
+	require.NotNil(t, kids, "page tree: %s", objects[2])
+	refs := refPattern.FindAllStringSubmatch(kids[1], -1)
+	assert.Equal(t, kids[2], strconv.Itoa(len(refs)), "page count")
+
+	var pages [][]string
+	for _, ref := range refs {
+		id, _ := strconv.Atoi(ref[1])
+		page := contentsPattern.FindStringSubmatch(objects[id])
+		require.NotNil(t, page, "page object: %s", objects[id])
+		contentID, _ := strconv.Atoi(page[1])
+
+		stream := objects[contentID]
+		header := streamPattern.FindStringSubmatch(stream)
+		require.NotNil(t, header, "content stream")
+		length, _ := strconv.Atoi(header[1])
+		body := stream[len(header[0]):]
+		require.Equal(t, "\nendstream", body[length:], "stream length")
+
+		var text []string
+		for _, m := range textPattern.FindAllStringSubmatch(body[:length], -1) {
+			text = append(text, regexp.MustCompile(`\\(.)`).ReplaceAllString(m[1], "$1"))
+		}
+		pages = append(pages, text)
+	}
+	return pages
+}
+
+func TestWritePDF(t *testing.T) {
+	table := Table{
+		Title:    "Standings",
+		Subtitle: "Liga 1 (2026)",
+		Columns:  []string{"Team", "Pts"},
+		Rows:     [][]string{{"Persija", "30"}, {"Persib", "7"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WritePDF(&buf, table))
+	pages := parsePDF(t, buf.Bytes())
+
+	require.Len(t, pages, 1)
+	// Numeric columns are right-aligned
+	assert.Equal(t, []string{"Standings", "Liga 1 (2026)", "Team     Pts", "Persija   30", "Persib     7", "Page 1 of 1"}, pages[0])
+}
+
+func TestWritePDF_Pagination(t *testing.T) {
+	table := Table{Title: "Players", Columns: []string{"#", "Name"}}
+	for i := range 120 {
+		table.Rows = append(table.Rows, []string{strconv.Itoa(i + 1), fmt.Sprintf("Player %d", i+1)})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WritePDF(&buf, table))
+	pages := parsePDF(t, buf.Bytes())
+
+	require.Greater(t, len(pages), 1)
+	rows := 0
+	for i, page := range pages {
+		first := 0
+		if i == 0 {
+			assert.Equal(t, "Players", page[0])
+			first = 1
+		}
+		assert.Equal(t, "#    Name", page[first], "header repeated on page %d", i+1)
+		assert.Equal(t, fmt.Sprintf("Page %d of %d", i+1, len(pages)), page[len(page)-1])
+		rows += len(page) - first - 2
+	}
+	assert.Equal(t, 120, rows)
+}
+
+func TestWritePDF_Text(t *testing.T) {
+	tests := []struct {
+		name  string
+		table Table
+		want  string // Text of the first row line
+	}{
+		{name: "no rows", table: Table{Columns: []string{"Team"}}, want: "No data"},
+		{name: "delimiters are escaped", table: Table{Columns: []string{"Note"}, Rows: [][]string{{`a (b) \ c`}}}, want: `a (b) \ c`},
+		{name: "latin-1 kept, others replaced", table: Table{Columns: []string{"Name"}, Rows: [][]string{{"Müller 李"}}}, want: "M\xfcller ?"},
+		{name: "short rows are padded", table: Table{Columns: []string{"A", "B"}, Rows: [][]string{{"x"}}}, want: "x"},
+		{
+			name:  "long values are truncated",
+			table: Table{Columns: []string{"Name"}, Rows: [][]string{{strings.Repeat("x", 50)}}},
+			want:  strings.Repeat("x", maxColumnChars-3) + "...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, WritePDF(&buf, tt.table))
+			pages := parsePDF(t, buf.Bytes())
+			// Title, header row, then the first row
+			assert.Equal(t, tt.want, pages[0][2])
+		})
+	}
+}
+
+func TestPDFColumns(t *testing.T) {
+	narrow := Table{Columns: []string{"A", "B"}, Rows: [][]string{{"abc", "1"}}}
+	widths, size := pdfColumns(narrow, usableWidth)
+	assert.Equal(t, []int{3, 1}, widths)
+	assert.Equal(t, maxTableSize, size)
+
+	// Too wide even at the smallest size: the widest columns give way first
+	wide := Table{Columns: make([]string, 12)}
+	for i := range wide.Columns {
+		wide.Columns[i] = strings.Repeat("c", 40)
+	}
+	widths, size = pdfColumns(wide, usableWidth)
+	assert.Equal(t, minTableSize, size)
+	total := columnGap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	assert.LessOrEqual(t, float64(total)*minTableSize*courierAdvance, usableWidth)
+}
+
+func TestWriteDocument(t *testing.T) {
+	doc := Document{
+		Brand:    "XYZ Football",
+		Title:    "Match Report",
+		Subtitle: "Persija vs Persib",
+		Footer:   "Generated 2026-10-16",
+		Sections: []Section{
+			{Heading: "Match", Fields: []Field{{Label: "Score", Value: "2 - 1"}}},
+			{Heading: "Cards", Table: &Table{Columns: []string{"Player"}}, Empty: "No cards"},
+			{Heading: "Goals", Table: &Table{Columns: []string{"Min", "Player"}}},
+		},
+		Signatures: []string{"Referee", "Match Commissioner"},
+	}
+	for i := range 80 {
+		doc.Sections[2].Table.Rows = append(doc.Sections[2].Table.Rows, []string{strconv.Itoa(i + 1), "Striker"})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDocument(&buf, doc))
+	pages := parsePDF(t, buf.Bytes())
+
+	require.Greater(t, len(pages), 1)
+	first := pages[0]
+	assert.Equal(t, []string{"XYZ Football", "Match Report", "Persija vs Persib", "Match", "Score", "2 - 1", "Cards", "No cards", "Goals", "Min  Player"}, first[:10])
+
+	goals := 0
+	for i, page := range pages {
+		assert.Equal(t, "XYZ Football", page[0], "brand on page %d", i+1)
+		assert.Equal(t, []string{"Generated 2026-10-16", fmt.Sprintf("Page %d of %d", i+1, len(pages))}, page[len(page)-2:])
+		for _, text := range page {
+			if strings.HasSuffix(text, "Striker") {
+				goals++
+			}
+		}
+		if i > 0 {
+			assert.Equal(t, "Min  Player", page[1], "table header repeated on page %d", i+1)
+		}
+	}
+	assert.Equal(t, 80, goals)
+
+	last := pages[len(pages)-1]
+	assert.Equal(t, []string{"Referee", "Match Commissioner"}, last[len(last)-4:len(last)-2])
+}
+
+func TestWriteCSV(t *testing.T) {
+	table := Table{
+		Title:   "Players",
+		Columns: []string{"Name", "Goal difference", "Note"},
+		Rows: [][]string{
+			{"=HYPERLINK(\"x\")", "-3", "+1 assist"},
+			{"@SUM(A1)", "4", "a, \"quoted\" note"},
+			{"Short row"},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, FormatCSV.Write(&buf, table))
+	assert.Equal(t, "Name,Goal difference,Note\n"+
+		"\"'=HYPERLINK(\"\"x\"\")\",-3,'+1 assist\n"+
+		"'@SUM(A1),4,\"a, \"\"quoted\"\" note\"\n"+
+		"Short row,,\n", buf.String())
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "application/pdf", FormatPDF.ContentType())
+	assert.Equal(t, "text/csv; charset=utf-8", FormatCSV.ContentType())
+	assert.Error(t, Format("xlsx").Write(&bytes.Buffer{}, Table{}))
+}
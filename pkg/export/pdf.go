@@ -0,0 +1,278 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Page layout in points: A4 landscape with half-inch margins.
+const (
+	pageWidth   = 842.0
+	pageHeight  = 595.0
+	pageMargin  = 36.0
+	usableWidth = pageWidth - 2*pageMargin
+
+	titleSize    = 14.0
+	subtitleSize = 9.0
+	footerSize   = 8.0
+	maxTableSize = 9.0
+	minTableSize = 6.0
+
+	// courierAdvance is the width of every Courier glyph as a fraction of the font size.
+	courierAdvance = 0.6
+	// columnGap is the number of blank characters between columns.
+	columnGap = 2
+	// maxColumnChars caps a column so one long value cannot squeeze the others.
+	maxColumnChars = 40
+)
+
+// Font resource names used in content streams.
+const (
+	fontTitle  = "F1" // Helvetica-Bold
+	fontBody   = "F2" // Courier
+	fontHeader = "F3" // Courier-Bold
+	fontFooter = "F4" // Helvetica
+)
+
+// WritePDF renders t as a paginated PDF document. The table is set in a monospaced font so
+// columns line up without font metrics; the header row is repeated on every page.
+// Characters outside Latin-1 are replaced with "?".
+func WritePDF(w io.Writer, t Table) error {
+	widths, fontSize := pdfColumns(t, usableWidth)
+	lineHeight := fontSize * 1.4
+
+	header := pdfLine(t.Columns, widths, nil)
+	numeric := numericColumns(t)
+	lines := make([]string, len(t.Rows))
+	for i, row := range t.Rows {
+		lines[i] = pdfLine(row, widths, numeric)
+	}
+	if len(lines) == 0 {
+		lines = []string{"No data"}
+	}
+
+	// The first page also holds the title block
+	titleBlock := titleSize * 1.6
+	if t.Subtitle != "" {
+		titleBlock += subtitleSize * 1.6
+	}
+	tableHeight := pageHeight - 2*pageMargin - footerSize*2 - lineHeight*1.5 // minus header row and rule
+	perPage := max(int(tableHeight/lineHeight), 1)
+	firstPage := max(int((tableHeight-titleBlock)/lineHeight), 1)
+
+	var pages [][]string
+	for rest, n := lines, firstPage; len(rest) > 0; n = perPage {
+		n = min(n, len(rest))
+		pages = append(pages, rest[:n])
+		rest = rest[n:]
+	}
+
+	doc := newPDFDocument()
+	resources := doc.addFonts()
+
+	var kids []string
+	for i, pageLines := range pages {
+		var content bytes.Buffer
+		y := pageHeight - pageMargin
+		if i == 0 {
+			y -= titleSize
+			pdfText(&content, fontTitle, titleSize, pageMargin, y, t.Title)
+			y -= titleSize * 0.6
+			if t.Subtitle != "" {
+				y -= subtitleSize * 1.6
+				pdfText(&content, fontFooter, subtitleSize, pageMargin, y, t.Subtitle)
+			}
+			y -= titleSize
+		}
+
+		y -= fontSize
+		pdfText(&content, fontHeader, fontSize, pageMargin, y, header)
+		ruleY := y - lineHeight*0.4
+		fmt.Fprintf(&content, "0.5 w %.2f %.2f m %.2f %.2f l S\n", pageMargin, ruleY, pageWidth-pageMargin, ruleY)
+		y -= lineHeight * 0.5
+
+		for _, line := range pageLines {
+			y -= lineHeight
+			pdfText(&content, fontBody, fontSize, pageMargin, y, line)
+		}
+
+		pdfText(&content, fontFooter, footerSize, pageMargin, pageMargin-footerSize, fmt.Sprintf("Page %d of %d", i+1, len(pages)))
+
+		kids = append(kids, doc.addPage(content.Bytes(), pageWidth, pageHeight, resources))
+	}
+	doc.setPages(kids)
+
+	_, err := w.Write(doc.bytes())
+	return err
+}
+
+// pdfColumns sizes each column to its longest value and picks the largest font size that fits width.
+// When even the smallest size is too wide, the widest columns are narrowed and their values truncated.
+func pdfColumns(t Table, width float64) ([]int, float64) {
+	widths := make([]int, len(t.Columns))
+	for i, col := range t.Columns {
+		widths[i] = utf8.RuneCountInString(col)
+		for _, row := range t.Rows {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell(row, i)))
+		}
+		widths[i] = min(widths[i], maxColumnChars)
+	}
+
+	total := func() int {
+		sum := columnGap * max(len(widths)-1, 0)
+		for _, w := range widths {
+			sum += w
+		}
+		return max(sum, 1)
+	}
+
+	fontSize := min(maxTableSize, width/(float64(total())*courierAdvance))
+	if fontSize >= minTableSize {
+		return widths, fontSize
+	}
+
+	available := width / (minTableSize * courierAdvance)
+	for float64(total()) > available {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 4 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths, minTableSize
+}
+
+// numericColumns reports which columns hold only numbers (ignoring blanks); they are right-aligned.
+func numericColumns(t Table) []bool {
+	numeric := make([]bool, len(t.Columns))
+	for i := range numeric {
+		numeric[i] = len(t.Rows) > 0
+		for _, row := range t.Rows {
+			if v := cell(row, i); v != "" && !isNumber(v) {
+				numeric[i] = false
+				break
+			}
+		}
+	}
+	return numeric
+}
+
+// pdfLine pads (or truncates) each cell to its column width and joins them into one line.
+func pdfLine(row []string, widths []int, rightAlign []bool) string {
+	var b strings.Builder
+	for i, width := range widths {
+		if i > 0 {
+			b.WriteString(strings.Repeat(" ", columnGap))
+		}
+		value := []rune(cell(row, i))
+		if len(value) > width {
+			value = append(value[:width-3], []rune("...")...)
+		}
+		pad := strings.Repeat(" ", width-len(value))
+		if i < len(rightAlign) && rightAlign[i] {
+			b.WriteString(pad + string(value))
+		} else {
+			b.WriteString(string(value) + pad)
+		}
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+// pdfText appends a text-showing operation to a content stream.
+func pdfText(buf *bytes.Buffer, font string, size, x, y float64, text string) {
+	fmt.Fprintf(buf, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfString(text))
+}
+
+// pdfString encodes text as a Latin-1 PDF string literal body, escaping delimiters.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// pdfDocument collects numbered objects and serializes them with a cross-reference table.
+type pdfDocument struct {
+	objects []string
+}
+
+// newPDFDocument starts a document with its catalog and a placeholder for the page tree,
+// which setPages fills in once the page objects are numbered.
+func newPDFDocument() *pdfDocument {
+	doc := &pdfDocument{}
+	doc.add("<< /Type /Catalog /Pages 2 0 R >>")
+	doc.add("")
+	return doc
+}
+
+// addFonts adds the standard fonts used in content streams and returns the resource
+// dictionary referencing them.
+func (d *pdfDocument) addFonts() string {
+	fonts := map[string]string{
+		fontTitle:  "Helvetica-Bold",
+		fontBody:   "Courier",
+		fontHeader: "Courier-Bold",
+		fontFooter: "Helvetica",
+	}
+	var fontRefs []string
+	for _, name := range []string{fontTitle, fontBody, fontHeader, fontFooter} {
+		id := d.add(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", fonts[name]))
+		fontRefs = append(fontRefs, fmt.Sprintf("/%s %d 0 R", name, id))
+	}
+	return "<< /Font << " + strings.Join(fontRefs, " ") + " >> >>"
+}
+
+// addPage adds a page of the given size drawing content and returns its reference.
+func (d *pdfDocument) addPage(content []byte, width, height float64, resources string) string {
+	contentID := d.add(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	pageID := d.add(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources %s /Contents %d 0 R >>",
+		width, height, resources, contentID))
+	return fmt.Sprintf("%d 0 R", pageID)
+}
+
+// setPages fills in the page tree with the pages in order.
+func (d *pdfDocument) setPages(kids []string) {
+	d.objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+}
+
+// add appends an object and returns its object number.
+func (d *pdfDocument) add(body string) int {
+	d.objects = append(d.objects, body)
+	return len(d.objects)
+}
+
+func (d *pdfDocument) bytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(d.objects))
+	for i, body := range d.objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, body)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, xref)
+	return buf.Bytes()
+}
@@ -0,0 +1,78 @@
+// Package fields implements sparse fieldsets: responses holding only the fields a client
+// asks for, as in ?fields=id,name.
+package fields
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Parse splits a comma-separated fields parameter into names, without blanks or repeats.
+// An empty parameter returns nil, meaning every field.
+func Parse(raw string) []string {
+	var names []string
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Names returns the JSON names of the fields of struct type T, including those of embedded
+// structs, in declaration order. Fields without a JSON name ("-") are left out.
+func Names[T any]() []string {
+	return names(reflect.TypeFor[T]())
+}
+
+func names(t reflect.Type) []string {
+	var out []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			out = append(out, names(field.Type)...)
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		out = append(out, name)
+	}
+	return out
+}
+
+// Select returns items as JSON objects holding only the named fields. With no names the
+// items are returned as they are.
+func Select[T any](items []T, names []string) (any, error) {
+	if len(names) == 0 {
+		return items, nil
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var objects []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, err
+	}
+	for _, object := range objects {
+		for name := range object {
+			if !slices.Contains(names, name) {
+				delete(object, name)
+			}
+		}
+	}
+	return objects, nil
+}
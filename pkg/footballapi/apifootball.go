@@ -0,0 +1,265 @@
+package footballapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiFootballURL is the address of version 3 of the API-Football API.
+const apiFootballURL = "https://v3.football.api-sports.io"
+
+// APIFootball reads from API-Football (api-sports.io). Competitions are identified by their
+// numeric league ID, e.g. "39".
+type APIFootball struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+// Name returns "api-football".
+func (a *APIFootball) Name() string { return "api-football" }
+
+// Competition returns the league with the season starting in the given year. API-Football
+// has no season IDs, so the season is identified by the league and the year.
+func (a *APIFootball) Competition(ctx context.Context, competition string, season int) (*Competition, error) {
+	var body []struct {
+		League struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"league"`
+		Country struct {
+			Name string `json:"name"`
+		} `json:"country"`
+		Seasons []struct {
+			Year  int    `json:"year"`
+			Start string `json:"start"`
+			End   string `json:"end"`
+		} `json:"seasons"`
+	}
+	query := url.Values{"id": {competition}, "season": {strconv.Itoa(season)}}
+	if err := a.get(ctx, "/leagues", query, &body); err != nil {
+		return nil, err
+	}
+
+	for _, league := range body {
+		for _, s := range league.Seasons {
+			if s.Year == season {
+				return &Competition{
+					ID:      strconv.Itoa(league.League.ID),
+					Name:    league.League.Name,
+					Country: league.Country.Name,
+					Season:  Season{ID: fmt.Sprintf("%d-%d", league.League.ID, s.Year), StartDate: s.Start, EndDate: s.End},
+				}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("api-football: league %s has no season starting in %d", competition, season)
+}
+
+// Teams returns the teams of the season.
+func (a *APIFootball) Teams(ctx context.Context, competition string, season int) ([]Team, error) {
+	var body []struct {
+		Team struct {
+			ID      int    `json:"id"`
+			Name    string `json:"name"`
+			Code    string `json:"code"`
+			Founded int    `json:"founded"`
+			Logo    string `json:"logo"`
+		} `json:"team"`
+		Venue struct {
+			Address string `json:"address"`
+			City    string `json:"city"`
+		} `json:"venue"`
+	}
+	if err := a.get(ctx, "/teams", a.query(competition, season), &body); err != nil {
+		return nil, err
+	}
+
+	teams := make([]Team, len(body))
+	for i, t := range body {
+		teams[i] = Team{
+			ID:           strconv.Itoa(t.Team.ID),
+			Name:         t.Team.Name,
+			Abbreviation: t.Team.Code,
+			City:         t.Venue.City,
+			Address:      t.Venue.Address,
+			Founded:      t.Team.Founded,
+			LogoURL:      t.Team.Logo,
+		}
+	}
+	return teams, nil
+}
+
+// Players returns the squads of the teams of the season, requesting each team's squad in turn.
+// API-Football only has current squads, so past seasons get today's players.
+func (a *APIFootball) Players(ctx context.Context, competition string, season int) ([]Player, error) {
+	teams, err := a.Teams(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []Player
+	for _, team := range teams {
+		var body []struct {
+			Players []struct {
+				ID       int    `json:"id"`
+				Name     string `json:"name"`
+				Number   *int   `json:"number"`
+				Position string `json:"position"`
+			} `json:"players"`
+		}
+		if err := a.get(ctx, "/players/squads", url.Values{"team": {team.ID}}, &body); err != nil {
+			return nil, err
+		}
+		for _, squad := range body {
+			for _, p := range squad.Players {
+				player := Player{ID: strconv.Itoa(p.ID), TeamID: team.ID, Name: p.Name, Position: apiFootballPosition(p.Position)}
+				if p.Number != nil {
+					player.Number = *p.Number
+				}
+				players = append(players, player)
+			}
+		}
+	}
+	return players, nil
+}
+
+// apiFootballPosition maps an API-Football squad position to a Position.
+func apiFootballPosition(position string) string {
+	switch position {
+	case "Goalkeeper":
+		return PositionGoalkeeper
+	case "Defender":
+		return PositionDefender
+	case "Midfielder":
+		return PositionMidfielder
+	case "Attacker":
+		return PositionForward
+	}
+	return ""
+}
+
+// Matches returns the fixtures of the season. The matchday is read from round names such as
+// "Regular Season - 12".
+func (a *APIFootball) Matches(ctx context.Context, competition string, season int) ([]Match, error) {
+	var body []struct {
+		Fixture struct {
+			ID     int       `json:"id"`
+			Date   time.Time `json:"date"`
+			Status struct {
+				Short string `json:"short"`
+			} `json:"status"`
+		} `json:"fixture"`
+		League struct {
+			Round string `json:"round"`
+		} `json:"league"`
+		Teams struct {
+			Home struct {
+				ID int `json:"id"`
+			} `json:"home"`
+			Away struct {
+				ID int `json:"id"`
+			} `json:"away"`
+		} `json:"teams"`
+		Goals struct {
+			Home *int `json:"home"`
+			Away *int `json:"away"`
+		} `json:"goals"`
+	}
+	if err := a.get(ctx, "/fixtures", a.query(competition, season), &body); err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, len(body))
+	for i, f := range body {
+		round := 0
+		if _, number, ok := strings.Cut(f.League.Round, " - "); ok {
+			round, _ = strconv.Atoi(number)
+		}
+		matches[i] = Match{
+			ID:         strconv.Itoa(f.Fixture.ID),
+			HomeTeamID: strconv.Itoa(f.Teams.Home.ID),
+			AwayTeamID: strconv.Itoa(f.Teams.Away.ID),
+			Kickoff:    f.Fixture.Date,
+			Round:      round,
+			Status:     apiFootballStatus(f.Fixture.Status.Short),
+			HomeScore:  f.Goals.Home,
+			AwayScore:  f.Goals.Away,
+		}
+	}
+	return matches, nil
+}
+
+// apiFootballStatus maps an API-Football fixture status to a Status.
+func apiFootballStatus(status string) string {
+	switch status {
+	case "1H", "HT", "2H", "ET", "BT", "P", "SUSP", "INT", "LIVE":
+		return StatusLive
+	case "FT", "AET", "PEN", "AWD", "WO":
+		return StatusFinished
+	case "PST":
+		return StatusPostponed
+	case "CANC", "ABD":
+		return StatusCancelled
+	default: // TBD, NS
+		return StatusScheduled
+	}
+}
+
+// query selects a league's season in the teams and fixtures endpoints.
+func (a *APIFootball) query(competition string, season int) url.Values {
+	return url.Values{"league": {competition}, "season": {strconv.Itoa(season)}}
+}
+
+// get requests path of the API with query and decodes the response list into dst, following
+// the pages of paginated endpoints. API-Football reports failures such as a wrong key with
+// status 200 and an errors field.
+func (a *APIFootball) get(ctx context.Context, path string, query url.Values, dst any) error {
+	query = maps.Clone(query)
+	var items []json.RawMessage
+	for page := 1; ; page++ {
+		var body struct {
+			Errors json.RawMessage `json:"errors"`
+			Paging struct {
+				Total int `json:"total"`
+			} `json:"paging"`
+			Response json.RawMessage `json:"response"`
+		}
+		// Endpoints without pages reject the page parameter
+		if page > 1 {
+			query.Set("page", strconv.Itoa(page))
+		}
+		u := a.baseURL + path + "?" + query.Encode()
+		if err := getJSON(ctx, a.client, a.Name(), u, http.Header{"X-Apisports-Key": {a.apiKey}}, &body); err != nil {
+			return err
+		}
+		// errors is [] without failures and an object of messages with them
+		if failures := strings.TrimSpace(string(body.Errors)); failures != "" && failures != "[]" && failures != "{}" {
+			return fmt.Errorf("api-football: %s", failures)
+		}
+		var pageItems []json.RawMessage
+		if err := json.Unmarshal(body.Response, &pageItems); err != nil {
+			return fmt.Errorf("api-football: decoding response: %w", err)
+		}
+		items = append(items, pageItems...)
+		if page >= body.Paging.Total {
+			break
+		}
+	}
+
+	joined, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(joined, dst); err != nil {
+		return fmt.Errorf("api-football: decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package footballapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apiFootballRoutes are the fixtures of the 2024 Premier League at API-Football. Fixtures
+// come in two pages.
+var apiFootballRoutes = map[string]string{
+	"/leagues?id=39&season=2024":             "leagues.json",
+	"/teams?league=39&season=2024":           "teams.json",
+	"/players/squads?team=42":                "squad-42.json",
+	"/players/squads?team=49":                "squad-49.json",
+	"/fixtures?league=39&season=2024":        "fixtures.json",
+	"/fixtures?league=39&page=2&season=2024": "fixtures-page-2.json",
+}
+
+func newTestAPIFootball(t *testing.T, routes map[string]string) (*APIFootball, *fixtureServer) {
+	server := newFixtureServer(t, "api-football", routes)
+	provider, err := New("api-football", "af-key", server.URL)
+	require.NoError(t, err)
+	return provider.(*APIFootball), server
+}
+
+func TestAPIFootball_Competition(t *testing.T) {
+	provider, server := newTestAPIFootball(t, apiFootballRoutes)
+
+	competition, err := provider.Competition(context.Background(), "39", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Competition{
+		ID:      "39",
+		Name:    "Premier League",
+		Country: "England",
+		Season:  Season{ID: "39-2024", StartDate: "2024-08-16", EndDate: "2025-05-25"},
+	}, competition)
+	headers := server.headers()
+	require.Len(t, headers, 1)
+	assert.Equal(t, "af-key", headers[0].Get("X-Apisports-Key"))
+	assert.Equal(t, "application/json", headers[0].Get("Accept"))
+
+	t.Run("season not listed", func(t *testing.T) {
+		provider, _ := newTestAPIFootball(t, map[string]string{"/leagues?id=39&season=2019": "leagues.json"})
+		_, err := provider.Competition(context.Background(), "39", 2019)
+		assert.EqualError(t, err, "api-football: league 39 has no season starting in 2019")
+	})
+}
+
+func TestAPIFootball_Teams(t *testing.T) {
+	provider, _ := newTestAPIFootball(t, apiFootballRoutes)
+
+	teams, err := provider.Teams(context.Background(), "39", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Team{
+		{ID: "42", Name: "Arsenal", Abbreviation: "ARS", City: "London", Address: "Queensland Road",
+			Founded: 1886, LogoURL: "https://media.api-sports.io/football/teams/42.png"},
+		{ID: "49", Name: "Chelsea", Abbreviation: "CHE", City: "London", Address: "Fulham Road",
+			Founded: 1905, LogoURL: "https://media.api-sports.io/football/teams/49.png"},
+	}, teams)
+}
+
+func TestAPIFootball_Players(t *testing.T) {
+	provider, server := newTestAPIFootball(t, apiFootballRoutes)
+
+	players, err := provider.Players(context.Background(), "39", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Player{
+		{ID: "1460", TeamID: "42", Name: "B. Saka", Position: PositionForward, Number: 7},
+		{ID: "19465", TeamID: "42", Name: "D. Raya", Position: PositionGoalkeeper, Number: 22},
+		// Players without a squad number yet
+		{ID: "22224", TeamID: "42", Name: "Gabriel Magalhães", Position: PositionDefender},
+		{ID: "152982", TeamID: "49", Name: "C. Palmer", Position: PositionMidfielder, Number: 20},
+	}, players)
+	// The squads are requested team by team
+	assert.Equal(t, []string{
+		"/teams?league=39&season=2024",
+		"/players/squads?team=42",
+		"/players/squads?team=49",
+	}, server.paths())
+
+	t.Run("squad error", func(t *testing.T) {
+		routes := map[string]string{"/teams?league=39&season=2024": "teams.json", "/players/squads?team=42": "squad-42.json"}
+		provider, _ := newTestAPIFootball(t, routes)
+
+		players, err := provider.Players(context.Background(), "39", 2024)
+
+		assert.Nil(t, players)
+		assert.ErrorContains(t, err, "api-football responded with status 404")
+	})
+}
+
+func TestAPIFootball_Matches(t *testing.T) {
+	provider, server := newTestAPIFootball(t, apiFootballRoutes)
+	two, one, zero := 2, 1, 0
+
+	matches, err := provider.Matches(context.Background(), "39", 2024)
+
+	require.NoError(t, err)
+	// Dates come with a +00:00 offset
+	for i := range matches {
+		matches[i].Kickoff = matches[i].Kickoff.UTC()
+	}
+	assert.Equal(t, []Match{
+		{ID: "1208021", HomeTeamID: "42", AwayTeamID: "49", Kickoff: time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC),
+			Round: 1, Status: StatusFinished, HomeScore: &two, AwayScore: &zero},
+		{ID: "1208022", HomeTeamID: "49", AwayTeamID: "42", Kickoff: time.Date(2024, 8, 24, 16, 30, 0, 0, time.UTC),
+			Round: 2, Status: StatusLive, HomeScore: &one, AwayScore: &one},
+		// From the second page
+		{ID: "1208023", HomeTeamID: "42", AwayTeamID: "49", Kickoff: time.Date(2024, 9, 1, 15, 30, 0, 0, time.UTC),
+			Round: 3, Status: StatusPostponed},
+		// Rounds without a matchday
+		{ID: "1208024", HomeTeamID: "42", AwayTeamID: "49", Kickoff: time.Date(2025, 3, 16, 19, 0, 0, 0, time.UTC),
+			Status: StatusScheduled},
+	}, matches)
+	assert.Equal(t, []string{
+		"/fixtures?league=39&season=2024",
+		"/fixtures?league=39&page=2&season=2024",
+	}, server.paths())
+}
+
+func TestAPIFootball_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "wrong key reported with status 200", status: http.StatusOK,
+			body:    `{"errors": {"token": "Error/Missing application key."}, "paging": {"current": 1, "total": 1}, "response": []}`,
+			wantErr: `api-football: {"token": "Error/Missing application key."}`},
+		{name: "request limit reported with status 200", status: http.StatusOK,
+			body:    `{"errors": {"requests": "You have reached the request limit for the day."}, "response": []}`,
+			wantErr: "api-football: {\"requests\": \"You have reached the request limit for the day.\"}"},
+		{name: "error status", status: http.StatusInternalServerError, body: `{"message": "Internal error"}`,
+			wantErr: `api-football responded with status 500: {"message": "Internal error"}`},
+		{name: "unexpected shape", status: http.StatusOK, body: `{"errors": [], "response": {"team": {}}}`,
+			wantErr: "api-football: decoding response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.body)
+			provider, err := New("api-football", "af-key", server.URL)
+			require.NoError(t, err)
+
+			teams, err := provider.Teams(context.Background(), "39", 2024)
+
+			assert.Nil(t, teams)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("error fixture", func(t *testing.T) {
+		provider, _ := newTestAPIFootball(t, map[string]string{"/teams?league=39&season=2024": "errors.json"})
+
+		_, err := provider.Teams(context.Background(), "39", 2024)
+
+		assert.EqualError(t, err, `api-football: {"token": "Error/Missing application key."}`)
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		server := statusServer(t, http.StatusOK, `{"errors": [], "results": 0, "paging": {"current": 1, "total": 0}, "response": []}`)
+		provider, err := New("api-football", "af-key", server.URL)
+		require.NoError(t, err)
+
+		teams, err := provider.Teams(context.Background(), "39", 2024)
+
+		require.NoError(t, err)
+		assert.Empty(t, teams)
+	})
+}
+
+func TestAPIFootballStatus(t *testing.T) {
+	tests := map[string]string{
+		"TBD":  StatusScheduled,
+		"NS":   StatusScheduled,
+		"1H":   StatusLive,
+		"HT":   StatusLive,
+		"2H":   StatusLive,
+		"ET":   StatusLive,
+		"P":    StatusLive,
+		"SUSP": StatusLive,
+		"FT":   StatusFinished,
+		"AET":  StatusFinished,
+		"PEN":  StatusFinished,
+		"AWD":  StatusFinished,
+		"PST":  StatusPostponed,
+		"CANC": StatusCancelled,
+		"ABD":  StatusCancelled,
+	}
+	for status, want := range tests {
+		assert.Equal(t, want, apiFootballStatus(status), status)
+	}
+}
@@ -0,0 +1,175 @@
+// Package footballapi reads competitions, teams, squads and fixtures from external football data
+// providers such as football-data.org and API-Football, in one shape for every provider.
+package footballapi
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds one request to a provider's API.
+const requestTimeout = 30 * time.Second
+
+// Providers are the names New accepts.
+var Providers = []string{"football-data", "api-football"}
+
+// Statuses of a Match, mapped from each provider's own.
+const (
+	StatusScheduled = "scheduled"
+	StatusLive      = "live"
+	StatusFinished  = "finished"
+	StatusPostponed = "postponed"
+	StatusCancelled = "cancelled"
+)
+
+// Positions of a Player, mapped from each provider's own.
+const (
+	PositionGoalkeeper = "goalkeeper"
+	PositionDefender   = "defender"
+	PositionMidfielder = "midfielder"
+	PositionForward    = "forward"
+)
+
+// Competition is a league or cup with one of its seasons. IDs are the provider's.
+type Competition struct {
+	ID      string
+	Name    string // e.g. "Premier League"
+	Country string
+	Season  Season
+}
+
+// Season is one edition of a competition.
+type Season struct {
+	ID        string
+	StartDate string // YYYY-MM-DD
+	EndDate   string // YYYY-MM-DD
+}
+
+// Team is a team taking part in a season. Fields the provider does not know are empty.
+type Team struct {
+	ID           string
+	Name         string
+	ShortName    string
+	Abbreviation string // Usually three letters, e.g. "ARS"
+	City         string
+	Address      string
+	Founded      int
+	LogoURL      string
+}
+
+// Player is a member of a team's squad for the season. Fields the provider does not know are
+// empty.
+type Player struct {
+	ID          string
+	TeamID      string
+	Name        string
+	Position    string // One of the Position constants
+	Number      int    // Shirt number
+	DateOfBirth string // YYYY-MM-DD
+}
+
+// Match is a fixture of a season, with its result once played.
+type Match struct {
+	ID         string
+	HomeTeamID string
+	AwayTeamID string
+	Kickoff    time.Time
+	Round      int    // Matchday; 0 when the provider has none, e.g. in knockout rounds
+	Status     string // One of the Status constants
+	HomeScore  *int   // Full-time score; nil before kick-off
+	AwayScore  *int
+}
+
+// Provider reads a competition's data from one provider. competition is the provider's code
+// or ID of the competition, e.g. "PL" or "39", and season the year it starts in.
+type Provider interface {
+	// Name identifies the provider, e.g. "football-data"; it is stored with imported IDs.
+	Name() string
+	Competition(ctx context.Context, competition string, season int) (*Competition, error)
+	Teams(ctx context.Context, competition string, season int) ([]Team, error)
+	Players(ctx context.Context, competition string, season int) ([]Player, error)
+	Matches(ctx context.Context, competition string, season int) ([]Match, error)
+}
+
+// New returns the provider called name, one of Providers, authenticating with apiKey.
+// baseURL overrides the provider's API address when set, e.g. for a proxy.
+func New(name, apiKey, baseURL string) (Provider, error) {
+	client := &http.Client{Timeout: requestTimeout}
+	switch name {
+	case "football-data":
+		return &FootballData{apiKey: apiKey, baseURL: strings.TrimSuffix(cmp.Or(baseURL, footballDataURL), "/"), client: client}, nil
+	case "api-football":
+		return &APIFootball{apiKey: apiKey, baseURL: strings.TrimSuffix(cmp.Or(baseURL, apiFootballURL), "/"), client: client}, nil
+	}
+	return nil, fmt.Errorf("unknown football data provider %q (use %s)", name, strings.Join(Providers, " or "))
+}
+
+// getJSON requests url with the given headers and decodes the JSON response into dst.
+func getJSON(ctx context.Context, client *http.Client, provider, url string, header http.Header, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", provider, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		// Both providers explain the failure in a short JSON body
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s responded with status %d: %s", provider, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("%s: decoding response: %w", provider, err)
+	}
+	return nil
+}
+
+// Memory is a Provider serving fixed data, for tests and local development.
+type Memory struct {
+	competition Competition
+	teams       []Team
+	players     []Player
+	matches     []Match
+}
+
+// NewMemory creates a provider serving the given data for every competition and season.
+func NewMemory(competition Competition, teams []Team, players []Player, matches []Match) *Memory {
+	return &Memory{competition: competition, teams: teams, players: players, matches: matches}
+}
+
+// Name returns "memory".
+func (m *Memory) Name() string { return "memory" }
+
+// Competition returns the competition.
+func (m *Memory) Competition(context.Context, string, int) (*Competition, error) {
+	competition := m.competition
+	return &competition, nil
+}
+
+// Teams returns the teams.
+func (m *Memory) Teams(context.Context, string, int) ([]Team, error) {
+	return append([]Team(nil), m.teams...), nil
+}
+
+// Players returns the players.
+func (m *Memory) Players(context.Context, string, int) ([]Player, error) {
+	return append([]Player(nil), m.players...), nil
+}
+
+// Matches returns the matches.
+func (m *Memory) Matches(context.Context, string, int) ([]Match, error) {
+	return append([]Match(nil), m.matches...), nil
+}
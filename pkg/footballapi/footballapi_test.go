@@ -0,0 +1,166 @@
+package footballapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixtureServer serves the JSON files under testdata/<dir>, picking the file by the request
+// path and query in routes, e.g. "/teams?league=39&season=2024": "teams.json". Unknown
+// requests get a 404 with a JSON message, as the providers send.
+type fixtureServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func newFixtureServer(t *testing.T, dir string, routes map[string]string) *fixtureServer {
+	t.Helper()
+	s := &fixtureServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.mu.Unlock()
+
+		key := r.URL.Path
+		if r.URL.RawQuery != "" {
+			key += "?" + r.URL.RawQuery
+		}
+		file, ok := routes[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Resource not found", "errorCode": 404}`))
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", dir, file))
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// paths returns the path and query of every request received, in order.
+func (s *fixtureServer) paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	paths := make([]string, len(s.requests))
+	for i, r := range s.requests {
+		paths[i] = r.URL.RequestURI()
+	}
+	return paths
+}
+
+// headers returns the headers of every request received, in order.
+func (s *fixtureServer) headers() []http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	headers := make([]http.Header, len(s.requests))
+	for i, r := range s.requests {
+		headers[i] = r.Header
+	}
+	return headers
+}
+
+// statusServer answers every request with status and body.
+func statusServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		provider    string
+		baseURL     string
+		wantName    string
+		wantBaseURL string
+		wantErr     string
+	}{
+		{name: "football-data", provider: "football-data", wantName: "football-data", wantBaseURL: footballDataURL},
+		{name: "api-football", provider: "api-football", wantName: "api-football", wantBaseURL: apiFootballURL},
+		{name: "base URL override", provider: "football-data", baseURL: "http://proxy.local/v4/", wantName: "football-data", wantBaseURL: "http://proxy.local/v4"},
+		{name: "unknown provider", provider: "sportmonks", wantErr: `unknown football data provider "sportmonks" (use football-data or api-football)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := New(tt.provider, "key", tt.baseURL)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, provider.Name())
+			switch p := provider.(type) {
+			case *FootballData:
+				assert.Equal(t, tt.wantBaseURL, p.baseURL)
+			case *APIFootball:
+				assert.Equal(t, tt.wantBaseURL, p.baseURL)
+			}
+		})
+	}
+}
+
+func TestGetJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "decodes the body", status: http.StatusOK, body: `{"name": "Premier League"}`},
+		{name: "error status with the provider's message", status: http.StatusForbidden,
+			body:    `{"message": "The resource you are looking for is restricted.", "errorCode": 403}` + "\n",
+			wantErr: `test responded with status 403: {"message": "The resource you are looking for is restricted.", "errorCode": 403}`},
+		{name: "rate limited", status: http.StatusTooManyRequests, body: `{"message": "You reached your request limit."}`,
+			wantErr: `test responded with status 429: {"message": "You reached your request limit."}`},
+		{name: "malformed body", status: http.StatusOK, body: `<html>`, wantErr: "test: decoding response: invalid character '<'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.body)
+
+			var body struct {
+				Name string `json:"name"`
+			}
+			err := getJSON(context.Background(), server.Client(), "test", server.URL, http.Header{"X-Auth-Token": {"key"}}, &body)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Premier League", body.Name)
+		})
+	}
+
+	t.Run("unreachable provider", func(t *testing.T) {
+		server := statusServer(t, http.StatusOK, `{}`)
+		server.Close()
+
+		err := getJSON(context.Background(), server.Client(), "test", server.URL, nil, &struct{}{})
+		assert.ErrorContains(t, err, "test: ")
+	})
+}
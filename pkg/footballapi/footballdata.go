@@ -0,0 +1,220 @@
+package footballapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// footballDataURL is the address of version 4 of the football-data.org API.
+const footballDataURL = "https://api.football-data.org/v4"
+
+// FootballData reads from football-data.org. Competitions are identified by their code,
+// e.g. "PL", or numeric ID.
+type FootballData struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+// Name returns "football-data".
+func (f *FootballData) Name() string { return "football-data" }
+
+// Competition returns the competition with the season starting in the given year.
+func (f *FootballData) Competition(ctx context.Context, competition string, season int) (*Competition, error) {
+	var body struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		Area struct {
+			Name string `json:"name"`
+		} `json:"area"`
+		Seasons []struct {
+			ID        int    `json:"id"`
+			StartDate string `json:"startDate"`
+			EndDate   string `json:"endDate"`
+		} `json:"seasons"`
+	}
+	if err := f.get(ctx, "/competitions/"+url.PathEscape(competition), nil, &body); err != nil {
+		return nil, err
+	}
+
+	for _, s := range body.Seasons {
+		if len(s.StartDate) >= 4 && s.StartDate[:4] == strconv.Itoa(season) {
+			return &Competition{
+				ID:      strconv.Itoa(body.ID),
+				Name:    body.Name,
+				Country: body.Area.Name,
+				Season:  Season{ID: strconv.Itoa(s.ID), StartDate: s.StartDate, EndDate: s.EndDate},
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("football-data: %s has no season starting in %d", competition, season)
+}
+
+// footballDataTeam is a team in the teams of a competition's season, with its squad.
+type footballDataTeam struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	ShortName string `json:"shortName"`
+	TLA       string `json:"tla"`
+	Crest     string `json:"crest"`
+	Address   string `json:"address"`
+	Founded   int    `json:"founded"`
+	Squad     []struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Position    string `json:"position"`
+		DateOfBirth string `json:"dateOfBirth"`
+		ShirtNumber int    `json:"shirtNumber"`
+	} `json:"squad"`
+}
+
+// teams requests the teams of the season, which come with their squads.
+func (f *FootballData) teams(ctx context.Context, competition string, season int) ([]footballDataTeam, error) {
+	var body struct {
+		Teams []footballDataTeam `json:"teams"`
+	}
+	query := url.Values{"season": {strconv.Itoa(season)}}
+	if err := f.get(ctx, "/competitions/"+url.PathEscape(competition)+"/teams", query, &body); err != nil {
+		return nil, err
+	}
+	return body.Teams, nil
+}
+
+// Teams returns the teams of the season.
+func (f *FootballData) Teams(ctx context.Context, competition string, season int) ([]Team, error) {
+	body, err := f.teams(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]Team, len(body))
+	for i, t := range body {
+		teams[i] = Team{
+			ID:           strconv.Itoa(t.ID),
+			Name:         t.Name,
+			ShortName:    t.ShortName,
+			Abbreviation: t.TLA,
+			Address:      t.Address,
+			Founded:      t.Founded,
+			LogoURL:      t.Crest,
+		}
+	}
+	return teams, nil
+}
+
+// Players returns the squads of the teams of the season. football-data.org lists shirt numbers
+// on paid plans only.
+func (f *FootballData) Players(ctx context.Context, competition string, season int) ([]Player, error) {
+	body, err := f.teams(ctx, competition, season)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []Player
+	for _, t := range body {
+		for _, p := range t.Squad {
+			players = append(players, Player{
+				ID:          strconv.Itoa(p.ID),
+				TeamID:      strconv.Itoa(t.ID),
+				Name:        p.Name,
+				Position:    footballDataPosition(p.Position),
+				Number:      p.ShirtNumber,
+				DateOfBirth: p.DateOfBirth[:min(len(p.DateOfBirth), len(time.DateOnly))],
+			})
+		}
+	}
+	return players, nil
+}
+
+// footballDataPosition maps a football-data.org position, either a line such as "Defence" or
+// a role such as "Left-Back", to a Position.
+func footballDataPosition(position string) string {
+	switch {
+	case position == "Goalkeeper":
+		return PositionGoalkeeper
+	case position == "Defence" || strings.HasSuffix(position, "Back"):
+		return PositionDefender
+	case strings.Contains(position, "Midfield"):
+		return PositionMidfielder
+	case position == "Offence" || strings.Contains(position, "Forward") || strings.Contains(position, "Winger") || strings.Contains(position, "Striker"):
+		return PositionForward
+	}
+	return ""
+}
+
+// Matches returns the fixtures of the season.
+func (f *FootballData) Matches(ctx context.Context, competition string, season int) ([]Match, error) {
+	var body struct {
+		Matches []struct {
+			ID       int       `json:"id"`
+			UTCDate  time.Time `json:"utcDate"`
+			Status   string    `json:"status"`
+			Matchday int       `json:"matchday"`
+			HomeTeam struct {
+				ID int `json:"id"`
+			} `json:"homeTeam"`
+			AwayTeam struct {
+				ID int `json:"id"`
+			} `json:"awayTeam"`
+			Score struct {
+				FullTime struct {
+					Home *int `json:"home"`
+					Away *int `json:"away"`
+				} `json:"fullTime"`
+			} `json:"score"`
+		} `json:"matches"`
+	}
+	query := url.Values{"season": {strconv.Itoa(season)}}
+	if err := f.get(ctx, "/competitions/"+url.PathEscape(competition)+"/matches", query, &body); err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, 0, len(body.Matches))
+	for _, m := range body.Matches {
+		// Fixtures of knockout rounds can be listed before their teams are known
+		if m.HomeTeam.ID == 0 || m.AwayTeam.ID == 0 {
+			continue
+		}
+		matches = append(matches, Match{
+			ID:         strconv.Itoa(m.ID),
+			HomeTeamID: strconv.Itoa(m.HomeTeam.ID),
+			AwayTeamID: strconv.Itoa(m.AwayTeam.ID),
+			Kickoff:    m.UTCDate,
+			Round:      m.Matchday,
+			Status:     footballDataStatus(m.Status),
+			HomeScore:  m.Score.FullTime.Home,
+			AwayScore:  m.Score.FullTime.Away,
+		})
+	}
+	return matches, nil
+}
+
+// footballDataStatus maps a football-data.org match status to a Status.
+func footballDataStatus(status string) string {
+	switch status {
+	case "IN_PLAY", "PAUSED", "SUSPENDED":
+		return StatusLive
+	case "FINISHED", "AWARDED":
+		return StatusFinished
+	case "POSTPONED":
+		return StatusPostponed
+	case "CANCELLED":
+		return StatusCancelled
+	default: // SCHEDULED, TIMED
+		return StatusScheduled
+	}
+}
+
+// get requests path of the API with query and decodes the response into dst.
+func (f *FootballData) get(ctx context.Context, path string, query url.Values, dst any) error {
+	u := f.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return getJSON(ctx, f.client, f.Name(), u, http.Header{"X-Auth-Token": {f.apiKey}}, dst)
+}
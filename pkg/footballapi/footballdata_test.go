@@ -0,0 +1,150 @@
+package footballapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// footballDataRoutes are the fixtures of the 2024/25 Premier League at football-data.org.
+var footballDataRoutes = map[string]string{
+	"/competitions/PL":                     "competition.json",
+	"/competitions/PL/teams?season=2024":   "teams.json",
+	"/competitions/PL/matches?season=2024": "matches.json",
+}
+
+func newTestFootballData(t *testing.T) (*FootballData, *fixtureServer) {
+	server := newFixtureServer(t, "football-data", footballDataRoutes)
+	provider, err := New("football-data", "fd-key", server.URL)
+	require.NoError(t, err)
+	return provider.(*FootballData), server
+}
+
+func TestFootballData_Competition(t *testing.T) {
+	provider, server := newTestFootballData(t)
+
+	competition, err := provider.Competition(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Competition{
+		ID:      "2021",
+		Name:    "Premier League",
+		Country: "England",
+		Season:  Season{ID: "2287", StartDate: "2024-08-16", EndDate: "2025-05-25"},
+	}, competition)
+	headers := server.headers()
+	require.Len(t, headers, 1)
+	assert.Equal(t, "fd-key", headers[0].Get("X-Auth-Token"))
+	assert.Equal(t, "application/json", headers[0].Get("Accept"))
+
+	t.Run("season not listed", func(t *testing.T) {
+		_, err := provider.Competition(context.Background(), "PL", 2019)
+		assert.EqualError(t, err, "football-data: PL has no season starting in 2019")
+	})
+
+	t.Run("unknown competition", func(t *testing.T) {
+		_, err := provider.Competition(context.Background(), "XX", 2024)
+		assert.EqualError(t, err, `football-data responded with status 404: {"message": "Resource not found", "errorCode": 404}`)
+	})
+}
+
+func TestFootballData_Teams(t *testing.T) {
+	provider, server := newTestFootballData(t)
+
+	teams, err := provider.Teams(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Team{
+		{ID: "57", Name: "Arsenal FC", ShortName: "Arsenal", Abbreviation: "ARS", Address: "75 Drayton Park London N5 1BU",
+			Founded: 1886, LogoURL: "https://crests.football-data.org/57.png"},
+		{ID: "61", Name: "Chelsea FC", ShortName: "Chelsea", Abbreviation: "CHE", Address: "Fulham Road London SW6 1HS",
+			Founded: 1905, LogoURL: "https://crests.football-data.org/61.png"},
+	}, teams)
+	assert.Equal(t, []string{"/competitions/PL/teams?season=2024"}, server.paths())
+}
+
+func TestFootballData_Players(t *testing.T) {
+	provider, _ := newTestFootballData(t)
+
+	players, err := provider.Players(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Player{
+		{ID: "7784", TeamID: "57", Name: "Bukayo Saka", Position: PositionForward, Number: 7, DateOfBirth: "2001-09-05"},
+		// Shirt numbers are missing on free plans
+		{ID: "3174", TeamID: "57", Name: "David Raya", Position: PositionGoalkeeper, DateOfBirth: "1995-09-15"},
+		{ID: "8176", TeamID: "57", Name: "Ben White", Position: PositionDefender, Number: 4, DateOfBirth: "1997-10-08"},
+		{ID: "8004", TeamID: "61", Name: "Cole Palmer", Position: PositionMidfielder, Number: 20, DateOfBirth: "2002-05-06"},
+		{ID: "3402", TeamID: "61", Name: "Levi Colwill", Position: PositionDefender, DateOfBirth: "2003-02-26"},
+		{ID: "9110", TeamID: "61", Name: "Nicolas Jackson", Position: PositionForward, DateOfBirth: "2001-06-20"},
+		{ID: "9111", TeamID: "61", Name: "Mystery Man"},
+	}, players)
+}
+
+func TestFootballData_Matches(t *testing.T) {
+	provider, _ := newTestFootballData(t)
+	two, one, zero := 2, 1, 0
+
+	matches, err := provider.Matches(context.Background(), "PL", 2024)
+
+	require.NoError(t, err)
+	// The final, whose teams are not known yet, is left out
+	assert.Equal(t, []Match{
+		{ID: "497410", HomeTeamID: "57", AwayTeamID: "61", Kickoff: time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC),
+			Round: 1, Status: StatusFinished, HomeScore: &two, AwayScore: &zero},
+		{ID: "497411", HomeTeamID: "61", AwayTeamID: "57", Kickoff: time.Date(2024, 8, 24, 16, 30, 0, 0, time.UTC),
+			Round: 2, Status: StatusLive, HomeScore: &one, AwayScore: &one},
+		{ID: "497412", HomeTeamID: "57", AwayTeamID: "61", Kickoff: time.Date(2024, 9, 1, 15, 30, 0, 0, time.UTC),
+			Round: 3, Status: StatusScheduled},
+	}, matches)
+}
+
+func TestFootballData_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "wrong key", status: http.StatusBadRequest, body: `{"message": "Your API token is invalid.", "errorCode": 400}`,
+			wantErr: `football-data responded with status 400: {"message": "Your API token is invalid.", "errorCode": 400}`},
+		{name: "competition outside the plan", status: http.StatusForbidden, body: `{"message": "The resource you are looking for is restricted."}`,
+			wantErr: "football-data responded with status 403"},
+		{name: "server error without a body", status: http.StatusBadGateway, wantErr: "football-data responded with status 502: "},
+		{name: "unexpected shape", status: http.StatusOK, body: `{"matches": {}}`, wantErr: "football-data: decoding response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := statusServer(t, tt.status, tt.body)
+			provider, err := New("football-data", "fd-key", server.URL)
+			require.NoError(t, err)
+
+			matches, err := provider.Matches(context.Background(), "PL", 2024)
+
+			assert.Nil(t, matches)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestFootballDataStatus(t *testing.T) {
+	tests := map[string]string{
+		"SCHEDULED": StatusScheduled,
+		"TIMED":     StatusScheduled,
+		"IN_PLAY":   StatusLive,
+		"PAUSED":    StatusLive,
+		"SUSPENDED": StatusLive,
+		"FINISHED":  StatusFinished,
+		"AWARDED":   StatusFinished,
+		"POSTPONED": StatusPostponed,
+		"CANCELLED": StatusCancelled,
+	}
+	for status, want := range tests {
+		assert.Equal(t, want, footballDataStatus(status), status)
+	}
+}
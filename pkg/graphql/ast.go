@@ -0,0 +1,109 @@
+package graphql
+
+// Document is a parsed GraphQL request document.
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+// Operation is a query definition. Mutations and subscriptions are rejected by the parser.
+type Operation struct {
+	Name         string
+	Variables    []*VariableDefinition
+	SelectionSet []Selection
+}
+
+// VariableDefinition declares an operation variable, e.g. `$id: ID!`.
+type VariableDefinition struct {
+	Name    string
+	Type    string // As written, e.g. "[ID!]!"
+	Default Value  // nil when there is no default
+}
+
+// Fragment is a named fragment definition.
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+// Selection is a *FieldSelection, *FragmentSpread or *InlineFragment.
+type Selection interface {
+	selection()
+}
+
+// FieldSelection selects one field of an object, optionally under an alias.
+type FieldSelection struct {
+	Alias        string
+	Name         string
+	Arguments    []*Argument
+	Directives   []*Directive
+	SelectionSet []Selection
+	Line, Column int
+}
+
+// ResponseKey is the key the field's value is returned under.
+func (f *FieldSelection) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// FragmentSpread includes a named fragment, e.g. `...teamFields`.
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+}
+
+// InlineFragment groups selections under an optional type condition, e.g. `... on Team { name }`.
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+func (*FieldSelection) selection() {}
+func (*FragmentSpread) selection() {}
+func (*InlineFragment) selection() {}
+
+// Argument is a name/value pair passed to a field or directive.
+type Argument struct {
+	Name  string
+	Value Value
+}
+
+// Directive is an annotation such as `@include(if: $withPlayers)`.
+type Directive struct {
+	Name      string
+	Arguments []*Argument
+}
+
+// Value is a literal or variable in a document: Variable, IntValue, FloatValue,
+// StringValue, BooleanValue, NullValue, EnumValue, ListValue or ObjectValue.
+type Value interface {
+	value()
+}
+
+type (
+	Variable     string
+	IntValue     int64
+	FloatValue   float64
+	StringValue  string
+	BooleanValue bool
+	NullValue    struct{}
+	EnumValue    string
+	ListValue    []Value
+	ObjectValue  []*Argument
+)
+
+func (Variable) value()     {}
+func (IntValue) value()     {}
+func (FloatValue) value()   {}
+func (StringValue) value()  {}
+func (BooleanValue) value() {}
+func (NullValue) value()    {}
+func (EnumValue) value()    {}
+func (ListValue) value()    {}
+func (ObjectValue) value()  {}
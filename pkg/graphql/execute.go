@@ -0,0 +1,722 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Request is a GraphQL request as sent by clients.
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Response is the result of a request. Data is absent when the request failed before
+// execution started, i.e. on a syntax, validation or variable error.
+type Response struct {
+	Data   any      `json:"data,omitempty"`
+	Errors []*Error `json:"errors,omitempty"`
+}
+
+// Location is a position in the request document.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error is an error in a response. Path locates the field that failed; it is empty for
+// errors that prevented execution. Err is the error a resolver returned, if any.
+// Extensions carries additional details for clients, such as an error code.
+type Error struct {
+	Message    string         `json:"message"`
+	Locations  []Location     `json:"locations,omitempty"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+	Err        error          `json:"-"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Unwrap returns the resolver's error.
+func (e *Error) Unwrap() error { return e.Err }
+
+// Execute runs the query in req. Field errors do not fail the request: the field is
+// returned as null and the error is listed with its path, so clients still get the rest.
+// A non-null field that resolves to null is reported the same way rather than nulling its parent.
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	doc, err := Parse(req.Query)
+	if err != nil {
+		var syntax *SyntaxError
+		if errors.As(err, &syntax) {
+			return &Response{Errors: []*Error{{
+				Message:   "syntax error: " + syntax.Message,
+				Locations: []Location{{Line: syntax.Line, Column: syntax.Column}},
+			}}}
+		}
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+
+	e := &executor{
+		schema:    s,
+		doc:       doc,
+		vars:      make(map[string]any),
+		args:      make(map[*FieldSelection]Args),
+		used:      make(map[string]bool),
+		visiting:  make(map[string]bool),
+		validated: make(map[string]bool),
+	}
+	if err := e.coerceVariables(op, req.Variables); err != nil {
+		return &Response{Errors: []*Error{{Message: err.Error()}}}
+	}
+	e.validate(s.query, op.SelectionSet, 1)
+	for name := range doc.Fragments {
+		if !e.used[name] {
+			e.addError(&Error{Message: fmt.Sprintf("fragment %q is never used", name)})
+		}
+	}
+	if len(e.errors) > 0 {
+		return &Response{Errors: e.errors}
+	}
+
+	data := e.executeObjects(ctx, s.query, op.SelectionSet, []any{nil}, [][]any{nil})[0]
+	return &Response{Data: data, Errors: e.errors}
+}
+
+// selectOperation picks the operation to run: the one named name, or the only one.
+func selectOperation(doc *Document, name string) (*Operation, error) {
+	if name == "" {
+		if len(doc.Operations) > 1 {
+			return nil, fmt.Errorf("operationName is required when the document contains several operations")
+		}
+		return doc.Operations[0], nil
+	}
+	for _, op := range doc.Operations {
+		if op.Name == name {
+			return op, nil
+		}
+	}
+	return nil, fmt.Errorf("operation %q is not defined in the document", name)
+}
+
+// executor holds the state of one request.
+type executor struct {
+	schema *Schema
+	doc    *Document
+	vars   map[string]any           // Coerced values of the variables that were provided or defaulted
+	args   map[*FieldSelection]Args // Coerced field arguments, filled in by validate
+	errors []*Error
+
+	// Validation bookkeeping
+	used      map[string]bool // Fragments spread somewhere
+	visiting  map[string]bool // Fragments on the current spread chain, to detect cycles
+	validated map[string]bool // Fragment name@depth already checked
+	tooDeep   bool
+}
+
+func (e *executor) addError(err *Error) {
+	e.errors = append(e.errors, err)
+}
+
+func (e *executor) fieldError(message string, sel *FieldSelection, path []any) {
+	e.addError(&Error{Message: message, Locations: locate(sel), Path: path})
+}
+
+func locate(sel *FieldSelection) []Location {
+	if sel == nil || sel.Line == 0 {
+		return nil
+	}
+	return []Location{{Line: sel.Line, Column: sel.Column}}
+}
+
+// coerceVariables checks the provided variables against the operation's definitions.
+// Variables that were neither provided nor defaulted are left out of e.vars, so arguments
+// bound to them fall back to the argument default.
+func (e *executor) coerceVariables(op *Operation, provided map[string]any) error {
+	declared := make(map[string]bool)
+	for _, def := range op.Variables {
+		if declared[def.Name] {
+			return fmt.Errorf("variable $%s is declared more than once", def.Name)
+		}
+		declared[def.Name] = true
+
+		t, err := e.schema.inputType(def.Type)
+		if err != nil {
+			return fmt.Errorf("variable $%s: %w", def.Name, err)
+		}
+		raw, ok := provided[def.Name]
+		if !ok {
+			if def.Default != nil {
+				value, err := e.coerceLiteral(t, def.Default)
+				if err != nil {
+					return fmt.Errorf("variable $%s: default value: %w", def.Name, err)
+				}
+				e.vars[def.Name] = value
+			} else if _, required := t.(*NonNull); required {
+				return fmt.Errorf("variable $%s of required type %s was not provided", def.Name, t)
+			}
+			continue
+		}
+		value, err := coerceValue(t, raw)
+		if err != nil {
+			return fmt.Errorf("variable $%s: %w", def.Name, err)
+		}
+		e.vars[def.Name] = value
+	}
+
+	// Variables used but not declared are caught while coercing arguments; keep the declared set for that
+	for name := range declared {
+		if _, ok := e.vars[name]; !ok {
+			e.vars[name] = notProvided{}
+		}
+	}
+	return nil
+}
+
+// notProvided marks a declared variable that has no value.
+type notProvided struct{}
+
+// inputType resolves a type reference such as "[ID!]!" to an input type.
+func (s *Schema) inputType(ref string) (Type, error) {
+	if inner, ok := strings.CutSuffix(ref, "!"); ok {
+		t, err := s.inputType(inner)
+		if err != nil {
+			return nil, err
+		}
+		return NonNullOf(t), nil
+	}
+	if strings.HasPrefix(ref, "[") && strings.HasSuffix(ref, "]") {
+		t, err := s.inputType(ref[1 : len(ref)-1])
+		if err != nil {
+			return nil, err
+		}
+		return ListOf(t), nil
+	}
+	t, ok := s.types[ref]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", ref)
+	}
+	if _, isObject := t.(*Object); isObject {
+		return nil, fmt.Errorf("type %q is not an input type", ref)
+	}
+	return t, nil
+}
+
+// coerceValue converts a decoded JSON value to t.
+func coerceValue(t Type, v any) (any, error) {
+	switch t := t.(type) {
+	case *NonNull:
+		if v == nil {
+			return nil, fmt.Errorf("expected a value of type %s, found null", t)
+		}
+		return coerceValue(t.Of, v)
+	case *List:
+		if v == nil {
+			return nil, nil
+		}
+		items, ok := v.([]any)
+		if !ok {
+			// A single value is accepted as a list of one
+			item, err := coerceValue(t.Of, v)
+			if err != nil {
+				return nil, err
+			}
+			return []any{item}, nil
+		}
+		out := make([]any, len(items))
+		for i, item := range items {
+			value, err := coerceValue(t.Of, item)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			out[i] = value
+		}
+		return out, nil
+	case *Scalar:
+		if v == nil {
+			return nil, nil
+		}
+		return t.Parse(v)
+	}
+	return nil, fmt.Errorf("type %s is not an input type", t)
+}
+
+// coerceLiteral converts a value written in the document to t, substituting variables.
+func (e *executor) coerceLiteral(t Type, v Value) (any, error) {
+	if variable, ok := v.(Variable); ok {
+		value, declared := e.vars[string(variable)]
+		if !declared {
+			return nil, fmt.Errorf("variable $%s is not defined", variable)
+		}
+		if _, missing := value.(notProvided); missing {
+			value = nil
+		}
+		return coerceValue(t, value)
+	}
+
+	switch t := t.(type) {
+	case *NonNull:
+		if _, null := v.(NullValue); null {
+			return nil, fmt.Errorf("expected a value of type %s, found null", t)
+		}
+		return e.coerceLiteral(t.Of, v)
+	case *List:
+		switch v := v.(type) {
+		case NullValue:
+			return nil, nil
+		case ListValue:
+			out := make([]any, len(v))
+			for i, item := range v {
+				value, err := e.coerceLiteral(t.Of, item)
+				if err != nil {
+					return nil, fmt.Errorf("item %d: %w", i, err)
+				}
+				out[i] = value
+			}
+			return out, nil
+		}
+		item, err := e.coerceLiteral(t.Of, v)
+		if err != nil {
+			return nil, err
+		}
+		return []any{item}, nil
+	case *Scalar:
+		var raw any
+		switch v := v.(type) {
+		case NullValue:
+			return nil, nil
+		case IntValue:
+			raw = int64(v)
+		case FloatValue:
+			raw = float64(v)
+		case StringValue:
+			raw = string(v)
+		case BooleanValue:
+			raw = bool(v)
+		default:
+			return nil, fmt.Errorf("expected a value of type %s", t)
+		}
+		return t.Parse(raw)
+	}
+	return nil, fmt.Errorf("type %s is not an input type", t)
+}
+
+// coerceArgs checks the arguments of sel against f and applies defaults.
+func (e *executor) coerceArgs(f *Field, sel *FieldSelection) (Args, error) {
+	given := make(map[string]Value, len(sel.Arguments))
+	for _, a := range sel.Arguments {
+		if _, dup := given[a.Name]; dup {
+			return nil, fmt.Errorf("argument %q is given more than once", a.Name)
+		}
+		if !hasArg(f, a.Name) {
+			return nil, fmt.Errorf("unknown argument %q on field %q", a.Name, f.Name)
+		}
+		given[a.Name] = a.Value
+	}
+
+	args := make(Args, len(f.Args))
+	for _, def := range f.Args {
+		value, ok := given[def.Name]
+		if variable, isVariable := value.(Variable); isVariable {
+			if _, missing := e.vars[string(variable)].(notProvided); missing {
+				ok = false
+			}
+		}
+		if !ok {
+			if def.Default != nil {
+				args[def.Name] = def.Default
+			} else if _, required := def.Type.(*NonNull); required {
+				return nil, fmt.Errorf("argument %q of type %s is required", def.Name, def.Type)
+			}
+			continue
+		}
+		coerced, err := e.coerceLiteral(def.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", def.Name, err)
+		}
+		args[def.Name] = coerced
+	}
+	return args, nil
+}
+
+func hasArg(f *Field, name string) bool {
+	for _, a := range f.Args {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks a selection set on obj before anything is resolved: fields exist, leaves
+// and objects are selected correctly, arguments coerce, fragments apply and the depth limit holds.
+func (e *executor) validate(obj *Object, set []Selection, depth int) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *FieldSelection:
+			e.validateDirectives(sel.Directives, sel)
+			if sel.Name == "__typename" {
+				if len(sel.SelectionSet) > 0 {
+					e.fieldError(`field "__typename" must not have a selection`, sel, nil)
+				}
+				continue
+			}
+			if strings.HasPrefix(sel.Name, "__") {
+				e.fieldError("introspection is not supported; fetch the schema definition instead", sel, nil)
+				continue
+			}
+			f := obj.field(sel.Name)
+			if f == nil {
+				e.fieldError(fmt.Sprintf("cannot query field %q on type %q", sel.Name, obj.Name), sel, nil)
+				continue
+			}
+			if e.schema.maxDepth > 0 && depth > e.schema.maxDepth {
+				if !e.tooDeep {
+					e.tooDeep = true
+					e.fieldError(fmt.Sprintf("query is nested deeper than %d levels", e.schema.maxDepth), sel, nil)
+				}
+				continue
+			}
+			args, err := e.coerceArgs(f, sel)
+			if err != nil {
+				e.fieldError(err.Error(), sel, nil)
+			} else {
+				e.args[sel] = args
+			}
+			if object, ok := namedType(f.Type).(*Object); ok {
+				if len(sel.SelectionSet) == 0 {
+					e.fieldError(fmt.Sprintf("field %q of type %s must have a selection of subfields", sel.Name, f.Type), sel, nil)
+					continue
+				}
+				e.validate(object, sel.SelectionSet, depth+1)
+			} else if len(sel.SelectionSet) > 0 {
+				e.fieldError(fmt.Sprintf("field %q of type %s must not have a selection", sel.Name, f.Type), sel, nil)
+			}
+
+		case *FragmentSpread:
+			e.validateDirectives(sel.Directives, nil)
+			frag, ok := e.doc.Fragments[sel.Name]
+			if !ok {
+				e.addError(&Error{Message: fmt.Sprintf("unknown fragment %q", sel.Name)})
+				continue
+			}
+			e.used[sel.Name] = true
+			if frag.TypeCondition != obj.Name {
+				e.addError(&Error{Message: fmt.Sprintf("fragment %q on type %q cannot be spread within type %q", sel.Name, frag.TypeCondition, obj.Name)})
+				continue
+			}
+			if e.visiting[sel.Name] {
+				e.addError(&Error{Message: fmt.Sprintf("fragment %q spreads itself", sel.Name)})
+				continue
+			}
+			key := fmt.Sprintf("%s@%d", sel.Name, depth)
+			if e.validated[key] {
+				continue
+			}
+			e.validated[key] = true
+			e.visiting[sel.Name] = true
+			e.validate(obj, frag.SelectionSet, depth)
+			e.visiting[sel.Name] = false
+
+		case *InlineFragment:
+			e.validateDirectives(sel.Directives, nil)
+			if sel.TypeCondition != "" && sel.TypeCondition != obj.Name {
+				e.addError(&Error{Message: fmt.Sprintf("inline fragment on type %q cannot be used within type %q", sel.TypeCondition, obj.Name)})
+				continue
+			}
+			e.validate(obj, sel.SelectionSet, depth)
+		}
+	}
+}
+
+// validateDirectives accepts only @include(if:) and @skip(if:).
+func (e *executor) validateDirectives(directives []*Directive, sel *FieldSelection) {
+	for _, d := range directives {
+		if d.Name != "include" && d.Name != "skip" {
+			e.addError(&Error{Message: fmt.Sprintf("unknown directive @%s", d.Name), Locations: locate(sel)})
+			continue
+		}
+		if len(d.Arguments) != 1 || d.Arguments[0].Name != "if" {
+			e.addError(&Error{Message: fmt.Sprintf("directive @%s takes exactly one argument, if", d.Name), Locations: locate(sel)})
+			continue
+		}
+		if _, err := e.coerceLiteral(NonNullOf(Boolean), d.Arguments[0].Value); err != nil {
+			e.addError(&Error{Message: fmt.Sprintf("directive @%s: %v", d.Name, err), Locations: locate(sel)})
+		}
+	}
+}
+
+// included evaluates @include and @skip; validate has already checked them.
+func (e *executor) included(directives []*Directive) bool {
+	for _, d := range directives {
+		value, _ := e.coerceLiteral(NonNullOf(Boolean), d.Arguments[0].Value)
+		condition, _ := value.(bool)
+		if d.Name == "include" && !condition || d.Name == "skip" && condition {
+			return false
+		}
+	}
+	return true
+}
+
+// collectedField is a response key with the merged selections of every field selected under it.
+type collectedField struct {
+	key        string
+	field      *Field // nil for __typename
+	first      *FieldSelection
+	selections []Selection
+}
+
+// collectFields flattens fragments and merges fields selected more than once under the same key.
+func (e *executor) collectFields(obj *Object, set []Selection) []*collectedField {
+	var out []*collectedField
+	index := make(map[string]*collectedField)
+	visited := make(map[string]bool)
+
+	var collect func(set []Selection)
+	collect = func(set []Selection) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *FieldSelection:
+				if !e.included(sel.Directives) {
+					continue
+				}
+				key := sel.ResponseKey()
+				if cf, ok := index[key]; ok {
+					if cf.first.Name != sel.Name {
+						e.fieldError(fmt.Sprintf("fields %q and %q cannot both be returned as %q", cf.first.Name, sel.Name, key), sel, nil)
+						continue
+					}
+					cf.selections = append(cf.selections, sel.SelectionSet...)
+					continue
+				}
+				cf := &collectedField{
+					key:        key,
+					field:      obj.field(sel.Name),
+					first:      sel,
+					selections: append([]Selection(nil), sel.SelectionSet...),
+				}
+				index[key] = cf
+				out = append(out, cf)
+			case *FragmentSpread:
+				if !e.included(sel.Directives) || visited[sel.Name] {
+					continue
+				}
+				visited[sel.Name] = true
+				collect(e.doc.Fragments[sel.Name].SelectionSet)
+			case *InlineFragment:
+				if e.included(sel.Directives) {
+					collect(sel.SelectionSet)
+				}
+			}
+		}
+	}
+	collect(set)
+	return out
+}
+
+// executeObjects resolves set on every source at once. Each field is resolved for all
+// sources before moving on, and the next level is then completed in one pass, so a
+// Batch resolver runs once per level however many objects that level holds.
+func (e *executor) executeObjects(ctx context.Context, obj *Object, set []Selection, sources []any, paths [][]any) []*result {
+	results := make([]*result, len(sources))
+	for i := range results {
+		results[i] = &result{values: make(map[string]any)}
+	}
+
+	for _, cf := range e.collectFields(obj, set) {
+		if cf.field == nil {
+			for _, r := range results {
+				r.set(cf.key, obj.Name)
+			}
+			continue
+		}
+
+		fieldPaths := make([][]any, len(sources))
+		for i := range sources {
+			fieldPaths[i] = appendPath(paths[i], cf.key)
+		}
+		values, errs := e.resolve(ctx, cf.field, sources, e.args[cf.first])
+		failed := make([]bool, len(sources))
+		for i, err := range errs {
+			if err != nil {
+				e.addError(&Error{Message: err.Error(), Locations: locate(cf.first), Path: fieldPaths[i], Err: err})
+				values[i], failed[i] = nil, true
+			}
+		}
+
+		completed := e.complete(ctx, cf.field.Type, cf, values, fieldPaths, failed)
+		for i, r := range results {
+			r.set(cf.key, completed[i])
+		}
+	}
+	return results
+}
+
+// resolve calls the field's resolver for every source.
+func (e *executor) resolve(ctx context.Context, f *Field, sources []any, args Args) ([]any, []error) {
+	errs := make([]error, len(sources))
+	if f.Batch != nil {
+		values, err := f.Batch(ctx, sources, args)
+		if err == nil && len(values) != len(sources) {
+			err = fmt.Errorf("internal error: %s resolved %d values for %d objects", f.Name, len(values), len(sources))
+		}
+		if err != nil {
+			for i := range errs {
+				errs[i] = err
+			}
+			return make([]any, len(sources)), errs
+		}
+		return values, errs
+	}
+
+	values := make([]any, len(sources))
+	for i, source := range sources {
+		values[i], errs[i] = f.Resolve(ctx, source, args)
+	}
+	return values, errs
+}
+
+// complete serializes resolved values to type t. failed marks values whose resolver
+// already reported an error; it may be nil.
+func (e *executor) complete(ctx context.Context, t Type, cf *collectedField, values []any, paths [][]any, failed []bool) []any {
+	out := make([]any, len(values))
+	switch t := t.(type) {
+	case *NonNull:
+		out = e.complete(ctx, t.Of, cf, values, paths, failed)
+		for i, v := range values {
+			if isNull(v) && (failed == nil || !failed[i]) {
+				e.fieldError(fmt.Sprintf("cannot return null for non-nullable field %q", cf.first.Name), cf.first, paths[i])
+			}
+		}
+
+	case *Scalar:
+		for i, v := range values {
+			if isNull(v) {
+				continue
+			}
+			serialized, err := t.Serialize(v)
+			if err != nil {
+				e.fieldError(err.Error(), cf.first, paths[i])
+				continue
+			}
+			out[i] = serialized
+		}
+
+	case *List:
+		// Flatten every list into one level so nested objects are still resolved in one batch
+		var items []any
+		var itemPaths [][]any
+		spans := make([][2]int, len(values))
+		isList := make([]bool, len(values))
+		for i, v := range values {
+			if isNull(v) {
+				continue
+			}
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+				e.fieldError(fmt.Sprintf("internal error: %q resolved %T, expected a list", cf.first.Name, v), cf.first, paths[i])
+				continue
+			}
+			start := len(items)
+			for j := 0; j < rv.Len(); j++ {
+				items = append(items, rv.Index(j).Interface())
+				itemPaths = append(itemPaths, appendPath(paths[i], j))
+			}
+			spans[i] = [2]int{start, len(items)}
+			isList[i] = true
+		}
+		completed := e.complete(ctx, t.Of, cf, items, itemPaths, nil)
+		for i := range values {
+			if isList[i] {
+				list := make([]any, spans[i][1]-spans[i][0])
+				copy(list, completed[spans[i][0]:spans[i][1]])
+				out[i] = list
+			}
+		}
+
+	case *Object:
+		var sources []any
+		var sourcePaths [][]any
+		var positions []int
+		for i, v := range values {
+			if !isNull(v) {
+				sources = append(sources, v)
+				sourcePaths = append(sourcePaths, paths[i])
+				positions = append(positions, i)
+			}
+		}
+		if len(sources) > 0 {
+			objects := e.executeObjects(ctx, t, cf.selections, sources, sourcePaths)
+			for k, i := range positions {
+				out[i] = objects[k]
+			}
+		}
+	}
+	return out
+}
+
+// isNull reports whether v is nil or a nil pointer or map. A nil slice is an empty list, not null.
+func isNull(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+func appendPath(path []any, element any) []any {
+	out := make([]any, len(path)+1)
+	copy(out, path)
+	out[len(path)] = element
+	return out
+}
+
+// result is a response object. It keeps fields in selection order when marshalled,
+// as the GraphQL spec requires.
+type result struct {
+	keys   []string
+	values map[string]any
+}
+
+func (r *result) set(key string, value any) {
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.values[key] = value
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *result) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(r.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,385 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTeam struct {
+	ID, Name, City string
+	Founded        int
+	RivalID        string
+	Players        []testPlayer
+}
+
+type testPlayer struct {
+	Name   string
+	Number int
+}
+
+var testTeams = []*testTeam{
+	{ID: "1", Name: "Persija", City: "Jakarta", Founded: 1928, RivalID: "2", Players: []testPlayer{{"Bambang", 20}, {"Ismed", 7}, {"Riko", 9}}},
+	{ID: "2", Name: "Persib", Founded: 1933, RivalID: "1", Players: []testPlayer{{"Atep", 7}}},
+}
+
+func findTestTeam(id string) *testTeam {
+	for _, team := range testTeams {
+		if team.ID == id {
+			return team
+		}
+	}
+	return nil
+}
+
+// newTestSchema builds a small football schema. batches counts the calls of the players batch resolver.
+func newTestSchema(t *testing.T, batches *int) *Schema {
+	t.Helper()
+	player := &Object{Name: "Player", Fields: []*Field{
+		{Name: "name", Type: NonNullOf(String), Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(testPlayer).Name, nil
+		}},
+		{Name: "number", Type: NonNullOf(Int), Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(testPlayer).Number, nil
+		}},
+	}}
+
+	team := &Object{Name: "Team"}
+	team.Fields = []*Field{
+		{Name: "id", Type: NonNullOf(ID), Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*testTeam).ID, nil
+		}},
+		{Name: "name", Type: NonNullOf(String), Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*testTeam).Name, nil
+		}},
+		{Name: "city", Type: String, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			if city := src.(*testTeam).City; city != "" {
+				return city, nil
+			}
+			return nil, nil
+		}},
+		{Name: "founded", Type: Int, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return src.(*testTeam).Founded, nil
+		}},
+		{Name: "rival", Type: team, Resolve: func(_ context.Context, src any, _ Args) (any, error) {
+			return findTestTeam(src.(*testTeam).RivalID), nil
+		}},
+		{
+			Name: "players", Type: NonNullOf(ListOf(NonNullOf(player))),
+			Args: []*Arg{{Name: "limit", Type: Int, Default: 2}},
+			Batch: func(_ context.Context, sources []any, args Args) ([]any, error) {
+				*batches++
+				out := make([]any, len(sources))
+				for i, src := range sources {
+					players := src.(*testTeam).Players
+					out[i] = players[:min(args.Int("limit"), len(players))]
+				}
+				return out, nil
+			},
+		},
+		{Name: "stats", Type: String, Resolve: func(context.Context, any, Args) (any, error) {
+			return nil, errors.New("stats unavailable")
+		}},
+		{Name: "coach", Type: NonNullOf(String), Resolve: func(context.Context, any, Args) (any, error) {
+			return nil, nil
+		}},
+		{Name: "staff", Type: ListOf(String), Batch: func(context.Context, []any, Args) ([]any, error) {
+			return []any{}, nil
+		}},
+		{Name: "ratings", Type: ListOf(Int), Resolve: func(context.Context, any, Args) (any, error) {
+			return []any{1, "high", 3}, nil
+		}},
+	}
+
+	query := &Object{Name: "Query", Fields: []*Field{
+		{
+			Name: "teams", Type: NonNullOf(ListOf(NonNullOf(team))),
+			Args: []*Arg{{Name: "city", Type: String}, {Name: "ids", Type: ListOf(NonNullOf(ID))}},
+			Resolve: func(_ context.Context, _ any, args Args) (any, error) {
+				var out []*testTeam
+				for _, team := range testTeams {
+					ids, _ := args["ids"].([]any)
+					if city := args.String("city"); city != "" && team.City != city || ids != nil && !slices.Contains(ids, any(team.ID)) {
+						continue
+					}
+					out = append(out, team)
+				}
+				return out, nil
+			},
+		},
+		{
+			Name: "team", Type: team,
+			Args: []*Arg{{Name: "id", Type: NonNullOf(ID)}},
+			Resolve: func(_ context.Context, _ any, args Args) (any, error) {
+				if team := findTestTeam(args.String("id")); team != nil {
+					return team, nil
+				}
+				return nil, nil
+			},
+		},
+	}}
+
+	schema, err := NewSchema(query, 4)
+	require.NoError(t, err)
+	return schema
+}
+
+// execute runs req and returns the response as JSON.
+func execute(t *testing.T, schema *Schema, req Request) string {
+	t.Helper()
+	body, err := json.Marshal(schema.Execute(context.Background(), req))
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name string
+		req  Request
+		want string
+	}{
+		{
+			name: "nested selections",
+			req:  Request{Query: "{ teams { name founded players { name number } } }"},
+			want: `{"data":{"teams":[
+				{"name":"Persija","founded":1928,"players":[{"name":"Bambang","number":20},{"name":"Ismed","number":7}]},
+				{"name":"Persib","founded":1933,"players":[{"name":"Atep","number":7}]}]}}`,
+		},
+		{
+			name: "aliases and __typename",
+			req:  Request{Query: `{ home: team(id: "1") { __typename club: name } away: team(id: 2) { name } }`},
+			want: `{"data":{"home":{"__typename":"Team","club":"Persija"},"away":{"name":"Persib"}}}`,
+		},
+		{
+			name: "null object and nullable scalar",
+			req:  Request{Query: `{ team(id: "9") { name } other: team(id: "2") { city } }`},
+			want: `{"data":{"team":null,"other":{"city":null}}}`,
+		},
+		{
+			name: "recursive object",
+			req:  Request{Query: `{ team(id: "1") { rival { rival { name } } } }`},
+			want: `{"data":{"team":{"rival":{"rival":{"name":"Persija"}}}}}`,
+		},
+		{
+			name: "fragments",
+			req: Request{Query: `query { team(id: "1") { ...names ... on Team { founded } ... { city } } }
+				fragment names on Team { id ...short }
+				fragment short on Team { name }`},
+			want: `{"data":{"team":{"id":"1","name":"Persija","founded":1928,"city":"Jakarta"}}}`,
+		},
+		{
+			name: "fields selected twice are merged",
+			req:  Request{Query: `{ team(id: "1") { players(limit: 1) { name } ...more } } fragment more on Team { players(limit: 1) { number } }`},
+			want: `{"data":{"team":{"players":[{"name":"Bambang","number":20}]}}}`,
+		},
+		{
+			name: "variables",
+			req: Request{
+				Query:     `query ($id: ID!, $limit: Int) { team(id: $id) { players(limit: $limit) { name } } }`,
+				Variables: map[string]any{"id": "1", "limit": float64(1)},
+			},
+			want: `{"data":{"team":{"players":[{"name":"Bambang"}]}}}`,
+		},
+		{
+			name: "numeric variable accepted as ID",
+			req:  Request{Query: `query ($id: ID!) { team(id: $id) { name } }`, Variables: map[string]any{"id": float64(2)}},
+			want: `{"data":{"team":{"name":"Persib"}}}`,
+		},
+		{
+			name: "variable default",
+			req:  Request{Query: `query ($city: String = "Jakarta") { teams(city: $city) { name } }`},
+			want: `{"data":{"teams":[{"name":"Persija"}]}}`,
+		},
+		{
+			name: "omitted variable falls back to the argument default",
+			req:  Request{Query: `query ($limit: Int) { team(id: "1") { players(limit: $limit) { number } } }`},
+			want: `{"data":{"team":{"players":[{"number":20},{"number":7}]}}}`,
+		},
+		{
+			name: "single value accepted as a list",
+			req:  Request{Query: `query ($ids: [ID!]) { teams(ids: $ids) { name } byLiteral: teams(ids: "1") { name } }`, Variables: map[string]any{"ids": "2"}},
+			want: `{"data":{"teams":[{"name":"Persib"}],"byLiteral":[{"name":"Persija"}]}}`,
+		},
+		{
+			name: "include and skip",
+			req: Request{
+				Query:     `query ($full: Boolean!) { team(id: "1") { name city @include(if: $full) founded @skip(if: true) ...f @skip(if: $full) } } fragment f on Team { id }`,
+				Variables: map[string]any{"full": true},
+			},
+			want: `{"data":{"team":{"name":"Persija","city":"Jakarta"}}}`,
+		},
+		{
+			name: "named operation",
+			req:  Request{Query: `query A { team(id: "1") { name } } query B { team(id: "2") { name } }`, OperationName: "B"},
+			want: `{"data":{"team":{"name":"Persib"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches int
+			assert.JSONEq(t, tt.want, execute(t, newTestSchema(t, &batches), tt.req))
+		})
+	}
+}
+
+func TestExecute_FieldErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "resolver error nulls the field only",
+			query: `{ team(id: "1") { name stats } }`,
+			want: `{"data":{"team":{"name":"Persija","stats":null}},
+				"errors":[{"message":"stats unavailable","locations":[{"line":1,"column":24}],"path":["team","stats"]}]}`,
+		},
+		{
+			name:  "error paths include list indexes",
+			query: `{ teams { stats } }`,
+			want: `{"data":{"teams":[{"stats":null},{"stats":null}]},"errors":[
+				{"message":"stats unavailable","locations":[{"line":1,"column":11}],"path":["teams",0,"stats"]},
+				{"message":"stats unavailable","locations":[{"line":1,"column":11}],"path":["teams",1,"stats"]}]}`,
+		},
+		{
+			name:  "null for a non-null field",
+			query: `{ team(id: "2") { coach } }`,
+			want: `{"data":{"team":{"coach":null}},
+				"errors":[{"message":"cannot return null for non-nullable field \"coach\"","locations":[{"line":1,"column":19}],"path":["team","coach"]}]}`,
+		},
+		{
+			name:  "batch resolver returning the wrong number of values",
+			query: `{ team(id: "2") { staff } }`,
+			want: `{"data":{"team":{"staff":null}},
+				"errors":[{"message":"internal error: staff resolved 0 values for 1 objects","locations":[{"line":1,"column":19}],"path":["team","staff"]}]}`,
+		},
+		{
+			name:  "list item that does not serialize",
+			query: `{ team(id: "2") { ratings } }`,
+			want: `{"data":{"team":{"ratings":[1,null,3]}},
+				"errors":[{"message":"Int cannot represent string","locations":[{"line":1,"column":19}],"path":["team","ratings",1]}]}`,
+		},
+		{
+			name:  "conflicting fields under one response key",
+			query: `{ team(id: "1") { x: name x: city } }`,
+			want: `{"data":{"team":{"x":"Persija"}},
+				"errors":[{"message":"fields \"name\" and \"city\" cannot both be returned as \"x\"","locations":[{"line":1,"column":27}]}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches int
+			assert.JSONEq(t, tt.want, execute(t, newTestSchema(t, &batches), Request{Query: tt.query}))
+		})
+	}
+}
+
+func TestExecute_RequestErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       Request
+		wantError string
+	}{
+		{name: "syntax error", req: Request{Query: "{ team("}, wantError: `{"message":"syntax error: unexpected end of document","locations":[{"line":1,"column":8}]}`},
+		{name: "unknown field", req: Request{Query: "{ players { name } }"}, wantError: `{"message":"cannot query field \"players\" on type \"Query\"","locations":[{"line":1,"column":3}]}`},
+		{name: "leaf with selection", req: Request{Query: `{ team(id: "1") { name { first } } }`}, wantError: `{"message":"field \"name\" of type String! must not have a selection","locations":[{"line":1,"column":19}]}`},
+		{name: "object without selection", req: Request{Query: `{ team(id: "1") }`}, wantError: `{"message":"field \"team\" of type Team must have a selection of subfields","locations":[{"line":1,"column":3}]}`},
+		{name: "typename with selection", req: Request{Query: `{ __typename { a } }`}, wantError: `{"message":"field \"__typename\" must not have a selection","locations":[{"line":1,"column":3}]}`},
+		{name: "introspection", req: Request{Query: `{ __schema { types { name } } }`}, wantError: `{"message":"introspection is not supported; fetch the schema definition instead","locations":[{"line":1,"column":3}]}`},
+		{name: "unknown argument", req: Request{Query: `{ team(name: "x") { id } }`}, wantError: `{"message":"unknown argument \"name\" on field \"team\"","locations":[{"line":1,"column":3}]}`},
+		{name: "duplicate argument", req: Request{Query: `{ team(id: "1", id: "2") { id } }`}, wantError: `{"message":"argument \"id\" is given more than once","locations":[{"line":1,"column":3}]}`},
+		{name: "missing required argument", req: Request{Query: `{ team { id } }`}, wantError: `{"message":"argument \"id\" of type ID! is required","locations":[{"line":1,"column":3}]}`},
+		{name: "argument of the wrong type", req: Request{Query: `{ teams(city: 5) { id } }`}, wantError: `{"message":"argument \"city\": String cannot represent a non-string value","locations":[{"line":1,"column":3}]}`},
+		{name: "enum for a scalar", req: Request{Query: `{ teams(city: JAKARTA) { id } }`}, wantError: `{"message":"argument \"city\": expected a value of type String","locations":[{"line":1,"column":3}]}`},
+		{name: "null for a required argument", req: Request{Query: `{ team(id: null) { id } }`}, wantError: `{"message":"argument \"id\": expected a value of type ID!, found null","locations":[{"line":1,"column":3}]}`},
+		{name: "undeclared variable", req: Request{Query: `{ team(id: $id) { id } }`}, wantError: `{"message":"argument \"id\": variable $id is not defined","locations":[{"line":1,"column":3}]}`},
+		{name: "required variable not provided", req: Request{Query: `query ($id: ID!) { team(id: $id) { id } }`}, wantError: `{"message":"variable $id of required type ID! was not provided"}`},
+		{name: "variable of the wrong type", req: Request{Query: `query ($limit: Int) { teams { players(limit: $limit) { name } } }`, Variables: map[string]any{"limit": 1.5}}, wantError: `{"message":"variable $limit: Int cannot represent non-integer value 1.5"}`},
+		{name: "variable list item of the wrong type", req: Request{Query: `query ($ids: [ID!]) { teams(ids: $ids) { id } }`, Variables: map[string]any{"ids": []any{"1", nil}}}, wantError: `{"message":"variable $ids: item 1: expected a value of type ID!, found null"}`},
+		{name: "variable of an object type", req: Request{Query: `query ($t: Team) { teams { id } }`}, wantError: `{"message":"variable $t: type \"Team\" is not an input type"}`},
+		{name: "variable of an unknown type", req: Request{Query: `query ($t: Club) { teams { id } }`}, wantError: `{"message":"variable $t: unknown type \"Club\""}`},
+		{name: "variable declared twice", req: Request{Query: `query ($a: Int, $a: Int) { teams { id } }`}, wantError: `{"message":"variable $a is declared more than once"}`},
+		{name: "bad variable default", req: Request{Query: `query ($a: Int = "x") { teams { id } }`}, wantError: `{"message":"variable $a: default value: Int cannot represent string"}`},
+		{name: "unknown fragment", req: Request{Query: `{ teams { ...missing } }`}, wantError: `{"message":"unknown fragment \"missing\""}`},
+		{name: "unused fragment", req: Request{Query: `{ teams { id } } fragment f on Team { id }`}, wantError: `{"message":"fragment \"f\" is never used"}`},
+		{name: "fragment on another type", req: Request{Query: `{ teams { ...p } } fragment p on Player { name }`}, wantError: `{"message":"fragment \"p\" on type \"Player\" cannot be spread within type \"Team\""}`},
+		{name: "inline fragment on another type", req: Request{Query: `{ teams { ... on Player { name } } }`}, wantError: `{"message":"inline fragment on type \"Player\" cannot be used within type \"Team\""}`},
+		{name: "fragment cycle", req: Request{Query: `{ teams { ...a } } fragment a on Team { ...b } fragment b on Team { ...a }`}, wantError: `{"message":"fragment \"a\" spreads itself"}`},
+		{name: "unknown directive", req: Request{Query: `{ teams { id @deprecated } }`}, wantError: `{"message":"unknown directive @deprecated","locations":[{"line":1,"column":11}]}`},
+		{name: "directive without if", req: Request{Query: `{ teams { id @skip(when: true) } }`}, wantError: `{"message":"directive @skip takes exactly one argument, if","locations":[{"line":1,"column":11}]}`},
+		{name: "too deep", req: Request{Query: `{ teams { rival { rival { rival { name } } } } }`}, wantError: `{"message":"query is nested deeper than 4 levels","locations":[{"line":1,"column":35}]}`},
+		{name: "operation name required", req: Request{Query: `query A { teams { id } } query B { teams { id } }`}, wantError: `{"message":"operationName is required when the document contains several operations"}`},
+		{name: "unknown operation", req: Request{Query: `query A { teams { id } }`, OperationName: "B"}, wantError: `{"message":"operation \"B\" is not defined in the document"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches int
+			// Nothing is executed, so there is no data
+			assert.JSONEq(t, `{"errors":[`+tt.wantError+`]}`, execute(t, newTestSchema(t, &batches), tt.req))
+			assert.Zero(t, batches)
+		})
+	}
+}
+
+func TestExecute_BatchesEachLevelOnce(t *testing.T) {
+	var batches int
+	schema := newTestSchema(t, &batches)
+
+	body := execute(t, schema, Request{Query: `{ teams { players { name } rival { players { number } } } }`})
+
+	assert.NotContains(t, body, `"errors"`)
+	// One call for the teams' players and one for the rivals' players, not one per team
+	assert.Equal(t, 2, batches)
+}
+
+func TestExecute_KeepsSelectionOrder(t *testing.T) {
+	var batches int
+	body := execute(t, newTestSchema(t, &batches), Request{Query: `{ team(id: "1") { name id founded city } }`})
+
+	assert.Equal(t, `{"data":{"team":{"name":"Persija","id":"1","founded":1928,"city":"Jakarta"}}}`, body)
+}
+
+func TestNewSchema_Errors(t *testing.T) {
+	resolve := func(context.Context, any, Args) (any, error) { return nil, nil }
+	other := &Object{Name: "Team", Fields: []*Field{{Name: "id", Type: ID, Resolve: resolve}}}
+
+	tests := []struct {
+		name    string
+		fields  []*Field
+		wantErr string
+	}{
+		{
+			name:    "no resolver",
+			fields:  []*Field{{Name: "teams", Type: String}},
+			wantErr: "field Query.teams needs exactly one of Resolve and Batch",
+		},
+		{
+			name:    "object argument",
+			fields:  []*Field{{Name: "teams", Type: String, Resolve: resolve, Args: []*Arg{{Name: "team", Type: other}}}},
+			wantErr: "argument Query.teams(team) must be an input type",
+		},
+		{
+			name: "two types with one name",
+			fields: []*Field{
+				{Name: "a", Type: other, Resolve: resolve},
+				{Name: "b", Type: &Object{Name: "Team", Fields: []*Field{{Name: "id", Type: ID, Resolve: resolve}}}, Resolve: resolve},
+			},
+			wantErr: "two different types are named Team",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSchema(&Object{Name: "Query", Fields: tt.fields}, 0)
+			assert.EqualError(t, err, "graphql: "+tt.wantErr)
+		})
+	}
+}
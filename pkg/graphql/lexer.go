@@ -0,0 +1,242 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind         tokenKind
+	text         string // Punctuator, name, number literal or decoded string
+	line, column int
+}
+
+// lexer splits a document into tokens, skipping whitespace, commas and comments.
+type lexer struct {
+	src          string
+	pos          int
+	line, column int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1, column: 1}
+}
+
+func (l *lexer) errorf(format string, args ...any) error {
+	return &SyntaxError{Message: fmt.Sprintf(format, args...), Line: l.line, Column: l.column}
+}
+
+func (l *lexer) advance(n int) {
+	for i := 0; i < n && l.pos < len(l.src); i++ {
+		if l.src[l.pos] == '\n' {
+			l.line++
+			l.column = 1
+		} else {
+			l.column++
+		}
+		l.pos++
+	}
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, line: l.line, column: l.column}, nil
+	}
+
+	start := token{line: l.line, column: l.column}
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "..."):
+		l.advance(3)
+		start.kind, start.text = tokenPunct, "..."
+		return start, nil
+	case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+		l.advance(1)
+		start.kind, start.text = tokenPunct, string(c)
+		return start, nil
+	case c == '_' || isLetter(c):
+		end := l.pos
+		for end < len(l.src) && (l.src[end] == '_' || isLetter(l.src[end]) || isDigit(l.src[end])) {
+			end++
+		}
+		start.kind, start.text = tokenName, l.src[l.pos:end]
+		l.advance(end - l.pos)
+		return start, nil
+	case c == '-' || isDigit(c):
+		return l.number(start)
+	case c == '"':
+		return l.string(start)
+	}
+	return token{}, l.errorf("unexpected character %q", c)
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.advance(1)
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.advance(1)
+			}
+		case strings.HasPrefix(l.src[l.pos:], "\ufeff"): // byte order mark
+			l.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) number(t token) (token, error) {
+	end := l.pos
+	if l.src[end] == '-' {
+		end++
+	}
+	digits := func() {
+		for end < len(l.src) && isDigit(l.src[end]) {
+			end++
+		}
+	}
+	intStart := end
+	digits()
+	if end == intStart {
+		return token{}, l.errorf("invalid number")
+	}
+	t.kind = tokenInt
+	if end < len(l.src) && l.src[end] == '.' {
+		end++
+		fracStart := end
+		digits()
+		if end == fracStart {
+			return token{}, l.errorf("invalid number")
+		}
+		t.kind = tokenFloat
+	}
+	if end < len(l.src) && (l.src[end] == 'e' || l.src[end] == 'E') {
+		end++
+		if end < len(l.src) && (l.src[end] == '+' || l.src[end] == '-') {
+			end++
+		}
+		expStart := end
+		digits()
+		if end == expStart {
+			return token{}, l.errorf("invalid number")
+		}
+		t.kind = tokenFloat
+	}
+	t.text = l.src[l.pos:end]
+	l.advance(end - l.pos)
+	return t, nil
+}
+
+func (l *lexer) string(t token) (token, error) {
+	t.kind = tokenString
+	if strings.HasPrefix(l.src[l.pos:], `"""`) {
+		// The block ends at the first """ that is not escaped as \"""
+		for end := l.pos + 3; end < len(l.src); end++ {
+			if strings.HasPrefix(l.src[end:], `\"""`) {
+				end += 3
+				continue
+			}
+			if strings.HasPrefix(l.src[end:], `"""`) {
+				t.text = blockStringValue(l.src[l.pos+3 : end])
+				l.advance(end + 3 - l.pos)
+				return t, nil
+			}
+		}
+		return token{}, l.errorf("unterminated block string")
+	}
+
+	l.advance(1)
+	var b strings.Builder
+	for {
+		if l.pos >= len(l.src) || l.src[l.pos] == '\n' {
+			return token{}, l.errorf("unterminated string")
+		}
+		c := l.src[l.pos]
+		if c == '"' {
+			l.advance(1)
+			t.text = b.String()
+			return t, nil
+		}
+		if c != '\\' {
+			r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+			b.WriteRune(r)
+			l.advance(size)
+			continue
+		}
+		if l.pos+1 >= len(l.src) {
+			return token{}, l.errorf("unterminated string")
+		}
+		switch esc := l.src[l.pos+1]; esc {
+		case '"', '\\', '/':
+			b.WriteByte(esc)
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if l.pos+6 > len(l.src) {
+				return token{}, l.errorf("invalid unicode escape")
+			}
+			var r rune
+			if _, err := fmt.Sscanf(l.src[l.pos+2:l.pos+6], "%04x", &r); err != nil {
+				return token{}, l.errorf("invalid unicode escape")
+			}
+			b.WriteRune(r)
+			l.advance(4)
+		default:
+			return token{}, l.errorf("invalid escape sequence \\%c", esc)
+		}
+		l.advance(2)
+	}
+}
+
+// blockStringValue removes the common indentation and surrounding blank lines of a block string.
+func blockStringValue(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(raw, `\"""`, `"""`), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	for i := 1; i < len(lines) && indent > 0; i++ {
+		if len(lines[i]) >= indent {
+			lines[i] = lines[i][indent:]
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
@@ -0,0 +1,125 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lexAll returns every token of src up to, not including, the end of the document.
+func lexAll(src string) ([]token, error) {
+	l := newLexer(src)
+	var tokens []token
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenEOF {
+			return tokens, nil
+		}
+		tokens = append(tokens, tok)
+	}
+}
+
+func TestLexer(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []token
+	}{
+		{
+			name: "punctuators and names",
+			src:  "query($id:ID!){...on Team}",
+			want: []token{
+				{kind: tokenName, text: "query"}, {kind: tokenPunct, text: "("}, {kind: tokenPunct, text: "$"},
+				{kind: tokenName, text: "id"}, {kind: tokenPunct, text: ":"}, {kind: tokenName, text: "ID"},
+				{kind: tokenPunct, text: "!"}, {kind: tokenPunct, text: ")"}, {kind: tokenPunct, text: "{"},
+				{kind: tokenPunct, text: "..."}, {kind: tokenName, text: "on"}, {kind: tokenName, text: "Team"},
+				{kind: tokenPunct, text: "}"},
+			},
+		},
+		{
+			name: "commas, comments and byte order mark are ignored",
+			src:  "\ufeff a, # comment , c\n b,\t_c1",
+			want: []token{{kind: tokenName, text: "a"}, {kind: tokenName, text: "b"}, {kind: tokenName, text: "_c1"}},
+		},
+		{
+			name: "numbers",
+			src:  "0 -12 3.5 -0.25 1e3 2.5E-2 6e+1",
+			want: []token{
+				{kind: tokenInt, text: "0"}, {kind: tokenInt, text: "-12"}, {kind: tokenFloat, text: "3.5"},
+				{kind: tokenFloat, text: "-0.25"}, {kind: tokenFloat, text: "1e3"}, {kind: tokenFloat, text: "2.5E-2"},
+				{kind: tokenFloat, text: "6e+1"},
+			},
+		},
+		{
+			name: "string escapes",
+			src:  `"a\"b\\c\/d\n\té ✓"`,
+			want: []token{{kind: tokenString, text: "a\"b\\c/d\n\té ✓"}},
+		},
+		{
+			name: "empty string",
+			src:  `""`,
+			want: []token{{kind: tokenString, text: ""}},
+		},
+		{
+			name: "block string drops common indentation and blank edges",
+			src:  "\"\"\"\n    Top scorers\n      of the season\n    with \\\"\"\" quotes\n  \"\"\"",
+			want: []token{{kind: tokenString, text: "Top scorers\n  of the season\nwith \"\"\" quotes"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := lexAll(tt.src)
+			require.NoError(t, err)
+			for i := range tokens {
+				tokens[i].line, tokens[i].column = 0, 0
+			}
+			assert.Equal(t, tt.want, tokens)
+		})
+	}
+}
+
+func TestLexer_Positions(t *testing.T) {
+	tokens, err := lexAll("{\n  team {\n    name\n  }\n}")
+	require.NoError(t, err)
+
+	var positions [][2]int
+	for _, tok := range tokens {
+		positions = append(positions, [2]int{tok.line, tok.column})
+	}
+	assert.Equal(t, [][2]int{{1, 1}, {2, 3}, {2, 8}, {3, 5}, {4, 3}, {5, 1}}, positions)
+}
+
+func TestLexer_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{name: "unexpected character", src: "{ team % }", wantErr: "syntax error at 1:8: unexpected character '%'"},
+		{name: "minus without digits", src: "- 1", wantErr: "syntax error at 1:1: invalid number"},
+		{name: "fraction without digits", src: "1.", wantErr: "invalid number"},
+		{name: "exponent without digits", src: "1e+", wantErr: "invalid number"},
+		{name: "unterminated string", src: `"abc`, wantErr: "unterminated string"},
+		{name: "line break in string", src: "\"ab\ncd\"", wantErr: "syntax error at 1:4: unterminated string"},
+		{name: "trailing backslash", src: `"ab\`, wantErr: "unterminated string"},
+		{name: "invalid escape", src: `"\x41"`, wantErr: `invalid escape sequence \x`},
+		{name: "short unicode escape", src: `"\u12"`, wantErr: "invalid unicode escape"},
+		{name: "bad unicode escape", src: `"\uzzzz"`, wantErr: "invalid unicode escape"},
+		{name: "unterminated block string", src: `"""abc""`, wantErr: "unterminated block string"},
+		{name: "block string closed only by an escaped quote", src: `"""abc\"""`, wantErr: "unterminated block string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := lexAll(tt.src)
+			var syntax *SyntaxError
+			require.ErrorAs(t, err, &syntax)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SyntaxError reports a malformed document.
+type SyntaxError struct {
+	Message      string
+	Line, Column int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// maxNesting bounds how deeply selection sets and values may nest, so a hostile document
+// cannot exhaust the stack while being parsed.
+const maxNesting = 64
+
+// Parse parses a request document.
+func Parse(src string) (*Document, error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{Fragments: make(map[string]*Fragment)}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.peek(tokenPunct, "{"):
+			set, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, &Operation{SelectionSet: set})
+		case p.peek(tokenName, "query"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, op)
+		case p.peek(tokenName, "mutation"), p.peek(tokenName, "subscription"):
+			return nil, p.errorf("only query operations are supported")
+		case p.peek(tokenName, "fragment"):
+			frag, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := doc.Fragments[frag.Name]; dup {
+				return nil, p.errorf("fragment %q is defined more than once", frag.Name)
+			}
+			doc.Fragments[frag.Name] = frag
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	if len(doc.Operations) == 0 {
+		return nil, p.errorf("document contains no operation")
+	}
+	return doc, nil
+}
+
+type parser struct {
+	lex   *lexer
+	tok   token
+	depth int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &SyntaxError{Message: fmt.Sprintf(format, args...), Line: p.tok.line, Column: p.tok.column}
+}
+
+func (p *parser) unexpected() error {
+	if p.tok.kind == tokenEOF {
+		return p.errorf("unexpected end of document")
+	}
+	return p.errorf("unexpected %q", p.tok.text)
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) peek(kind tokenKind, text string) bool {
+	return p.tok.kind == kind && p.tok.text == text
+}
+
+// skip consumes the punctuator text if it is next and reports whether it did.
+func (p *parser) skip(text string) (bool, error) {
+	if !p.peek(tokenPunct, text) {
+		return false, nil
+	}
+	return true, p.advance()
+}
+
+func (p *parser) expect(text string) error {
+	if !p.peek(tokenPunct, text) {
+		if p.tok.kind == tokenEOF {
+			return p.errorf("expected %q, found end of document", text)
+		}
+		return p.errorf("expected %q, found %q", text, p.tok.text)
+	}
+	return p.advance()
+}
+
+func (p *parser) name() (string, error) {
+	if p.tok.kind != tokenName {
+		return "", p.unexpected()
+	}
+	name := p.tok.text
+	return name, p.advance()
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxNesting {
+		return p.errorf("document is nested too deeply")
+	}
+	return nil
+}
+
+func (p *parser) operation() (*Operation, error) {
+	if err := p.advance(); err != nil { // "query"
+		return nil, err
+	}
+	op := &Operation{}
+	if p.tok.kind == tokenName {
+		op.Name = p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if ok, err := p.skip("("); err != nil {
+		return nil, err
+	} else if ok {
+		for !p.peek(tokenPunct, ")") {
+			def, err := p.variableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.Variables = append(op.Variables, def)
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+
+	set, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.SelectionSet = set
+	return op, nil
+}
+
+func (p *parser) variableDefinition() (*VariableDefinition, error) {
+	if err := p.expect("$"); err != nil {
+		return nil, err
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	typ, err := p.typeRef()
+	if err != nil {
+		return nil, err
+	}
+	def := &VariableDefinition{Name: name, Type: typ}
+	if ok, err := p.skip("="); err != nil {
+		return nil, err
+	} else if ok {
+		if def.Default, err = p.value(true); err != nil {
+			return nil, err
+		}
+	}
+	return def, nil
+}
+
+// typeRef reads a type reference such as "[ID!]!" and returns it as written, without spaces.
+func (p *parser) typeRef() (string, error) {
+	var typ string
+	if ok, err := p.skip("["); err != nil {
+		return "", err
+	} else if ok {
+		inner, err := p.typeRef()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + inner + "]"
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return "", err
+		}
+		typ = name
+	}
+	if ok, err := p.skip("!"); err != nil {
+		return "", err
+	} else if ok {
+		typ += "!"
+	}
+	return typ, nil
+}
+
+func (p *parser) fragment() (*Fragment, error) {
+	if err := p.advance(); err != nil { // "fragment"
+		return nil, err
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if name == "on" {
+		return nil, p.errorf("fragment cannot be named \"on\"")
+	}
+	if !p.peek(tokenName, "on") {
+		return nil, p.errorf("expected \"on\" after fragment name")
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	frag := &Fragment{Name: name}
+	if frag.TypeCondition, err = p.name(); err != nil {
+		return nil, err
+	}
+	if frag.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if frag.SelectionSet, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+func (p *parser) selectionSet() ([]Selection, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var set []Selection
+	for !p.peek(tokenPunct, "}") {
+		sel, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, sel)
+	}
+	if len(set) == 0 {
+		return nil, p.errorf("selection set must not be empty")
+	}
+	return set, p.advance()
+}
+
+func (p *parser) selection() (Selection, error) {
+	if ok, err := p.skip("..."); err != nil {
+		return nil, err
+	} else if ok {
+		return p.fragmentSelection()
+	}
+
+	field := &FieldSelection{Line: p.tok.line, Column: p.tok.column}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := p.skip(":"); err != nil {
+		return nil, err
+	} else if ok {
+		field.Alias = name
+		if name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	field.Name = name
+
+	if field.Arguments, err = p.arguments(); err != nil {
+		return nil, err
+	}
+	if field.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if p.peek(tokenPunct, "{") {
+		if field.SelectionSet, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return field, nil
+}
+
+// fragmentSelection parses what follows "...": a fragment spread or an inline fragment.
+func (p *parser) fragmentSelection() (Selection, error) {
+	if p.tok.kind == tokenName && p.tok.text != "on" {
+		spread := &FragmentSpread{Name: p.tok.text}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		var err error
+		spread.Directives, err = p.directives()
+		return spread, err
+	}
+
+	inline := &InlineFragment{}
+	if p.peek(tokenName, "on") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		inline.TypeCondition = name
+	}
+	var err error
+	if inline.Directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if inline.SelectionSet, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return inline, nil
+}
+
+func (p *parser) arguments() ([]*Argument, error) {
+	if ok, err := p.skip("("); err != nil || !ok {
+		return nil, err
+	}
+	var args []*Argument
+	for !p.peek(tokenPunct, ")") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		val, err := p.value(false)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &Argument{Name: name, Value: val})
+	}
+	if len(args) == 0 {
+		return nil, p.errorf("argument list must not be empty")
+	}
+	return args, p.advance()
+}
+
+func (p *parser) directives() ([]*Directive, error) {
+	var dirs []*Directive
+	for p.peek(tokenPunct, "@") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, &Directive{Name: name, Arguments: args})
+	}
+	return dirs, nil
+}
+
+// value parses an input value. Variables are not allowed in constant contexts such as defaults.
+func (p *parser) value(constant bool) (Value, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	tok := p.tok
+	switch tok.kind {
+	case tokenInt:
+		n, err := strconv.ParseInt(tok.text, 10, 64)
+		if err != nil {
+			return nil, p.errorf("integer %s is out of range", tok.text)
+		}
+		return IntValue(n), p.advance()
+	case tokenFloat:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %s", tok.text)
+		}
+		return FloatValue(f), p.advance()
+	case tokenString:
+		return StringValue(tok.text), p.advance()
+	case tokenName:
+		var v Value
+		switch tok.text {
+		case "true", "false":
+			v = BooleanValue(tok.text == "true")
+		case "null":
+			v = NullValue{}
+		default:
+			v = EnumValue(tok.text)
+		}
+		return v, p.advance()
+	}
+
+	switch {
+	case p.peek(tokenPunct, "$"):
+		if constant {
+			return nil, p.errorf("variables are not allowed here")
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		return Variable(name), err
+	case p.peek(tokenPunct, "["):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		list := ListValue{}
+		for !p.peek(tokenPunct, "]") {
+			item, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		return list, p.advance()
+	case p.peek(tokenPunct, "{"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		obj := ObjectValue{}
+		for !p.peek(tokenPunct, "}") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			val, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, &Argument{Name: name, Value: val})
+		}
+		return obj, p.advance()
+	}
+	return nil, p.unexpected()
+}
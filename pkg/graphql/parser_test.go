@@ -0,0 +1,160 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want *Document
+	}{
+		{
+			name: "anonymous shorthand query",
+			src:  "{ teams { name } }",
+			want: &Document{
+				Operations: []*Operation{{SelectionSet: []Selection{
+					&FieldSelection{Name: "teams", Line: 1, Column: 3, SelectionSet: []Selection{
+						&FieldSelection{Name: "name", Line: 1, Column: 11},
+					}},
+				}}},
+				Fragments: map[string]*Fragment{},
+			},
+		},
+		{
+			name: "variables, aliases, arguments and directives",
+			src: `query Squad($id: ID!, $ids: [ID!]! = ["1"], $withPlayers: Boolean = true) {
+  home: team(id: $id) {
+    players(limit: 5, filter: {position: FW, numbers: [9, 10], rating: 7.5, active: true, note: null}) @include(if: $withPlayers) { name }
+  }
+}`,
+			want: &Document{
+				Operations: []*Operation{{
+					Name: "Squad",
+					Variables: []*VariableDefinition{
+						{Name: "id", Type: "ID!"},
+						{Name: "ids", Type: "[ID!]!", Default: ListValue{StringValue("1")}},
+						{Name: "withPlayers", Type: "Boolean", Default: BooleanValue(true)},
+					},
+					SelectionSet: []Selection{
+						&FieldSelection{
+							Alias: "home", Name: "team", Line: 2, Column: 3,
+							Arguments: []*Argument{{Name: "id", Value: Variable("id")}},
+							SelectionSet: []Selection{
+								&FieldSelection{
+									Name: "players", Line: 3, Column: 5,
+									Arguments: []*Argument{
+										{Name: "limit", Value: IntValue(5)},
+										{Name: "filter", Value: ObjectValue{
+											{Name: "position", Value: EnumValue("FW")},
+											{Name: "numbers", Value: ListValue{IntValue(9), IntValue(10)}},
+											{Name: "rating", Value: FloatValue(7.5)},
+											{Name: "active", Value: BooleanValue(true)},
+											{Name: "note", Value: NullValue{}},
+										}},
+									},
+									Directives:   []*Directive{{Name: "include", Arguments: []*Argument{{Name: "if", Value: Variable("withPlayers")}}}},
+									SelectionSet: []Selection{&FieldSelection{Name: "name", Line: 3, Column: 133}},
+								},
+							},
+						},
+					},
+				}},
+				Fragments: map[string]*Fragment{},
+			},
+		},
+		{
+			name: "fragments",
+			src: `query { team(id: 1) { ...teamFields @skip(if: false) ... on Team { city } ... { id } } }
+fragment teamFields on Team { name }`,
+			want: &Document{
+				Operations: []*Operation{{SelectionSet: []Selection{
+					&FieldSelection{
+						Name: "team", Line: 1, Column: 9,
+						Arguments: []*Argument{{Name: "id", Value: IntValue(1)}},
+						SelectionSet: []Selection{
+							&FragmentSpread{Name: "teamFields", Directives: []*Directive{{Name: "skip", Arguments: []*Argument{{Name: "if", Value: BooleanValue(false)}}}}},
+							&InlineFragment{TypeCondition: "Team", SelectionSet: []Selection{&FieldSelection{Name: "city", Line: 1, Column: 68}}},
+							&InlineFragment{SelectionSet: []Selection{&FieldSelection{Name: "id", Line: 1, Column: 81}}},
+						},
+					},
+				}}},
+				Fragments: map[string]*Fragment{
+					"teamFields": {Name: "teamFields", TypeCondition: "Team", SelectionSet: []Selection{&FieldSelection{Name: "name", Line: 2, Column: 31}}},
+				},
+			},
+		},
+		{
+			name: "several named operations",
+			src:  "query A { a } query B { b }",
+			want: &Document{
+				Operations: []*Operation{
+					{Name: "A", SelectionSet: []Selection{&FieldSelection{Name: "a", Line: 1, Column: 11}}},
+					{Name: "B", SelectionSet: []Selection{&FieldSelection{Name: "b", Line: 1, Column: 25}}},
+				},
+				Fragments: map[string]*Fragment{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.src)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, doc)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{name: "empty document", src: "  # nothing here", wantErr: "1:17: document contains no operation"},
+		{name: "only fragments", src: "fragment f on Team { name }", wantErr: "document contains no operation"},
+		{name: "mutation", src: "mutation { deleteTeam(id: 1) }", wantErr: "1:1: only query operations are supported"},
+		{name: "subscription", src: "subscription { goals { minute } }", wantErr: "only query operations are supported"},
+		{name: "unexpected top-level token", src: "team { name }", wantErr: `1:1: unexpected "team"`},
+		{name: "unclosed selection set", src: "{ teams { name }", wantErr: "unexpected end of document"},
+		{name: "empty selection set", src: "{ teams { } }", wantErr: "1:11: selection set must not be empty"},
+		{name: "empty argument list", src: "{ team() { name } }", wantErr: "argument list must not be empty"},
+		{name: "argument without colon", src: "{ team(id 1) { name } }", wantErr: `expected ":", found "1"`},
+		{name: "variable without type", src: "query ($id) { team }", wantErr: `expected ":", found ")"`},
+		{name: "variable in default value", src: "query ($a: Int = $b) { team }", wantErr: "variables are not allowed here"},
+		{name: "unclosed list type", src: "query ($a: [Int) { team }", wantErr: `expected "]", found ")"`},
+		{name: "fragment named on", src: "fragment on on Team { name }", wantErr: `fragment cannot be named "on"`},
+		{name: "fragment without type condition", src: "fragment f Team { name } { a }", wantErr: `expected "on" after fragment name`},
+		{name: "duplicate fragment", src: "{ a } fragment f on T { a } fragment f on T { b }", wantErr: `fragment "f" is defined more than once`},
+		{name: "integer out of range", src: "{ team(id: 99999999999999999999) { name } }", wantErr: "integer 99999999999999999999 is out of range"},
+		{name: "missing value", src: "{ team(id: ) { name } }", wantErr: `unexpected ")"`},
+		{name: "lexer error is passed on", src: "{ team ? }", wantErr: "unexpected character '?'"},
+		{name: "selection nested too deeply", src: deepSelection(maxNesting + 1), wantErr: "document is nested too deeply"},
+		{name: "value nested too deeply", src: "{ a(x: " + strings.Repeat("[", maxNesting+1) + ") }", wantErr: "document is nested too deeply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.src)
+			var syntax *SyntaxError
+			require.ErrorAs(t, err, &syntax)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestParse_NestingLimit(t *testing.T) {
+	_, err := Parse(deepSelection(maxNesting))
+	assert.NoError(t, err)
+}
+
+// deepSelection returns a query whose selection sets nest depth levels deep.
+func deepSelection(depth int) string {
+	return strings.Repeat("{ a ", depth-1) + "{ a }" + strings.Repeat(" }", depth-1)
+}
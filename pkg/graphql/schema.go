@@ -0,0 +1,360 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type is a GraphQL type: *Scalar, *Object, *List or *NonNull.
+type Type interface {
+	String() string
+}
+
+// Scalar is a leaf type. Serialize converts a resolved Go value to its JSON form;
+// Parse converts an input (a decoded JSON variable or a literal from the document) to Go.
+type Scalar struct {
+	Name        string
+	Description string
+	Serialize   func(value any) (any, error)
+	Parse       func(value any) (any, error)
+}
+
+func (s *Scalar) String() string { return s.Name }
+
+// Object is a type with fields. Fields may refer back to the object itself or to
+// objects defined later, so they can be assigned after the objects are created.
+type Object struct {
+	Name        string
+	Description string
+	Fields      []*Field
+}
+
+func (o *Object) String() string { return o.Name }
+
+// field returns the field named name, or nil.
+func (o *Object) field(name string) *Field {
+	for _, f := range o.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// List is a list of another type.
+type List struct {
+	Of Type
+}
+
+func (l *List) String() string { return "[" + l.Of.String() + "]" }
+
+// NonNull marks a type whose values are never null.
+type NonNull struct {
+	Of Type
+}
+
+func (n *NonNull) String() string { return n.Of.String() + "!" }
+
+// ListOf returns a list type of t.
+func ListOf(t Type) *List { return &List{Of: t} }
+
+// NonNullOf returns the non-null variant of t.
+func NonNullOf(t Type) *NonNull { return &NonNull{Of: t} }
+
+// Field is a field of an Object. Exactly one of Resolve and Batch must be set.
+//
+// Batch is how N+1 queries are avoided: fields are resolved level by level, so a field
+// with a Batch resolver is called once with every parent at that level (for example all
+// teams of a list when selecting their players) and returns one value per source, in order.
+type Field struct {
+	Name        string
+	Description string
+	Type        Type
+	Args        []*Arg
+	Resolve     func(ctx context.Context, source any, args Args) (any, error)
+	Batch       func(ctx context.Context, sources []any, args Args) ([]any, error)
+}
+
+// Arg is a field argument. A non-null argument without a default is required.
+type Arg struct {
+	Name        string
+	Description string
+	Type        Type
+	Default     any
+}
+
+// Args holds the coerced argument values of a field: string, int, float64, bool, []any or nil.
+type Args map[string]any
+
+// String returns the string argument name, or "" if it is unset or null.
+func (a Args) String(name string) string {
+	s, _ := a[name].(string)
+	return s
+}
+
+// Int returns the integer argument name, or 0 if it is unset or null.
+func (a Args) Int(name string) int {
+	n, _ := a[name].(int)
+	return n
+}
+
+// Bool returns the boolean argument name, or false if it is unset or null.
+func (a Args) Bool(name string) bool {
+	b, _ := a[name].(bool)
+	return b
+}
+
+// Built-in scalars.
+var (
+	Int = &Scalar{
+		Name:        "Int",
+		Description: "A signed 32-bit integer.",
+		Serialize:   serializeInt,
+		Parse:       serializeInt,
+	}
+	Float = &Scalar{
+		Name:        "Float",
+		Description: "A double-precision floating-point number.",
+		Serialize:   parseFloat,
+		Parse:       parseFloat,
+	}
+	String = &Scalar{
+		Name:        "String",
+		Description: "A UTF-8 string.",
+		Serialize:   serializeString,
+		Parse:       parseString,
+	}
+	Boolean = &Scalar{
+		Name:        "Boolean",
+		Description: "true or false.",
+		Serialize:   parseBoolean,
+		Parse:       parseBoolean,
+	}
+	ID = &Scalar{
+		Name:        "ID",
+		Description: "A unique identifier, serialized as a string.",
+		Serialize:   serializeString,
+		Parse:       parseID,
+	}
+)
+
+func serializeInt(v any) (any, error) {
+	var n int64
+	switch v := v.(type) {
+	case int:
+		n = int64(v)
+	case int32:
+		n = int64(v)
+	case int64:
+		n = v
+	case float64:
+		if v != math.Trunc(v) {
+			return nil, fmt.Errorf("Int cannot represent non-integer value %v", v)
+		}
+		n = int64(v)
+	default:
+		return nil, fmt.Errorf("Int cannot represent %T", v)
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return nil, fmt.Errorf("Int cannot represent %d; it is out of 32-bit range", n)
+	}
+	return int(n), nil
+}
+
+func parseFloat(v any) (any, error) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	}
+	return nil, fmt.Errorf("Float cannot represent %T", v)
+}
+
+func serializeString(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	return nil, fmt.Errorf("String cannot represent %T", v)
+}
+
+func parseString(v any) (any, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("String cannot represent a non-string value")
+}
+
+func parseBoolean(v any) (any, error) {
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return nil, fmt.Errorf("Boolean cannot represent a non-boolean value")
+}
+
+func parseID(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		if v == math.Trunc(v) {
+			return strconv.FormatInt(int64(v), 10), nil
+		}
+	}
+	return nil, fmt.Errorf("ID cannot represent %T", v)
+}
+
+// Schema is an executable schema rooted at a query type.
+type Schema struct {
+	query    *Object
+	maxDepth int
+	types    map[string]Type // Named types reachable from the query type
+}
+
+// NewSchema validates the types reachable from query and returns the schema.
+// Queries nesting selections deeper than maxDepth are rejected; 0 means no limit.
+func NewSchema(query *Object, maxDepth int) (*Schema, error) {
+	s := &Schema{query: query, maxDepth: maxDepth, types: make(map[string]Type)}
+	for _, scalar := range []*Scalar{Int, Float, String, Boolean, ID} {
+		s.types[scalar.Name] = scalar
+	}
+	if err := s.register(query); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// register adds t and every type its fields refer to.
+func (s *Schema) register(t Type) error {
+	switch t := t.(type) {
+	case *List:
+		return s.register(t.Of)
+	case *NonNull:
+		return s.register(t.Of)
+	case *Scalar:
+		if existing, ok := s.types[t.Name]; ok && existing != t {
+			return fmt.Errorf("graphql: two different types are named %s", t.Name)
+		}
+		s.types[t.Name] = t
+	case *Object:
+		if existing, ok := s.types[t.Name]; ok {
+			if existing != t {
+				return fmt.Errorf("graphql: two different types are named %s", t.Name)
+			}
+			return nil
+		}
+		s.types[t.Name] = t
+		for _, f := range t.Fields {
+			if (f.Resolve == nil) == (f.Batch == nil) {
+				return fmt.Errorf("graphql: field %s.%s needs exactly one of Resolve and Batch", t.Name, f.Name)
+			}
+			if err := s.register(f.Type); err != nil {
+				return err
+			}
+			for _, a := range f.Args {
+				if _, isObject := namedType(a.Type).(*Object); isObject {
+					return fmt.Errorf("graphql: argument %s.%s(%s) must be an input type", t.Name, f.Name, a.Name)
+				}
+				if err := s.register(a.Type); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("graphql: unsupported type %T", t)
+	}
+	return nil
+}
+
+// namedType strips list and non-null wrappers.
+func namedType(t Type) Type {
+	for {
+		switch w := t.(type) {
+		case *List:
+			t = w.Of
+		case *NonNull:
+			t = w.Of
+		default:
+			return t
+		}
+	}
+}
+
+// SDL returns the schema in the GraphQL schema definition language, for client code generators
+// and as documentation. The query type comes first, then the other objects and custom scalars by name.
+func (s *Schema) SDL() string {
+	var names []string
+	for name, t := range s.types {
+		switch t := t.(type) {
+		case *Object:
+			if t != s.query {
+				names = append(names, name)
+			}
+		case *Scalar:
+			if !slices.Contains([]string{"Int", "Float", "String", "Boolean", "ID"}, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	writeObject(&b, s.query)
+	for _, name := range names {
+		b.WriteString("\n")
+		switch t := s.types[name].(type) {
+		case *Object:
+			writeObject(&b, t)
+		case *Scalar:
+			writeDescription(&b, "", t.Description)
+			fmt.Fprintf(&b, "scalar %s\n", t.Name)
+		}
+	}
+	return b.String()
+}
+
+func writeObject(b *strings.Builder, o *Object) {
+	writeDescription(b, "", o.Description)
+	fmt.Fprintf(b, "type %s {\n", o.Name)
+	for _, f := range o.Fields {
+		writeDescription(b, "  ", f.Description)
+		b.WriteString("  " + f.Name)
+		if len(f.Args) > 0 {
+			args := make([]string, len(f.Args))
+			for i, a := range f.Args {
+				args[i] = a.Name + ": " + a.Type.String()
+				if a.Default != nil {
+					args[i] += " = " + formatDefault(a.Default)
+				}
+			}
+			b.WriteString("(" + strings.Join(args, ", ") + ")")
+		}
+		fmt.Fprintf(b, ": %s\n", f.Type)
+	}
+	b.WriteString("}\n")
+}
+
+func writeDescription(b *strings.Builder, indent, description string) {
+	if description != "" {
+		fmt.Fprintf(b, "%s%s\n", indent, strconv.Quote(description))
+	}
+}
+
+func formatDefault(v any) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
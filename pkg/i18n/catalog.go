@@ -0,0 +1,143 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxDepth limits how deep arguments of formatted messages are translated in turn,
+// e.g. the "Goal #1" in "Goal #1: player not found".
+const maxDepth = 2
+
+// verb matches the fmt verbs catalog keys may contain. Translations may use explicit
+// argument indexes such as %[2]s to change the order of the arguments.
+var verb = regexp.MustCompile(`%(?:\[(\d+)\])?([sdqv])`)
+
+// catalog holds the translations of one language. Keys without verbs are looked up as they
+// are; keys with verbs are format strings, matched against messages built from them.
+type catalog struct {
+	messages map[string]string
+	patterns []pattern
+}
+
+// pattern is a format string key compiled to a regular expression capturing its arguments.
+type pattern struct {
+	re          *regexp.Regexp
+	verbs       []string // Verb of each argument, to tell strings (translated too) from numbers
+	translation string
+	literal     int // Length of the key without its verbs; longer keys are more specific
+}
+
+// newCatalog compiles the catalog of a language from its translations by English message.
+// It panics on a key or translation it cannot use, as catalogs are fixed at compile time.
+func newCatalog(translations map[string]string) *catalog {
+	c := &catalog{messages: map[string]string{}}
+	for key, translation := range translations {
+		verbs := verb.FindAllStringSubmatch(key, -1)
+		if len(verbs) == 0 {
+			c.messages[key] = translation
+			continue
+		}
+
+		p := pattern{translation: translation}
+		var expr strings.Builder
+		expr.WriteString("^")
+		for i, part := range verb.Split(key, -1) {
+			expr.WriteString(regexp.QuoteMeta(part))
+			p.literal += len(part)
+			if i == len(verbs) {
+				break
+			}
+			p.verbs = append(p.verbs, verbs[i][2])
+			switch verbs[i][2] {
+			case "d":
+				expr.WriteString(`(-?\d+)`)
+			case "q":
+				expr.WriteString(`("(?:[^"\\]|\\.)*")`)
+			default:
+				expr.WriteString(`(.+?)`)
+			}
+		}
+		expr.WriteString("$")
+		p.re = regexp.MustCompile(expr.String())
+
+		for _, m := range verb.FindAllStringSubmatch(translation, -1) {
+			if m[1] == "" {
+				continue
+			}
+			if n, _ := strconv.Atoi(m[1]); n < 1 || n > len(verbs) {
+				panic(fmt.Sprintf("i18n: translation of %q refers to argument %d of %d", key, n, len(verbs)))
+			}
+		}
+		c.patterns = append(c.patterns, p)
+	}
+
+	sort.Slice(c.patterns, func(i, j int) bool {
+		if c.patterns[i].literal != c.patterns[j].literal {
+			return c.patterns[i].literal > c.patterns[j].literal
+		}
+		return c.patterns[i].re.String() < c.patterns[j].re.String()
+	})
+	return c
+}
+
+// translate looks message up, first as it is, then against the format strings, most specific first.
+func (c *catalog) translate(message string, depth int) (string, bool) {
+	if translated, ok := c.messages[message]; ok {
+		return translated, true
+	}
+	for _, p := range c.patterns {
+		args := p.re.FindStringSubmatch(message)
+		if args == nil {
+			continue
+		}
+		args = args[1:]
+		for i, arg := range args {
+			if p.verbs[i] == "s" || p.verbs[i] == "v" {
+				args[i] = c.translateArg(arg, depth+1)
+			}
+		}
+		return p.format(args), true
+	}
+	return message, false
+}
+
+// translateArg translates an argument of a formatted message if the catalog knows it. Lists such
+// as "red card, 5 yellow cards" are translated item by item.
+func (c *catalog) translateArg(arg string, depth int) string {
+	if depth > maxDepth {
+		return arg
+	}
+	if translated, ok := c.translate(arg, depth); ok {
+		return translated
+	}
+	items := strings.Split(arg, ", ")
+	if len(items) == 1 {
+		return arg
+	}
+	for i, item := range items {
+		items[i], _ = c.translate(item, depth)
+	}
+	return strings.Join(items, ", ")
+}
+
+// format fills the verbs of the translation with the captured arguments, in order unless indexed.
+func (p pattern) format(args []string) string {
+	next := 0
+	return verb.ReplaceAllStringFunc(p.translation, func(v string) string {
+		index := next
+		if m := verb.FindStringSubmatch(v); m[1] != "" {
+			n, _ := strconv.Atoi(m[1])
+			index = n - 1
+		} else {
+			next++
+		}
+		if index >= len(args) {
+			return v
+		}
+		return args[index]
+	})
+}
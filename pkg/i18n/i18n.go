@@ -0,0 +1,86 @@
+// Package i18n translates the API's messages. Messages are written in English in the code;
+// the catalogs map them, or the format strings they were built from, to other languages.
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported languages, as primary language subtags of Accept-Language.
+const (
+	English    = "en"
+	Indonesian = "id"
+)
+
+// Default is the language of the messages in the code, used when the client accepts none of the others.
+const Default = English
+
+var catalogs = map[string]*catalog{
+	Indonesian: newCatalog(indonesian),
+}
+
+// Supported returns the languages messages are available in, the default first.
+func Supported() []string {
+	langs := []string{Default}
+	for lang := range catalogs {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs[1:])
+	return langs
+}
+
+// Negotiate picks the supported language the client prefers from an Accept-Language header
+// such as "id-ID,id;q=0.9,en;q=0.8". Regions are ignored; without a match it returns Default.
+func Negotiate(acceptLanguage string) string {
+	best, bestQ := Default, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if lang == "*" {
+			lang = Default
+		}
+		if q > bestQ && (lang == Default || catalogs[lang] != nil) {
+			best, bestQ = lang, q
+		}
+	}
+	return best
+}
+
+type contextKey struct{}
+
+// WithLanguage returns a copy of ctx carrying the language of the response.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// Language returns the language stored in ctx, or Default if there is none.
+func Language(ctx context.Context) string {
+	if lang, ok := ctx.Value(contextKey{}).(string); ok {
+		return lang
+	}
+	return Default
+}
+
+// Translate returns message in lang. Messages missing from the catalog are returned unchanged,
+// so an untranslated message is still readable.
+func Translate(lang, message string) string {
+	c, ok := catalogs[lang]
+	if !ok {
+		return message
+	}
+	if translated, ok := c.translate(message, 0); ok {
+		return translated
+	}
+	return message
+}
@@ -0,0 +1,396 @@
+package i18n
+
+// indonesian translates the API's messages to Bahasa Indonesia. Field names, enum values and
+// other parts of the API itself (team_id, scheduled, cascade=true) are kept as they are.
+var indonesian = map[string]string{
+	// Validation
+	"Validation failed":                      "Validasi gagal",
+	"%s is required":                         "%s wajib diisi",
+	"%s is invalid":                          "%s tidak valid",
+	"%s must be greater than %s":             "%s harus lebih besar dari %s",
+	"%s must be at least %s":                 "%s minimal %s",
+	"%s must be at most %s":                  "%s maksimal %s",
+	"%s must be at most 100 characters":      "%s maksimal 100 karakter",
+	"%s must be 0 or at least %s":            "%s harus 0 atau minimal %s",
+	"%s must be a valid URL":                 "%s harus berupa URL yang valid",
+	"%s must be a valid URL or empty":        "%s harus berupa URL yang valid atau kosong",
+	"%s must be a valid UUID":                "%s harus berupa UUID yang valid",
+	"%s must be a valid UUID or empty":       "%s harus berupa UUID yang valid atau kosong",
+	"%s must be one of: %s":                  "%s harus salah satu dari: %s",
+	"%s must be a date in YYYY-MM-DD format": "%s harus berupa tanggal dengan format YYYY-MM-DD",
+	"%s must be an ISO 8601 date and time such as 2025-06-15T19:30:00+07:00": "%s harus berupa tanggal dan waktu ISO 8601 seperti 2025-06-15T19:30:00+07:00",
+	"%s must be an ISO 3166-1 alpha-2 country code such as ID":               "%s harus berupa kode negara ISO 3166-1 alpha-2 seperti ID",
+	"%s must be an ISO 3166-1 alpha-2 country code such as ID, or empty":     "%s harus berupa kode negara ISO 3166-1 alpha-2 seperti ID, atau kosong",
+	"%s must be a match minute such as 67, or 90+3 in added time":            "%s harus berupa menit pertandingan seperti 67, atau 90+3 pada tambahan waktu",
+	"%s must be one of %s":                   "%s harus salah satu dari %s",
+	"%s must not be before %s":               "%s tidak boleh sebelum %s",
+	"%s must not be after %s":                "%s tidak boleh setelah %s",
+	"%s must not be greater than %s":         "%s tidak boleh lebih besar dari %s",
+	"Invalid %s format":                      "Format %s tidak valid",
+	"Invalid %s format, expected YYYY-MM-DD": "Format %s tidak valid, seharusnya YYYY-MM-DD",
+	"Invalid %s, expected YYYY-MM-DD":        "Nilai %s tidak valid, seharusnya YYYY-MM-DD",
+	"Invalid UUID format for '%s' parameter": "Format UUID untuk parameter '%s' tidak valid",
+	"Invalid UUID format for '%s' argument":  "Format UUID untuk argumen '%s' tidak valid",
+	"Invalid match_datetime, expected ISO 8601 such as 2025-06-15T19:30:00+07:00": "match_datetime tidak valid, seharusnya ISO 8601 seperti 2025-06-15T19:30:00+07:00",
+	"match_datetime must be in the future":                                        "match_datetime harus di masa depan",
+	"match_datetime cannot be more than 2 years ahead":                            "match_datetime tidak boleh lebih dari 2 tahun ke depan",
+	"team_id must be either home or away team":                                    "team_id harus tim tuan rumah atau tim tamu",
+	"team_ids can only be set for team managers":                                  "team_ids hanya dapat diisi untuk manajer tim",
+
+	// Requests
+	"Invalid request body":                                                         "Body permintaan tidak valid",
+	"Request body must not be larger than %s":                                      "Body permintaan tidak boleh lebih dari %s",
+	"Content-Type must be %s":                                                      "Content-Type harus %s",
+	"If-Match must be an ETag returned by this API":                                "If-Match harus berupa ETag yang dikembalikan oleh API ini",
+	"If-Match and version in the request body do not match":                        "If-Match dan version di body permintaan tidak cocok",
+	"%s was modified by another request; reload it and try again":                  "%s telah diubah oleh permintaan lain; muat ulang lalu coba lagi",
+	"%s was modified by another request (now version %d); reload it and try again": "%s telah diubah oleh permintaan lain (sekarang versi %d); muat ulang lalu coba lagi",
+	"Too many requests, please try again later":                                    "Terlalu banyak permintaan, silakan coba lagi nanti",
+	"Internal server error":                                                        "Terjadi kesalahan pada server",
+
+	// Authentication and authorization
+	"Login successful":                                         "Login berhasil",
+	"Logout successful":                                        "Logout berhasil",
+	"Logged out from all sessions":                             "Berhasil keluar dari semua sesi",
+	"Token refreshed successfully":                             "Token berhasil diperbarui",
+	"Password changed successfully":                            "Kata sandi berhasil diganti",
+	"Confirmation token issued":                                "Token konfirmasi diterbitkan",
+	"Invalid username or password":                             "Username atau kata sandi salah",
+	"Authentication required":                                  "Autentikasi diperlukan",
+	"Authorization header is required":                         "Header Authorization wajib diisi",
+	"Invalid authorization header format. Use: Bearer <token>": "Format header Authorization tidak valid. Gunakan: Bearer <token>",
+	"Access token is required":                                 "Token akses wajib diisi",
+	"Invalid or expired access token":                          "Token akses tidak valid atau kedaluwarsa",
+	"Invalid refresh token":                                    "Refresh token tidak valid",
+	"Refresh token has expired":                                "Refresh token sudah kedaluwarsa",
+	"Invalid API key":                                          "Kunci API tidak valid",
+	"API key is revoked or expired":                            "Kunci API telah dicabut atau kedaluwarsa",
+	"API key is missing the %s scope":                          "Kunci API tidak memiliki scope %s",
+	"API keys cannot access this endpoint":                     "Kunci API tidak dapat mengakses endpoint ini",
+	"Insufficient permissions for this operation":              "Izin tidak cukup untuk operasi ini",
+	"Password change required before using the API":            "Kata sandi harus diganti sebelum menggunakan API",
+	"Invalid or expired confirmation token":                    "Token konfirmasi tidak valid atau kedaluwarsa",
+	"You can only manage players of your own teams":            "Anda hanya dapat mengelola pemain dari tim Anda sendiri",
+	"You can only submit results for your own teams' matches":  "Anda hanya dapat mengirim hasil pertandingan tim Anda sendiri",
+	"This operation requires confirmation. Request a token via POST /api/v1/confirmations and send it in the X-Confirmation-Token header": "Operasi ini memerlukan konfirmasi. Minta token melalui POST /api/v1/confirmations dan kirimkan di header X-Confirmation-Token",
+	"Account is locked due to too many failed login attempts, try again later":                                                            "Akun terkunci karena terlalu banyak percobaan login yang gagal, coba lagi nanti",
+	"Too many failed login attempts, account locked for %s":                                                                               "Terlalu banyak percobaan login yang gagal, akun dikunci selama %s",
+
+	// Passwords and admin accounts
+	"Current password is incorrect":                            "Kata sandi saat ini salah",
+	"New password must be different from the current password": "Kata sandi baru harus berbeda dari kata sandi saat ini",
+	"New password must not be one of the last %d passwords":    "Kata sandi baru tidak boleh sama dengan %d kata sandi terakhir",
+	"Password must %s": "Kata sandi harus %s",
+	"Password must be at least %d characters long":     "Kata sandi harus terdiri dari minimal %d karakter",
+	"Password must be at least %d characters long, %s": "Kata sandi harus terdiri dari minimal %d karakter, %s",
+	"contain an uppercase letter":                      "mengandung huruf besar",
+	"contain a lowercase letter":                       "mengandung huruf kecil",
+	"contain a digit":                                  "mengandung angka",
+	"contain a symbol":                                 "mengandung simbol",
+	"Username already taken":                           "Username sudah digunakan",
+	"Cannot remove or demote the last super admin":     "Super admin terakhir tidak dapat dihapus atau diturunkan perannya",
+	"Email is required to receive notifications":       "Email wajib diisi untuk menerima notifikasi",
+	"Admin created successfully":                       "Admin berhasil dibuat",
+	"Admin deleted successfully":                       "Admin berhasil dihapus",
+	"Admin not found":                                  "Admin tidak ditemukan",
+	"Admin retrieved successfully":                     "Admin berhasil diambil",
+	"Admin unlocked successfully":                      "Kunci admin berhasil dibuka",
+	"Admin updated successfully":                       "Admin berhasil diperbarui",
+	"Admins retrieved successfully":                    "Daftar admin berhasil diambil",
+	"Notification preferences retrieved successfully":  "Preferensi notifikasi berhasil diambil",
+	"Notification preferences updated successfully":    "Preferensi notifikasi berhasil diperbarui",
+	"API key created successfully":                     "Kunci API berhasil dibuat",
+	"API key not found":                                "Kunci API tidak ditemukan",
+	"API key revoked successfully":                     "Kunci API berhasil dicabut",
+	"API keys retrieved successfully":                  "Daftar kunci API berhasil diambil",
+	"Audit logs retrieved successfully":                "Log audit berhasil diambil",
+
+	// Teams
+	"Team":                                   "Tim",
+	"Team created successfully":              "Tim berhasil dibuat",
+	"Team deleted successfully":              "Tim berhasil dihapus",
+	"Team not found":                         "Tim tidak ditemukan",
+	"Team name already taken":                "Nama tim sudah digunakan",
+	"Team %s not found":                      "Tim %s tidak ditemukan",
+	"Team retrieved successfully":            "Tim berhasil diambil",
+	"Team updated successfully":              "Tim berhasil diperbarui",
+	"Teams retrieved successfully":           "Daftar tim berhasil diambil",
+	"Team statistics retrieved successfully": "Statistik tim berhasil diambil",
+	"Team has %d players; delete them first or use cascade=true":                                     "Tim memiliki %d pemain; hapus pemain terlebih dahulu atau gunakan cascade=true",
+	"Team has %d scheduled, live, awaiting_result or postponed matches; cancel or delete them first": "Tim memiliki %d pertandingan berstatus scheduled, live, awaiting_result atau postponed; batalkan atau hapus terlebih dahulu",
+	"Team already has a head coach (%s); change their role first":                                    "Tim sudah memiliki pelatih kepala (%s); ubah perannya terlebih dahulu",
+
+	// Players
+	"Player":                                                  "Pemain",
+	"player":                                                  "pemain",
+	"related player":                                          "pemain terkait",
+	"Player created successfully":                             "Pemain berhasil dibuat",
+	"Player deleted successfully":                             "Pemain berhasil dihapus",
+	"Player not found":                                        "Pemain tidak ditemukan",
+	"Player retrieved successfully":                           "Pemain berhasil diambil",
+	"Player updated successfully":                             "Pemain berhasil diperbarui",
+	"Player transferred successfully":                         "Pemain berhasil dipindahkan",
+	"Player statistics retrieved successfully":                "Statistik pemain berhasil diambil",
+	"Players retrieved successfully":                          "Daftar pemain berhasil diambil",
+	"Player already belongs to this team":                     "Pemain sudah terdaftar di tim ini",
+	"Jersey number already used in this team":                 "Nomor punggung sudah digunakan di tim ini",
+	"Imported %d of %d players":                               "%d dari %d pemain berhasil diimpor",
+	"A CSV or XLSX file is required in the 'file' form field": "File CSV atau XLSX wajib dikirim di field formulir 'file'",
+	"Failed to read uploaded file":                            "Gagal membaca file yang diunggah",
+	"File is empty":                                           "File kosong",
+	"File must not be larger than 2 MB":                       "Ukuran file tidak boleh lebih dari 2 MB",
+	"File contains no player rows":                            "File tidak berisi baris pemain",
+	"File contains %d player rows, at most %d are allowed":    "File berisi %d baris pemain, maksimal %d yang diperbolehkan",
+	"Missing column(s): %s":                                   "Kolom tidak ditemukan: %s",
+
+	// Player photos
+	"Player photo uploaded successfully":                 "Foto pemain berhasil diunggah",
+	"An image file is required in the 'file' form field": "File gambar wajib dikirim di field formulir 'file'",
+	"Photo must not be larger than 4 MB":                 "Ukuran foto tidak boleh lebih dari 4 MB",
+	"Photo must be a JPEG, PNG or GIF image":             "Foto harus berupa gambar JPEG, PNG, atau GIF",
+	"Photo must not have more than %d megapixels":        "Foto tidak boleh lebih dari %d megapiksel",
+
+	// Roster rules
+	"Squad would break the roster rules":                            "Skuad akan melanggar aturan pendaftaran pemain",
+	"Squad would have %d players, at most %d are allowed":           "Skuad akan berisi %d pemain, maksimal %d yang diperbolehkan",
+	"Squad would have %d foreign players, at most %d are allowed":   "Skuad akan berisi %d pemain asing, maksimal %d yang diperbolehkan",
+	"Squad must keep places for at least %d goalkeepers, it has %d": "Skuad harus menyisakan tempat untuk minimal %d penjaga gawang, saat ini ada %d",
+
+	// Coaches and absences
+	"Coach created successfully":      "Pelatih berhasil dibuat",
+	"Coach deleted successfully":      "Pelatih berhasil dihapus",
+	"Coach not found":                 "Pelatih tidak ditemukan",
+	"Coach retrieved successfully":    "Pelatih berhasil diambil",
+	"Coach updated successfully":      "Pelatih berhasil diperbarui",
+	"Coaches retrieved successfully":  "Daftar pelatih berhasil diambil",
+	"Absence created successfully":    "Ketidakhadiran berhasil dibuat",
+	"Absence deleted successfully":    "Ketidakhadiran berhasil dihapus",
+	"Absence not found":               "Ketidakhadiran tidak ditemukan",
+	"Absence retrieved successfully":  "Ketidakhadiran berhasil diambil",
+	"Absence updated successfully":    "Ketidakhadiran berhasil diperbarui",
+	"Absences retrieved successfully": "Daftar ketidakhadiran berhasil diambil",
+	"injury":                          "cedera",
+	"suspension":                      "skorsing",
+
+	// Matches
+	"Match":                                       "Pertandingan",
+	"Match created successfully":                  "Pertandingan berhasil dibuat",
+	"Match deleted successfully":                  "Pertandingan berhasil dihapus",
+	"Match not found":                             "Pertandingan tidak ditemukan",
+	"Match retrieved successfully":                "Pertandingan berhasil diambil",
+	"Match updated successfully":                  "Pertandingan berhasil diperbarui",
+	"Match status updated successfully":           "Status pertandingan berhasil diperbarui",
+	"Matches retrieved successfully":              "Daftar pertandingan berhasil diambil",
+	"Archived matches retrieved successfully":     "Arsip pertandingan berhasil diambil",
+	"Home team":                                   "Tim tuan rumah",
+	"Away team":                                   "Tim tamu",
+	"Home team not found":                         "Tim tuan rumah tidak ditemukan",
+	"Away team not found":                         "Tim tamu tidak ditemukan",
+	"Venue not found":                             "Tempat pertandingan tidak ditemukan",
+	"Home team and away team cannot be the same":  "Tim tuan rumah dan tim tamu tidak boleh sama",
+	"%s already has a match on %s at %s":          "%s sudah memiliki pertandingan pada %s pukul %s",
+	"Cannot change match status from %s to %s":    "Status pertandingan tidak dapat diubah dari %s menjadi %s",
+	"Cannot update schedule of a %s match":        "Jadwal pertandingan berstatus %s tidak dapat diperbarui",
+	"Submit the match result to complete a match": "Kirim hasil pertandingan untuk menyelesaikan pertandingan",
+	"Reschedule the match to a future kick-off before returning it to the schedule": "Jadwalkan ulang pertandingan ke waktu kick-off di masa depan sebelum mengembalikannya ke jadwal",
+
+	// Results
+	"Match result submitted successfully":                                                    "Hasil pertandingan berhasil dikirim",
+	"Match result updated successfully":                                                      "Hasil pertandingan berhasil diperbarui",
+	"Match result already submitted. Use PUT to update.":                                     "Hasil pertandingan sudah dikirim. Gunakan PUT untuk memperbaruinya.",
+	"Match has not been completed yet":                                                       "Pertandingan belum selesai",
+	"Cannot submit result of a %s match":                                                     "Hasil pertandingan berstatus %s tidak dapat dikirim",
+	"Cannot submit result of a match that has not kicked off yet":                            "Hasil pertandingan yang belum dimulai tidak dapat dikirim",
+	"Cannot update result of a match that has not been completed. Use POST to submit first.": "Hasil pertandingan yang belum selesai tidak dapat diperbarui. Gunakan POST untuk mengirimnya terlebih dahulu.",
+	"Provide either events or goals, not both":                                               "Kirim events atau goals, tidak keduanya",
+	"Event #%d":                                               "Kejadian #%d",
+	"Goal #%d":                                                "Gol #%d",
+	"%s: invalid player_id format":                            "%s: format player_id tidak valid",
+	"%s: invalid related_player_id format":                    "%s: format related_player_id tidak valid",
+	"%s: invalid team_id format":                              "%s: format team_id tidak valid",
+	"%s: team_id must be either home or away team":            "%s: team_id harus tim tuan rumah atau tim tamu",
+	"%s: unsupported event type %q":                           "%s: jenis kejadian %q tidak didukung",
+	"%s: %s not found":                                        "%s: %s tidak ditemukan",
+	"%s: %s does not belong to the specified team":            "%s: %s tidak terdaftar di tim yang ditentukan",
+	"%s: related_player_id is only allowed for substitutions": "%s: related_player_id hanya diperbolehkan untuk pergantian pemain",
+	"%s: related_player_id is required for substitutions":     "%s: related_player_id wajib diisi untuk pergantian pemain",
+	"%s: a player cannot substitute themselves":               "%s: pemain tidak dapat menggantikan dirinya sendiri",
+	"%s: a team cannot make more than %d substitutions":       "%s: tim tidak dapat melakukan lebih dari %d pergantian",
+	"%s: player cannot receive more than two yellow cards":    "%s: pemain tidak dapat menerima lebih dari dua kartu kuning",
+	"%s: player has already been sent off":                    "%s: pemain sudah dikeluarkan dari lapangan",
+
+	// Penalty shootouts
+	"Penalty shootout is only possible after a draw, the result is %d-%d": "Adu penalti hanya dapat dilakukan setelah hasil imbang, hasilnya %d-%d",
+	"Penalty shootout must have a winner":                                 "Adu penalti harus memiliki pemenang",
+
+	// Match timeline
+	"Match timeline retrieved successfully": "Linimasa pertandingan berhasil diambil",
+
+	// Match attachments
+	"Match attachments retrieved successfully":    "Daftar lampiran pertandingan berhasil diambil",
+	"Match attachment uploaded successfully":      "Lampiran pertandingan berhasil diunggah",
+	"Match attachment deleted successfully":       "Lampiran pertandingan berhasil dihapus",
+	"Attachment not found":                        "Lampiran tidak ditemukan",
+	"A file is required in the 'file' form field": "File wajib dikirim di field formulir 'file'",
+	"Attachment must not be larger than 4 MB":     "Ukuran lampiran tidak boleh lebih dari 4 MB",
+	"Attachment must be a PDF, JPEG or PNG file":  "Lampiran harus berupa file PDF, JPEG, atau PNG",
+
+	// Lineups, officials and discipline
+	"Match lineup retrieved successfully":         "Susunan pemain berhasil diambil",
+	"Match lineup saved successfully":             "Susunan pemain berhasil disimpan",
+	"Match officials retrieved successfully":      "Perangkat pertandingan berhasil diambil",
+	"Match officials saved successfully":          "Perangkat pertandingan berhasil disimpan",
+	"Match suspensions retrieved successfully":    "Daftar skorsing pertandingan berhasil diambil",
+	"A lineup cannot have more than %d starters":  "Susunan pemain tidak boleh memiliki lebih dari %d pemain inti",
+	"A match cannot have more than %d assistants": "Pertandingan tidak boleh memiliki lebih dari %d asisten wasit",
+	"Starter #%d":                                      "Pemain inti #%d",
+	"Substitute #%d":                                   "Pemain cadangan #%d",
+	"Referee":                                          "Wasit",
+	"Assistant #%d":                                    "Asisten wasit #%d",
+	"%s: invalid player id format":                     "%s: format ID pemain tidak valid",
+	"%s: invalid referee id format":                    "%s: format ID wasit tidak valid",
+	"%s: player not found":                             "%s: pemain tidak ditemukan",
+	"%s: referee not found":                            "%s: wasit tidak ditemukan",
+	"%s: player is listed more than once":              "%s: pemain tercantum lebih dari sekali",
+	"%s: referee is assigned more than once":           "%s: wasit ditugaskan lebih dari sekali",
+	"%s: player does not belong to the specified team": "%s: pemain tidak terdaftar di tim yang ditentukan",
+	"%s: player is suspended (%s)":                     "%s: pemain sedang menjalani skorsing (%s)",
+	"%s: player is unavailable on %s (%s)":             "%s: pemain tidak tersedia pada %s (%s)",
+	"red card":                                         "kartu merah",
+	"two yellow cards in one match":                    "dua kartu kuning dalam satu pertandingan",
+	"%d yellow cards":                                  "%d kartu kuning",
+
+	// Competitions, seasons, stadiums and referees
+	"Competition created successfully":                  "Kompetisi berhasil dibuat",
+	"Competition deleted successfully":                  "Kompetisi berhasil dihapus",
+	"Competition not found":                             "Kompetisi tidak ditemukan",
+	"Competition retrieved successfully":                "Kompetisi berhasil diambil",
+	"Competition updated successfully":                  "Kompetisi berhasil diperbarui",
+	"Competitions retrieved successfully":               "Daftar kompetisi berhasil diambil",
+	"Competition still has seasons. Delete them first.": "Kompetisi masih memiliki musim. Hapus musim tersebut terlebih dahulu.",
+	"Season created successfully":                       "Musim berhasil dibuat",
+	"Season deleted successfully":                       "Musim berhasil dihapus",
+	"Season not found":                                  "Musim tidak ditemukan",
+	"Season retrieved successfully":                     "Musim berhasil diambil",
+	"Season updated successfully":                       "Musim berhasil diperbarui",
+	"Seasons retrieved successfully":                    "Daftar musim berhasil diambil",
+	"Season still has matches assigned":                 "Musim masih memiliki pertandingan",
+	"Stadium created successfully":                      "Stadion berhasil dibuat",
+	"Stadium deleted successfully":                      "Stadion berhasil dihapus",
+	"Stadium not found":                                 "Stadion tidak ditemukan",
+	"Stadium retrieved successfully":                    "Stadion berhasil diambil",
+	"Stadium updated successfully":                      "Stadion berhasil diperbarui",
+	"Stadiums retrieved successfully":                   "Daftar stadion berhasil diambil",
+	"Stadium is the home of %d teams and the venue of %d matches; reassign them first": "Stadion menjadi kandang %d tim dan tempat %d pertandingan; pindahkan terlebih dahulu",
+	"Referee created successfully":                           "Wasit berhasil dibuat",
+	"Referee deleted successfully":                           "Wasit berhasil dihapus",
+	"Referee not found":                                      "Wasit tidak ditemukan",
+	"Referee retrieved successfully":                         "Wasit berhasil diambil",
+	"Referee updated successfully":                           "Wasit berhasil diperbarui",
+	"Referees retrieved successfully":                        "Daftar wasit berhasil diambil",
+	"Referee matches retrieved successfully":                 "Daftar pertandingan wasit berhasil diambil",
+	"Referee is assigned to %d matches; reassign them first": "Wasit ditugaskan di %d pertandingan; tugaskan ulang terlebih dahulu",
+
+	// Reports, webhooks and health
+	"Match report retrieved successfully":                 "Laporan pertandingan berhasil diambil",
+	"Match reports retrieved successfully":                "Daftar laporan pertandingan berhasil diambil",
+	"Standings retrieved successfully":                    "Klasemen berhasil diambil",
+	"Standings history retrieved successfully":            "Riwayat klasemen berhasil diambil",
+	"Team ratings retrieved successfully":                 "Peringkat tim berhasil diambil",
+	"Rating history retrieved successfully":               "Riwayat peringkat berhasil diambil",
+	"Attendance report retrieved successfully":            "Laporan jumlah penonton berhasil diambil",
+	"Match prediction retrieved successfully":             "Prediksi pertandingan berhasil diambil",
+	"Man of the match leaderboard retrieved successfully": "Peringkat pemain terbaik pertandingan berhasil diambil",
+	"Goals by team retrieved successfully":                "Gol per tim berhasil diambil",
+	"Webhook created successfully":                        "Webhook berhasil dibuat",
+	"Webhook deleted successfully":                        "Webhook berhasil dihapus",
+	"Webhook not found":                                   "Webhook tidak ditemukan",
+	"Webhook retrieved successfully":                      "Webhook berhasil diambil",
+	"Webhook updated successfully":                        "Webhook berhasil diperbarui",
+	"Webhooks retrieved successfully":                     "Daftar webhook berhasil diambil",
+	"Webhook deliveries retrieved successfully":           "Riwayat pengiriman webhook berhasil diambil",
+	"Health details retrieved successfully":               "Detail kesehatan layanan berhasil diambil",
+
+	// Match result import
+	"Imported %d of %d match results":                                                       "%d dari %d hasil pertandingan berhasil diimpor",
+	"File must not be larger than 1 MB":                                                     "Ukuran file tidak boleh lebih dari 1 MB",
+	"File contains no match results":                                                        "File tidak berisi hasil pertandingan",
+	"File contains results of %d matches, at most %d are allowed":                           "File berisi hasil %d pertandingan, maksimal %d yang diperbolehkan",
+	"Row %d: match_id is required":                                                          "Baris %d: match_id wajib diisi",
+	"Row %d: attendance must be a number of 0 or more":                                      "Baris %d: attendance harus berupa angka 0 atau lebih",
+	"Row %d: home_shootout_score and away_shootout_score must both be numbers of 0 or more": "Baris %d: home_shootout_score dan away_shootout_score harus berupa angka 0 atau lebih",
+	"Invalid match_id format":                                                               "Format match_id tidak valid",
+
+	// Result dry runs
+	"Match result is valid": "Hasil pertandingan valid",
+
+	// Result undo
+	"Match result undone successfully":                                                   "Hasil pertandingan berhasil dibatalkan",
+	"Cannot undo the result of a match that has not been completed":                      "Hasil pertandingan yang belum selesai tidak dapat dibatalkan",
+	"Undoing match results is disabled":                                                  "Pembatalan hasil pertandingan dinonaktifkan",
+	"Results can only be undone within %d minutes of submission. Use PUT to correct it.": "Hasil hanya dapat dibatalkan dalam %d menit setelah dikirim. Gunakan PUT untuk memperbaikinya.",
+
+	// Maintenance mode
+	"The API is down for maintenance, please try again later": "API sedang dalam pemeliharaan, silakan coba lagi nanti",
+	"Maintenance mode retrieved successfully":                 "Status mode pemeliharaan berhasil diambil",
+	"Maintenance mode enabled":                                "Mode pemeliharaan diaktifkan",
+	"Maintenance mode disabled":                               "Mode pemeliharaan dinonaktifkan",
+
+	// API documentation
+	"Invalid documentation credentials": "Kredensial dokumentasi tidak valid",
+
+	// Rounds (matchweeks)
+	"A round can only be set for a match in a season": "Pekan hanya dapat diatur untuk pertandingan dalam musim",
+	"%s already plays in round %d of this season":     "%s sudah bertanding di pekan %d musim ini",
+	"No matches are scheduled in round %d":            "Tidak ada pertandingan yang dijadwalkan di pekan %d",
+	"Invalid round, expected a number of 1 or more":   "Pekan tidak valid, harus berupa angka 1 atau lebih",
+	"Round report retrieved successfully":             "Laporan pekan berhasil diambil",
+
+	// Group stage
+	"Group not found":                               "Grup tidak ditemukan",
+	"Groups retrieved successfully":                 "Daftar grup berhasil diambil",
+	"Group retrieved successfully":                  "Grup berhasil diambil",
+	"Group created successfully":                    "Grup berhasil dibuat",
+	"Group updated successfully":                    "Grup berhasil diperbarui",
+	"Group deleted successfully":                    "Grup berhasil dihapus",
+	"Group standings retrieved successfully":        "Klasemen grup berhasil diambil",
+	"Invalid team_ids format":                       "Format team_ids tidak valid",
+	"A team is listed more than once in the group":  "Sebuah tim tercantum lebih dari sekali dalam grup",
+	"A group of %d teams cannot have %d qualifiers": "Grup berisi %d tim tidak dapat memiliki %d tim yang lolos",
+	"%s already plays in %s":                        "%s sudah bermain di %s",
+
+	// Points rules
+	"A draw cannot be worth more points than a win (%d > %d)": "Seri tidak boleh bernilai lebih dari kemenangan (%d > %d)",
+
+	// Player profile
+	"%s must be one of: %s, or empty":                                    "%s harus salah satu dari: %s, atau kosong",
+	"%s must be a date in YYYY-MM-DD format, or empty":                   "%s harus berupa tanggal dengan format YYYY-MM-DD, atau kosong",
+	"birth_date cannot be in the future":                                 "birth_date tidak boleh di masa depan",
+	"age_min must not be greater than age_max":                           "age_min tidak boleh lebih besar dari age_max",
+	"Squad must keep places for at least %d players under %d, it has %d": "Skuad harus menyisakan tempat untuk minimal %d pemain di bawah %d tahun, saat ini ada %d",
+
+	// Team display fields
+	"Team abbreviation already taken":                  "Singkatan tim sudah digunakan",
+	"%s must be a hex color such as #E30613":           "%s harus berupa warna heksadesimal seperti #E30613",
+	"%s must be a hex color such as #E30613, or empty": "%s harus berupa warna heksadesimal seperti #E30613, atau kosong",
+	"%s must be three letters such as PSJ":             "%s harus berupa tiga huruf seperti PSJ",
+	"%s must be three letters such as PSJ, or empty":   "%s harus berupa tiga huruf seperti PSJ, atau kosong",
+
+	// Batch listings
+	"At most %d ids are allowed": "Maksimal %d ids yang diperbolehkan",
+
+	// Sparse fieldsets
+	"Unknown field %q in fields, expected some of: %s": "Field %q di fields tidak dikenal, seharusnya beberapa dari: %s",
+
+	// Query parameters
+	"%s must be a whole number": "%s harus berupa bilangan bulat",
+	"%s must be a number":       "%s harus berupa angka",
+	"%s must be true or false":  "%s harus bernilai true atau false",
+	"Invalid query parameters":  "Parameter query tidak valid",
+
+	// Man of the match
+	"Invalid man_of_the_match_id format":                  "Format man_of_the_match_id tidak valid",
+	"Man of the match: player not found":                  "Pemain terbaik pertandingan: pemain tidak ditemukan",
+	"Man of the match must play for one of the two teams": "Pemain terbaik pertandingan harus bermain untuk salah satu dari kedua tim",
+	"Man of the match must be in the team's lineup":       "Pemain terbaik pertandingan harus ada di susunan pemain tim",
+}
@@ -0,0 +1,113 @@
+// Package ical renders iCalendar (RFC 5545) feeds that calendar apps can subscribe to.
+// Only the subset needed for published event lists is supported; all times are written in UTC.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Event statuses (RFC 5545 section 3.8.1.11).
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusTentative = "TENTATIVE"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineOctets is the longest content line allowed before folding, excluding the line break.
+const maxLineOctets = 75
+
+// timestampFormat is the UTC DATE-TIME form ("20250615T123000Z").
+const timestampFormat = "20060102T150405Z"
+
+// Calendar is a published list of events.
+type Calendar struct {
+	ProdID string // Identifies the product that created the feed, e.g. "-//XYZ//Football API//EN"
+	Name   string // Display name suggested to calendar apps
+	Events []Event
+}
+
+// Event is a single VEVENT. UID must be globally unique and stable across feed refreshes.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	Updated     time.Time // Used for DTSTAMP and LAST-MODIFIED
+}
+
+// Write renders cal as an iCalendar stream with CRLF line endings and folded long lines.
+func Write(w io.Writer, cal Calendar) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeFolded(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", cal.ProdID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	for _, e := range cal.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", e.UID)
+		line("DTSTAMP", e.Updated.UTC().Format(timestampFormat))
+		line("LAST-MODIFIED", e.Updated.UTC().Format(timestampFormat))
+		line("DTSTART", e.Start.UTC().Format(timestampFormat))
+		line("DTEND", e.End.UTC().Format(timestampFormat))
+		line("SUMMARY", escapeText(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escapeText(e.Description))
+		}
+		if e.Location != "" {
+			line("LOCATION", escapeText(e.Location))
+		}
+		if e.Status != "" {
+			line("STATUS", e.Status)
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeText escapes a TEXT value: backslashes, semicolons, commas and line breaks.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeFolded writes one content line, folding it into 75-octet chunks without splitting UTF-8 characters.
+// Continuation lines start with a single space.
+func writeFolded(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1 // the leading space counts toward the limit
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
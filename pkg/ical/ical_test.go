@@ -0,0 +1,151 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	cal := Calendar{
+		ProdID: "-//XYZ//Football API//EN",
+		Name:   "Persija, home & away",
+		Events: []Event{
+			{
+				UID:         "0190a1b2-0000-7000-8000-000000000001@xyz-football-api",
+				Start:       time.Date(2025, 6, 15, 19, 30, 0, 0, jakarta),
+				End:         time.Date(2025, 6, 15, 21, 30, 0, 0, jakarta),
+				Summary:     "Persija Jakarta vs Persib Bandung",
+				Description: "Matchweek 3\nKick-off 19:30 WIB; gates open at 17:30",
+				Location:    "Jakarta International Stadium, Jakarta",
+				Status:      StatusConfirmed,
+				Updated:     time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC),
+			},
+			{
+				UID:     "0190a1b2-0000-7000-8000-000000000002@xyz-football-api",
+				Start:   time.Date(2025, 6, 22, 15, 0, 0, 0, time.UTC),
+				End:     time.Date(2025, 6, 22, 17, 0, 0, 0, time.UTC),
+				Summary: "Arema FC vs Persija Jakarta",
+				Updated: time.Date(2025, 6, 2, 9, 15, 0, 0, jakarta),
+			},
+		},
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//XYZ//Football API//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Persija\, home & away`,
+		"BEGIN:VEVENT",
+		"UID:0190a1b2-0000-7000-8000-000000000001@xyz-football-api",
+		"DTSTAMP:20250601T080000Z",
+		"LAST-MODIFIED:20250601T080000Z",
+		"DTSTART:20250615T123000Z",
+		"DTEND:20250615T143000Z",
+		"SUMMARY:Persija Jakarta vs Persib Bandung",
+		`DESCRIPTION:Matchweek 3\nKick-off 19:30 WIB\; gates open at 17:30`,
+		`LOCATION:Jakarta International Stadium\, Jakarta`,
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:0190a1b2-0000-7000-8000-000000000002@xyz-football-api",
+		"DTSTAMP:20250602T021500Z",
+		"LAST-MODIFIED:20250602T021500Z",
+		"DTSTART:20250622T150000Z",
+		"DTEND:20250622T170000Z",
+		"SUMMARY:Arema FC vs Persija Jakarta",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, cal))
+	assert.Equal(t, want, buf.String())
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "Persija Jakarta", want: "Persija Jakarta"},
+		{in: "Jakarta, Indonesia", want: `Jakarta\, Indonesia`},
+		{in: "Home; away", want: `Home\; away`},
+		{in: `C:\reports`, want: `C:\\reports`},
+		{in: `\,`, want: `\\\,`},
+		{in: "line 1\nline 2", want: `line 1\nline 2`},
+		{in: "line 1\r\nline 2", want: `line 1\nline 2`},
+		{in: "line 1\rline 2", want: `line 1\nline 2`},
+		{in: "a\n\nb", want: `a\n\nb`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, escapeText(tt.in), "%q", tt.in)
+	}
+}
+
+func TestWriteFolded(t *testing.T) {
+	fold := func(s string) string {
+		var buf bytes.Buffer
+		w := bufio.NewWriter(&buf)
+		writeFolded(w, s)
+		require.NoError(t, w.Flush())
+		return buf.String()
+	}
+	a := func(n int) string { return strings.Repeat("a", n) }
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "short", in: "SUMMARY:Derby", want: "SUMMARY:Derby\r\n"},
+		{name: "exactly 75 octets", in: a(75), want: a(75) + "\r\n"},
+		{name: "76 octets", in: a(76), want: a(75) + "\r\n a\r\n"},
+		{
+			// Continuation lines hold 74 octets after their leading space
+			name: "three lines",
+			in:   a(75 + 74 + 10),
+			want: a(75) + "\r\n " + a(74) + "\r\n " + a(10) + "\r\n",
+		},
+		{
+			// "é" is two octets and would straddle the limit, so it moves to the next line whole
+			name: "multi-byte character at the limit",
+			in:   a(74) + "é" + "b",
+			want: a(74) + "\r\n éb\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fold(tt.in))
+		})
+	}
+}
+
+func TestWriteFolded_Unfolds(t *testing.T) {
+	// Unfolding (RFC 5545 section 3.1) must give back the original line, whatever its characters
+	in := "DESCRIPTION:" + strings.Repeat("Stadion Gelora Bung Karno ⚽ Jakarta — ", 12)
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	writeFolded(w, in)
+	require.NoError(t, w.Flush())
+
+	out := buf.String()
+	require.True(t, strings.HasSuffix(out, "\r\n"))
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		assert.True(t, utf8.ValidString(line), "line %q splits a character", line)
+	}
+	assert.Equal(t, in+"\r\n", strings.ReplaceAll(out, "\r\n ", ""))
+}
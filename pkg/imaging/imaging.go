@@ -0,0 +1,144 @@
+// Package imaging decodes uploaded images and scales them down for storage, using only the
+// standard library. Images are flattened onto white and re-encoded as JPEG, which also drops
+// any metadata such as the GPS position of a phone camera.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+
+	// Registered for image.Decode
+	_ "image/gif"
+	_ "image/png"
+)
+
+// MaxPixels is the largest image accepted by Decode, so that a small upload cannot
+// decompress into gigabytes of memory.
+const MaxPixels = 40_000_000
+
+// jpegQuality is the quality resized images are encoded at.
+const jpegQuality = 85
+
+var (
+	// ErrUnsupportedFormat is returned for data that is not a JPEG, PNG or GIF image.
+	ErrUnsupportedFormat = errors.New("image must be a JPEG, PNG or GIF")
+	// ErrTooLarge is returned for images with more than MaxPixels pixels.
+	ErrTooLarge = fmt.Errorf("image must not have more than %d megapixels", MaxPixels/1_000_000)
+)
+
+// Decode decodes a JPEG, PNG or GIF image (the first frame of an animation).
+func Decode(data []byte) (image.Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if format != "jpeg" && format != "png" && format != "gif" {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrUnsupportedFormat
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	return img, nil
+}
+
+// Fit scales img down so that neither side is longer than size, keeping its aspect ratio.
+// Images that already fit are returned flattened but not enlarged.
+func Fit(img image.Image, size int) *image.RGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/w)
+		} else {
+			w, h = max(1, w*size/h), size
+		}
+	}
+	return scale(flatten(img, img.Bounds()), w, h)
+}
+
+// Square crops the largest centered square out of img and scales it down to size × size,
+// for thumbnails and avatars. Images smaller than size keep their shorter side.
+func Square(img image.Image, size int) *image.RGBA {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	size = min(size, side)
+	return scale(flatten(img, image.Rect(x0, y0, x0+side, y0+side)), size, size)
+}
+
+// JPEG encodes img as a JPEG.
+func JPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("encode jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// flatten copies the part of img within r onto a white background, so transparent
+// areas do not turn black in a JPEG.
+func flatten(img image.Image, r image.Rectangle) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, r.Min, draw.Over)
+	return dst
+}
+
+// scale resizes src to w × h by averaging the source pixels under each destination pixel,
+// which keeps downscaled photos smooth. It is only used to shrink images.
+func scale(src *image.RGBA, w, h int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	if sw == w && sh == h {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for dy := 0; dy < h; dy++ {
+		sy0, sy1 := span(dy, h, sh)
+		for dx := 0; dx < w; dx++ {
+			sx0, sx1 := span(dx, w, sw)
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := sx0; sx < sx1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			i := dy*dst.Stride + dx*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// span returns the source pixels [from, to) covered by destination pixel d of n,
+// for a source of length total. Every destination pixel covers at least one source pixel.
+func span(d, n, total int) (from, to int) {
+	from = d * total / n
+	to = (d + 1) * total / n
+	if to <= from {
+		to = from + 1
+	}
+	return from, min(to, total)
+}
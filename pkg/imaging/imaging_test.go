@@ -0,0 +1,182 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func solid(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h, size   int
+		wantW, wantH int
+	}{
+		{name: "landscape", w: 4000, h: 3000, size: 1600, wantW: 1600, wantH: 1200},
+		{name: "portrait", w: 3000, h: 4000, size: 1600, wantW: 1200, wantH: 1600},
+		{name: "square", w: 2000, h: 2000, size: 1600, wantW: 1600, wantH: 1600},
+		{name: "wide", w: 1920, h: 1080, size: 800, wantW: 800, wantH: 450},
+		{name: "one side too long", w: 1000, h: 200, size: 500, wantW: 500, wantH: 100},
+		{name: "thin strip keeps a pixel", w: 5000, h: 2, size: 100, wantW: 100, wantH: 1},
+		{name: "already fits", w: 640, h: 480, size: 1600, wantW: 640, wantH: 480},
+		{name: "exact fit", w: 1600, h: 900, size: 1600, wantW: 1600, wantH: 900},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fit(image.NewRGBA(image.Rect(0, 0, tt.w, tt.h)), tt.size)
+			assert.Equal(t, image.Rect(0, 0, tt.wantW, tt.wantH), got.Bounds())
+		})
+	}
+}
+
+func TestSquare(t *testing.T) {
+	tests := []struct {
+		name       string
+		w, h, size int
+		want       int
+	}{
+		{name: "landscape", w: 300, h: 200, size: 100, want: 100},
+		{name: "portrait", w: 200, h: 300, size: 100, want: 100},
+		{name: "smaller than size", w: 80, h: 60, size: 100, want: 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Square(image.NewRGBA(image.Rect(0, 0, tt.w, tt.h)), tt.size)
+			assert.Equal(t, image.Rect(0, 0, tt.want, tt.want), got.Bounds())
+		})
+	}
+}
+
+func TestSquare_CropsCenter(t *testing.T) {
+	// Red bands of 50 pixels either side of a blue 100 × 100 center
+	img := solid(200, 100, color.RGBA{R: 255, A: 255})
+	for y := 0; y < 100; y++ {
+		for x := 50; x < 150; x++ {
+			img.Set(x, y, color.RGBA{B: 255, A: 255})
+		}
+	}
+
+	got := Square(img, 10)
+	require.Equal(t, image.Rect(0, 0, 10, 10), got.Bounds())
+	for _, p := range []image.Point{{0, 0}, {9, 0}, {0, 9}, {9, 9}, {5, 5}} {
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, got.RGBAAt(p.X, p.Y), "pixel %v", p)
+	}
+}
+
+func TestFit_SubImage(t *testing.T) {
+	img := solid(100, 100, color.RGBA{R: 255, A: 255})
+	for y := 50; y < 100; y++ {
+		for x := 50; x < 100; x++ {
+			img.Set(x, y, color.RGBA{G: 255, A: 255})
+		}
+	}
+
+	// Bounds that do not start at the origin
+	got := Fit(img.SubImage(image.Rect(50, 50, 100, 100)), 10)
+	require.Equal(t, image.Rect(0, 0, 10, 10), got.Bounds())
+	assert.Equal(t, color.RGBA{G: 255, A: 255}, got.RGBAAt(0, 0))
+}
+
+func TestFit_AveragesPixels(t *testing.T) {
+	// Alternating black and white columns shrink to gray, not to one of the two
+	img := solid(4, 2, color.Black)
+	for y := 0; y < 2; y++ {
+		img.Set(1, y, color.White)
+		img.Set(3, y, color.White)
+	}
+
+	got := Fit(img, 2)
+	require.Equal(t, image.Rect(0, 0, 2, 1), got.Bounds())
+	assert.Equal(t, color.RGBA{R: 127, G: 127, B: 127, A: 255}, got.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 127, G: 127, B: 127, A: 255}, got.RGBAAt(1, 0))
+}
+
+func TestFit_FlattensOntoWhite(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(1, 0, color.NRGBA{R: 255, A: 128}) // Half transparent red; pixel 0 fully transparent
+
+	got := Fit(img, 10)
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, got.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 255, G: 127, B: 127, A: 255}, got.RGBAAt(1, 0))
+}
+
+func TestDecode(t *testing.T) {
+	src := solid(30, 20, color.RGBA{G: 200, A: 255})
+	var gifBuf bytes.Buffer
+	require.NoError(t, gif.Encode(&gifBuf, src, nil))
+	jpg, err := JPEG(src)
+	require.NoError(t, err)
+
+	for name, data := range map[string][]byte{"png": encodePNG(t, src), "gif": gifBuf.Bytes(), "jpeg": jpg} {
+		t.Run(name, func(t *testing.T) {
+			img, err := Decode(data)
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, 30, 20), img.Bounds())
+		})
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	// A PNG whose header claims 10000 × 10000 pixels; only the header is read before rejecting it
+	huge := encodePNG(t, image.NewGray(image.Rect(0, 0, 1, 1)))
+	ihdr := huge[8+8 : 8+8+13] // After the signature and the chunk length and type
+	binary.BigEndian.PutUint32(ihdr[0:], 10000)
+	binary.BigEndian.PutUint32(ihdr[4:], 10000)
+	binary.BigEndian.PutUint32(huge[8+8+13:], crc32.ChecksumIEEE(huge[8+4:8+8+13]))
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "empty", data: nil, wantErr: ErrUnsupportedFormat},
+		{name: "text", data: []byte("not an image"), wantErr: ErrUnsupportedFormat},
+		{name: "truncated png", data: encodePNG(t, solid(30, 20, color.White))[:60], wantErr: ErrUnsupportedFormat},
+		{name: "too many pixels", data: huge, wantErr: ErrTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.data)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestJPEG(t *testing.T) {
+	data, err := JPEG(Fit(solid(400, 300, color.RGBA{R: 200, G: 30, B: 40, A: 255}), 100))
+	require.NoError(t, err)
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 100, cfg.Width)
+	assert.Equal(t, 75, cfg.Height)
+}
@@ -1,6 +1,7 @@
 package jwt
 
 import (
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,6 +12,9 @@ import (
 type Claims struct {
 	AdminID  uuid.UUID `json:"admin_id"`
 	Username string    `json:"username"`
+	Role     string    `json:"role"`
+	// MustChangePassword limits the token to changing the password and logging out
+	MustChangePassword bool `json:"must_change_password,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -22,26 +26,78 @@ type TokenPair struct {
 
 // Service handles JWT token generation and validation.
 type Service struct {
-	secret            []byte
+	signingKey        Key
+	previousKeys      []previousKey
 	accessExpiration  time.Duration
 	refreshExpiration time.Duration
 }
 
-// NewService creates a new JWT service with the given configuration.
+// previousKey is a key that signed tokens before a rotation, accepted until a deadline.
+type previousKey struct {
+	key   Key
+	until time.Time
+}
+
+// NewService creates a new JWT service signing HS256 tokens with a shared secret.
 func NewService(secret string, accessExp, refreshExp time.Duration) *Service {
 	return &Service{
-		secret:            []byte(secret),
+		signingKey:        HMACKey("", []byte(secret)),
+		accessExpiration:  accessExp,
+		refreshExpiration: refreshExp,
+	}
+}
+
+// NewServiceWithKey creates a new JWT service signing tokens with the given key.
+func NewServiceWithKey(key Key, accessExp, refreshExp time.Duration) (*Service, error) {
+	if !key.CanSign() {
+		return nil, errors.New("signing key has no private part")
+	}
+	return &Service{
+		signingKey:        key,
 		accessExpiration:  accessExp,
 		refreshExpiration: refreshExp,
+	}, nil
+}
+
+// AcceptPreviousKey keeps accepting tokens signed with a key that was replaced, until the given
+// time, so sessions survive a key rotation. The key may be public only.
+func (s *Service) AcceptPreviousKey(key Key, until time.Time) {
+	s.previousKeys = append(s.previousKeys, previousKey{key: key, until: until})
+}
+
+// verificationKeys returns the signing key and the previous keys still accepted at now.
+func (s *Service) verificationKeys(now time.Time) []Key {
+	keys := []Key{s.signingKey}
+	for _, previous := range s.previousKeys {
+		if now.Before(previous.until) {
+			keys = append(keys, previous.key)
+		}
+	}
+	return keys
+}
+
+// JWKS returns the public keys tokens can currently be verified with, for other services.
+// It is empty when tokens are signed with a shared secret, which is never published.
+func (s *Service) JWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range s.verificationKeys(time.Now()) {
+		if jwk, ok := key.jwk(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
 	}
+	return set
 }
 
 // GenerateAccessToken creates a signed JWT access token for the given admin.
-func (s *Service) GenerateAccessToken(adminID uuid.UUID, username string) (string, error) {
+// The role is embedded in the claims so middleware can enforce permissions without a DB lookup,
+// as is whether the admin has to change their password first.
+func (s *Service) GenerateAccessToken(adminID uuid.UUID, username, role string, mustChangePassword bool) (string, error) {
 	now := time.Now()
 	claims := Claims{
-		AdminID:  adminID,
-		Username: username,
+		AdminID:            adminID,
+		Username:           username,
+		Role:               role,
+		MustChangePassword: mustChangePassword,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessExpiration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -51,8 +107,11 @@ func (s *Service) GenerateAccessToken(adminID uuid.UUID, username string) (strin
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secret)
+	token := jwt.NewWithClaims(s.signingKey.method(), claims)
+	if s.signingKey.ID != "" {
+		token.Header["kid"] = s.signingKey.ID
+	}
+	return token.SignedString(s.signingKey.signKey)
 }
 
 // GenerateRefreshToken creates a random refresh token string and returns
@@ -67,13 +126,22 @@ func (s *Service) GenerateRefreshToken() (string, time.Time, error) {
 }
 
 // ValidateAccessToken parses and validates an access token, returning the claims.
+// The token must be signed with the current key or a previous key still accepted, using that
+// key's algorithm; its "kid" header, when present, selects among keys that have an ID.
 func (s *Service) ValidateAccessToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrSignatureInvalid
+		kid, _ := token.Header["kid"].(string)
+		var set jwt.VerificationKeySet
+		for _, key := range s.verificationKeys(time.Now()) {
+			if key.Algorithm == token.Method.Alg() && (kid == "" || key.ID == "" || kid == key.ID) {
+				set.Keys = append(set.Keys, key.verifyKey)
+			}
+		}
+		if len(set.Keys) == 0 {
+			return nil, jwt.ErrTokenUnverifiable
 		}
-		return s.secret, nil
-	})
+		return set, nil
+	}, jwt.WithValidMethods(Algorithms))
 	if err != nil {
 		return nil, err
 	}
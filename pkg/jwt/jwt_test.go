@@ -0,0 +1,257 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rsaPEM returns a fresh RSA private key and the PEM encoding of its public part.
+func rsaPEM(t *testing.T) (private, public []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return pemEncode(t, key, &key.PublicKey)
+}
+
+// ed25519PEM returns a fresh Ed25519 private key and the PEM encoding of its public part.
+func ed25519PEM(t *testing.T) (private, public []byte) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return pemEncode(t, priv, pub)
+}
+
+func pemEncode(t *testing.T, priv, pub any) (private, public []byte) {
+	t.Helper()
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+}
+
+func parseKey(t *testing.T, id string, data []byte) Key {
+	t.Helper()
+	key, err := ParsePEMKey(id, data)
+	require.NoError(t, err)
+	return key
+}
+
+func newKeyService(t *testing.T, key Key) *Service {
+	t.Helper()
+	s, err := NewServiceWithKey(key, 15*time.Minute, time.Hour)
+	require.NoError(t, err)
+	return s
+}
+
+func TestService_RoundTrip(t *testing.T) {
+	rsaPriv, _ := rsaPEM(t)
+	edPriv, _ := ed25519PEM(t)
+
+	tests := []struct {
+		name    string
+		service *Service
+		alg     string
+	}{
+		{name: "shared secret", service: NewService("secret", 15*time.Minute, time.Hour), alg: AlgorithmHS256},
+		{name: "rsa", service: newKeyService(t, parseKey(t, "rsa-1", rsaPriv)), alg: AlgorithmRS256},
+		{name: "ed25519", service: newKeyService(t, parseKey(t, "ed-1", edPriv)), alg: AlgorithmEdDSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminID := uuid.New()
+			token, err := tt.service.GenerateAccessToken(adminID, "admin", "editor", true)
+			require.NoError(t, err)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &Claims{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.alg, parsed.Method.Alg())
+
+			claims, err := tt.service.ValidateAccessToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, adminID, claims.AdminID)
+			assert.Equal(t, "admin", claims.Username)
+			assert.Equal(t, "editor", claims.Role)
+			assert.True(t, claims.MustChangePassword)
+		})
+	}
+}
+
+func TestService_ValidateAccessToken_Expired(t *testing.T) {
+	s := NewService("secret", -time.Minute, time.Hour)
+	token, err := s.GenerateAccessToken(uuid.New(), "admin", "admin", false)
+	require.NoError(t, err)
+
+	_, err = s.ValidateAccessToken(token)
+	assert.ErrorIs(t, err, jwt.ErrTokenExpired)
+}
+
+func TestService_PreviousKey(t *testing.T) {
+	oldPriv, oldPub := ed25519PEM(t)
+	newPriv, _ := ed25519PEM(t)
+
+	oldService := newKeyService(t, parseKey(t, "2025-01", oldPriv))
+	oldToken, err := oldService.GenerateAccessToken(uuid.New(), "admin", "admin", false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		until   time.Time
+		wantErr bool
+	}{
+		{name: "inside grace window", until: time.Now().Add(time.Hour)},
+		{name: "after grace window", until: time.Now().Add(-time.Second), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newKeyService(t, parseKey(t, "2025-02", newPriv))
+			// Only the public part of the old key is kept after a rotation
+			s.AcceptPreviousKey(parseKey(t, "2025-01", oldPub), tt.until)
+
+			_, err := s.ValidateAccessToken(oldToken)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, jwt.ErrTokenUnverifiable)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("without previous key", func(t *testing.T) {
+		s := newKeyService(t, parseKey(t, "2025-02", newPriv))
+		_, err := s.ValidateAccessToken(oldToken)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ValidateAccessToken_Rejects(t *testing.T) {
+	rsaPriv, rsaPub := rsaPEM(t)
+	edPriv, edPub := ed25519PEM(t)
+	rsaKey := parseKey(t, "rsa-1", rsaPriv)
+	edKey := parseKey(t, "ed-1", edPriv)
+
+	claims := Claims{
+		AdminID:  uuid.New(),
+		Username: "admin",
+		Role:     "admin",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+
+	tests := []struct {
+		name    string
+		service *Service
+		token   string
+		wantErr error
+	}{
+		{
+			name:    "unknown kid",
+			service: newKeyService(t, rsaKey),
+			token:   sign(jwt.SigningMethodRS256, "rsa-2", rsaKey.signKey),
+			wantErr: jwt.ErrTokenUnverifiable,
+		},
+		{
+			// The classic algorithm confusion: the public key, which is no secret, used as an HMAC secret
+			name:    "hs256 against rsa key",
+			service: newKeyService(t, rsaKey),
+			token:   sign(jwt.SigningMethodHS256, "rsa-1", rsaPub),
+			wantErr: jwt.ErrTokenUnverifiable,
+		},
+		{
+			name:    "hs256 against ed25519 key",
+			service: newKeyService(t, edKey),
+			token:   sign(jwt.SigningMethodHS256, "ed-1", edPub),
+			wantErr: jwt.ErrTokenUnverifiable,
+		},
+		{
+			name:    "rs256 against ed25519 key",
+			service: newKeyService(t, edKey),
+			token:   sign(jwt.SigningMethodRS256, "ed-1", rsaKey.signKey),
+			wantErr: jwt.ErrTokenUnverifiable,
+		},
+		{
+			name:    "unsigned",
+			service: newKeyService(t, edKey),
+			token:   sign(jwt.SigningMethodNone, "ed-1", jwt.UnsafeAllowNoneSignatureType),
+		},
+		{
+			name:    "wrong secret",
+			service: NewService("secret", time.Minute, time.Hour),
+			token:   sign(jwt.SigningMethodHS256, "", []byte("other")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.service.ValidateAccessToken(tt.token)
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_JWKS(t *testing.T) {
+	rsaPriv, rsaPub := rsaPEM(t)
+	edPriv, _ := ed25519PEM(t)
+
+	t.Run("shared secret is not published", func(t *testing.T) {
+		s := NewService("secret", time.Minute, time.Hour)
+		assert.Empty(t, s.JWKS().Keys)
+	})
+
+	t.Run("public keys only", func(t *testing.T) {
+		rsaKey := parseKey(t, "rsa-1", rsaPriv)
+		s := newKeyService(t, parseKey(t, "ed-1", edPriv))
+		s.AcceptPreviousKey(parseKey(t, "rsa-1", rsaPub), time.Now().Add(time.Hour))
+		s.AcceptPreviousKey(HMACKey("hs-1", []byte("secret")), time.Now().Add(time.Hour))
+		s.AcceptPreviousKey(parseKey(t, "expired", rsaPub), time.Now().Add(-time.Hour))
+
+		keys := s.JWKS().Keys
+		require.Len(t, keys, 2)
+
+		ed := keys[0]
+		assert.Equal(t, JWK{Kty: "OKP", Kid: "ed-1", Use: "sig", Alg: AlgorithmEdDSA, Crv: "Ed25519", X: ed.X}, ed)
+		x, err := base64.RawURLEncoding.DecodeString(ed.X)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(s.signingKey.verifyKey.(ed25519.PublicKey)), x)
+
+		rs := keys[1]
+		assert.Equal(t, "RSA", rs.Kty)
+		assert.Equal(t, "rsa-1", rs.Kid)
+		assert.Equal(t, AlgorithmRS256, rs.Alg)
+		pub := rsaKey.verifyKey.(*rsa.PublicKey)
+		n, err := base64.RawURLEncoding.DecodeString(rs.N)
+		require.NoError(t, err)
+		e, err := base64.RawURLEncoding.DecodeString(rs.E)
+		require.NoError(t, err)
+		assert.Equal(t, pub.N, new(big.Int).SetBytes(n))
+		assert.Equal(t, int64(pub.E), new(big.Int).SetBytes(e).Int64())
+	})
+}
@@ -0,0 +1,130 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmHS256 = "HS256" // Shared secret; tokens can only be verified by holders of the secret
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA" // Ed25519
+)
+
+// Algorithms lists the supported signing algorithms.
+var Algorithms = []string{AlgorithmHS256, AlgorithmRS256, AlgorithmEdDSA}
+
+// Key is a key tokens are signed or verified with. ID is sent as the "kid" header so a
+// verifier can pick the right key, e.g. during a rotation.
+type Key struct {
+	ID        string
+	Algorithm string
+	signKey   any // nil for keys that can only verify
+	verifyKey any
+}
+
+// HMACKey returns an HS256 key for a shared secret.
+func HMACKey(id string, secret []byte) Key {
+	return Key{ID: id, Algorithm: AlgorithmHS256, signKey: secret, verifyKey: secret}
+}
+
+// ParsePEMKey parses an RSA or Ed25519 key in PEM format: a private key (PKCS #1 or PKCS #8)
+// can sign and verify, a public key (PKIX) only verify. The algorithm follows from the key type.
+// An empty id is replaced by a fingerprint of the public key.
+func ParsePEMKey(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return Key{}, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return Key{}, err
+	}
+
+	var key Key
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key = Key{Algorithm: AlgorithmRS256, signKey: k, verifyKey: &k.PublicKey}
+	case *rsa.PublicKey:
+		key = Key{Algorithm: AlgorithmRS256, verifyKey: k}
+	case ed25519.PrivateKey:
+		key = Key{Algorithm: AlgorithmEdDSA, signKey: k, verifyKey: k.Public()}
+	case ed25519.PublicKey:
+		key = Key{Algorithm: AlgorithmEdDSA, verifyKey: k}
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %T; use RSA or Ed25519", parsed)
+	}
+
+	key.ID = id
+	if key.ID == "" {
+		der, err := x509.MarshalPKIXPublicKey(key.verifyKey)
+		if err != nil {
+			return Key{}, err
+		}
+		sum := sha256.Sum256(der)
+		key.ID = hex.EncodeToString(sum[:8])
+	}
+	return key, nil
+}
+
+// CanSign reports whether the key holds the private part (or secret) needed to sign tokens.
+func (k Key) CanSign() bool {
+	return k.signKey != nil
+}
+
+// method returns the signing method of the key's algorithm.
+func (k Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"` // OKP keys
+	X   string `json:"x,omitempty"`   // OKP keys
+	N   string `json:"n,omitempty"`   // RSA keys
+	E   string `json:"e,omitempty"`   // RSA keys
+}
+
+// JWKSet is a set of public keys in JSON Web Key Set format, as served to other services.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// jwk returns the public part of an asymmetric key as a JWK; shared secrets are never published.
+func (k Key) jwk() (JWK, bool) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch pub := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{Kty: "RSA", Kid: k.ID, Use: "sig", Alg: k.Algorithm, N: b64(pub.N.Bytes()), E: b64(big.NewInt(int64(pub.E)).Bytes())}, true
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Kid: k.ID, Use: "sig", Alg: k.Algorithm, Crv: "Ed25519", X: b64(pub)}, true
+	}
+	return JWK{}, false
+}
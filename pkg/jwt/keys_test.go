@@ -0,0 +1,80 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePEMKey(t *testing.T) {
+	rsaPriv, rsaPub := rsaPEM(t)
+	edPriv, edPub := ed25519PEM(t)
+
+	block, _ := pem.Decode(rsaPriv)
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(parsed.(*rsa.PrivateKey))})
+
+	tests := []struct {
+		name    string
+		data    []byte
+		alg     string
+		canSign bool
+	}{
+		{name: "rsa pkcs8", data: rsaPriv, alg: AlgorithmRS256, canSign: true},
+		{name: "rsa pkcs1", data: pkcs1, alg: AlgorithmRS256, canSign: true},
+		{name: "rsa public", data: rsaPub, alg: AlgorithmRS256},
+		{name: "ed25519", data: edPriv, alg: AlgorithmEdDSA, canSign: true},
+		{name: "ed25519 public", data: edPub, alg: AlgorithmEdDSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePEMKey("key-1", tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, "key-1", key.ID)
+			assert.Equal(t, tt.alg, key.Algorithm)
+			assert.Equal(t, tt.canSign, key.CanSign())
+		})
+	}
+}
+
+func TestParsePEMKey_Fingerprint(t *testing.T) {
+	priv, pub := ed25519PEM(t)
+	_, other := ed25519PEM(t)
+
+	fromPriv := parseKey(t, "", priv)
+	fromPub := parseKey(t, "", pub)
+	assert.Len(t, fromPriv.ID, 16)
+	assert.Equal(t, fromPriv.ID, fromPub.ID, "both halves of a key pair share a fingerprint")
+	assert.NotEqual(t, fromPriv.ID, parseKey(t, "", other).ID)
+}
+
+func TestParsePEMKey_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not pem", data: []byte("secret")},
+		{name: "unsupported block", data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte{1}})},
+		{name: "corrupt key", data: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1, 2, 3}})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePEMKey("", tt.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewServiceWithKey_PublicKey(t *testing.T) {
+	_, pub := ed25519PEM(t)
+	_, err := NewServiceWithKey(parseKey(t, "", pub), time.Minute, time.Hour)
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// GormLogger adapts slog to GORM's logger interface so SQL logs share the application's
+// format and carry the request ID when the query runs with a request context.
+// Queries are logged at debug, slow queries at warn and failed queries at error;
+// record-not-found is expected and never logged.
+type GormLogger struct {
+	logger        *slog.Logger
+	level         gormlogger.LogLevel
+	slowThreshold time.Duration
+}
+
+// NewGormLogger returns a GORM logger writing to logger. Queries slower than
+// slowThreshold are reported as warnings; zero disables slow query reporting.
+func NewGormLogger(logger *slog.Logger, slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{logger: logger, level: gormlogger.Info, slowThreshold: slowThreshold}
+}
+
+// LogMode returns a copy logging at the given GORM level.
+func (l *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+// Info logs a GORM informational message.
+func (l *GormLogger) Info(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Info {
+		l.logger.InfoContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Warn logs a GORM warning.
+func (l *GormLogger) Warn(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Warn {
+		l.logger.WarnContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Error logs a GORM error.
+func (l *GormLogger) Error(ctx context.Context, msg string, args ...any) {
+	if l.level >= gormlogger.Error {
+		l.logger.ErrorContext(ctx, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Trace logs one executed statement with its duration and affected rows.
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		l.logger.ErrorContext(ctx, "database query failed", "error", err, "sql", sql, "rows", rows, "duration_ms", elapsed.Milliseconds())
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		l.logger.WarnContext(ctx, "slow database query", "sql", sql, "rows", rows, "duration_ms", elapsed.Milliseconds(), "threshold_ms", l.slowThreshold.Milliseconds())
+	case l.level >= gormlogger.Info && l.logger.Enabled(ctx, slog.LevelDebug):
+		sql, rows := fc()
+		l.logger.DebugContext(ctx, "database query", "sql", sql, "rows", rows, "duration_ms", elapsed.Milliseconds())
+	}
+}
@@ -0,0 +1,30 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+)
+
+// Log output formats accepted by NewHandler.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// NewHandler returns a handler writing records at level or above to w, wrapped with
+// request ID injection. FormatJSON writes one JSON object per line for log shippers;
+// any other format writes logfmt-style text with a short timestamp for reading in a terminal.
+func NewHandler(w io.Writer, format string, level slog.Leveler) slog.Handler {
+	if format == FormatJSON {
+		return NewContextHandler(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}))
+	}
+	return NewContextHandler(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				a.Value = slog.StringValue(a.Value.Time().Format("15:04:05.000"))
+			}
+			return a
+		},
+	}))
+}
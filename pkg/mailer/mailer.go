@@ -0,0 +1,53 @@
+// Package mailer sends plain-text email notifications.
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// Message is a plain-text email to one or more recipients.
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email.
+type Mailer interface {
+	// Send delivers msg. It returns once the mail server accepted the message.
+	Send(ctx context.Context, msg Message) error
+}
+
+// Noop is the Mailer used when no mail server is configured. It discards every message.
+type Noop struct{}
+
+// Send discards the message.
+func (Noop) Send(context.Context, Message) error { return nil }
+
+// Memory is an in-memory Mailer that keeps every message it is given, for tests
+// and local development.
+type Memory struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+// NewMemory creates an empty in-memory mailer.
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Send stores the message.
+func (m *Memory) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first.
+func (m *Memory) Sent() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.sent...)
+}
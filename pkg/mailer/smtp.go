@@ -0,0 +1,148 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// smtpTimeout bounds a whole delivery when ctx has no earlier deadline.
+const smtpTimeout = 30 * time.Second
+
+// SMTP sends mail through an SMTP server with the standard library client.
+// smtp:// URLs upgrade the connection with STARTTLS when the server offers it;
+// smtps:// URLs use TLS from the start (usually port 465).
+type SMTP struct {
+	addr     string
+	host     string
+	implicit bool // TLS from the first byte instead of STARTTLS
+	auth     smtp.Auth
+	from     mail.Address
+}
+
+// NewSMTP creates a mailer for an smtp[s]://[user:password@]host[:port] URL that sends
+// as from, e.g. "XYZ Football <noreply@example.com>".
+func NewSMTP(rawURL, from string) (*SMTP, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "smtp" && u.Scheme != "smtps") || u.Hostname() == "" {
+		return nil, errors.New("invalid smtp url, expected smtp[s]://[user:password@]host:port")
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	s := &SMTP{addr: u.Host, host: u.Hostname(), implicit: u.Scheme == "smtps", from: *sender}
+	if u.Port() == "" {
+		port := "587"
+		if s.implicit {
+			port = "465"
+		}
+		s.addr = net.JoinHostPort(s.host, port)
+	}
+	if u.User != nil {
+		pass, _ := u.User.Password()
+		s.auth = smtp.PlainAuth("", u.User.Username(), pass, s.host)
+	}
+	return s, nil
+}
+
+// Send delivers msg to all its recipients in one SMTP transaction.
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return nil
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, smtpTimeout)
+		defer cancel()
+	}
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("smtp: connect: %w", err)
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp: %w", err)
+	}
+	defer client.Close()
+
+	if !s.implicit {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+				return fmt.Errorf("smtp: starttls: %w", err)
+			}
+		}
+	}
+	if s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return fmt.Errorf("smtp: auth: %w", err)
+		}
+	}
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("smtp: recipient %s: %w", to, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if _, err := w.Write(s.compose(msg)); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return client.Quit()
+}
+
+// dial opens the connection to the server, with TLS for smtps:// URLs.
+func (s *SMTP) dial(ctx context.Context) (net.Conn, error) {
+	if s.implicit {
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: s.host}}
+		return dialer.DialContext(ctx, "tcp", s.addr)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", s.addr)
+}
+
+// compose renders msg as a MIME message with a quoted-printable UTF-8 body.
+func (s *SMTP) compose(msg Message) []byte {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	header("From", s.from.String())
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	_, _ = qp.Write([]byte(strings.ReplaceAll(msg.Body, "\n", "\r\n")))
+	_ = qp.Close()
+	return buf.Bytes()
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are dropped from memory.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens   float64
+	last     time.Time
+	fullTime time.Time // When the bucket will be full again and can be forgotten
+}
+
+// MemoryLimiter keeps buckets in process memory. Limits are per instance, so use
+// RedisLimiter when several instances serve the same clients.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryLimiter creates a new in-memory limiter.
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes one token from the bucket of key, refilling it for the time elapsed since the last call.
+func (l *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	capacity := float64(rule.Requests)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.last).Seconds()*rule.refillPerSecond())
+	b.last = now
+
+	decision := Decision{Allowed: b.tokens >= 1}
+	if decision.Allowed {
+		b.tokens--
+	} else {
+		decision.RetryAfter = retryAfter(b.tokens, rule)
+	}
+	b.fullTime = now.Add(time.Duration((capacity - b.tokens) / rule.refillPerSecond() * float64(time.Second)))
+
+	return decision, nil
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket behaves the same.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if !now.Before(b.fullTime) {
+			delete(l.buckets, key)
+		}
+	}
+}
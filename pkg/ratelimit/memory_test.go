@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	rule := Rule{Requests: 3, Period: 3 * time.Second} // One token per second
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		steps          []time.Duration // Time advanced before each call
+		wantAllowed    []bool
+		wantRetryAfter time.Duration // Of the last call
+	}{
+		{
+			name:        "full bucket allows a burst",
+			steps:       []time.Duration{0, 0, 0},
+			wantAllowed: []bool{true, true, true},
+		},
+		{
+			name:           "empty bucket rejects",
+			steps:          []time.Duration{0, 0, 0, 0},
+			wantAllowed:    []bool{true, true, true, false},
+			wantRetryAfter: time.Second,
+		},
+		{
+			name:           "retry after counts partial refill",
+			steps:          []time.Duration{0, 0, 0, 400 * time.Millisecond},
+			wantAllowed:    []bool{true, true, true, false},
+			wantRetryAfter: 600 * time.Millisecond,
+		},
+		{
+			name:        "tokens refill over time",
+			steps:       []time.Duration{0, 0, 0, time.Second},
+			wantAllowed: []bool{true, true, true, true},
+		},
+		{
+			name:           "refill is capped at the burst size",
+			steps:          []time.Duration{0, time.Hour, 0, 0, 0},
+			wantAllowed:    []bool{true, true, true, true, false},
+			wantRetryAfter: time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewMemoryLimiter()
+			clock := now
+			l.now = func() time.Time { return clock }
+
+			var last Decision
+			for i, step := range tt.steps {
+				clock = clock.Add(step)
+				var err error
+				last, err = l.Allow(context.Background(), "ip:1.2.3.4", rule)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAllowed[i], last.Allowed, "call %d", i)
+			}
+			assert.InDelta(t, tt.wantRetryAfter, last.RetryAfter, float64(time.Millisecond))
+		})
+	}
+}
+
+func TestMemoryLimiter_SeparateKeys(t *testing.T) {
+	l := NewMemoryLimiter()
+	rule := Rule{Requests: 1, Period: time.Minute}
+
+	first, _ := l.Allow(context.Background(), "a", rule)
+	second, _ := l.Allow(context.Background(), "a", rule)
+	other, _ := l.Allow(context.Background(), "b", rule)
+
+	assert.True(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	assert.True(t, other.Allowed)
+}
+
+func TestMemoryLimiter_SweepsFullBuckets(t *testing.T) {
+	l := NewMemoryLimiter()
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return clock }
+	rule := Rule{Requests: 2, Period: time.Second}
+
+	_, _ = l.Allow(context.Background(), "idle", rule)
+	clock = clock.Add(2 * sweepInterval)
+	_, _ = l.Allow(context.Background(), "busy", rule)
+
+	assert.NotContains(t, l.buckets, "idle")
+	assert.Contains(t, l.buckets, "busy")
+}
@@ -0,0 +1,35 @@
+// Package ratelimit provides token bucket rate limiters backed by process memory or Redis.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Rule allows Requests requests per Period. Buckets start full, so up to Requests
+// requests may arrive in a burst; tokens then refill evenly over Period.
+type Rule struct {
+	Requests int
+	Period   time.Duration
+}
+
+// refillPerSecond returns how many tokens the rule adds back per second.
+func (r Rule) refillPerSecond() float64 {
+	return float64(r.Requests) / r.Period.Seconds()
+}
+
+// Decision is the outcome of a rate limit check.
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration // Time until the next token is available; zero when allowed
+}
+
+// Limiter takes one token from the bucket identified by key.
+type Limiter interface {
+	Allow(ctx context.Context, key string, rule Rule) (Decision, error)
+}
+
+// retryAfter returns how long it takes the bucket to refill from tokens to one token.
+func retryAfter(tokens float64, rule Rule) time.Duration {
+	return time.Duration((1 - tokens) / rule.refillPerSecond() * float64(time.Second))
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+)
+
+// tokenBucketScript refills and takes from a bucket stored as a hash {tokens, ts} atomically,
+// using the Redis server clock so all instances agree on time.
+// Returns {allowed (0/1), retry after in milliseconds}.
+const tokenBucketScript = `
+local capacity = tonumber(ARGV[1])
+local refill = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or capacity
+local ts = tonumber(state[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * refill)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / refill * 1000)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity / refill * 1000))
+return {allowed, wait}
+`
+
+// RedisLimiter keeps buckets in Redis so the limit is shared by every instance.
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisLimiter creates a limiter storing its buckets under the given key prefix.
+func NewRedisLimiter(client *redis.Client, prefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, prefix: prefix}
+}
+
+// Allow takes one token from the bucket of key.
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (Decision, error) {
+	reply, err := l.client.Do(ctx, "EVAL", tokenBucketScript, "1", l.prefix+key,
+		strconv.Itoa(rule.Requests), strconv.FormatFloat(rule.refillPerSecond(), 'f', -1, 64))
+	if err != nil {
+		return Decision{}, err
+	}
+
+	values, ok := reply.([]any)
+	if !ok || len(values) != 2 {
+		return Decision{}, fmt.Errorf("unexpected redis reply %v", reply)
+	}
+	allowed, _ := values[0].(int64)
+	waitMs, _ := values[1].(int64)
+
+	return Decision{Allowed: allowed == 1, RetryAfter: time.Duration(waitMs) * time.Millisecond}, nil
+}
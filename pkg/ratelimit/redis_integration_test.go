@@ -0,0 +1,63 @@
+//go:build integration
+
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedisLimiter_Script runs the token bucket script on the Redis server at TEST_REDIS_URL.
+func TestRedisLimiter_Script(t *testing.T) {
+	rawURL := os.Getenv("TEST_REDIS_URL")
+	if rawURL == "" {
+		t.Skip("TEST_REDIS_URL is not set")
+	}
+	client, err := redis.NewClient(rawURL)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	prefix := "test:ratelimit:" + strconv.FormatInt(time.Now().UnixNano(), 36) + ":"
+	limiter := NewRedisLimiter(client, prefix)
+	rule := Rule{Requests: 2, Period: 400 * time.Millisecond} // One token per 200ms
+
+	for i := range 2 {
+		decision, err := limiter.Allow(ctx, "ip:1", rule)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed, "call %d", i+1)
+	}
+	decision, err := limiter.Allow(ctx, "ip:1", rule)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Greater(t, decision.RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, decision.RetryAfter, 200*time.Millisecond)
+
+	// Other keys have buckets of their own
+	decision, err = limiter.Allow(ctx, "ip:2", rule)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+
+	ttl, err := client.Do(ctx, "PTTL", prefix+"ip:1")
+	require.NoError(t, err)
+	assert.Greater(t, ttl, int64(0))
+	assert.LessOrEqual(t, ttl, int64(400))
+
+	// Once the key expires the bucket starts full again
+	time.Sleep(500 * time.Millisecond)
+	exists, err := client.Do(ctx, "EXISTS", prefix+"ip:1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+	for i := range 2 {
+		decision, err := limiter.Allow(ctx, "ip:1", rule)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed, "call %d after reset", i+1)
+	}
+}
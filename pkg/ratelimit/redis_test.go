@@ -0,0 +1,236 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is a RESP server answering each command with reply. It runs on its own
+// goroutines, so failures are reported with assert rather than require.
+type fakeRedis struct {
+	t     *testing.T
+	mu    sync.Mutex
+	reply func(args []string) string
+}
+
+func newFakeRedis(t *testing.T, reply func(args []string) string) *redis.Client {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	f := &fakeRedis{t: t, reply: reply}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+
+	client, err := redis.NewClient("redis://" + ln.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		f.mu.Lock()
+		reply := f.reply(args)
+		f.mu.Unlock()
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+// readCommand reads a command sent by the client: an array of bulk strings.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "*"), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if line, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "$"), "\r\n"))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+// scriptBucket is a bucket as tokenBucketScript stores it.
+type scriptBucket struct {
+	tokens, ts float64
+	expiresAt  float64
+}
+
+// tokenBucketServer answers EVAL of tokenBucketScript the way Redis runs it, on a clock the test
+// sets. No Redis server is available here to run the Lua itself, so this mirrors the script line
+// by line; the commands the limiter sends are checked exactly.
+type tokenBucketServer struct {
+	t       *testing.T
+	now     float64 // Seconds, as returned by TIME
+	buckets map[string]*scriptBucket
+	keys    []string
+}
+
+func (s *tokenBucketServer) reply(args []string) string {
+	if !assert.Len(s.t, args, 6) || !assert.Equal(s.t, "EVAL", args[0]) {
+		return "-ERR unexpected command\r\n"
+	}
+	assert.Equal(s.t, tokenBucketScript, args[1])
+	assert.Equal(s.t, "1", args[2], "number of keys")
+	key := args[3]
+	s.keys = append(s.keys, key)
+	capacity, err := strconv.ParseFloat(args[4], 64)
+	assert.NoError(s.t, err)
+	refill, err := strconv.ParseFloat(args[5], 64)
+	assert.NoError(s.t, err)
+
+	bucket, ok := s.buckets[key]
+	if ok && s.now >= bucket.expiresAt {
+		delete(s.buckets, key)
+		ok = false
+	}
+	tokens, ts := capacity, s.now
+	if ok {
+		tokens, ts = bucket.tokens, bucket.ts
+	}
+	tokens = math.Min(capacity, tokens+math.Max(0, s.now-ts)*refill)
+	allowed, wait := 0, 0.0
+	if tokens >= 1 {
+		tokens--
+		allowed = 1
+	} else {
+		wait = math.Ceil((1 - tokens) / refill * 1000)
+	}
+	s.buckets[key] = &scriptBucket{tokens: tokens, ts: s.now, expiresAt: s.now + math.Ceil(capacity/refill*1000)/1000}
+	return fmt.Sprintf("*2\r\n:%d\r\n:%d\r\n", allowed, int64(wait))
+}
+
+func TestRedisLimiter_Allow(t *testing.T) {
+	rule := Rule{Requests: 3, Period: 3 * time.Second} // One token per second
+
+	tests := []struct {
+		name           string
+		steps          []float64 // Seconds advanced before each call
+		wantAllowed    []bool
+		wantRetryAfter time.Duration // Of the last call
+	}{
+		{
+			name:        "full bucket allows a burst",
+			steps:       []float64{0, 0, 0},
+			wantAllowed: []bool{true, true, true},
+		},
+		{
+			name:           "empty bucket denies",
+			steps:          []float64{0, 0, 0, 0},
+			wantAllowed:    []bool{true, true, true, false},
+			wantRetryAfter: time.Second,
+		},
+		{
+			name:           "retry after counts partial refill",
+			steps:          []float64{0, 0, 0, 0.4},
+			wantAllowed:    []bool{true, true, true, false},
+			wantRetryAfter: 600 * time.Millisecond,
+		},
+		{
+			name:        "tokens refill over time",
+			steps:       []float64{0, 0, 0, 1},
+			wantAllowed: []bool{true, true, true, true},
+		},
+		{
+			// The key expires once a full bucket's worth of time has passed, so it starts full again
+			name:        "expired bucket resets",
+			steps:       []float64{0, 0, 0, 0, 10, 0, 0},
+			wantAllowed: []bool{true, true, true, false, true, true, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &tokenBucketServer{t: t, now: 1_767_268_800, buckets: make(map[string]*scriptBucket)}
+			limiter := NewRedisLimiter(newFakeRedis(t, server.reply), "xyz:ratelimit:")
+
+			var last Decision
+			for i, step := range tt.steps {
+				server.now += step
+				decision, err := limiter.Allow(context.Background(), "ip:203.0.113.7", rule)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAllowed[i], decision.Allowed, "call %d", i+1)
+				last = decision
+			}
+			assert.Equal(t, tt.wantRetryAfter, last.RetryAfter)
+			assert.Equal(t, "xyz:ratelimit:ip:203.0.113.7", server.keys[0])
+		})
+	}
+}
+
+func TestRedisLimiter_AllowSendsRule(t *testing.T) {
+	var args []string
+	client := newFakeRedis(t, func(a []string) string {
+		args = a
+		return "*2\r\n:1\r\n:0\r\n"
+	})
+
+	_, err := NewRedisLimiter(client, "rl:").Allow(context.Background(), "admin:1", Rule{Requests: 60, Period: time.Minute})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EVAL", tokenBucketScript, "1", "rl:admin:1", "60", "1"}, args)
+}
+
+func TestRedisLimiter_AllowErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		wantErr string
+	}{
+		{name: "error reply", reply: "-NOSCRIPT scripting disabled\r\n", wantErr: "NOSCRIPT scripting disabled"},
+		{name: "not an array", reply: ":1\r\n", wantErr: "unexpected redis reply"},
+		{name: "short array", reply: "*1\r\n:1\r\n", wantErr: "unexpected redis reply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeRedis(t, func([]string) string { return tt.reply })
+			_, err := NewRedisLimiter(client, "rl:").Allow(context.Background(), "ip:1", Rule{Requests: 1, Period: time.Second})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Package redis is a minimal Redis client speaking RESP2 over a small connection pool.
+// It covers the few commands this service needs without an external dependency.
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// poolSize is the maximum number of idle connections kept open.
+const poolSize = 8
+
+// timeout bounds dialing and each command round trip.
+const timeout = 2 * time.Second
+
+// Client sends commands to one Redis server.
+type Client struct {
+	addr     string
+	username string
+	password string
+	db       int
+	idle     chan *conn
+}
+
+// NewClient creates a client for a redis://[[user]:password@]host:port[/db] URL.
+// A user selects a Redis 6 ACL user; without one the password is checked against
+// requirepass. Connections are opened lazily on first use.
+func NewClient(rawURL string) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "redis" || u.Host == "" {
+		return nil, fmt.Errorf("invalid redis url, expected redis://[[user]:password@]host:port[/db]")
+	}
+
+	c := &Client{
+		addr: u.Host,
+		idle: make(chan *conn, poolSize),
+	}
+	if u.Port() == "" {
+		c.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	if password, ok := u.User.Password(); ok {
+		c.username = u.User.Username()
+		c.password = password
+	}
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if c.db, err = strconv.Atoi(db); err != nil {
+			return nil, fmt.Errorf("invalid redis database %q", db)
+		}
+	}
+	return c, nil
+}
+
+// Do runs a single command and returns its reply: string, int64, nil (missing bulk string) or []any.
+// Error replies from the server are returned as Error. Connections that fail are discarded.
+func (c *Client) Do(ctx context.Context, args ...string) (any, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := cn.do(ctx, args...)
+	var replyErr Error
+	if err != nil && !errors.As(err, &replyErr) {
+		cn.Close()
+		return nil, err
+	}
+
+	select {
+	case c.idle <- cn:
+	default:
+		cn.Close()
+	}
+	return reply, err
+}
+
+// Ping checks that the server is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Do(ctx, "PING")
+	return err
+}
+
+// Close closes all idle connections.
+func (c *Client) Close() error {
+	for {
+		select {
+		case cn := <-c.idle:
+			cn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// get returns an idle connection or dials, authenticates and selects the database on a new one.
+func (c *Client) get(ctx context.Context) (*conn, error) {
+	select {
+	case cn := <-c.idle:
+		return cn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: timeout}
+	nc, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, fmt.Errorf("connect to redis: %w", err)
+	}
+	cn := &conn{Conn: nc, r: bufio.NewReader(nc)}
+
+	if c.password != "" {
+		auth := []string{"AUTH", c.password}
+		if c.username != "" {
+			auth = []string{"AUTH", c.username, c.password}
+		}
+		if _, err := cn.do(ctx, auth...); err != nil {
+			cn.Close()
+			return nil, fmt.Errorf("redis auth: %w", err)
+		}
+	}
+	if c.db != 0 {
+		if _, err := cn.do(ctx, "SELECT", strconv.Itoa(c.db)); err != nil {
+			cn.Close()
+			return nil, fmt.Errorf("redis select: %w", err)
+		}
+	}
+	return cn, nil
+}
+
+// Error is an error reply sent by the server; the connection stays usable.
+type Error string
+
+func (e Error) Error() string {
+	return "redis: " + string(e)
+}
+
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// do writes a command as a RESP array of bulk strings and reads one reply.
+func (c *conn) do(ctx context.Context, args ...string) (any, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := c.Write([]byte(b.String())); err != nil {
+		return nil, err
+	}
+	return c.readReply()
+}
+
+// readReply parses one RESP2 reply: simple string, error, integer, bulk string or array.
+func (c *conn) readReply() (any, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("redis: empty reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil // missing key
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		values := make([]any, n)
+		for i := range values {
+			if values[i], err = c.readReply(); err != nil {
+				// Not an Error: the rest of the array is unread, so the connection must be dropped
+				return nil, fmt.Errorf("redis: read array element: %v", err)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("redis: unexpected reply %q", line)
+	}
+}
@@ -0,0 +1,170 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer answers each command read from nc with the next reply and records the commands.
+// A command is read with the client's own parser, since it is a RESP array of bulk strings.
+func fakeServer(t *testing.T, nc net.Conn, replies ...string) <-chan []any {
+	t.Helper()
+	commands := make(chan []any, len(replies))
+	go func() {
+		defer close(commands)
+		server := &conn{Conn: nc, r: bufio.NewReader(nc)}
+		for _, reply := range replies {
+			command, err := server.readReply()
+			if err != nil {
+				return
+			}
+			commands <- command.([]any)
+			if _, err := nc.Write([]byte(reply)); err != nil {
+				return
+			}
+		}
+	}()
+	return commands
+}
+
+func TestConn_Do(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   string
+		want    any
+		wantErr string
+	}{
+		{name: "simple string", reply: "+OK\r\n", want: "OK"},
+		{name: "error", reply: "-ERR unknown command\r\n", wantErr: "redis: ERR unknown command"},
+		{name: "integer", reply: ":42\r\n", want: int64(42)},
+		{name: "negative integer", reply: ":-2\r\n", want: int64(-2)},
+		{name: "bulk string", reply: "$5\r\nhello\r\n", want: "hello"},
+		{name: "bulk string with line break", reply: "$4\r\na\r\nb\r\n", want: "a\r\nb"},
+		{name: "empty bulk string", reply: "$0\r\n\r\n", want: ""},
+		{name: "missing bulk string", reply: "$-1\r\n", want: nil},
+		{name: "array", reply: "*3\r\n:1\r\n$3\r\nfoo\r\n$-1\r\n", want: []any{int64(1), "foo", nil}},
+		{name: "nested array", reply: "*2\r\n*1\r\n+a\r\n:2\r\n", want: []any{[]any{"a"}, int64(2)}},
+		{name: "empty array", reply: "*0\r\n", want: []any{}},
+		{name: "bad integer", reply: ":x\r\n", wantErr: "invalid syntax"},
+		{name: "error inside array", reply: "*2\r\n-ERR boom\r\n:1\r\n", wantErr: "read array element"},
+		{name: "unknown type", reply: "?\r\n", wantErr: "unexpected reply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			commands := fakeServer(t, server, tt.reply)
+
+			cn := &conn{Conn: client, r: bufio.NewReader(client)}
+			got, err := cn.do(context.Background(), "GET", "some key")
+
+			assert.Equal(t, []any{"GET", "some key"}, <-commands)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConn_DoErrorReplyIsError(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	fakeServer(t, server, "-WRONGTYPE wrong kind of value\r\n")
+
+	cn := &conn{Conn: client, r: bufio.NewReader(client)}
+	_, err := cn.do(context.Background(), "INCR", "k")
+
+	var replyErr Error
+	require.ErrorAs(t, err, &replyErr)
+	assert.Equal(t, Error("WRONGTYPE wrong kind of value"), replyErr)
+}
+
+func TestClient_Handshake(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want [][]any // Commands sent on a new connection, the last one being PING
+	}{
+		{name: "no auth", url: "redis://%s", want: [][]any{{"PING"}}},
+		{name: "password", url: "redis://:secret@%s", want: [][]any{{"AUTH", "secret"}, {"PING"}}},
+		{name: "acl user", url: "redis://cache:secret@%s", want: [][]any{{"AUTH", "cache", "secret"}, {"PING"}}},
+		{name: "database", url: "redis://cache:secret@%s/2", want: [][]any{{"AUTH", "cache", "secret"}, {"SELECT", "2"}, {"PING"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer ln.Close()
+
+			replies := make([]string, len(tt.want))
+			for i := range replies {
+				replies[i] = "+OK\r\n"
+			}
+			accepted := make(chan (<-chan []any), 1)
+			go func() {
+				nc, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				t.Cleanup(func() { nc.Close() })
+				accepted <- fakeServer(t, nc, replies...)
+			}()
+
+			client, err := NewClient(strings.Replace(tt.url, "%s", ln.Addr().String(), 1))
+			require.NoError(t, err)
+			defer client.Close()
+			require.NoError(t, client.Ping(context.Background()))
+
+			commands := <-accepted
+			var got [][]any
+			for range tt.want {
+				got = append(got, <-commands)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    Client
+		wantErr bool
+	}{
+		{name: "default port", url: "redis://localhost", want: Client{addr: "localhost:6379"}},
+		{name: "password and database", url: "redis://:pw@redis:6380/3", want: Client{addr: "redis:6380", password: "pw", db: 3}},
+		{name: "acl user", url: "redis://app:pw@redis:6379", want: Client{addr: "redis:6379", username: "app", password: "pw"}},
+		{name: "wrong scheme", url: "http://localhost:6379", wantErr: true},
+		{name: "bad database", url: "redis://localhost:6379/x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.addr, client.addr)
+			assert.Equal(t, tt.want.username, client.username)
+			assert.Equal(t, tt.want.password, client.password)
+			assert.Equal(t, tt.want.db, client.db)
+		})
+	}
+}
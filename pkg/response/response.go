@@ -3,11 +3,14 @@ package response
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/mhakimsaputra17/xyz-football-api/pkg/errs"
+	"github.com/mhakimsaputra17/xyz-football-api/pkg/i18n"
 )
 
-// Envelope is the standard API response format: { status, message, data, meta?, errors? }
+// Envelope is the standard API response format: { status, code?, message, data, meta?, errors? }
+// Code is only set on errors; see errs.AppError.
 type Envelope struct {
 	Status  string            `json:"status" example:"success"`
+	Code    string            `json:"code,omitempty" example:"TEAM_NOT_FOUND"`
 	Message string            `json:"message" example:"Operation successful"`
 	Data    any               `json:"data,omitempty"`
 	Meta    *PaginationMeta   `json:"meta,omitempty"`
@@ -26,7 +29,7 @@ type PaginationMeta struct {
 func Success(c *gin.Context, code int, message string, data any) {
 	c.JSON(code, Envelope{
 		Status:  "success",
-		Message: message,
+		Message: translate(c, message),
 		Data:    data,
 	})
 }
@@ -35,7 +38,7 @@ func Success(c *gin.Context, code int, message string, data any) {
 func SuccessWithPagination(c *gin.Context, code int, message string, data any, meta *PaginationMeta) {
 	c.JSON(code, Envelope{
 		Status:  "success",
-		Message: message,
+		Message: translate(c, message),
 		Data:    data,
 		Meta:    meta,
 	})
@@ -44,18 +47,41 @@ func SuccessWithPagination(c *gin.Context, code int, message string, data any, m
 // Error sends an error response derived from an AppError.
 // Detail is logged server-side; only the structured error goes to the client.
 func Error(c *gin.Context, err *errs.AppError) {
-	c.JSON(err.Code, Envelope{
-		Status:  "error",
-		Message: err.Message,
-		Errors:  err.Errors,
-	})
+	c.JSON(err.Code, errorEnvelope(c, err))
 }
 
 // Abort sends an error response and aborts the middleware chain.
 func Abort(c *gin.Context, err *errs.AppError) {
-	c.AbortWithStatusJSON(err.Code, Envelope{
+	c.AbortWithStatusJSON(err.Code, errorEnvelope(c, err))
+}
+
+// AbortWithData sends an error response carrying data about the error, such as when to
+// retry, and aborts the middleware chain.
+func AbortWithData(c *gin.Context, err *errs.AppError, data any) {
+	envelope := errorEnvelope(c, err)
+	envelope.Data = data
+	c.AbortWithStatusJSON(err.Code, envelope)
+}
+
+// errorEnvelope builds the envelope of an error, with its messages in the request's language.
+// The error's own field errors are left untouched; they may be logged or reused.
+func errorEnvelope(c *gin.Context, err *errs.AppError) Envelope {
+	var fields []errs.FieldError
+	if len(err.Errors) > 0 {
+		fields = make([]errs.FieldError, len(err.Errors))
+		for i, field := range err.Errors {
+			fields[i] = errs.FieldError{Field: field.Field, Message: translate(c, field.Message)}
+		}
+	}
+	return Envelope{
 		Status:  "error",
-		Message: err.Message,
-		Errors:  err.Errors,
-	})
+		Code:    err.ErrorCode,
+		Message: translate(c, err.Message),
+		Errors:  fields,
+	}
+}
+
+// translate returns message in the language LocaleMiddleware picked for the request.
+func translate(c *gin.Context, message string) string {
+	return i18n.Translate(i18n.Language(c.Request.Context()), message)
 }
@@ -0,0 +1,105 @@
+// Package scheduler runs periodic background jobs inside the API process.
+// Each job runs on its own goroutine: once at start and then on every interval tick.
+// Runs of the same job never overlap; a run that takes longer than the interval
+// delays the next one instead of stacking up.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a periodic task.
+type Job struct {
+	Name     string
+	Interval time.Duration // Time between the start of two runs; zero disables the job
+	Timeout  time.Duration // Deadline of a single run; zero means no deadline
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs registered jobs until its context is cancelled.
+type Scheduler struct {
+	logger *slog.Logger
+	jobs   []Job
+	wg     sync.WaitGroup
+}
+
+// New creates a Scheduler that logs through logger (slog.Default() when nil).
+func New(logger *slog.Logger) *Scheduler {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Scheduler{logger: logger}
+}
+
+// Add registers a job. Jobs with a zero interval are logged as disabled and never run.
+// Add must be called before Start.
+func (s *Scheduler) Add(job Job) {
+	if job.Interval <= 0 {
+		s.logger.Info("background job disabled", "job", job.Name)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job in the background until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.logger.Info("background job scheduled", "job", job.Name, "interval", job.Interval.String())
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+}
+
+// Wait blocks until every job has stopped after the Start context was cancelled.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// loop runs a job at once and then on every tick until ctx is cancelled.
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run executes a single run of a job, logging its outcome. Panics are recovered
+// so one faulty run cannot take down the process or stop later runs.
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := func() (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = fmt.Errorf("panic: %v", p)
+			}
+		}()
+		return job.Run(ctx)
+	}()
+
+	elapsed := time.Since(start).Milliseconds()
+	if err != nil {
+		s.logger.ErrorContext(ctx, "background job failed", "job", job.Name, "duration_ms", elapsed, "error", err)
+		return
+	}
+	s.logger.DebugContext(ctx, "background job finished", "job", job.Name, "duration_ms", elapsed)
+}
@@ -0,0 +1,247 @@
+// Package sheet reads the first worksheet of an uploaded CSV or XLSX file into rows of cells.
+// XLSX files are read with the standard library only (zip + XML); formulas are not evaluated,
+// the cached value stored in the file is returned instead.
+package sheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// maxPartSize caps how much of one decompressed XML part is read, guarding against zip bombs.
+const maxPartSize = 32 << 20
+
+// maxRowIndex rejects sheets whose row numbers would make the result unreasonably large.
+const maxRowIndex = 100000
+
+// ErrUnsupportedFormat is returned for files that are neither .csv nor .xlsx.
+var ErrUnsupportedFormat = errors.New("unsupported file type, expected .csv or .xlsx")
+
+// Parse reads all rows of data, choosing the format from the extension of filename.
+// Cells are returned as text with surrounding whitespace trimmed.
+func Parse(filename string, data []byte) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return parseCSV(data)
+	case ".xlsx":
+		return parseXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func parseCSV(data []byte) ([][]string, error) {
+	// Spreadsheet programs often prepend a UTF-8 byte order mark
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV file: %w", err)
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+	return rows, nil
+}
+
+// xlsxWorkbook lists the worksheets of a workbook in display order.
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xlsxRelationships maps relationship IDs to part paths.
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText is a shared or inline string, either plain or made of formatted runs.
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func parseXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.New("invalid XLSX file")
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXML(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	var ws xlsxWorksheet
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, errors.New("invalid XLSX file: worksheet not found")
+	}
+	if err := decodeXML(f, &ws); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for i, r := range ws.Rows {
+		// Rows without any cells may be omitted from the file; keep numbering aligned with the sheet
+		index := r.Index
+		if index == 0 {
+			index = len(rows) + 1
+		}
+		if index > maxRowIndex {
+			return nil, fmt.Errorf("sheet has too many rows, at most %d are supported", maxRowIndex)
+		}
+		for len(rows) < index-1 {
+			rows = append(rows, nil)
+		}
+
+		var row []string
+		for j, c := range r.Cells {
+			col := j
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, fmt.Errorf("invalid XLSX file: row %d: %w", i+1, err)
+				}
+			}
+			for len(row) <= col {
+				row = append(row, "")
+			}
+
+			switch c.Type {
+			case "s":
+				n, err := strconv.Atoi(c.Value)
+				if err != nil || n < 0 || n >= len(shared.Items) {
+					return nil, fmt.Errorf("invalid XLSX file: cell %s references a missing string", c.Ref)
+				}
+				row[col] = shared.Items[n].String()
+			case "inlineStr":
+				row[col] = c.Inline.String()
+			default:
+				row[col] = c.Value
+			}
+			row[col] = strings.TrimSpace(row[col])
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the archive path of the first worksheet through the workbook relationships.
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var wb xlsxWorkbook
+	f, ok := files["xl/workbook.xml"]
+	if !ok {
+		return "", errors.New("invalid XLSX file: workbook not found")
+	}
+	if err := decodeXML(f, &wb); err != nil {
+		return "", err
+	}
+	if len(wb.Sheets) == 0 {
+		return "", errors.New("invalid XLSX file: workbook has no sheets")
+	}
+
+	var rels xlsxRelationships
+	if f, ok := files["xl/_rels/workbook.xml.rels"]; ok {
+		if err := decodeXML(f, &rels); err != nil {
+			return "", err
+		}
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != wb.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "xl/worksheets/sheet1.xml", nil
+}
+
+func decodeXML(f *zip.File, v any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("invalid XLSX file: %w", err)
+	}
+	defer rc.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(rc, maxPartSize)).Decode(v); err != nil {
+		return fmt.Errorf("invalid XLSX file: %s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// columnIndex converts the column letters of a cell reference ("C7") to a zero-based index.
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+		n++
+	}
+	if n == 0 || n > 3 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return col - 1, nil
+}
@@ -0,0 +1,174 @@
+package sheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_XLSXFixture(t *testing.T) {
+	// players.xlsx lists the squad second in the archive but first in the workbook, uses shared,
+	// rich-text and inline strings, a formula, a skipped row and a missing cell
+	data, err := os.ReadFile("testdata/players.xlsx")
+	require.NoError(t, err)
+
+	rows, err := Parse("Players.XLSX", data)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"name", "position", "jersey_number", "height"},
+		{"Bambang Pamungkas", "FW", "20", "170"},
+		nil,
+		{"Evan Dimas", "", "6"},
+	}, rows)
+}
+
+// xlsx zips the given parts into a workbook whose first sheet is xl/worksheets/sheet1.xml.
+func xlsx(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+const workbook = `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet r:id="rId1"/></sheets></workbook>`
+
+func TestParse_XLSX(t *testing.T) {
+	tests := []struct {
+		name    string
+		parts   map[string]string
+		want    [][]string
+		wantErr string
+	}{
+		{
+			name: "cells without references",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row><c><v>1</v></c><c><v>2</v></c></row><row><c><v>3</v></c></row></sheetData></worksheet>`,
+			},
+			want: [][]string{{"1", "2"}, {"3"}},
+		},
+		{
+			name: "column after z",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="AB1"><v>x</v></c></row></sheetData></worksheet>`,
+			},
+			want: [][]string{append(make([]string, 27), "x")},
+		},
+		{
+			name:    "not a zip file",
+			wantErr: "invalid XLSX file",
+		},
+		{
+			name:    "workbook missing",
+			parts:   map[string]string{"xl/worksheets/sheet1.xml": `<worksheet/>`},
+			wantErr: "workbook not found",
+		},
+		{
+			name:    "workbook without sheets",
+			parts:   map[string]string{"xl/workbook.xml": `<workbook/>`},
+			wantErr: "workbook has no sheets",
+		},
+		{
+			name: "worksheet missing",
+			parts: map[string]string{
+				"xl/workbook.xml": workbook,
+			},
+			wantErr: "worksheet not found",
+		},
+		{
+			name: "shared string out of range",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/sharedStrings.xml":     `<sst><si><t>only</t></si></sst>`,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="A1" t="s"><v>1</v></c></row></sheetData></worksheet>`,
+			},
+			wantErr: "cell A1 references a missing string",
+		},
+		{
+			name: "bad cell reference",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1"><c r="12"><v>1</v></c></row></sheetData></worksheet>`,
+			},
+			wantErr: "invalid cell reference",
+		},
+		{
+			name: "too many rows",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="100001"><c><v>1</v></c></row></sheetData></worksheet>`,
+			},
+			wantErr: "too many rows",
+		},
+		{
+			name: "malformed xml",
+			parts: map[string]string{
+				"xl/workbook.xml":          workbook,
+				"xl/worksheets/sheet1.xml": `<worksheet><sheetData>`,
+			},
+			wantErr: "xl/worksheets/sheet1.xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte("not a zip")
+			if tt.parts != nil {
+				data = xlsx(t, tt.parts)
+			}
+
+			rows, err := Parse("squad.xlsx", data)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows)
+		})
+	}
+}
+
+func TestParse_CSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    [][]string
+		wantErr bool
+	}{
+		{name: "plain", data: "name,position\nBambang,FW\n", want: [][]string{{"name", "position"}, {"Bambang", "FW"}}},
+		{name: "byte order mark and spaces", data: "\xef\xbb\xbfname , position\n Bambang ,FW", want: [][]string{{"name", "position"}, {"Bambang", "FW"}}},
+		{name: "rows of different length", data: "a,b,c\nd\n", want: [][]string{{"a", "b", "c"}, {"d"}}},
+		{name: "quoted comma", data: `"Dimas, Evan",MF`, want: [][]string{{"Dimas, Evan", "MF"}}},
+		{name: "bad quote", data: `"unterminated,MF`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Parse("squad.csv", []byte(tt.data))
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "invalid CSV file")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows)
+		})
+	}
+}
+
+func TestParse_UnsupportedFormat(t *testing.T) {
+	_, err := Parse("squad.xls", []byte("data"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
@@ -0,0 +1,93 @@
+// Package slug turns names into URL-friendly identifiers such as "persija-jakarta".
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxLength is the longest slug Make returns, before any numeric suffix.
+const maxLength = 80
+
+// folds maps accented Latin letters to their plain ASCII spelling.
+var folds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ğ': "g", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'į': "i", 'ı': "i",
+	'ł': "l", 'ľ': "l", 'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ř': "r", 'ś': "s", 'š': "s", 'ş': "s", 'ș': "s", 'ß': "ss", 'ť': "t", 'ţ': "t", 'ț': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// Make returns the slug of name: lower-case ASCII letters and digits, with every other run of
+// characters replaced by a single dash, e.g. "Persija Jakarta" → "persija-jakarta" and
+// "Đorđe Ćosić" → "dorde-cosic". Letters without an ASCII spelling are dropped. The result is
+// empty when name has no letters or digits.
+func Make(name string) string {
+	var b strings.Builder
+	dash := false
+	write := func(s string) {
+		if dash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		dash = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			write(string(r))
+		case folds[r] != "":
+			write(folds[r])
+		case r == '\'' || r == '’':
+			// Apostrophes join their word: "O'Neil" → "oneil"
+		default:
+			dash = true
+		}
+	}
+
+	s := b.String()
+	if len(s) > maxLength {
+		s = strings.TrimRight(s[:maxLength], "-")
+	}
+	return s
+}
+
+// Unique returns base, or base with the lowest numeric suffix from 2 up ("persija-jakarta-2")
+// that is not in taken.
+func Unique(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+	if !used[base] {
+		return base
+	}
+	for n := 2; ; n++ {
+		if candidate := base + "-" + strconv.Itoa(n); !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// Derives reports whether s is base or base with a numeric suffix, as returned by Unique.
+func Derives(s, base string) bool {
+	if s == base {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(s, base+"-")
+	if !ok || suffix == "" {
+		return false
+	}
+	for _, r := range suffix {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Local is a Store that keeps files in a directory on disk. The files are expected to be
+// served from baseURL, by the API itself or by a web server or CDN in front of it.
+type Local struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocal creates a store writing to dir, which is created on the first Put.
+// baseURL is the URL or path prefix the directory is served from, e.g. "/uploads"; it is
+// empty for files only handed out through Get.
+func NewLocal(dir, baseURL string) *Local {
+	return &Local{dir: dir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Put writes data to the file for key through a temporary file, so a concurrent download
+// never sees a partly written file.
+func (l *Local) Put(_ context.Context, key string, data []byte, _ string) (string, error) {
+	name, err := l.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return "", fmt.Errorf("create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return "", fmt.Errorf("write file: %w", err)
+	}
+
+	return l.baseURL + "/" + key, nil
+}
+
+// Get reads the file for key.
+func (l *Local) Get(_ context.Context, key string) ([]byte, error) {
+	name, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the file for key.
+func (l *Local) Delete(_ context.Context, key string) error {
+	name, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete file: %w", err)
+	}
+	return nil
+}
+
+// path returns the file name for key, rejecting keys that would leave the directory.
+func (l *Local) path(key string) (string, error) {
+	if key == "" || key != path.Clean(key) || strings.HasPrefix(key, "/") || strings.HasPrefix(key, "../") || key == ".." {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
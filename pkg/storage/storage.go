@@ -0,0 +1,74 @@
+// Package storage keeps uploaded files, such as player photos, and hands out the URLs
+// clients download them from.
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNotFound is returned by Get for keys without a file.
+var ErrNotFound = errors.New("file not found")
+
+// Store saves files under slash-separated keys such as "players/<id>/photo.jpg".
+type Store interface {
+	// Put saves data under key, replacing any file already there, and returns its public URL.
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	// Get returns the contents of the file under key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the file under key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// File is a file kept by a Memory store.
+type File struct {
+	Data        []byte
+	ContentType string
+}
+
+// Memory is an in-memory Store for tests and local development.
+type Memory struct {
+	mu    sync.Mutex
+	files map[string]File
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{files: make(map[string]File)}
+}
+
+// Put stores a copy of data and returns "memory://<key>".
+func (m *Memory) Put(_ context.Context, key string, data []byte, contentType string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[key] = File{Data: append([]byte(nil), data...), ContentType: contentType}
+	return "memory://" + key, nil
+}
+
+// Get returns a copy of the file under key.
+func (m *Memory) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), file.Data...), nil
+}
+
+// Delete removes the file under key.
+func (m *Memory) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.files, key)
+	return nil
+}
+
+// File returns the file under key with its content type, and whether it exists.
+func (m *Memory) File(key string) (File, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[key]
+	return file, ok
+}
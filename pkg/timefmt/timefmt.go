@@ -0,0 +1,54 @@
+// Package timefmt formats and parses the timestamps the API exchanges: RFC 3339 with
+// millisecond precision and a UTC offset, e.g. "2025-01-15T17:30:00.000+07:00".
+package timefmt
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Layout is the RFC 3339 form timestamps are written in. UTC times end in "Z".
+const Layout = "2006-01-02T15:04:05.000Z07:00"
+
+// layouts are the forms Parse accepts. Go reads fractional seconds after the seconds of a
+// layout, so "2025-01-15T10:30:00.123Z" matches time.RFC3339 as well.
+var layouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// location is the timezone Format writes timestamps in; UTC until SetLocation is called.
+var location atomic.Pointer[time.Location]
+
+// SetLocation sets the timezone Format writes timestamps in. It is called once at startup.
+func SetLocation(loc *time.Location) {
+	location.Store(loc)
+}
+
+// Location returns the timezone Format writes timestamps in.
+func Location() *time.Location {
+	if loc := location.Load(); loc != nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// Format writes t in the configured timezone, e.g. "2025-01-15T10:30:00.000Z".
+func Format(t time.Time) string {
+	return FormatIn(t, Location())
+}
+
+// FormatIn writes t in loc, e.g. "2025-01-15T17:30:00.000+07:00".
+func FormatIn(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(Layout)
+}
+
+// Parse reads an ISO 8601 timestamp with a "Z" or numeric UTC offset, optionally with
+// fractional seconds. Values without an offset are read in loc.
+func Parse(value string, loc *time.Location) (time.Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}